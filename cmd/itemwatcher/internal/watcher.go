@@ -18,7 +18,7 @@ import (
 
 type Watcher struct {
 	gr                     *memory.GameReader
-	rules                  []nip.Rule
+	rules                  *nip.Watcher
 	alreadyNotifiedItemIDs []itemFootprint
 }
 
@@ -34,7 +34,7 @@ func (fp itemFootprint) Match(area area.ID, i data.Item) bool {
 	return fp.area == area && fp.position == i.Position && fp.name == i.Name && fp.quality == i.Quality
 }
 
-func NewWatcher(gr *memory.GameReader, rules []nip.Rule) *Watcher {
+func NewWatcher(gr *memory.GameReader, rules *nip.Watcher) *Watcher {
 	return &Watcher{gr: gr, rules: rules}
 }
 
@@ -54,15 +54,12 @@ func (w *Watcher) Start(ctx context.Context) error {
 
 			d := w.gr.GetData()
 			for _, i := range d.Inventory.ByLocation(item.LocationGround) {
-				for _, r := range w.rules {
-					res, err := r.Evaluate(i)
-					if err != nil {
-						log.Printf("error evaluating rule: %v", err)
-						continue
-					}
-					if res == nip.RuleResultNoMatch {
-						continue
-					}
+				rule, res, err := w.rules.Rules().EvaluateAllE(i)
+				if err != nil {
+					log.Printf("error evaluating rules for %s: %v", i.Name, err)
+				}
+				if res == nip.RuleResultNoMatch {
+					continue
 				}
 
 				found := false
@@ -76,7 +73,7 @@ func (w *Watcher) Start(ctx context.Context) error {
 					continue
 				}
 
-				log.Printf("%s: Item detected: %s. Quality: %s", time.Now().Format(time.RFC3339), i.Name, i.Quality.ToString())
+				log.Printf("%s: Item detected: %s. Quality: %s. Rule: %s", time.Now().Format(time.RFC3339), i.Name, i.Quality.ToString(), rule.Location())
 
 				w.alreadyNotifiedItemIDs = append(w.alreadyNotifiedItemIDs, itemFootprint{
 					detectedAt: time.Now(),
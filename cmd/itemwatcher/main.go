@@ -8,6 +8,7 @@ import (
 	"log"
 	"os"
 	"os/signal"
+	"time"
 )
 
 func main() {
@@ -18,14 +19,22 @@ func main() {
 
 	gr := memory.NewGameReader(process)
 
-	rules, err := nip.ReadDir("config/itemfilter/")
+	rules, err := nip.NewWatcher("config/itemfilter/", time.Second)
 	if err != nil {
 		log.Fatalf("error reading NIP files: %s", err.Error())
 	}
+	rules.OnReload(func(r nip.Rules, err error) {
+		if err != nil {
+			log.Printf("error reloading NIP files, keeping previous rules: %s", err.Error())
+			return
+		}
+		log.Printf("NIP files reloaded, %d rules loaded", len(r))
+	})
 
 	watcher := itemwatcher.NewWatcher(gr, rules)
 
 	ctx := contextWithSigterm(context.Background())
+	go rules.Start(ctx)
 	err = watcher.Start(ctx)
 	if err != nil {
 		log.Fatalf("error during process: %s", err.Error())
@@ -7,6 +7,7 @@ import (
 	"go/format"
 	"os"
 	"regexp"
+	"slices"
 	"strings"
 	"text/template"
 )
@@ -114,6 +115,22 @@ func generateFile(sourcePath, destinationPath, tpl string) error {
 			reg := regexp.MustCompile("[^0-9]")
 			return reg.ReplaceAllString(s, "")
 		},
+		// synergies returns the skills referenced in the synergy formulas, like "skill('Fire Bolt'.blvl)"
+		"synergies": func(formulas ...string) string {
+			reg := regexp.MustCompile(`skill\('([^']+)'\.blvl\)`)
+			names := make([]string, 0)
+			for _, f := range formulas {
+				for _, match := range reg.FindAllStringSubmatch(f, -1) {
+					if !slices.Contains(names, match[1]) {
+						names = append(names, match[1])
+					}
+				}
+			}
+			if len(names) == 0 {
+				return "nil"
+			}
+			return fmt.Sprintf("%#v", names)
+		},
 	}
 
 	t := template.Must(template.New("tpl").Funcs(funcMap).Parse(tpl))
@@ -190,7 +207,7 @@ func generateItems() error {
 			fieldsToCheck := []string{
 				"minac", "maxac", "mindam", "maxdam", "2handmindam", "2handmaxdam",
 				"minmisdam", "maxmisdam", "speed", "StrBonus", "DexBonus",
-				"reqstr", "reqdex", "durability", "level", "gemsockets",
+				"reqstr", "reqdex", "durability", "level", "gemsockets", "rangeadder",
 			}
 
 			for _, field := range fieldsToCheck {
@@ -12,7 +12,7 @@ const (
 
 var ItemTypes = map[string]Type{
 {{- range $key, $value := . }}
-	Type{{ replace $value.ItemType " " "" }}: {ID: {{ $key }}, Name: "{{ $value.ItemType }}", Code: "{{ $value.Code }}", Throwable: {{ if eq $value.Throwable "1" }}true{{ else }}false{{ end }}, Beltable: {{ if eq $value.Beltable "1" }}true{{ else }}false{{ end }}, BodyLocs: []LocationType{{ if $value.BodyLoc1 }}{ {{- $loc1 := $value.BodyLoc1 }}{{- $loc1 = replace $loc1 "tors" "Torso" }}{{- $loc1 = replace $loc1 "larm" "LeftArm" }}{{- $loc1 = replace $loc1 "rarm" "RightArm" }}{{- $loc1 = replace $loc1 "lrin" "LeftRing" }}{{- $loc1 = replace $loc1 "rrin" "RightRing" }}{{- $loc1 = replace $loc1 "glov" "Gloves" }}{{- $loc1 = replace $loc1 "feet" "Feet" }}{{- $loc1 = replace $loc1 "neck" "Neck" }}{{- $loc1 = replace $loc1 "head" "Head" }}{{- $loc1 = replace $loc1 "belt" "Belt" }}Loc{{ $loc1 }}{{ if and $value.BodyLoc2 (ne $value.BodyLoc2 $value.BodyLoc1) }}{{- $loc2 := $value.BodyLoc2 }}{{- $loc2 = replace $loc2 "tors" "Torso" }}{{- $loc2 = replace $loc2 "larm" "LeftArm" }}{{- $loc2 = replace $loc2 "rarm" "RightArm" }}{{- $loc2 = replace $loc2 "lrin" "LeftRing" }}{{- $loc2 = replace $loc2 "rrin" "RightRing" }}{{- $loc2 = replace $loc2 "glov" "Gloves" }}{{- $loc2 = replace $loc2 "feet" "Feet" }}{{- $loc2 = replace $loc2 "neck" "Neck" }}{{- $loc2 = replace $loc2 "head" "Head" }}{{- $loc2 = replace $loc2 "belt" "Belt" }}, Loc{{ $loc2 }}{{ end }}}{{ else }}{}{{ end }}},
+	Type{{ replace $value.ItemType " " "" }}: {ID: {{ $key }}, Name: "{{ $value.ItemType }}", Code: "{{ $value.Code }}", Throwable: {{ if eq $value.Throwable "1" }}true{{ else }}false{{ end }}, Beltable: {{ if eq $value.Beltable "1" }}true{{ else }}false{{ end }}, BodyLocs: []LocationType{{ if $value.BodyLoc1 }}{ {{- $loc1 := $value.BodyLoc1 }}{{- $loc1 = replace $loc1 "tors" "Torso" }}{{- $loc1 = replace $loc1 "larm" "LeftArm" }}{{- $loc1 = replace $loc1 "rarm" "RightArm" }}{{- $loc1 = replace $loc1 "lrin" "LeftRing" }}{{- $loc1 = replace $loc1 "rrin" "RightRing" }}{{- $loc1 = replace $loc1 "glov" "Gloves" }}{{- $loc1 = replace $loc1 "feet" "Feet" }}{{- $loc1 = replace $loc1 "neck" "Neck" }}{{- $loc1 = replace $loc1 "head" "Head" }}{{- $loc1 = replace $loc1 "belt" "Belt" }}Loc{{ $loc1 }}{{ if and $value.BodyLoc2 (ne $value.BodyLoc2 $value.BodyLoc1) }}{{- $loc2 := $value.BodyLoc2 }}{{- $loc2 = replace $loc2 "tors" "Torso" }}{{- $loc2 = replace $loc2 "larm" "LeftArm" }}{{- $loc2 = replace $loc2 "rarm" "RightArm" }}{{- $loc2 = replace $loc2 "lrin" "LeftRing" }}{{- $loc2 = replace $loc2 "rrin" "RightRing" }}{{- $loc2 = replace $loc2 "glov" "Gloves" }}{{- $loc2 = replace $loc2 "feet" "Feet" }}{{- $loc2 = replace $loc2 "neck" "Neck" }}{{- $loc2 = replace $loc2 "head" "Head" }}{{- $loc2 = replace $loc2 "belt" "Belt" }}, Loc{{ $loc2 }}{{ end }}}{{ else }}{}{{ end }}, Equiv: []string{ {{- if $value.Equiv1 }}"{{ $value.Equiv1 }}"{{ end }}{{ if $value.Equiv2 }}, "{{ $value.Equiv2 }}"{{ end -}} }},
 {{- end }}
 }`
 
@@ -22,7 +22,7 @@ package area
 
 var Areas = map[ID]Area{
 {{- range $key, $value := . }}
-	{{ $key }}: {Name: "{{ $value.LevelName }}", ID: {{ $key }}},
+	{{ $key }}: {Name: "{{ $value.LevelName }}", ID: {{ $key }}, MonsterLevels: [3]int{ {{- default (index $value "MonLvlEx") "0" }}, {{ default (index $value "MonLvlEx(N)") "0" }}, {{ default (index $value "MonLvlEx(H)") "0" }}}, HasWaypoint: {{ if or (eq $value.Waypoint "") (eq $value.Waypoint "255") }}false{{ else }}true{{ end }}, ConnectedAreas: []ID{ {{- $seen := "," }}{{- range $i := iterate 8 }}{{- with index $value (printf "Vis%d" $i) }}{{- if and (ne . "") (ne . "0") (not (contains $seen (printf ",%s," .))) }}{{ if ne $seen "," }}, {{ end }}{{ . }}{{- $seen = printf "%s%s," $seen . }}{{- end }}{{- end }}{{- end -}} }},
 {{- end }}
 }`
 
@@ -46,7 +46,7 @@ package skill
 
 var Skills = map[ID]Skill{
 {{- range $key, $value := . }}
-	{{ index $value "*Id" }}: {Name: "{{ $value.skill }}", SkillDesc: "{{ $value.skilldesc }}", ID: {{ $key }}, LeftSkill: {{ if eq $value.leftskill "1" }}true{{ else }}false{{ end }}, RightSkill: {{ if eq $value.rightskill "1" }}true{{ else }}false{{ end }}, ReqLevel: {{ default $value.reqlevel "0" }}, Class: "{{ default $value.charclass "" }}", ReqSkill1: "{{ default $value.reqskill1 "" }}", ReqSkill2: "{{ default $value.reqskill2 "" }}"},
+	{{ index $value "*Id" }}: {Name: "{{ $value.skill }}", SkillDesc: "{{ $value.skilldesc }}", ID: {{ $key }}, LeftSkill: {{ if eq $value.leftskill "1" }}true{{ else }}false{{ end }}, RightSkill: {{ if eq $value.rightskill "1" }}true{{ else }}false{{ end }}, ReqLevel: {{ default $value.reqlevel "0" }}, Class: "{{ default $value.charclass "" }}", ReqSkill1: "{{ default $value.reqskill1 "" }}", ReqSkill2: "{{ default $value.reqskill2 "" }}", ReqSkill3: "{{ default $value.reqskill3 "" }}", MaxLevel: {{ default $value.maxlvl "0" }}, Mana: {{ default $value.mana "0" }}, LvlMana: {{ default $value.lvlmana "0" }}, MinMana: {{ default $value.minmana "0" }}, ManaShift: {{ default $value.manashift "0" }}, CastDelayFrames: {{ default $value.localdelay "0" }}, Synergies: {{ synergies $value.DmgSymPerCalc $value.EDmgSymPerCalc $value.ELenSymPerCalc }}},
 {{- end }}
 }`
 
@@ -56,12 +56,12 @@ package item
 
 var Desc = map[int]Description{
 {{- range $key, $value := . }}
-	{{ $value.ID }}: {Name: "{{ $value.name }}", ID: {{ $value.ID }}, Code: "{{ $value.code }}", NormalCode: "{{ $value.normcode }}", UberCode: "{{ $value.ubercode }}", UltraCode: "{{ $value.ultracode }}", InventoryWidth: {{ $value.invwidth }}, InventoryHeight: {{ $value.invheight }}, MinDefense: {{ $value.minac }}, MaxDefense: {{ $value.maxac }}, MinDamage: {{ $value.mindam }}, MaxDamage: {{ $value.maxdam }}, TwoHandMinDamage: {{ index $value "2handmindam" }}, TwoHandMaxDamage: {{ index $value "2handmaxdam" }}, MinMissileDamage: {{ $value.minmisdam }}, MaxMissileDamage: {{ $value.maxmisdam }}, Speed: {{ $value.speed }}, StrengthBonus: {{ $value.StrBonus }}, DexterityBonus: {{ $value.DexBonus }}, RequiredStrength: {{ $value.reqstr }}, RequiredDexterity: {{ $value.reqdex }}, Durability: {{ $value.durability }}, RequiredLevel: {{ $value.levelreq }}, MaxSockets: {{ $value.gemsockets }}, Type: "{{ $value.type }}"},
+	{{ $value.ID }}: {Name: "{{ $value.name }}", ID: {{ $value.ID }}, Code: "{{ $value.code }}", NormalCode: "{{ $value.normcode }}", UberCode: "{{ $value.ubercode }}", UltraCode: "{{ $value.ultracode }}", InventoryWidth: {{ $value.invwidth }}, InventoryHeight: {{ $value.invheight }}, MinDefense: {{ $value.minac }}, MaxDefense: {{ $value.maxac }}, MinDamage: {{ $value.mindam }}, MaxDamage: {{ $value.maxdam }}, TwoHandMinDamage: {{ index $value "2handmindam" }}, TwoHandMaxDamage: {{ index $value "2handmaxdam" }}, MinMissileDamage: {{ $value.minmisdam }}, MaxMissileDamage: {{ $value.maxmisdam }}, Speed: {{ $value.speed }}, StrengthBonus: {{ $value.StrBonus }}, DexterityBonus: {{ $value.DexBonus }}, RequiredStrength: {{ $value.reqstr }}, RequiredDexterity: {{ $value.reqdex }}, Durability: {{ $value.durability }}, RequiredLevel: {{ $value.levelreq }}, MaxSockets: {{ $value.gemsockets }}, Range: {{ $value.rangeadder }}, Type: "{{ $value.type }}"},
 {{- end }}`
 
 const templateArmorAndMisc = `
 {{- range $key, $value := . }}
-	{{ $value.ID }}: {Name: "{{ $value.name }}", ID: {{ $value.ID }}, Code: "{{ $value.code }}", NormalCode: "{{ $value.normcode }}", UberCode: "{{ $value.ubercode }}", UltraCode: "{{ $value.ultracode }}", InventoryWidth: {{ $value.invwidth }}, InventoryHeight: {{ $value.invheight }}, MinDefense: {{ $value.minac }}, MaxDefense: {{ $value.maxac }}, MinDamage: {{ $value.mindam }}, MaxDamage: {{ $value.maxdam }}, TwoHandMinDamage: {{ index $value "2handmindam" }}, TwoHandMaxDamage: {{ index $value "2handmaxdam" }}, MinMissileDamage: {{ $value.minmisdam }}, MaxMissileDamage: {{ $value.maxmisdam }}, Speed: {{ $value.speed }}, StrengthBonus: {{ $value.StrBonus }}, DexterityBonus: {{ $value.DexBonus }}, RequiredStrength: {{ $value.reqstr }}, RequiredDexterity: {{ $value.reqdex }}, Durability: {{ $value.durability }}, RequiredLevel: {{ $value.levelreq }}, MaxSockets: {{ $value.gemsockets }}, Type: "{{ $value.type }}"},
+	{{ $value.ID }}: {Name: "{{ $value.name }}", ID: {{ $value.ID }}, Code: "{{ $value.code }}", NormalCode: "{{ $value.normcode }}", UberCode: "{{ $value.ubercode }}", UltraCode: "{{ $value.ultracode }}", InventoryWidth: {{ $value.invwidth }}, InventoryHeight: {{ $value.invheight }}, MinDefense: {{ $value.minac }}, MaxDefense: {{ $value.maxac }}, MinDamage: {{ $value.mindam }}, MaxDamage: {{ $value.maxdam }}, TwoHandMinDamage: {{ index $value "2handmindam" }}, TwoHandMaxDamage: {{ index $value "2handmaxdam" }}, MinMissileDamage: {{ $value.minmisdam }}, MaxMissileDamage: {{ $value.maxmisdam }}, Speed: {{ $value.speed }}, StrengthBonus: {{ $value.StrBonus }}, DexterityBonus: {{ $value.DexBonus }}, RequiredStrength: {{ $value.reqstr }}, RequiredDexterity: {{ $value.reqdex }}, Durability: {{ $value.durability }}, RequiredLevel: {{ $value.levelreq }}, MaxSockets: {{ $value.gemsockets }}, Range: {{ $value.rangeadder }}, Type: "{{ $value.type }}"},
 {{- end }}
 `
 
@@ -71,7 +71,7 @@ package object
 
 var Desc = map[int]Description{
 {{- range $key, $value := . }}
-	{{ index $value "*ID" }}: {Name: "{{ $value.Name }}", ID: {{ index $value "*ID" }}, SizeX: {{ $value.SizeX }}, SizeY: {{ $value.SizeY }}, Left: {{ $value.Left }}, Top: {{ $value.Top }}, Width: {{ $value.Width }}, Height: {{ $value.Height }}, Yoffset: {{ $value.Yoffset }}, Xoffset: {{ $value.Xoffset }}, HasCollision: {{ if eq $value.HasCollision0 "1" }}true{{ else }}false{{ end }}},
+	{{ index $value "*ID" }}: {Name: "{{ $value.Name }}", ID: {{ index $value "*ID" }}, SizeX: {{ $value.SizeX }}, SizeY: {{ $value.SizeY }}, Left: {{ $value.Left }}, Top: {{ $value.Top }}, Width: {{ $value.Width }}, Height: {{ $value.Height }}, Yoffset: {{ $value.Yoffset }}, Xoffset: {{ $value.Xoffset }}, HasCollision: {{ if eq $value.HasCollision0 "1" }}true{{ else }}false{{ end }}, OperateFn: {{ default $value.OperateFn "0" }}, SubClass: {{ default $value.SubClass "0" }}},
 {{- end }}
 }`
 
@@ -109,6 +109,27 @@ func MonStatsFlagsForID(id ID) (MonStatsFlags, bool) {
 	flags, ok := MonStatsFlagsByID[id]
 	return flags, ok
 }
+
+// MonStats contains the base stats of each monster, indexed by difficulty (normal, nightmare and hell)
+type MonStats struct {
+	ID          int
+	Level       [3]int // Monsters spawned by the area use the area level instead on nightmare and hell
+	MinHP       [3]int // HP and experience are percentages of the base values by monster level (monlvl.txt)
+	MaxHP       [3]int
+	Experience  [3]int
+	Resistances [3]Resistances
+}
+
+var MonStatsByID = map[ID]MonStats{
+{{- range $key, $value := . }}
+	{{ default (index $value "*hcIdx") (index $value "hcIdx") }}: {ID: {{ default (index $value "*hcIdx") (index $value "hcIdx") }}, Level: [3]int{ {{- default (index $value "Level") "0" }}, {{ default (index $value "Level(N)") "0" }}, {{ default (index $value "Level(H)") "0" }}}, MinHP: [3]int{ {{- default (index $value "minHP") "0" }}, {{ default (index $value "MinHP(N)") "0" }}, {{ default (index $value "MinHP(H)") "0" }}}, MaxHP: [3]int{ {{- default (index $value "maxHP") "0" }}, {{ default (index $value "MaxHP(N)") "0" }}, {{ default (index $value "MaxHP(H)") "0" }}}, Experience: [3]int{ {{- default (index $value "Exp") "0" }}, {{ default (index $value "Exp(N)") "0" }}, {{ default (index $value "Exp(H)") "0" }}}, Resistances: [3]Resistances{ {Physical: {{ default (index $value "ResDm") "0" }}, Magic: {{ default (index $value "ResMa") "0" }}, Fire: {{ default (index $value "ResFi") "0" }}, Lightning: {{ default (index $value "ResLi") "0" }}, Cold: {{ default (index $value "ResCo") "0" }}, Poison: {{ default (index $value "ResPo") "0" }}}, {Physical: {{ default (index $value "ResDm(N)") "0" }}, Magic: {{ default (index $value "ResMa(N)") "0" }}, Fire: {{ default (index $value "ResFi(N)") "0" }}, Lightning: {{ default (index $value "ResLi(N)") "0" }}, Cold: {{ default (index $value "ResCo(N)") "0" }}, Poison: {{ default (index $value "ResPo(N)") "0" }}}, {Physical: {{ default (index $value "ResDm(H)") "0" }}, Magic: {{ default (index $value "ResMa(H)") "0" }}, Fire: {{ default (index $value "ResFi(H)") "0" }}, Lightning: {{ default (index $value "ResLi(H)") "0" }}, Cold: {{ default (index $value "ResCo(H)") "0" }}, Poison: {{ default (index $value "ResPo(H)") "0" }}}}},
+{{- end }}
+}
+
+func MonStatsForID(id ID) (MonStats, bool) {
+	stats, ok := MonStatsByID[id]
+	return stats, ok
+}
 `
 
 const templateMonStats2 = `// Code generated by cmd/txttocode. DO NOT EDIT.
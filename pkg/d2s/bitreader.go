@@ -0,0 +1,52 @@
+package d2s
+
+import (
+	"errors"
+)
+
+var errUnexpectedEOF = errors.New("unexpected end of file")
+
+// bitReader reads little endian bit fields, least significant bit first, as the game stores them
+type bitReader struct {
+	data []byte
+	pos  int // Position in bits
+	err  error
+}
+
+func newBitReader(data []byte, bytePos int) *bitReader {
+	return &bitReader{data: data, pos: bytePos * 8}
+}
+
+// bits reads up to 32 bits, after an error every read returns 0 and the error is kept
+func (r *bitReader) bits(n int) uint32 {
+	if r.err != nil {
+		return 0
+	}
+	if r.pos+n > len(r.data)*8 {
+		r.err = errUnexpectedEOF
+		return 0
+	}
+
+	var value uint32
+	for i := 0; i < n; i++ {
+		bytePos := (r.pos + i) / 8
+		bitPos := (r.pos + i) % 8
+		value |= uint32(r.data[bytePos]>>bitPos&1) << i
+	}
+	r.pos += n
+
+	return value
+}
+
+func (r *bitReader) bool() bool {
+	return r.bits(1) == 1
+}
+
+// align moves the reader to the beginning of the next byte if it's in the middle of one
+func (r *bitReader) align() {
+	r.pos = (r.pos + 7) / 8 * 8
+}
+
+func (r *bitReader) bytePos() int {
+	return (r.pos + 7) / 8
+}
@@ -0,0 +1,300 @@
+// Package d2s parses offline character save files (.d2s) into the same data types returned by the memory reader.
+// Only the first D2R save file version is supported (version 97)
+package d2s
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/hectorgimenez/d2go/pkg/data"
+	"github.com/hectorgimenez/d2go/pkg/data/area"
+	"github.com/hectorgimenez/d2go/pkg/data/difficulty"
+	"github.com/hectorgimenez/d2go/pkg/data/item"
+	"github.com/hectorgimenez/d2go/pkg/data/quest"
+	"github.com/hectorgimenez/d2go/pkg/data/skill"
+	"github.com/hectorgimenez/d2go/pkg/data/stat"
+)
+
+const (
+	signature = 0xAA55AA55
+
+	// First D2R version, older versions use a different item format. Newer versions change the header layout and are
+	// rejected until they can be checked against real save files
+	minVersion = 0x61
+	maxVersion = 0x61
+
+	nameOffset       = 0x14
+	statusOffset     = 0x24
+	classOffset      = 0x28
+	levelOffset      = 0x2B
+	difficultyOffset = 0xA8
+	questsOffset     = 0x14F
+	waypointsOffset  = 0x279
+	statsOffset      = 0x2FD
+
+	questsHeaderSize  = 10
+	questsPerDiffSize = 96
+	wpHeaderSize      = 8
+	wpPerDiffSize     = 24
+	skillsCount       = 30
+)
+
+var (
+	ErrInvalidSignature  = errors.New("invalid d2s file signature")
+	ErrUnsupportedFormat = errors.New("unsupported d2s file version")
+
+	errInvalidItemCode = errors.New("invalid item code")
+)
+
+var difficulties = []difficulty.Difficulty{difficulty.Normal, difficulty.Nightmare, difficulty.Hell}
+
+// First skill of each class, skills are stored in the same order they are defined in skills.txt
+var firstClassSkill = map[data.Class]skill.ID{
+	data.Amazon:      skill.MagicArrow,
+	data.Sorceress:   skill.FireBolt,
+	data.Necromancer: skill.AmplifyDamage,
+	data.Paladin:     skill.Sacrifice,
+	data.Barbarian:   skill.Bash,
+	data.Druid:       skill.Raven,
+	data.Assassin:    skill.FireBlast,
+}
+
+// Waypoints in the same order they are stored in the save file
+var waypoints = []area.ID{
+	area.RogueEncampment, area.ColdPlains, area.StonyField, area.DarkWood, area.BlackMarsh, area.OuterCloister,
+	area.JailLevel1, area.InnerCloister, area.CatacombsLevel2,
+	area.LutGholein, area.SewersLevel2Act2, area.DryHills, area.HallsOfTheDeadLevel2, area.FarOasis, area.LostCity,
+	area.PalaceCellarLevel1, area.ArcaneSanctuary, area.CanyonOfTheMagi,
+	area.KurastDocks, area.SpiderForest, area.GreatMarsh, area.FlayerJungle, area.LowerKurast, area.KurastBazaar,
+	area.UpperKurast, area.Travincal, area.DuranceOfHateLevel2,
+	area.ThePandemoniumFortress, area.CityOfTheDamned, area.RiverOfFlame,
+	area.Harrogath, area.FrigidHighlands, area.ArreatPlateau, area.CrystallinePassage, area.HallsOfPain,
+	area.GlacialTrail, area.FrozenTundra, area.TheAncientsWay, area.TheWorldStoneKeepLevel2,
+}
+
+type Character struct {
+	Version    uint32
+	Name       string
+	Class      data.Class
+	Level      int
+	Hardcore   bool
+	Expansion  bool
+	Ladder     bool
+	Died       bool
+	Difficulty difficulty.Difficulty // Difficulty the character was playing when saved
+	Act        int                   // Act the character was playing when saved, starting from 1
+	Stats      stat.Stats
+	Skills     map[skill.ID]skill.Points
+	Quests     quest.ByDifficulty
+	Waypoints  map[difficulty.Difficulty][]area.ID
+	Items      []data.Item // Items owned by the player, socketed items are included in the Sockets of their parent item
+	Corpse     []data.Item // Items in the corpse, only if the character died and didn't recover it
+	Mercenary  []data.Item // Items equipped by the mercenary
+}
+
+// ParseFile reads and parses a .d2s file
+func ParseFile(path string) (Character, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return Character{}, err
+	}
+
+	return Parse(content)
+}
+
+// Parse parses the content of a .d2s file
+func Parse(content []byte) (Character, error) {
+	if len(content) < statsOffset+2 {
+		return Character{}, errUnexpectedEOF
+	}
+	if binary.LittleEndian.Uint32(content) != signature {
+		return Character{}, ErrInvalidSignature
+	}
+
+	c := Character{Version: binary.LittleEndian.Uint32(content[4:])}
+	if c.Version < minVersion || c.Version > maxVersion {
+		return Character{}, fmt.Errorf("%w: %d", ErrUnsupportedFormat, c.Version)
+	}
+
+	c.parseHeader(content)
+
+	var err error
+	if c.Quests, err = parseQuests(content); err != nil {
+		return Character{}, err
+	}
+	if c.Waypoints, err = parseWaypoints(content); err != nil {
+		return Character{}, err
+	}
+
+	offset, err := c.parseStats(content)
+	if err != nil {
+		return Character{}, err
+	}
+	if offset, err = c.parseSkills(content, offset); err != nil {
+		return Character{}, err
+	}
+	if c.Items, offset, err = readItemList(content, offset, c.Version); err != nil {
+		return Character{}, fmt.Errorf("error reading player items: %w", err)
+	}
+	if c.Corpse, offset, err = readCorpse(content, offset, c.Version); err != nil {
+		return Character{}, fmt.Errorf("error reading corpse items: %w", err)
+	}
+	if c.Expansion {
+		if c.Mercenary, _, err = readMercenary(content, offset, c.Version); err != nil {
+			return Character{}, fmt.Errorf("error reading mercenary items: %w", err)
+		}
+	}
+
+	return c, nil
+}
+
+func (c *Character) parseHeader(content []byte) {
+	c.Name = readString(content[nameOffset : nameOffset+16])
+
+	status := content[statusOffset]
+	c.Hardcore = status&0x04 != 0
+	c.Died = status&0x08 != 0
+	c.Expansion = status&0x20 != 0
+	c.Ladder = status&0x40 != 0
+
+	c.Class = data.Class(content[classOffset])
+	c.Level = int(content[levelOffset])
+
+	c.Difficulty = difficulty.Normal
+	c.Act = 1
+	for i, d := range difficulties {
+		if diff := content[difficultyOffset+i]; diff&0x80 != 0 {
+			c.Difficulty = d
+			c.Act = int(diff&0x07) + 1
+		}
+	}
+}
+
+func parseQuests(content []byte) (quest.ByDifficulty, error) {
+	if !bytes.Equal(content[questsOffset:questsOffset+4], []byte("Woo!")) {
+		return nil, errors.New("quests section not found")
+	}
+
+	quests := make(quest.ByDifficulty, len(difficulties))
+	for i, d := range difficulties {
+		block := content[questsOffset+questsHeaderSize+i*questsPerDiffSize:]
+		quests[d] = make(quest.Quests, len(quest.All))
+		for _, q := range quest.All {
+			quests[d][q] = quest.Status(binary.LittleEndian.Uint16(block[q.Word()*2:]))
+		}
+	}
+
+	return quests, nil
+}
+
+func parseWaypoints(content []byte) (map[difficulty.Difficulty][]area.ID, error) {
+	if !bytes.Equal(content[waypointsOffset:waypointsOffset+2], []byte("WS")) {
+		return nil, errors.New("waypoints section not found")
+	}
+
+	wps := make(map[difficulty.Difficulty][]area.ID, len(difficulties))
+	for i, d := range difficulties {
+		// Each difficulty starts with 2 unknown bytes, followed by one bit per waypoint
+		r := newBitReader(content, waypointsOffset+wpHeaderSize+i*wpPerDiffSize+2)
+		wps[d] = make([]area.ID, 0)
+		for _, wp := range waypoints {
+			if r.bool() {
+				wps[d] = append(wps[d], wp)
+			}
+		}
+	}
+
+	return wps, nil
+}
+
+func (c *Character) parseStats(content []byte) (int, error) {
+	if !bytes.Equal(content[statsOffset:statsOffset+2], []byte("gf")) {
+		return 0, errors.New("stats section not found")
+	}
+
+	r := newBitReader(content, statsOffset+2)
+	for {
+		id := stat.ID(r.bits(9))
+		if r.err != nil {
+			return 0, r.err
+		}
+		if id == 0x1FF {
+			break
+		}
+
+		size, found := characterStatBits[id]
+		if !found {
+			return 0, fmt.Errorf("unknown character stat %d", id)
+		}
+		value := int(r.bits(size))
+		switch id {
+		case stat.Life, stat.MaxLife, stat.Mana, stat.MaxMana, stat.Stamina, stat.MaxStamina:
+			// Fixed point values, same conversion done by the memory reader
+			value >>= 8
+		}
+		c.Stats = append(c.Stats, stat.Data{ID: id, Value: value})
+	}
+
+	return r.bytePos(), r.err
+}
+
+func (c *Character) parseSkills(content []byte, offset int) (int, error) {
+	if offset+2+skillsCount > len(content) {
+		return 0, errUnexpectedEOF
+	}
+	if !bytes.Equal(content[offset:offset+2], []byte("if")) {
+		return 0, errors.New("skills section not found")
+	}
+
+	c.Skills = make(map[skill.ID]skill.Points)
+	first := firstClassSkill[c.Class]
+	for i := range skillsCount {
+		if lvl := content[offset+2+i]; lvl > 0 {
+			c.Skills[first+skill.ID(i)] = skill.Points{Level: uint(lvl)}
+		}
+	}
+
+	return offset + 2 + skillsCount, nil
+}
+
+func readString(b []byte) string {
+	if idx := bytes.IndexByte(b, 0); idx >= 0 {
+		b = b[:idx]
+	}
+
+	return string(b)
+}
+
+// PlayerUnit returns the character as it would be returned by the memory reader, only the fields stored in the save
+// file are filled
+func (c Character) PlayerUnit() data.PlayerUnit {
+	return data.PlayerUnit{
+		Name:      c.Name,
+		Class:     c.Class,
+		Stats:     c.Stats,
+		BaseStats: c.Stats,
+		Skills:    c.Skills,
+	}
+}
+
+// Inventory returns the player items as they would be returned by the memory reader, gold is taken from the player stats
+func (c Character) Inventory() data.Inventory {
+	inv := data.Inventory{AllItems: make([]data.Item, 0, len(c.Items)+len(c.Mercenary))}
+	for _, i := range append(c.Items, c.Mercenary...) {
+		if i.Location.LocationType == item.LocationBelt {
+			inv.Belt.Items = append(inv.Belt.Items, i)
+			continue
+		}
+		inv.AllItems = append(inv.AllItems, i)
+	}
+
+	gold, _ := c.Stats.FindStat(stat.Gold, 0)
+	stashGold, _ := c.Stats.FindStat(stat.StashGold, 0)
+	inv.Gold = gold.Value
+	inv.StashedGold[0] = stashGold.Value
+
+	return inv
+}
@@ -0,0 +1,160 @@
+package d2s
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/hectorgimenez/d2go/pkg/data"
+	"github.com/hectorgimenez/d2go/pkg/data/area"
+	"github.com/hectorgimenez/d2go/pkg/data/difficulty"
+	"github.com/hectorgimenez/d2go/pkg/data/item"
+	"github.com/hectorgimenez/d2go/pkg/data/quest"
+	"github.com/hectorgimenez/d2go/pkg/data/skill"
+	"github.com/hectorgimenez/d2go/pkg/data/stat"
+	"github.com/stretchr/testify/require"
+)
+
+type bitWriter struct {
+	data []byte
+	pos  int
+}
+
+func (w *bitWriter) write(value uint32, n int) {
+	for i := 0; i < n; i++ {
+		if w.pos/8 >= len(w.data) {
+			w.data = append(w.data, 0)
+		}
+		w.data[w.pos/8] |= byte(value>>i&1) << (w.pos % 8)
+		w.pos++
+	}
+}
+
+func (w *bitWriter) writeCode(code string) {
+	codes := make(map[byte]string, len(huffmanCodes))
+	for bits, c := range huffmanCodes {
+		codes[c] = bits
+	}
+	for len(code) < 4 {
+		code += " "
+	}
+	for i := range code {
+		for _, b := range codes[code[i]] {
+			w.write(uint32(b-'0'), 1)
+		}
+	}
+}
+
+func TestHuffmanCodesArePrefixFree(t *testing.T) {
+	for a := range huffmanCodes {
+		for b := range huffmanCodes {
+			if a != b && len(a) <= len(b) {
+				require.NotEqual(t, a, b[:len(a)], "%s is a prefix of %s", a, b)
+			}
+		}
+	}
+}
+
+func TestParse(t *testing.T) {
+	content := make([]byte, statsOffset)
+	binary.LittleEndian.PutUint32(content, signature)
+	binary.LittleEndian.PutUint32(content[4:], minVersion)
+	copy(content[nameOffset:], "Tester")
+	content[statusOffset] = 0x20 | 0x04
+	content[classOffset] = byte(data.Sorceress)
+	content[levelOffset] = 90
+	content[difficultyOffset+2] = 0x80 | 0x04
+	copy(content[questsOffset:], "Woo!")
+	binary.LittleEndian.PutUint16(content[questsOffset+questsHeaderSize+2*questsPerDiffSize+2:], 0x1001)
+	copy(content[waypointsOffset:], "WS")
+	content[waypointsOffset+wpHeaderSize+2] = 0x03
+
+	w := &bitWriter{data: []byte("gf")}
+	w.pos = 16
+	w.write(uint32(stat.Level), 9)
+	w.write(90, 7)
+	w.write(uint32(stat.Gold), 9)
+	w.write(5000, 25)
+	w.write(0x1FF, 9)
+	content = append(content, w.data...)
+
+	skills := make([]byte, skillsCount)
+	skills[0] = 1
+	content = append(append(content, "if"...), skills...)
+
+	// One simple item: an El rune in the inventory
+	items := &bitWriter{}
+	items.write(0x200000|0x10, 32)
+	items.write(0, 3)
+	items.write(storedLocation, 3)
+	items.write(0, 4)
+	items.write(2, 4)
+	items.write(1, 4)
+	items.write(inventoryPanel, 3)
+	items.writeCode("r01")
+	items.write(0, 1)
+	content = append(append(content, 'J', 'M', 1, 0), items.data...)
+	content = append(content, "JM\x00\x00jfkf\x00"...)
+
+	c, err := Parse(content)
+	require.NoError(t, err)
+	require.Equal(t, "Tester", c.Name)
+	require.Equal(t, data.Sorceress, c.Class)
+	require.Equal(t, 90, c.Level)
+	require.True(t, c.Hardcore)
+	require.True(t, c.Expansion)
+	require.Equal(t, difficulty.Difficulty(difficulty.Hell), c.Difficulty)
+	require.Equal(t, 5, c.Act)
+	require.True(t, c.Quests[difficulty.Hell][quest.Act1DenOfEvil].Completed())
+	require.Equal(t, []area.ID{area.RogueEncampment, area.ColdPlains}, c.Waypoints[difficulty.Normal])
+	require.Equal(t, skill.Points{Level: 1}, c.Skills[skill.FireBolt])
+	require.Equal(t, 5000, c.Inventory().Gold)
+
+	require.Len(t, c.Items, 1)
+	require.Equal(t, item.Name("ElRune"), c.Items[0].Name)
+	require.Equal(t, item.LocationInventory, c.Items[0].Location.LocationType)
+	require.Equal(t, data.Position{X: 2, Y: 1}, c.Items[0].Position)
+}
+
+func TestParseInvalidSignature(t *testing.T) {
+	_, err := Parse(make([]byte, statsOffset+2))
+	require.ErrorIs(t, err, ErrInvalidSignature)
+}
+
+func TestParseUnsupportedVersion(t *testing.T) {
+	for _, version := range []uint32{0x60, 0x62} {
+		content := make([]byte, statsOffset+2)
+		binary.LittleEndian.PutUint32(content, signature)
+		binary.LittleEndian.PutUint32(content[4:], version)
+
+		_, err := Parse(content)
+		require.ErrorIs(t, err, ErrUnsupportedFormat, "version %d", version)
+	}
+}
+
+func TestParseSharedStash(t *testing.T) {
+	page := func(gold uint32) []byte {
+		header := make([]byte, stashPageHeaderSize)
+		binary.LittleEndian.PutUint32(header, signature)
+		binary.LittleEndian.PutUint32(header[4:], 1)
+		binary.LittleEndian.PutUint32(header[8:], minVersion)
+		binary.LittleEndian.PutUint32(header[12:], gold)
+		binary.LittleEndian.PutUint32(header[16:], stashPageHeaderSize+4)
+		return append(header, 'J', 'M', 0, 0)
+	}
+
+	content := append(append(page(100), page(200)...), page(300)...)
+	stash, err := ParseSharedStash(content)
+	require.NoError(t, err)
+	require.False(t, stash.Hardcore)
+	require.Len(t, stash.Pages, 3)
+	require.Equal(t, [4]int{0, 100, 200, 300}, stash.Merge(data.Inventory{}).StashedGold)
+
+	// Page size pointing past the end of the file
+	binary.LittleEndian.PutUint32(content[2*(stashPageHeaderSize+4)+16:], stashPageHeaderSize+8)
+	_, err = ParseSharedStash(content)
+	require.Error(t, err)
+
+	binary.LittleEndian.PutUint32(content[8:], 0x62)
+	_, err = ParseSharedStash(content)
+	require.ErrorIs(t, err, ErrUnsupportedFormat)
+}
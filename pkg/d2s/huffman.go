@@ -0,0 +1,75 @@
+package d2s
+
+import "strings"
+
+// Since D2R item codes are huffman encoded, each string is the sequence of bits in the order they are read
+var huffmanCodes = map[string]byte{
+	"10":        ' ',
+	"11111011":  '0',
+	"1111100":   '1',
+	"001100":    '2',
+	"1101101":   '3',
+	"11111010":  '4',
+	"00010110":  '5',
+	"1101111":   '6',
+	"01111":     '7',
+	"000100":    '8',
+	"01110":     '9',
+	"11110":     'a',
+	"0101":      'b',
+	"01000":     'c',
+	"110001":    'd',
+	"110000":    'e',
+	"010011":    'f',
+	"11010":     'g',
+	"00011":     'h',
+	"1111110":   'i',
+	"000101110": 'j',
+	"010010":    'k',
+	"11101":     'l',
+	"01101":     'm',
+	"001101":    'n',
+	"1111111":   'o',
+	"11001":     'p',
+	"11011001":  'q',
+	"11100":     'r',
+	"0010":      's',
+	"01100":     't',
+	"00001":     'u',
+	"1101110":   'v',
+	"00000":     'w',
+	"00111":     'x',
+	"0001010":   'y',
+	"11011000":  'z',
+}
+
+// Longest code in the table, used to detect corrupted data
+const maxHuffmanCodeLength = 9
+
+// readItemCode reads the 4 huffman encoded characters of the item code, codes shorter than 4 characters are padded
+// with spaces
+func readItemCode(r *bitReader) (string, error) {
+	var code strings.Builder
+	for range 4 {
+		var bits strings.Builder
+		for {
+			if r.bool() {
+				bits.WriteByte('1')
+			} else {
+				bits.WriteByte('0')
+			}
+			if r.err != nil {
+				return "", r.err
+			}
+			if c, found := huffmanCodes[bits.String()]; found {
+				code.WriteByte(c)
+				break
+			}
+			if bits.Len() >= maxHuffmanCodeLength {
+				return "", errInvalidItemCode
+			}
+		}
+	}
+
+	return strings.TrimSpace(code.String()), nil
+}
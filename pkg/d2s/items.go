@@ -0,0 +1,462 @@
+package d2s
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/hectorgimenez/d2go/pkg/data"
+	"github.com/hectorgimenez/d2go/pkg/data/item"
+	"github.com/hectorgimenez/d2go/pkg/data/stat"
+)
+
+const (
+	// Runeword IDs are stored as the runes.txt row, the game uses this offset for the runeword "prefix"
+	runewordPrefixOffset = 20480
+	// Some save files store Delirium with this ID instead of the runes.txt row
+	deliriumAltID = 2718
+	deliriumID    = 48
+
+	framesPerSecond = 25
+)
+
+var itemListHeader = []byte("JM")
+
+var (
+	itemIDsByCode     map[string]int
+	itemIDsByCodeOnce sync.Once
+)
+
+// Stored item location, panel is only set for stored items
+const (
+	storedLocation   = 0
+	equippedLocation = 1
+	beltLocation     = 2
+	cursorLocation   = 4
+	socketLocation   = 6
+
+	inventoryPanel = 1
+	cubePanel      = 4
+	stashPanel     = 5
+)
+
+// parsedItem is an item and the amount of socketed items stored right after it
+type parsedItem struct {
+	data.Item
+	socketed int
+}
+
+// readItemList reads a "JM" item list, socketed items are added to their parent item Sockets
+func readItemList(content []byte, offset int, version uint32) ([]data.Item, int, error) {
+	if offset+4 > len(content) {
+		return nil, 0, errUnexpectedEOF
+	}
+	if !bytes.Equal(content[offset:offset+2], itemListHeader) {
+		return nil, 0, errors.New("item list not found")
+	}
+
+	count := int(binary.LittleEndian.Uint16(content[offset+2:]))
+	offset += 4
+
+	items := make([]data.Item, 0, count)
+	for i := 0; i < count; i++ {
+		it, next, err := readItem(content, offset, version)
+		if err != nil {
+			return nil, 0, fmt.Errorf("error reading item %d at offset %d: %w", i, offset, err)
+		}
+		offset = next
+
+		for s := 0; s < it.socketed; s++ {
+			socketed, next, err := readItem(content, offset, version)
+			if err != nil {
+				return nil, 0, fmt.Errorf("error reading socketed item at offset %d: %w", offset, err)
+			}
+			offset = next
+			it.Sockets = append(it.Sockets, socketed.Item)
+		}
+
+		items = append(items, it.Item)
+	}
+
+	return items, offset, nil
+}
+
+func readCorpse(content []byte, offset int, version uint32) ([]data.Item, int, error) {
+	if offset+4 > len(content) || !bytes.Equal(content[offset:offset+2], itemListHeader) {
+		return nil, 0, errors.New("corpse section not found")
+	}
+
+	corpses := int(binary.LittleEndian.Uint16(content[offset+2:]))
+	offset += 4
+
+	items := make([]data.Item, 0)
+	for i := 0; i < corpses; i++ {
+		// Unknown value and corpse position, 4 bytes each
+		corpseItems, next, err := readItemList(content, offset+12, version)
+		if err != nil {
+			return nil, 0, err
+		}
+		items = append(items, corpseItems...)
+		offset = next
+	}
+
+	return items, offset, nil
+}
+
+func readMercenary(content []byte, offset int, version uint32) ([]data.Item, int, error) {
+	if offset+2 > len(content) || !bytes.Equal(content[offset:offset+2], []byte("jf")) {
+		return nil, 0, errors.New("mercenary section not found")
+	}
+	offset += 2
+
+	// Item list is only present if the character has a mercenary
+	if offset+2 > len(content) || !bytes.Equal(content[offset:offset+2], itemListHeader) {
+		return nil, offset, nil
+	}
+
+	items, offset, err := readItemList(content, offset, version)
+	if err != nil {
+		return nil, 0, err
+	}
+	for i := range items {
+		items[i].Location.LocationType = item.LocationMercenary
+	}
+
+	return items, offset, nil
+}
+
+// readItem reads a single item starting at offset, it returns the offset of the next item
+func readItem(content []byte, offset int, version uint32) (parsedItem, int, error) {
+	r := newBitReader(content, offset)
+
+	// Item flags, same values used by the game in memory
+	flags := r.bits(32)
+	it := parsedItem{Item: data.Item{Sockets: make([]data.Item, 0)}}
+	it.Identified = flags&0x10 != 0
+	it.HasSockets = flags&0x800 != 0
+	it.IsEar = flags&0x10000 != 0
+	it.IsStartItem = flags&0x20000 != 0
+	it.Ethereal = flags&0x400000 != 0
+	it.IsNamed = flags&0x1000000 != 0
+	it.IsRuneword = flags&0x4000000 != 0
+	simple := flags&0x200000 != 0
+
+	r.bits(3) // Item version
+	location := r.bits(3)
+	equipped := r.bits(4)
+	it.Position = data.Position{X: int(r.bits(4)), Y: int(r.bits(4))}
+	panel := r.bits(3)
+	it.Location = itemLocation(location, equipped, panel)
+
+	if it.IsEar {
+		// Class, level and player name, there is no item data for ears
+		r.bits(3)
+		r.bits(7)
+		skipString(r)
+		r.align()
+		return it, r.bytePos(), r.err
+	}
+
+	code, err := readItemCode(r)
+	if err != nil {
+		return parsedItem{}, 0, err
+	}
+	id, found := itemIDByCode(code)
+	if !found {
+		return parsedItem{}, 0, fmt.Errorf("unknown item code %q", code)
+	}
+	it.ID = id
+	it.Name = item.GetNameByEnum(uint(id))
+
+	if simple {
+		it.socketed = int(r.bits(1))
+		it.Quality = item.QualityNormal
+		it.LevelReq = it.Desc().RequiredLevel
+		r.align()
+		return it, r.bytePos(), r.err
+	}
+	it.socketed = int(r.bits(3))
+
+	if err := readExtendedItem(r, &it, code, version); err != nil {
+		return parsedItem{}, 0, err
+	}
+
+	r.align()
+	return it, r.bytePos(), r.err
+}
+
+func readExtendedItem(r *bitReader, it *parsedItem, code string, version uint32) error {
+	// Save files store the item fingerprint, the seed the item was generated with, instead of a unit ID
+	it.Seed = r.bits(32)
+	it.UnitID = data.UnitID(it.Seed)
+	it.ItemLevel = int(r.bits(7))
+	it.Quality = item.Quality(r.bits(4))
+
+	// Picture variant for rings, amulets, charms and jewels
+	if r.bool() {
+		r.bits(3)
+	}
+	// Class specific automatic affix
+	if r.bool() {
+		r.bits(11)
+	}
+
+	switch it.Quality {
+	case item.QualityLowQuality, item.QualitySuperior:
+		r.bits(3)
+	case item.QualityMagic:
+		it.Affixes.Magic.Prefixes[0] = int16(r.bits(11))
+		it.Affixes.Magic.Suffixes[0] = int16(r.bits(11))
+	case item.QualitySet, item.QualityUnique:
+		it.UniqueSetID = int32(r.bits(12))
+	case item.QualityRare, item.QualityCrafted:
+		it.Affixes.Rare.Prefix = int16(r.bits(8))
+		it.Affixes.Rare.Suffix = int16(r.bits(8))
+		for i := range 3 {
+			if r.bool() {
+				it.Affixes.Magic.Prefixes[i] = int16(r.bits(11))
+			}
+			if r.bool() {
+				it.Affixes.Magic.Suffixes[i] = int16(r.bits(11))
+			}
+		}
+	}
+
+	if it.IsRuneword {
+		runewordID := r.bits(12)
+		if runewordID == deliriumAltID {
+			runewordID = deliriumID
+		}
+		r.bits(4)
+		it.Affixes.Magic.Prefixes[0] = int16(runewordID + runewordPrefixOffset)
+		it.RunewordName = item.RunewordIDMap[it.Affixes.Magic.Prefixes[0]]
+	}
+
+	if it.IsNamed {
+		// Personalized items, player name stored as a null terminated string
+		skipString(r)
+	}
+
+	// Tomes store the spell they cast
+	if code == "tbk" || code == "ibk" {
+		r.bits(5)
+	}
+	// Realm data flag
+	r.bits(1)
+
+	desc := it.Desc()
+	baseStats := make(stat.Stats, 0)
+	if desc.MaxDefense > 0 {
+		def := itemStatBits[stat.Defense]
+		baseStats = append(baseStats, stat.Data{ID: stat.Defense, Value: int(r.bits(def.bits)) - def.add})
+	}
+	if desc.MaxDefense > 0 || isWeapon(desc) {
+		maxDurability := int(r.bits(itemStatBits[stat.MaxDurability].bits))
+		if maxDurability > 0 {
+			baseStats = append(baseStats,
+				stat.Data{ID: stat.MaxDurability, Value: maxDurability},
+				stat.Data{ID: stat.Durability, Value: int(r.bits(itemStatBits[stat.Durability].bits))},
+			)
+		}
+	}
+	if isStackable(desc) {
+		baseStats = append(baseStats, stat.Data{ID: stat.Quantity, Value: int(r.bits(9))})
+	}
+	if it.HasSockets {
+		baseStats = append(baseStats, stat.Data{ID: stat.NumSockets, Value: int(r.bits(4))})
+	}
+
+	// Set items store a bit for each set bonus stat list present
+	setLists := 0
+	if it.Quality == item.QualitySet {
+		setLists = bitsSet(r.bits(5))
+	}
+
+	stats, err := readStatList(r)
+	if err != nil {
+		return err
+	}
+	for range setLists {
+		if _, err := readStatList(r); err != nil {
+			return err
+		}
+	}
+	if it.IsRuneword {
+		runewordStats, err := readStatList(r)
+		if err != nil {
+			return err
+		}
+		stats = append(stats, runewordStats...)
+	}
+
+	it.BaseStats = baseStats
+	it.Stats = append(append(make(stat.Stats, 0, len(baseStats)+len(stats)), baseStats...), stats...)
+	it.IdentifiedName = identifiedName(it.Item)
+	it.LevelReq = levelReq(it.Item)
+
+	return r.err
+}
+
+// readStatList reads stats until the 0x1FF terminator, set bonus lists are read but not returned since they
+// depend on the amount of set items equipped
+func readStatList(r *bitReader) (stat.Stats, error) {
+	stats := make(stat.Stats, 0)
+	for {
+		id := stat.ID(r.bits(9))
+		if r.err != nil {
+			return nil, r.err
+		}
+		if id == 0x1FF {
+			return stats, nil
+		}
+
+		for _, statID := range append([]stat.ID{id}, statGroups[id]...) {
+			bits, found := itemStatBits[statID]
+			if !found {
+				return nil, fmt.Errorf("unknown item stat %d", statID)
+			}
+
+			layer := 0
+			if bits.paramBits > 0 {
+				layer = int(r.bits(bits.paramBits))
+			}
+			value := int(r.bits(bits.bits)) - bits.add
+			if statID == stat.ColdLength || statID == stat.PoisonLength {
+				value /= framesPerSecond
+			}
+
+			stats = append(stats, stat.Data{ID: statID, Value: value, Layer: layer})
+		}
+	}
+}
+
+// skipString skips a null terminated player name, characters are stored using 7 bits
+func skipString(r *bitReader) {
+	for r.bits(7) != 0 && r.err == nil {
+	}
+}
+
+func itemLocation(location, equipped, panel uint32) item.Location {
+	l := item.Location{LocationType: item.LocationUnknown, BodyLocation: item.LocNone}
+	switch location {
+	case storedLocation:
+		switch panel {
+		case inventoryPanel:
+			l.LocationType = item.LocationInventory
+		case cubePanel:
+			l.LocationType = item.LocationCube
+		case stashPanel:
+			l.LocationType = item.LocationStash
+		}
+	case equippedLocation:
+		l.LocationType = item.LocationEquipped
+		l.BodyLocation = bodyLocation(equipped)
+	case beltLocation:
+		l.LocationType = item.LocationBelt
+	case cursorLocation:
+		l.LocationType = item.LocationCursor
+	case socketLocation:
+		l.LocationType = item.LocationSocket
+	}
+
+	return l
+}
+
+// Same slots used in memory
+func bodyLocation(slot uint32) item.LocationType {
+	return item.BodyLocation(slot).LocationType()
+}
+
+func itemIDByCode(code string) (int, bool) {
+	itemIDsByCodeOnce.Do(func() {
+		itemIDsByCode = make(map[string]int, len(item.Desc))
+		for id, desc := range item.Desc {
+			itemIDsByCode[desc.Code] = id
+		}
+	})
+
+	id, found := itemIDsByCode[code]
+	return id, found
+}
+
+func isWeapon(desc item.Description) bool {
+	return desc.GetType().Is(item.TypeWeapon)
+}
+
+// Throwing weapons, arrows, bolts, keys and tomes have an item quantity
+func isStackable(desc item.Description) bool {
+	t := desc.GetType()
+	return t.Is(item.TypeThrownWeapon) || t.Is(item.TypeBowQuiver) || t.Is(item.TypeCrossbowQuiver) ||
+		t.Is(item.TypeKey) || t.Is(item.TypeBook)
+}
+
+func bitsSet(v uint32) int {
+	count := 0
+	for ; v > 0; v >>= 1 {
+		count += int(v & 1)
+	}
+
+	return count
+}
+
+func identifiedName(i data.Item) string {
+	if !i.Identified {
+		return ""
+	}
+
+	switch i.Quality {
+	case item.QualityUnique:
+		for _, u := range item.UniqueItems {
+			if u.ID == int(i.UniqueSetID) {
+				return u.Name
+			}
+		}
+	case item.QualitySet:
+		for name, s := range item.SetItems {
+			if s.ID == int(i.UniqueSetID) {
+				return string(name)
+			}
+		}
+	case item.QualityRare, item.QualityCrafted:
+		prefix, foundPrefix := i.Affixes.GetRarePrefix()
+		suffix, foundSuffix := i.Affixes.GetRareSuffix()
+		if foundPrefix && foundSuffix {
+			return prefix.Name + " " + suffix.Name
+		}
+	case item.QualityMagic:
+		parts := append(i.Affixes.PrefixNames(), i.Desc().Name)
+		return strings.Join(append(parts, i.Affixes.SuffixNames()...), " ")
+	}
+
+	return ""
+}
+
+func levelReq(i data.Item) int {
+	req := i.Desc().RequiredLevel
+	switch i.Quality {
+	case item.QualityUnique:
+		for _, u := range item.UniqueItems {
+			if u.ID == int(i.UniqueSetID) {
+				return max(req, u.LevelReq)
+			}
+		}
+	case item.QualitySet:
+		for _, s := range item.SetItems {
+			if s.ID == int(i.UniqueSetID) {
+				return max(req, s.LevelReq)
+			}
+		}
+	case item.QualityMagic, item.QualityRare, item.QualityCrafted:
+		for _, prefix := range i.Affixes.GetMagicPrefixes() {
+			req = max(req, prefix.LevelReq)
+		}
+		for _, suffix := range i.Affixes.GetMagicSuffixes() {
+			req = max(req, suffix.LevelReq)
+		}
+	}
+
+	return req
+}
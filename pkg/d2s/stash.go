@@ -0,0 +1,98 @@
+package d2s
+
+import (
+	"encoding/binary"
+	"fmt"
+	"os"
+
+	"github.com/hectorgimenez/d2go/pkg/data"
+	"github.com/hectorgimenez/d2go/pkg/data/item"
+)
+
+// Each shared stash page starts with a fixed size header, followed by the item list
+const stashPageHeaderSize = 64
+
+type SharedStash struct {
+	Hardcore bool
+	Pages    []StashPage
+}
+
+type StashPage struct {
+	Gold  int
+	Items []data.Item
+}
+
+// ParseSharedStashFile reads and parses a shared stash file (.d2i)
+func ParseSharedStashFile(path string) (SharedStash, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return SharedStash{}, err
+	}
+
+	return ParseSharedStash(content)
+}
+
+// ParseSharedStash parses the content of a shared stash file (.d2i), items are placed in the LocationSharedStash
+// location with the page number starting from 1, same as the memory reader does
+func ParseSharedStash(content []byte) (SharedStash, error) {
+	stash := SharedStash{Pages: make([]StashPage, 0, 3)}
+	for offset := 0; offset < len(content); {
+		if offset+stashPageHeaderSize > len(content) {
+			return SharedStash{}, errUnexpectedEOF
+		}
+		header := content[offset:]
+		if binary.LittleEndian.Uint32(header) != signature {
+			return SharedStash{}, ErrInvalidSignature
+		}
+		version := binary.LittleEndian.Uint32(header[8:])
+		if version < minVersion || version > maxVersion {
+			return SharedStash{}, fmt.Errorf("%w: %d", ErrUnsupportedFormat, version)
+		}
+		size := int(binary.LittleEndian.Uint32(header[16:]))
+		if size < stashPageHeaderSize || offset+size > len(content) {
+			return SharedStash{}, fmt.Errorf("invalid shared stash page size %d at offset %d", size, offset)
+		}
+
+		stash.Hardcore = binary.LittleEndian.Uint32(header[4:]) == 0
+		items, _, err := readItemList(content[:offset+size], offset+stashPageHeaderSize, version)
+		if err != nil {
+			return SharedStash{}, fmt.Errorf("error reading shared stash page %d: %w", len(stash.Pages)+1, err)
+		}
+		for i := range items {
+			items[i].Location.LocationType = item.LocationSharedStash
+			items[i].Location.Page = len(stash.Pages) + 1
+		}
+
+		stash.Pages = append(stash.Pages, StashPage{
+			Gold:  int(binary.LittleEndian.Uint32(header[12:])),
+			Items: items,
+		})
+		offset += size
+	}
+
+	return stash, nil
+}
+
+// Items returns the items of every page
+func (s SharedStash) Items() []data.Item {
+	items := make([]data.Item, 0)
+	for _, p := range s.Pages {
+		items = append(items, p.Items...)
+	}
+
+	return items
+}
+
+// Merge adds the shared stash items and gold to a character inventory, gold of each page is stored from index 1 of
+// StashedGold, same as the memory reader does
+func (s SharedStash) Merge(inv data.Inventory) data.Inventory {
+	inv.AllItems = append(append(make([]data.Item, 0, len(inv.AllItems)), inv.AllItems...), s.Items()...)
+	for i, p := range s.Pages {
+		if i+1 >= len(inv.StashedGold) {
+			break
+		}
+		inv.StashedGold[i+1] = p.Gold
+	}
+
+	return inv
+}
@@ -0,0 +1,238 @@
+package d2s
+
+import "github.com/hectorgimenez/d2go/pkg/data/stat"
+
+// statBits describes how an item stat is stored, values from itemstatcost.txt (Save Bits, Save Add, Save Param Bits)
+type statBits struct {
+	bits      int
+	add       int
+	paramBits int
+}
+
+// Character stats stored in the attributes section (CSvBits)
+var characterStatBits = map[stat.ID]int{
+	stat.Strength:    10,
+	stat.Energy:      10,
+	stat.Dexterity:   10,
+	stat.Vitality:    10,
+	stat.StatPoints:  10,
+	stat.SkillPoints: 8,
+	stat.Life:        21,
+	stat.MaxLife:     21,
+	stat.Mana:        21,
+	stat.MaxMana:     21,
+	stat.Stamina:     21,
+	stat.MaxStamina:  21,
+	stat.Level:       7,
+	stat.Experience:  32,
+	stat.Gold:        25,
+	stat.StashGold:   25,
+}
+
+// Some stats are always saved together, only the first one has the stat ID
+var statGroups = map[stat.ID][]stat.ID{
+	stat.EnhancedDamageMin:  {stat.EnhancedDamage},
+	stat.FireMinDamage:      {stat.FireMaxDamage},
+	stat.LightningMinDamage: {stat.LightningMaxDamage},
+	stat.MagicMinDamage:     {stat.MagicMaxDamage},
+	stat.ColdMinDamage:      {stat.ColdMaxDamage, stat.ColdLength},
+	stat.PoisonMinDamage:    {stat.PoisonMaxDamage, stat.PoisonLength},
+}
+
+var itemStatBits = map[stat.ID]statBits{
+	stat.Strength:                    {bits: 8, add: 32},
+	stat.Energy:                      {bits: 7, add: 32},
+	stat.Dexterity:                   {bits: 7, add: 32},
+	stat.Vitality:                    {bits: 7, add: 32},
+	stat.MaxLife:                     {bits: 9, add: 32},
+	stat.MaxMana:                     {bits: 8, add: 32},
+	stat.MaxStamina:                  {bits: 8, add: 32},
+	stat.EnhancedDefense:             {bits: 9},
+	stat.EnhancedDamageMin:           {bits: 9},
+	stat.EnhancedDamage:              {bits: 9},
+	stat.AttackRating:                {bits: 10},
+	stat.ChanceToBlock:               {bits: 6},
+	stat.MinDamage:                   {bits: 6},
+	stat.MaxDamage:                   {bits: 7},
+	stat.TwoHandedMinDamage:          {bits: 6},
+	stat.TwoHandedMaxDamage:          {bits: 7},
+	stat.DamagePercent:               {bits: 8},
+	stat.ManaRecovery:                {bits: 8},
+	stat.ManaRecoveryBonus:           {bits: 8},
+	stat.StaminaRecoveryBonus:        {bits: 8},
+	stat.Defense:                     {bits: 11, add: 10},
+	stat.DefenseVsMissiles:           {bits: 9},
+	stat.DefenseVsHth:                {bits: 8},
+	stat.NormalDamageReduction:       {bits: 6},
+	stat.MagicDamageReduction:        {bits: 6},
+	stat.DamageReduced:               {bits: 8},
+	stat.MagicResist:                 {bits: 8, add: 50},
+	stat.MaxMagicResist:              {bits: 5},
+	stat.FireResist:                  {bits: 8, add: 50},
+	stat.MaxFireResist:               {bits: 5},
+	stat.LightningResist:             {bits: 8, add: 50},
+	stat.MaxLightningResist:          {bits: 5},
+	stat.ColdResist:                  {bits: 8, add: 50},
+	stat.MaxColdResist:               {bits: 5},
+	stat.PoisonResist:                {bits: 8, add: 50},
+	stat.MaxPoisonResist:             {bits: 5},
+	stat.FireMinDamage:               {bits: 8},
+	stat.FireMaxDamage:               {bits: 9},
+	stat.LightningMinDamage:          {bits: 6},
+	stat.LightningMaxDamage:          {bits: 10},
+	stat.MagicMinDamage:              {bits: 8},
+	stat.MagicMaxDamage:              {bits: 9},
+	stat.ColdMinDamage:               {bits: 8},
+	stat.ColdMaxDamage:               {bits: 9},
+	stat.ColdLength:                  {bits: 8},
+	stat.PoisonMinDamage:             {bits: 10},
+	stat.PoisonMaxDamage:             {bits: 10},
+	stat.PoisonLength:                {bits: 9},
+	stat.LifeSteal:                   {bits: 7},
+	stat.ManaSteal:                   {bits: 7},
+	stat.Durability:                  {bits: 9},
+	stat.MaxDurability:               {bits: 8},
+	stat.ReplenishLife:               {bits: 6, add: 30},
+	stat.MaxDurabilityPercent:        {bits: 7, add: 20},
+	stat.MaxLifePercent:              {bits: 6, add: 10},
+	stat.MaxManaPercent:              {bits: 6, add: 10},
+	stat.AttackerTakesDamage:         {bits: 7},
+	stat.GoldFind:                    {bits: 9, add: 100},
+	stat.MagicFind:                   {bits: 8, add: 100},
+	stat.Knockback:                   {bits: 7},
+	stat.AddClassSkills:              {bits: 3, paramBits: 3},
+	stat.AddExperience:               {bits: 9, add: 50},
+	stat.LifeAfterEachKill:           {bits: 7},
+	stat.ReducePrices:                {bits: 7},
+	stat.LightRadius:                 {bits: 4, add: 4},
+	stat.LightColor:                  {bits: 24},
+	stat.Requirements:                {bits: 8, add: 100},
+	stat.IncreasedAttackSpeed:        {bits: 7, add: 20},
+	stat.FasterRunWalk:               {bits: 7, add: 20},
+	stat.NonClassSkill:               {bits: 6, paramBits: 9},
+	stat.State:                       {bits: 1, paramBits: 8},
+	stat.FasterHitRecovery:           {bits: 7, add: 20},
+	stat.FasterBlockRate:             {bits: 7, add: 20},
+	stat.FasterCastRate:              {bits: 7, add: 20},
+	stat.SingleSkill:                 {bits: 3, paramBits: 9},
+	stat.SlainMonstersRestInPeace:    {bits: 1},
+	stat.CurseResistance:             {bits: 9},
+	stat.PoisonLengthReduced:         {bits: 8, add: 20},
+	stat.NormalDamage:                {bits: 9, add: 20},
+	stat.HitCausesMonsterToFlee:      {bits: 7, add: -1},
+	stat.HitBlindsTarget:             {bits: 7},
+	stat.DamageTakenGoesToMana:       {bits: 6},
+	stat.IgnoreTargetsDefense:        {bits: 1},
+	stat.TargetDefense:               {bits: 7},
+	stat.PreventMonsterHeal:          {bits: 7},
+	stat.HalfFreezeDuration:          {bits: 1},
+	stat.AttackRatingPercent:         {bits: 9, add: 20},
+	stat.MonsterDefensePerHit:        {bits: 7, add: 128},
+	stat.DemonDamagePercent:          {bits: 9, add: 20},
+	stat.UndeadDamagePercent:         {bits: 9, add: 20},
+	stat.DemonAttackRating:           {bits: 10, add: 128},
+	stat.UndeadAttackRating:          {bits: 10, add: 128},
+	stat.Throwable:                   {bits: 1},
+	stat.FireSkills:                  {bits: 3, paramBits: 3},
+	stat.AllSkills:                   {bits: 3},
+	stat.AttackerTakesLightDamage:    {bits: 5},
+	stat.FreezesTarget:               {bits: 5},
+	stat.OpenWounds:                  {bits: 7},
+	stat.CrushingBlow:                {bits: 7},
+	stat.KickDamage:                  {bits: 7},
+	stat.ManaAfterKill:               {bits: 7},
+	stat.HealAfterDemonKill:          {bits: 7},
+	stat.ExtraBlood:                  {bits: 7},
+	stat.DeadlyStrike:                {bits: 7},
+	stat.AbsorbFirePercent:           {bits: 7},
+	stat.AbsorbFire:                  {bits: 7},
+	stat.AbsorbLightningPercent:      {bits: 7},
+	stat.AbsorbLightning:             {bits: 7},
+	stat.AbsorbMagicPercent:          {bits: 7},
+	stat.AbsorbMagic:                 {bits: 7},
+	stat.AbsorbColdPercent:           {bits: 7},
+	stat.AbsorbCold:                  {bits: 7},
+	stat.SlowsTarget:                 {bits: 7},
+	stat.Aura:                        {bits: 5, paramBits: 9},
+	stat.Indestructible:              {bits: 1},
+	stat.CannotBeFrozen:              {bits: 1},
+	stat.SlowerStaminaDrain:          {bits: 7, add: 20},
+	stat.Reanimate:                   {bits: 7, paramBits: 10},
+	stat.Pierce:                      {bits: 7},
+	stat.MagicArrow:                  {bits: 7},
+	stat.ExplosiveArrow:              {bits: 7},
+	stat.ThrowMinDamage:              {bits: 6},
+	stat.ThrowMaxDamage:              {bits: 7},
+	stat.AddSkillTab:                 {bits: 3, paramBits: 16},
+	stat.NumSockets:                  {bits: 4},
+	stat.SkillOnAttack:               {bits: 7, paramBits: 16},
+	stat.SkillOnKill:                 {bits: 7, paramBits: 16},
+	stat.SkillOnDeath:                {bits: 7, paramBits: 16},
+	stat.SkillOnHit:                  {bits: 7, paramBits: 16},
+	stat.SkillOnLevelUp:              {bits: 7, paramBits: 16},
+	stat.SkillOnGetHit:               {bits: 7, paramBits: 16},
+	stat.ItemChargedSkill:            {bits: 16, paramBits: 16},
+	stat.DefensePerLevel:             {bits: 6},
+	stat.ArmorPercentPerLevel:        {bits: 6},
+	stat.LifePerLevel:                {bits: 6},
+	stat.ManaPerLevel:                {bits: 6},
+	stat.MaxDamagePerLevel:           {bits: 6},
+	stat.MaxDamagePercentPerLevel:    {bits: 6},
+	stat.StrengthPerLevel:            {bits: 6},
+	stat.DexterityPerLevel:           {bits: 6},
+	stat.EnergyPerLevel:              {bits: 6},
+	stat.VitalityPerLevel:            {bits: 6},
+	stat.AttackRatingPerLevel:        {bits: 6},
+	stat.AttackRatingPercentPerLevel: {bits: 6},
+	stat.ColdDamageMaxPerLevel:       {bits: 6},
+	stat.FireDamageMaxPerLevel:       {bits: 6},
+	stat.LightningDamageMaxPerLevel:  {bits: 6},
+	stat.PoisonDamageMaxPerLevel:     {bits: 6},
+	stat.ResistColdPerLevel:          {bits: 6},
+	stat.ResistFirePerLevel:          {bits: 6},
+	stat.ResistLightningPerLevel:     {bits: 6},
+	stat.ResistPoisonPerLevel:        {bits: 6},
+	stat.AbsorbColdPerLevel:          {bits: 6},
+	stat.AbsorbFirePerLevel:          {bits: 6},
+	stat.AbsorbLightningPerLevel:     {bits: 6},
+	stat.AbsorbPoisonPerLevel:        {bits: 6},
+	stat.ThornsPerLevel:              {bits: 5},
+	stat.ExtraGoldPerLevel:           {bits: 6},
+	stat.MagicFindPerLevel:           {bits: 6},
+	stat.RegenStaminaPerLevel:        {bits: 6},
+	stat.StaminaPerLevel:             {bits: 6},
+	stat.DamageDemonPerLevel:         {bits: 6},
+	stat.DamageUndeadPerLevel:        {bits: 6},
+	stat.AttackRatingDemonPerLevel:   {bits: 6},
+	stat.AttackRatingUndeadPerLevel:  {bits: 6},
+	stat.CrushingBlowPerLevel:        {bits: 6},
+	stat.OpenWoundsPerLevel:          {bits: 6},
+	stat.KickDamagePerLevel:          {bits: 6},
+	stat.DeadlyStrikePerLevel:        {bits: 6},
+	stat.FindGemsPerLevel:            {bits: 6},
+	stat.ReplenishDurability:         {bits: 6},
+	stat.ReplenishQuantity:           {bits: 6},
+	stat.ExtraStack:                  {bits: 8},
+	stat.PierceCold:                  {bits: 8, add: 50},
+	stat.PierceFire:                  {bits: 8, add: 50},
+	stat.PierceLightning:             {bits: 8, add: 50},
+	stat.PiercePoison:                {bits: 8, add: 50},
+	stat.FireSkillDamage:             {bits: 9, add: 50},
+	stat.LightningSkillDamage:        {bits: 9, add: 50},
+	stat.ColdSkillDamage:             {bits: 9, add: 50},
+	stat.PoisonSkillDamage:           {bits: 9, add: 50},
+	stat.EnemyFireResist:             {bits: 8},
+	stat.EnemyLightningResist:        {bits: 8},
+	stat.EnemyColdResist:             {bits: 8},
+	stat.EnemyPoisonResist:           {bits: 8},
+	stat.QuestItemDifficulty:         {bits: 2},
+	stat.PassiveMagicMastery:         {bits: 9, add: 50},
+	stat.PassiveMagicPierce:          {bits: 8},
+}
+
+func init() {
+	// Stats modified by time are stored as time period (2 bits) + start value (10 bits) + end value (10 bits)
+	for id := stat.ArmorByTime; id <= stat.FindGemsByTime; id++ {
+		itemStatBits[id] = statBits{bits: 22}
+	}
+}
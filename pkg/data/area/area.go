@@ -1,10 +1,27 @@
 package area
 
+import "github.com/hectorgimenez/d2go/pkg/data/difficulty"
+
 type ID int
 
 type Area struct {
 	ID
-	Name string
+	Name           string
+	MonsterLevels  [3]int // Normal, nightmare and hell
+	HasWaypoint    bool
+	ConnectedAreas []ID // Areas linked through warps (stairs, cave entrances...), areas sharing a border are not included
+}
+
+// MonsterLevel returns the area monster level for the given difficulty, terror zones are not taken into account
+func (a Area) MonsterLevel(d difficulty.Difficulty) int {
+	switch d {
+	case difficulty.Nightmare:
+		return a.MonsterLevels[1]
+	case difficulty.Hell:
+		return a.MonsterLevels[2]
+	}
+
+	return a.MonsterLevels[0]
 }
 
 func (a ID) IsTown() bool {
@@ -3,141 +3,141 @@
 package area
 
 var Areas = map[ID]Area{
-	0:   {Name: "", ID: 0},
-	1:   {Name: "Rogue Encampment", ID: 1},
-	2:   {Name: "Blood Moor", ID: 2},
-	3:   {Name: "Cold Plains", ID: 3},
-	4:   {Name: "Stony Field", ID: 4},
-	5:   {Name: "Dark Wood", ID: 5},
-	6:   {Name: "Black Marsh", ID: 6},
-	7:   {Name: "Tamoe Highland", ID: 7},
-	8:   {Name: "Den of Evil", ID: 8},
-	9:   {Name: "Cave Level 1", ID: 9},
-	10:  {Name: "Underground Passage Level 1", ID: 10},
-	11:  {Name: "Hole Level 1", ID: 11},
-	12:  {Name: "Pit Level 1", ID: 12},
-	13:  {Name: "Cave Level 2", ID: 13},
-	14:  {Name: "Underground Passage Level 2", ID: 14},
-	15:  {Name: "Hole Level 2", ID: 15},
-	16:  {Name: "Pit Level 2", ID: 16},
-	17:  {Name: "Burial Grounds", ID: 17},
-	18:  {Name: "Crypt", ID: 18},
-	19:  {Name: "Mausoleum", ID: 19},
-	20:  {Name: "Forgotten Tower", ID: 20},
-	21:  {Name: "Tower Cellar Level 1", ID: 21},
-	22:  {Name: "Tower Cellar Level 2", ID: 22},
-	23:  {Name: "Tower Cellar Level 3", ID: 23},
-	24:  {Name: "Tower Cellar Level 4", ID: 24},
-	25:  {Name: "Tower Cellar Level 5", ID: 25},
-	26:  {Name: "Monastery Gate", ID: 26},
-	27:  {Name: "Outer Cloister", ID: 27},
-	28:  {Name: "Barracks", ID: 28},
-	29:  {Name: "Jail Level 1", ID: 29},
-	30:  {Name: "Jail Level 2", ID: 30},
-	31:  {Name: "Jail Level 3", ID: 31},
-	32:  {Name: "Inner Cloister", ID: 32},
-	33:  {Name: "Cathedral", ID: 33},
-	34:  {Name: "Catacombs Level 1", ID: 34},
-	35:  {Name: "Catacombs Level 2", ID: 35},
-	36:  {Name: "Catacombs Level 3", ID: 36},
-	37:  {Name: "Catacombs Level 4", ID: 37},
-	38:  {Name: "Tristram", ID: 38},
-	39:  {Name: "Moo Moo Farm", ID: 39},
-	40:  {Name: "Lut Gholein", ID: 40},
-	41:  {Name: "Rocky Waste", ID: 41},
-	42:  {Name: "Dry Hills", ID: 42},
-	43:  {Name: "Far Oasis", ID: 43},
-	44:  {Name: "Lost City", ID: 44},
-	45:  {Name: "Valley of Snakes", ID: 45},
-	46:  {Name: "Canyon of the Magi", ID: 46},
-	47:  {Name: "Sewers Level 1", ID: 47},
-	48:  {Name: "Sewers Level 2", ID: 48},
-	49:  {Name: "Sewers Level 3", ID: 49},
-	50:  {Name: "Harem Level 1", ID: 50},
-	51:  {Name: "Harem Level 2", ID: 51},
-	52:  {Name: "Palace Cellar Level 1", ID: 52},
-	53:  {Name: "Palace Cellar Level 2", ID: 53},
-	54:  {Name: "Palace Cellar Level 3", ID: 54},
-	55:  {Name: "Stony Tomb Level 1", ID: 55},
-	56:  {Name: "Halls of the Dead Level 1", ID: 56},
-	57:  {Name: "Halls of the Dead Level 2", ID: 57},
-	58:  {Name: "Claw Viper Temple Level 1", ID: 58},
-	59:  {Name: "Stony Tomb Level 2", ID: 59},
-	60:  {Name: "Halls of the Dead Level 3", ID: 60},
-	61:  {Name: "Claw Viper Temple Level 2", ID: 61},
-	62:  {Name: "Maggot Lair Level 1", ID: 62},
-	63:  {Name: "Maggot Lair Level 2", ID: 63},
-	64:  {Name: "Maggot Lair Level 3", ID: 64},
-	65:  {Name: "Ancient Tunnels", ID: 65},
-	66:  {Name: "Tal Rasha's Tomb", ID: 66},
-	67:  {Name: "Tal Rasha's Tomb", ID: 67},
-	68:  {Name: "Tal Rasha's Tomb", ID: 68},
-	69:  {Name: "Tal Rasha's Tomb", ID: 69},
-	70:  {Name: "Tal Rasha's Tomb", ID: 70},
-	71:  {Name: "Tal Rasha's Tomb", ID: 71},
-	72:  {Name: "Tal Rasha's Tomb", ID: 72},
-	73:  {Name: "Duriel's Lair", ID: 73},
-	74:  {Name: "Arcane Sanctuary", ID: 74},
-	75:  {Name: "Kurast Docktown", ID: 75},
-	76:  {Name: "Spider Forest", ID: 76},
-	77:  {Name: "Great Marsh", ID: 77},
-	78:  {Name: "Flayer Jungle", ID: 78},
-	79:  {Name: "Lower Kurast", ID: 79},
-	80:  {Name: "Kurast Bazaar", ID: 80},
-	81:  {Name: "Upper Kurast", ID: 81},
-	82:  {Name: "Kurast Causeway", ID: 82},
-	83:  {Name: "Travincal", ID: 83},
-	84:  {Name: "Spider Cave", ID: 84},
-	85:  {Name: "Spider Cavern", ID: 85},
-	86:  {Name: "Swampy Pit Level 1", ID: 86},
-	87:  {Name: "Swampy Pit Level 2", ID: 87},
-	88:  {Name: "Flayer Dungeon Level 1", ID: 88},
-	89:  {Name: "Flayer Dungeon Level 2", ID: 89},
-	90:  {Name: "Swampy Pit Level 3", ID: 90},
-	91:  {Name: "Flayer Dungeon Level 3", ID: 91},
-	92:  {Name: "Sewers Level 1", ID: 92},
-	93:  {Name: "Sewers Level 2", ID: 93},
-	94:  {Name: "Ruined Temple", ID: 94},
-	95:  {Name: "Disused Fane", ID: 95},
-	96:  {Name: "Forgotten Reliquary", ID: 96},
-	97:  {Name: "Forgotten Temple", ID: 97},
-	98:  {Name: "Ruined Fane", ID: 98},
-	99:  {Name: "Disused Reliquary", ID: 99},
-	100: {Name: "Durance of Hate Level 1", ID: 100},
-	101: {Name: "Durance of Hate Level 2", ID: 101},
-	102: {Name: "Durance of Hate Level 3", ID: 102},
-	103: {Name: "The Pandemonium Fortress", ID: 103},
-	104: {Name: "Outer Steppes", ID: 104},
-	105: {Name: "Plains of Despair", ID: 105},
-	106: {Name: "City of the Damned", ID: 106},
-	107: {Name: "River of Flame", ID: 107},
-	108: {Name: "Chaos Sanctum", ID: 108},
-	109: {Name: "Harrogath", ID: 109},
-	110: {Name: "Bloody Foothills", ID: 110},
-	111: {Name: "Rigid Highlands", ID: 111},
-	112: {Name: "Arreat Plateau", ID: 112},
-	113: {Name: "Crystalized Cavern Level 1", ID: 113},
-	114: {Name: "Cellar of Pity", ID: 114},
-	115: {Name: "Crystalized Cavern Level 2", ID: 115},
-	116: {Name: "Echo Chamber", ID: 116},
-	117: {Name: "Tundra Wastelands", ID: 117},
-	118: {Name: "Glacial Caves Level 1", ID: 118},
-	119: {Name: "Glacial Caves Level 2", ID: 119},
-	120: {Name: "Rocky Summit", ID: 120},
-	121: {Name: "Nihlathaks Temple", ID: 121},
-	122: {Name: "Halls of Anguish", ID: 122},
-	123: {Name: "Halls of Death's Calling", ID: 123},
-	124: {Name: "Halls of Vaught", ID: 124},
-	125: {Name: "Hell1", ID: 125},
-	126: {Name: "Hell2", ID: 126},
-	127: {Name: "Hell3", ID: 127},
-	128: {Name: "The Worldstone Keep Level 1", ID: 128},
-	129: {Name: "The Worldstone Keep Level 2", ID: 129},
-	130: {Name: "The Worldstone Keep Level 3", ID: 130},
-	131: {Name: "Throne of Destruction", ID: 131},
-	132: {Name: "The Worldstone Chamber", ID: 132},
-	133: {Name: "Pandemonium Run 1", ID: 133},
-	134: {Name: "Pandemonium Run 2", ID: 134},
-	135: {Name: "Pandemonium Run 3", ID: 135},
-	136: {Name: "Tristram", ID: 136},
+	0:   {Name: "", ID: 0, MonsterLevels: [3]int{0, 0, 0}, HasWaypoint: false, ConnectedAreas: []ID{}},
+	1:   {Name: "Rogue Encampment", ID: 1, MonsterLevels: [3]int{0, 0, 0}, HasWaypoint: true, ConnectedAreas: []ID{}},
+	2:   {Name: "Blood Moor", ID: 2, MonsterLevels: [3]int{1, 36, 67}, HasWaypoint: false, ConnectedAreas: []ID{8}},
+	3:   {Name: "Cold Plains", ID: 3, MonsterLevels: [3]int{2, 36, 68}, HasWaypoint: true, ConnectedAreas: []ID{9}},
+	4:   {Name: "Stony Field", ID: 4, MonsterLevels: [3]int{4, 37, 68}, HasWaypoint: true, ConnectedAreas: []ID{10}},
+	5:   {Name: "Dark Wood", ID: 5, MonsterLevels: [3]int{5, 38, 68}, HasWaypoint: true, ConnectedAreas: []ID{10}},
+	6:   {Name: "Black Marsh", ID: 6, MonsterLevels: [3]int{6, 38, 69}, HasWaypoint: true, ConnectedAreas: []ID{20, 11}},
+	7:   {Name: "Tamoe Highland", ID: 7, MonsterLevels: [3]int{8, 39, 69}, HasWaypoint: false, ConnectedAreas: []ID{12}},
+	8:   {Name: "Den of Evil", ID: 8, MonsterLevels: [3]int{1, 36, 79}, HasWaypoint: false, ConnectedAreas: []ID{2}},
+	9:   {Name: "Cave Level 1", ID: 9, MonsterLevels: [3]int{2, 36, 77}, HasWaypoint: false, ConnectedAreas: []ID{3, 13}},
+	10:  {Name: "Underground Passage Level 1", ID: 10, MonsterLevels: [3]int{4, 37, 69}, HasWaypoint: false, ConnectedAreas: []ID{4, 5, 14}},
+	11:  {Name: "Hole Level 1", ID: 11, MonsterLevels: [3]int{5, 38, 80}, HasWaypoint: false, ConnectedAreas: []ID{6, 15}},
+	12:  {Name: "Pit Level 1", ID: 12, MonsterLevels: [3]int{7, 39, 85}, HasWaypoint: false, ConnectedAreas: []ID{7, 16}},
+	13:  {Name: "Cave Level 2", ID: 13, MonsterLevels: [3]int{2, 37, 78}, HasWaypoint: false, ConnectedAreas: []ID{9}},
+	14:  {Name: "Underground Passage Level 2", ID: 14, MonsterLevels: [3]int{4, 38, 85}, HasWaypoint: false, ConnectedAreas: []ID{10}},
+	15:  {Name: "Hole Level 2", ID: 15, MonsterLevels: [3]int{5, 39, 81}, HasWaypoint: false, ConnectedAreas: []ID{11}},
+	16:  {Name: "Pit Level 2", ID: 16, MonsterLevels: [3]int{7, 40, 85}, HasWaypoint: false, ConnectedAreas: []ID{12}},
+	17:  {Name: "Burial Grounds", ID: 17, MonsterLevels: [3]int{3, 36, 80}, HasWaypoint: false, ConnectedAreas: []ID{18, 19}},
+	18:  {Name: "Crypt", ID: 18, MonsterLevels: [3]int{3, 37, 83}, HasWaypoint: false, ConnectedAreas: []ID{17}},
+	19:  {Name: "Mausoleum", ID: 19, MonsterLevels: [3]int{3, 37, 85}, HasWaypoint: false, ConnectedAreas: []ID{17}},
+	20:  {Name: "Forgotten Tower", ID: 20, MonsterLevels: [3]int{0, 0, 0}, HasWaypoint: false, ConnectedAreas: []ID{6, 21}},
+	21:  {Name: "Tower Cellar Level 1", ID: 21, MonsterLevels: [3]int{7, 38, 75}, HasWaypoint: false, ConnectedAreas: []ID{20, 22}},
+	22:  {Name: "Tower Cellar Level 2", ID: 22, MonsterLevels: [3]int{7, 39, 76}, HasWaypoint: false, ConnectedAreas: []ID{21, 23}},
+	23:  {Name: "Tower Cellar Level 3", ID: 23, MonsterLevels: [3]int{7, 40, 77}, HasWaypoint: false, ConnectedAreas: []ID{22, 24}},
+	24:  {Name: "Tower Cellar Level 4", ID: 24, MonsterLevels: [3]int{7, 41, 78}, HasWaypoint: false, ConnectedAreas: []ID{23, 25}},
+	25:  {Name: "Tower Cellar Level 5", ID: 25, MonsterLevels: [3]int{7, 42, 79}, HasWaypoint: false, ConnectedAreas: []ID{24}},
+	26:  {Name: "Monastery Gate", ID: 26, MonsterLevels: [3]int{8, 40, 70}, HasWaypoint: false, ConnectedAreas: []ID{27}},
+	27:  {Name: "Outer Cloister", ID: 27, MonsterLevels: [3]int{9, 40, 70}, HasWaypoint: true, ConnectedAreas: []ID{26, 28}},
+	28:  {Name: "Barracks", ID: 28, MonsterLevels: [3]int{9, 40, 70}, HasWaypoint: false, ConnectedAreas: []ID{27, 29}},
+	29:  {Name: "Jail Level 1", ID: 29, MonsterLevels: [3]int{10, 41, 71}, HasWaypoint: true, ConnectedAreas: []ID{28, 30}},
+	30:  {Name: "Jail Level 2", ID: 30, MonsterLevels: [3]int{10, 41, 71}, HasWaypoint: false, ConnectedAreas: []ID{29, 31}},
+	31:  {Name: "Jail Level 3", ID: 31, MonsterLevels: [3]int{10, 41, 71}, HasWaypoint: false, ConnectedAreas: []ID{30, 32}},
+	32:  {Name: "Inner Cloister", ID: 32, MonsterLevels: [3]int{10, 41, 72}, HasWaypoint: true, ConnectedAreas: []ID{31, 33}},
+	33:  {Name: "Cathedral", ID: 33, MonsterLevels: [3]int{11, 42, 72}, HasWaypoint: false, ConnectedAreas: []ID{32, 34}},
+	34:  {Name: "Catacombs Level 1", ID: 34, MonsterLevels: [3]int{11, 42, 72}, HasWaypoint: false, ConnectedAreas: []ID{33, 35}},
+	35:  {Name: "Catacombs Level 2", ID: 35, MonsterLevels: [3]int{11, 42, 73}, HasWaypoint: true, ConnectedAreas: []ID{34, 36}},
+	36:  {Name: "Catacombs Level 3", ID: 36, MonsterLevels: [3]int{12, 43, 73}, HasWaypoint: false, ConnectedAreas: []ID{35, 37}},
+	37:  {Name: "Catacombs Level 4", ID: 37, MonsterLevels: [3]int{12, 43, 73}, HasWaypoint: false, ConnectedAreas: []ID{36}},
+	38:  {Name: "Tristram", ID: 38, MonsterLevels: [3]int{6, 39, 76}, HasWaypoint: false, ConnectedAreas: []ID{}},
+	39:  {Name: "Moo Moo Farm", ID: 39, MonsterLevels: [3]int{28, 64, 81}, HasWaypoint: false, ConnectedAreas: []ID{}},
+	40:  {Name: "Lut Gholein", ID: 40, MonsterLevels: [3]int{0, 0, 0}, HasWaypoint: true, ConnectedAreas: []ID{47, 50}},
+	41:  {Name: "Rocky Waste", ID: 41, MonsterLevels: [3]int{14, 43, 75}, HasWaypoint: false, ConnectedAreas: []ID{55}},
+	42:  {Name: "Dry Hills", ID: 42, MonsterLevels: [3]int{15, 44, 76}, HasWaypoint: true, ConnectedAreas: []ID{56}},
+	43:  {Name: "Far Oasis", ID: 43, MonsterLevels: [3]int{16, 45, 76}, HasWaypoint: true, ConnectedAreas: []ID{62}},
+	44:  {Name: "Lost City", ID: 44, MonsterLevels: [3]int{17, 46, 77}, HasWaypoint: true, ConnectedAreas: []ID{65}},
+	45:  {Name: "Valley of Snakes", ID: 45, MonsterLevels: [3]int{18, 46, 77}, HasWaypoint: false, ConnectedAreas: []ID{58}},
+	46:  {Name: "Canyon of the Magi", ID: 46, MonsterLevels: [3]int{16, 48, 79}, HasWaypoint: true, ConnectedAreas: []ID{66, 67, 68, 69, 70, 71, 72}},
+	47:  {Name: "Sewers Level 1", ID: 47, MonsterLevels: [3]int{13, 43, 74}, HasWaypoint: false, ConnectedAreas: []ID{40, 48}},
+	48:  {Name: "Sewers Level 2", ID: 48, MonsterLevels: [3]int{13, 43, 74}, HasWaypoint: true, ConnectedAreas: []ID{47, 49}},
+	49:  {Name: "Sewers Level 3", ID: 49, MonsterLevels: [3]int{14, 44, 75}, HasWaypoint: false, ConnectedAreas: []ID{48}},
+	50:  {Name: "Harem Level 1", ID: 50, MonsterLevels: [3]int{0, 0, 0}, HasWaypoint: false, ConnectedAreas: []ID{40, 51}},
+	51:  {Name: "Harem Level 2", ID: 51, MonsterLevels: [3]int{13, 47, 78}, HasWaypoint: false, ConnectedAreas: []ID{50, 52}},
+	52:  {Name: "Palace Cellar Level 1", ID: 52, MonsterLevels: [3]int{13, 47, 78}, HasWaypoint: true, ConnectedAreas: []ID{51, 53}},
+	53:  {Name: "Palace Cellar Level 2", ID: 53, MonsterLevels: [3]int{13, 47, 78}, HasWaypoint: false, ConnectedAreas: []ID{52, 54}},
+	54:  {Name: "Palace Cellar Level 3", ID: 54, MonsterLevels: [3]int{13, 48, 78}, HasWaypoint: false, ConnectedAreas: []ID{53}},
+	55:  {Name: "Stony Tomb Level 1", ID: 55, MonsterLevels: [3]int{12, 44, 85}, HasWaypoint: false, ConnectedAreas: []ID{41, 59}},
+	56:  {Name: "Halls of the Dead Level 1", ID: 56, MonsterLevels: [3]int{12, 44, 79}, HasWaypoint: false, ConnectedAreas: []ID{42, 57}},
+	57:  {Name: "Halls of the Dead Level 2", ID: 57, MonsterLevels: [3]int{13, 45, 81}, HasWaypoint: true, ConnectedAreas: []ID{56, 60}},
+	58:  {Name: "Claw Viper Temple Level 1", ID: 58, MonsterLevels: [3]int{14, 47, 82}, HasWaypoint: false, ConnectedAreas: []ID{45, 61}},
+	59:  {Name: "Stony Tomb Level 2", ID: 59, MonsterLevels: [3]int{12, 44, 85}, HasWaypoint: false, ConnectedAreas: []ID{55}},
+	60:  {Name: "Halls of the Dead Level 3", ID: 60, MonsterLevels: [3]int{13, 45, 82}, HasWaypoint: false, ConnectedAreas: []ID{57}},
+	61:  {Name: "Claw Viper Temple Level 2", ID: 61, MonsterLevels: [3]int{14, 47, 83}, HasWaypoint: false, ConnectedAreas: []ID{58}},
+	62:  {Name: "Maggot Lair Level 1", ID: 62, MonsterLevels: [3]int{17, 45, 84}, HasWaypoint: false, ConnectedAreas: []ID{43, 63}},
+	63:  {Name: "Maggot Lair Level 2", ID: 63, MonsterLevels: [3]int{17, 45, 84}, HasWaypoint: false, ConnectedAreas: []ID{62, 64}},
+	64:  {Name: "Maggot Lair Level 3", ID: 64, MonsterLevels: [3]int{17, 46, 85}, HasWaypoint: false, ConnectedAreas: []ID{63}},
+	65:  {Name: "Ancient Tunnels", ID: 65, MonsterLevels: [3]int{17, 46, 85}, HasWaypoint: false, ConnectedAreas: []ID{44}},
+	66:  {Name: "Tal Rasha's Tomb", ID: 66, MonsterLevels: [3]int{17, 49, 80}, HasWaypoint: false, ConnectedAreas: []ID{46}},
+	67:  {Name: "Tal Rasha's Tomb", ID: 67, MonsterLevels: [3]int{17, 49, 80}, HasWaypoint: false, ConnectedAreas: []ID{46}},
+	68:  {Name: "Tal Rasha's Tomb", ID: 68, MonsterLevels: [3]int{17, 49, 80}, HasWaypoint: false, ConnectedAreas: []ID{46}},
+	69:  {Name: "Tal Rasha's Tomb", ID: 69, MonsterLevels: [3]int{17, 49, 80}, HasWaypoint: false, ConnectedAreas: []ID{46}},
+	70:  {Name: "Tal Rasha's Tomb", ID: 70, MonsterLevels: [3]int{17, 49, 80}, HasWaypoint: false, ConnectedAreas: []ID{46}},
+	71:  {Name: "Tal Rasha's Tomb", ID: 71, MonsterLevels: [3]int{17, 49, 80}, HasWaypoint: false, ConnectedAreas: []ID{46}},
+	72:  {Name: "Tal Rasha's Tomb", ID: 72, MonsterLevels: [3]int{17, 49, 80}, HasWaypoint: false, ConnectedAreas: []ID{46}},
+	73:  {Name: "Duriel's Lair", ID: 73, MonsterLevels: [3]int{17, 49, 80}, HasWaypoint: false, ConnectedAreas: []ID{}},
+	74:  {Name: "Arcane Sanctuary", ID: 74, MonsterLevels: [3]int{14, 48, 79}, HasWaypoint: true, ConnectedAreas: []ID{}},
+	75:  {Name: "Kurast Docktown", ID: 75, MonsterLevels: [3]int{0, 0, 0}, HasWaypoint: true, ConnectedAreas: []ID{}},
+	76:  {Name: "Spider Forest", ID: 76, MonsterLevels: [3]int{21, 49, 79}, HasWaypoint: true, ConnectedAreas: []ID{84, 85}},
+	77:  {Name: "Great Marsh", ID: 77, MonsterLevels: [3]int{21, 50, 80}, HasWaypoint: true, ConnectedAreas: []ID{}},
+	78:  {Name: "Flayer Jungle", ID: 78, MonsterLevels: [3]int{22, 50, 80}, HasWaypoint: true, ConnectedAreas: []ID{86, 88}},
+	79:  {Name: "Lower Kurast", ID: 79, MonsterLevels: [3]int{22, 52, 80}, HasWaypoint: true, ConnectedAreas: []ID{}},
+	80:  {Name: "Kurast Bazaar", ID: 80, MonsterLevels: [3]int{22, 52, 81}, HasWaypoint: true, ConnectedAreas: []ID{92, 94, 95}},
+	81:  {Name: "Upper Kurast", ID: 81, MonsterLevels: [3]int{23, 52, 81}, HasWaypoint: true, ConnectedAreas: []ID{92, 96, 97}},
+	82:  {Name: "Kurast Causeway", ID: 82, MonsterLevels: [3]int{24, 53, 81}, HasWaypoint: false, ConnectedAreas: []ID{98, 99}},
+	83:  {Name: "Travincal", ID: 83, MonsterLevels: [3]int{24, 54, 82}, HasWaypoint: true, ConnectedAreas: []ID{100}},
+	84:  {Name: "Spider Cave", ID: 84, MonsterLevels: [3]int{21, 50, 85}, HasWaypoint: false, ConnectedAreas: []ID{76}},
+	85:  {Name: "Spider Cavern", ID: 85, MonsterLevels: [3]int{21, 50, 79}, HasWaypoint: false, ConnectedAreas: []ID{76}},
+	86:  {Name: "Swampy Pit Level 1", ID: 86, MonsterLevels: [3]int{21, 51, 85}, HasWaypoint: false, ConnectedAreas: []ID{87, 78}},
+	87:  {Name: "Swampy Pit Level 2", ID: 87, MonsterLevels: [3]int{21, 51, 85}, HasWaypoint: false, ConnectedAreas: []ID{90, 86}},
+	88:  {Name: "Flayer Dungeon Level 1", ID: 88, MonsterLevels: [3]int{22, 51, 81}, HasWaypoint: false, ConnectedAreas: []ID{89, 78}},
+	89:  {Name: "Flayer Dungeon Level 2", ID: 89, MonsterLevels: [3]int{22, 51, 82}, HasWaypoint: false, ConnectedAreas: []ID{91, 88}},
+	90:  {Name: "Swampy Pit Level 3", ID: 90, MonsterLevels: [3]int{21, 51, 85}, HasWaypoint: false, ConnectedAreas: []ID{87}},
+	91:  {Name: "Flayer Dungeon Level 3", ID: 91, MonsterLevels: [3]int{22, 51, 83}, HasWaypoint: false, ConnectedAreas: []ID{89}},
+	92:  {Name: "Sewers Level 1", ID: 92, MonsterLevels: [3]int{23, 52, 85}, HasWaypoint: false, ConnectedAreas: []ID{80, 81, 93}},
+	93:  {Name: "Sewers Level 2", ID: 93, MonsterLevels: [3]int{24, 53, 85}, HasWaypoint: false, ConnectedAreas: []ID{92}},
+	94:  {Name: "Ruined Temple", ID: 94, MonsterLevels: [3]int{23, 53, 85}, HasWaypoint: false, ConnectedAreas: []ID{80}},
+	95:  {Name: "Disused Fane", ID: 95, MonsterLevels: [3]int{23, 53, 85}, HasWaypoint: false, ConnectedAreas: []ID{80}},
+	96:  {Name: "Forgotten Reliquary", ID: 96, MonsterLevels: [3]int{23, 53, 85}, HasWaypoint: false, ConnectedAreas: []ID{81}},
+	97:  {Name: "Forgotten Temple", ID: 97, MonsterLevels: [3]int{24, 54, 85}, HasWaypoint: false, ConnectedAreas: []ID{81}},
+	98:  {Name: "Ruined Fane", ID: 98, MonsterLevels: [3]int{24, 54, 85}, HasWaypoint: false, ConnectedAreas: []ID{82}},
+	99:  {Name: "Disused Reliquary", ID: 99, MonsterLevels: [3]int{24, 54, 85}, HasWaypoint: false, ConnectedAreas: []ID{82}},
+	100: {Name: "Durance of Hate Level 1", ID: 100, MonsterLevels: [3]int{25, 55, 83}, HasWaypoint: false, ConnectedAreas: []ID{101, 83}},
+	101: {Name: "Durance of Hate Level 2", ID: 101, MonsterLevels: [3]int{25, 55, 83}, HasWaypoint: true, ConnectedAreas: []ID{102, 100}},
+	102: {Name: "Durance of Hate Level 3", ID: 102, MonsterLevels: [3]int{25, 55, 83}, HasWaypoint: false, ConnectedAreas: []ID{101}},
+	103: {Name: "The Pandemonium Fortress", ID: 103, MonsterLevels: [3]int{0, 0, 0}, HasWaypoint: true, ConnectedAreas: []ID{}},
+	104: {Name: "Outer Steppes", ID: 104, MonsterLevels: [3]int{26, 56, 82}, HasWaypoint: false, ConnectedAreas: []ID{}},
+	105: {Name: "Plains of Despair", ID: 105, MonsterLevels: [3]int{26, 56, 83}, HasWaypoint: false, ConnectedAreas: []ID{}},
+	106: {Name: "City of the Damned", ID: 106, MonsterLevels: [3]int{27, 57, 84}, HasWaypoint: true, ConnectedAreas: []ID{107}},
+	107: {Name: "River of Flame", ID: 107, MonsterLevels: [3]int{27, 57, 85}, HasWaypoint: true, ConnectedAreas: []ID{106, 108}},
+	108: {Name: "Chaos Sanctum", ID: 108, MonsterLevels: [3]int{28, 58, 85}, HasWaypoint: false, ConnectedAreas: []ID{107}},
+	109: {Name: "Harrogath", ID: 109, MonsterLevels: [3]int{0, 0, 0}, HasWaypoint: true, ConnectedAreas: []ID{}},
+	110: {Name: "Bloody Foothills", ID: 110, MonsterLevels: [3]int{24, 58, 80}, HasWaypoint: false, ConnectedAreas: []ID{}},
+	111: {Name: "Rigid Highlands", ID: 111, MonsterLevels: [3]int{25, 59, 81}, HasWaypoint: true, ConnectedAreas: []ID{}},
+	112: {Name: "Arreat Plateau", ID: 112, MonsterLevels: [3]int{26, 60, 81}, HasWaypoint: true, ConnectedAreas: []ID{113}},
+	113: {Name: "Crystalized Cavern Level 1", ID: 113, MonsterLevels: [3]int{29, 61, 82}, HasWaypoint: true, ConnectedAreas: []ID{112, 115, 114}},
+	114: {Name: "Cellar of Pity", ID: 114, MonsterLevels: [3]int{29, 61, 83}, HasWaypoint: false, ConnectedAreas: []ID{113}},
+	115: {Name: "Crystalized Cavern Level 2", ID: 115, MonsterLevels: [3]int{29, 61, 83}, HasWaypoint: true, ConnectedAreas: []ID{113, 117, 116}},
+	116: {Name: "Echo Chamber", ID: 116, MonsterLevels: [3]int{29, 61, 85}, HasWaypoint: false, ConnectedAreas: []ID{115}},
+	117: {Name: "Tundra Wastelands", ID: 117, MonsterLevels: [3]int{27, 60, 81}, HasWaypoint: true, ConnectedAreas: []ID{115, 118}},
+	118: {Name: "Glacial Caves Level 1", ID: 118, MonsterLevels: [3]int{29, 62, 82}, HasWaypoint: true, ConnectedAreas: []ID{117, 120, 119}},
+	119: {Name: "Glacial Caves Level 2", ID: 119, MonsterLevels: [3]int{29, 62, 85}, HasWaypoint: false, ConnectedAreas: []ID{118}},
+	120: {Name: "Rocky Summit", ID: 120, MonsterLevels: [3]int{37, 68, 87}, HasWaypoint: false, ConnectedAreas: []ID{118, 128}},
+	121: {Name: "Nihlathaks Temple", ID: 121, MonsterLevels: [3]int{32, 63, 83}, HasWaypoint: false, ConnectedAreas: []ID{122}},
+	122: {Name: "Halls of Anguish", ID: 122, MonsterLevels: [3]int{33, 63, 83}, HasWaypoint: false, ConnectedAreas: []ID{121, 123}},
+	123: {Name: "Halls of Death's Calling", ID: 123, MonsterLevels: [3]int{34, 64, 84}, HasWaypoint: true, ConnectedAreas: []ID{122, 124}},
+	124: {Name: "Halls of Vaught", ID: 124, MonsterLevels: [3]int{36, 64, 84}, HasWaypoint: false, ConnectedAreas: []ID{123}},
+	125: {Name: "Hell1", ID: 125, MonsterLevels: [3]int{39, 60, 85}, HasWaypoint: false, ConnectedAreas: []ID{}},
+	126: {Name: "Hell2", ID: 126, MonsterLevels: [3]int{39, 61, 85}, HasWaypoint: false, ConnectedAreas: []ID{}},
+	127: {Name: "Hell3", ID: 127, MonsterLevels: [3]int{39, 62, 85}, HasWaypoint: false, ConnectedAreas: []ID{}},
+	128: {Name: "The Worldstone Keep Level 1", ID: 128, MonsterLevels: [3]int{39, 65, 85}, HasWaypoint: false, ConnectedAreas: []ID{120, 129}},
+	129: {Name: "The Worldstone Keep Level 2", ID: 129, MonsterLevels: [3]int{40, 65, 85}, HasWaypoint: true, ConnectedAreas: []ID{128, 130}},
+	130: {Name: "The Worldstone Keep Level 3", ID: 130, MonsterLevels: [3]int{42, 66, 85}, HasWaypoint: false, ConnectedAreas: []ID{129, 131}},
+	131: {Name: "Throne of Destruction", ID: 131, MonsterLevels: [3]int{43, 66, 85}, HasWaypoint: false, ConnectedAreas: []ID{130, 132}},
+	132: {Name: "The Worldstone Chamber", ID: 132, MonsterLevels: [3]int{43, 66, 85}, HasWaypoint: false, ConnectedAreas: []ID{131}},
+	133: {Name: "Pandemonium Run 1", ID: 133, MonsterLevels: [3]int{50, 75, 83}, HasWaypoint: false, ConnectedAreas: []ID{17}},
+	134: {Name: "Pandemonium Run 2", ID: 134, MonsterLevels: [3]int{50, 75, 83}, HasWaypoint: false, ConnectedAreas: []ID{}},
+	135: {Name: "Pandemonium Run 3", ID: 135, MonsterLevels: [3]int{50, 75, 83}, HasWaypoint: false, ConnectedAreas: []ID{}},
+	136: {Name: "Tristram", ID: 136, MonsterLevels: [3]int{50, 75, 83}, HasWaypoint: false, ConnectedAreas: []ID{}},
 }
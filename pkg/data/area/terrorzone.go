@@ -5,7 +5,9 @@ var CanBeTerrorized = map[ID]bool{
 	DenOfEvil:                true,
 	ColdPlains:               true,
 	CaveLevel1:               true,
+	CaveLevel2:               true,
 	PitLevel1:                true,
+	PitLevel2:                true,
 	BurialGrounds:            true,
 	Crypt:                    true,
 	Mausoleum:                true,
@@ -14,9 +16,15 @@ var CanBeTerrorized = map[ID]bool{
 	UndergroundPassageLevel1: true,
 	UndergroundPassageLevel2: true,
 	BlackMarsh:               true,
+	TamoeHighland:            true,
 	HoleLevel1:               true,
 	HoleLevel2:               true,
 	ForgottenTower:           true,
+	TowerCellarLevel1:        true,
+	TowerCellarLevel2:        true,
+	TowerCellarLevel3:        true,
+	TowerCellarLevel4:        true,
+	TowerCellarLevel5:        true,
 	Barracks:                 true,
 	JailLevel1:               true,
 	JailLevel2:               true,
@@ -30,6 +38,8 @@ var CanBeTerrorized = map[ID]bool{
 	Tristram:                 true,
 	MooMooFarm:               true,
 	RockyWaste:               true,
+	StonyTombLevel1:          true,
+	StonyTombLevel2:          true,
 	SewersLevel1Act2:         true,
 	SewersLevel2Act2:         true,
 	SewersLevel3Act2:         true,
@@ -82,8 +92,108 @@ var CanBeTerrorized = map[ID]bool{
 	TheAncientsWay:           true,
 	IcyCellar:                true,
 	NihlathaksTemple:         true,
+	HallsOfAnguish:           true,
+	HallsOfPain:              true,
+	HallsOfVaught:            true,
 	TheWorldStoneKeepLevel1:  true,
 	TheWorldStoneKeepLevel2:  true,
 	TheWorldStoneKeepLevel3:  true,
 	ThroneOfDestruction:      true,
+	TheWorldstoneChamber:     true,
+}
+
+// TerrorZone is a group of areas terrorized together, Name matches the in game announcement
+type TerrorZone struct {
+	Name  string
+	Areas []ID
+}
+
+// Contains returns true if the given area is part of the terror zone
+func (tz TerrorZone) Contains(a ID) bool {
+	for _, za := range tz.Areas {
+		if za == a {
+			return true
+		}
+	}
+
+	return false
+}
+
+// TerrorZones are all the area groups that can be terrorized, every area in CanBeTerrorized belongs to one of them
+var TerrorZones = []TerrorZone{
+	// Act 1
+	{Name: "Blood Moor and Den of Evil", Areas: []ID{BloodMoor, DenOfEvil}},
+	{Name: "Cold Plains and The Cave", Areas: []ID{ColdPlains, CaveLevel1, CaveLevel2}},
+	{Name: "Burial Grounds, The Crypt and The Mausoleum", Areas: []ID{BurialGrounds, Crypt, Mausoleum}},
+	{Name: "Stony Field", Areas: []ID{StonyField}},
+	{Name: "Dark Wood and Underground Passage", Areas: []ID{DarkWood, UndergroundPassageLevel1, UndergroundPassageLevel2}},
+	{Name: "Black Marsh and The Hole", Areas: []ID{BlackMarsh, HoleLevel1, HoleLevel2}},
+	{Name: "The Forgotten Tower", Areas: []ID{ForgottenTower, TowerCellarLevel1, TowerCellarLevel2, TowerCellarLevel3, TowerCellarLevel4, TowerCellarLevel5}},
+	{Name: "Tamoe Highland and The Pit", Areas: []ID{TamoeHighland, PitLevel1, PitLevel2}},
+	{Name: "Jail and Barracks", Areas: []ID{Barracks, JailLevel1, JailLevel2, JailLevel3}},
+	{Name: "Cathedral and Catacombs", Areas: []ID{InnerCloister, Cathedral, CatacombsLevel1, CatacombsLevel2, CatacombsLevel3, CatacombsLevel4}},
+	{Name: "Tristram", Areas: []ID{Tristram}},
+	{Name: "Moo Moo Farm", Areas: []ID{MooMooFarm}},
+	// Act 2
+	{Name: "Rocky Waste and Stony Tomb", Areas: []ID{RockyWaste, StonyTombLevel1, StonyTombLevel2}},
+	{Name: "Lut Gholein Sewers", Areas: []ID{SewersLevel1Act2, SewersLevel2Act2, SewersLevel3Act2}},
+	{Name: "Dry Hills and Halls of the Dead", Areas: []ID{DryHills, HallsOfTheDeadLevel1, HallsOfTheDeadLevel2, HallsOfTheDeadLevel3}},
+	{Name: "Far Oasis", Areas: []ID{FarOasis}},
+	{Name: "Lost City, Valley of Snakes and Claw Viper Temple", Areas: []ID{LostCity, ValleyOfSnakes, ClawViperTempleLevel1, ClawViperTempleLevel2}},
+	{Name: "Ancient Tunnels", Areas: []ID{AncientTunnels}},
+	{Name: "Tal Rasha's Tombs", Areas: []ID{TalRashasTomb1, TalRashasTomb2, TalRashasTomb3, TalRashasTomb4, TalRashasTomb5, TalRashasTomb6, TalRashasTomb7}},
+	{Name: "Arcane Sanctuary", Areas: []ID{ArcaneSanctuary}},
+	// Act 3
+	{Name: "Spider Forest and Spider Cavern", Areas: []ID{SpiderForest, SpiderCavern}},
+	{Name: "Great Marsh", Areas: []ID{GreatMarsh}},
+	{Name: "Flayer Jungle and Flayer Dungeon", Areas: []ID{FlayerJungle, FlayerDungeonLevel1, FlayerDungeonLevel2, FlayerDungeonLevel3}},
+	{Name: "Kurast Bazaar, Ruined Temple and Disused Fane", Areas: []ID{KurastBazaar, RuinedTemple, DisusedFane}},
+	{Name: "Travincal", Areas: []ID{Travincal}},
+	{Name: "Durance of Hate", Areas: []ID{DuranceOfHateLevel1, DuranceOfHateLevel2, DuranceOfHateLevel3}},
+	// Act 4
+	{Name: "Outer Steppes and Plains of Despair", Areas: []ID{OuterSteppes, PlainsOfDespair}},
+	{Name: "City of the Damned and River of Flame", Areas: []ID{CityOfTheDamned, RiverOfFlame}},
+	{Name: "Chaos Sanctuary", Areas: []ID{ChaosSanctuary}},
+	// Act 5
+	{Name: "Bloody Foothills, Frigid Highlands and Abaddon", Areas: []ID{BloodyFoothills, FrigidHighlands, Abaddon}},
+	{Name: "Glacial Trail and Drifter Cavern", Areas: []ID{GlacialTrail, DrifterCavern}},
+	{Name: "Crystalline Passage and Frozen River", Areas: []ID{CrystallinePassage, FrozenRiver}},
+	{Name: "Arreat Plateau and Pit of Acheron", Areas: []ID{ArreatPlateau, PitOfAcheron}},
+	{Name: "Ancients' Way and Icy Cellar", Areas: []ID{TheAncientsWay, IcyCellar}},
+	{Name: "Nihlathak's Temple and Temple Halls", Areas: []ID{NihlathaksTemple, HallsOfAnguish, HallsOfPain, HallsOfVaught}},
+	{Name: "Worldstone Keep, Throne of Destruction and Worldstone Chamber", Areas: []ID{TheWorldStoneKeepLevel1, TheWorldStoneKeepLevel2, TheWorldStoneKeepLevel3, ThroneOfDestruction, TheWorldstoneChamber}},
+}
+
+// TerrorZoneOf returns the terror zone the given area belongs to, false if the area can't be terrorized
+func TerrorZoneOf(a ID) (TerrorZone, bool) {
+	for _, tz := range TerrorZones {
+		if tz.Contains(a) {
+			return tz, true
+		}
+	}
+
+	return TerrorZone{}, false
+}
+
+// GroupTerrorZones returns the terror zones of the given terrorized areas, in the same order and without duplicates
+func GroupTerrorZones(areas []ID) []TerrorZone {
+	zones := make([]TerrorZone, 0, 1)
+	for _, a := range areas {
+		tz, found := TerrorZoneOf(a)
+		if !found {
+			continue
+		}
+		duplicated := false
+		for _, z := range zones {
+			if z.Name == tz.Name {
+				duplicated = true
+				break
+			}
+		}
+		if !duplicated {
+			zones = append(zones, tz)
+		}
+	}
+
+	return zones
 }
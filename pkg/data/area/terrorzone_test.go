@@ -0,0 +1,28 @@
+package area
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTerrorZonesCoverTerrorizableAreas(t *testing.T) {
+	grouped := make(map[ID]string)
+	for _, tz := range TerrorZones {
+		for _, a := range tz.Areas {
+			require.True(t, CanBeTerrorized[a], "%s area %d can't be terrorized", tz.Name, a)
+			require.Empty(t, grouped[a], "area %d is in %s and %s", a, grouped[a], tz.Name)
+			grouped[a] = tz.Name
+		}
+	}
+	for a := range CanBeTerrorized {
+		require.NotEmpty(t, grouped[a], "area %d is not in any terror zone", a)
+	}
+}
+
+func TestGroupTerrorZones(t *testing.T) {
+	zones := GroupTerrorZones([]ID{ChaosSanctuary, CityOfTheDamned, RiverOfFlame, RogueEncampment})
+	require.Len(t, zones, 2)
+	require.Equal(t, "Chaos Sanctuary", zones[0].Name)
+	require.Equal(t, "City of the Damned and River of Flame", zones[1].Name)
+}
@@ -0,0 +1,120 @@
+package data
+
+import "github.com/hectorgimenez/d2go/pkg/data/object"
+
+type BarrierKind string
+
+const (
+	BarrierDoor      BarrierKind = "door"      // Opened by clicking it
+	BarrierBreakable BarrierKind = "breakable" // Barricades and slime doors, broken by attacking them
+	BarrierSealed    BarrierKind = "sealed"    // Quest gates, opened by the game when the quest is completed
+)
+
+type BarrierState string
+
+const (
+	BarrierClosed BarrierState = "closed"
+	BarrierOpen   BarrierState = "open"
+	BarrierBroken BarrierState = "broken"
+)
+
+// Barrier is an object blocking the way that can be removed, the collision map marks it as blocked while it's closed
+type Barrier struct {
+	Object Object
+	Kind   BarrierKind
+	State  BarrierState
+}
+
+// Barrier returns the object as a barrier, false if the object is not a door or a barricade
+func (o Object) Barrier() (Barrier, bool) {
+	var kind BarrierKind
+	switch o.Name {
+	case object.PenBreakableDoor, object.SlimeDoor1, object.SlimeDoor2:
+		kind = BarrierBreakable
+	case object.ExpansionTownGate, object.ArreatSummitDoorToWorldstone:
+		kind = BarrierSealed
+	default:
+		if !o.IsDoor() && !o.Desc().IsDoor() {
+			return Barrier{}, false
+		}
+		kind = BarrierDoor
+	}
+
+	state := BarrierClosed
+	if o.IsOpen() {
+		state = BarrierOpen
+		if kind == BarrierBreakable {
+			state = BarrierBroken
+		}
+	}
+
+	return Barrier{Object: o, Kind: kind, State: state}, true
+}
+
+// Barriers returns all the doors and barricades
+func (o Objects) Barriers() []Barrier {
+	barriers := make([]Barrier, 0)
+	for _, obj := range o {
+		if b, ok := obj.Barrier(); ok {
+			barriers = append(barriers, b)
+		}
+	}
+
+	return barriers
+}
+
+// Blocking returns true if the barrier is closed, it can't be walked through
+func (b Barrier) Blocking() bool {
+	return b.State == BarrierClosed
+}
+
+// CanBeOpened returns true if the player can remove the barrier, by opening or breaking it. Sealed gates can't
+func (b Barrier) CanBeOpened() bool {
+	return b.Blocking() && b.Kind != BarrierSealed
+}
+
+// Footprint returns the sub-tiles covered by the barrier, from objects.txt size centered on the object position
+func (b Barrier) Footprint() []Position {
+	desc := b.Object.Desc()
+	sizeX, sizeY := max(desc.SizeX, 1), max(desc.SizeY, 1)
+	origin := Position{X: b.Object.Position.X - sizeX/2, Y: b.Object.Position.Y - sizeY/2}
+
+	footprint := make([]Position, 0, sizeX*sizeY)
+	for y := 0; y < sizeY; y++ {
+		for x := 0; x < sizeX; x++ {
+			footprint = append(footprint, Position{X: origin.X + x, Y: origin.Y + y})
+		}
+	}
+
+	return footprint
+}
+
+// Covers returns true if the given position is part of the barrier footprint
+func (b Barrier) Covers(p Position) bool {
+	desc := b.Object.Desc()
+	sizeX, sizeY := max(desc.SizeX, 1), max(desc.SizeY, 1)
+	x, y := p.X-(b.Object.Position.X-sizeX/2), p.Y-(b.Object.Position.Y-sizeY/2)
+
+	return x >= 0 && y >= 0 && x < sizeX && y < sizeY
+}
+
+// WithoutBarriers returns a copy of the grid with the closed barriers that can be opened cleared, as if they were
+// open. Walls under the barrier footprint are kept, only the door and object flags are removed
+func (g CollisionGrid) WithoutBarriers(barriers []Barrier) CollisionGrid {
+	cleared := g
+	cleared.Flags = append([]Collision(nil), g.Flags...)
+	for _, b := range barriers {
+		if !b.CanBeOpened() {
+			continue
+		}
+		for _, p := range b.Footprint() {
+			x, y := p.X-g.Origin.X, p.Y-g.Origin.Y
+			if x < 0 || y < 0 || x >= g.Width || y >= g.Height || y*g.Width+x >= len(g.Flags) {
+				continue
+			}
+			cleared.Flags[y*g.Width+x] &^= CollisionDoor | CollisionObject
+		}
+	}
+
+	return cleared
+}
@@ -0,0 +1,118 @@
+package data
+
+import (
+	"github.com/hectorgimenez/d2go/pkg/data/skill"
+	"github.com/hectorgimenez/d2go/pkg/data/stat"
+	"github.com/hectorgimenez/d2go/pkg/data/state"
+)
+
+type Shapeshift string
+
+const (
+	ShapeshiftNone     Shapeshift = "None"
+	ShapeshiftWerewolf Shapeshift = "Werewolf"
+	ShapeshiftWerebear Shapeshift = "Werebear"
+)
+
+type Shouts struct {
+	Shout         bool
+	BattleOrders  bool
+	BattleCommand bool
+}
+
+type ChargeUp struct {
+	Skill   skill.ID
+	Charges int
+}
+
+// Player states set by each aura, this also includes auras granted by items or party members
+var auraStates = []struct {
+	state state.State
+	skill skill.ID
+}{
+	{state.Might, skill.Might},
+	{state.Prayer, skill.Prayer},
+	{state.Resistfire, skill.ResistFire},
+	{state.Holyfire, skill.HolyFire},
+	{state.Thorns, skill.Thorns},
+	{state.Defiance, skill.Defiance},
+	{state.Resistcold, skill.ResistCold},
+	{state.Blessedaim, skill.BlessedAim},
+	{state.Cleansing, skill.Cleansing},
+	{state.Resistlightning, skill.ResistLightning},
+	{state.Concentration, skill.Concentration},
+	{state.Holywind, skill.HolyFreeze},
+	{state.Stamina, skill.Vigor},
+	{state.Holyshock, skill.HolyShock},
+	{state.Sanctuary, skill.Sanctuary},
+	{state.Meditation, skill.Meditation},
+	{state.Fanaticism, skill.Fanaticism},
+	{state.Conviction, skill.Conviction},
+	{state.Redemption, skill.Redemption},
+	{state.Resistall, skill.Salvation},
+}
+
+// Assassin martial arts charge-ups, the amount of charges is stored in the progressive stats
+var chargeUpStates = []struct {
+	state state.State
+	skill skill.ID
+	stat  stat.ID
+}{
+	{state.Tigerstrike, skill.TigerStrike, stat.ProgressiveDamage},
+	{state.Cobrastrike, skill.CobraStrike, stat.ProgressiveSteal},
+	{state.Phoenixstrike, skill.PhoenixStrike, stat.ProgressiveOther},
+	{state.Fistsoffire, skill.FistsOfFire, stat.ProgressiveFire},
+	{state.Bladesofice, skill.BladesOfIce, stat.ProgressiveCold},
+	{state.Clawsofthunder, skill.ClawsOfThunder, stat.ProgressiveLightning},
+}
+
+// Shapeshift returns the current Druid form, any class can be shapeshifted using items granting the skill
+func (pu PlayerUnit) Shapeshift() Shapeshift {
+	switch {
+	case pu.States.HasState(state.Wolf):
+		return ShapeshiftWerewolf
+	case pu.States.HasState(state.Bear):
+		return ShapeshiftWerebear
+	}
+
+	return ShapeshiftNone
+}
+
+func (pu PlayerUnit) IsShapeshifted() bool {
+	return pu.Shapeshift() != ShapeshiftNone
+}
+
+func (pu PlayerUnit) ActiveShouts() Shouts {
+	return Shouts{
+		Shout:         pu.States.HasState(state.Shout),
+		BattleOrders:  pu.States.HasState(state.Battleorders),
+		BattleCommand: pu.States.HasState(state.Battlecommand),
+	}
+}
+
+// ActiveAuras returns the skill of every aura affecting the player, including auras from items or party members
+func (pu PlayerUnit) ActiveAuras() []skill.ID {
+	auras := make([]skill.ID, 0)
+	for _, a := range auraStates {
+		if pu.States.HasState(a.state) {
+			auras = append(auras, a.skill)
+		}
+	}
+
+	return auras
+}
+
+// ChargeUps returns the Assassin charge-up skills currently charged and their amount of charges
+func (pu PlayerUnit) ChargeUps() []ChargeUp {
+	chargeUps := make([]ChargeUp, 0)
+	for _, c := range chargeUpStates {
+		if !pu.States.HasState(c.state) {
+			continue
+		}
+
+		charges, _ := pu.FindStat(c.stat, 0)
+		chargeUps = append(chargeUps, ChargeUp{Skill: c.skill, Charges: charges.Value})
+	}
+
+	return chargeUps
+}
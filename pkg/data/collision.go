@@ -0,0 +1,107 @@
+package data
+
+// Collision are the collision flags of a sub-tile, as stored by the game in the room collision maps. Values follow the
+// COLLIDE_* flags from D2MOO (D2Collision.h)
+type Collision uint16
+
+const (
+	CollisionNone           Collision = 0x0000
+	CollisionBlockWalk      Collision = 0x0001 // Walls, cliffs, water... blocks walking units
+	CollisionBlockMissile   Collision = 0x0002 // Blocks missiles and line of sight, water doesn't have it
+	CollisionWall           Collision = 0x0004
+	CollisionBlockLeap      Collision = 0x0008
+	CollisionAlternateFloor Collision = 0x0010
+	CollisionBlank          Collision = 0x0020
+	CollisionMissile        Collision = 0x0040
+	CollisionPlayer         Collision = 0x0080
+	CollisionMonster        Collision = 0x0100
+	CollisionItem           Collision = 0x0200
+	CollisionObject         Collision = 0x0400
+	CollisionDoor           Collision = 0x0800
+	CollisionUnitRelated    Collision = 0x1000
+	CollisionPet            Collision = 0x2000
+	CollisionCorpse         Collision = 0x8000
+
+	// Flags that stop a walking unit, other units are ignored since they move
+	CollisionMaskWalk = CollisionBlockWalk | CollisionWall | CollisionObject | CollisionDoor
+	// Flags that stop a missile, it flies over water since water only blocks walking
+	CollisionMaskMissile = CollisionBlockMissile | CollisionWall | CollisionDoor
+)
+
+// CollisionGrid is the collision map of an area, one entry per sub-tile stored by rows starting from Origin.
+// Positions outside the grid are considered walls
+type CollisionGrid struct {
+	Origin Position
+	Width  int
+	Height int
+	Flags  []Collision
+}
+
+// At returns the collision flags at the given world position
+func (g CollisionGrid) At(p Position) Collision {
+	x, y := p.X-g.Origin.X, p.Y-g.Origin.Y
+	if x < 0 || y < 0 || x >= g.Width || y >= g.Height || y*g.Width+x >= len(g.Flags) {
+		return CollisionWall
+	}
+
+	return g.Flags[y*g.Width+x]
+}
+
+// IsWalkable returns true if a walking unit can stand on the given position
+func (g CollisionGrid) IsWalkable(p Position) bool {
+	return g.At(p)&CollisionMaskWalk == 0
+}
+
+// HasLineOfSight returns true if a missile can travel from one position to the other without hitting a wall, this is
+// the check to use for ranged attacks
+func (g CollisionGrid) HasLineOfSight(from, to Position) bool {
+	return g.lineIsClear(from, to, CollisionMaskMissile)
+}
+
+// HasWalkableLine returns true if a unit can walk straight from one position to the other, it's more restrictive than
+// HasLineOfSight, e.g. it's possible to shoot over a river but not to walk through it
+func (g CollisionGrid) HasWalkableLine(from, to Position) bool {
+	return g.lineIsClear(from, to, CollisionMaskWalk)
+}
+
+// lineIsClear walks the line between both positions (Bresenham), start and end positions are not checked since
+// units are standing on them
+func (g CollisionGrid) lineIsClear(from, to Position, mask Collision) bool {
+	dx, dy := abs(to.X-from.X), -abs(to.Y-from.Y)
+	sx, sy := 1, 1
+	if from.X > to.X {
+		sx = -1
+	}
+	if from.Y > to.Y {
+		sy = -1
+	}
+
+	p := from
+	err := dx + dy
+	for {
+		if p == to {
+			return true
+		}
+		if p != from && g.At(p)&mask != 0 {
+			return false
+		}
+
+		e2 := 2 * err
+		if e2 >= dy {
+			err += dy
+			p.X += sx
+		}
+		if e2 <= dx {
+			err += dx
+			p.Y += sy
+		}
+	}
+}
+
+func abs(v int) int {
+	if v < 0 {
+		return -v
+	}
+
+	return v
+}
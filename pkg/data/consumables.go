@@ -0,0 +1,88 @@
+package data
+
+import (
+	"strings"
+
+	"github.com/hectorgimenez/d2go/pkg/data/item"
+	"github.com/hectorgimenez/d2go/pkg/data/stat"
+)
+
+type PotionCount struct {
+	Belt      int
+	Inventory int
+}
+
+func (c PotionCount) Total() int {
+	return c.Belt + c.Inventory
+}
+
+// Consumables is a summary of the consumable items carried by the player, stash and cube are not included
+type Consumables struct {
+	TownPortalTome bool
+	TownPortals    int // Tome charges plus the loose scrolls in the inventory and belt
+	IdentifyTome   bool
+	Identify       int // Tome charges plus the loose scrolls in the inventory and belt
+	Keys           int
+	Potions        map[item.Name]PotionCount // Keyed by potion name, so each grade is counted separately
+}
+
+// PotionsByType returns the number of potions of the given type (all grades), e.g. HealingPotion returns the minor up
+// to super healing potions. RejuvenationPotion includes the full rejuvenation potions
+func (c Consumables) PotionsByType(potionType PotionType) PotionCount {
+	count := PotionCount{}
+	for name, pc := range c.Potions {
+		if strings.Contains(string(name), string(potionType)) {
+			count.Belt += pc.Belt
+			count.Inventory += pc.Inventory
+		}
+	}
+
+	return count
+}
+
+// Consumables returns the summary of the scrolls, keys and potions in the inventory and belt
+func (i Inventory) Consumables() Consumables {
+	c := Consumables{Potions: make(map[item.Name]PotionCount)}
+	for _, it := range i.ByLocation(item.LocationInventory) {
+		switch it.Name {
+		case item.TomeOfTownPortal:
+			c.TownPortalTome = true
+			c.TownPortals += it.quantity()
+		case item.ScrollOfTownPortal:
+			c.TownPortals++
+		case item.TomeOfIdentify:
+			c.IdentifyTome = true
+			c.Identify += it.quantity()
+		case item.ScrollOfIdentify:
+			c.Identify++
+		case item.Key:
+			c.Keys += it.quantity()
+		default:
+			if it.IsPotion() {
+				pc := c.Potions[it.Name]
+				pc.Inventory++
+				c.Potions[it.Name] = pc
+			}
+		}
+	}
+
+	for _, it := range i.Belt.Items {
+		switch {
+		case it.Name == item.ScrollOfTownPortal:
+			c.TownPortals++
+		case it.Name == item.ScrollOfIdentify:
+			c.Identify++
+		case it.IsPotion():
+			pc := c.Potions[it.Name]
+			pc.Belt++
+			c.Potions[it.Name] = pc
+		}
+	}
+
+	return c
+}
+
+func (i Item) quantity() int {
+	qty, _ := i.FindStat(stat.Quantity, 0)
+	return qty.Value
+}
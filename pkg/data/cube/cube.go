@@ -0,0 +1,151 @@
+package cube
+
+import (
+	"slices"
+
+	"github.com/hectorgimenez/d2go/pkg/data"
+	"github.com/hectorgimenez/d2go/pkg/data/item"
+)
+
+type Category int
+
+const (
+	CategoryAny Category = iota
+	CategoryWeapon
+	CategoryArmor // Any armor, including helms and shields
+	CategoryBodyArmor
+	CategoryHelm
+	CategoryShield
+)
+
+type Sockets int
+
+const (
+	SocketsAny    Sockets = iota
+	SocketsNone           // Item can not have sockets
+	SocketsFilled         // Item must have at least one socketed item
+)
+
+type Recipe struct {
+	Name   string
+	Inputs []Ingredient
+	Output item.Name // Empty when the recipe modifies one of the inputs or the result is not an item (portals)
+}
+
+// Ingredient describes a recipe input, every non empty field must be fulfilled by the item
+type Ingredient struct {
+	Names     []item.Name // Any of these items
+	Category  Category
+	Qualities []item.Quality // Any of these qualities
+	Tier      *item.Tier
+	Sockets   Sockets
+	Quantity  int
+}
+
+// Matches returns true if the item can be used as this ingredient
+func (in Ingredient) Matches(i data.Item) bool {
+	if len(in.Names) > 0 && !slices.Contains(in.Names, i.Name) {
+		return false
+	}
+	if in.Category != CategoryAny && !isCategory(i, in.Category) {
+		return false
+	}
+	if len(in.Qualities) > 0 && !slices.Contains(in.Qualities, i.Quality) {
+		return false
+	}
+	if in.Tier != nil && i.Desc().Tier() != *in.Tier {
+		return false
+	}
+
+	switch in.Sockets {
+	case SocketsNone:
+		return !i.HasSockets
+	case SocketsFilled:
+		return i.HasSocketedItems()
+	}
+
+	return true
+}
+
+// Match returns the items that would be consumed to transmute the recipe, false if the given items are not enough.
+// Items are picked in the same order they are provided
+func (r Recipe) Match(items []data.Item) ([]data.Item, bool) {
+	used := make([]bool, len(items))
+	matched := make([]data.Item, 0, len(r.Inputs))
+	for _, in := range r.Inputs {
+		found := 0
+		for idx, i := range items {
+			if found == in.Quantity {
+				break
+			}
+			if !used[idx] && in.Matches(i) {
+				used[idx] = true
+				matched = append(matched, i)
+				found++
+			}
+		}
+		if found < in.Quantity {
+			return nil, false
+		}
+	}
+
+	return matched, true
+}
+
+// MatchesExactly returns true if the given items are exactly the recipe inputs, nothing else, useful to check the cube
+// contents before pressing the transmute button
+func (r Recipe) MatchesExactly(items []data.Item) bool {
+	matched, found := r.Match(items)
+
+	return found && len(matched) == len(items)
+}
+
+// PossibleRecipes returns every recipe that can be transmuted using the given items
+func PossibleRecipes(items []data.Item) []Recipe {
+	possible := make([]Recipe, 0)
+	for _, r := range Recipes {
+		if _, found := r.Match(items); found {
+			possible = append(possible, r)
+		}
+	}
+
+	return possible
+}
+
+// FindRecipe returns the recipe matching exactly the given items, usually the cube contents
+func FindRecipe(items []data.Item) (Recipe, bool) {
+	for _, r := range Recipes {
+		if r.MatchesExactly(items) {
+			return r, true
+		}
+	}
+
+	return Recipe{}, false
+}
+
+func isCategory(i data.Item, c Category) bool {
+	desc := i.Desc()
+	switch c {
+	case CategoryWeapon:
+		if desc.MaxDefense > 0 || desc.Type == item.TypeMissilePotion {
+			return false
+		}
+		return desc.MaxDamage > 0 || desc.TwoHandMaxDamage > 0 || desc.MaxMissileDamage > 0
+	case CategoryArmor:
+		return desc.MaxDefense > 0
+	case CategoryBodyArmor:
+		return desc.Type == item.TypeArmor
+	case CategoryHelm:
+		switch desc.Type {
+		case item.TypeHelm, item.TypeCirclet, item.TypePelt, item.TypePrimalHelm:
+			return true
+		}
+	case CategoryShield:
+		switch desc.Type {
+		case item.TypeShield, item.TypeAuricShields, item.TypeVoodooHeads:
+			return true
+		}
+	}
+
+	return false
+}
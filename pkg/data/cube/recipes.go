@@ -0,0 +1,181 @@
+package cube
+
+import "github.com/hectorgimenez/d2go/pkg/data/item"
+
+var (
+	tierNormal      = item.TierNormal
+	tierExceptional = item.TierExceptional
+)
+
+// Recipes contains the standard Horadric Cube recipes, crafting recipes are not included. Some of them have extra
+// conditions not checked by the matcher: Pandemonium recipes only work in Act 5 on Hell difficulty
+var Recipes = []Recipe{
+	// Gem upgrades
+	{Name: "Upgrade Chipped Amethyst", Inputs: []Ingredient{items(3, "ChippedAmethyst")}, Output: "FlawedAmethyst"},
+	{Name: "Upgrade Flawed Amethyst", Inputs: []Ingredient{items(3, "FlawedAmethyst")}, Output: "Amethyst"},
+	{Name: "Upgrade Amethyst", Inputs: []Ingredient{items(3, "Amethyst")}, Output: "FlawlessAmethyst"},
+	{Name: "Upgrade Flawless Amethyst", Inputs: []Ingredient{items(3, "FlawlessAmethyst")}, Output: "PerfectAmethyst"},
+	{Name: "Upgrade Chipped Topaz", Inputs: []Ingredient{items(3, "ChippedTopaz")}, Output: "FlawedTopaz"},
+	{Name: "Upgrade Flawed Topaz", Inputs: []Ingredient{items(3, "FlawedTopaz")}, Output: "Topaz"},
+	{Name: "Upgrade Topaz", Inputs: []Ingredient{items(3, "Topaz")}, Output: "FlawlessTopaz"},
+	{Name: "Upgrade Flawless Topaz", Inputs: []Ingredient{items(3, "FlawlessTopaz")}, Output: "PerfectTopaz"},
+	{Name: "Upgrade Chipped Sapphire", Inputs: []Ingredient{items(3, "ChippedSapphire")}, Output: "FlawedSapphire"},
+	{Name: "Upgrade Flawed Sapphire", Inputs: []Ingredient{items(3, "FlawedSapphire")}, Output: "Sapphire"},
+	{Name: "Upgrade Sapphire", Inputs: []Ingredient{items(3, "Sapphire")}, Output: "FlawlessSapphire"},
+	{Name: "Upgrade Flawless Sapphire", Inputs: []Ingredient{items(3, "FlawlessSapphire")}, Output: "PerfectSapphire"},
+	{Name: "Upgrade Chipped Emerald", Inputs: []Ingredient{items(3, "ChippedEmerald")}, Output: "FlawedEmerald"},
+	{Name: "Upgrade Flawed Emerald", Inputs: []Ingredient{items(3, "FlawedEmerald")}, Output: "Emerald"},
+	{Name: "Upgrade Emerald", Inputs: []Ingredient{items(3, "Emerald")}, Output: "FlawlessEmerald"},
+	{Name: "Upgrade Flawless Emerald", Inputs: []Ingredient{items(3, "FlawlessEmerald")}, Output: "PerfectEmerald"},
+	{Name: "Upgrade Chipped Ruby", Inputs: []Ingredient{items(3, "ChippedRuby")}, Output: "FlawedRuby"},
+	{Name: "Upgrade Flawed Ruby", Inputs: []Ingredient{items(3, "FlawedRuby")}, Output: "Ruby"},
+	{Name: "Upgrade Ruby", Inputs: []Ingredient{items(3, "Ruby")}, Output: "FlawlessRuby"},
+	{Name: "Upgrade Flawless Ruby", Inputs: []Ingredient{items(3, "FlawlessRuby")}, Output: "PerfectRuby"},
+	{Name: "Upgrade Chipped Diamond", Inputs: []Ingredient{items(3, "ChippedDiamond")}, Output: "FlawedDiamond"},
+	{Name: "Upgrade Flawed Diamond", Inputs: []Ingredient{items(3, "FlawedDiamond")}, Output: "Diamond"},
+	{Name: "Upgrade Diamond", Inputs: []Ingredient{items(3, "Diamond")}, Output: "FlawlessDiamond"},
+	{Name: "Upgrade Flawless Diamond", Inputs: []Ingredient{items(3, "FlawlessDiamond")}, Output: "PerfectDiamond"},
+	{Name: "Upgrade Chipped Skull", Inputs: []Ingredient{items(3, "ChippedSkull")}, Output: "FlawedSkull"},
+	{Name: "Upgrade Flawed Skull", Inputs: []Ingredient{items(3, "FlawedSkull")}, Output: "Skull"},
+	{Name: "Upgrade Skull", Inputs: []Ingredient{items(3, "Skull")}, Output: "FlawlessSkull"},
+	{Name: "Upgrade Flawless Skull", Inputs: []Ingredient{items(3, "FlawlessSkull")}, Output: "PerfectSkull"},
+
+	// Rune upgrades
+	{Name: "Upgrade El Rune", Inputs: []Ingredient{items(3, "ElRune")}, Output: "EldRune"},
+	{Name: "Upgrade Eld Rune", Inputs: []Ingredient{items(3, "EldRune")}, Output: "TirRune"},
+	{Name: "Upgrade Tir Rune", Inputs: []Ingredient{items(3, "TirRune")}, Output: "NefRune"},
+	{Name: "Upgrade Nef Rune", Inputs: []Ingredient{items(3, "NefRune")}, Output: "EthRune"},
+	{Name: "Upgrade Eth Rune", Inputs: []Ingredient{items(3, "EthRune")}, Output: "IthRune"},
+	{Name: "Upgrade Ith Rune", Inputs: []Ingredient{items(3, "IthRune")}, Output: "TalRune"},
+	{Name: "Upgrade Tal Rune", Inputs: []Ingredient{items(3, "TalRune")}, Output: "RalRune"},
+	{Name: "Upgrade Ral Rune", Inputs: []Ingredient{items(3, "RalRune")}, Output: "OrtRune"},
+	{Name: "Upgrade Ort Rune", Inputs: []Ingredient{items(3, "OrtRune")}, Output: "ThulRune"},
+	{Name: "Upgrade Thul Rune", Inputs: []Ingredient{items(3, "ThulRune"), items(1, "ChippedTopaz")}, Output: "AmnRune"},
+	{Name: "Upgrade Amn Rune", Inputs: []Ingredient{items(3, "AmnRune"), items(1, "ChippedAmethyst")}, Output: "SolRune"},
+	{Name: "Upgrade Sol Rune", Inputs: []Ingredient{items(3, "SolRune"), items(1, "ChippedSapphire")}, Output: "ShaelRune"},
+	{Name: "Upgrade Shael Rune", Inputs: []Ingredient{items(3, "ShaelRune"), items(1, "ChippedRuby")}, Output: "DolRune"},
+	{Name: "Upgrade Dol Rune", Inputs: []Ingredient{items(3, "DolRune"), items(1, "ChippedEmerald")}, Output: "HelRune"},
+	{Name: "Upgrade Hel Rune", Inputs: []Ingredient{items(3, "HelRune"), items(1, "ChippedDiamond")}, Output: "IoRune"},
+	{Name: "Upgrade Io Rune", Inputs: []Ingredient{items(3, "IoRune"), items(1, "FlawedTopaz")}, Output: "LumRune"},
+	{Name: "Upgrade Lum Rune", Inputs: []Ingredient{items(3, "LumRune"), items(1, "FlawedAmethyst")}, Output: "KoRune"},
+	{Name: "Upgrade Ko Rune", Inputs: []Ingredient{items(3, "KoRune"), items(1, "FlawedSapphire")}, Output: "FalRune"},
+	{Name: "Upgrade Fal Rune", Inputs: []Ingredient{items(3, "FalRune"), items(1, "FlawedRuby")}, Output: "LemRune"},
+	{Name: "Upgrade Lem Rune", Inputs: []Ingredient{items(3, "LemRune"), items(1, "FlawedEmerald")}, Output: "PulRune"},
+	{Name: "Upgrade Pul Rune", Inputs: []Ingredient{items(2, "PulRune"), items(1, "FlawedDiamond")}, Output: "UmRune"},
+	{Name: "Upgrade Um Rune", Inputs: []Ingredient{items(2, "UmRune"), items(1, "Topaz")}, Output: "MalRune"},
+	{Name: "Upgrade Mal Rune", Inputs: []Ingredient{items(2, "MalRune"), items(1, "Amethyst")}, Output: "IstRune"},
+	{Name: "Upgrade Ist Rune", Inputs: []Ingredient{items(2, "IstRune"), items(1, "Sapphire")}, Output: "GulRune"},
+	{Name: "Upgrade Gul Rune", Inputs: []Ingredient{items(2, "GulRune"), items(1, "Ruby")}, Output: "VexRune"},
+	{Name: "Upgrade Vex Rune", Inputs: []Ingredient{items(2, "VexRune"), items(1, "Emerald")}, Output: "OhmRune"},
+	{Name: "Upgrade Ohm Rune", Inputs: []Ingredient{items(2, "OhmRune"), items(1, "Diamond")}, Output: "LoRune"},
+	{Name: "Upgrade Lo Rune", Inputs: []Ingredient{items(2, "LoRune"), items(1, "FlawlessTopaz")}, Output: "SurRune"},
+	{Name: "Upgrade Sur Rune", Inputs: []Ingredient{items(2, "SurRune"), items(1, "FlawlessAmethyst")}, Output: "BerRune"},
+	{Name: "Upgrade Ber Rune", Inputs: []Ingredient{items(2, "BerRune"), items(1, "FlawlessSapphire")}, Output: "JahRune"},
+	{Name: "Upgrade Jah Rune", Inputs: []Ingredient{items(2, "JahRune"), items(1, "FlawlessRuby")}, Output: "ChamRune"},
+	{Name: "Upgrade Cham Rune", Inputs: []Ingredient{items(2, "ChamRune"), items(1, "FlawlessEmerald")}, Output: "ZodRune"},
+	// Potions
+	{Name: "Rejuvenation Potion", Inputs: []Ingredient{
+		{Names: healingPotions, Quantity: 3},
+		{Names: manaPotions, Quantity: 3},
+		items(1, chippedGems...),
+	}, Output: "RejuvenationPotion"},
+	{Name: "Full Rejuvenation Potion", Inputs: []Ingredient{
+		{Names: healingPotions, Quantity: 3},
+		{Names: manaPotions, Quantity: 3},
+		items(1, standardGems...),
+	}, Output: "FullRejuvenationPotion"},
+	{Name: "Full Rejuvenation Potion from Rejuvenation Potions", Inputs: []Ingredient{items(3, "RejuvenationPotion")}, Output: "FullRejuvenationPotion"},
+
+	// Jewelry
+	{Name: "Magic Amulet from Rings", Inputs: []Ingredient{
+		{Names: []item.Name{"Ring"}, Qualities: []item.Quality{item.QualityMagic}, Quantity: 3},
+	}, Output: "Amulet"},
+	{Name: "Magic Ring from Amulets", Inputs: []Ingredient{
+		{Names: []item.Name{"Amulet"}, Qualities: []item.Quality{item.QualityMagic}, Quantity: 3},
+	}, Output: "Ring"},
+
+	// Add sockets to normal items
+	{Name: "Socket Body Armor", Inputs: []Ingredient{
+		items(1, "TalRune"), items(1, "ThulRune"), items(1, "PerfectTopaz"),
+		{Category: CategoryBodyArmor, Qualities: []item.Quality{item.QualityNormal}, Sockets: SocketsNone, Quantity: 1},
+	}},
+	{Name: "Socket Weapon", Inputs: []Ingredient{
+		items(1, "RalRune"), items(1, "AmnRune"), items(1, "PerfectAmethyst"),
+		{Category: CategoryWeapon, Qualities: []item.Quality{item.QualityNormal}, Sockets: SocketsNone, Quantity: 1},
+	}},
+	{Name: "Socket Helm", Inputs: []Ingredient{
+		items(1, "RalRune"), items(1, "ThulRune"), items(1, "PerfectSapphire"),
+		{Category: CategoryHelm, Qualities: []item.Quality{item.QualityNormal}, Sockets: SocketsNone, Quantity: 1},
+	}},
+	{Name: "Socket Shield", Inputs: []Ingredient{
+		items(1, "TalRune"), items(1, "AmnRune"), items(1, "PerfectRuby"),
+		{Category: CategoryShield, Qualities: []item.Quality{item.QualityNormal}, Sockets: SocketsNone, Quantity: 1},
+	}},
+	{Name: "Remove Socketed Items", Inputs: []Ingredient{
+		items(1, "HelRune"), items(1, item.ScrollOfTownPortal),
+		{Sockets: SocketsFilled, Quantity: 1},
+	}},
+
+	// Upgrade unique and rare items to the next tier
+	{Name: "Upgrade Normal Unique Weapon", Inputs: []Ingredient{
+		items(1, "RalRune"), items(1, "SolRune"), items(1, "PerfectEmerald"),
+		{Category: CategoryWeapon, Qualities: []item.Quality{item.QualityUnique}, Tier: &tierNormal, Quantity: 1},
+	}},
+	{Name: "Upgrade Exceptional Unique Weapon", Inputs: []Ingredient{
+		items(1, "LumRune"), items(1, "PulRune"), items(1, "PerfectEmerald"),
+		{Category: CategoryWeapon, Qualities: []item.Quality{item.QualityUnique}, Tier: &tierExceptional, Quantity: 1},
+	}},
+	{Name: "Upgrade Normal Unique Armor", Inputs: []Ingredient{
+		items(1, "TalRune"), items(1, "ShaelRune"), items(1, "PerfectDiamond"),
+		{Category: CategoryArmor, Qualities: []item.Quality{item.QualityUnique}, Tier: &tierNormal, Quantity: 1},
+	}},
+	{Name: "Upgrade Exceptional Unique Armor", Inputs: []Ingredient{
+		items(1, "KoRune"), items(1, "LemRune"), items(1, "PerfectDiamond"),
+		{Category: CategoryArmor, Qualities: []item.Quality{item.QualityUnique}, Tier: &tierExceptional, Quantity: 1},
+	}},
+	{Name: "Upgrade Normal Rare Weapon", Inputs: []Ingredient{
+		items(1, "OrtRune"), items(1, "AmnRune"), items(1, "PerfectSapphire"),
+		{Category: CategoryWeapon, Qualities: []item.Quality{item.QualityRare}, Tier: &tierNormal, Quantity: 1},
+	}},
+	{Name: "Upgrade Exceptional Rare Weapon", Inputs: []Ingredient{
+		items(1, "FalRune"), items(1, "UmRune"), items(1, "PerfectSapphire"),
+		{Category: CategoryWeapon, Qualities: []item.Quality{item.QualityRare}, Tier: &tierExceptional, Quantity: 1},
+	}},
+	{Name: "Upgrade Normal Rare Armor", Inputs: []Ingredient{
+		items(1, "RalRune"), items(1, "ThulRune"), items(1, "PerfectAmethyst"),
+		{Category: CategoryArmor, Qualities: []item.Quality{item.QualityRare}, Tier: &tierNormal, Quantity: 1},
+	}},
+	{Name: "Upgrade Exceptional Rare Armor", Inputs: []Ingredient{
+		items(1, "KoRune"), items(1, "PulRune"), items(1, "PerfectAmethyst"),
+		{Category: CategoryArmor, Qualities: []item.Quality{item.QualityRare}, Tier: &tierExceptional, Quantity: 1},
+	}},
+
+	// Reroll
+	{Name: "Reroll Rare Item", Inputs: []Ingredient{
+		items(6, "PerfectSkull"),
+		{Qualities: []item.Quality{item.QualityRare}, Quantity: 1},
+	}},
+
+	// Uber content
+	{Name: "Token of Absolution", Inputs: []Ingredient{
+		items(1, "TwistedEssenceOfSuffering"), items(1, "ChargedEssenceOfHatred"),
+		items(1, "BurningEssenceOfTerror"), items(1, "FesteringEssenceOfDestruction"),
+	}, Output: "TokenofAbsolution"},
+	{Name: "Pandemonium Portal", Inputs: []Ingredient{
+		items(1, "KeyOfTerror"), items(1, "KeyOfHate"), items(1, "KeyOfDestruction"),
+	}},
+	{Name: "Uber Tristram Portal", Inputs: []Ingredient{
+		items(1, "DiablosHorn"), items(1, "BaalsEye"), items(1, "MephistosBrain"),
+	}},
+}
+
+var (
+	healingPotions = []item.Name{"MinorHealingPotion", "LightHealingPotion", "HealingPotion", "GreaterHealingPotion", "SuperHealingPotion"}
+	manaPotions    = []item.Name{"MinorManaPotion", "LightManaPotion", "ManaPotion", "GreaterManaPotion", "SuperManaPotion"}
+	chippedGems    = []item.Name{"ChippedAmethyst", "ChippedTopaz", "ChippedSapphire", "ChippedEmerald", "ChippedRuby", "ChippedDiamond", "ChippedSkull"}
+	standardGems   = []item.Name{"Amethyst", "Topaz", "Sapphire", "Emerald", "Ruby", "Diamond", "Skull"}
+)
+
+func items(quantity int, names ...item.Name) Ingredient {
+	return Ingredient{Names: names, Quantity: quantity}
+}
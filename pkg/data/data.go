@@ -3,6 +3,7 @@ package data
 import (
 	"math"
 	"strings"
+	"time"
 
 	"github.com/hectorgimenez/d2go/pkg/data/mode"
 
@@ -27,7 +28,8 @@ const (
 
 type Data struct {
 	AreaOrigin       Position
-	Corpse           Corpse
+	Corpse           Corpse  // Main player corpse, the one holding the items if there are many
+	PlayerCorpses    Corpses // All the main player corpses, sorted by distance to the player
 	Monsters         Monsters
 	Corpses          Monsters
 	Game             OnlineGame
@@ -43,9 +45,11 @@ type Data struct {
 	HoverData        HoverData
 	TerrorZones      []area.ID
 	Quests           quest.Quests
+	QuestsRaw        quest.Raw // Buffer Quests is decoded from, useful to debug decoding issues after game patches
 	KeyBindings      KeyBindings
 	LegacyGraphics   bool
 	IsIngame         bool
+	AreaLoaded       bool // No loading screen and the player is linked to a level, always false on the first read after an area change
 	HasMerc          bool
 	ActiveWeaponSlot int
 }
@@ -117,12 +121,37 @@ func (d Data) MercHPPercent() int {
 }
 
 type RosterMember struct {
-	Name     string
-	Area     area.ID
-	Position Position
+	Name       string
+	Area       area.ID
+	Position   Position
+	PathTarget Position // Only known if the player unit is loaded, same as Position otherwise
+	// Position comes from the loaded player unit, otherwise it's the coarse roster position (not updated in town). Player
+	// units are only loaded when they are in the same act
+	PositionExact bool
+	LastSeen      time.Time // Last GetData call with the player unit loaded, zero if it has not been loaded in this game
 }
 type Roster []RosterMember
 
+// SameAct returns true if the member is in the same act as the given area, so the player unit can be loaded
+func (rm RosterMember) SameAct(a area.ID) bool {
+	return rm.Area.Act() == a.Act()
+}
+
+// Walkable returns true if the member is in the given area and its exact position is known, so it can be reached
+// walking, otherwise a waypoint/portal is needed or the position should be read again
+func (rm RosterMember) Walkable(a area.ID) bool {
+	return rm.PositionExact && rm.Area == a
+}
+
+// SinceSeen returns for how long the member player unit has not been loaded, false if it has never been loaded
+func (rm RosterMember) SinceSeen(now time.Time) (time.Duration, bool) {
+	if rm.LastSeen.IsZero() {
+		return 0, false
+	}
+
+	return now.Sub(rm.LastSeen), true
+}
+
 func (r Roster) FindByName(name string) (RosterMember, bool) {
 	for _, rm := range r {
 		if strings.EqualFold(rm.Name, name) {
@@ -133,6 +162,28 @@ func (r Roster) FindByName(name string) (RosterMember, bool) {
 	return RosterMember{}, false
 }
 
+// TerrorZoneGroups returns the active terror zones grouped as they are announced in game
+func (d Data) TerrorZoneGroups() []area.TerrorZone {
+	return area.GroupTerrorZones(d.TerrorZones)
+}
+
+// IsTerrorized returns true if the given area is part of an active terror zone, the whole group is terrorized even if
+// the game only lists some of its areas
+func (d Data) IsTerrorized(a area.ID) bool {
+	for _, tz := range d.TerrorZoneGroups() {
+		if tz.Contains(a) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// InTerrorZone returns true if the player is in a terrorized area
+func (d Data) InTerrorZone() bool {
+	return d.IsTerrorized(d.PlayerUnit.Area)
+}
+
 type Level struct {
 	Area       area.ID
 	Position   Position
@@ -151,13 +202,49 @@ const (
 	Assassin
 )
 
+func (c Class) String() string {
+	switch c {
+	case Amazon:
+		return "Amazon"
+	case Sorceress:
+		return "Sorceress"
+	case Necromancer:
+		return "Necromancer"
+	case Paladin:
+		return "Paladin"
+	case Barbarian:
+		return "Barbarian"
+	case Druid:
+		return "Druid"
+	case Assassin:
+		return "Assassin"
+	}
+
+	return ""
+}
+
 type Corpse struct {
+	ID        UnitID
 	Found     bool
 	IsHovered bool
+	HasItems  bool
 	Position  Position
 	States    state.States
 }
 
+type Corpses []Corpse
+
+// WithItems returns the first corpse still holding items, the one that should be picked on recovery
+func (c Corpses) WithItems() (Corpse, bool) {
+	for _, corpse := range c {
+		if corpse.HasItems {
+			return corpse, true
+		}
+	}
+
+	return Corpse{}, false
+}
+
 type Position struct {
 	X int
 	Y int
@@ -173,11 +260,41 @@ type PlayerUnit struct {
 	BaseStats          stat.Stats
 	Skills             map[skill.ID]skill.Points
 	States             state.States
+	StateEntries       state.Entries // Same states as States, including the skill or item that applied them
 	Class              Class
 	LeftSkill          skill.ID
 	RightSkill         skill.ID
 	AvailableWaypoints []area.ID // Is only filled when WP menu is open and only for the specific selected tab
 	Mode               mode.PlayerMode
+	PathTarget         Position // Where the player is moving to, same as Position if not moving
+	Velocity           float64  // Sub-tiles per second, measured between the last two GetData calls
+}
+
+// IsMoving returns true if the player has a path destination different from its current position
+func (pu PlayerUnit) IsMoving() bool {
+	return pu.PathTarget != pu.Position
+}
+
+// IsRunning returns true if the player is moving in run mode, false if walking or not moving
+func (pu PlayerUnit) IsRunning() bool {
+	return pu.Mode == mode.Running
+}
+
+// IsStuck returns true if the player is trying to move but the position didn't change since the previous read,
+// usually because the path is blocked by a wall or an object
+func (pu PlayerUnit) IsStuck(previous PlayerUnit) bool {
+	return pu.IsMoving() && previous.IsMoving() && pu.Position == previous.Position && pu.Area == previous.Area
+}
+
+// StaminaPercent returns the current stamina percent, 100 if max stamina is unknown
+func (pu PlayerUnit) StaminaPercent() int {
+	stamina, _ := pu.FindStat(stat.Stamina, 0)
+	maxStamina, found := pu.FindStat(stat.MaxStamina, 0)
+	if !found || maxStamina.Value == 0 {
+		return 100
+	}
+
+	return stamina.Value * 100 / maxStamina.Value
 }
 
 func (pu PlayerUnit) FindStat(id stat.ID, layer int) (stat.Data, bool) {
@@ -202,11 +319,24 @@ func (pu PlayerUnit) TotalPlayerGold() int {
 	return gold.Value + stashGold.Value
 }
 
+// IsDead returns true if the player is dying or dead, based on the unit mode and life. The player unit is not read
+// during loading screens, an empty stats list (and zero mode) doesn't mean the player is dead
 func (pu PlayerUnit) IsDead() bool {
+	if _, found := pu.FindStat(stat.MaxLife, 0); !found {
+		return false
+	}
+	if pu.Mode == mode.Death || pu.Mode == mode.Dead {
+		return true
+	}
+
 	life, _ := pu.FindStat(stat.Life, 0)
 	return life.Value <= 0
 }
 
+func (pu PlayerUnit) InTown() bool {
+	return pu.Area.IsTown()
+}
+
 func (pu PlayerUnit) HPPercent() int {
 	life, _ := pu.FindStat(stat.Life, 0)
 	maxLife, _ := pu.FindStat(stat.MaxLife, 0)
@@ -309,12 +439,12 @@ type OpenMenus struct {
 	QuitMenu       bool
 	Cube           bool
 	SkillSelect    bool
-	Anvil          bool
+	Anvil          bool // Charsi imbue screen
 	MercInventory  bool
 	BeltRows       bool
 	QuestLog       bool
 	PortraitsShown bool
-	ChatOpen       bool
+	ChatOpen       bool // Chat input box is open and has the keyboard focus, hotkeys are typed into the chat
 	Cinematic      bool
 }
 
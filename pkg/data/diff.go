@@ -0,0 +1,175 @@
+package data
+
+import (
+	"github.com/hectorgimenez/d2go/pkg/data/area"
+	"github.com/hectorgimenez/d2go/pkg/data/item"
+	"github.com/hectorgimenez/d2go/pkg/data/stat"
+)
+
+type Changes struct {
+	AreaChanged     bool
+	PreviousArea    area.ID
+	CurrentArea     area.ID
+	MonstersAdded   []Monster
+	MonstersRemoved []Monster // Monsters out of range are also reported as removed, check Corpses to know if it died
+	ItemsAdded      []Item    // Items not present in the previous snapshot (new drops, vendor refresh...)
+	ItemsRemoved    []Item    // Items not present anymore (sold, used, out of range...)
+	ItemsPickedUp   []Item    // Items moved from the ground to any player owned location
+	ItemsDropped    []Item    // Items moved from any player owned location to the ground
+	DropSources     []DropSource
+	StatChanges     []StatChange
+}
+
+// DropSource links a new ground item to the monster that most likely dropped it. The game doesn't keep the source unit
+// in the item data, so it's inferred from the closest monster that died between both snapshots
+type DropSource struct {
+	Item    Item
+	Monster Monster
+}
+
+// Items are dropped around the monster corpse, a few tiles away at most
+const dropSourceMaxDistance = 6
+
+type StatChange struct {
+	ID       stat.ID
+	Layer    int
+	OldValue int
+	NewValue int
+}
+
+func (c Changes) IsEmpty() bool {
+	return !c.AreaChanged && len(c.MonstersAdded) == 0 && len(c.MonstersRemoved) == 0 && len(c.ItemsAdded) == 0 &&
+		len(c.ItemsRemoved) == 0 && len(c.ItemsPickedUp) == 0 && len(c.ItemsDropped) == 0 && len(c.StatChanges) == 0
+}
+
+// Diff returns the changes between two game data snapshots. Monsters and items are matched by UnitID, stat changes
+// only cover the player stats
+func Diff(old, new Data) Changes {
+	changes := Changes{
+		PreviousArea: old.PlayerUnit.Area,
+		CurrentArea:  new.PlayerUnit.Area,
+		AreaChanged:  old.PlayerUnit.Area != new.PlayerUnit.Area,
+	}
+
+	oldMonsters := make(map[UnitID]struct{}, len(old.Monsters))
+	for _, m := range old.Monsters {
+		oldMonsters[m.UnitID] = struct{}{}
+	}
+	newMonsters := make(map[UnitID]struct{}, len(new.Monsters))
+	for _, m := range new.Monsters {
+		newMonsters[m.UnitID] = struct{}{}
+		if _, found := oldMonsters[m.UnitID]; !found {
+			changes.MonstersAdded = append(changes.MonstersAdded, m)
+		}
+	}
+	for _, m := range old.Monsters {
+		if _, found := newMonsters[m.UnitID]; !found {
+			changes.MonstersRemoved = append(changes.MonstersRemoved, m)
+		}
+	}
+
+	oldItems := make(map[UnitID]Item, len(old.Inventory.AllItems))
+	for _, i := range old.Inventory.AllItems {
+		oldItems[i.UnitID] = i
+	}
+	newItems := make(map[UnitID]struct{}, len(new.Inventory.AllItems))
+	for _, i := range new.Inventory.AllItems {
+		newItems[i.UnitID] = struct{}{}
+		oldItem, found := oldItems[i.UnitID]
+		if !found {
+			changes.ItemsAdded = append(changes.ItemsAdded, i)
+			continue
+		}
+
+		if oldItem.Location.LocationType == item.LocationGround && isPlayerOwnedLocation(i.Location.LocationType) {
+			changes.ItemsPickedUp = append(changes.ItemsPickedUp, i)
+		} else if isPlayerOwnedLocation(oldItem.Location.LocationType) && i.Location.LocationType == item.LocationGround {
+			changes.ItemsDropped = append(changes.ItemsDropped, i)
+		}
+	}
+	for _, i := range old.Inventory.AllItems {
+		if _, found := newItems[i.UnitID]; !found {
+			changes.ItemsRemoved = append(changes.ItemsRemoved, i)
+		}
+	}
+
+	changes.DropSources = dropSources(old, new, changes.ItemsAdded)
+	changes.StatChanges = diffStats(old.PlayerUnit.Stats, new.PlayerUnit.Stats)
+
+	return changes
+}
+
+func dropSources(old, new Data, added []Item) []DropSource {
+	oldCorpses := make(map[UnitID]struct{}, len(old.Corpses))
+	for _, c := range old.Corpses {
+		oldCorpses[c.UnitID] = struct{}{}
+	}
+	var died []Monster
+	for _, c := range new.Corpses {
+		if _, found := oldCorpses[c.UnitID]; !found {
+			died = append(died, c)
+		}
+	}
+	if len(died) == 0 {
+		return nil
+	}
+
+	var sources []DropSource
+	for _, i := range added {
+		if i.Location.LocationType != item.LocationGround {
+			continue
+		}
+
+		closest, closestDistance := -1, dropSourceMaxDistance*dropSourceMaxDistance+1
+		for idx, m := range died {
+			if d := sqDistance(i.Position, m.Position); d < closestDistance {
+				closest, closestDistance = idx, d
+			}
+		}
+		if closest >= 0 {
+			sources = append(sources, DropSource{Item: i, Monster: died[closest]})
+		}
+	}
+
+	return sources
+}
+
+func diffStats(old, new stat.Stats) []StatChange {
+	type statKey struct {
+		id    stat.ID
+		layer int
+	}
+
+	oldValues := make(map[statKey]int, len(old))
+	for _, s := range old {
+		oldValues[statKey{s.ID, s.Layer}] = s.Value
+	}
+
+	var changes []StatChange
+	for _, s := range new {
+		key := statKey{s.ID, s.Layer}
+		oldValue, found := oldValues[key]
+		delete(oldValues, key)
+		if !found || oldValue != s.Value {
+			changes = append(changes, StatChange{ID: s.ID, Layer: s.Layer, OldValue: oldValue, NewValue: s.Value})
+		}
+	}
+	// Stats not present anymore, the game doesn't keep stats with 0 value
+	for _, s := range old {
+		if _, found := oldValues[statKey{s.ID, s.Layer}]; found {
+			changes = append(changes, StatChange{ID: s.ID, Layer: s.Layer, OldValue: s.Value, NewValue: 0})
+		}
+	}
+
+	return changes
+}
+
+func isPlayerOwnedLocation(location item.LocationType) bool {
+	switch location {
+	case item.LocationInventory, item.LocationStash, item.LocationSharedStash, item.LocationBelt, item.LocationCube,
+		item.LocationCursor, item.LocationEquipped, item.LocationMercenary, item.LocationTrade:
+		return true
+	}
+
+	return false
+}
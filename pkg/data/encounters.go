@@ -0,0 +1,182 @@
+package data
+
+import (
+	"github.com/hectorgimenez/d2go/pkg/data/area"
+	"github.com/hectorgimenez/d2go/pkg/data/mode"
+	"github.com/hectorgimenez/d2go/pkg/data/npc"
+	"github.com/hectorgimenez/d2go/pkg/data/object"
+	"github.com/hectorgimenez/d2go/pkg/data/stat"
+)
+
+// baalWaveMonsters are the monsters only spawned by each Throne of Destruction wave
+var baalWaveMonsters = map[npc.ID]int{
+	npc.WarpedFallen:      1, // Colenzo the Annihilator
+	npc.WarpedShaman:      1,
+	npc.BaalSubjectMummy:  2, // Achmel the Cursed
+	npc.BaalColdMage:      2,
+	npc.CouncilMemberBall: 3, // Bartuc the Bloody
+	npc.VenomLord2:        4, // Ventar the Unholy
+	npc.BaalsMinion:       5, // Lister the Tormentor
+	npc.BaalsMinion2:      5,
+	npc.BaalsMinion3:      5,
+}
+
+var diabloSeals = []object.Name{object.DiabloSeal1, object.DiabloSeal2, object.DiabloSeal3, object.DiabloSeal4, object.DiabloSeal5}
+
+// BaalWave returns the Throne of Destruction wave currently alive (1-5), 0 if no wave monster is alive or the player is
+// not in the Throne of Destruction. Monsters are only read around the player, so stay close to the throne
+func (d Data) BaalWave() int {
+	if d.PlayerUnit.Area != area.ThroneOfDestruction {
+		return 0
+	}
+
+	wave := 0
+	for _, m := range d.Monsters {
+		if w, found := baalWaveMonsters[m.Name]; found && m.IsAttackable() {
+			wave = max(wave, w)
+		}
+	}
+
+	return wave
+}
+
+type SealBoss struct {
+	Name  npc.ID
+	Found bool // Alive or dead, false if it didn't spawn yet or it's out of range
+	Dead  bool
+}
+
+type ChaosSanctuaryState struct {
+	SealsOpened     map[object.Name]bool // Only the seals in range, not found seals are not included
+	GrandVizier     SealBoss
+	LordDeSeis      SealBoss
+	InfectorOfSouls SealBoss
+	DiabloSpawns    bool // All seals are open and seal bosses are dead, Diablo spawns after the last one dies
+}
+
+// ChaosSanctuary returns which Chaos Sanctuary seals are open and the state of each seal boss. Objects and monsters
+// are only read around the player, so seals and bosses far away are not reported
+func (d Data) ChaosSanctuary() ChaosSanctuaryState {
+	st := ChaosSanctuaryState{
+		SealsOpened:     make(map[object.Name]bool),
+		GrandVizier:     d.sealBoss(npc.StormCaster),
+		LordDeSeis:      d.sealBoss(npc.OblivionKnight),
+		InfectorOfSouls: d.sealBoss(npc.VenomLord),
+	}
+	if d.PlayerUnit.Area != area.ChaosSanctuary {
+		return st
+	}
+
+	for _, seal := range diabloSeals {
+		if obj, found := d.Objects.FindOne(seal); found {
+			st.SealsOpened[seal] = obj.Mode != mode.ObjectModeIdle
+		}
+	}
+
+	allOpen := len(st.SealsOpened) == len(diabloSeals)
+	for _, opened := range st.SealsOpened {
+		allOpen = allOpen && opened
+	}
+	st.DiabloSpawns = allOpen && st.GrandVizier.Dead && st.LordDeSeis.Dead && st.InfectorOfSouls.Dead
+
+	return st
+}
+
+func (d Data) sealBoss(id npc.ID) SealBoss {
+	if d.PlayerUnit.Area != area.ChaosSanctuary {
+		return SealBoss{Name: id}
+	}
+
+	for _, c := range d.Corpses {
+		if c.Name == id && c.IsSealElite() {
+			return SealBoss{Name: id, Found: true, Dead: true}
+		}
+	}
+	for _, m := range d.Monsters {
+		if m.Name == id && m.IsSealElite() {
+			return SealBoss{Name: id, Found: true}
+		}
+	}
+
+	return SealBoss{Name: id}
+}
+
+type AncientsState struct {
+	AltarActivated bool // The altar was used and the Ancients are awake
+	Alive          int
+	Dead           int
+}
+
+var ancients = []npc.ID{npc.AncientBarbarian, npc.AncientBarbarian2, npc.AncientBarbarian3}
+
+// Ancients returns the state of the Arreat Summit Ancients fight
+func (d Data) Ancients() AncientsState {
+	st := AncientsState{}
+	if d.PlayerUnit.Area != area.ArreatSummit {
+		return st
+	}
+
+	if altar, found := d.Objects.FindOne(object.AncientsAltar); found {
+		st.AltarActivated = altar.Mode != mode.ObjectModeIdle
+	}
+	st.Alive, st.Dead = d.countAliveAndDead(ancients)
+
+	return st
+}
+
+type CouncilState struct {
+	Alive int
+	Dead  int
+	// Ismail Vilehand, Geleb Flamefinger and Toorc Icefist, they share the monster id with the regular council members
+	SuperUniquesAlive int
+	SuperUniquesDead  int
+	Members           Monsters // Council members alive around the player
+}
+
+var councilMembers = []npc.ID{npc.CouncilMember, npc.CouncilMember2, npc.CouncilMember3}
+
+// TravincalCouncil returns how many High Council members (including Ismail, Geleb and Toorc) are alive and dead around
+// the player
+func (d Data) TravincalCouncil() CouncilState {
+	st := CouncilState{}
+	if d.PlayerUnit.Area != area.Travincal {
+		return st
+	}
+
+	st.Alive, st.Dead = d.countAliveAndDead(councilMembers)
+	for _, id := range councilMembers {
+		for _, c := range d.Corpses {
+			if c.Name == id && c.Type == MonsterTypeSuperUnique {
+				st.SuperUniquesDead++
+			}
+		}
+		for _, m := range d.Monsters {
+			if m.Name != id || m.Stats[stat.Life] <= 0 {
+				continue
+			}
+			st.Members = append(st.Members, m)
+			if m.Type == MonsterTypeSuperUnique {
+				st.SuperUniquesAlive++
+			}
+		}
+	}
+
+	return st
+}
+
+func (d Data) countAliveAndDead(ids []npc.ID) (alive, dead int) {
+	for _, id := range ids {
+		for _, c := range d.Corpses {
+			if c.Name == id {
+				dead++
+			}
+		}
+		for _, m := range d.Monsters {
+			if m.Name == id && m.Stats[stat.Life] > 0 {
+				alive++
+			}
+		}
+	}
+
+	return alive, dead
+}
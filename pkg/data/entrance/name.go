@@ -92,3 +92,7 @@ const (
 	A5BaalTempleDownL
 	A5BaalTempleDownR
 )
+
+func (n Name) Desc() Description {
+	return Desc[int(n)]
+}
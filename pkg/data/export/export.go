@@ -0,0 +1,409 @@
+// Package export converts data.Data into a stable, language agnostic representation, so game state can be consumed by
+// other services (dashboards, bots...) without importing the Go types. Field names are part of the schema: fields can be
+// added, but existing ones are never renamed or removed without bumping SchemaVersion.
+//
+// Enums are exported by name together with their numeric ID. Fields are tagged for encoding/json and also for msgpack,
+// so any msgpack encoder honoring struct tags produces the same schema.
+package export
+
+import (
+	"encoding/json"
+	"sort"
+
+	"github.com/hectorgimenez/d2go/pkg/data"
+	"github.com/hectorgimenez/d2go/pkg/data/area"
+	"github.com/hectorgimenez/d2go/pkg/data/npc"
+	"github.com/hectorgimenez/d2go/pkg/data/quest"
+	"github.com/hectorgimenez/d2go/pkg/data/skill"
+	"github.com/hectorgimenez/d2go/pkg/data/stat"
+	"github.com/hectorgimenez/d2go/pkg/data/state"
+)
+
+const SchemaVersion = 1
+
+type GameState struct {
+	SchemaVersion  int       `json:"schemaVersion" msgpack:"schemaVersion"`
+	IsIngame       bool      `json:"isIngame" msgpack:"isIngame"`
+	Game           Game      `json:"game" msgpack:"game"`
+	Player         Player    `json:"player" msgpack:"player"`
+	HasMerc        bool      `json:"hasMerc" msgpack:"hasMerc"`
+	Monsters       []Monster `json:"monsters" msgpack:"monsters"`
+	Corpses        []Monster `json:"corpses" msgpack:"corpses"`
+	Items          []Item    `json:"items" msgpack:"items"`
+	Gold           int       `json:"gold" msgpack:"gold"`
+	StashedGold    [4]int    `json:"stashedGold" msgpack:"stashedGold"`
+	Objects        []Object  `json:"objects" msgpack:"objects"`
+	AdjacentLevels []Level   `json:"adjacentLevels" msgpack:"adjacentLevels"`
+	Roster         []Member  `json:"roster" msgpack:"roster"`
+	TerrorZones    []Enum    `json:"terrorZones" msgpack:"terrorZones"`
+	Quests         []Quest   `json:"quests" msgpack:"quests"`
+	OpenMenus      []string  `json:"openMenus" msgpack:"openMenus"`
+}
+
+// Enum is any game enum, exported with both the numeric ID and the name, Name is empty if unknown
+type Enum struct {
+	ID   int    `json:"id" msgpack:"id"`
+	Name string `json:"name" msgpack:"name"`
+}
+
+type Position struct {
+	X int `json:"x" msgpack:"x"`
+	Y int `json:"y" msgpack:"y"`
+}
+
+// Game doesn't include the game password, exported data is meant to be shared with other processes and services
+type Game struct {
+	Name string `json:"name" msgpack:"name"`
+	FPS  int    `json:"fps" msgpack:"fps"`
+	Ping int    `json:"ping" msgpack:"ping"`
+}
+
+type Stat struct {
+	Enum
+	Layer int `json:"layer" msgpack:"layer"`
+	Value int `json:"value" msgpack:"value"`
+}
+
+type Skill struct {
+	Enum
+	Level    uint `json:"level" msgpack:"level"`
+	Quantity uint `json:"quantity" msgpack:"quantity"`
+	Charges  uint `json:"charges" msgpack:"charges"`
+}
+
+type Player struct {
+	ID         int      `json:"id" msgpack:"id"`
+	Name       string   `json:"name" msgpack:"name"`
+	Class      Enum     `json:"class" msgpack:"class"`
+	Area       Enum     `json:"area" msgpack:"area"`
+	Position   Position `json:"position" msgpack:"position"`
+	Stats      []Stat   `json:"stats" msgpack:"stats"`
+	BaseStats  []Stat   `json:"baseStats" msgpack:"baseStats"`
+	Skills     []Skill  `json:"skills" msgpack:"skills"`
+	States     []int    `json:"states" msgpack:"states"`
+	LeftSkill  Enum     `json:"leftSkill" msgpack:"leftSkill"`
+	RightSkill Enum     `json:"rightSkill" msgpack:"rightSkill"`
+	Mode       int      `json:"mode" msgpack:"mode"`
+	HPPercent  int      `json:"hpPercent" msgpack:"hpPercent"`
+	MPPercent  int      `json:"mpPercent" msgpack:"mpPercent"`
+}
+
+type Monster struct {
+	UnitID     int      `json:"unitId" msgpack:"unitId"`
+	Name       Enum     `json:"name" msgpack:"name"`
+	Type       string   `json:"type" msgpack:"type"`
+	Position   Position `json:"position" msgpack:"position"`
+	PathTarget Position `json:"pathTarget" msgpack:"pathTarget"`
+	Stats      []Stat   `json:"stats" msgpack:"stats"`
+	States     []int    `json:"states" msgpack:"states"`
+	IsHovered  bool     `json:"isHovered" msgpack:"isHovered"`
+	Mode       int      `json:"mode" msgpack:"mode"`
+}
+
+type Item struct {
+	UnitID         int      `json:"unitId" msgpack:"unitId"`
+	Name           string   `json:"name" msgpack:"name"`
+	IdentifiedName string   `json:"identifiedName" msgpack:"identifiedName"`
+	Quality        string   `json:"quality" msgpack:"quality"`
+	Location       string   `json:"location" msgpack:"location"`
+	BodyLocation   string   `json:"bodyLocation" msgpack:"bodyLocation"`
+	Page           int      `json:"page" msgpack:"page"`
+	Position       Position `json:"position" msgpack:"position"`
+	Ethereal       bool     `json:"ethereal" msgpack:"ethereal"`
+	Identified     bool     `json:"identified" msgpack:"identified"`
+	IsRuneword     bool     `json:"isRuneword" msgpack:"isRuneword"`
+	RunewordName   string   `json:"runewordName" msgpack:"runewordName"`
+	Stats          []Stat   `json:"stats" msgpack:"stats"`
+	Sockets        []Item   `json:"sockets" msgpack:"sockets"`
+}
+
+type Object struct {
+	UnitID     int      `json:"unitId" msgpack:"unitId"`
+	Name       Enum     `json:"name" msgpack:"name"`
+	Position   Position `json:"position" msgpack:"position"`
+	Selectable bool     `json:"selectable" msgpack:"selectable"`
+	IsHovered  bool     `json:"isHovered" msgpack:"isHovered"`
+	Mode       string   `json:"mode" msgpack:"mode"`
+	Owner      string   `json:"owner,omitempty" msgpack:"owner,omitempty"`
+}
+
+type Level struct {
+	Area       Enum     `json:"area" msgpack:"area"`
+	Position   Position `json:"position" msgpack:"position"`
+	IsEntrance bool     `json:"isEntrance" msgpack:"isEntrance"`
+}
+
+type Member struct {
+	Name     string   `json:"name" msgpack:"name"`
+	Area     Enum     `json:"area" msgpack:"area"`
+	Position Position `json:"position" msgpack:"position"`
+}
+
+type Quest struct {
+	ID        int  `json:"id" msgpack:"id"`
+	Status    int  `json:"status" msgpack:"status"`
+	Completed bool `json:"completed" msgpack:"completed"`
+}
+
+// Marshal returns the canonical JSON representation of the game state
+func Marshal(d data.Data) ([]byte, error) {
+	return json.Marshal(FromData(d))
+}
+
+// FromData converts the game data into the export schema, all the lists are sorted so the output is deterministic
+func FromData(d data.Data) GameState {
+	gs := GameState{
+		SchemaVersion: SchemaVersion,
+		IsIngame:      d.IsIngame,
+		Game: Game{
+			Name: d.Game.LastGameName,
+			FPS:  d.Game.FPS,
+			Ping: d.Game.Ping,
+		},
+		Player:         fromPlayer(d.PlayerUnit),
+		HasMerc:        d.HasMerc,
+		Monsters:       make([]Monster, 0, len(d.Monsters)),
+		Corpses:        make([]Monster, 0, len(d.Corpses)),
+		Items:          make([]Item, 0, len(d.Inventory.AllItems)),
+		Gold:           d.Inventory.Gold,
+		StashedGold:    d.Inventory.StashedGold,
+		Objects:        make([]Object, 0, len(d.Objects)),
+		AdjacentLevels: make([]Level, 0, len(d.AdjacentLevels)),
+		Roster:         make([]Member, 0, len(d.Roster)),
+		TerrorZones:    make([]Enum, 0, len(d.TerrorZones)),
+		Quests:         fromQuests(d.Quests),
+		OpenMenus:      fromOpenMenus(d.OpenMenus),
+	}
+
+	for _, m := range d.Monsters {
+		gs.Monsters = append(gs.Monsters, fromMonster(m))
+	}
+	for _, m := range d.Corpses {
+		gs.Corpses = append(gs.Corpses, fromMonster(m))
+	}
+	for _, i := range d.Inventory.AllItems {
+		gs.Items = append(gs.Items, fromItem(i))
+	}
+	for _, o := range d.Objects {
+		gs.Objects = append(gs.Objects, Object{
+			UnitID:     int(o.ID),
+			Name:       Enum{ID: int(o.Name), Name: o.Desc().Name},
+			Position:   fromPosition(o.Position),
+			Selectable: o.Selectable,
+			IsHovered:  o.IsHovered,
+			Mode:       o.Mode.String(),
+			Owner:      o.Owner,
+		})
+	}
+	for _, l := range d.AdjacentLevels {
+		gs.AdjacentLevels = append(gs.AdjacentLevels, Level{
+			Area:       fromArea(l.Area),
+			Position:   fromPosition(l.Position),
+			IsEntrance: l.IsEntrance,
+		})
+	}
+	for _, rm := range d.Roster {
+		gs.Roster = append(gs.Roster, Member{
+			Name:     rm.Name,
+			Area:     fromArea(rm.Area),
+			Position: fromPosition(rm.Position),
+		})
+	}
+	for _, tz := range d.TerrorZones {
+		gs.TerrorZones = append(gs.TerrorZones, fromArea(tz))
+	}
+	sort.Slice(gs.Monsters, func(i, j int) bool { return gs.Monsters[i].UnitID < gs.Monsters[j].UnitID })
+	sort.Slice(gs.Corpses, func(i, j int) bool { return gs.Corpses[i].UnitID < gs.Corpses[j].UnitID })
+	sort.Slice(gs.Items, func(i, j int) bool { return gs.Items[i].UnitID < gs.Items[j].UnitID })
+	sort.Slice(gs.Objects, func(i, j int) bool { return gs.Objects[i].UnitID < gs.Objects[j].UnitID })
+
+	return gs
+}
+
+func fromPlayer(pu data.PlayerUnit) Player {
+	p := Player{
+		ID:         int(pu.ID),
+		Name:       pu.Name,
+		Class:      Enum{ID: int(pu.Class), Name: pu.Class.String()},
+		Area:       fromArea(pu.Area),
+		Position:   fromPosition(pu.Position),
+		Stats:      fromStats(pu.Stats),
+		BaseStats:  fromStats(pu.BaseStats),
+		Skills:     make([]Skill, 0, len(pu.Skills)),
+		States:     fromStates(pu.States),
+		LeftSkill:  fromSkill(pu.LeftSkill),
+		RightSkill: fromSkill(pu.RightSkill),
+		Mode:       int(pu.Mode),
+	}
+	if _, found := pu.FindStat(stat.MaxLife, 0); found {
+		p.HPPercent = pu.HPPercent()
+	}
+	if _, found := pu.FindStat(stat.MaxMana, 0); found {
+		p.MPPercent = pu.MPPercent()
+	}
+
+	for id, points := range pu.Skills {
+		p.Skills = append(p.Skills, Skill{
+			Enum:     fromSkill(id),
+			Level:    points.Level,
+			Quantity: points.Quantity,
+			Charges:  points.Charges,
+		})
+	}
+	sort.Slice(p.Skills, func(i, j int) bool { return p.Skills[i].ID < p.Skills[j].ID })
+
+	return p
+}
+
+func fromMonster(m data.Monster) Monster {
+	name := Enum{ID: int(m.Name)}
+	if flags, found := npc.MonStatsFlagsForID(m.Name); found {
+		name.Name = flags.ClassID
+	}
+
+	stats := make([]Stat, 0, len(m.Stats))
+	for id, value := range m.Stats {
+		stats = append(stats, Stat{Enum: Enum{ID: int(id), Name: id.String()}, Value: value})
+	}
+	sortStats(stats)
+
+	return Monster{
+		UnitID:     int(m.UnitID),
+		Name:       name,
+		Type:       string(m.Type),
+		Position:   fromPosition(m.Position),
+		PathTarget: fromPosition(m.PathTarget),
+		Stats:      stats,
+		States:     fromStates(m.States),
+		IsHovered:  m.IsHovered,
+		Mode:       int(m.Mode),
+	}
+}
+
+func fromItem(i data.Item) Item {
+	it := Item{
+		UnitID:         int(i.UnitID),
+		Name:           string(i.Name),
+		IdentifiedName: i.IdentifiedName,
+		Quality:        i.Quality.ToString(),
+		Location:       string(i.Location.LocationType),
+		BodyLocation:   string(i.Location.BodyLocation),
+		Page:           i.Location.Page,
+		Position:       fromPosition(i.Position),
+		Ethereal:       i.Ethereal,
+		Identified:     i.Identified,
+		IsRuneword:     i.IsRuneword,
+		RunewordName:   string(i.RunewordName),
+		Stats:          fromStats(i.Stats),
+		Sockets:        make([]Item, 0, len(i.Sockets)),
+	}
+	for _, s := range i.Sockets {
+		it.Sockets = append(it.Sockets, fromItem(s))
+	}
+
+	return it
+}
+
+func fromItems(items []data.Item) []Item {
+	result := make([]Item, 0, len(items))
+	for _, i := range items {
+		result = append(result, fromItem(i))
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].UnitID < result[j].UnitID })
+
+	return result
+}
+
+func fromQuests(quests quest.Quests) []Quest {
+	result := make([]Quest, 0, len(quests))
+	for q, status := range quests {
+		result = append(result, Quest{ID: int(q), Status: int(status), Completed: status.Completed()})
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].ID < result[j].ID })
+
+	return result
+}
+
+func fromStats(stats stat.Stats) []Stat {
+	result := make([]Stat, 0, len(stats))
+	for _, s := range stats {
+		result = append(result, Stat{
+			Enum:  Enum{ID: int(s.ID), Name: s.ID.String()},
+			Layer: s.Layer,
+			Value: s.Value,
+		})
+	}
+	sortStats(result)
+
+	return result
+}
+
+func sortStats(stats []Stat) {
+	sort.Slice(stats, func(i, j int) bool {
+		if stats[i].ID != stats[j].ID {
+			return stats[i].ID < stats[j].ID
+		}
+		return stats[i].Layer < stats[j].Layer
+	})
+}
+
+func fromStates(states state.States) []int {
+	result := make([]int, 0, len(states))
+	for _, s := range states {
+		result = append(result, int(s))
+	}
+	sort.Ints(result)
+
+	return result
+}
+
+func fromArea(id area.ID) Enum {
+	return Enum{ID: int(id), Name: id.Area().Name}
+}
+
+func fromSkill(id skill.ID) Enum {
+	return Enum{ID: int(id), Name: skill.Skills[id].Name}
+}
+
+// fromOpenMenus returns the names of the open menus, names are explicit so renaming a Go field doesn't break the schema
+func fromOpenMenus(om data.OpenMenus) []string {
+	menus := []struct {
+		name string
+		open bool
+	}{
+		{"inventory", om.Inventory},
+		{"loadingScreen", om.LoadingScreen},
+		{"npcInteract", om.NPCInteract},
+		{"npcShop", om.NPCShop},
+		{"stash", om.Stash},
+		{"waypoint", om.Waypoint},
+		{"mapShown", om.MapShown},
+		{"newSkills", om.NewSkills},
+		{"newStats", om.NewStats},
+		{"skillTree", om.SkillTree},
+		{"character", om.Character},
+		{"quitMenu", om.QuitMenu},
+		{"cube", om.Cube},
+		{"skillSelect", om.SkillSelect},
+		{"anvil", om.Anvil},
+		{"mercInventory", om.MercInventory},
+		{"beltRows", om.BeltRows},
+		{"questLog", om.QuestLog},
+		{"portraitsShown", om.PortraitsShown},
+		{"chatOpen", om.ChatOpen},
+		{"cinematic", om.Cinematic},
+	}
+
+	open := make([]string, 0)
+	for _, m := range menus {
+		if m.open {
+			open = append(open, m.name)
+		}
+	}
+
+	return open
+}
+
+func fromPosition(p data.Position) Position {
+	return Position{X: p.X, Y: p.Y}
+}
@@ -0,0 +1,120 @@
+package export
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/hectorgimenez/d2go/pkg/data"
+	"github.com/hectorgimenez/d2go/pkg/data/area"
+	"github.com/hectorgimenez/d2go/pkg/data/item"
+	"github.com/hectorgimenez/d2go/pkg/data/skill"
+	"github.com/hectorgimenez/d2go/pkg/data/stat"
+)
+
+func TestMarshal(t *testing.T) {
+	d := data.Data{
+		IsIngame: true,
+		PlayerUnit: data.PlayerUnit{
+			Name:      "test",
+			Class:     data.Sorceress,
+			Area:      area.RogueEncampment,
+			Stats:     stat.Stats{{ID: stat.Life, Value: 50}, {ID: stat.MaxLife, Value: 100}},
+			Skills:    map[skill.ID]skill.Points{skill.Teleport: {Level: 1}, skill.FireBolt: {Level: 20}},
+			LeftSkill: skill.FireBolt,
+		},
+	}
+	d.Game.LastGamePassword = "secret"
+
+	b, err := Marshal(d)
+	if err != nil {
+		t.Fatalf("error marshaling: %v", err)
+	}
+
+	var gs GameState
+	if err = json.Unmarshal(b, &gs); err != nil {
+		t.Fatalf("error unmarshaling: %v", err)
+	}
+	if strings.Contains(string(b), "secret") {
+		t.Error("game password is exported")
+	}
+
+	if gs.SchemaVersion != SchemaVersion {
+		t.Errorf("schema version %d, expected %d", gs.SchemaVersion, SchemaVersion)
+	}
+	if gs.Player.Class.Name != "Sorceress" {
+		t.Errorf("class name %q, expected Sorceress", gs.Player.Class.Name)
+	}
+	if gs.Player.Area.Name != area.RogueEncampment.Area().Name {
+		t.Errorf("area name %q, expected %q", gs.Player.Area.Name, area.RogueEncampment.Area().Name)
+	}
+	if gs.Player.HPPercent != 50 {
+		t.Errorf("hp percent %d, expected 50", gs.Player.HPPercent)
+	}
+	if len(gs.Player.Skills) != 2 || gs.Player.Skills[0].ID != int(skill.FireBolt) {
+		t.Errorf("skills are not sorted by ID: %+v", gs.Player.Skills)
+	}
+	if gs.Player.LeftSkill.Name != skill.Skills[skill.FireBolt].Name {
+		t.Errorf("left skill name %q, expected %q", gs.Player.LeftSkill.Name, skill.Skills[skill.FireBolt].Name)
+	}
+
+	again, _ := Marshal(d)
+	if string(again) != string(b) {
+		t.Error("output is not deterministic")
+	}
+}
+
+func TestMarshalTradeItems(t *testing.T) {
+	it := data.Item{
+		ID:         item.GetIDByName("Shako"),
+		Name:       "Shako",
+		Quality:    item.QualityUnique,
+		Identified: true,
+		Stats:      stat.Stats{{ID: stat.Strength, Value: 2}, {ID: stat.Defense, Value: 141}},
+	}
+
+	b, err := MarshalTradeItems([]data.Item{it})
+	if err != nil {
+		t.Fatalf("error marshaling: %v", err)
+	}
+
+	var items []TradeItem
+	if err = json.Unmarshal(b, &items); err != nil {
+		t.Fatalf("error unmarshaling: %v", err)
+	}
+	if len(items) != 1 {
+		t.Fatalf("%d items, expected 1", len(items))
+	}
+	if items[0].Quality != "unique" || items[0].Tier != "elite" {
+		t.Errorf("quality %q and tier %q, expected unique and elite", items[0].Quality, items[0].Tier)
+	}
+	if items[0].Defense != 141 {
+		t.Errorf("defense %d, expected 141", items[0].Defense)
+	}
+	if len(items[0].Stats) != 1 || items[0].Stats[0].Name != "strength" || items[0].Stats[0].Text != "+2 to Strength" {
+		t.Errorf("unexpected stats: %+v", items[0].Stats)
+	}
+}
+
+func TestSnapshot(t *testing.T) {
+	d := data.Data{
+		PlayerUnit: data.PlayerUnit{Name: "test", Stats: stat.Stats{{ID: stat.Level, Value: 85}}},
+		HasMerc:    true,
+		Inventory: data.Inventory{AllItems: []data.Item{
+			{UnitID: 2, Name: "Shako", Location: item.Location{LocationType: item.LocationEquipped}},
+			{UnitID: 1, Name: "Andariel's Visage", Location: item.Location{LocationType: item.LocationMercenary}},
+			{UnitID: 3, Name: "Jah", Location: item.Location{LocationType: item.LocationStash}},
+		}},
+	}
+
+	s := Snapshot(d)
+	if s.Level != 85 {
+		t.Errorf("level %d, expected 85", s.Level)
+	}
+	if len(s.Equipped) != 1 || s.Equipped[0].UnitID != 2 {
+		t.Errorf("unexpected equipped items: %+v", s.Equipped)
+	}
+	if !s.Mercenary.Hired || s.Mercenary.Unit != nil || len(s.Mercenary.Items) != 1 {
+		t.Errorf("unexpected mercenary: %+v", s.Mercenary)
+	}
+}
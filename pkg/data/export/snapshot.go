@@ -0,0 +1,60 @@
+package export
+
+import (
+	"encoding/json"
+
+	"github.com/hectorgimenez/d2go/pkg/data"
+	"github.com/hectorgimenez/d2go/pkg/data/item"
+	"github.com/hectorgimenez/d2go/pkg/data/stat"
+)
+
+// CharacterSnapshot is a character profile, as shown by armory websites. It only contains the character build and
+// progress, not the game state around it
+type CharacterSnapshot struct {
+	SchemaVersion int       `json:"schemaVersion" msgpack:"schemaVersion"`
+	Player        Player    `json:"player" msgpack:"player"`
+	Level         int       `json:"level" msgpack:"level"`
+	Experience    int       `json:"experience" msgpack:"experience"`
+	Equipped      []Item    `json:"equipped" msgpack:"equipped"`
+	Mercenary     Mercenary `json:"mercenary" msgpack:"mercenary"`
+	Quests        []Quest   `json:"quests" msgpack:"quests"`
+}
+
+type Mercenary struct {
+	Hired bool     `json:"hired" msgpack:"hired"`
+	Unit  *Monster `json:"unit,omitempty" msgpack:"unit,omitempty"` // Only if the mercenary is alive and nearby
+	Items []Item   `json:"items" msgpack:"items"`
+}
+
+// MarshalSnapshot returns the JSON representation of the character snapshot
+func MarshalSnapshot(d data.Data) ([]byte, error) {
+	return json.Marshal(Snapshot(d))
+}
+
+// Snapshot builds the character snapshot from the game data, lists are sorted the same way FromData does
+func Snapshot(d data.Data) CharacterSnapshot {
+	lvl, _ := d.PlayerUnit.FindStat(stat.Level, 0)
+	exp, _ := d.PlayerUnit.FindStat(stat.Experience, 0)
+	s := CharacterSnapshot{
+		SchemaVersion: SchemaVersion,
+		Player:        fromPlayer(d.PlayerUnit),
+		Level:         lvl.Value,
+		Experience:    exp.Value,
+		Equipped:      fromItems(d.Inventory.ByLocation(item.LocationEquipped)),
+		Mercenary: Mercenary{
+			Hired: d.HasMerc,
+			Items: fromItems(d.Inventory.ByLocation(item.LocationMercenary)),
+		},
+		Quests: fromQuests(d.Quests),
+	}
+
+	for _, m := range d.Monsters {
+		if m.IsMerc() {
+			merc := fromMonster(m)
+			s.Mercenary.Unit = &merc
+			break
+		}
+	}
+
+	return s
+}
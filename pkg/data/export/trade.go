@@ -0,0 +1,132 @@
+package export
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/hectorgimenez/d2go/pkg/data"
+	"github.com/hectorgimenez/d2go/pkg/data/item"
+	"github.com/hectorgimenez/d2go/pkg/data/stat"
+)
+
+// TradeItem is a flat item representation to publish items on trade sites. It's not the schema of any specific site,
+// it follows the conventions of this package (camelCase field names) except for quality and tier, exported by their
+// lowercase name only, and stats, named after their itemstatcost.txt entry and with the tooltip line
+type TradeItem struct {
+	Name          string      `json:"name" msgpack:"name"`
+	BaseName      string      `json:"baseName" msgpack:"baseName"`
+	Code          string      `json:"code" msgpack:"code"`
+	Type          string      `json:"type" msgpack:"type"`
+	Quality       string      `json:"quality" msgpack:"quality"`
+	Tier          string      `json:"tier" msgpack:"tier"`
+	ItemLevel     int         `json:"itemLevel" msgpack:"itemLevel"`
+	LevelReq      int         `json:"levelReq" msgpack:"levelReq"`
+	Identified    bool        `json:"identified" msgpack:"identified"`
+	Ethereal      bool        `json:"ethereal" msgpack:"ethereal"`
+	Runeword      string      `json:"runeword,omitempty" msgpack:"runeword,omitempty"`
+	Defense       int         `json:"defense,omitempty" msgpack:"defense,omitempty"`
+	Quantity      int         `json:"quantity,omitempty" msgpack:"quantity,omitempty"`
+	Sockets       int         `json:"sockets" msgpack:"sockets"`
+	SocketedItems []TradeItem `json:"socketedItems" msgpack:"socketedItems"`
+	Stats         []TradeStat `json:"stats" msgpack:"stats"`
+}
+
+type TradeStat struct {
+	Name  string `json:"name" msgpack:"name"`
+	Param int    `json:"param,omitempty" msgpack:"param,omitempty"`
+	Value int    `json:"value" msgpack:"value"`
+	Text  string `json:"text" msgpack:"text"` // Tooltip line, empty if unknown
+}
+
+var tradeQualities = map[item.Quality]string{
+	item.QualityLowQuality: "low",
+	item.QualityNormal:     "normal",
+	item.QualitySuperior:   "superior",
+	item.QualityMagic:      "magic",
+	item.QualitySet:        "set",
+	item.QualityRare:       "rare",
+	item.QualityUnique:     "unique",
+	item.QualityCrafted:    "crafted",
+}
+
+var tradeTiers = map[item.Tier]string{
+	item.TierNormal:      "normal",
+	item.TierExceptional: "exceptional",
+	item.TierElite:       "elite",
+}
+
+// Base stats already exported as their own fields, or changing during the game
+var tradeSkippedStats = map[stat.ID]bool{
+	stat.Defense:       true,
+	stat.Quantity:      true,
+	stat.NumSockets:    true,
+	stat.Durability:    true,
+	stat.MaxDurability: true,
+}
+
+// MarshalTradeItems returns the JSON array of the given items as TradeItem
+func MarshalTradeItems(items []data.Item) ([]byte, error) {
+	result := make([]TradeItem, 0, len(items))
+	for _, i := range items {
+		result = append(result, ToTradeItem(i))
+	}
+
+	return json.Marshal(result)
+}
+
+// ToTradeItem converts an item to its TradeItem representation
+func ToTradeItem(i data.Item) TradeItem {
+	desc := i.Desc()
+	it := TradeItem{
+		Name:          tradeItemName(i),
+		BaseName:      desc.Name,
+		Code:          desc.Code,
+		Type:          desc.Type,
+		Quality:       tradeQualities[i.Quality],
+		Tier:          tradeTiers[desc.Tier()],
+		ItemLevel:     i.ItemLevel,
+		LevelReq:      i.Requirements().Level,
+		Identified:    i.Identified,
+		Ethereal:      i.Ethereal,
+		Runeword:      string(i.RunewordName),
+		SocketedItems: make([]TradeItem, 0, len(i.Sockets)),
+		Stats:         make([]TradeStat, 0, len(i.Stats)),
+	}
+	if def, found := i.FindStat(stat.Defense, 0); found {
+		it.Defense = def.Value
+	}
+	if qty, found := i.FindStat(stat.Quantity, 0); found {
+		it.Quantity = qty.Value
+	}
+	if sockets, found := i.FindStat(stat.NumSockets, 0); found {
+		it.Sockets = sockets.Value
+	}
+
+	for _, s := range i.Sockets {
+		it.SocketedItems = append(it.SocketedItems, ToTradeItem(s))
+	}
+	for _, s := range fromStats(i.Stats) {
+		if tradeSkippedStats[stat.ID(s.ID)] {
+			continue
+		}
+		it.Stats = append(it.Stats, TradeStat{
+			Name:  s.Name,
+			Param: s.Layer,
+			Value: s.Value,
+			Text:  strings.TrimSpace(stat.Data{ID: stat.ID(s.ID), Value: s.Value, Layer: s.Layer}.String()),
+		})
+	}
+
+	return it
+}
+
+func tradeItemName(i data.Item) string {
+	if i.IsRuneword && i.RunewordName != "" {
+		return string(i.RunewordName)
+	}
+	if i.IdentifiedName != "" {
+		return i.IdentifiedName
+	}
+
+	return i.Desc().Name
+}
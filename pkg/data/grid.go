@@ -0,0 +1,163 @@
+package data
+
+import "github.com/hectorgimenez/d2go/pkg/data/item"
+
+// gridSizes are the width and height of each storage grid, stash tabs are all the same size
+var gridSizes = map[item.LocationType][2]int{
+	item.LocationInventory:   {10, 4},
+	item.LocationStash:       {10, 10},
+	item.LocationSharedStash: {10, 10},
+	item.LocationCube:        {3, 4},
+}
+
+// StorageGrid is the occupancy of a storage grid (inventory, stash tab or cube), Occupied is indexed by [y][x]
+type StorageGrid struct {
+	Location item.LocationType
+	Page     int
+	Width    int
+	Height   int
+	Occupied [][]bool
+}
+
+// Grid returns the occupancy of the given storage location, page is only used for the shared stash tabs (1-3).
+// Returns an empty grid for locations without a grid, like the belt or the ground
+func (i Inventory) Grid(location item.LocationType, page int) StorageGrid {
+	size := gridSizes[location]
+	g := StorageGrid{Location: location, Page: page, Width: size[0], Height: size[1]}
+	g.Occupied = make([][]bool, g.Height)
+	for y := range g.Occupied {
+		g.Occupied[y] = make([]bool, g.Width)
+	}
+
+	for _, it := range i.ByLocation(location) {
+		if location == item.LocationSharedStash && it.Location.Page != page {
+			continue
+		}
+		g.Place(it.Position, it.Desc().InventoryWidth, it.Desc().InventoryHeight)
+	}
+
+	return g
+}
+
+// FitsAt returns true if an item of the given size can be placed with its top-left corner at the given position
+func (g StorageGrid) FitsAt(p Position, width, height int) bool {
+	if p.X < 0 || p.Y < 0 || p.X+width > g.Width || p.Y+height > g.Height {
+		return false
+	}
+
+	for y := p.Y; y < p.Y+height; y++ {
+		for x := p.X; x < p.X+width; x++ {
+			if g.Occupied[y][x] {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// FindSpace returns the top-left position where an item of the given size fits, scanning by columns like the game
+// does when an item is picked up. Returns false if there is no space for that size
+func (g StorageGrid) FindSpace(width, height int) (Position, bool) {
+	for x := 0; x+width <= g.Width; x++ {
+		for y := 0; y+height <= g.Height; y++ {
+			if g.FitsAt(Position{X: x, Y: y}, width, height) {
+				return Position{X: x, Y: y}, true
+			}
+		}
+	}
+
+	return Position{}, false
+}
+
+// Fits returns true if the item fits anywhere in the grid
+func (g StorageGrid) Fits(it Item) bool {
+	_, found := g.FindSpace(it.Desc().InventoryWidth, it.Desc().InventoryHeight)
+	return found
+}
+
+// Place marks the area as occupied, it can be used to plan where several items will go before moving them.
+// Cells out of the grid are ignored
+func (g StorageGrid) Place(p Position, width, height int) {
+	for y := max(p.Y, 0); y < min(p.Y+height, g.Height); y++ {
+		for x := max(p.X, 0); x < min(p.X+width, g.Width); x++ {
+			g.Occupied[y][x] = true
+		}
+	}
+}
+
+// FreeCells returns the number of empty cells
+func (g StorageGrid) FreeCells() int {
+	free := 0
+	for _, row := range g.Occupied {
+		for _, occupied := range row {
+			if !occupied {
+				free++
+			}
+		}
+	}
+
+	return free
+}
+
+// CanPickup returns true if the item fits in the inventory, so "inventory full" can be detected before trying
+func (i Inventory) CanPickup(it Item) bool {
+	return i.Grid(item.LocationInventory, 0).Fits(it)
+}
+
+type InventoryZone string
+
+const (
+	InventoryZoneLoot  InventoryZone = "loot"
+	InventoryZoneCharm InventoryZone = "charm" // Reserved for charms and any other item kept between runs
+)
+
+// InventoryLayout marks the inventory cells reserved for charms, indexed by [y][x] like Inventory.Matrix
+type InventoryLayout [4][10]bool
+
+// Zone returns the zone of the given inventory cell, cells out of the inventory belong to the loot zone
+func (l InventoryLayout) Zone(p Position) InventoryZone {
+	if p.X >= 0 && p.Y >= 0 && p.Y < len(l) && p.X < len(l[0]) && l[p.Y][p.X] {
+		return InventoryZoneCharm
+	}
+
+	return InventoryZoneLoot
+}
+
+// overlapsCharmZone returns true if any cell of the item is in the charm zone
+func (l InventoryLayout) overlapsCharmZone(it Item) bool {
+	for y := it.Position.Y; y < it.Position.Y+it.Desc().InventoryHeight; y++ {
+		for x := it.Position.X; x < it.Position.X+it.Desc().InventoryWidth; x++ {
+			if l.Zone(Position{X: x, Y: y}) == InventoryZoneCharm {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// CharmZoneItems returns the inventory items placed (even partially) in the charm zone, they should not be stashed
+func (i Inventory) CharmZoneItems(l InventoryLayout) []Item {
+	return i.FindAllMatching(l.overlapsCharmZone, item.LocationInventory)
+}
+
+// LootZoneItems returns the inventory items placed entirely in the loot zone
+func (i Inventory) LootZoneItems(l InventoryLayout) []Item {
+	return i.FindAllMatching(func(it Item) bool { return !l.overlapsCharmZone(it) }, item.LocationInventory)
+}
+
+// LootGrid returns the inventory grid with the charm zone marked as occupied, so pickups are only planned in the loot
+// zone
+func (i Inventory) LootGrid(l InventoryLayout) StorageGrid {
+	g := i.Grid(item.LocationInventory, 0)
+	for y := range l {
+		for x := range l[y] {
+			if l[y][x] {
+				g.Occupied[y][x] = true
+			}
+		}
+	}
+
+	return g
+}
@@ -0,0 +1,76 @@
+package data
+
+// The game files don't define how close the player has to be to operate an object or use an entrance, the operate
+// range of objects.txt is not part of the generated object descriptions. These values are conservative estimates, not
+// measured in game, they can be changed by the caller if they turn out to be too short or too long
+var (
+	// ObjectInteractDistance is the distance from the edge of the object footprint the player can operate it from
+	ObjectInteractDistance = 2
+	// EntranceInteractDistance is the distance from the entrance position the player can use it from, entrances don't
+	// have a footprint
+	EntranceInteractDistance = 5
+)
+
+// Hitbox is the selectable area of a unit in screen pixels, relative to the screen position of the unit
+type Hitbox struct {
+	Left   int
+	Top    int
+	Width  int
+	Height int
+}
+
+// Center returns the point of the hitbox to click, relative to the screen position of the unit
+func (h Hitbox) Center() (int, int) {
+	return h.Left + h.Width/2, h.Top + h.Height/2
+}
+
+// Empty returns true if the unit has no selectable area defined, the unit position should be clicked instead
+func (h Hitbox) Empty() bool {
+	return h.Width == 0 || h.Height == 0
+}
+
+// InteractRange returns the maximum distance from the object position the player can operate it from
+func (o Object) InteractRange() int {
+	desc := o.Desc()
+
+	return (max(desc.SizeX, desc.SizeY)+1)/2 + ObjectInteractDistance
+}
+
+// SelectableHitbox returns the area of the object that can be hovered/clicked, from objects.txt
+func (o Object) SelectableHitbox() Hitbox {
+	desc := o.Desc()
+
+	return Hitbox{Left: desc.Left, Top: desc.Top, Width: desc.Width, Height: desc.Height}
+}
+
+// InteractRange returns the maximum distance from the entrance position the player can use it from
+func (e Entrance) InteractRange() int {
+	return EntranceInteractDistance
+}
+
+// SelectableHitbox returns the area of the entrance that can be hovered/clicked, from lvlwarp.txt
+func (e Entrance) SelectableHitbox() Hitbox {
+	desc := e.Desc()
+
+	return Hitbox{Left: desc.SelectX, Top: desc.SelectY, Width: desc.SelectDX, Height: desc.SelectDY}
+}
+
+// InInteractRange returns true if the given position is close enough to interact with the object
+func (o Object) InInteractRange(p Position) bool {
+	return inRange(o.Position, p, o.InteractRange())
+}
+
+// InInteractRange returns true if the given position is close enough to use the entrance
+func (e Entrance) InInteractRange(p Position) bool {
+	return inRange(e.Position, p, e.InteractRange())
+}
+
+func inRange(a, b Position, distance int) bool {
+	return sqDistance(a, b) <= distance*distance
+}
+
+func sqDistance(a, b Position) int {
+	dx, dy := a.X-b.X, a.Y-b.Y
+
+	return dx*dx + dy*dy
+}
@@ -25,6 +25,7 @@ type Description struct {
 	Durability        int
 	RequiredLevel     int
 	MaxSockets        int
+	Range             int // Weapon range adder, 0 for everything that is not a melee weapon
 	Type              string
 }
 
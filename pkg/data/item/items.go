@@ -3,664 +3,664 @@
 package item
 
 var Desc = map[int]Description{
-	0:   {Name: "Hand Axe", ID: 0, Code: "hax", NormalCode: "hax", UberCode: "9ha", UltraCode: "7ha", InventoryWidth: 1, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 3, MaxDamage: 6, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 28, RequiredLevel: 0, MaxSockets: 2, Type: "axe"},
-	1:   {Name: "Axe", ID: 1, Code: "axe", NormalCode: "axe", UberCode: "9ax", UltraCode: "7ax", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 4, MaxDamage: 11, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 32, RequiredDexterity: 0, Durability: 24, RequiredLevel: 0, MaxSockets: 4, Type: "axe"},
-	2:   {Name: "Double Axe", ID: 2, Code: "2ax", NormalCode: "2ax", UberCode: "92a", UltraCode: "72a", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 5, MaxDamage: 13, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 43, RequiredDexterity: 0, Durability: 24, RequiredLevel: 0, MaxSockets: 5, Type: "axe"},
-	3:   {Name: "Military Pick", ID: 3, Code: "mpi", NormalCode: "mpi", UberCode: "9mp", UltraCode: "7mp", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 7, MaxDamage: 11, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 49, RequiredDexterity: 33, Durability: 26, RequiredLevel: 0, MaxSockets: 6, Type: "axe"},
-	4:   {Name: "War Axe", ID: 4, Code: "wax", NormalCode: "wax", UberCode: "9wa", UltraCode: "7wa", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 10, MaxDamage: 18, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 67, RequiredDexterity: 0, Durability: 26, RequiredLevel: 0, MaxSockets: 6, Type: "axe"},
-	5:   {Name: "Large Axe", ID: 5, Code: "lax", NormalCode: "lax", UberCode: "9la", UltraCode: "7la", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 6, TwoHandMaxDamage: 13, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 35, RequiredDexterity: 0, Durability: 30, RequiredLevel: 0, MaxSockets: 4, Type: "axe"},
-	6:   {Name: "Broad Axe ", ID: 6, Code: "bax", NormalCode: "bax", UberCode: "9ba", UltraCode: "7ba", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 10, TwoHandMaxDamage: 18, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 48, RequiredDexterity: 0, Durability: 35, RequiredLevel: 0, MaxSockets: 5, Type: "axe"},
-	7:   {Name: "Battle Axe", ID: 7, Code: "btx", NormalCode: "btx", UberCode: "9bt", UltraCode: "7bt", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 12, TwoHandMaxDamage: 32, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 54, RequiredDexterity: 0, Durability: 40, RequiredLevel: 0, MaxSockets: 5, Type: "axe"},
-	8:   {Name: "Great Axe", ID: 8, Code: "gax", NormalCode: "gax", UberCode: "9ga", UltraCode: "7ga", InventoryWidth: 2, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 9, TwoHandMaxDamage: 30, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 63, RequiredDexterity: 39, Durability: 50, RequiredLevel: 0, MaxSockets: 6, Type: "axe"},
-	9:   {Name: "Giant Axe", ID: 9, Code: "gix", NormalCode: "gix", UberCode: "9gi", UltraCode: "7gi", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 22, TwoHandMaxDamage: 45, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 70, RequiredDexterity: 0, Durability: 50, RequiredLevel: 0, MaxSockets: 6, Type: "axe"},
-	10:  {Name: "Wand", ID: 10, Code: "wnd", NormalCode: "wnd", UberCode: "9wn", UltraCode: "7wn", InventoryWidth: 1, InventoryHeight: 2, MinDefense: 0, MaxDefense: 0, MinDamage: 2, MaxDamage: 4, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 15, RequiredLevel: 0, MaxSockets: 1, Type: "wand"},
-	11:  {Name: "Yew Wand", ID: 11, Code: "ywn", NormalCode: "ywn", UberCode: "9yw", UltraCode: "7yw", InventoryWidth: 1, InventoryHeight: 2, MinDefense: 0, MaxDefense: 0, MinDamage: 2, MaxDamage: 8, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 15, RequiredLevel: 0, MaxSockets: 1, Type: "wand"},
-	12:  {Name: "Bone Wand", ID: 12, Code: "bwn", NormalCode: "bwn", UberCode: "9bw", UltraCode: "7bw", InventoryWidth: 1, InventoryHeight: 2, MinDefense: 0, MaxDefense: 0, MinDamage: 3, MaxDamage: 7, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -20, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 15, RequiredLevel: 0, MaxSockets: 2, Type: "wand"},
-	13:  {Name: "Grim Wand", ID: 13, Code: "gwn", NormalCode: "gwn", UberCode: "9gw", UltraCode: "7gw", InventoryWidth: 1, InventoryHeight: 2, MinDefense: 0, MaxDefense: 0, MinDamage: 5, MaxDamage: 11, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 15, RequiredLevel: 0, MaxSockets: 2, Type: "wand"},
-	14:  {Name: "Club", ID: 14, Code: "clb", NormalCode: "clb", UberCode: "9cl", UltraCode: "7cl", InventoryWidth: 1, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 1, MaxDamage: 6, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 24, RequiredLevel: 0, MaxSockets: 2, Type: "club"},
-	15:  {Name: "Scepter", ID: 15, Code: "scp", NormalCode: "scp", UberCode: "9sc", UltraCode: "7sc", InventoryWidth: 1, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 6, MaxDamage: 11, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 25, RequiredDexterity: 0, Durability: 50, RequiredLevel: 0, MaxSockets: 2, Type: "scep"},
-	16:  {Name: "Grand Scepter", ID: 16, Code: "gsc", NormalCode: "gsc", UberCode: "9qs", UltraCode: "7qs", InventoryWidth: 1, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 8, MaxDamage: 18, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 37, RequiredDexterity: 0, Durability: 60, RequiredLevel: 0, MaxSockets: 3, Type: "scep"},
-	17:  {Name: "War Scepter", ID: 17, Code: "wsp", NormalCode: "wsp", UberCode: "9ws", UltraCode: "7ws", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 10, MaxDamage: 17, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 55, RequiredDexterity: 0, Durability: 70, RequiredLevel: 0, MaxSockets: 5, Type: "scep"},
-	18:  {Name: "Spiked Club", ID: 18, Code: "spc", NormalCode: "spc", UberCode: "9sp", UltraCode: "7sp", InventoryWidth: 1, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 5, MaxDamage: 8, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 36, RequiredLevel: 0, MaxSockets: 2, Type: "club"},
-	19:  {Name: "Mace", ID: 19, Code: "mac", NormalCode: "mac", UberCode: "9ma", UltraCode: "7ma", InventoryWidth: 1, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 3, MaxDamage: 10, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 27, RequiredDexterity: 0, Durability: 60, RequiredLevel: 0, MaxSockets: 2, Type: "mace"},
-	20:  {Name: "Morning Star", ID: 20, Code: "mst", NormalCode: "mst", UberCode: "9mt", UltraCode: "7mt", InventoryWidth: 1, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 7, MaxDamage: 16, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 36, RequiredDexterity: 0, Durability: 72, RequiredLevel: 0, MaxSockets: 3, Type: "mace"},
-	21:  {Name: "Flail", ID: 21, Code: "fla", NormalCode: "fla", UberCode: "9fl", UltraCode: "7fl", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 1, MaxDamage: 24, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 41, RequiredDexterity: 35, Durability: 30, RequiredLevel: 0, MaxSockets: 5, Type: "mace"},
-	22:  {Name: "War Hammer", ID: 22, Code: "whm", NormalCode: "whm", UberCode: "9wh", UltraCode: "7wh", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 19, MaxDamage: 29, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 20, StrengthBonus: 110, DexterityBonus: 0, RequiredStrength: 53, RequiredDexterity: 0, Durability: 55, RequiredLevel: 0, MaxSockets: 4, Type: "hamm"},
-	23:  {Name: "Maul", ID: 23, Code: "mau", NormalCode: "mau", UberCode: "9m9", UltraCode: "7m7", InventoryWidth: 2, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 30, TwoHandMaxDamage: 43, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 10, StrengthBonus: 110, DexterityBonus: 0, RequiredStrength: 69, RequiredDexterity: 0, Durability: 60, RequiredLevel: 0, MaxSockets: 6, Type: "hamm"},
-	24:  {Name: "Great Maul", ID: 24, Code: "gma", NormalCode: "gma", UberCode: "9gm", UltraCode: "7gm", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 38, TwoHandMaxDamage: 58, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 20, StrengthBonus: 110, DexterityBonus: 0, RequiredStrength: 99, RequiredDexterity: 0, Durability: 60, RequiredLevel: 0, MaxSockets: 6, Type: "hamm"},
-	25:  {Name: "Short Sword", ID: 25, Code: "ssd", NormalCode: "ssd", UberCode: "9ss", UltraCode: "7ss", InventoryWidth: 1, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 2, MaxDamage: 7, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 24, RequiredLevel: 0, MaxSockets: 2, Type: "swor"},
-	26:  {Name: "Scimitar", ID: 26, Code: "scm", NormalCode: "scm", UberCode: "9sm", UltraCode: "7sm", InventoryWidth: 1, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 2, MaxDamage: 6, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -20, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 21, Durability: 22, RequiredLevel: 0, MaxSockets: 2, Type: "swor"},
-	27:  {Name: "Saber", ID: 27, Code: "sbr", NormalCode: "sbr", UberCode: "9sb", UltraCode: "7sb", InventoryWidth: 1, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 3, MaxDamage: 8, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 25, RequiredDexterity: 25, Durability: 32, RequiredLevel: 0, MaxSockets: 2, Type: "swor"},
-	28:  {Name: "Falchion", ID: 28, Code: "flc", NormalCode: "flc", UberCode: "9fc", UltraCode: "7fc", InventoryWidth: 1, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 9, MaxDamage: 17, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 20, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 33, RequiredDexterity: 0, Durability: 32, RequiredLevel: 0, MaxSockets: 2, Type: "swor"},
-	29:  {Name: "Crystal Sword", ID: 29, Code: "crs", NormalCode: "crs", UberCode: "9cr", UltraCode: "7cr", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 5, MaxDamage: 15, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 43, RequiredDexterity: 0, Durability: 20, RequiredLevel: 0, MaxSockets: 6, Type: "swor"},
-	30:  {Name: "Broad Sword", ID: 30, Code: "bsd", NormalCode: "bsd", UberCode: "9bs", UltraCode: "7bs", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 7, MaxDamage: 14, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 48, RequiredDexterity: 0, Durability: 32, RequiredLevel: 0, MaxSockets: 4, Type: "swor"},
-	31:  {Name: "Long Sword ", ID: 31, Code: "lsd", NormalCode: "lsd", UberCode: "9ls", UltraCode: "7ls", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 3, MaxDamage: 19, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 55, RequiredDexterity: 39, Durability: 44, RequiredLevel: 0, MaxSockets: 4, Type: "swor"},
-	32:  {Name: "War Sword", ID: 32, Code: "wsd", NormalCode: "wsd", UberCode: "9wd", UltraCode: "7wd", InventoryWidth: 1, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 8, MaxDamage: 20, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 71, RequiredDexterity: 45, Durability: 44, RequiredLevel: 0, MaxSockets: 3, Type: "swor"},
-	33:  {Name: "Two-Handed Sword", ID: 33, Code: "2hs", NormalCode: "2hs", UberCode: "92h", UltraCode: "72h", InventoryWidth: 1, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 2, MaxDamage: 9, TwoHandMinDamage: 8, TwoHandMaxDamage: 17, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 35, RequiredDexterity: 27, Durability: 44, RequiredLevel: 0, MaxSockets: 3, Type: "swor"},
-	34:  {Name: "Claymore", ID: 34, Code: "clm", NormalCode: "clm", UberCode: "9cm", UltraCode: "7cm", InventoryWidth: 1, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 5, MaxDamage: 12, TwoHandMinDamage: 13, TwoHandMaxDamage: 30, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 47, RequiredDexterity: 0, Durability: 50, RequiredLevel: 0, MaxSockets: 4, Type: "swor"},
-	35:  {Name: "Giant Sword", ID: 35, Code: "gis", NormalCode: "gis", UberCode: "9gs", UltraCode: "7gs", InventoryWidth: 1, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 3, MaxDamage: 16, TwoHandMinDamage: 9, TwoHandMaxDamage: 28, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 56, RequiredDexterity: 34, Durability: 50, RequiredLevel: 0, MaxSockets: 4, Type: "swor"},
-	36:  {Name: "Bastard Sword", ID: 36, Code: "bsw", NormalCode: "bsw", UberCode: "9b9", UltraCode: "7b7", InventoryWidth: 1, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 7, MaxDamage: 19, TwoHandMinDamage: 20, TwoHandMaxDamage: 28, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 62, RequiredDexterity: 0, Durability: 40, RequiredLevel: 0, MaxSockets: 4, Type: "swor"},
-	37:  {Name: "Flamberge", ID: 37, Code: "flb", NormalCode: "flb", UberCode: "9fb", UltraCode: "7fb", InventoryWidth: 2, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 9, MaxDamage: 15, TwoHandMinDamage: 13, TwoHandMaxDamage: 26, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 70, RequiredDexterity: 49, Durability: 50, RequiredLevel: 0, MaxSockets: 5, Type: "swor"},
-	38:  {Name: "Great Sword", ID: 38, Code: "gsd", NormalCode: "gsd", UberCode: "9gd", UltraCode: "7gd", InventoryWidth: 2, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 12, MaxDamage: 20, TwoHandMinDamage: 25, TwoHandMaxDamage: 42, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 100, RequiredDexterity: 60, Durability: 50, RequiredLevel: 0, MaxSockets: 6, Type: "swor"},
-	39:  {Name: "Dagger", ID: 39, Code: "dgr", NormalCode: "dgr", UberCode: "9dg", UltraCode: "7dg", InventoryWidth: 1, InventoryHeight: 2, MinDefense: 0, MaxDefense: 0, MinDamage: 1, MaxDamage: 4, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -20, StrengthBonus: 75, DexterityBonus: 75, RequiredStrength: 0, RequiredDexterity: 0, Durability: 16, RequiredLevel: 0, MaxSockets: 1, Type: "knif"},
-	40:  {Name: "Dirk", ID: 40, Code: "dir", NormalCode: "dir", UberCode: "9di", UltraCode: "7di", InventoryWidth: 1, InventoryHeight: 2, MinDefense: 0, MaxDefense: 0, MinDamage: 3, MaxDamage: 9, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 75, DexterityBonus: 75, RequiredStrength: 0, RequiredDexterity: 25, Durability: 20, RequiredLevel: 0, MaxSockets: 1, Type: "knif"},
-	41:  {Name: "Kriss", ID: 41, Code: "kri", NormalCode: "kri", UberCode: "9kr", UltraCode: "7kr", InventoryWidth: 1, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 2, MaxDamage: 11, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -20, StrengthBonus: 75, DexterityBonus: 75, RequiredStrength: 0, RequiredDexterity: 45, Durability: 24, RequiredLevel: 0, MaxSockets: 3, Type: "knif"},
-	42:  {Name: "Blade", ID: 42, Code: "bld", NormalCode: "bld", UberCode: "9bl", UltraCode: "7bl", InventoryWidth: 1, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 4, MaxDamage: 15, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -10, StrengthBonus: 75, DexterityBonus: 75, RequiredStrength: 35, RequiredDexterity: 51, Durability: 24, RequiredLevel: 0, MaxSockets: 2, Type: "knif"},
-	43:  {Name: "Throwing Knife", ID: 43, Code: "tkf", NormalCode: "tkf", UberCode: "9tk", UltraCode: "7tk", InventoryWidth: 1, InventoryHeight: 2, MinDefense: 0, MaxDefense: 0, MinDamage: 2, MaxDamage: 3, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 4, MaxMissileDamage: 9, Speed: 0, StrengthBonus: 75, DexterityBonus: 75, RequiredStrength: 0, RequiredDexterity: 21, Durability: 4, RequiredLevel: 0, MaxSockets: 0, Type: "tkni"},
-	44:  {Name: "Throwing Axe", ID: 44, Code: "tax", NormalCode: "tax", UberCode: "9ta", UltraCode: "7ta", InventoryWidth: 1, InventoryHeight: 2, MinDefense: 0, MaxDefense: 0, MinDamage: 4, MaxDamage: 7, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 8, MaxMissileDamage: 12, Speed: 10, StrengthBonus: 75, DexterityBonus: 75, RequiredStrength: 0, RequiredDexterity: 40, Durability: 6, RequiredLevel: 0, MaxSockets: 0, Type: "taxe"},
-	45:  {Name: "Balanced Knife", ID: 45, Code: "bkf", NormalCode: "bkf", UberCode: "9bk", UltraCode: "7bk", InventoryWidth: 1, InventoryHeight: 2, MinDefense: 0, MaxDefense: 0, MinDamage: 1, MaxDamage: 8, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 6, MaxMissileDamage: 11, Speed: -20, StrengthBonus: 75, DexterityBonus: 75, RequiredStrength: 0, RequiredDexterity: 51, Durability: 8, RequiredLevel: 0, MaxSockets: 0, Type: "tkni"},
-	46:  {Name: "Balanced Axe", ID: 46, Code: "bal", NormalCode: "bal", UberCode: "9b8", UltraCode: "7b8", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 5, MaxDamage: 10, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 12, MaxMissileDamage: 15, Speed: -10, StrengthBonus: 75, DexterityBonus: 75, RequiredStrength: 0, RequiredDexterity: 57, Durability: 10, RequiredLevel: 0, MaxSockets: 0, Type: "taxe"},
-	47:  {Name: "Javelin", ID: 47, Code: "jav", NormalCode: "jav", UberCode: "9ja", UltraCode: "7ja", InventoryWidth: 1, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 1, MaxDamage: 5, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 6, MaxMissileDamage: 14, Speed: -10, StrengthBonus: 75, DexterityBonus: 75, RequiredStrength: 0, RequiredDexterity: 0, Durability: 2, RequiredLevel: 0, MaxSockets: 0, Type: "jave"},
-	48:  {Name: "Pilum", ID: 48, Code: "pil", NormalCode: "pil", UberCode: "9pi", UltraCode: "7pi", InventoryWidth: 1, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 4, MaxDamage: 9, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 7, MaxMissileDamage: 20, Speed: 0, StrengthBonus: 75, DexterityBonus: 75, RequiredStrength: 0, RequiredDexterity: 45, Durability: 3, RequiredLevel: 0, MaxSockets: 0, Type: "jave"},
-	49:  {Name: "Short Spear", ID: 49, Code: "ssp", NormalCode: "ssp", UberCode: "9s9", UltraCode: "7s7", InventoryWidth: 1, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 2, MaxDamage: 13, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 10, MaxMissileDamage: 22, Speed: 10, StrengthBonus: 75, DexterityBonus: 75, RequiredStrength: 40, RequiredDexterity: 40, Durability: 4, RequiredLevel: 0, MaxSockets: 0, Type: "jave"},
-	50:  {Name: "Glaive", ID: 50, Code: "glv", NormalCode: "glv", UberCode: "9gl", UltraCode: "7gl", InventoryWidth: 1, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 5, MaxDamage: 17, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 16, MaxMissileDamage: 22, Speed: 20, StrengthBonus: 75, DexterityBonus: 75, RequiredStrength: 52, RequiredDexterity: 35, Durability: 5, RequiredLevel: 0, MaxSockets: 0, Type: "jave"},
-	51:  {Name: "Throwing Spear", ID: 51, Code: "tsp", NormalCode: "tsp", UberCode: "9ts", UltraCode: "7ts", InventoryWidth: 1, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 5, MaxDamage: 15, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 12, MaxMissileDamage: 30, Speed: -10, StrengthBonus: 75, DexterityBonus: 75, RequiredStrength: 0, RequiredDexterity: 65, Durability: 6, RequiredLevel: 0, MaxSockets: 0, Type: "jave"},
-	52:  {Name: "Spear", ID: 52, Code: "spr", NormalCode: "spr", UberCode: "9sr", UltraCode: "7sr", InventoryWidth: 2, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 3, TwoHandMaxDamage: 15, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 20, Durability: 30, RequiredLevel: 0, MaxSockets: 3, Type: "spea"},
-	53:  {Name: "Trident", ID: 53, Code: "tri", NormalCode: "tri", UberCode: "9tr", UltraCode: "7tr", InventoryWidth: 2, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 9, TwoHandMaxDamage: 15, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 38, RequiredDexterity: 24, Durability: 35, RequiredLevel: 0, MaxSockets: 4, Type: "spea"},
-	54:  {Name: "Brandistock", ID: 54, Code: "brn", NormalCode: "brn", UberCode: "9br", UltraCode: "7br", InventoryWidth: 2, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 7, TwoHandMaxDamage: 17, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -20, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 40, RequiredDexterity: 50, Durability: 28, RequiredLevel: 0, MaxSockets: 5, Type: "spea"},
-	55:  {Name: "Spetum", ID: 55, Code: "spt", NormalCode: "spt", UberCode: "9st", UltraCode: "7st", InventoryWidth: 2, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 15, TwoHandMaxDamage: 23, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 54, RequiredDexterity: 35, Durability: 28, RequiredLevel: 0, MaxSockets: 6, Type: "spea"},
-	56:  {Name: "Pike", ID: 56, Code: "pik", NormalCode: "pik", UberCode: "9p9", UltraCode: "7p7", InventoryWidth: 2, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 14, TwoHandMaxDamage: 63, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 20, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 60, RequiredDexterity: 45, Durability: 25, RequiredLevel: 0, MaxSockets: 6, Type: "spea"},
-	57:  {Name: "Bardiche", ID: 57, Code: "bar", NormalCode: "bar", UberCode: "9b7", UltraCode: "7o7", InventoryWidth: 2, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 1, TwoHandMaxDamage: 27, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 40, RequiredDexterity: 0, Durability: 50, RequiredLevel: 0, MaxSockets: 3, Type: "pole"},
-	58:  {Name: "Voulge", ID: 58, Code: "vou", NormalCode: "vou", UberCode: "9vo", UltraCode: "7vo", InventoryWidth: 2, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 6, TwoHandMaxDamage: 21, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 50, RequiredDexterity: 0, Durability: 50, RequiredLevel: 0, MaxSockets: 4, Type: "pole"},
-	59:  {Name: "Scythe", ID: 59, Code: "scy", NormalCode: "scy", UberCode: "9s8", UltraCode: "7s8", InventoryWidth: 2, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 8, TwoHandMaxDamage: 20, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 41, RequiredDexterity: 41, Durability: 65, RequiredLevel: 0, MaxSockets: 5, Type: "pole"},
-	60:  {Name: "Poleaxe", ID: 60, Code: "pax", NormalCode: "pax", UberCode: "9pa", UltraCode: "7pa", InventoryWidth: 2, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 18, TwoHandMaxDamage: 39, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 62, RequiredDexterity: 0, Durability: 65, RequiredLevel: 0, MaxSockets: 5, Type: "pole"},
-	61:  {Name: "Halberd", ID: 61, Code: "hal", NormalCode: "hal", UberCode: "9h9", UltraCode: "7h7", InventoryWidth: 2, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 12, TwoHandMaxDamage: 45, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 75, RequiredDexterity: 47, Durability: 55, RequiredLevel: 0, MaxSockets: 6, Type: "pole"},
-	62:  {Name: "War Scythe", ID: 62, Code: "wsc", NormalCode: "wsc", UberCode: "9wc", UltraCode: "7wc", InventoryWidth: 2, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 15, TwoHandMaxDamage: 36, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 80, RequiredDexterity: 80, Durability: 55, RequiredLevel: 0, MaxSockets: 6, Type: "pole"},
-	63:  {Name: "Short Staff", ID: 63, Code: "sst", NormalCode: "sst", UberCode: "8ss", UltraCode: "6ss", InventoryWidth: 1, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 1, TwoHandMaxDamage: 5, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 20, RequiredLevel: 0, MaxSockets: 2, Type: "staf"},
-	64:  {Name: "Long Staff", ID: 64, Code: "lst", NormalCode: "lst", UberCode: "8ls", UltraCode: "6ls", InventoryWidth: 1, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 2, TwoHandMaxDamage: 8, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 30, RequiredLevel: 0, MaxSockets: 3, Type: "staf"},
-	65:  {Name: "Gnarled Staff", ID: 65, Code: "cst", NormalCode: "cst", UberCode: "8cs", UltraCode: "6cs", InventoryWidth: 1, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 4, TwoHandMaxDamage: 12, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 35, RequiredLevel: 0, MaxSockets: 4, Type: "staf"},
-	66:  {Name: "Battle Staff", ID: 66, Code: "bst", NormalCode: "bst", UberCode: "8bs", UltraCode: "6bs", InventoryWidth: 1, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 6, TwoHandMaxDamage: 13, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 40, RequiredLevel: 0, MaxSockets: 4, Type: "staf"},
-	67:  {Name: "War Staff", ID: 67, Code: "wst", NormalCode: "wst", UberCode: "8ws", UltraCode: "6ws", InventoryWidth: 2, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 12, TwoHandMaxDamage: 28, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 20, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 50, RequiredLevel: 0, MaxSockets: 6, Type: "staf"},
-	68:  {Name: "Short Bow", ID: 68, Code: "sbw", NormalCode: "sbw", UberCode: "8sb", UltraCode: "6sb", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 1, TwoHandMaxDamage: 4, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 5, StrengthBonus: 0, DexterityBonus: 100, RequiredStrength: 0, RequiredDexterity: 15, Durability: 20, RequiredLevel: 0, MaxSockets: 3, Type: "bow"},
-	69:  {Name: "Hunter's Bow", ID: 69, Code: "hbw", NormalCode: "hbw", UberCode: "8hb", UltraCode: "6hb", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 2, TwoHandMaxDamage: 6, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -10, StrengthBonus: 0, DexterityBonus: 100, RequiredStrength: 0, RequiredDexterity: 28, Durability: 32, RequiredLevel: 0, MaxSockets: 4, Type: "bow"},
-	70:  {Name: "Long Bow", ID: 70, Code: "lbw", NormalCode: "lbw", UberCode: "8lb", UltraCode: "6lb", InventoryWidth: 2, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 3, TwoHandMaxDamage: 10, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 100, RequiredStrength: 22, RequiredDexterity: 19, Durability: 28, RequiredLevel: 0, MaxSockets: 5, Type: "bow"},
-	71:  {Name: "Composite Bow", ID: 71, Code: "cbw", NormalCode: "cbw", UberCode: "8cb", UltraCode: "6cb", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 4, TwoHandMaxDamage: 8, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -10, StrengthBonus: 0, DexterityBonus: 100, RequiredStrength: 25, RequiredDexterity: 35, Durability: 36, RequiredLevel: 0, MaxSockets: 4, Type: "bow"},
-	72:  {Name: "Short Battle Bow", ID: 72, Code: "sbb", NormalCode: "sbb", UberCode: "8s8", UltraCode: "6s7", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 5, TwoHandMaxDamage: 11, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 100, RequiredStrength: 30, RequiredDexterity: 40, Durability: 40, RequiredLevel: 0, MaxSockets: 5, Type: "bow"},
-	73:  {Name: "Long Battle Bow", ID: 73, Code: "lbb", NormalCode: "lbb", UberCode: "8l8", UltraCode: "6l7", InventoryWidth: 2, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 3, TwoHandMaxDamage: 18, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 10, StrengthBonus: 0, DexterityBonus: 100, RequiredStrength: 40, RequiredDexterity: 50, Durability: 44, RequiredLevel: 0, MaxSockets: 6, Type: "bow"},
-	74:  {Name: "Short War Bow", ID: 74, Code: "swb", NormalCode: "swb", UberCode: "8sw", UltraCode: "6sw", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 6, TwoHandMaxDamage: 14, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 100, RequiredStrength: 35, RequiredDexterity: 55, Durability: 48, RequiredLevel: 0, MaxSockets: 5, Type: "bow"},
-	75:  {Name: "Long War Bow", ID: 75, Code: "lwb", NormalCode: "lwb", UberCode: "8lw", UltraCode: "6lw", InventoryWidth: 2, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 3, TwoHandMaxDamage: 23, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 10, StrengthBonus: 0, DexterityBonus: 100, RequiredStrength: 50, RequiredDexterity: 65, Durability: 55, RequiredLevel: 0, MaxSockets: 6, Type: "bow"},
-	76:  {Name: "Light Crossbow", ID: 76, Code: "lxb", NormalCode: "lxb", UberCode: "8lx", UltraCode: "6lx", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 6, TwoHandMaxDamage: 9, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -10, StrengthBonus: 0, DexterityBonus: 100, RequiredStrength: 21, RequiredDexterity: 27, Durability: 30, RequiredLevel: 0, MaxSockets: 3, Type: "xbow"},
-	77:  {Name: "Crossbow", ID: 77, Code: "mxb", NormalCode: "mxb", UberCode: "8mx", UltraCode: "6mx", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 9, TwoHandMaxDamage: 16, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 100, RequiredStrength: 40, RequiredDexterity: 33, Durability: 40, RequiredLevel: 0, MaxSockets: 4, Type: "xbow"},
-	78:  {Name: "Heavy Crossbow", ID: 78, Code: "hxb", NormalCode: "hxb", UberCode: "8hx", UltraCode: "6hx", InventoryWidth: 2, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 14, TwoHandMaxDamage: 26, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 10, StrengthBonus: 0, DexterityBonus: 100, RequiredStrength: 60, RequiredDexterity: 40, Durability: 50, RequiredLevel: 0, MaxSockets: 6, Type: "xbow"},
-	79:  {Name: "Repeating Crossbow", ID: 79, Code: "rxb", NormalCode: "rxb", UberCode: "8rx", UltraCode: "6rx", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 6, TwoHandMaxDamage: 12, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -40, StrengthBonus: 0, DexterityBonus: 100, RequiredStrength: 40, RequiredDexterity: 50, Durability: 40, RequiredLevel: 0, MaxSockets: 5, Type: "xbow"},
-	80:  {Name: "Rancid Gas Potion", ID: 80, Code: "gps", NormalCode: "gps", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 1, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 2, RequiredLevel: 24, MaxSockets: 0, Type: "tpot"},
-	81:  {Name: "Oil Potion", ID: 81, Code: "ops", NormalCode: "ops", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 1, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 2, RequiredLevel: 20, MaxSockets: 0, Type: "tpot"},
-	82:  {Name: "Choking Gas Potion", ID: 82, Code: "gpm", NormalCode: "gpm", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 1, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 2, RequiredLevel: 16, MaxSockets: 0, Type: "tpot"},
-	83:  {Name: "Exploding Potion", ID: 83, Code: "opm", NormalCode: "opm", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 1, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 2, RequiredLevel: 12, MaxSockets: 0, Type: "tpot"},
-	84:  {Name: "Strangling Gas Potion", ID: 84, Code: "gpl", NormalCode: "gpl", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 1, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 2, RequiredLevel: 6, MaxSockets: 0, Type: "tpot"},
-	85:  {Name: "Fulminating Potion", ID: 85, Code: "opl", NormalCode: "opl", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 1, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 2, RequiredLevel: 0, MaxSockets: 0, Type: "tpot"},
-	86:  {Name: "Decoy Gidbinn", ID: 86, Code: "d33", NormalCode: "d33", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 2, MinDefense: 0, MaxDefense: 0, MinDamage: 1, MaxDamage: 2, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -20, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 15, RequiredDexterity: 20, Durability: 10, RequiredLevel: 0, MaxSockets: 0, Type: "knif"},
-	87:  {Name: "The Gidbinn", ID: 87, Code: "g33", NormalCode: "g33", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 2, MinDefense: 0, MaxDefense: 0, MinDamage: 3, MaxDamage: 7, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -20, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 15, RequiredDexterity: 25, Durability: 30, RequiredLevel: 0, MaxSockets: 0, Type: "knif"},
-	88:  {Name: "Wirt's Leg", ID: 88, Code: "leg", NormalCode: "leg", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 2, MaxDamage: 8, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 66, RequiredLevel: 0, MaxSockets: 3, Type: "club"},
-	89:  {Name: "Horadric Malus", ID: 89, Code: "hdm", NormalCode: "hdm", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 2, MinDefense: 0, MaxDefense: 0, MinDamage: 6, MaxDamage: 15, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 20, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 15, RequiredDexterity: 15, Durability: 55, RequiredLevel: 0, MaxSockets: 0, Type: "hamm"},
-	90:  {Name: "Hellforge Hammer", ID: 90, Code: "hfh", NormalCode: "hfh", UberCode: "", UltraCode: "", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 6, MaxDamage: 15, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 55, RequiredLevel: 0, MaxSockets: 5, Type: "hamm"},
-	91:  {Name: "Horadric Staff", ID: 91, Code: "hst", NormalCode: "hst", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 12, TwoHandMaxDamage: 20, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 30, RequiredDexterity: 0, Durability: 50, RequiredLevel: 0, MaxSockets: 0, Type: "staf"},
-	92:  {Name: "Staff of Kings", ID: 92, Code: "msf", NormalCode: "msf", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 10, TwoHandMaxDamage: 15, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 25, RequiredDexterity: 0, Durability: 45, RequiredLevel: 0, MaxSockets: 0, Type: "staf"},
-	93:  {Name: "Hatchet", ID: 93, Code: "9ha", NormalCode: "hax", UberCode: "9ha", UltraCode: "7ha", InventoryWidth: 1, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 10, MaxDamage: 21, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 25, RequiredDexterity: 25, Durability: 28, RequiredLevel: 19, MaxSockets: 2, Type: "axe"},
-	94:  {Name: "Cleaver", ID: 94, Code: "9ax", NormalCode: "axe", UberCode: "9ax", UltraCode: "7ax", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 10, MaxDamage: 33, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 68, RequiredDexterity: 0, Durability: 24, RequiredLevel: 22, MaxSockets: 4, Type: "axe"},
-	95:  {Name: "Twin Axe", ID: 95, Code: "92a", NormalCode: "2ax", UberCode: "92a", UltraCode: "72a", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 13, MaxDamage: 38, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 85, RequiredDexterity: 0, Durability: 24, RequiredLevel: 25, MaxSockets: 5, Type: "axe"},
-	96:  {Name: "Crowbill", ID: 96, Code: "9mp", NormalCode: "mpi", UberCode: "9mp", UltraCode: "7mp", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 14, MaxDamage: 34, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 94, RequiredDexterity: 70, Durability: 26, RequiredLevel: 25, MaxSockets: 6, Type: "axe"},
-	97:  {Name: "Naga", ID: 97, Code: "9wa", NormalCode: "wax", UberCode: "9wa", UltraCode: "7wa", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 16, MaxDamage: 45, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 121, RequiredDexterity: 0, Durability: 26, RequiredLevel: 25, MaxSockets: 6, Type: "axe"},
-	98:  {Name: "Military Axe", ID: 98, Code: "9la", NormalCode: "lax", UberCode: "9la", UltraCode: "7la", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 14, TwoHandMaxDamage: 34, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 73, RequiredDexterity: 0, Durability: 30, RequiredLevel: 22, MaxSockets: 4, Type: "axe"},
-	99:  {Name: "Bearded Axe ", ID: 99, Code: "9ba", NormalCode: "bax", UberCode: "9ba", UltraCode: "7ba", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 21, TwoHandMaxDamage: 49, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 92, RequiredDexterity: 0, Durability: 35, RequiredLevel: 25, MaxSockets: 5, Type: "axe"},
-	100: {Name: "Tabar", ID: 100, Code: "9bt", NormalCode: "btx", UberCode: "9bt", UltraCode: "7bt", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 24, TwoHandMaxDamage: 77, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 101, RequiredDexterity: 0, Durability: 40, RequiredLevel: 25, MaxSockets: 5, Type: "axe"},
-	101: {Name: "Gothic Axe", ID: 101, Code: "9ga", NormalCode: "gax", UberCode: "9ga", UltraCode: "7ga", InventoryWidth: 2, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 18, TwoHandMaxDamage: 70, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 115, RequiredDexterity: 79, Durability: 50, RequiredLevel: 25, MaxSockets: 6, Type: "axe"},
-	102: {Name: "Ancient Axe", ID: 102, Code: "9gi", NormalCode: "gix", UberCode: "9gi", UltraCode: "7gi", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 43, TwoHandMaxDamage: 85, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 125, RequiredDexterity: 0, Durability: 50, RequiredLevel: 25, MaxSockets: 6, Type: "axe"},
-	103: {Name: "Burnt Wand", ID: 103, Code: "9wn", NormalCode: "wnd", UberCode: "9wn", UltraCode: "7wn", InventoryWidth: 1, InventoryHeight: 2, MinDefense: 0, MaxDefense: 0, MinDamage: 8, MaxDamage: 18, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 25, RequiredDexterity: 0, Durability: 15, RequiredLevel: 19, MaxSockets: 1, Type: "wand"},
-	104: {Name: "Petrified Wand", ID: 104, Code: "9yw", NormalCode: "ywn", UberCode: "9yw", UltraCode: "7yw", InventoryWidth: 1, InventoryHeight: 2, MinDefense: 0, MaxDefense: 0, MinDamage: 8, MaxDamage: 24, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 25, RequiredDexterity: 0, Durability: 15, RequiredLevel: 25, MaxSockets: 2, Type: "wand"},
-	105: {Name: "Tomb Wand", ID: 105, Code: "9bw", NormalCode: "bwn", UberCode: "9bw", UltraCode: "7bw", InventoryWidth: 1, InventoryHeight: 2, MinDefense: 0, MaxDefense: 0, MinDamage: 10, MaxDamage: 22, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -20, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 25, RequiredDexterity: 0, Durability: 15, RequiredLevel: 25, MaxSockets: 2, Type: "wand"},
-	106: {Name: "Grave Wand", ID: 106, Code: "9gw", NormalCode: "gwn", UberCode: "9gw", UltraCode: "7gw", InventoryWidth: 1, InventoryHeight: 2, MinDefense: 0, MaxDefense: 0, MinDamage: 13, MaxDamage: 29, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 25, RequiredDexterity: 0, Durability: 15, RequiredLevel: 25, MaxSockets: 2, Type: "wand"},
-	107: {Name: "Cudgel", ID: 107, Code: "9cl", NormalCode: "clb", UberCode: "9cl", UltraCode: "7cl", InventoryWidth: 1, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 6, MaxDamage: 21, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 25, RequiredDexterity: 0, Durability: 24, RequiredLevel: 18, MaxSockets: 2, Type: "club"},
-	108: {Name: "Rune Scepter", ID: 108, Code: "9sc", NormalCode: "scp", UberCode: "9sc", UltraCode: "7sc", InventoryWidth: 1, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 13, MaxDamage: 24, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 58, RequiredDexterity: 0, Durability: 50, RequiredLevel: 19, MaxSockets: 2, Type: "scep"},
-	109: {Name: "Holy Water Sprinkler", ID: 109, Code: "9qs", NormalCode: "gsc", UberCode: "9qs", UltraCode: "7qs", InventoryWidth: 1, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 14, MaxDamage: 36, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 76, RequiredDexterity: 0, Durability: 60, RequiredLevel: 25, MaxSockets: 3, Type: "scep"},
-	110: {Name: "Divine Scepter", ID: 110, Code: "9ws", NormalCode: "wsp", UberCode: "9ws", UltraCode: "7ws", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 16, MaxDamage: 38, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 103, RequiredDexterity: 0, Durability: 70, RequiredLevel: 25, MaxSockets: 5, Type: "scep"},
-	111: {Name: "Barbed Club", ID: 111, Code: "9sp", NormalCode: "spc", UberCode: "9sp", UltraCode: "7sp", InventoryWidth: 1, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 13, MaxDamage: 25, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 30, RequiredDexterity: 0, Durability: 36, RequiredLevel: 20, MaxSockets: 3, Type: "club"},
-	112: {Name: "Flanged Mace", ID: 112, Code: "9ma", NormalCode: "mac", UberCode: "9ma", UltraCode: "7ma", InventoryWidth: 1, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 15, MaxDamage: 23, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 61, RequiredDexterity: 0, Durability: 60, RequiredLevel: 23, MaxSockets: 2, Type: "mace"},
-	113: {Name: "Jagged Star", ID: 113, Code: "9mt", NormalCode: "mst", UberCode: "9mt", UltraCode: "7mt", InventoryWidth: 1, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 20, MaxDamage: 31, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 74, RequiredDexterity: 0, Durability: 72, RequiredLevel: 25, MaxSockets: 3, Type: "mace"},
-	114: {Name: "Knout", ID: 114, Code: "9fl", NormalCode: "fla", UberCode: "9fl", UltraCode: "7fl", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 13, MaxDamage: 35, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 82, RequiredDexterity: 73, Durability: 30, RequiredLevel: 25, MaxSockets: 5, Type: "mace"},
-	115: {Name: "Battle Hammer", ID: 115, Code: "9wh", NormalCode: "whm", UberCode: "9wh", UltraCode: "7wh", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 35, MaxDamage: 58, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 20, StrengthBonus: 110, DexterityBonus: 0, RequiredStrength: 100, RequiredDexterity: 0, Durability: 55, RequiredLevel: 25, MaxSockets: 4, Type: "hamm"},
-	116: {Name: "War Club", ID: 116, Code: "9m9", NormalCode: "mau", UberCode: "9m9", UltraCode: "7m7", InventoryWidth: 2, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 53, TwoHandMaxDamage: 78, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 10, StrengthBonus: 110, DexterityBonus: 0, RequiredStrength: 124, RequiredDexterity: 0, Durability: 60, RequiredLevel: 25, MaxSockets: 6, Type: "hamm"},
-	117: {Name: "Martel de Fer", ID: 117, Code: "9gm", NormalCode: "gma", UberCode: "9gm", UltraCode: "7gm", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 61, TwoHandMaxDamage: 99, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 20, StrengthBonus: 110, DexterityBonus: 0, RequiredStrength: 169, RequiredDexterity: 0, Durability: 60, RequiredLevel: 25, MaxSockets: 6, Type: "hamm"},
-	118: {Name: "Gladius", ID: 118, Code: "9ss", NormalCode: "ssd", UberCode: "9ss", UltraCode: "7ss", InventoryWidth: 1, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 8, MaxDamage: 22, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 25, RequiredDexterity: 0, Durability: 24, RequiredLevel: 18, MaxSockets: 2, Type: "swor"},
-	119: {Name: "Cutlass", ID: 119, Code: "9sm", NormalCode: "scm", UberCode: "9sm", UltraCode: "7sm", InventoryWidth: 1, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 8, MaxDamage: 21, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -30, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 25, RequiredDexterity: 52, Durability: 22, RequiredLevel: 25, MaxSockets: 2, Type: "swor"},
-	120: {Name: "Shamshir", ID: 120, Code: "9sb", NormalCode: "sbr", UberCode: "9sb", UltraCode: "7sb", InventoryWidth: 1, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 10, MaxDamage: 24, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 58, RequiredDexterity: 58, Durability: 32, RequiredLevel: 23, MaxSockets: 2, Type: "swor"},
-	121: {Name: "Tulwar", ID: 121, Code: "9fc", NormalCode: "flc", UberCode: "9fc", UltraCode: "7fc", InventoryWidth: 1, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 16, MaxDamage: 35, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 20, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 70, RequiredDexterity: 42, Durability: 32, RequiredLevel: 25, MaxSockets: 2, Type: "swor"},
-	122: {Name: "Dimensional Blade", ID: 122, Code: "9cr", NormalCode: "crs", UberCode: "9cr", UltraCode: "7cr", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 13, MaxDamage: 35, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 85, RequiredDexterity: 60, Durability: 20, RequiredLevel: 25, MaxSockets: 6, Type: "swor"},
-	123: {Name: "Battle Sword", ID: 123, Code: "9bs", NormalCode: "bsd", UberCode: "9bs", UltraCode: "7bs", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 16, MaxDamage: 34, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 92, RequiredDexterity: 43, Durability: 32, RequiredLevel: 25, MaxSockets: 4, Type: "swor"},
-	124: {Name: "Rune Sword", ID: 124, Code: "9ls", NormalCode: "lsd", UberCode: "9ls", UltraCode: "7ls", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 10, MaxDamage: 42, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 103, RequiredDexterity: 79, Durability: 44, RequiredLevel: 25, MaxSockets: 4, Type: "swor"},
-	125: {Name: "Ancient Sword", ID: 125, Code: "9wd", NormalCode: "wsd", UberCode: "9wd", UltraCode: "7wd", InventoryWidth: 1, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 18, MaxDamage: 43, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 127, RequiredDexterity: 88, Durability: 44, RequiredLevel: 25, MaxSockets: 3, Type: "swor"},
-	126: {Name: "Espandon", ID: 126, Code: "92h", NormalCode: "2hs", UberCode: "92h", UltraCode: "72h", InventoryWidth: 1, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 8, MaxDamage: 26, TwoHandMinDamage: 18, TwoHandMaxDamage: 40, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 73, RequiredDexterity: 61, Durability: 44, RequiredLevel: 25, MaxSockets: 3, Type: "swor"},
-	127: {Name: "Dacian Falx", ID: 127, Code: "9cm", NormalCode: "clm", UberCode: "9cm", UltraCode: "7cm", InventoryWidth: 1, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 13, MaxDamage: 30, TwoHandMinDamage: 26, TwoHandMaxDamage: 61, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 91, RequiredDexterity: 20, Durability: 50, RequiredLevel: 25, MaxSockets: 4, Type: "swor"},
-	128: {Name: "Tusk Sword", ID: 128, Code: "9gs", NormalCode: "gis", UberCode: "9gs", UltraCode: "7gs", InventoryWidth: 1, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 10, MaxDamage: 37, TwoHandMinDamage: 19, TwoHandMaxDamage: 58, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 104, RequiredDexterity: 71, Durability: 50, RequiredLevel: 25, MaxSockets: 4, Type: "swor"},
-	129: {Name: "Gothic Sword", ID: 129, Code: "9b9", NormalCode: "bsw", UberCode: "9b9", UltraCode: "7b7", InventoryWidth: 1, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 14, MaxDamage: 40, TwoHandMinDamage: 39, TwoHandMaxDamage: 60, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 113, RequiredDexterity: 20, Durability: 40, RequiredLevel: 25, MaxSockets: 4, Type: "swor"},
-	130: {Name: "Zweihander", ID: 130, Code: "9fb", NormalCode: "flb", UberCode: "9fb", UltraCode: "7fb", InventoryWidth: 2, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 19, MaxDamage: 35, TwoHandMinDamage: 29, TwoHandMaxDamage: 54, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 125, RequiredDexterity: 94, Durability: 50, RequiredLevel: 25, MaxSockets: 5, Type: "swor"},
-	131: {Name: "Executioner Sword", ID: 131, Code: "9gd", NormalCode: "gsd", UberCode: "9gd", UltraCode: "7gd", InventoryWidth: 2, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 24, MaxDamage: 40, TwoHandMinDamage: 47, TwoHandMaxDamage: 80, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 170, RequiredDexterity: 110, Durability: 50, RequiredLevel: 25, MaxSockets: 6, Type: "swor"},
-	132: {Name: "Poignard", ID: 132, Code: "9dg", NormalCode: "dgr", UberCode: "9dg", UltraCode: "7dg", InventoryWidth: 1, InventoryHeight: 2, MinDefense: 0, MaxDefense: 0, MinDamage: 6, MaxDamage: 18, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -20, StrengthBonus: 75, DexterityBonus: 75, RequiredStrength: 25, RequiredDexterity: 0, Durability: 16, RequiredLevel: 19, MaxSockets: 1, Type: "knif"},
-	133: {Name: "Rondel", ID: 133, Code: "9di", NormalCode: "dir", UberCode: "9di", UltraCode: "7di", InventoryWidth: 1, InventoryHeight: 2, MinDefense: 0, MaxDefense: 0, MinDamage: 10, MaxDamage: 26, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 75, DexterityBonus: 75, RequiredStrength: 25, RequiredDexterity: 58, Durability: 20, RequiredLevel: 24, MaxSockets: 1, Type: "knif"},
-	134: {Name: "Cinquedeas", ID: 134, Code: "9kr", NormalCode: "kri", UberCode: "9kr", UltraCode: "7kr", InventoryWidth: 1, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 15, MaxDamage: 31, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -20, StrengthBonus: 75, DexterityBonus: 75, RequiredStrength: 25, RequiredDexterity: 88, Durability: 24, RequiredLevel: 25, MaxSockets: 3, Type: "knif"},
-	135: {Name: "Stilleto", ID: 135, Code: "9bl", NormalCode: "bld", UberCode: "9bl", UltraCode: "7bl", InventoryWidth: 1, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 19, MaxDamage: 36, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -10, StrengthBonus: 75, DexterityBonus: 75, RequiredStrength: 47, RequiredDexterity: 97, Durability: 24, RequiredLevel: 25, MaxSockets: 2, Type: "knif"},
-	136: {Name: "Battle Dart", ID: 136, Code: "9tk", NormalCode: "tkf", UberCode: "9tk", UltraCode: "7tk", InventoryWidth: 1, InventoryHeight: 2, MinDefense: 0, MaxDefense: 0, MinDamage: 8, MaxDamage: 16, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 11, MaxMissileDamage: 24, Speed: 0, StrengthBonus: 75, DexterityBonus: 75, RequiredStrength: 25, RequiredDexterity: 52, Durability: 6, RequiredLevel: 19, MaxSockets: 0, Type: "tkni"},
-	137: {Name: "Francisca", ID: 137, Code: "9ta", NormalCode: "tax", UberCode: "9ta", UltraCode: "7ta", InventoryWidth: 1, InventoryHeight: 2, MinDefense: 0, MaxDefense: 0, MinDamage: 11, MaxDamage: 22, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 18, MaxMissileDamage: 33, Speed: 10, StrengthBonus: 75, DexterityBonus: 75, RequiredStrength: 25, RequiredDexterity: 80, Durability: 15, RequiredLevel: 22, MaxSockets: 0, Type: "taxe"},
-	138: {Name: "War Dart", ID: 138, Code: "9bk", NormalCode: "bkf", UberCode: "9bk", UltraCode: "7bk", InventoryWidth: 1, InventoryHeight: 2, MinDefense: 0, MaxDefense: 0, MinDamage: 6, MaxDamage: 24, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 14, MaxMissileDamage: 27, Speed: -20, StrengthBonus: 75, DexterityBonus: 75, RequiredStrength: 25, RequiredDexterity: 97, Durability: 20, RequiredLevel: 25, MaxSockets: 0, Type: "tkni"},
-	139: {Name: "Hurlbat", ID: 139, Code: "9b8", NormalCode: "bal", UberCode: "9b8", UltraCode: "7b8", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 13, MaxDamage: 27, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 24, MaxMissileDamage: 34, Speed: -10, StrengthBonus: 75, DexterityBonus: 75, RequiredStrength: 25, RequiredDexterity: 106, Durability: 16, RequiredLevel: 25, MaxSockets: 0, Type: "taxe"},
-	140: {Name: "War Javelin", ID: 140, Code: "9ja", NormalCode: "jav", UberCode: "9ja", UltraCode: "7ja", InventoryWidth: 1, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 6, MaxDamage: 19, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 14, MaxMissileDamage: 32, Speed: -10, StrengthBonus: 75, DexterityBonus: 75, RequiredStrength: 25, RequiredDexterity: 25, Durability: 10, RequiredLevel: 18, MaxSockets: 0, Type: "jave"},
-	141: {Name: "Great Pilum", ID: 141, Code: "9pi", NormalCode: "pil", UberCode: "9pi", UltraCode: "7pi", InventoryWidth: 1, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 11, MaxDamage: 26, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 16, MaxMissileDamage: 42, Speed: 0, StrengthBonus: 75, DexterityBonus: 75, RequiredStrength: 25, RequiredDexterity: 88, Durability: 12, RequiredLevel: 25, MaxSockets: 0, Type: "jave"},
-	142: {Name: "Simbilan", ID: 142, Code: "9s9", NormalCode: "ssp", UberCode: "9s9", UltraCode: "7s7", InventoryWidth: 1, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 8, MaxDamage: 32, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 27, MaxMissileDamage: 50, Speed: 10, StrengthBonus: 75, DexterityBonus: 75, RequiredStrength: 80, RequiredDexterity: 80, Durability: 14, RequiredLevel: 25, MaxSockets: 0, Type: "jave"},
-	143: {Name: "Spiculum", ID: 143, Code: "9gl", NormalCode: "glv", UberCode: "9gl", UltraCode: "7gl", InventoryWidth: 1, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 13, MaxDamage: 38, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 32, MaxMissileDamage: 60, Speed: 20, StrengthBonus: 75, DexterityBonus: 75, RequiredStrength: 98, RequiredDexterity: 73, Durability: 16, RequiredLevel: 25, MaxSockets: 0, Type: "jave"},
-	144: {Name: "Harpoon", ID: 144, Code: "9ts", NormalCode: "tsp", UberCode: "9ts", UltraCode: "7ts", InventoryWidth: 1, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 13, MaxDamage: 35, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 18, MaxMissileDamage: 54, Speed: -10, StrengthBonus: 75, DexterityBonus: 75, RequiredStrength: 25, RequiredDexterity: 118, Durability: 18, RequiredLevel: 25, MaxSockets: 0, Type: "jave"},
-	145: {Name: "War Spear", ID: 145, Code: "9sr", NormalCode: "spr", UberCode: "9sr", UltraCode: "7sr", InventoryWidth: 2, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 10, TwoHandMaxDamage: 37, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 25, RequiredDexterity: 25, Durability: 30, RequiredLevel: 21, MaxSockets: 3, Type: "spea"},
-	146: {Name: "Fuscina", ID: 146, Code: "9tr", NormalCode: "tri", UberCode: "9tr", UltraCode: "7tr", InventoryWidth: 2, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 19, TwoHandMaxDamage: 37, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 77, RequiredDexterity: 25, Durability: 35, RequiredLevel: 24, MaxSockets: 4, Type: "spea"},
-	147: {Name: "War Fork", ID: 147, Code: "9br", NormalCode: "brn", UberCode: "9br", UltraCode: "7br", InventoryWidth: 2, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 16, TwoHandMaxDamage: 40, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -20, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 80, RequiredDexterity: 95, Durability: 28, RequiredLevel: 25, MaxSockets: 5, Type: "spea"},
-	148: {Name: "Yari", ID: 148, Code: "9st", NormalCode: "spt", UberCode: "9st", UltraCode: "7st", InventoryWidth: 2, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 29, TwoHandMaxDamage: 59, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 101, RequiredDexterity: 0, Durability: 28, RequiredLevel: 25, MaxSockets: 6, Type: "spea"},
-	149: {Name: "Lance", ID: 149, Code: "9p9", NormalCode: "pik", UberCode: "9p9", UltraCode: "7p7", InventoryWidth: 2, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 27, TwoHandMaxDamage: 114, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 20, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 110, RequiredDexterity: 88, Durability: 25, RequiredLevel: 25, MaxSockets: 6, Type: "spea"},
-	150: {Name: "Lochaber Axe", ID: 150, Code: "9b7", NormalCode: "bar", UberCode: "9b7", UltraCode: "7o7", InventoryWidth: 2, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 6, TwoHandMaxDamage: 58, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 80, RequiredDexterity: 0, Durability: 50, RequiredLevel: 21, MaxSockets: 3, Type: "pole"},
-	151: {Name: "Bill", ID: 151, Code: "9vo", NormalCode: "vou", UberCode: "9vo", UltraCode: "7vo", InventoryWidth: 2, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 14, TwoHandMaxDamage: 53, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 95, RequiredDexterity: 0, Durability: 50, RequiredLevel: 25, MaxSockets: 4, Type: "pole"},
-	152: {Name: "Battle Scythe", ID: 152, Code: "9s8", NormalCode: "scy", UberCode: "9s8", UltraCode: "7s8", InventoryWidth: 2, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 18, TwoHandMaxDamage: 45, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 82, RequiredDexterity: 82, Durability: 65, RequiredLevel: 25, MaxSockets: 5, Type: "pole"},
-	153: {Name: "Partizan", ID: 153, Code: "9pa", NormalCode: "pax", UberCode: "9pa", UltraCode: "7pa", InventoryWidth: 2, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 34, TwoHandMaxDamage: 75, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 113, RequiredDexterity: 67, Durability: 65, RequiredLevel: 23, MaxSockets: 5, Type: "pole"},
-	154: {Name: "Bec-de-Corbin", ID: 154, Code: "9h9", NormalCode: "hal", UberCode: "9h9", UltraCode: "7h7", InventoryWidth: 2, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 13, TwoHandMaxDamage: 85, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 133, RequiredDexterity: 91, Durability: 55, RequiredLevel: 25, MaxSockets: 6, Type: "pole"},
-	155: {Name: "Grim Scythe", ID: 155, Code: "9wc", NormalCode: "wsc", UberCode: "9wc", UltraCode: "7wc", InventoryWidth: 2, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 30, TwoHandMaxDamage: 70, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 140, RequiredDexterity: 140, Durability: 55, RequiredLevel: 25, MaxSockets: 6, Type: "pole"},
-	156: {Name: "Jo Staff", ID: 156, Code: "8ss", NormalCode: "sst", UberCode: "8ss", UltraCode: "6ss", InventoryWidth: 1, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 6, TwoHandMaxDamage: 21, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 25, RequiredDexterity: 0, Durability: 20, RequiredLevel: 18, MaxSockets: 2, Type: "staf"},
-	157: {Name: "Quarterstaff", ID: 157, Code: "8ls", NormalCode: "lst", UberCode: "8ls", UltraCode: "6ls", InventoryWidth: 1, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 8, TwoHandMaxDamage: 26, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 25, RequiredDexterity: 0, Durability: 30, RequiredLevel: 23, MaxSockets: 3, Type: "staf"},
-	158: {Name: "Cedar Staff", ID: 158, Code: "8cs", NormalCode: "cst", UberCode: "8cs", UltraCode: "6cs", InventoryWidth: 1, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 11, TwoHandMaxDamage: 32, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 25, RequiredDexterity: 0, Durability: 35, RequiredLevel: 25, MaxSockets: 4, Type: "staf"},
-	159: {Name: "Gothic Staff", ID: 159, Code: "8bs", NormalCode: "bst", UberCode: "8bs", UltraCode: "6bs", InventoryWidth: 1, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 14, TwoHandMaxDamage: 34, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 25, RequiredDexterity: 0, Durability: 40, RequiredLevel: 25, MaxSockets: 4, Type: "staf"},
-	160: {Name: "Rune Staff", ID: 160, Code: "8ws", NormalCode: "wst", UberCode: "8ws", UltraCode: "6ws", InventoryWidth: 2, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 24, TwoHandMaxDamage: 58, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 20, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 25, RequiredDexterity: 0, Durability: 50, RequiredLevel: 25, MaxSockets: 6, Type: "staf"},
-	161: {Name: "Edge Bow", ID: 161, Code: "8sb", NormalCode: "sbw", UberCode: "8sb", UltraCode: "6sb", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 6, TwoHandMaxDamage: 19, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 5, StrengthBonus: 0, DexterityBonus: 100, RequiredStrength: 25, RequiredDexterity: 43, Durability: 20, RequiredLevel: 18, MaxSockets: 3, Type: "bow"},
-	162: {Name: "Razor Bow", ID: 162, Code: "8hb", NormalCode: "hbw", UberCode: "8hb", UltraCode: "6hb", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 8, TwoHandMaxDamage: 22, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -10, StrengthBonus: 0, DexterityBonus: 100, RequiredStrength: 25, RequiredDexterity: 62, Durability: 32, RequiredLevel: 21, MaxSockets: 4, Type: "bow"},
-	163: {Name: "Cedar Bow", ID: 163, Code: "8lb", NormalCode: "lbw", UberCode: "8lb", UltraCode: "6lb", InventoryWidth: 2, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 10, TwoHandMaxDamage: 29, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 100, RequiredStrength: 53, RequiredDexterity: 49, Durability: 28, RequiredLevel: 23, MaxSockets: 5, Type: "bow"},
-	164: {Name: "Double Bow", ID: 164, Code: "8cb", NormalCode: "cbw", UberCode: "8cb", UltraCode: "6cb", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 11, TwoHandMaxDamage: 26, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -10, StrengthBonus: 0, DexterityBonus: 100, RequiredStrength: 58, RequiredDexterity: 73, Durability: 36, RequiredLevel: 25, MaxSockets: 4, Type: "bow"},
-	165: {Name: "Short Siege Bow", ID: 165, Code: "8s8", NormalCode: "sbb", UberCode: "8s8", UltraCode: "6s7", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 13, TwoHandMaxDamage: 30, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 100, RequiredStrength: 65, RequiredDexterity: 80, Durability: 40, RequiredLevel: 25, MaxSockets: 5, Type: "bow"},
-	166: {Name: "Long Siege Bow", ID: 166, Code: "8l8", NormalCode: "lbb", UberCode: "8l8", UltraCode: "6l7", InventoryWidth: 2, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 10, TwoHandMaxDamage: 42, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 10, StrengthBonus: 0, DexterityBonus: 100, RequiredStrength: 80, RequiredDexterity: 95, Durability: 44, RequiredLevel: 25, MaxSockets: 6, Type: "bow"},
-	167: {Name: "Rune Bow", ID: 167, Code: "8sw", NormalCode: "swb", UberCode: "8sw", UltraCode: "6sw", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 14, TwoHandMaxDamage: 35, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 100, RequiredStrength: 73, RequiredDexterity: 103, Durability: 48, RequiredLevel: 25, MaxSockets: 5, Type: "bow"},
-	168: {Name: "Gothic Bow", ID: 168, Code: "8lw", NormalCode: "lwb", UberCode: "8lw", UltraCode: "6lw", InventoryWidth: 2, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 10, TwoHandMaxDamage: 50, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 10, StrengthBonus: 0, DexterityBonus: 100, RequiredStrength: 95, RequiredDexterity: 118, Durability: 55, RequiredLevel: 25, MaxSockets: 6, Type: "bow"},
-	169: {Name: "Arbalest", ID: 169, Code: "8lx", NormalCode: "lxb", UberCode: "8lx", UltraCode: "6lx", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 14, TwoHandMaxDamage: 27, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -10, StrengthBonus: 0, DexterityBonus: 100, RequiredStrength: 52, RequiredDexterity: 61, Durability: 30, RequiredLevel: 22, MaxSockets: 3, Type: "xbow"},
-	170: {Name: "Siege Crossbow", ID: 170, Code: "8mx", NormalCode: "mxb", UberCode: "8mx", UltraCode: "6mx", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 20, TwoHandMaxDamage: 42, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 100, RequiredStrength: 80, RequiredDexterity: 70, Durability: 40, RequiredLevel: 25, MaxSockets: 4, Type: "xbow"},
-	171: {Name: "Ballista", ID: 171, Code: "8hx", NormalCode: "hxb", UberCode: "8hx", UltraCode: "6hx", InventoryWidth: 2, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 33, TwoHandMaxDamage: 55, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 10, StrengthBonus: 0, DexterityBonus: 100, RequiredStrength: 110, RequiredDexterity: 80, Durability: 50, RequiredLevel: 25, MaxSockets: 6, Type: "xbow"},
-	172: {Name: "Chu-Ko-Nu", ID: 172, Code: "8rx", NormalCode: "rxb", UberCode: "8rx", UltraCode: "6rx", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 14, TwoHandMaxDamage: 32, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -60, StrengthBonus: 0, DexterityBonus: 100, RequiredStrength: 80, RequiredDexterity: 95, Durability: 40, RequiredLevel: 25, MaxSockets: 5, Type: "xbow"},
-	173: {Name: "Khalim's Flail", ID: 173, Code: "qf1", NormalCode: "fla", UberCode: "", UltraCode: "", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 1, MaxDamage: 15, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 41, RequiredDexterity: 35, Durability: 30, RequiredLevel: 0, MaxSockets: 3, Type: "mace"},
-	174: {Name: "Khalim's Will", ID: 174, Code: "qf2", NormalCode: "fla", UberCode: "", UltraCode: "", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 1, MaxDamage: 15, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 30, RequiredLevel: 0, MaxSockets: 3, Type: "mace"},
-	175: {Name: "Katar", ID: 175, Code: "ktr", NormalCode: "ktr", UberCode: "9ar", UltraCode: "7ar", InventoryWidth: 1, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 4, MaxDamage: 7, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -10, StrengthBonus: 75, DexterityBonus: 75, RequiredStrength: 20, RequiredDexterity: 20, Durability: 48, RequiredLevel: 0, MaxSockets: 2, Type: "h2h"},
-	176: {Name: "Wrist Blade", ID: 176, Code: "wrb", NormalCode: "wrb", UberCode: "9wb", UltraCode: "7wb", InventoryWidth: 1, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 5, MaxDamage: 9, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 75, DexterityBonus: 75, RequiredStrength: 33, RequiredDexterity: 33, Durability: 52, RequiredLevel: 0, MaxSockets: 2, Type: "h2h"},
-	177: {Name: "Hatchet Hands", ID: 177, Code: "axf", NormalCode: "axf", UberCode: "9xf", UltraCode: "7xf", InventoryWidth: 1, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 2, MaxDamage: 15, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 10, StrengthBonus: 75, DexterityBonus: 75, RequiredStrength: 37, RequiredDexterity: 37, Durability: 56, RequiredLevel: 0, MaxSockets: 2, Type: "h2h"},
-	178: {Name: "Cestus", ID: 178, Code: "ces", NormalCode: "ces", UberCode: "9cs", UltraCode: "7cs", InventoryWidth: 1, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 7, MaxDamage: 15, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 75, DexterityBonus: 75, RequiredStrength: 42, RequiredDexterity: 42, Durability: 72, RequiredLevel: 0, MaxSockets: 2, Type: "h2h"},
-	179: {Name: "Claws", ID: 179, Code: "clw", NormalCode: "clw", UberCode: "9lw", UltraCode: "7lw", InventoryWidth: 1, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 8, MaxDamage: 15, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -10, StrengthBonus: 75, DexterityBonus: 75, RequiredStrength: 46, RequiredDexterity: 46, Durability: 64, RequiredLevel: 0, MaxSockets: 3, Type: "h2h"},
-	180: {Name: "Blade Talons", ID: 180, Code: "btl", NormalCode: "btl", UberCode: "9tw", UltraCode: "7tw", InventoryWidth: 1, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 10, MaxDamage: 14, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -20, StrengthBonus: 75, DexterityBonus: 75, RequiredStrength: 50, RequiredDexterity: 50, Durability: 69, RequiredLevel: 0, MaxSockets: 3, Type: "h2h"},
-	181: {Name: "Scissors Katar", ID: 181, Code: "skr", NormalCode: "skr", UberCode: "9qr", UltraCode: "7qr", InventoryWidth: 1, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 9, MaxDamage: 17, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -10, StrengthBonus: 75, DexterityBonus: 75, RequiredStrength: 55, RequiredDexterity: 55, Durability: 68, RequiredLevel: 0, MaxSockets: 3, Type: "h2h"},
-	182: {Name: "Quhab", ID: 182, Code: "9ar", NormalCode: "ktr", UberCode: "9ar", UltraCode: "7ar", InventoryWidth: 1, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 11, MaxDamage: 24, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 75, DexterityBonus: 75, RequiredStrength: 57, RequiredDexterity: 57, Durability: 48, RequiredLevel: 21, MaxSockets: 3, Type: "h2h"},
-	183: {Name: "Wrist Spike", ID: 183, Code: "9wb", NormalCode: "wrb", UberCode: "9wb", UltraCode: "7wb", InventoryWidth: 1, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 13, MaxDamage: 27, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -10, StrengthBonus: 75, DexterityBonus: 75, RequiredStrength: 66, RequiredDexterity: 66, Durability: 56, RequiredLevel: 24, MaxSockets: 2, Type: "h2h"},
-	184: {Name: "Fascia", ID: 184, Code: "9xf", NormalCode: "axf", UberCode: "9xf", UltraCode: "7xf", InventoryWidth: 1, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 8, MaxDamage: 37, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 10, StrengthBonus: 75, DexterityBonus: 75, RequiredStrength: 69, RequiredDexterity: 69, Durability: 64, RequiredLevel: 27, MaxSockets: 2, Type: "h2h"},
-	185: {Name: "Hand Scythe", ID: 185, Code: "9cs", NormalCode: "ces", UberCode: "9cs", UltraCode: "7cs", InventoryWidth: 1, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 16, MaxDamage: 37, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -10, StrengthBonus: 75, DexterityBonus: 75, RequiredStrength: 73, RequiredDexterity: 73, Durability: 72, RequiredLevel: 30, MaxSockets: 2, Type: "h2h2"},
-	186: {Name: "Greater Claws", ID: 186, Code: "9lw", NormalCode: "clw", UberCode: "9lw", UltraCode: "7lw", InventoryWidth: 1, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 18, MaxDamage: 37, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -20, StrengthBonus: 75, DexterityBonus: 75, RequiredStrength: 76, RequiredDexterity: 76, Durability: 52, RequiredLevel: 33, MaxSockets: 3, Type: "h2h2"},
-	187: {Name: "Greater Talons", ID: 187, Code: "9tw", NormalCode: "btl", UberCode: "9tw", UltraCode: "7tw", InventoryWidth: 1, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 21, MaxDamage: 35, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -30, StrengthBonus: 75, DexterityBonus: 75, RequiredStrength: 79, RequiredDexterity: 79, Durability: 69, RequiredLevel: 37, MaxSockets: 3, Type: "h2h2"},
-	188: {Name: "Scissors Quhab", ID: 188, Code: "9qr", NormalCode: "skr", UberCode: "9qr", UltraCode: "7qr", InventoryWidth: 1, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 19, MaxDamage: 40, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 75, DexterityBonus: 75, RequiredStrength: 82, RequiredDexterity: 82, Durability: 68, RequiredLevel: 40, MaxSockets: 3, Type: "h2h2"},
-	189: {Name: "Suwayyah", ID: 189, Code: "7ar", NormalCode: "ktr", UberCode: "9ar", UltraCode: "7ar", InventoryWidth: 1, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 39, MaxDamage: 52, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 75, DexterityBonus: 75, RequiredStrength: 99, RequiredDexterity: 99, Durability: 48, RequiredLevel: 44, MaxSockets: 3, Type: "h2h2"},
-	190: {Name: "Wrist Sword", ID: 190, Code: "7wb", NormalCode: "wrb", UberCode: "9wb", UltraCode: "7wb", InventoryWidth: 1, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 34, MaxDamage: 45, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -10, StrengthBonus: 75, DexterityBonus: 75, RequiredStrength: 105, RequiredDexterity: 105, Durability: 56, RequiredLevel: 46, MaxSockets: 3, Type: "h2h2"},
-	191: {Name: "War Fist", ID: 191, Code: "7xf", NormalCode: "axf", UberCode: "9xf", UltraCode: "7xf", InventoryWidth: 1, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 44, MaxDamage: 53, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 10, StrengthBonus: 75, DexterityBonus: 75, RequiredStrength: 108, RequiredDexterity: 108, Durability: 64, RequiredLevel: 51, MaxSockets: 2, Type: "h2h2"},
-	192: {Name: "Battle Cestus", ID: 192, Code: "7cs", NormalCode: "ces", UberCode: "9cs", UltraCode: "7cs", InventoryWidth: 1, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 36, MaxDamage: 42, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -10, StrengthBonus: 75, DexterityBonus: 75, RequiredStrength: 110, RequiredDexterity: 110, Durability: 72, RequiredLevel: 54, MaxSockets: 2, Type: "h2h2"},
-	193: {Name: "Feral Claws", ID: 193, Code: "7lw", NormalCode: "clw", UberCode: "9lw", UltraCode: "7lw", InventoryWidth: 1, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 22, MaxDamage: 53, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -20, StrengthBonus: 75, DexterityBonus: 75, RequiredStrength: 113, RequiredDexterity: 113, Durability: 52, RequiredLevel: 58, MaxSockets: 3, Type: "h2h2"},
-	194: {Name: "Runic Talons", ID: 194, Code: "7tw", NormalCode: "btl", UberCode: "9tw", UltraCode: "7tw", InventoryWidth: 1, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 24, MaxDamage: 44, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -30, StrengthBonus: 75, DexterityBonus: 75, RequiredStrength: 115, RequiredDexterity: 115, Durability: 69, RequiredLevel: 60, MaxSockets: 3, Type: "h2h2"},
-	195: {Name: "Scissors Suwayyah", ID: 195, Code: "7qr", NormalCode: "skr", UberCode: "9qr", UltraCode: "7qr", InventoryWidth: 1, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 40, MaxDamage: 51, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 75, DexterityBonus: 75, RequiredStrength: 118, RequiredDexterity: 118, Durability: 68, RequiredLevel: 64, MaxSockets: 3, Type: "h2h2"},
-	196: {Name: "Tomahawk", ID: 196, Code: "7ha", NormalCode: "hax", UberCode: "9ha", UltraCode: "7ha", InventoryWidth: 1, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 33, MaxDamage: 58, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 125, RequiredDexterity: 67, Durability: 28, RequiredLevel: 40, MaxSockets: 2, Type: "axe"},
-	197: {Name: "Small Crescent", ID: 197, Code: "7ax", NormalCode: "axe", UberCode: "9ax", UltraCode: "7ax", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 38, MaxDamage: 60, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 115, RequiredDexterity: 83, Durability: 24, RequiredLevel: 45, MaxSockets: 4, Type: "axe"},
-	198: {Name: "Ettin Axe", ID: 198, Code: "72a", NormalCode: "2ax", UberCode: "92a", UltraCode: "72a", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 33, MaxDamage: 66, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 145, RequiredDexterity: 45, Durability: 24, RequiredLevel: 52, MaxSockets: 5, Type: "axe"},
-	199: {Name: "War Spike", ID: 199, Code: "7mp", NormalCode: "mpi", UberCode: "9mp", UltraCode: "7mp", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 30, MaxDamage: 48, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 133, RequiredDexterity: 54, Durability: 26, RequiredLevel: 59, MaxSockets: 6, Type: "axe"},
-	200: {Name: "Berserker Axe", ID: 200, Code: "7wa", NormalCode: "wax", UberCode: "9wa", UltraCode: "7wa", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 24, MaxDamage: 71, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 138, RequiredDexterity: 59, Durability: 26, RequiredLevel: 64, MaxSockets: 6, Type: "axe"},
-	201: {Name: "Feral Axe", ID: 201, Code: "7la", NormalCode: "lax", UberCode: "9la", UltraCode: "7la", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 25, TwoHandMaxDamage: 123, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -15, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 196, RequiredDexterity: 0, Durability: 30, RequiredLevel: 42, MaxSockets: 4, Type: "axe"},
-	202: {Name: "Silver-edged Axe", ID: 202, Code: "7ba", NormalCode: "bax", UberCode: "9ba", UltraCode: "7ba", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 62, TwoHandMaxDamage: 110, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 166, RequiredDexterity: 65, Durability: 35, RequiredLevel: 48, MaxSockets: 5, Type: "axe"},
-	203: {Name: "Decapitator", ID: 203, Code: "7bt", NormalCode: "btx", UberCode: "9bt", UltraCode: "7bt", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 49, TwoHandMaxDamage: 137, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 189, RequiredDexterity: 33, Durability: 40, RequiredLevel: 54, MaxSockets: 5, Type: "axe"},
-	204: {Name: "Champion Axe", ID: 204, Code: "7ga", NormalCode: "gax", UberCode: "9ga", UltraCode: "7ga", InventoryWidth: 2, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 59, TwoHandMaxDamage: 94, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 167, RequiredDexterity: 59, Durability: 50, RequiredLevel: 61, MaxSockets: 6, Type: "axe"},
-	205: {Name: "Glorious Axe", ID: 205, Code: "7gi", NormalCode: "gix", UberCode: "9gi", UltraCode: "7gi", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 60, TwoHandMaxDamage: 124, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 164, RequiredDexterity: 55, Durability: 50, RequiredLevel: 66, MaxSockets: 6, Type: "axe"},
-	206: {Name: "Polished Wand", ID: 206, Code: "7wn", NormalCode: "wnd", UberCode: "9wn", UltraCode: "7wn", InventoryWidth: 1, InventoryHeight: 2, MinDefense: 0, MaxDefense: 0, MinDamage: 18, MaxDamage: 33, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 25, RequiredDexterity: 0, Durability: 22, RequiredLevel: 41, MaxSockets: 2, Type: "wand"},
-	207: {Name: "Ghost Wand", ID: 207, Code: "7yw", NormalCode: "ywn", UberCode: "9yw", UltraCode: "7yw", InventoryWidth: 1, InventoryHeight: 2, MinDefense: 0, MaxDefense: 0, MinDamage: 20, MaxDamage: 40, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 25, RequiredDexterity: 0, Durability: 14, RequiredLevel: 48, MaxSockets: 2, Type: "wand"},
-	208: {Name: "Lich Wand", ID: 208, Code: "7bw", NormalCode: "bwn", UberCode: "9bw", UltraCode: "7bw", InventoryWidth: 1, InventoryHeight: 2, MinDefense: 0, MaxDefense: 0, MinDamage: 10, MaxDamage: 31, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -20, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 25, RequiredDexterity: 0, Durability: 17, RequiredLevel: 56, MaxSockets: 2, Type: "wand"},
-	209: {Name: "Unearthed Wand", ID: 209, Code: "7gw", NormalCode: "gwn", UberCode: "9gw", UltraCode: "7gw", InventoryWidth: 1, InventoryHeight: 2, MinDefense: 0, MaxDefense: 0, MinDamage: 22, MaxDamage: 28, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 25, RequiredDexterity: 0, Durability: 18, RequiredLevel: 64, MaxSockets: 2, Type: "wand"},
-	210: {Name: "Truncheon", ID: 210, Code: "7cl", NormalCode: "clb", UberCode: "9cl", UltraCode: "7cl", InventoryWidth: 1, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 35, MaxDamage: 43, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 88, RequiredDexterity: 43, Durability: 55, RequiredLevel: 39, MaxSockets: 2, Type: "club"},
-	211: {Name: "Mighty Scepter", ID: 211, Code: "7sc", NormalCode: "scp", UberCode: "9sc", UltraCode: "7sc", InventoryWidth: 1, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 40, MaxDamage: 52, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 125, RequiredDexterity: 65, Durability: 50, RequiredLevel: 46, MaxSockets: 2, Type: "scep"},
-	212: {Name: "Seraph Rod", ID: 212, Code: "7qs", NormalCode: "gsc", UberCode: "9qs", UltraCode: "7qs", InventoryWidth: 1, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 45, MaxDamage: 54, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 108, RequiredDexterity: 69, Durability: 60, RequiredLevel: 57, MaxSockets: 3, Type: "scep"},
-	213: {Name: "Caduceus", ID: 213, Code: "7ws", NormalCode: "wsp", UberCode: "9ws", UltraCode: "7ws", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 37, MaxDamage: 43, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 97, RequiredDexterity: 70, Durability: 70, RequiredLevel: 66, MaxSockets: 5, Type: "scep"},
-	214: {Name: "Tyrant Club", ID: 214, Code: "7sp", NormalCode: "spc", UberCode: "9sp", UltraCode: "7sp", InventoryWidth: 1, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 32, MaxDamage: 58, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 133, RequiredDexterity: 0, Durability: 65, RequiredLevel: 42, MaxSockets: 3, Type: "club"},
-	215: {Name: "Reinforced Mace", ID: 215, Code: "7ma", NormalCode: "mac", UberCode: "9ma", UltraCode: "7ma", InventoryWidth: 1, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 41, MaxDamage: 49, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 145, RequiredDexterity: 46, Durability: 60, RequiredLevel: 47, MaxSockets: 2, Type: "mace"},
-	216: {Name: "Devil Star", ID: 216, Code: "7mt", NormalCode: "mst", UberCode: "9mt", UltraCode: "7mt", InventoryWidth: 1, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 43, MaxDamage: 53, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 153, RequiredDexterity: 44, Durability: 72, RequiredLevel: 52, MaxSockets: 3, Type: "mace"},
-	217: {Name: "Scourge", ID: 217, Code: "7fl", NormalCode: "fla", UberCode: "9fl", UltraCode: "7fl", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 3, MaxDamage: 80, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 125, RequiredDexterity: 77, Durability: 65, RequiredLevel: 57, MaxSockets: 5, Type: "mace"},
-	218: {Name: "Legendary Mallet", ID: 218, Code: "7wh", NormalCode: "whm", UberCode: "9wh", UltraCode: "7wh", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 50, MaxDamage: 61, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 20, StrengthBonus: 110, DexterityBonus: 0, RequiredStrength: 189, RequiredDexterity: 0, Durability: 65, RequiredLevel: 61, MaxSockets: 4, Type: "hamm"},
-	219: {Name: "Ogre Maul", ID: 219, Code: "7m7", NormalCode: "mau", UberCode: "9m9", UltraCode: "7m7", InventoryWidth: 2, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 77, TwoHandMaxDamage: 106, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 10, StrengthBonus: 110, DexterityBonus: 0, RequiredStrength: 225, RequiredDexterity: 0, Durability: 60, RequiredLevel: 51, MaxSockets: 6, Type: "hamm"},
-	220: {Name: "Thunder Maul", ID: 220, Code: "7gm", NormalCode: "gma", UberCode: "9gm", UltraCode: "7gm", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 33, TwoHandMaxDamage: 180, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 20, StrengthBonus: 110, DexterityBonus: 0, RequiredStrength: 253, RequiredDexterity: 0, Durability: 60, RequiredLevel: 65, MaxSockets: 6, Type: "hamm"},
-	221: {Name: "Falcata", ID: 221, Code: "7ss", NormalCode: "ssd", UberCode: "9ss", UltraCode: "7ss", InventoryWidth: 1, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 31, MaxDamage: 59, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 150, RequiredDexterity: 88, Durability: 24, RequiredLevel: 42, MaxSockets: 2, Type: "swor"},
-	222: {Name: "Ataghan", ID: 222, Code: "7sm", NormalCode: "scm", UberCode: "9sm", UltraCode: "7sm", InventoryWidth: 1, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 26, MaxDamage: 46, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -20, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 138, RequiredDexterity: 95, Durability: 22, RequiredLevel: 45, MaxSockets: 2, Type: "swor"},
-	223: {Name: "Elegant Blade", ID: 223, Code: "7sb", NormalCode: "sbr", UberCode: "9sb", UltraCode: "7sb", InventoryWidth: 1, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 33, MaxDamage: 45, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 109, RequiredDexterity: 122, Durability: 32, RequiredLevel: 47, MaxSockets: 2, Type: "swor"},
-	224: {Name: "Hydra Edge", ID: 224, Code: "7fc", NormalCode: "flc", UberCode: "9fc", UltraCode: "7fc", InventoryWidth: 1, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 28, MaxDamage: 68, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 142, RequiredDexterity: 105, Durability: 32, RequiredLevel: 51, MaxSockets: 2, Type: "swor"},
-	225: {Name: "Phase Blade", ID: 225, Code: "7cr", NormalCode: "crs", UberCode: "9cr", UltraCode: "7cr", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 31, MaxDamage: 35, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -30, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 25, RequiredDexterity: 136, Durability: 0, RequiredLevel: 54, MaxSockets: 6, Type: "swor"},
-	226: {Name: "Conquest Sword", ID: 226, Code: "7bs", NormalCode: "bsd", UberCode: "9bs", UltraCode: "7bs", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 37, MaxDamage: 53, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 142, RequiredDexterity: 112, Durability: 32, RequiredLevel: 58, MaxSockets: 4, Type: "swor"},
-	227: {Name: "Cryptic Sword", ID: 227, Code: "7ls", NormalCode: "lsd", UberCode: "9ls", UltraCode: "7ls", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 5, MaxDamage: 77, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 99, RequiredDexterity: 109, Durability: 44, RequiredLevel: 61, MaxSockets: 4, Type: "swor"},
-	228: {Name: "Mythical Sword", ID: 228, Code: "7wd", NormalCode: "wsd", UberCode: "9wd", UltraCode: "7wd", InventoryWidth: 1, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 40, MaxDamage: 50, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 147, RequiredDexterity: 124, Durability: 44, RequiredLevel: 66, MaxSockets: 3, Type: "swor"},
-	229: {Name: "Legend Sword", ID: 229, Code: "72h", NormalCode: "2hs", UberCode: "92h", UltraCode: "72h", InventoryWidth: 1, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 22, MaxDamage: 56, TwoHandMinDamage: 50, TwoHandMaxDamage: 94, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -15, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 175, RequiredDexterity: 100, Durability: 44, RequiredLevel: 44, MaxSockets: 3, Type: "swor"},
-	230: {Name: "Highland Blade", ID: 230, Code: "7cm", NormalCode: "clm", UberCode: "9cm", UltraCode: "7cm", InventoryWidth: 1, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 22, MaxDamage: 62, TwoHandMinDamage: 67, TwoHandMaxDamage: 96, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -5, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 171, RequiredDexterity: 104, Durability: 50, RequiredLevel: 49, MaxSockets: 4, Type: "swor"},
-	231: {Name: "Balrog Blade", ID: 231, Code: "7gs", NormalCode: "gis", UberCode: "9gs", UltraCode: "7gs", InventoryWidth: 1, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 15, MaxDamage: 75, TwoHandMinDamage: 55, TwoHandMaxDamage: 118, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 185, RequiredDexterity: 87, Durability: 50, RequiredLevel: 53, MaxSockets: 4, Type: "swor"},
-	232: {Name: "Champion Sword", ID: 232, Code: "7b7", NormalCode: "bsw", UberCode: "9b9", UltraCode: "7b7", InventoryWidth: 1, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 24, MaxDamage: 54, TwoHandMinDamage: 71, TwoHandMaxDamage: 83, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 163, RequiredDexterity: 103, Durability: 40, RequiredLevel: 57, MaxSockets: 4, Type: "swor"},
-	233: {Name: "Colossus Sword", ID: 233, Code: "7fb", NormalCode: "flb", UberCode: "9fb", UltraCode: "7fb", InventoryWidth: 2, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 26, MaxDamage: 70, TwoHandMinDamage: 61, TwoHandMaxDamage: 121, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 182, RequiredDexterity: 95, Durability: 50, RequiredLevel: 60, MaxSockets: 5, Type: "swor"},
-	234: {Name: "Colossus Blade", ID: 234, Code: "7gd", NormalCode: "gsd", UberCode: "9gd", UltraCode: "7gd", InventoryWidth: 2, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 25, MaxDamage: 65, TwoHandMinDamage: 58, TwoHandMaxDamage: 115, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 5, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 189, RequiredDexterity: 110, Durability: 50, RequiredLevel: 63, MaxSockets: 6, Type: "swor"},
-	235: {Name: "Bone Knife", ID: 235, Code: "7dg", NormalCode: "dgr", UberCode: "9dg", UltraCode: "7dg", InventoryWidth: 1, InventoryHeight: 2, MinDefense: 0, MaxDefense: 0, MinDamage: 23, MaxDamage: 49, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -20, StrengthBonus: 75, DexterityBonus: 75, RequiredStrength: 38, RequiredDexterity: 75, Durability: 26, RequiredLevel: 43, MaxSockets: 1, Type: "knif"},
-	236: {Name: "Mithral Point", ID: 236, Code: "7di", NormalCode: "dir", UberCode: "9di", UltraCode: "7di", InventoryWidth: 1, InventoryHeight: 2, MinDefense: 0, MaxDefense: 0, MinDamage: 37, MaxDamage: 53, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 75, DexterityBonus: 75, RequiredStrength: 55, RequiredDexterity: 98, Durability: 55, RequiredLevel: 52, MaxSockets: 1, Type: "knif"},
-	237: {Name: "Fanged Knife", ID: 237, Code: "7kr", NormalCode: "kri", UberCode: "9kr", UltraCode: "7kr", InventoryWidth: 1, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 15, MaxDamage: 57, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -20, StrengthBonus: 75, DexterityBonus: 75, RequiredStrength: 42, RequiredDexterity: 86, Durability: 36, RequiredLevel: 62, MaxSockets: 3, Type: "knif"},
-	238: {Name: "Legend Spike", ID: 238, Code: "7bl", NormalCode: "bld", UberCode: "9bl", UltraCode: "7bl", InventoryWidth: 1, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 31, MaxDamage: 47, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -10, StrengthBonus: 75, DexterityBonus: 75, RequiredStrength: 65, RequiredDexterity: 67, Durability: 47, RequiredLevel: 66, MaxSockets: 2, Type: "knif"},
-	239: {Name: "Flying Knife", ID: 239, Code: "7tk", NormalCode: "tkf", UberCode: "9tk", UltraCode: "7tk", InventoryWidth: 1, InventoryHeight: 2, MinDefense: 0, MaxDefense: 0, MinDamage: 23, MaxDamage: 54, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 23, MaxMissileDamage: 54, Speed: 0, StrengthBonus: 75, DexterityBonus: 75, RequiredStrength: 48, RequiredDexterity: 141, Durability: 6, RequiredLevel: 48, MaxSockets: 0, Type: "tkni"},
-	240: {Name: "Flying Axe", ID: 240, Code: "7ta", NormalCode: "tax", UberCode: "9ta", UltraCode: "7ta", InventoryWidth: 1, InventoryHeight: 2, MinDefense: 0, MaxDefense: 0, MinDamage: 17, MaxDamage: 65, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 15, MaxMissileDamage: 66, Speed: 10, StrengthBonus: 75, DexterityBonus: 75, RequiredStrength: 88, RequiredDexterity: 108, Durability: 15, RequiredLevel: 42, MaxSockets: 0, Type: "taxe"},
-	241: {Name: "Winged Knife", ID: 241, Code: "7bk", NormalCode: "bkf", UberCode: "9bk", UltraCode: "7bk", InventoryWidth: 1, InventoryHeight: 2, MinDefense: 0, MaxDefense: 0, MinDamage: 27, MaxDamage: 35, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 23, MaxMissileDamage: 39, Speed: -20, StrengthBonus: 75, DexterityBonus: 75, RequiredStrength: 45, RequiredDexterity: 142, Durability: 20, RequiredLevel: 57, MaxSockets: 0, Type: "tkni"},
-	242: {Name: "Winged Axe", ID: 242, Code: "7b8", NormalCode: "bal", UberCode: "9b8", UltraCode: "7b8", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 11, MaxDamage: 56, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 7, MaxMissileDamage: 60, Speed: -10, StrengthBonus: 75, DexterityBonus: 75, RequiredStrength: 96, RequiredDexterity: 122, Durability: 16, RequiredLevel: 60, MaxSockets: 0, Type: "taxe"},
-	243: {Name: "Hyperion Javelin", ID: 243, Code: "7ja", NormalCode: "jav", UberCode: "9ja", UltraCode: "7ja", InventoryWidth: 1, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 21, MaxDamage: 57, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 28, MaxMissileDamage: 55, Speed: -10, StrengthBonus: 75, DexterityBonus: 75, RequiredStrength: 98, RequiredDexterity: 123, Durability: 10, RequiredLevel: 40, MaxSockets: 0, Type: "jave"},
-	244: {Name: "Stygian Pilum", ID: 244, Code: "7pi", NormalCode: "pil", UberCode: "9pi", UltraCode: "7pi", InventoryWidth: 1, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 14, MaxDamage: 64, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 21, MaxMissileDamage: 75, Speed: 0, StrengthBonus: 75, DexterityBonus: 75, RequiredStrength: 118, RequiredDexterity: 112, Durability: 12, RequiredLevel: 46, MaxSockets: 0, Type: "jave"},
-	245: {Name: "Balrog Spear", ID: 245, Code: "7s7", NormalCode: "ssp", UberCode: "9s9", UltraCode: "7s7", InventoryWidth: 1, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 33, MaxDamage: 63, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 40, MaxMissileDamage: 62, Speed: 10, StrengthBonus: 75, DexterityBonus: 75, RequiredStrength: 127, RequiredDexterity: 95, Durability: 14, RequiredLevel: 53, MaxSockets: 0, Type: "jave"},
-	246: {Name: "Ghost Glaive", ID: 246, Code: "7gl", NormalCode: "glv", UberCode: "9gl", UltraCode: "7gl", InventoryWidth: 1, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 19, MaxDamage: 60, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 30, MaxMissileDamage: 85, Speed: 20, StrengthBonus: 75, DexterityBonus: 75, RequiredStrength: 89, RequiredDexterity: 137, Durability: 16, RequiredLevel: 59, MaxSockets: 0, Type: "jave"},
-	247: {Name: "Winged Harpoon", ID: 247, Code: "7ts", NormalCode: "tsp", UberCode: "9ts", UltraCode: "7ts", InventoryWidth: 1, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 27, MaxDamage: 35, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 11, MaxMissileDamage: 77, Speed: -10, StrengthBonus: 75, DexterityBonus: 75, RequiredStrength: 76, RequiredDexterity: 145, Durability: 18, RequiredLevel: 65, MaxSockets: 0, Type: "jave"},
-	248: {Name: "Hyperion Spear", ID: 248, Code: "7sr", NormalCode: "spr", UberCode: "9sr", UltraCode: "7sr", InventoryWidth: 2, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 35, TwoHandMaxDamage: 119, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 155, RequiredDexterity: 120, Durability: 30, RequiredLevel: 43, MaxSockets: 3, Type: "spea"},
-	249: {Name: "Stygian Pike", ID: 249, Code: "7tr", NormalCode: "tri", UberCode: "9tr", UltraCode: "7tr", InventoryWidth: 2, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 29, TwoHandMaxDamage: 144, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 168, RequiredDexterity: 97, Durability: 35, RequiredLevel: 49, MaxSockets: 4, Type: "spea"},
-	250: {Name: "Mancatcher", ID: 250, Code: "7br", NormalCode: "brn", UberCode: "9br", UltraCode: "7br", InventoryWidth: 2, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 42, TwoHandMaxDamage: 92, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -20, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 132, RequiredDexterity: 134, Durability: 28, RequiredLevel: 55, MaxSockets: 5, Type: "spea"},
-	251: {Name: "Ghost Spear", ID: 251, Code: "7st", NormalCode: "spt", UberCode: "9st", UltraCode: "7st", InventoryWidth: 2, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 18, TwoHandMaxDamage: 155, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 122, RequiredDexterity: 163, Durability: 28, RequiredLevel: 62, MaxSockets: 6, Type: "spea"},
-	252: {Name: "War Pike", ID: 252, Code: "7p7", NormalCode: "pik", UberCode: "9p9", UltraCode: "7p7", InventoryWidth: 2, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 33, TwoHandMaxDamage: 178, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 20, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 165, RequiredDexterity: 106, Durability: 25, RequiredLevel: 66, MaxSockets: 6, Type: "spea"},
-	253: {Name: "Ogre Axe", ID: 253, Code: "7o7", NormalCode: "bar", UberCode: "9b7", UltraCode: "7o7", InventoryWidth: 2, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 28, TwoHandMaxDamage: 145, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 195, RequiredDexterity: 75, Durability: 50, RequiredLevel: 45, MaxSockets: 3, Type: "pole"},
-	254: {Name: "Colossus Voulge", ID: 254, Code: "7vo", NormalCode: "vou", UberCode: "9vo", UltraCode: "7vo", InventoryWidth: 2, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 17, TwoHandMaxDamage: 165, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 210, RequiredDexterity: 55, Durability: 50, RequiredLevel: 48, MaxSockets: 4, Type: "pole"},
-	255: {Name: "Thresher", ID: 255, Code: "7s8", NormalCode: "scy", UberCode: "9s8", UltraCode: "7s8", InventoryWidth: 2, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 12, TwoHandMaxDamage: 141, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 152, RequiredDexterity: 118, Durability: 65, RequiredLevel: 53, MaxSockets: 5, Type: "pole"},
-	256: {Name: "Cryptic Axe", ID: 256, Code: "7pa", NormalCode: "pax", UberCode: "9pa", UltraCode: "7pa", InventoryWidth: 2, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 33, TwoHandMaxDamage: 150, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 165, RequiredDexterity: 103, Durability: 65, RequiredLevel: 59, MaxSockets: 5, Type: "pole"},
-	257: {Name: "Great Poleaxe", ID: 257, Code: "7h7", NormalCode: "hal", UberCode: "9h9", UltraCode: "7h7", InventoryWidth: 2, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 46, TwoHandMaxDamage: 127, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 179, RequiredDexterity: 99, Durability: 55, RequiredLevel: 63, MaxSockets: 6, Type: "pole"},
-	258: {Name: "Giant Thresher", ID: 258, Code: "7wc", NormalCode: "wsc", UberCode: "9wc", UltraCode: "7wc", InventoryWidth: 2, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 40, TwoHandMaxDamage: 114, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 188, RequiredDexterity: 140, Durability: 55, RequiredLevel: 66, MaxSockets: 6, Type: "pole"},
-	259: {Name: "Walking Stick", ID: 259, Code: "6ss", NormalCode: "sst", UberCode: "8ss", UltraCode: "6ss", InventoryWidth: 1, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 69, TwoHandMaxDamage: 85, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 25, RequiredDexterity: 0, Durability: 20, RequiredLevel: 43, MaxSockets: 2, Type: "staf"},
-	260: {Name: "Stalagmite", ID: 260, Code: "6ls", NormalCode: "lst", UberCode: "8ls", UltraCode: "6ls", InventoryWidth: 1, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 75, TwoHandMaxDamage: 107, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 63, RequiredDexterity: 35, Durability: 30, RequiredLevel: 49, MaxSockets: 3, Type: "staf"},
-	261: {Name: "Elder Staff", ID: 261, Code: "6cs", NormalCode: "cst", UberCode: "8cs", UltraCode: "6cs", InventoryWidth: 1, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 80, TwoHandMaxDamage: 93, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 44, RequiredDexterity: 37, Durability: 35, RequiredLevel: 55, MaxSockets: 4, Type: "staf"},
-	262: {Name: "Shillelagh", ID: 262, Code: "6bs", NormalCode: "bst", UberCode: "8bs", UltraCode: "6bs", InventoryWidth: 1, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 65, TwoHandMaxDamage: 108, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 52, RequiredDexterity: 27, Durability: 40, RequiredLevel: 62, MaxSockets: 4, Type: "staf"},
-	263: {Name: "Archon Staff", ID: 263, Code: "6ws", NormalCode: "wst", UberCode: "8ws", UltraCode: "6ws", InventoryWidth: 2, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 83, TwoHandMaxDamage: 99, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 34, RequiredDexterity: 0, Durability: 26, RequiredLevel: 66, MaxSockets: 6, Type: "staf"},
-	264: {Name: "Spider Bow", ID: 264, Code: "6sb", NormalCode: "sbw", UberCode: "8sb", UltraCode: "6sb", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 23, TwoHandMaxDamage: 50, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 5, StrengthBonus: 0, DexterityBonus: 100, RequiredStrength: 64, RequiredDexterity: 143, Durability: 20, RequiredLevel: 41, MaxSockets: 3, Type: "bow"},
-	265: {Name: "Blade Bow", ID: 265, Code: "6hb", NormalCode: "hbw", UberCode: "8hb", UltraCode: "6hb", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 21, TwoHandMaxDamage: 41, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -10, StrengthBonus: 0, DexterityBonus: 100, RequiredStrength: 76, RequiredDexterity: 119, Durability: 32, RequiredLevel: 45, MaxSockets: 4, Type: "bow"},
-	266: {Name: "Shadow Bow", ID: 266, Code: "6lb", NormalCode: "lbw", UberCode: "8lb", UltraCode: "6lb", InventoryWidth: 2, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 15, TwoHandMaxDamage: 59, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 100, RequiredStrength: 52, RequiredDexterity: 188, Durability: 28, RequiredLevel: 47, MaxSockets: 5, Type: "bow"},
-	267: {Name: "Great Bow", ID: 267, Code: "6cb", NormalCode: "cbw", UberCode: "8cb", UltraCode: "6cb", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 12, TwoHandMaxDamage: 52, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -10, StrengthBonus: 0, DexterityBonus: 100, RequiredStrength: 121, RequiredDexterity: 107, Durability: 36, RequiredLevel: 51, MaxSockets: 4, Type: "bow"},
-	268: {Name: "Diamond Bow", ID: 268, Code: "6s7", NormalCode: "sbb", UberCode: "8s8", UltraCode: "6s7", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 33, TwoHandMaxDamage: 40, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 100, RequiredStrength: 89, RequiredDexterity: 132, Durability: 40, RequiredLevel: 54, MaxSockets: 5, Type: "bow"},
-	269: {Name: "Crusader Bow", ID: 269, Code: "6l7", NormalCode: "lbb", UberCode: "8l8", UltraCode: "6l7", InventoryWidth: 2, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 15, TwoHandMaxDamage: 63, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 10, StrengthBonus: 0, DexterityBonus: 100, RequiredStrength: 97, RequiredDexterity: 121, Durability: 44, RequiredLevel: 57, MaxSockets: 6, Type: "bow"},
-	270: {Name: "Ward Bow", ID: 270, Code: "6sw", NormalCode: "swb", UberCode: "8sw", UltraCode: "6sw", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 20, TwoHandMaxDamage: 53, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 100, RequiredStrength: 72, RequiredDexterity: 146, Durability: 48, RequiredLevel: 60, MaxSockets: 5, Type: "bow"},
-	271: {Name: "Hydra Bow", ID: 271, Code: "6lw", NormalCode: "lwb", UberCode: "8lw", UltraCode: "6lw", InventoryWidth: 2, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 10, TwoHandMaxDamage: 68, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 10, StrengthBonus: 0, DexterityBonus: 100, RequiredStrength: 134, RequiredDexterity: 167, Durability: 55, RequiredLevel: 63, MaxSockets: 6, Type: "bow"},
-	272: {Name: "Pellet Bow", ID: 272, Code: "6lx", NormalCode: "lxb", UberCode: "8lx", UltraCode: "6lx", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 28, TwoHandMaxDamage: 73, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -10, StrengthBonus: 0, DexterityBonus: 100, RequiredStrength: 83, RequiredDexterity: 155, Durability: 30, RequiredLevel: 42, MaxSockets: 3, Type: "xbow"},
-	273: {Name: "Gorgon Crossbow", ID: 273, Code: "6mx", NormalCode: "mxb", UberCode: "8mx", UltraCode: "6mx", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 25, TwoHandMaxDamage: 87, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 100, RequiredStrength: 117, RequiredDexterity: 105, Durability: 40, RequiredLevel: 50, MaxSockets: 4, Type: "xbow"},
-	274: {Name: "Colossus Crossbow", ID: 274, Code: "6hx", NormalCode: "hxb", UberCode: "8hx", UltraCode: "6hx", InventoryWidth: 2, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 32, TwoHandMaxDamage: 91, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 10, StrengthBonus: 0, DexterityBonus: 100, RequiredStrength: 163, RequiredDexterity: 77, Durability: 50, RequiredLevel: 56, MaxSockets: 6, Type: "xbow"},
-	275: {Name: "Demon Crossbow", ID: 275, Code: "6rx", NormalCode: "rxb", UberCode: "8rx", UltraCode: "6rx", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 26, TwoHandMaxDamage: 40, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -60, StrengthBonus: 0, DexterityBonus: 100, RequiredStrength: 141, RequiredDexterity: 98, Durability: 40, RequiredLevel: 63, MaxSockets: 5, Type: "xbow"},
-	276: {Name: "Eagle Orb", ID: 276, Code: "ob1", NormalCode: "ob1", UberCode: "ob6", UltraCode: "obb", InventoryWidth: 1, InventoryHeight: 2, MinDefense: 0, MaxDefense: 0, MinDamage: 2, MaxDamage: 5, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 20, RequiredLevel: 0, MaxSockets: 4, Type: "orb"},
-	277: {Name: "Sacred Globe", ID: 277, Code: "ob2", NormalCode: "ob2", UberCode: "ob7", UltraCode: "obc", InventoryWidth: 1, InventoryHeight: 2, MinDefense: 0, MaxDefense: 0, MinDamage: 3, MaxDamage: 8, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 30, RequiredLevel: 0, MaxSockets: 4, Type: "orb"},
-	278: {Name: "Smoked Sphere", ID: 278, Code: "ob3", NormalCode: "ob3", UberCode: "ob8", UltraCode: "obd", InventoryWidth: 1, InventoryHeight: 2, MinDefense: 0, MaxDefense: 0, MinDamage: 4, MaxDamage: 10, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 35, RequiredLevel: 8, MaxSockets: 4, Type: "orb"},
-	279: {Name: "Clasped Orb", ID: 279, Code: "ob4", NormalCode: "ob4", UberCode: "ob9", UltraCode: "obe", InventoryWidth: 1, InventoryHeight: 2, MinDefense: 0, MaxDefense: 0, MinDamage: 5, MaxDamage: 12, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 40, RequiredLevel: 13, MaxSockets: 4, Type: "orb"},
-	280: {Name: "Jared's Stone", ID: 280, Code: "ob5", NormalCode: "ob5", UberCode: "oba", UltraCode: "obf", InventoryWidth: 1, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 8, MaxDamage: 18, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 50, RequiredLevel: 18, MaxSockets: 4, Type: "orb"},
-	281: {Name: "Stag Bow", ID: 281, Code: "am1", NormalCode: "am1", UberCode: "am6", UltraCode: "amb", InventoryWidth: 2, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 7, TwoHandMaxDamage: 12, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 100, RequiredStrength: 30, RequiredDexterity: 45, Durability: 48, RequiredLevel: 14, MaxSockets: 5, Type: "abow"},
-	282: {Name: "Reflex Bow", ID: 282, Code: "am2", NormalCode: "am2", UberCode: "am7", UltraCode: "amc", InventoryWidth: 2, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 9, TwoHandMaxDamage: 19, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 10, StrengthBonus: 0, DexterityBonus: 100, RequiredStrength: 35, RequiredDexterity: 60, Durability: 55, RequiredLevel: 20, MaxSockets: 5, Type: "abow"},
-	283: {Name: "Maiden Spear", ID: 283, Code: "am3", NormalCode: "am3", UberCode: "am8", UltraCode: "amd", InventoryWidth: 2, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 18, TwoHandMaxDamage: 24, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 80, DexterityBonus: 50, RequiredStrength: 54, RequiredDexterity: 40, Durability: 28, RequiredLevel: 14, MaxSockets: 6, Type: "aspe"},
-	284: {Name: "Maiden Pike", ID: 284, Code: "am4", NormalCode: "am4", UberCode: "am9", UltraCode: "ame", InventoryWidth: 2, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 23, TwoHandMaxDamage: 55, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 10, StrengthBonus: 80, DexterityBonus: 50, RequiredStrength: 63, RequiredDexterity: 52, Durability: 25, RequiredLevel: 20, MaxSockets: 6, Type: "aspe"},
-	285: {Name: "Maiden Javelin", ID: 285, Code: "am5", NormalCode: "am5", UberCode: "ama", UltraCode: "amf", InventoryWidth: 1, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 8, MaxDamage: 14, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 6, MaxMissileDamage: 22, Speed: -10, StrengthBonus: 80, DexterityBonus: 50, RequiredStrength: 33, RequiredDexterity: 47, Durability: 6, RequiredLevel: 17, MaxSockets: 0, Type: "ajav"},
-	286: {Name: "Glowing Orb", ID: 286, Code: "ob6", NormalCode: "ob1", UberCode: "ob6", UltraCode: "obb", InventoryWidth: 1, InventoryHeight: 2, MinDefense: 0, MaxDefense: 0, MinDamage: 8, MaxDamage: 21, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 20, RequiredLevel: 24, MaxSockets: 4, Type: "orb"},
-	287: {Name: "Crystalline Globe", ID: 287, Code: "ob7", NormalCode: "ob2", UberCode: "ob7", UltraCode: "obc", InventoryWidth: 1, InventoryHeight: 2, MinDefense: 0, MaxDefense: 0, MinDamage: 10, MaxDamage: 26, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 30, RequiredLevel: 27, MaxSockets: 4, Type: "orb"},
-	288: {Name: "Cloudy Sphere", ID: 288, Code: "ob8", NormalCode: "ob3", UberCode: "ob8", UltraCode: "obd", InventoryWidth: 1, InventoryHeight: 2, MinDefense: 0, MaxDefense: 0, MinDamage: 11, MaxDamage: 29, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 35, RequiredLevel: 30, MaxSockets: 4, Type: "orb"},
-	289: {Name: "Sparkling Ball", ID: 289, Code: "ob9", NormalCode: "ob4", UberCode: "ob9", UltraCode: "obe", InventoryWidth: 1, InventoryHeight: 2, MinDefense: 0, MaxDefense: 0, MinDamage: 13, MaxDamage: 32, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 40, RequiredLevel: 34, MaxSockets: 4, Type: "orb"},
-	290: {Name: "Swirling Crystal", ID: 290, Code: "oba", NormalCode: "ob5", UberCode: "oba", UltraCode: "obf", InventoryWidth: 1, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 18, MaxDamage: 42, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 50, RequiredLevel: 37, MaxSockets: 4, Type: "orb"},
-	291: {Name: "Ashwood Bow", ID: 291, Code: "am6", NormalCode: "am1", UberCode: "am6", UltraCode: "amb", InventoryWidth: 2, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 16, TwoHandMaxDamage: 29, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 100, RequiredStrength: 56, RequiredDexterity: 77, Durability: 48, RequiredLevel: 29, MaxSockets: 5, Type: "abow"},
-	292: {Name: "Ceremonial Bow", ID: 292, Code: "am7", NormalCode: "am2", UberCode: "am7", UltraCode: "amc", InventoryWidth: 2, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 19, TwoHandMaxDamage: 41, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 10, StrengthBonus: 0, DexterityBonus: 100, RequiredStrength: 73, RequiredDexterity: 110, Durability: 55, RequiredLevel: 35, MaxSockets: 5, Type: "abow"},
-	293: {Name: "Ceremonial Spear", ID: 293, Code: "am8", NormalCode: "am3", UberCode: "am8", UltraCode: "amd", InventoryWidth: 2, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 34, TwoHandMaxDamage: 51, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 80, DexterityBonus: 50, RequiredStrength: 101, RequiredDexterity: 80, Durability: 28, RequiredLevel: 32, MaxSockets: 6, Type: "aspe"},
-	294: {Name: "Ceremonial Pike", ID: 294, Code: "am9", NormalCode: "am4", UberCode: "am9", UltraCode: "ame", InventoryWidth: 2, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 42, TwoHandMaxDamage: 101, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 20, StrengthBonus: 80, DexterityBonus: 50, RequiredStrength: 115, RequiredDexterity: 98, Durability: 25, RequiredLevel: 38, MaxSockets: 6, Type: "aspe"},
-	295: {Name: "Ceremonial Javelin", ID: 295, Code: "ama", NormalCode: "am5", UberCode: "ama", UltraCode: "amf", InventoryWidth: 1, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 18, MaxDamage: 35, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 18, MaxMissileDamage: 54, Speed: -10, StrengthBonus: 80, DexterityBonus: 50, RequiredStrength: 25, RequiredDexterity: 109, Durability: 6, RequiredLevel: 26, MaxSockets: 0, Type: "ajav"},
-	296: {Name: "Heavenly Stone", ID: 296, Code: "obb", NormalCode: "ob1", UberCode: "ob6", UltraCode: "obb", InventoryWidth: 1, InventoryHeight: 2, MinDefense: 0, MaxDefense: 0, MinDamage: 21, MaxDamage: 46, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 20, RequiredLevel: 44, MaxSockets: 4, Type: "orb"},
-	297: {Name: "Eldritch Orb", ID: 297, Code: "obc", NormalCode: "ob2", UberCode: "ob7", UltraCode: "obc", InventoryWidth: 1, InventoryHeight: 2, MinDefense: 0, MaxDefense: 0, MinDamage: 18, MaxDamage: 50, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 30, RequiredLevel: 50, MaxSockets: 4, Type: "orb"},
-	298: {Name: "Demon Heart", ID: 298, Code: "obd", NormalCode: "ob3", UberCode: "ob8", UltraCode: "obd", InventoryWidth: 1, InventoryHeight: 2, MinDefense: 0, MaxDefense: 0, MinDamage: 23, MaxDamage: 55, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 35, RequiredLevel: 56, MaxSockets: 4, Type: "orb"},
-	299: {Name: "Vortex Orb", ID: 299, Code: "obe", NormalCode: "ob4", UberCode: "ob9", UltraCode: "obe", InventoryWidth: 1, InventoryHeight: 2, MinDefense: 0, MaxDefense: 0, MinDamage: 12, MaxDamage: 66, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 40, RequiredLevel: 63, MaxSockets: 4, Type: "orb"},
-	300: {Name: "Dimensional Shard", ID: 300, Code: "obf", NormalCode: "ob5", UberCode: "oba", UltraCode: "obf", InventoryWidth: 1, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 30, MaxDamage: 53, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 50, RequiredLevel: 66, MaxSockets: 4, Type: "orb"},
-	301: {Name: "Matriarchal Bow", ID: 301, Code: "amb", NormalCode: "am1", UberCode: "am6", UltraCode: "amb", InventoryWidth: 2, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 20, TwoHandMaxDamage: 47, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -10, StrengthBonus: 0, DexterityBonus: 100, RequiredStrength: 87, RequiredDexterity: 187, Durability: 48, RequiredLevel: 39, MaxSockets: 5, Type: "abow"},
-	302: {Name: "Grand Matron Bow", ID: 302, Code: "amc", NormalCode: "am2", UberCode: "am7", UltraCode: "amc", InventoryWidth: 2, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 14, TwoHandMaxDamage: 72, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 10, StrengthBonus: 0, DexterityBonus: 100, RequiredStrength: 108, RequiredDexterity: 152, Durability: 55, RequiredLevel: 58, MaxSockets: 5, Type: "abow"},
-	303: {Name: "Matriarchal Spear", ID: 303, Code: "amd", NormalCode: "am3", UberCode: "am8", UltraCode: "amd", InventoryWidth: 2, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 65, TwoHandMaxDamage: 95, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 80, DexterityBonus: 50, RequiredStrength: 114, RequiredDexterity: 142, Durability: 28, RequiredLevel: 45, MaxSockets: 6, Type: "aspe"},
-	304: {Name: "Matriarchal Pike", ID: 304, Code: "ame", NormalCode: "am4", UberCode: "am9", UltraCode: "ame", InventoryWidth: 2, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 37, TwoHandMaxDamage: 153, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 20, StrengthBonus: 80, DexterityBonus: 50, RequiredStrength: 132, RequiredDexterity: 149, Durability: 25, RequiredLevel: 60, MaxSockets: 6, Type: "aspe"},
-	305: {Name: "Matriarchal Javelin", ID: 305, Code: "amf", NormalCode: "am5", UberCode: "ama", UltraCode: "amf", InventoryWidth: 1, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 30, MaxDamage: 54, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 35, MaxMissileDamage: 66, Speed: -10, StrengthBonus: 80, DexterityBonus: 50, RequiredStrength: 107, RequiredDexterity: 151, Durability: 6, RequiredLevel: 48, MaxSockets: 0, Type: "ajav"},
-	306: {Name: "Cap", ID: 306, Code: "cap", NormalCode: "cap", UberCode: "xap", UltraCode: "uap", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 3, MaxDefense: 5, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 12, RequiredLevel: 0, MaxSockets: 2, Type: "helm"},
-	307: {Name: "Skull Cap", ID: 307, Code: "skp", NormalCode: "skp", UberCode: "xkp", UltraCode: "ukp", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 8, MaxDefense: 11, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 15, RequiredDexterity: 0, Durability: 18, RequiredLevel: 0, MaxSockets: 2, Type: "helm"},
-	308: {Name: "Helm", ID: 308, Code: "hlm", NormalCode: "hlm", UberCode: "xlm", UltraCode: "ulm", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 15, MaxDefense: 18, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 26, RequiredDexterity: 0, Durability: 24, RequiredLevel: 0, MaxSockets: 2, Type: "helm"},
-	309: {Name: "Full Helm", ID: 309, Code: "fhl", NormalCode: "fhl", UberCode: "xhl", UltraCode: "uhl", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 23, MaxDefense: 26, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 41, RequiredDexterity: 0, Durability: 30, RequiredLevel: 0, MaxSockets: 2, Type: "helm"},
-	310: {Name: "Great Helm", ID: 310, Code: "ghm", NormalCode: "ghm", UberCode: "xhm", UltraCode: "uhm", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 30, MaxDefense: 35, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 63, RequiredDexterity: 0, Durability: 40, RequiredLevel: 0, MaxSockets: 3, Type: "helm"},
-	311: {Name: "Crown", ID: 311, Code: "crn", NormalCode: "crn", UberCode: "xrn", UltraCode: "urn", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 25, MaxDefense: 45, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 55, RequiredDexterity: 0, Durability: 50, RequiredLevel: 0, MaxSockets: 3, Type: "helm"},
-	312: {Name: "Mask", ID: 312, Code: "msk", NormalCode: "msk", UberCode: "xsk", UltraCode: "usk", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 9, MaxDefense: 27, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 23, RequiredDexterity: 0, Durability: 20, RequiredLevel: 0, MaxSockets: 3, Type: "helm"},
-	313: {Name: "Quilted Armor", ID: 313, Code: "qui", NormalCode: "qui", UberCode: "xui", UltraCode: "uui", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 8, MaxDefense: 11, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 12, RequiredDexterity: 0, Durability: 20, RequiredLevel: 0, MaxSockets: 2, Type: "tors"},
-	314: {Name: "Leather Armor", ID: 314, Code: "lea", NormalCode: "lea", UberCode: "xea", UltraCode: "uea", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 14, MaxDefense: 17, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 15, RequiredDexterity: 0, Durability: 24, RequiredLevel: 0, MaxSockets: 2, Type: "tors"},
-	315: {Name: "Hard Leather Armor", ID: 315, Code: "hla", NormalCode: "hla", UberCode: "xla", UltraCode: "ula", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 21, MaxDefense: 24, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 20, RequiredDexterity: 0, Durability: 28, RequiredLevel: 0, MaxSockets: 2, Type: "tors"},
-	316: {Name: "Studded Leather ", ID: 316, Code: "stu", NormalCode: "stu", UberCode: "xtu", UltraCode: "utu", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 32, MaxDefense: 35, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 27, RequiredDexterity: 0, Durability: 32, RequiredLevel: 0, MaxSockets: 2, Type: "tors"},
-	317: {Name: "Ring Mail", ID: 317, Code: "rng", NormalCode: "rng", UberCode: "xng", UltraCode: "ung", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 45, MaxDefense: 48, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 5, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 36, RequiredDexterity: 0, Durability: 26, RequiredLevel: 0, MaxSockets: 3, Type: "tors"},
-	318: {Name: "Scale Mail", ID: 318, Code: "scl", NormalCode: "scl", UberCode: "xcl", UltraCode: "ucl", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 57, MaxDefense: 60, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 10, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 44, RequiredDexterity: 0, Durability: 36, RequiredLevel: 0, MaxSockets: 2, Type: "tors"},
-	319: {Name: "Chain Mail", ID: 319, Code: "chn", NormalCode: "chn", UberCode: "xhn", UltraCode: "uhn", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 72, MaxDefense: 75, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 5, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 48, RequiredDexterity: 0, Durability: 45, RequiredLevel: 0, MaxSockets: 2, Type: "tors"},
-	320: {Name: "Breast Plate", ID: 320, Code: "brs", NormalCode: "brs", UberCode: "xrs", UltraCode: "urs", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 65, MaxDefense: 68, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 30, RequiredDexterity: 0, Durability: 50, RequiredLevel: 0, MaxSockets: 3, Type: "tors"},
-	321: {Name: "Splint Mail", ID: 321, Code: "spl", NormalCode: "spl", UberCode: "xpl", UltraCode: "upl", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 90, MaxDefense: 95, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 5, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 51, RequiredDexterity: 0, Durability: 30, RequiredLevel: 0, MaxSockets: 2, Type: "tors"},
-	322: {Name: "Plate Mail", ID: 322, Code: "plt", NormalCode: "plt", UberCode: "xlt", UltraCode: "ult", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 108, MaxDefense: 116, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 10, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 65, RequiredDexterity: 0, Durability: 60, RequiredLevel: 0, MaxSockets: 2, Type: "tors"},
-	323: {Name: "Field Plate", ID: 323, Code: "fld", NormalCode: "fld", UberCode: "xld", UltraCode: "uld", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 101, MaxDefense: 105, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 5, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 55, RequiredDexterity: 0, Durability: 48, RequiredLevel: 0, MaxSockets: 2, Type: "tors"},
-	324: {Name: "Gothic Plate", ID: 324, Code: "gth", NormalCode: "gth", UberCode: "xth", UltraCode: "uth", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 128, MaxDefense: 135, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 5, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 70, RequiredDexterity: 0, Durability: 55, RequiredLevel: 0, MaxSockets: 4, Type: "tors"},
-	325: {Name: "Full Plate Mail", ID: 325, Code: "ful", NormalCode: "ful", UberCode: "xul", UltraCode: "uul", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 150, MaxDefense: 161, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 10, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 80, RequiredDexterity: 0, Durability: 70, RequiredLevel: 0, MaxSockets: 4, Type: "tors"},
-	326: {Name: "Ancient Armor", ID: 326, Code: "aar", NormalCode: "aar", UberCode: "xar", UltraCode: "uar", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 218, MaxDefense: 233, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 5, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 100, RequiredDexterity: 0, Durability: 60, RequiredLevel: 0, MaxSockets: 4, Type: "tors"},
-	327: {Name: "Light Plate", ID: 327, Code: "ltp", NormalCode: "ltp", UberCode: "xtp", UltraCode: "utp", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 90, MaxDefense: 107, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 41, RequiredDexterity: 0, Durability: 60, RequiredLevel: 0, MaxSockets: 3, Type: "tors"},
-	328: {Name: "Buckler", ID: 328, Code: "buc", NormalCode: "buc", UberCode: "xuc", UltraCode: "uuc", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 4, MaxDefense: 6, MinDamage: 1, MaxDamage: 3, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 12, RequiredDexterity: 0, Durability: 12, RequiredLevel: 0, MaxSockets: 1, Type: "shie"},
-	329: {Name: "Small Shield", ID: 329, Code: "sml", NormalCode: "sml", UberCode: "xml", UltraCode: "uml", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 8, MaxDefense: 10, MinDamage: 2, MaxDamage: 3, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 22, RequiredDexterity: 0, Durability: 16, RequiredLevel: 0, MaxSockets: 2, Type: "shie"},
-	330: {Name: "Large Shield", ID: 330, Code: "lrg", NormalCode: "lrg", UberCode: "xrg", UltraCode: "urg", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 12, MaxDefense: 14, MinDamage: 2, MaxDamage: 4, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 5, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 34, RequiredDexterity: 0, Durability: 24, RequiredLevel: 0, MaxSockets: 3, Type: "shie"},
-	331: {Name: "Kite Shield", ID: 331, Code: "kit", NormalCode: "kit", UberCode: "xit", UltraCode: "uit", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 16, MaxDefense: 18, MinDamage: 2, MaxDamage: 5, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 47, RequiredDexterity: 0, Durability: 30, RequiredLevel: 0, MaxSockets: 3, Type: "shie"},
-	332: {Name: "Tower Shield", ID: 332, Code: "tow", NormalCode: "tow", UberCode: "xow", UltraCode: "uow", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 22, MaxDefense: 25, MinDamage: 1, MaxDamage: 5, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 75, RequiredDexterity: 0, Durability: 60, RequiredLevel: 0, MaxSockets: 3, Type: "shie"},
-	333: {Name: "Gothic Shield", ID: 333, Code: "gts", NormalCode: "gts", UberCode: "xts", UltraCode: "uts", InventoryWidth: 2, InventoryHeight: 4, MinDefense: 30, MaxDefense: 35, MinDamage: 2, MaxDamage: 6, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 5, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 60, RequiredDexterity: 0, Durability: 40, RequiredLevel: 0, MaxSockets: 3, Type: "shie"},
-	334: {Name: "Leather Gloves", ID: 334, Code: "lgl", NormalCode: "lgl", UberCode: "xlg", UltraCode: "ulg", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 2, MaxDefense: 3, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 12, RequiredLevel: 0, MaxSockets: 0, Type: "glov"},
-	335: {Name: "Heavy Gloves", ID: 335, Code: "vgl", NormalCode: "vgl", UberCode: "xvg", UltraCode: "uvg", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 5, MaxDefense: 6, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 14, RequiredLevel: 0, MaxSockets: 0, Type: "glov"},
-	336: {Name: "Chain Gloves", ID: 336, Code: "mgl", NormalCode: "mgl", UberCode: "xmg", UltraCode: "umg", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 8, MaxDefense: 9, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 25, RequiredDexterity: 0, Durability: 16, RequiredLevel: 0, MaxSockets: 0, Type: "glov"},
-	337: {Name: "Light Gauntlets", ID: 337, Code: "tgl", NormalCode: "tgl", UberCode: "xtg", UltraCode: "utg", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 9, MaxDefense: 11, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 45, RequiredDexterity: 0, Durability: 18, RequiredLevel: 0, MaxSockets: 0, Type: "glov"},
-	338: {Name: "Gauntlets", ID: 338, Code: "hgl", NormalCode: "hgl", UberCode: "xhg", UltraCode: "uhg", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 12, MaxDefense: 15, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 60, RequiredDexterity: 0, Durability: 24, RequiredLevel: 0, MaxSockets: 0, Type: "glov"},
-	339: {Name: "Boots", ID: 339, Code: "lbt", NormalCode: "lbt", UberCode: "xlb", UltraCode: "ulb", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 2, MaxDefense: 3, MinDamage: 3, MaxDamage: 8, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 120, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 12, RequiredLevel: 0, MaxSockets: 0, Type: "boot"},
-	340: {Name: "Heavy Boots", ID: 340, Code: "vbt", NormalCode: "vbt", UberCode: "xvb", UltraCode: "uvb", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 5, MaxDefense: 6, MinDamage: 4, MaxDamage: 10, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 120, DexterityBonus: 0, RequiredStrength: 18, RequiredDexterity: 0, Durability: 14, RequiredLevel: 0, MaxSockets: 0, Type: "boot"},
-	341: {Name: "Chain Boots", ID: 341, Code: "mbt", NormalCode: "mbt", UberCode: "xmb", UltraCode: "umb", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 8, MaxDefense: 9, MinDamage: 6, MaxDamage: 12, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 120, DexterityBonus: 0, RequiredStrength: 30, RequiredDexterity: 0, Durability: 16, RequiredLevel: 0, MaxSockets: 0, Type: "boot"},
-	342: {Name: "Light Plated Boots", ID: 342, Code: "tbt", NormalCode: "tbt", UberCode: "xtb", UltraCode: "utb", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 9, MaxDefense: 11, MinDamage: 8, MaxDamage: 16, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 120, DexterityBonus: 0, RequiredStrength: 50, RequiredDexterity: 0, Durability: 18, RequiredLevel: 0, MaxSockets: 0, Type: "boot"},
-	343: {Name: "Greaves", ID: 343, Code: "hbt", NormalCode: "hbt", UberCode: "xhb", UltraCode: "uhb", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 12, MaxDefense: 15, MinDamage: 10, MaxDamage: 20, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 120, DexterityBonus: 0, RequiredStrength: 70, RequiredDexterity: 0, Durability: 24, RequiredLevel: 0, MaxSockets: 0, Type: "boot"},
-	344: {Name: "Sash", ID: 344, Code: "lbl", NormalCode: "lbl", UberCode: "zlb", UltraCode: "ulc", InventoryWidth: 2, InventoryHeight: 1, MinDefense: 2, MaxDefense: 2, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 12, RequiredLevel: 0, MaxSockets: 0, Type: "belt"},
-	345: {Name: "Light Belt", ID: 345, Code: "vbl", NormalCode: "vbl", UberCode: "zvb", UltraCode: "uvc", InventoryWidth: 2, InventoryHeight: 1, MinDefense: 3, MaxDefense: 3, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 14, RequiredLevel: 0, MaxSockets: 0, Type: "belt"},
-	346: {Name: "Belt", ID: 346, Code: "mbl", NormalCode: "mbl", UberCode: "zmb", UltraCode: "umc", InventoryWidth: 2, InventoryHeight: 1, MinDefense: 5, MaxDefense: 5, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 25, RequiredDexterity: 0, Durability: 16, RequiredLevel: 0, MaxSockets: 0, Type: "belt"},
-	347: {Name: "Heavy Belt", ID: 347, Code: "tbl", NormalCode: "tbl", UberCode: "ztb", UltraCode: "utc", InventoryWidth: 2, InventoryHeight: 1, MinDefense: 6, MaxDefense: 6, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 45, RequiredDexterity: 0, Durability: 18, RequiredLevel: 0, MaxSockets: 0, Type: "belt"},
-	348: {Name: "Plated Belt", ID: 348, Code: "hbl", NormalCode: "hbl", UberCode: "zhb", UltraCode: "uhc", InventoryWidth: 2, InventoryHeight: 1, MinDefense: 8, MaxDefense: 11, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 60, RequiredDexterity: 0, Durability: 24, RequiredLevel: 0, MaxSockets: 0, Type: "belt"},
-	349: {Name: "Bone Helm", ID: 349, Code: "bhm", NormalCode: "bhm", UberCode: "xh9", UltraCode: "uh9", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 33, MaxDefense: 36, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 25, RequiredDexterity: 0, Durability: 40, RequiredLevel: 0, MaxSockets: 2, Type: "helm"},
-	350: {Name: "Bone Shield", ID: 350, Code: "bsh", NormalCode: "bsh", UberCode: "xsh", UltraCode: "ush", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 10, MaxDefense: 30, MinDamage: 3, MaxDamage: 6, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 25, RequiredDexterity: 0, Durability: 40, RequiredLevel: 0, MaxSockets: 2, Type: "shie"},
-	351: {Name: "Spiked Shield", ID: 351, Code: "spk", NormalCode: "spk", UberCode: "xpk", UltraCode: "upk", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 15, MaxDefense: 25, MinDamage: 5, MaxDamage: 9, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 30, RequiredDexterity: 0, Durability: 40, RequiredLevel: 0, MaxSockets: 2, Type: "shie"},
-	352: {Name: "War Hat", ID: 352, Code: "xap", NormalCode: "cap", UberCode: "xap", UltraCode: "uap", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 45, MaxDefense: 53, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 20, RequiredDexterity: 0, Durability: 12, RequiredLevel: 22, MaxSockets: 2, Type: "helm"},
-	353: {Name: "Sallet", ID: 353, Code: "xkp", NormalCode: "skp", UberCode: "xkp", UltraCode: "ukp", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 52, MaxDefense: 62, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 43, RequiredDexterity: 0, Durability: 18, RequiredLevel: 25, MaxSockets: 2, Type: "helm"},
-	354: {Name: "Casque", ID: 354, Code: "xlm", NormalCode: "hlm", UberCode: "xlm", UltraCode: "ulm", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 63, MaxDefense: 72, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 59, RequiredDexterity: 0, Durability: 24, RequiredLevel: 25, MaxSockets: 2, Type: "helm"},
-	355: {Name: "Basinet", ID: 355, Code: "xhl", NormalCode: "fhl", UberCode: "xhl", UltraCode: "uhl", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 75, MaxDefense: 84, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 82, RequiredDexterity: 0, Durability: 30, RequiredLevel: 25, MaxSockets: 2, Type: "helm"},
-	356: {Name: "Winged Helm", ID: 356, Code: "xhm", NormalCode: "ghm", UberCode: "xhm", UltraCode: "uhm", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 85, MaxDefense: 98, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 115, RequiredDexterity: 0, Durability: 40, RequiredLevel: 25, MaxSockets: 3, Type: "helm"},
-	357: {Name: "Grand Crown", ID: 357, Code: "xrn", NormalCode: "crn", UberCode: "xrn", UltraCode: "urn", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 78, MaxDefense: 113, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 103, RequiredDexterity: 0, Durability: 50, RequiredLevel: 25, MaxSockets: 3, Type: "helm"},
-	358: {Name: "Death Mask", ID: 358, Code: "xsk", NormalCode: "msk", UberCode: "xsk", UltraCode: "usk", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 54, MaxDefense: 86, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 55, RequiredDexterity: 0, Durability: 20, RequiredLevel: 25, MaxSockets: 3, Type: "helm"},
-	359: {Name: "Ghost Armor", ID: 359, Code: "xui", NormalCode: "qui", UberCode: "xui", UltraCode: "uui", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 102, MaxDefense: 117, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 38, RequiredDexterity: 0, Durability: 20, RequiredLevel: 22, MaxSockets: 2, Type: "tors"},
-	360: {Name: "Serpentskin Armor", ID: 360, Code: "xea", NormalCode: "lea", UberCode: "xea", UltraCode: "uea", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 111, MaxDefense: 126, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 43, RequiredDexterity: 0, Durability: 24, RequiredLevel: 24, MaxSockets: 2, Type: "tors"},
-	361: {Name: "Demonhide Armor", ID: 361, Code: "xla", NormalCode: "hla", UberCode: "xla", UltraCode: "ula", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 122, MaxDefense: 136, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 50, RequiredDexterity: 0, Durability: 28, RequiredLevel: 25, MaxSockets: 2, Type: "tors"},
-	362: {Name: "Trellised Armor", ID: 362, Code: "xtu", NormalCode: "stu", UberCode: "xtu", UltraCode: "utu", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 138, MaxDefense: 153, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 61, RequiredDexterity: 0, Durability: 32, RequiredLevel: 25, MaxSockets: 2, Type: "tors"},
-	363: {Name: "Linked Mail", ID: 363, Code: "xng", NormalCode: "rng", UberCode: "xng", UltraCode: "ung", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 158, MaxDefense: 172, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 5, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 74, RequiredDexterity: 0, Durability: 26, RequiredLevel: 25, MaxSockets: 3, Type: "tors"},
-	364: {Name: "Tigulated Mail", ID: 364, Code: "xcl", NormalCode: "scl", UberCode: "xcl", UltraCode: "ucl", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 176, MaxDefense: 190, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 10, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 86, RequiredDexterity: 0, Durability: 36, RequiredLevel: 25, MaxSockets: 3, Type: "tors"},
-	365: {Name: "Mesh Armor", ID: 365, Code: "xhn", NormalCode: "chn", UberCode: "xhn", UltraCode: "uhn", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 198, MaxDefense: 213, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 5, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 92, RequiredDexterity: 0, Durability: 45, RequiredLevel: 25, MaxSockets: 3, Type: "tors"},
-	366: {Name: "Cuirass", ID: 366, Code: "xrs", NormalCode: "brs", UberCode: "xrs", UltraCode: "urs", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 188, MaxDefense: 202, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 65, RequiredDexterity: 0, Durability: 50, RequiredLevel: 25, MaxSockets: 3, Type: "tors"},
-	367: {Name: "Russet Armor", ID: 367, Code: "xpl", NormalCode: "spl", UberCode: "xpl", UltraCode: "upl", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 225, MaxDefense: 243, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 5, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 97, RequiredDexterity: 0, Durability: 30, RequiredLevel: 25, MaxSockets: 3, Type: "tors"},
-	368: {Name: "Templar Coat", ID: 368, Code: "xlt", NormalCode: "plt", UberCode: "xlt", UltraCode: "ult", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 252, MaxDefense: 274, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 10, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 118, RequiredDexterity: 0, Durability: 60, RequiredLevel: 25, MaxSockets: 3, Type: "tors"},
-	369: {Name: "Sharktooth Armor", ID: 369, Code: "xld", NormalCode: "fld", UberCode: "xld", UltraCode: "uld", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 242, MaxDefense: 258, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 5, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 103, RequiredDexterity: 0, Durability: 48, RequiredLevel: 25, MaxSockets: 3, Type: "tors"},
-	370: {Name: "Embossed Plate", ID: 370, Code: "xth", NormalCode: "gth", UberCode: "xth", UltraCode: "uth", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 282, MaxDefense: 303, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 5, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 125, RequiredDexterity: 0, Durability: 55, RequiredLevel: 25, MaxSockets: 4, Type: "tors"},
-	371: {Name: "Chaos Armor", ID: 371, Code: "xul", NormalCode: "ful", UberCode: "xul", UltraCode: "uul", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 315, MaxDefense: 342, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 10, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 140, RequiredDexterity: 0, Durability: 70, RequiredLevel: 25, MaxSockets: 4, Type: "tors"},
-	372: {Name: "Ornate Plate", ID: 372, Code: "xar", NormalCode: "aar", UberCode: "xar", UltraCode: "uar", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 417, MaxDefense: 450, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 5, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 170, RequiredDexterity: 0, Durability: 60, RequiredLevel: 25, MaxSockets: 4, Type: "tors"},
-	373: {Name: "Mage Plate", ID: 373, Code: "xtp", NormalCode: "ltp", UberCode: "xtp", UltraCode: "utp", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 225, MaxDefense: 261, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 55, RequiredDexterity: 0, Durability: 60, RequiredLevel: 25, MaxSockets: 3, Type: "tors"},
-	374: {Name: "Defender", ID: 374, Code: "xuc", NormalCode: "buc", UberCode: "xuc", UltraCode: "uuc", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 41, MaxDefense: 49, MinDamage: 8, MaxDamage: 12, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 38, RequiredDexterity: 0, Durability: 68, RequiredLevel: 22, MaxSockets: 1, Type: "shie"},
-	375: {Name: "Round Shield", ID: 375, Code: "xml", NormalCode: "sml", UberCode: "xml", UltraCode: "uml", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 47, MaxDefense: 55, MinDamage: 7, MaxDamage: 14, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 53, RequiredDexterity: 0, Durability: 64, RequiredLevel: 25, MaxSockets: 2, Type: "shie"},
-	376: {Name: "Scutum", ID: 376, Code: "xrg", NormalCode: "lrg", UberCode: "xrg", UltraCode: "urg", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 53, MaxDefense: 61, MinDamage: 11, MaxDamage: 15, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 5, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 71, RequiredDexterity: 0, Durability: 62, RequiredLevel: 25, MaxSockets: 3, Type: "shie"},
-	377: {Name: "Dragon Shield", ID: 377, Code: "xit", NormalCode: "kit", UberCode: "xit", UltraCode: "uit", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 59, MaxDefense: 67, MinDamage: 15, MaxDamage: 24, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 91, RequiredDexterity: 0, Durability: 76, RequiredLevel: 25, MaxSockets: 3, Type: "shie"},
-	378: {Name: "Pavise", ID: 378, Code: "xow", NormalCode: "tow", UberCode: "xow", UltraCode: "uow", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 68, MaxDefense: 78, MinDamage: 10, MaxDamage: 17, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 133, RequiredDexterity: 0, Durability: 72, RequiredLevel: 25, MaxSockets: 3, Type: "shie"},
-	379: {Name: "Kurast Shield", ID: 379, Code: "xts", NormalCode: "gts", UberCode: "xts", UltraCode: "uts", InventoryWidth: 2, InventoryHeight: 4, MinDefense: 80, MaxDefense: 93, MinDamage: 12, MaxDamage: 16, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 5, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 110, RequiredDexterity: 0, Durability: 80, RequiredLevel: 25, MaxSockets: 3, Type: "shie"},
-	380: {Name: "Demonhide Gloves", ID: 380, Code: "xlg", NormalCode: "lgl", UberCode: "xlg", UltraCode: "ulg", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 28, MaxDefense: 35, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 20, RequiredDexterity: 0, Durability: 12, RequiredLevel: 21, MaxSockets: 0, Type: "glov"},
-	381: {Name: "Sharkskin Gloves", ID: 381, Code: "xvg", NormalCode: "vgl", UberCode: "xvg", UltraCode: "uvg", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 33, MaxDefense: 39, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 20, RequiredDexterity: 0, Durability: 14, RequiredLevel: 25, MaxSockets: 0, Type: "glov"},
-	382: {Name: "Heavy Bracers", ID: 382, Code: "xmg", NormalCode: "mgl", UberCode: "xmg", UltraCode: "umg", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 37, MaxDefense: 44, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 58, RequiredDexterity: 0, Durability: 16, RequiredLevel: 25, MaxSockets: 0, Type: "glov"},
-	383: {Name: "Battle Gauntlets", ID: 383, Code: "xtg", NormalCode: "tgl", UberCode: "xtg", UltraCode: "utg", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 39, MaxDefense: 47, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 88, RequiredDexterity: 0, Durability: 18, RequiredLevel: 25, MaxSockets: 0, Type: "glov"},
-	384: {Name: "War Gauntlets", ID: 384, Code: "xhg", NormalCode: "hgl", UberCode: "xhg", UltraCode: "uhg", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 43, MaxDefense: 53, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 110, RequiredDexterity: 0, Durability: 24, RequiredLevel: 25, MaxSockets: 0, Type: "glov"},
-	385: {Name: "Demonhide Boots", ID: 385, Code: "xlb", NormalCode: "lbt", UberCode: "xlb", UltraCode: "ulb", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 28, MaxDefense: 35, MinDamage: 26, MaxDamage: 46, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 120, DexterityBonus: 0, RequiredStrength: 20, RequiredDexterity: 0, Durability: 12, RequiredLevel: 24, MaxSockets: 0, Type: "boot"},
-	386: {Name: "Sharkskin Boots", ID: 386, Code: "xvb", NormalCode: "vbt", UberCode: "xvb", UltraCode: "uvb", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 33, MaxDefense: 39, MinDamage: 28, MaxDamage: 50, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 120, DexterityBonus: 0, RequiredStrength: 47, RequiredDexterity: 0, Durability: 14, RequiredLevel: 25, MaxSockets: 0, Type: "boot"},
-	387: {Name: "Mesh Boots", ID: 387, Code: "xmb", NormalCode: "mbt", UberCode: "xmb", UltraCode: "umb", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 37, MaxDefense: 44, MinDamage: 23, MaxDamage: 52, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 120, DexterityBonus: 0, RequiredStrength: 65, RequiredDexterity: 0, Durability: 16, RequiredLevel: 25, MaxSockets: 0, Type: "boot"},
-	388: {Name: "Battle Boots", ID: 388, Code: "xtb", NormalCode: "tbt", UberCode: "xtb", UltraCode: "utb", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 39, MaxDefense: 47, MinDamage: 37, MaxDamage: 64, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 120, DexterityBonus: 0, RequiredStrength: 95, RequiredDexterity: 0, Durability: 18, RequiredLevel: 25, MaxSockets: 0, Type: "boot"},
-	389: {Name: "War Boots", ID: 389, Code: "xhb", NormalCode: "hbt", UberCode: "xhb", UltraCode: "uhb", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 43, MaxDefense: 53, MinDamage: 39, MaxDamage: 80, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 120, DexterityBonus: 0, RequiredStrength: 125, RequiredDexterity: 0, Durability: 24, RequiredLevel: 25, MaxSockets: 0, Type: "boot"},
-	390: {Name: "Demonhide Sash", ID: 390, Code: "zlb", NormalCode: "lbl", UberCode: "zlb", UltraCode: "ulc", InventoryWidth: 2, InventoryHeight: 1, MinDefense: 29, MaxDefense: 34, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 20, RequiredDexterity: 0, Durability: 12, RequiredLevel: 24, MaxSockets: 0, Type: "belt"},
-	391: {Name: "Sharkskin Belt", ID: 391, Code: "zvb", NormalCode: "vbl", UberCode: "zvb", UltraCode: "uvc", InventoryWidth: 2, InventoryHeight: 1, MinDefense: 31, MaxDefense: 36, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 20, RequiredDexterity: 0, Durability: 14, RequiredLevel: 25, MaxSockets: 0, Type: "belt"},
-	392: {Name: "Mesh Belt", ID: 392, Code: "zmb", NormalCode: "mbl", UberCode: "zmb", UltraCode: "umc", InventoryWidth: 2, InventoryHeight: 1, MinDefense: 35, MaxDefense: 40, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 58, RequiredDexterity: 0, Durability: 16, RequiredLevel: 25, MaxSockets: 0, Type: "belt"},
-	393: {Name: "Battle Belt", ID: 393, Code: "ztb", NormalCode: "tbl", UberCode: "ztb", UltraCode: "utc", InventoryWidth: 2, InventoryHeight: 1, MinDefense: 37, MaxDefense: 42, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 88, RequiredDexterity: 0, Durability: 18, RequiredLevel: 25, MaxSockets: 0, Type: "belt"},
-	394: {Name: "War Belt", ID: 394, Code: "zhb", NormalCode: "hbl", UberCode: "zhb", UltraCode: "uhc", InventoryWidth: 2, InventoryHeight: 1, MinDefense: 41, MaxDefense: 52, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 110, RequiredDexterity: 0, Durability: 24, RequiredLevel: 25, MaxSockets: 0, Type: "belt"},
-	395: {Name: "Grim Helm", ID: 395, Code: "xh9", NormalCode: "bhm", UberCode: "xh9", UltraCode: "uh9", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 60, MaxDefense: 125, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 58, RequiredDexterity: 0, Durability: 40, RequiredLevel: 25, MaxSockets: 2, Type: "helm"},
-	396: {Name: "Grim Shield", ID: 396, Code: "xsh", NormalCode: "bsh", UberCode: "xsh", UltraCode: "ush", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 50, MaxDefense: 150, MinDamage: 14, MaxDamage: 20, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 58, RequiredDexterity: 0, Durability: 70, RequiredLevel: 25, MaxSockets: 2, Type: "shie"},
-	397: {Name: "Barbed Shield", ID: 397, Code: "xpk", NormalCode: "spk", UberCode: "xpk", UltraCode: "upk", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 58, MaxDefense: 78, MinDamage: 18, MaxDamage: 35, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 65, RequiredDexterity: 0, Durability: 55, RequiredLevel: 25, MaxSockets: 2, Type: "shie"},
-	398: {Name: "Wolf Head", ID: 398, Code: "dr1", NormalCode: "dr1", UberCode: "dr6", UltraCode: "drb", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 8, MaxDefense: 11, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 16, RequiredDexterity: 0, Durability: 20, RequiredLevel: 3, MaxSockets: 3, Type: "pelt"},
-	399: {Name: "Hawk Helm", ID: 399, Code: "dr2", NormalCode: "dr2", UberCode: "dr7", UltraCode: "drc", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 4, MaxDefense: 15, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 20, RequiredDexterity: 0, Durability: 20, RequiredLevel: 6, MaxSockets: 3, Type: "pelt"},
-	400: {Name: "Antlers", ID: 400, Code: "dr3", NormalCode: "dr3", UberCode: "dr8", UltraCode: "drd", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 18, MaxDefense: 24, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 24, RequiredDexterity: 0, Durability: 20, RequiredLevel: 12, MaxSockets: 3, Type: "pelt"},
-	401: {Name: "Falcon Mask", ID: 401, Code: "dr4", NormalCode: "dr4", UberCode: "dr9", UltraCode: "dre", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 12, MaxDefense: 28, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 28, RequiredDexterity: 0, Durability: 20, RequiredLevel: 15, MaxSockets: 3, Type: "pelt"},
-	402: {Name: "Spirit Mask", ID: 402, Code: "dr5", NormalCode: "dr5", UberCode: "dra", UltraCode: "drf", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 22, MaxDefense: 35, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 30, RequiredDexterity: 0, Durability: 20, RequiredLevel: 18, MaxSockets: 3, Type: "pelt"},
-	403: {Name: "Jawbone Cap", ID: 403, Code: "ba1", NormalCode: "ba1", UberCode: "ba6", UltraCode: "bab", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 10, MaxDefense: 15, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 25, RequiredDexterity: 0, Durability: 25, RequiredLevel: 3, MaxSockets: 3, Type: "phlm"},
-	404: {Name: "Fanged Helm", ID: 404, Code: "ba2", NormalCode: "ba2", UberCode: "ba7", UltraCode: "bac", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 15, MaxDefense: 20, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 35, RequiredDexterity: 0, Durability: 35, RequiredLevel: 6, MaxSockets: 3, Type: "phlm"},
-	405: {Name: "Horned Helm", ID: 405, Code: "ba3", NormalCode: "ba3", UberCode: "ba8", UltraCode: "bad", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 25, MaxDefense: 30, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 45, RequiredDexterity: 0, Durability: 45, RequiredLevel: 12, MaxSockets: 3, Type: "phlm"},
-	406: {Name: "Assault Helmet", ID: 406, Code: "ba4", NormalCode: "ba4", UberCode: "ba9", UltraCode: "bae", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 30, MaxDefense: 35, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 55, RequiredDexterity: 0, Durability: 50, RequiredLevel: 15, MaxSockets: 3, Type: "phlm"},
-	407: {Name: "Avenger Guard", ID: 407, Code: "ba5", NormalCode: "ba5", UberCode: "baa", UltraCode: "baf", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 35, MaxDefense: 50, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 65, RequiredDexterity: 0, Durability: 55, RequiredLevel: 18, MaxSockets: 3, Type: "phlm"},
-	408: {Name: "Targe", ID: 408, Code: "pa1", NormalCode: "pa1", UberCode: "pa6", UltraCode: "pab", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 8, MaxDefense: 12, MinDamage: 2, MaxDamage: 6, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 16, RequiredDexterity: 0, Durability: 20, RequiredLevel: 3, MaxSockets: 4, Type: "ashd"},
-	409: {Name: "Rondache", ID: 409, Code: "pa2", NormalCode: "pa2", UberCode: "pa7", UltraCode: "pac", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 10, MaxDefense: 18, MinDamage: 2, MaxDamage: 8, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 26, RequiredDexterity: 0, Durability: 30, RequiredLevel: 6, MaxSockets: 4, Type: "ashd"},
-	410: {Name: "Heraldic Shield", ID: 410, Code: "pa3", NormalCode: "pa3", UberCode: "pa8", UltraCode: "pad", InventoryWidth: 2, InventoryHeight: 4, MinDefense: 16, MaxDefense: 26, MinDamage: 3, MaxDamage: 9, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 40, RequiredDexterity: 0, Durability: 40, RequiredLevel: 12, MaxSockets: 4, Type: "ashd"},
-	411: {Name: "Aerin Shield", ID: 411, Code: "pa4", NormalCode: "pa4", UberCode: "pa9", UltraCode: "pae", InventoryWidth: 2, InventoryHeight: 4, MinDefense: 26, MaxDefense: 36, MinDamage: 4, MaxDamage: 10, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 50, RequiredDexterity: 0, Durability: 50, RequiredLevel: 15, MaxSockets: 4, Type: "ashd"},
-	412: {Name: "Crown Shield", ID: 412, Code: "pa5", NormalCode: "pa5", UberCode: "paa", UltraCode: "paf", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 30, MaxDefense: 40, MinDamage: 4, MaxDamage: 12, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 65, RequiredDexterity: 0, Durability: 60, RequiredLevel: 18, MaxSockets: 4, Type: "ashd"},
-	413: {Name: "Preserved Head", ID: 413, Code: "ne1", NormalCode: "ne1", UberCode: "ne6", UltraCode: "neb", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 2, MaxDefense: 5, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 12, RequiredDexterity: 0, Durability: 20, RequiredLevel: 3, MaxSockets: 2, Type: "head"},
-	414: {Name: "Zombie Head", ID: 414, Code: "ne2", NormalCode: "ne2", UberCode: "ne7", UltraCode: "neg", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 4, MaxDefense: 8, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 14, RequiredDexterity: 0, Durability: 20, RequiredLevel: 6, MaxSockets: 2, Type: "head"},
-	415: {Name: "Unraveller Head", ID: 415, Code: "ne3", NormalCode: "ne3", UberCode: "ne8", UltraCode: "ned", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 6, MaxDefense: 10, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 18, RequiredDexterity: 0, Durability: 20, RequiredLevel: 12, MaxSockets: 2, Type: "head"},
-	416: {Name: "Gargoyle Head", ID: 416, Code: "ne4", NormalCode: "ne4", UberCode: "ne9", UltraCode: "nee", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 10, MaxDefense: 16, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 20, RequiredDexterity: 0, Durability: 20, RequiredLevel: 15, MaxSockets: 2, Type: "head"},
-	417: {Name: "Demon Head", ID: 417, Code: "ne5", NormalCode: "ne5", UberCode: "nea", UltraCode: "nef", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 15, MaxDefense: 20, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 25, RequiredDexterity: 0, Durability: 20, RequiredLevel: 18, MaxSockets: 2, Type: "head"},
-	418: {Name: "Circlet", ID: 418, Code: "ci0", NormalCode: "ci0", UberCode: "ci2", UltraCode: "ci3", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 20, MaxDefense: 30, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 35, RequiredLevel: 16, MaxSockets: 2, Type: "circ"},
-	419: {Name: "Coronet", ID: 419, Code: "ci1", NormalCode: "ci1", UberCode: "ci2", UltraCode: "ci3", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 30, MaxDefense: 40, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 30, RequiredLevel: 39, MaxSockets: 2, Type: "circ"},
-	420: {Name: "Tiara", ID: 420, Code: "ci2", NormalCode: "ci1", UberCode: "ci2", UltraCode: "ci3", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 40, MaxDefense: 50, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 25, RequiredLevel: 52, MaxSockets: 3, Type: "circ"},
-	421: {Name: "Diadem", ID: 421, Code: "ci3", NormalCode: "ci1", UberCode: "ci2", UltraCode: "ci3", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 50, MaxDefense: 60, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 20, RequiredLevel: 64, MaxSockets: 3, Type: "circ"},
-	422: {Name: "Shako", ID: 422, Code: "uap", NormalCode: "cap", UberCode: "xap", UltraCode: "uap", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 98, MaxDefense: 141, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 50, RequiredDexterity: 0, Durability: 12, RequiredLevel: 43, MaxSockets: 2, Type: "helm"},
-	423: {Name: "Hydraskull", ID: 423, Code: "ukp", NormalCode: "skp", UberCode: "xkp", UltraCode: "ukp", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 101, MaxDefense: 145, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 84, RequiredDexterity: 0, Durability: 18, RequiredLevel: 47, MaxSockets: 2, Type: "helm"},
-	424: {Name: "Armet", ID: 424, Code: "ulm", NormalCode: "hlm", UberCode: "xlm", UltraCode: "ulm", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 105, MaxDefense: 149, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 109, RequiredDexterity: 0, Durability: 24, RequiredLevel: 51, MaxSockets: 2, Type: "helm"},
-	425: {Name: "Giant Conch", ID: 425, Code: "uhl", NormalCode: "fhl", UberCode: "xhl", UltraCode: "uhl", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 110, MaxDefense: 154, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 142, RequiredDexterity: 0, Durability: 30, RequiredLevel: 40, MaxSockets: 2, Type: "helm"},
-	426: {Name: "Spired Helm", ID: 426, Code: "uhm", NormalCode: "ghm", UberCode: "xhm", UltraCode: "uhm", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 114, MaxDefense: 159, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 192, RequiredDexterity: 0, Durability: 40, RequiredLevel: 59, MaxSockets: 3, Type: "helm"},
-	427: {Name: "Corona", ID: 427, Code: "urn", NormalCode: "crn", UberCode: "xrn", UltraCode: "urn", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 111, MaxDefense: 165, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 174, RequiredDexterity: 0, Durability: 50, RequiredLevel: 66, MaxSockets: 3, Type: "helm"},
-	428: {Name: "Demonhead", ID: 428, Code: "usk", NormalCode: "msk", UberCode: "xsk", UltraCode: "usk", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 101, MaxDefense: 154, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 102, RequiredDexterity: 0, Durability: 20, RequiredLevel: 55, MaxSockets: 3, Type: "helm"},
-	429: {Name: "Dusk Shroud", ID: 429, Code: "uui", NormalCode: "qui", UberCode: "xui", UltraCode: "uui", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 361, MaxDefense: 467, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 77, RequiredDexterity: 0, Durability: 20, RequiredLevel: 49, MaxSockets: 4, Type: "tors"},
-	430: {Name: "Wyrmhide", ID: 430, Code: "uea", NormalCode: "lea", UberCode: "xea", UltraCode: "uea", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 364, MaxDefense: 470, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 84, RequiredDexterity: 0, Durability: 24, RequiredLevel: 50, MaxSockets: 4, Type: "tors"},
-	431: {Name: "Scarab Husk", ID: 431, Code: "ula", NormalCode: "hla", UberCode: "xla", UltraCode: "ula", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 369, MaxDefense: 474, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 95, RequiredDexterity: 0, Durability: 28, RequiredLevel: 51, MaxSockets: 4, Type: "tors"},
-	432: {Name: "Wire Fleece", ID: 432, Code: "utu", NormalCode: "stu", UberCode: "xtu", UltraCode: "utu", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 375, MaxDefense: 481, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 111, RequiredDexterity: 0, Durability: 32, RequiredLevel: 53, MaxSockets: 4, Type: "tors"},
-	433: {Name: "Diamond Mail", ID: 433, Code: "ung", NormalCode: "rng", UberCode: "xng", UltraCode: "ung", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 383, MaxDefense: 489, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 5, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 131, RequiredDexterity: 0, Durability: 26, RequiredLevel: 54, MaxSockets: 4, Type: "tors"},
-	434: {Name: "Loricated Mail", ID: 434, Code: "ucl", NormalCode: "scl", UberCode: "xcl", UltraCode: "ucl", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 390, MaxDefense: 496, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 10, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 149, RequiredDexterity: 0, Durability: 36, RequiredLevel: 55, MaxSockets: 4, Type: "tors"},
-	435: {Name: "Boneweave", ID: 435, Code: "uhn", NormalCode: "chn", UberCode: "xhn", UltraCode: "uhn", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 399, MaxDefense: 505, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 5, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 158, RequiredDexterity: 0, Durability: 45, RequiredLevel: 47, MaxSockets: 4, Type: "tors"},
-	436: {Name: "Great Hauberk", ID: 436, Code: "urs", NormalCode: "brs", UberCode: "xrs", UltraCode: "urs", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 395, MaxDefense: 501, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 118, RequiredDexterity: 0, Durability: 50, RequiredLevel: 56, MaxSockets: 4, Type: "tors"},
-	437: {Name: "Balrog Skin", ID: 437, Code: "upl", NormalCode: "spl", UberCode: "xpl", UltraCode: "upl", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 410, MaxDefense: 517, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 5, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 165, RequiredDexterity: 0, Durability: 30, RequiredLevel: 57, MaxSockets: 4, Type: "tors"},
-	438: {Name: "Hellforge Plate", ID: 438, Code: "ult", NormalCode: "plt", UberCode: "xlt", UltraCode: "ult", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 421, MaxDefense: 530, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 10, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 196, RequiredDexterity: 0, Durability: 60, RequiredLevel: 59, MaxSockets: 4, Type: "tors"},
-	439: {Name: "Kraken Shell", ID: 439, Code: "uld", NormalCode: "fld", UberCode: "xld", UltraCode: "uld", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 417, MaxDefense: 523, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 5, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 174, RequiredDexterity: 0, Durability: 48, RequiredLevel: 61, MaxSockets: 4, Type: "tors"},
-	440: {Name: "Lacquered Plate", ID: 440, Code: "uth", NormalCode: "gth", UberCode: "xth", UltraCode: "uth", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 433, MaxDefense: 541, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 5, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 208, RequiredDexterity: 0, Durability: 55, RequiredLevel: 62, MaxSockets: 4, Type: "tors"},
-	441: {Name: "Shadow Plate", ID: 441, Code: "uul", NormalCode: "ful", UberCode: "xul", UltraCode: "uul", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 446, MaxDefense: 557, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 10, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 230, RequiredDexterity: 0, Durability: 70, RequiredLevel: 64, MaxSockets: 4, Type: "tors"},
-	442: {Name: "Sacred Armor", ID: 442, Code: "uar", NormalCode: "aar", UberCode: "xar", UltraCode: "uar", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 487, MaxDefense: 600, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 5, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 232, RequiredDexterity: 0, Durability: 60, RequiredLevel: 66, MaxSockets: 4, Type: "tors"},
-	443: {Name: "Archon Plate", ID: 443, Code: "utp", NormalCode: "ltp", UberCode: "xtp", UltraCode: "utp", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 410, MaxDefense: 524, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 103, RequiredDexterity: 0, Durability: 60, RequiredLevel: 63, MaxSockets: 4, Type: "tors"},
-	444: {Name: "Heater", ID: 444, Code: "uuc", NormalCode: "buc", UberCode: "xuc", UltraCode: "uuc", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 95, MaxDefense: 110, MinDamage: 16, MaxDamage: 30, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 77, RequiredDexterity: 0, Durability: 88, RequiredLevel: 43, MaxSockets: 2, Type: "shie"},
-	445: {Name: "Luna", ID: 445, Code: "uml", NormalCode: "sml", UberCode: "xml", UltraCode: "uml", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 108, MaxDefense: 123, MinDamage: 17, MaxDamage: 29, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 100, RequiredDexterity: 0, Durability: 84, RequiredLevel: 45, MaxSockets: 2, Type: "shie"},
-	446: {Name: "Hyperion", ID: 446, Code: "urg", NormalCode: "lrg", UberCode: "xrg", UltraCode: "urg", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 119, MaxDefense: 135, MinDamage: 14, MaxDamage: 32, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 5, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 127, RequiredDexterity: 0, Durability: 82, RequiredLevel: 48, MaxSockets: 3, Type: "shie"},
-	447: {Name: "Monarch", ID: 447, Code: "uit", NormalCode: "kit", UberCode: "xit", UltraCode: "uit", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 133, MaxDefense: 148, MinDamage: 12, MaxDamage: 34, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 156, RequiredDexterity: 0, Durability: 86, RequiredLevel: 54, MaxSockets: 4, Type: "shie"},
-	448: {Name: "Aegis", ID: 448, Code: "uow", NormalCode: "tow", UberCode: "xow", UltraCode: "uow", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 145, MaxDefense: 161, MinDamage: 18, MaxDamage: 28, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 219, RequiredDexterity: 0, Durability: 92, RequiredLevel: 59, MaxSockets: 4, Type: "shie"},
-	449: {Name: "Ward", ID: 449, Code: "uts", NormalCode: "gts", UberCode: "xts", UltraCode: "uts", InventoryWidth: 2, InventoryHeight: 4, MinDefense: 153, MaxDefense: 170, MinDamage: 11, MaxDamage: 35, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 5, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 185, RequiredDexterity: 0, Durability: 100, RequiredLevel: 63, MaxSockets: 4, Type: "shie"},
-	450: {Name: "Bramble Mitts", ID: 450, Code: "ulg", NormalCode: "lgl", UberCode: "xlg", UltraCode: "ulg", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 54, MaxDefense: 62, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 50, RequiredDexterity: 0, Durability: 12, RequiredLevel: 42, MaxSockets: 0, Type: "glov"},
-	451: {Name: "Vampirebone Gloves", ID: 451, Code: "uvg", NormalCode: "vgl", UberCode: "xvg", UltraCode: "uvg", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 56, MaxDefense: 65, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 50, RequiredDexterity: 0, Durability: 14, RequiredLevel: 47, MaxSockets: 0, Type: "glov"},
-	452: {Name: "Vambraces", ID: 452, Code: "umg", NormalCode: "mgl", UberCode: "xmg", UltraCode: "umg", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 59, MaxDefense: 67, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 106, RequiredDexterity: 0, Durability: 16, RequiredLevel: 51, MaxSockets: 0, Type: "glov"},
-	453: {Name: "Crusader Gauntlets", ID: 453, Code: "utg", NormalCode: "tgl", UberCode: "xtg", UltraCode: "utg", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 59, MaxDefense: 68, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 151, RequiredDexterity: 0, Durability: 18, RequiredLevel: 57, MaxSockets: 0, Type: "glov"},
-	454: {Name: "Ogre Gauntlets", ID: 454, Code: "uhg", NormalCode: "hgl", UberCode: "xhg", UltraCode: "uhg", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 62, MaxDefense: 71, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 185, RequiredDexterity: 0, Durability: 24, RequiredLevel: 64, MaxSockets: 0, Type: "glov"},
-	455: {Name: "Wyrmhide Boots", ID: 455, Code: "ulb", NormalCode: "lbt", UberCode: "xlb", UltraCode: "ulb", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 54, MaxDefense: 62, MinDamage: 65, MaxDamage: 100, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 120, DexterityBonus: 0, RequiredStrength: 50, RequiredDexterity: 0, Durability: 12, RequiredLevel: 45, MaxSockets: 0, Type: "boot"},
-	456: {Name: "Scarabshell Boots", ID: 456, Code: "uvb", NormalCode: "vbt", UberCode: "xvb", UltraCode: "uvb", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 56, MaxDefense: 65, MinDamage: 60, MaxDamage: 110, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 120, DexterityBonus: 0, RequiredStrength: 91, RequiredDexterity: 0, Durability: 14, RequiredLevel: 49, MaxSockets: 0, Type: "boot"},
-	457: {Name: "Boneweave Boots", ID: 457, Code: "umb", NormalCode: "mbt", UberCode: "xmb", UltraCode: "umb", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 59, MaxDefense: 67, MinDamage: 69, MaxDamage: 118, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 120, DexterityBonus: 0, RequiredStrength: 118, RequiredDexterity: 0, Durability: 16, RequiredLevel: 54, MaxSockets: 0, Type: "boot"},
-	458: {Name: "Mirrored Boots", ID: 458, Code: "utb", NormalCode: "tbt", UberCode: "xtb", UltraCode: "utb", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 59, MaxDefense: 68, MinDamage: 50, MaxDamage: 145, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 120, DexterityBonus: 0, RequiredStrength: 163, RequiredDexterity: 0, Durability: 18, RequiredLevel: 60, MaxSockets: 0, Type: "boot"},
-	459: {Name: "Myrmidon Greaves", ID: 459, Code: "uhb", NormalCode: "hbt", UberCode: "xhb", UltraCode: "uhb", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 62, MaxDefense: 71, MinDamage: 83, MaxDamage: 149, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 120, DexterityBonus: 0, RequiredStrength: 208, RequiredDexterity: 0, Durability: 24, RequiredLevel: 65, MaxSockets: 0, Type: "boot"},
-	460: {Name: "Spiderweb Sash", ID: 460, Code: "ulc", NormalCode: "lbl", UberCode: "zlb", UltraCode: "ulc", InventoryWidth: 2, InventoryHeight: 1, MinDefense: 55, MaxDefense: 62, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 50, RequiredDexterity: 0, Durability: 12, RequiredLevel: 46, MaxSockets: 0, Type: "belt"},
-	461: {Name: "Vampirefang Belt", ID: 461, Code: "uvc", NormalCode: "vbl", UberCode: "zvb", UltraCode: "uvc", InventoryWidth: 2, InventoryHeight: 1, MinDefense: 56, MaxDefense: 63, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 50, RequiredDexterity: 0, Durability: 14, RequiredLevel: 51, MaxSockets: 0, Type: "belt"},
-	462: {Name: "Mithril Coil", ID: 462, Code: "umc", NormalCode: "mbl", UberCode: "zmb", UltraCode: "umc", InventoryWidth: 2, InventoryHeight: 1, MinDefense: 58, MaxDefense: 65, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 106, RequiredDexterity: 0, Durability: 16, RequiredLevel: 56, MaxSockets: 0, Type: "belt"},
-	463: {Name: "Troll Belt", ID: 463, Code: "utc", NormalCode: "tbl", UberCode: "ztb", UltraCode: "utc", InventoryWidth: 2, InventoryHeight: 1, MinDefense: 59, MaxDefense: 66, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 151, RequiredDexterity: 0, Durability: 18, RequiredLevel: 62, MaxSockets: 0, Type: "belt"},
-	464: {Name: "Colossus Girdle", ID: 464, Code: "uhc", NormalCode: "hbl", UberCode: "zhb", UltraCode: "uhc", InventoryWidth: 2, InventoryHeight: 1, MinDefense: 61, MaxDefense: 71, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 185, RequiredDexterity: 0, Durability: 24, RequiredLevel: 67, MaxSockets: 0, Type: "belt"},
-	465: {Name: "Bone Visage", ID: 465, Code: "uh9", NormalCode: "bhm", UberCode: "xh9", UltraCode: "uh9", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 100, MaxDefense: 157, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 106, RequiredDexterity: 0, Durability: 40, RequiredLevel: 63, MaxSockets: 3, Type: "helm"},
-	466: {Name: "Troll Nest", ID: 466, Code: "ush", NormalCode: "bsh", UberCode: "xsh", UltraCode: "ush", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 158, MaxDefense: 173, MinDamage: 24, MaxDamage: 38, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 106, RequiredDexterity: 0, Durability: 74, RequiredLevel: 57, MaxSockets: 3, Type: "shie"},
-	467: {Name: "Blade Barrier", ID: 467, Code: "upk", NormalCode: "spk", UberCode: "xpk", UltraCode: "upk", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 147, MaxDefense: 163, MinDamage: 26, MaxDamage: 40, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 118, RequiredDexterity: 0, Durability: 83, RequiredLevel: 51, MaxSockets: 3, Type: "shie"},
-	468: {Name: "Alpha Helm", ID: 468, Code: "dr6", NormalCode: "dr1", UberCode: "dr6", UltraCode: "drb", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 52, MaxDefense: 62, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 44, RequiredDexterity: 0, Durability: 20, RequiredLevel: 26, MaxSockets: 3, Type: "pelt"},
-	469: {Name: "Griffon Headress", ID: 469, Code: "dr7", NormalCode: "dr2", UberCode: "dr7", UltraCode: "drc", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 46, MaxDefense: 68, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 50, RequiredDexterity: 0, Durability: 20, RequiredLevel: 30, MaxSockets: 3, Type: "pelt"},
-	470: {Name: "Hunter's Guise", ID: 470, Code: "dr8", NormalCode: "dr3", UberCode: "dr8", UltraCode: "drd", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 67, MaxDefense: 81, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 56, RequiredDexterity: 0, Durability: 20, RequiredLevel: 29, MaxSockets: 3, Type: "pelt"},
-	471: {Name: "Sacred Feathers", ID: 471, Code: "dr9", NormalCode: "dr4", UberCode: "dr9", UltraCode: "dre", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 58, MaxDefense: 87, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 62, RequiredDexterity: 0, Durability: 20, RequiredLevel: 32, MaxSockets: 3, Type: "pelt"},
-	472: {Name: "Totemic Mask", ID: 472, Code: "dra", NormalCode: "dr5", UberCode: "dra", UltraCode: "drf", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 73, MaxDefense: 98, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 65, RequiredDexterity: 0, Durability: 20, RequiredLevel: 41, MaxSockets: 3, Type: "pelt"},
-	473: {Name: "Jawbone Visor", ID: 473, Code: "ba6", NormalCode: "ba1", UberCode: "ba6", UltraCode: "bab", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 55, MaxDefense: 68, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 58, RequiredDexterity: 0, Durability: 25, RequiredLevel: 25, MaxSockets: 3, Type: "phlm"},
-	474: {Name: "Lion Helm", ID: 474, Code: "ba7", NormalCode: "ba2", UberCode: "ba7", UltraCode: "bac", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 63, MaxDefense: 75, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 73, RequiredDexterity: 0, Durability: 35, RequiredLevel: 29, MaxSockets: 3, Type: "phlm"},
-	475: {Name: "Rage Mask", ID: 475, Code: "ba8", NormalCode: "ba3", UberCode: "ba8", UltraCode: "bad", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 78, MaxDefense: 90, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 88, RequiredDexterity: 0, Durability: 45, RequiredLevel: 29, MaxSockets: 3, Type: "phlm"},
-	476: {Name: "Savage Helmet", ID: 476, Code: "ba9", NormalCode: "ba4", UberCode: "ba9", UltraCode: "bae", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 85, MaxDefense: 98, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 103, RequiredDexterity: 0, Durability: 50, RequiredLevel: 32, MaxSockets: 3, Type: "phlm"},
-	477: {Name: "Slayer Guard", ID: 477, Code: "baa", NormalCode: "ba5", UberCode: "baa", UltraCode: "baf", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 93, MaxDefense: 120, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 118, RequiredDexterity: 0, Durability: 55, RequiredLevel: 40, MaxSockets: 3, Type: "phlm"},
-	478: {Name: "Akaran Targe", ID: 478, Code: "pa6", NormalCode: "pa1", UberCode: "pa6", UltraCode: "pab", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 101, MaxDefense: 125, MinDamage: 12, MaxDamage: 16, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 44, RequiredDexterity: 0, Durability: 20, RequiredLevel: 26, MaxSockets: 4, Type: "ashd"},
-	479: {Name: "Akaran Rondache", ID: 479, Code: "pa7", NormalCode: "pa2", UberCode: "pa7", UltraCode: "pac", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 113, MaxDefense: 137, MinDamage: 15, MaxDamage: 20, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 59, RequiredDexterity: 0, Durability: 30, RequiredLevel: 30, MaxSockets: 4, Type: "ashd"},
-	480: {Name: "Protector Shield", ID: 480, Code: "pa8", NormalCode: "pa3", UberCode: "pa8", UltraCode: "pad", InventoryWidth: 2, InventoryHeight: 4, MinDefense: 129, MaxDefense: 153, MinDamage: 18, MaxDamage: 24, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 69, RequiredDexterity: 0, Durability: 40, RequiredLevel: 34, MaxSockets: 4, Type: "ashd"},
-	481: {Name: "Gilded Shield", ID: 481, Code: "pa9", NormalCode: "pa4", UberCode: "pa9", UltraCode: "pae", InventoryWidth: 2, InventoryHeight: 4, MinDefense: 144, MaxDefense: 168, MinDamage: 20, MaxDamage: 28, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 89, RequiredDexterity: 0, Durability: 50, RequiredLevel: 38, MaxSockets: 4, Type: "ashd"},
-	482: {Name: "Royal Shield", ID: 482, Code: "paa", NormalCode: "pa5", UberCode: "paa", UltraCode: "paf", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 156, MaxDefense: 181, MinDamage: 24, MaxDamage: 32, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 114, RequiredDexterity: 0, Durability: 60, RequiredLevel: 41, MaxSockets: 4, Type: "ashd"},
-	483: {Name: "Mummified Trophy", ID: 483, Code: "ne6", NormalCode: "ne1", UberCode: "ne6", UltraCode: "neb", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 38, MaxDefense: 48, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 38, RequiredDexterity: 0, Durability: 20, RequiredLevel: 24, MaxSockets: 2, Type: "head"},
-	484: {Name: "Fetish Trophy", ID: 484, Code: "ne7", NormalCode: "ne2", UberCode: "ne7", UltraCode: "neg", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 41, MaxDefense: 52, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 41, RequiredDexterity: 0, Durability: 20, RequiredLevel: 29, MaxSockets: 2, Type: "head"},
-	485: {Name: "Sexton Trophy", ID: 485, Code: "ne8", NormalCode: "ne3", UberCode: "ne8", UltraCode: "ned", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 44, MaxDefense: 55, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 47, RequiredDexterity: 0, Durability: 20, RequiredLevel: 33, MaxSockets: 2, Type: "head"},
-	486: {Name: "Cantor Trophy", ID: 486, Code: "ne9", NormalCode: "ne4", UberCode: "ne9", UltraCode: "nee", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 50, MaxDefense: 64, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 50, RequiredDexterity: 0, Durability: 20, RequiredLevel: 36, MaxSockets: 2, Type: "head"},
-	487: {Name: "Hierophant Trophy", ID: 487, Code: "nea", NormalCode: "ne5", UberCode: "nea", UltraCode: "nef", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 58, MaxDefense: 70, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 58, RequiredDexterity: 0, Durability: 20, RequiredLevel: 40, MaxSockets: 2, Type: "head"},
-	488: {Name: "Blood Spirit", ID: 488, Code: "drb", NormalCode: "dr1", UberCode: "dr6", UltraCode: "drb", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 101, MaxDefense: 145, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 86, RequiredDexterity: 0, Durability: 20, RequiredLevel: 46, MaxSockets: 3, Type: "pelt"},
-	489: {Name: "Sun Spirit", ID: 489, Code: "drc", NormalCode: "dr2", UberCode: "dr7", UltraCode: "drc", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 98, MaxDefense: 147, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 95, RequiredDexterity: 0, Durability: 20, RequiredLevel: 51, MaxSockets: 3, Type: "pelt"},
-	490: {Name: "Earth Spirit", ID: 490, Code: "drd", NormalCode: "dr3", UberCode: "dr8", UltraCode: "drd", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 107, MaxDefense: 152, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 104, RequiredDexterity: 0, Durability: 20, RequiredLevel: 57, MaxSockets: 3, Type: "pelt"},
-	491: {Name: "Sky Spirit", ID: 491, Code: "dre", NormalCode: "dr4", UberCode: "dr9", UltraCode: "dre", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 103, MaxDefense: 155, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 113, RequiredDexterity: 0, Durability: 20, RequiredLevel: 62, MaxSockets: 3, Type: "pelt"},
-	492: {Name: "Dream Spirit", ID: 492, Code: "drf", NormalCode: "dr5", UberCode: "dra", UltraCode: "drf", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 109, MaxDefense: 159, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 118, RequiredDexterity: 0, Durability: 20, RequiredLevel: 66, MaxSockets: 3, Type: "pelt"},
-	493: {Name: "Carnage Helm", ID: 493, Code: "bab", NormalCode: "ba1", UberCode: "ba6", UltraCode: "bab", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 102, MaxDefense: 147, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 106, RequiredDexterity: 0, Durability: 25, RequiredLevel: 45, MaxSockets: 3, Type: "phlm"},
-	494: {Name: "Fury Visor", ID: 494, Code: "bac", NormalCode: "ba2", UberCode: "ba7", UltraCode: "bac", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 105, MaxDefense: 150, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 129, RequiredDexterity: 0, Durability: 35, RequiredLevel: 49, MaxSockets: 3, Type: "phlm"},
-	495: {Name: "Destroyer Helm", ID: 495, Code: "bad", NormalCode: "ba3", UberCode: "ba8", UltraCode: "bad", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 111, MaxDefense: 156, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 151, RequiredDexterity: 0, Durability: 45, RequiredLevel: 54, MaxSockets: 3, Type: "phlm"},
-	496: {Name: "Conqueror Crown", ID: 496, Code: "bae", NormalCode: "ba4", UberCode: "ba9", UltraCode: "bae", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 114, MaxDefense: 159, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 174, RequiredDexterity: 0, Durability: 50, RequiredLevel: 60, MaxSockets: 3, Type: "phlm"},
-	497: {Name: "Guardian Crown", ID: 497, Code: "baf", NormalCode: "ba5", UberCode: "baa", UltraCode: "baf", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 117, MaxDefense: 168, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 196, RequiredDexterity: 0, Durability: 55, RequiredLevel: 65, MaxSockets: 3, Type: "phlm"},
-	498: {Name: "Sacred Targe", ID: 498, Code: "pab", NormalCode: "pa1", UberCode: "pa6", UltraCode: "pab", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 126, MaxDefense: 158, MinDamage: 22, MaxDamage: 70, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 86, RequiredDexterity: 0, Durability: 45, RequiredLevel: 47, MaxSockets: 4, Type: "ashd"},
-	499: {Name: "Sacred Rondache", ID: 499, Code: "pac", NormalCode: "pa2", UberCode: "pa7", UltraCode: "pac", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 138, MaxDefense: 164, MinDamage: 35, MaxDamage: 58, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 109, RequiredDexterity: 0, Durability: 68, RequiredLevel: 52, MaxSockets: 4, Type: "ashd"},
-	500: {Name: "Kurast Shield", ID: 500, Code: "pad", NormalCode: "pa3", UberCode: "pa8", UltraCode: "pad", InventoryWidth: 2, InventoryHeight: 4, MinDefense: 154, MaxDefense: 172, MinDamage: 10, MaxDamage: 82, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 124, RequiredDexterity: 0, Durability: 55, RequiredLevel: 55, MaxSockets: 4, Type: "ashd"},
-	501: {Name: "Zakarum Shield", ID: 501, Code: "pae", NormalCode: "pa4", UberCode: "pa9", UltraCode: "pae", InventoryWidth: 2, InventoryHeight: 4, MinDefense: 169, MaxDefense: 193, MinDamage: 46, MaxDamage: 46, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 142, RequiredDexterity: 0, Durability: 65, RequiredLevel: 61, MaxSockets: 4, Type: "ashd"},
-	502: {Name: "Vortex Shield", ID: 502, Code: "paf", NormalCode: "pa5", UberCode: "paa", UltraCode: "paf", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 182, MaxDefense: 225, MinDamage: 5, MaxDamage: 87, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 148, RequiredDexterity: 0, Durability: 90, RequiredLevel: 66, MaxSockets: 4, Type: "ashd"},
-	503: {Name: "Minion Skull", ID: 503, Code: "neb", NormalCode: "ne1", UberCode: "ne6", UltraCode: "neb", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 95, MaxDefense: 139, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 77, RequiredDexterity: 0, Durability: 20, RequiredLevel: 44, MaxSockets: 2, Type: "head"},
-	504: {Name: "Hellspawn Skull", ID: 504, Code: "neg", NormalCode: "ne2", UberCode: "ne7", UltraCode: "neg", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 96, MaxDefense: 141, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 82, RequiredDexterity: 0, Durability: 20, RequiredLevel: 50, MaxSockets: 2, Type: "head"},
-	505: {Name: "Overseer Skull", ID: 505, Code: "ned", NormalCode: "ne3", UberCode: "ne8", UltraCode: "ned", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 98, MaxDefense: 142, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 91, RequiredDexterity: 0, Durability: 20, RequiredLevel: 49, MaxSockets: 2, Type: "head"},
-	506: {Name: "Succubus Skull", ID: 506, Code: "nee", NormalCode: "ne4", UberCode: "ne9", UltraCode: "nee", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 100, MaxDefense: 146, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 95, RequiredDexterity: 0, Durability: 20, RequiredLevel: 60, MaxSockets: 2, Type: "head"},
-	507: {Name: "Bloodlord Skull", ID: 507, Code: "nef", NormalCode: "ne5", UberCode: "nea", UltraCode: "nef", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 103, MaxDefense: 148, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 106, RequiredDexterity: 0, Durability: 20, RequiredLevel: 65, MaxSockets: 2, Type: "head"},
+	0:   {Name: "Hand Axe", ID: 0, Code: "hax", NormalCode: "hax", UberCode: "9ha", UltraCode: "7ha", InventoryWidth: 1, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 3, MaxDamage: 6, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 28, RequiredLevel: 0, MaxSockets: 2, Range: 0, Type: "axe"},
+	1:   {Name: "Axe", ID: 1, Code: "axe", NormalCode: "axe", UberCode: "9ax", UltraCode: "7ax", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 4, MaxDamage: 11, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 32, RequiredDexterity: 0, Durability: 24, RequiredLevel: 0, MaxSockets: 4, Range: 1, Type: "axe"},
+	2:   {Name: "Double Axe", ID: 2, Code: "2ax", NormalCode: "2ax", UberCode: "92a", UltraCode: "72a", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 5, MaxDamage: 13, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 43, RequiredDexterity: 0, Durability: 24, RequiredLevel: 0, MaxSockets: 5, Range: 1, Type: "axe"},
+	3:   {Name: "Military Pick", ID: 3, Code: "mpi", NormalCode: "mpi", UberCode: "9mp", UltraCode: "7mp", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 7, MaxDamage: 11, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 49, RequiredDexterity: 33, Durability: 26, RequiredLevel: 0, MaxSockets: 6, Range: 1, Type: "axe"},
+	4:   {Name: "War Axe", ID: 4, Code: "wax", NormalCode: "wax", UberCode: "9wa", UltraCode: "7wa", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 10, MaxDamage: 18, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 67, RequiredDexterity: 0, Durability: 26, RequiredLevel: 0, MaxSockets: 6, Range: 2, Type: "axe"},
+	5:   {Name: "Large Axe", ID: 5, Code: "lax", NormalCode: "lax", UberCode: "9la", UltraCode: "7la", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 6, TwoHandMaxDamage: 13, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 35, RequiredDexterity: 0, Durability: 30, RequiredLevel: 0, MaxSockets: 4, Range: 1, Type: "axe"},
+	6:   {Name: "Broad Axe ", ID: 6, Code: "bax", NormalCode: "bax", UberCode: "9ba", UltraCode: "7ba", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 10, TwoHandMaxDamage: 18, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 48, RequiredDexterity: 0, Durability: 35, RequiredLevel: 0, MaxSockets: 5, Range: 1, Type: "axe"},
+	7:   {Name: "Battle Axe", ID: 7, Code: "btx", NormalCode: "btx", UberCode: "9bt", UltraCode: "7bt", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 12, TwoHandMaxDamage: 32, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 54, RequiredDexterity: 0, Durability: 40, RequiredLevel: 0, MaxSockets: 5, Range: 1, Type: "axe"},
+	8:   {Name: "Great Axe", ID: 8, Code: "gax", NormalCode: "gax", UberCode: "9ga", UltraCode: "7ga", InventoryWidth: 2, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 9, TwoHandMaxDamage: 30, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 63, RequiredDexterity: 39, Durability: 50, RequiredLevel: 0, MaxSockets: 6, Range: 2, Type: "axe"},
+	9:   {Name: "Giant Axe", ID: 9, Code: "gix", NormalCode: "gix", UberCode: "9gi", UltraCode: "7gi", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 22, TwoHandMaxDamage: 45, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 70, RequiredDexterity: 0, Durability: 50, RequiredLevel: 0, MaxSockets: 6, Range: 3, Type: "axe"},
+	10:  {Name: "Wand", ID: 10, Code: "wnd", NormalCode: "wnd", UberCode: "9wn", UltraCode: "7wn", InventoryWidth: 1, InventoryHeight: 2, MinDefense: 0, MaxDefense: 0, MinDamage: 2, MaxDamage: 4, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 15, RequiredLevel: 0, MaxSockets: 1, Range: 0, Type: "wand"},
+	11:  {Name: "Yew Wand", ID: 11, Code: "ywn", NormalCode: "ywn", UberCode: "9yw", UltraCode: "7yw", InventoryWidth: 1, InventoryHeight: 2, MinDefense: 0, MaxDefense: 0, MinDamage: 2, MaxDamage: 8, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 15, RequiredLevel: 0, MaxSockets: 1, Range: 0, Type: "wand"},
+	12:  {Name: "Bone Wand", ID: 12, Code: "bwn", NormalCode: "bwn", UberCode: "9bw", UltraCode: "7bw", InventoryWidth: 1, InventoryHeight: 2, MinDefense: 0, MaxDefense: 0, MinDamage: 3, MaxDamage: 7, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -20, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 15, RequiredLevel: 0, MaxSockets: 2, Range: 0, Type: "wand"},
+	13:  {Name: "Grim Wand", ID: 13, Code: "gwn", NormalCode: "gwn", UberCode: "9gw", UltraCode: "7gw", InventoryWidth: 1, InventoryHeight: 2, MinDefense: 0, MaxDefense: 0, MinDamage: 5, MaxDamage: 11, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 15, RequiredLevel: 0, MaxSockets: 2, Range: 0, Type: "wand"},
+	14:  {Name: "Club", ID: 14, Code: "clb", NormalCode: "clb", UberCode: "9cl", UltraCode: "7cl", InventoryWidth: 1, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 1, MaxDamage: 6, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 24, RequiredLevel: 0, MaxSockets: 2, Range: 0, Type: "club"},
+	15:  {Name: "Scepter", ID: 15, Code: "scp", NormalCode: "scp", UberCode: "9sc", UltraCode: "7sc", InventoryWidth: 1, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 6, MaxDamage: 11, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 25, RequiredDexterity: 0, Durability: 50, RequiredLevel: 0, MaxSockets: 2, Range: 0, Type: "scep"},
+	16:  {Name: "Grand Scepter", ID: 16, Code: "gsc", NormalCode: "gsc", UberCode: "9qs", UltraCode: "7qs", InventoryWidth: 1, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 8, MaxDamage: 18, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 37, RequiredDexterity: 0, Durability: 60, RequiredLevel: 0, MaxSockets: 3, Range: 1, Type: "scep"},
+	17:  {Name: "War Scepter", ID: 17, Code: "wsp", NormalCode: "wsp", UberCode: "9ws", UltraCode: "7ws", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 10, MaxDamage: 17, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 55, RequiredDexterity: 0, Durability: 70, RequiredLevel: 0, MaxSockets: 5, Range: 1, Type: "scep"},
+	18:  {Name: "Spiked Club", ID: 18, Code: "spc", NormalCode: "spc", UberCode: "9sp", UltraCode: "7sp", InventoryWidth: 1, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 5, MaxDamage: 8, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 36, RequiredLevel: 0, MaxSockets: 2, Range: 1, Type: "club"},
+	19:  {Name: "Mace", ID: 19, Code: "mac", NormalCode: "mac", UberCode: "9ma", UltraCode: "7ma", InventoryWidth: 1, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 3, MaxDamage: 10, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 27, RequiredDexterity: 0, Durability: 60, RequiredLevel: 0, MaxSockets: 2, Range: 0, Type: "mace"},
+	20:  {Name: "Morning Star", ID: 20, Code: "mst", NormalCode: "mst", UberCode: "9mt", UltraCode: "7mt", InventoryWidth: 1, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 7, MaxDamage: 16, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 36, RequiredDexterity: 0, Durability: 72, RequiredLevel: 0, MaxSockets: 3, Range: 1, Type: "mace"},
+	21:  {Name: "Flail", ID: 21, Code: "fla", NormalCode: "fla", UberCode: "9fl", UltraCode: "7fl", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 1, MaxDamage: 24, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 41, RequiredDexterity: 35, Durability: 30, RequiredLevel: 0, MaxSockets: 5, Range: 2, Type: "mace"},
+	22:  {Name: "War Hammer", ID: 22, Code: "whm", NormalCode: "whm", UberCode: "9wh", UltraCode: "7wh", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 19, MaxDamage: 29, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 20, StrengthBonus: 110, DexterityBonus: 0, RequiredStrength: 53, RequiredDexterity: 0, Durability: 55, RequiredLevel: 0, MaxSockets: 4, Range: 0, Type: "hamm"},
+	23:  {Name: "Maul", ID: 23, Code: "mau", NormalCode: "mau", UberCode: "9m9", UltraCode: "7m7", InventoryWidth: 2, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 30, TwoHandMaxDamage: 43, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 10, StrengthBonus: 110, DexterityBonus: 0, RequiredStrength: 69, RequiredDexterity: 0, Durability: 60, RequiredLevel: 0, MaxSockets: 6, Range: 1, Type: "hamm"},
+	24:  {Name: "Great Maul", ID: 24, Code: "gma", NormalCode: "gma", UberCode: "9gm", UltraCode: "7gm", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 38, TwoHandMaxDamage: 58, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 20, StrengthBonus: 110, DexterityBonus: 0, RequiredStrength: 99, RequiredDexterity: 0, Durability: 60, RequiredLevel: 0, MaxSockets: 6, Range: 2, Type: "hamm"},
+	25:  {Name: "Short Sword", ID: 25, Code: "ssd", NormalCode: "ssd", UberCode: "9ss", UltraCode: "7ss", InventoryWidth: 1, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 2, MaxDamage: 7, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 24, RequiredLevel: 0, MaxSockets: 2, Range: 0, Type: "swor"},
+	26:  {Name: "Scimitar", ID: 26, Code: "scm", NormalCode: "scm", UberCode: "9sm", UltraCode: "7sm", InventoryWidth: 1, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 2, MaxDamage: 6, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -20, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 21, Durability: 22, RequiredLevel: 0, MaxSockets: 2, Range: 0, Type: "swor"},
+	27:  {Name: "Saber", ID: 27, Code: "sbr", NormalCode: "sbr", UberCode: "9sb", UltraCode: "7sb", InventoryWidth: 1, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 3, MaxDamage: 8, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 25, RequiredDexterity: 25, Durability: 32, RequiredLevel: 0, MaxSockets: 2, Range: 0, Type: "swor"},
+	28:  {Name: "Falchion", ID: 28, Code: "flc", NormalCode: "flc", UberCode: "9fc", UltraCode: "7fc", InventoryWidth: 1, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 9, MaxDamage: 17, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 20, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 33, RequiredDexterity: 0, Durability: 32, RequiredLevel: 0, MaxSockets: 2, Range: 0, Type: "swor"},
+	29:  {Name: "Crystal Sword", ID: 29, Code: "crs", NormalCode: "crs", UberCode: "9cr", UltraCode: "7cr", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 5, MaxDamage: 15, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 43, RequiredDexterity: 0, Durability: 20, RequiredLevel: 0, MaxSockets: 6, Range: 1, Type: "swor"},
+	30:  {Name: "Broad Sword", ID: 30, Code: "bsd", NormalCode: "bsd", UberCode: "9bs", UltraCode: "7bs", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 7, MaxDamage: 14, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 48, RequiredDexterity: 0, Durability: 32, RequiredLevel: 0, MaxSockets: 4, Range: 0, Type: "swor"},
+	31:  {Name: "Long Sword ", ID: 31, Code: "lsd", NormalCode: "lsd", UberCode: "9ls", UltraCode: "7ls", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 3, MaxDamage: 19, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 55, RequiredDexterity: 39, Durability: 44, RequiredLevel: 0, MaxSockets: 4, Range: 1, Type: "swor"},
+	32:  {Name: "War Sword", ID: 32, Code: "wsd", NormalCode: "wsd", UberCode: "9wd", UltraCode: "7wd", InventoryWidth: 1, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 8, MaxDamage: 20, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 71, RequiredDexterity: 45, Durability: 44, RequiredLevel: 0, MaxSockets: 3, Range: 1, Type: "swor"},
+	33:  {Name: "Two-Handed Sword", ID: 33, Code: "2hs", NormalCode: "2hs", UberCode: "92h", UltraCode: "72h", InventoryWidth: 1, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 2, MaxDamage: 9, TwoHandMinDamage: 8, TwoHandMaxDamage: 17, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 35, RequiredDexterity: 27, Durability: 44, RequiredLevel: 0, MaxSockets: 3, Range: 2, Type: "swor"},
+	34:  {Name: "Claymore", ID: 34, Code: "clm", NormalCode: "clm", UberCode: "9cm", UltraCode: "7cm", InventoryWidth: 1, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 5, MaxDamage: 12, TwoHandMinDamage: 13, TwoHandMaxDamage: 30, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 47, RequiredDexterity: 0, Durability: 50, RequiredLevel: 0, MaxSockets: 4, Range: 2, Type: "swor"},
+	35:  {Name: "Giant Sword", ID: 35, Code: "gis", NormalCode: "gis", UberCode: "9gs", UltraCode: "7gs", InventoryWidth: 1, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 3, MaxDamage: 16, TwoHandMinDamage: 9, TwoHandMaxDamage: 28, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 56, RequiredDexterity: 34, Durability: 50, RequiredLevel: 0, MaxSockets: 4, Range: 2, Type: "swor"},
+	36:  {Name: "Bastard Sword", ID: 36, Code: "bsw", NormalCode: "bsw", UberCode: "9b9", UltraCode: "7b7", InventoryWidth: 1, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 7, MaxDamage: 19, TwoHandMinDamage: 20, TwoHandMaxDamage: 28, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 62, RequiredDexterity: 0, Durability: 40, RequiredLevel: 0, MaxSockets: 4, Range: 1, Type: "swor"},
+	37:  {Name: "Flamberge", ID: 37, Code: "flb", NormalCode: "flb", UberCode: "9fb", UltraCode: "7fb", InventoryWidth: 2, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 9, MaxDamage: 15, TwoHandMinDamage: 13, TwoHandMaxDamage: 26, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 70, RequiredDexterity: 49, Durability: 50, RequiredLevel: 0, MaxSockets: 5, Range: 2, Type: "swor"},
+	38:  {Name: "Great Sword", ID: 38, Code: "gsd", NormalCode: "gsd", UberCode: "9gd", UltraCode: "7gd", InventoryWidth: 2, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 12, MaxDamage: 20, TwoHandMinDamage: 25, TwoHandMaxDamage: 42, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 100, RequiredDexterity: 60, Durability: 50, RequiredLevel: 0, MaxSockets: 6, Range: 2, Type: "swor"},
+	39:  {Name: "Dagger", ID: 39, Code: "dgr", NormalCode: "dgr", UberCode: "9dg", UltraCode: "7dg", InventoryWidth: 1, InventoryHeight: 2, MinDefense: 0, MaxDefense: 0, MinDamage: 1, MaxDamage: 4, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -20, StrengthBonus: 75, DexterityBonus: 75, RequiredStrength: 0, RequiredDexterity: 0, Durability: 16, RequiredLevel: 0, MaxSockets: 1, Range: 0, Type: "knif"},
+	40:  {Name: "Dirk", ID: 40, Code: "dir", NormalCode: "dir", UberCode: "9di", UltraCode: "7di", InventoryWidth: 1, InventoryHeight: 2, MinDefense: 0, MaxDefense: 0, MinDamage: 3, MaxDamage: 9, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 75, DexterityBonus: 75, RequiredStrength: 0, RequiredDexterity: 25, Durability: 20, RequiredLevel: 0, MaxSockets: 1, Range: 0, Type: "knif"},
+	41:  {Name: "Kriss", ID: 41, Code: "kri", NormalCode: "kri", UberCode: "9kr", UltraCode: "7kr", InventoryWidth: 1, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 2, MaxDamage: 11, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -20, StrengthBonus: 75, DexterityBonus: 75, RequiredStrength: 0, RequiredDexterity: 45, Durability: 24, RequiredLevel: 0, MaxSockets: 3, Range: 0, Type: "knif"},
+	42:  {Name: "Blade", ID: 42, Code: "bld", NormalCode: "bld", UberCode: "9bl", UltraCode: "7bl", InventoryWidth: 1, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 4, MaxDamage: 15, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -10, StrengthBonus: 75, DexterityBonus: 75, RequiredStrength: 35, RequiredDexterity: 51, Durability: 24, RequiredLevel: 0, MaxSockets: 2, Range: 0, Type: "knif"},
+	43:  {Name: "Throwing Knife", ID: 43, Code: "tkf", NormalCode: "tkf", UberCode: "9tk", UltraCode: "7tk", InventoryWidth: 1, InventoryHeight: 2, MinDefense: 0, MaxDefense: 0, MinDamage: 2, MaxDamage: 3, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 4, MaxMissileDamage: 9, Speed: 0, StrengthBonus: 75, DexterityBonus: 75, RequiredStrength: 0, RequiredDexterity: 21, Durability: 4, RequiredLevel: 0, MaxSockets: 0, Range: 0, Type: "tkni"},
+	44:  {Name: "Throwing Axe", ID: 44, Code: "tax", NormalCode: "tax", UberCode: "9ta", UltraCode: "7ta", InventoryWidth: 1, InventoryHeight: 2, MinDefense: 0, MaxDefense: 0, MinDamage: 4, MaxDamage: 7, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 8, MaxMissileDamage: 12, Speed: 10, StrengthBonus: 75, DexterityBonus: 75, RequiredStrength: 0, RequiredDexterity: 40, Durability: 6, RequiredLevel: 0, MaxSockets: 0, Range: 0, Type: "taxe"},
+	45:  {Name: "Balanced Knife", ID: 45, Code: "bkf", NormalCode: "bkf", UberCode: "9bk", UltraCode: "7bk", InventoryWidth: 1, InventoryHeight: 2, MinDefense: 0, MaxDefense: 0, MinDamage: 1, MaxDamage: 8, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 6, MaxMissileDamage: 11, Speed: -20, StrengthBonus: 75, DexterityBonus: 75, RequiredStrength: 0, RequiredDexterity: 51, Durability: 8, RequiredLevel: 0, MaxSockets: 0, Range: 0, Type: "tkni"},
+	46:  {Name: "Balanced Axe", ID: 46, Code: "bal", NormalCode: "bal", UberCode: "9b8", UltraCode: "7b8", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 5, MaxDamage: 10, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 12, MaxMissileDamage: 15, Speed: -10, StrengthBonus: 75, DexterityBonus: 75, RequiredStrength: 0, RequiredDexterity: 57, Durability: 10, RequiredLevel: 0, MaxSockets: 0, Range: 0, Type: "taxe"},
+	47:  {Name: "Javelin", ID: 47, Code: "jav", NormalCode: "jav", UberCode: "9ja", UltraCode: "7ja", InventoryWidth: 1, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 1, MaxDamage: 5, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 6, MaxMissileDamage: 14, Speed: -10, StrengthBonus: 75, DexterityBonus: 75, RequiredStrength: 0, RequiredDexterity: 0, Durability: 2, RequiredLevel: 0, MaxSockets: 0, Range: 2, Type: "jave"},
+	48:  {Name: "Pilum", ID: 48, Code: "pil", NormalCode: "pil", UberCode: "9pi", UltraCode: "7pi", InventoryWidth: 1, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 4, MaxDamage: 9, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 7, MaxMissileDamage: 20, Speed: 0, StrengthBonus: 75, DexterityBonus: 75, RequiredStrength: 0, RequiredDexterity: 45, Durability: 3, RequiredLevel: 0, MaxSockets: 0, Range: 2, Type: "jave"},
+	49:  {Name: "Short Spear", ID: 49, Code: "ssp", NormalCode: "ssp", UberCode: "9s9", UltraCode: "7s7", InventoryWidth: 1, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 2, MaxDamage: 13, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 10, MaxMissileDamage: 22, Speed: 10, StrengthBonus: 75, DexterityBonus: 75, RequiredStrength: 40, RequiredDexterity: 40, Durability: 4, RequiredLevel: 0, MaxSockets: 0, Range: 2, Type: "jave"},
+	50:  {Name: "Glaive", ID: 50, Code: "glv", NormalCode: "glv", UberCode: "9gl", UltraCode: "7gl", InventoryWidth: 1, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 5, MaxDamage: 17, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 16, MaxMissileDamage: 22, Speed: 20, StrengthBonus: 75, DexterityBonus: 75, RequiredStrength: 52, RequiredDexterity: 35, Durability: 5, RequiredLevel: 0, MaxSockets: 0, Range: 2, Type: "jave"},
+	51:  {Name: "Throwing Spear", ID: 51, Code: "tsp", NormalCode: "tsp", UberCode: "9ts", UltraCode: "7ts", InventoryWidth: 1, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 5, MaxDamage: 15, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 12, MaxMissileDamage: 30, Speed: -10, StrengthBonus: 75, DexterityBonus: 75, RequiredStrength: 0, RequiredDexterity: 65, Durability: 6, RequiredLevel: 0, MaxSockets: 0, Range: 2, Type: "jave"},
+	52:  {Name: "Spear", ID: 52, Code: "spr", NormalCode: "spr", UberCode: "9sr", UltraCode: "7sr", InventoryWidth: 2, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 3, TwoHandMaxDamage: 15, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 20, Durability: 30, RequiredLevel: 0, MaxSockets: 3, Range: 3, Type: "spea"},
+	53:  {Name: "Trident", ID: 53, Code: "tri", NormalCode: "tri", UberCode: "9tr", UltraCode: "7tr", InventoryWidth: 2, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 9, TwoHandMaxDamage: 15, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 38, RequiredDexterity: 24, Durability: 35, RequiredLevel: 0, MaxSockets: 4, Range: 3, Type: "spea"},
+	54:  {Name: "Brandistock", ID: 54, Code: "brn", NormalCode: "brn", UberCode: "9br", UltraCode: "7br", InventoryWidth: 2, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 7, TwoHandMaxDamage: 17, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -20, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 40, RequiredDexterity: 50, Durability: 28, RequiredLevel: 0, MaxSockets: 5, Range: 4, Type: "spea"},
+	55:  {Name: "Spetum", ID: 55, Code: "spt", NormalCode: "spt", UberCode: "9st", UltraCode: "7st", InventoryWidth: 2, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 15, TwoHandMaxDamage: 23, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 54, RequiredDexterity: 35, Durability: 28, RequiredLevel: 0, MaxSockets: 6, Range: 4, Type: "spea"},
+	56:  {Name: "Pike", ID: 56, Code: "pik", NormalCode: "pik", UberCode: "9p9", UltraCode: "7p7", InventoryWidth: 2, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 14, TwoHandMaxDamage: 63, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 20, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 60, RequiredDexterity: 45, Durability: 25, RequiredLevel: 0, MaxSockets: 6, Range: 4, Type: "spea"},
+	57:  {Name: "Bardiche", ID: 57, Code: "bar", NormalCode: "bar", UberCode: "9b7", UltraCode: "7o7", InventoryWidth: 2, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 1, TwoHandMaxDamage: 27, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 40, RequiredDexterity: 0, Durability: 50, RequiredLevel: 0, MaxSockets: 3, Range: 2, Type: "pole"},
+	58:  {Name: "Voulge", ID: 58, Code: "vou", NormalCode: "vou", UberCode: "9vo", UltraCode: "7vo", InventoryWidth: 2, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 6, TwoHandMaxDamage: 21, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 50, RequiredDexterity: 0, Durability: 50, RequiredLevel: 0, MaxSockets: 4, Range: 2, Type: "pole"},
+	59:  {Name: "Scythe", ID: 59, Code: "scy", NormalCode: "scy", UberCode: "9s8", UltraCode: "7s8", InventoryWidth: 2, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 8, TwoHandMaxDamage: 20, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 41, RequiredDexterity: 41, Durability: 65, RequiredLevel: 0, MaxSockets: 5, Range: 1, Type: "pole"},
+	60:  {Name: "Poleaxe", ID: 60, Code: "pax", NormalCode: "pax", UberCode: "9pa", UltraCode: "7pa", InventoryWidth: 2, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 18, TwoHandMaxDamage: 39, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 62, RequiredDexterity: 0, Durability: 65, RequiredLevel: 0, MaxSockets: 5, Range: 3, Type: "pole"},
+	61:  {Name: "Halberd", ID: 61, Code: "hal", NormalCode: "hal", UberCode: "9h9", UltraCode: "7h7", InventoryWidth: 2, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 12, TwoHandMaxDamage: 45, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 75, RequiredDexterity: 47, Durability: 55, RequiredLevel: 0, MaxSockets: 6, Range: 4, Type: "pole"},
+	62:  {Name: "War Scythe", ID: 62, Code: "wsc", NormalCode: "wsc", UberCode: "9wc", UltraCode: "7wc", InventoryWidth: 2, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 15, TwoHandMaxDamage: 36, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 80, RequiredDexterity: 80, Durability: 55, RequiredLevel: 0, MaxSockets: 6, Range: 4, Type: "pole"},
+	63:  {Name: "Short Staff", ID: 63, Code: "sst", NormalCode: "sst", UberCode: "8ss", UltraCode: "6ss", InventoryWidth: 1, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 1, TwoHandMaxDamage: 5, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 20, RequiredLevel: 0, MaxSockets: 2, Range: 1, Type: "staf"},
+	64:  {Name: "Long Staff", ID: 64, Code: "lst", NormalCode: "lst", UberCode: "8ls", UltraCode: "6ls", InventoryWidth: 1, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 2, TwoHandMaxDamage: 8, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 30, RequiredLevel: 0, MaxSockets: 3, Range: 1, Type: "staf"},
+	65:  {Name: "Gnarled Staff", ID: 65, Code: "cst", NormalCode: "cst", UberCode: "8cs", UltraCode: "6cs", InventoryWidth: 1, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 4, TwoHandMaxDamage: 12, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 35, RequiredLevel: 0, MaxSockets: 4, Range: 1, Type: "staf"},
+	66:  {Name: "Battle Staff", ID: 66, Code: "bst", NormalCode: "bst", UberCode: "8bs", UltraCode: "6bs", InventoryWidth: 1, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 6, TwoHandMaxDamage: 13, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 40, RequiredLevel: 0, MaxSockets: 4, Range: 1, Type: "staf"},
+	67:  {Name: "War Staff", ID: 67, Code: "wst", NormalCode: "wst", UberCode: "8ws", UltraCode: "6ws", InventoryWidth: 2, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 12, TwoHandMaxDamage: 28, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 20, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 50, RequiredLevel: 0, MaxSockets: 6, Range: 1, Type: "staf"},
+	68:  {Name: "Short Bow", ID: 68, Code: "sbw", NormalCode: "sbw", UberCode: "8sb", UltraCode: "6sb", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 1, TwoHandMaxDamage: 4, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 5, StrengthBonus: 0, DexterityBonus: 100, RequiredStrength: 0, RequiredDexterity: 15, Durability: 20, RequiredLevel: 0, MaxSockets: 3, Range: 0, Type: "bow"},
+	69:  {Name: "Hunter's Bow", ID: 69, Code: "hbw", NormalCode: "hbw", UberCode: "8hb", UltraCode: "6hb", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 2, TwoHandMaxDamage: 6, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -10, StrengthBonus: 0, DexterityBonus: 100, RequiredStrength: 0, RequiredDexterity: 28, Durability: 32, RequiredLevel: 0, MaxSockets: 4, Range: 0, Type: "bow"},
+	70:  {Name: "Long Bow", ID: 70, Code: "lbw", NormalCode: "lbw", UberCode: "8lb", UltraCode: "6lb", InventoryWidth: 2, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 3, TwoHandMaxDamage: 10, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 100, RequiredStrength: 22, RequiredDexterity: 19, Durability: 28, RequiredLevel: 0, MaxSockets: 5, Range: 0, Type: "bow"},
+	71:  {Name: "Composite Bow", ID: 71, Code: "cbw", NormalCode: "cbw", UberCode: "8cb", UltraCode: "6cb", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 4, TwoHandMaxDamage: 8, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -10, StrengthBonus: 0, DexterityBonus: 100, RequiredStrength: 25, RequiredDexterity: 35, Durability: 36, RequiredLevel: 0, MaxSockets: 4, Range: 0, Type: "bow"},
+	72:  {Name: "Short Battle Bow", ID: 72, Code: "sbb", NormalCode: "sbb", UberCode: "8s8", UltraCode: "6s7", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 5, TwoHandMaxDamage: 11, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 100, RequiredStrength: 30, RequiredDexterity: 40, Durability: 40, RequiredLevel: 0, MaxSockets: 5, Range: 0, Type: "bow"},
+	73:  {Name: "Long Battle Bow", ID: 73, Code: "lbb", NormalCode: "lbb", UberCode: "8l8", UltraCode: "6l7", InventoryWidth: 2, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 3, TwoHandMaxDamage: 18, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 10, StrengthBonus: 0, DexterityBonus: 100, RequiredStrength: 40, RequiredDexterity: 50, Durability: 44, RequiredLevel: 0, MaxSockets: 6, Range: 0, Type: "bow"},
+	74:  {Name: "Short War Bow", ID: 74, Code: "swb", NormalCode: "swb", UberCode: "8sw", UltraCode: "6sw", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 6, TwoHandMaxDamage: 14, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 100, RequiredStrength: 35, RequiredDexterity: 55, Durability: 48, RequiredLevel: 0, MaxSockets: 5, Range: 0, Type: "bow"},
+	75:  {Name: "Long War Bow", ID: 75, Code: "lwb", NormalCode: "lwb", UberCode: "8lw", UltraCode: "6lw", InventoryWidth: 2, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 3, TwoHandMaxDamage: 23, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 10, StrengthBonus: 0, DexterityBonus: 100, RequiredStrength: 50, RequiredDexterity: 65, Durability: 55, RequiredLevel: 0, MaxSockets: 6, Range: 0, Type: "bow"},
+	76:  {Name: "Light Crossbow", ID: 76, Code: "lxb", NormalCode: "lxb", UberCode: "8lx", UltraCode: "6lx", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 6, TwoHandMaxDamage: 9, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -10, StrengthBonus: 0, DexterityBonus: 100, RequiredStrength: 21, RequiredDexterity: 27, Durability: 30, RequiredLevel: 0, MaxSockets: 3, Range: 0, Type: "xbow"},
+	77:  {Name: "Crossbow", ID: 77, Code: "mxb", NormalCode: "mxb", UberCode: "8mx", UltraCode: "6mx", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 9, TwoHandMaxDamage: 16, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 100, RequiredStrength: 40, RequiredDexterity: 33, Durability: 40, RequiredLevel: 0, MaxSockets: 4, Range: 0, Type: "xbow"},
+	78:  {Name: "Heavy Crossbow", ID: 78, Code: "hxb", NormalCode: "hxb", UberCode: "8hx", UltraCode: "6hx", InventoryWidth: 2, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 14, TwoHandMaxDamage: 26, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 10, StrengthBonus: 0, DexterityBonus: 100, RequiredStrength: 60, RequiredDexterity: 40, Durability: 50, RequiredLevel: 0, MaxSockets: 6, Range: 0, Type: "xbow"},
+	79:  {Name: "Repeating Crossbow", ID: 79, Code: "rxb", NormalCode: "rxb", UberCode: "8rx", UltraCode: "6rx", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 6, TwoHandMaxDamage: 12, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -40, StrengthBonus: 0, DexterityBonus: 100, RequiredStrength: 40, RequiredDexterity: 50, Durability: 40, RequiredLevel: 0, MaxSockets: 5, Range: 0, Type: "xbow"},
+	80:  {Name: "Rancid Gas Potion", ID: 80, Code: "gps", NormalCode: "gps", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 1, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 2, RequiredLevel: 24, MaxSockets: 0, Range: 0, Type: "tpot"},
+	81:  {Name: "Oil Potion", ID: 81, Code: "ops", NormalCode: "ops", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 1, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 2, RequiredLevel: 20, MaxSockets: 0, Range: 0, Type: "tpot"},
+	82:  {Name: "Choking Gas Potion", ID: 82, Code: "gpm", NormalCode: "gpm", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 1, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 2, RequiredLevel: 16, MaxSockets: 0, Range: 0, Type: "tpot"},
+	83:  {Name: "Exploding Potion", ID: 83, Code: "opm", NormalCode: "opm", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 1, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 2, RequiredLevel: 12, MaxSockets: 0, Range: 0, Type: "tpot"},
+	84:  {Name: "Strangling Gas Potion", ID: 84, Code: "gpl", NormalCode: "gpl", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 1, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 2, RequiredLevel: 6, MaxSockets: 0, Range: 0, Type: "tpot"},
+	85:  {Name: "Fulminating Potion", ID: 85, Code: "opl", NormalCode: "opl", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 1, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 2, RequiredLevel: 0, MaxSockets: 0, Range: 0, Type: "tpot"},
+	86:  {Name: "Decoy Gidbinn", ID: 86, Code: "d33", NormalCode: "d33", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 2, MinDefense: 0, MaxDefense: 0, MinDamage: 1, MaxDamage: 2, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -20, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 15, RequiredDexterity: 20, Durability: 10, RequiredLevel: 0, MaxSockets: 0, Range: 0, Type: "knif"},
+	87:  {Name: "The Gidbinn", ID: 87, Code: "g33", NormalCode: "g33", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 2, MinDefense: 0, MaxDefense: 0, MinDamage: 3, MaxDamage: 7, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -20, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 15, RequiredDexterity: 25, Durability: 30, RequiredLevel: 0, MaxSockets: 0, Range: 0, Type: "knif"},
+	88:  {Name: "Wirt's Leg", ID: 88, Code: "leg", NormalCode: "leg", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 2, MaxDamage: 8, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 66, RequiredLevel: 0, MaxSockets: 3, Range: 0, Type: "club"},
+	89:  {Name: "Horadric Malus", ID: 89, Code: "hdm", NormalCode: "hdm", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 2, MinDefense: 0, MaxDefense: 0, MinDamage: 6, MaxDamage: 15, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 20, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 15, RequiredDexterity: 15, Durability: 55, RequiredLevel: 0, MaxSockets: 0, Range: 0, Type: "hamm"},
+	90:  {Name: "Hellforge Hammer", ID: 90, Code: "hfh", NormalCode: "hfh", UberCode: "", UltraCode: "", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 6, MaxDamage: 15, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 55, RequiredLevel: 0, MaxSockets: 5, Range: 0, Type: "hamm"},
+	91:  {Name: "Horadric Staff", ID: 91, Code: "hst", NormalCode: "hst", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 12, TwoHandMaxDamage: 20, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 30, RequiredDexterity: 0, Durability: 50, RequiredLevel: 0, MaxSockets: 0, Range: 2, Type: "staf"},
+	92:  {Name: "Staff of Kings", ID: 92, Code: "msf", NormalCode: "msf", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 10, TwoHandMaxDamage: 15, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 25, RequiredDexterity: 0, Durability: 45, RequiredLevel: 0, MaxSockets: 0, Range: 2, Type: "staf"},
+	93:  {Name: "Hatchet", ID: 93, Code: "9ha", NormalCode: "hax", UberCode: "9ha", UltraCode: "7ha", InventoryWidth: 1, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 10, MaxDamage: 21, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 25, RequiredDexterity: 25, Durability: 28, RequiredLevel: 19, MaxSockets: 2, Range: 0, Type: "axe"},
+	94:  {Name: "Cleaver", ID: 94, Code: "9ax", NormalCode: "axe", UberCode: "9ax", UltraCode: "7ax", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 10, MaxDamage: 33, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 68, RequiredDexterity: 0, Durability: 24, RequiredLevel: 22, MaxSockets: 4, Range: 1, Type: "axe"},
+	95:  {Name: "Twin Axe", ID: 95, Code: "92a", NormalCode: "2ax", UberCode: "92a", UltraCode: "72a", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 13, MaxDamage: 38, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 85, RequiredDexterity: 0, Durability: 24, RequiredLevel: 25, MaxSockets: 5, Range: 1, Type: "axe"},
+	96:  {Name: "Crowbill", ID: 96, Code: "9mp", NormalCode: "mpi", UberCode: "9mp", UltraCode: "7mp", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 14, MaxDamage: 34, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 94, RequiredDexterity: 70, Durability: 26, RequiredLevel: 25, MaxSockets: 6, Range: 1, Type: "axe"},
+	97:  {Name: "Naga", ID: 97, Code: "9wa", NormalCode: "wax", UberCode: "9wa", UltraCode: "7wa", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 16, MaxDamage: 45, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 121, RequiredDexterity: 0, Durability: 26, RequiredLevel: 25, MaxSockets: 6, Range: 2, Type: "axe"},
+	98:  {Name: "Military Axe", ID: 98, Code: "9la", NormalCode: "lax", UberCode: "9la", UltraCode: "7la", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 14, TwoHandMaxDamage: 34, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 73, RequiredDexterity: 0, Durability: 30, RequiredLevel: 22, MaxSockets: 4, Range: 1, Type: "axe"},
+	99:  {Name: "Bearded Axe ", ID: 99, Code: "9ba", NormalCode: "bax", UberCode: "9ba", UltraCode: "7ba", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 21, TwoHandMaxDamage: 49, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 92, RequiredDexterity: 0, Durability: 35, RequiredLevel: 25, MaxSockets: 5, Range: 1, Type: "axe"},
+	100: {Name: "Tabar", ID: 100, Code: "9bt", NormalCode: "btx", UberCode: "9bt", UltraCode: "7bt", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 24, TwoHandMaxDamage: 77, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 101, RequiredDexterity: 0, Durability: 40, RequiredLevel: 25, MaxSockets: 5, Range: 1, Type: "axe"},
+	101: {Name: "Gothic Axe", ID: 101, Code: "9ga", NormalCode: "gax", UberCode: "9ga", UltraCode: "7ga", InventoryWidth: 2, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 18, TwoHandMaxDamage: 70, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 115, RequiredDexterity: 79, Durability: 50, RequiredLevel: 25, MaxSockets: 6, Range: 2, Type: "axe"},
+	102: {Name: "Ancient Axe", ID: 102, Code: "9gi", NormalCode: "gix", UberCode: "9gi", UltraCode: "7gi", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 43, TwoHandMaxDamage: 85, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 125, RequiredDexterity: 0, Durability: 50, RequiredLevel: 25, MaxSockets: 6, Range: 3, Type: "axe"},
+	103: {Name: "Burnt Wand", ID: 103, Code: "9wn", NormalCode: "wnd", UberCode: "9wn", UltraCode: "7wn", InventoryWidth: 1, InventoryHeight: 2, MinDefense: 0, MaxDefense: 0, MinDamage: 8, MaxDamage: 18, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 25, RequiredDexterity: 0, Durability: 15, RequiredLevel: 19, MaxSockets: 1, Range: 0, Type: "wand"},
+	104: {Name: "Petrified Wand", ID: 104, Code: "9yw", NormalCode: "ywn", UberCode: "9yw", UltraCode: "7yw", InventoryWidth: 1, InventoryHeight: 2, MinDefense: 0, MaxDefense: 0, MinDamage: 8, MaxDamage: 24, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 25, RequiredDexterity: 0, Durability: 15, RequiredLevel: 25, MaxSockets: 2, Range: 0, Type: "wand"},
+	105: {Name: "Tomb Wand", ID: 105, Code: "9bw", NormalCode: "bwn", UberCode: "9bw", UltraCode: "7bw", InventoryWidth: 1, InventoryHeight: 2, MinDefense: 0, MaxDefense: 0, MinDamage: 10, MaxDamage: 22, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -20, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 25, RequiredDexterity: 0, Durability: 15, RequiredLevel: 25, MaxSockets: 2, Range: 0, Type: "wand"},
+	106: {Name: "Grave Wand", ID: 106, Code: "9gw", NormalCode: "gwn", UberCode: "9gw", UltraCode: "7gw", InventoryWidth: 1, InventoryHeight: 2, MinDefense: 0, MaxDefense: 0, MinDamage: 13, MaxDamage: 29, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 25, RequiredDexterity: 0, Durability: 15, RequiredLevel: 25, MaxSockets: 2, Range: 0, Type: "wand"},
+	107: {Name: "Cudgel", ID: 107, Code: "9cl", NormalCode: "clb", UberCode: "9cl", UltraCode: "7cl", InventoryWidth: 1, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 6, MaxDamage: 21, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 25, RequiredDexterity: 0, Durability: 24, RequiredLevel: 18, MaxSockets: 2, Range: 0, Type: "club"},
+	108: {Name: "Rune Scepter", ID: 108, Code: "9sc", NormalCode: "scp", UberCode: "9sc", UltraCode: "7sc", InventoryWidth: 1, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 13, MaxDamage: 24, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 58, RequiredDexterity: 0, Durability: 50, RequiredLevel: 19, MaxSockets: 2, Range: 0, Type: "scep"},
+	109: {Name: "Holy Water Sprinkler", ID: 109, Code: "9qs", NormalCode: "gsc", UberCode: "9qs", UltraCode: "7qs", InventoryWidth: 1, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 14, MaxDamage: 36, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 76, RequiredDexterity: 0, Durability: 60, RequiredLevel: 25, MaxSockets: 3, Range: 1, Type: "scep"},
+	110: {Name: "Divine Scepter", ID: 110, Code: "9ws", NormalCode: "wsp", UberCode: "9ws", UltraCode: "7ws", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 16, MaxDamage: 38, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 103, RequiredDexterity: 0, Durability: 70, RequiredLevel: 25, MaxSockets: 5, Range: 1, Type: "scep"},
+	111: {Name: "Barbed Club", ID: 111, Code: "9sp", NormalCode: "spc", UberCode: "9sp", UltraCode: "7sp", InventoryWidth: 1, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 13, MaxDamage: 25, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 30, RequiredDexterity: 0, Durability: 36, RequiredLevel: 20, MaxSockets: 3, Range: 1, Type: "club"},
+	112: {Name: "Flanged Mace", ID: 112, Code: "9ma", NormalCode: "mac", UberCode: "9ma", UltraCode: "7ma", InventoryWidth: 1, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 15, MaxDamage: 23, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 61, RequiredDexterity: 0, Durability: 60, RequiredLevel: 23, MaxSockets: 2, Range: 0, Type: "mace"},
+	113: {Name: "Jagged Star", ID: 113, Code: "9mt", NormalCode: "mst", UberCode: "9mt", UltraCode: "7mt", InventoryWidth: 1, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 20, MaxDamage: 31, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 74, RequiredDexterity: 0, Durability: 72, RequiredLevel: 25, MaxSockets: 3, Range: 1, Type: "mace"},
+	114: {Name: "Knout", ID: 114, Code: "9fl", NormalCode: "fla", UberCode: "9fl", UltraCode: "7fl", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 13, MaxDamage: 35, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 82, RequiredDexterity: 73, Durability: 30, RequiredLevel: 25, MaxSockets: 5, Range: 2, Type: "mace"},
+	115: {Name: "Battle Hammer", ID: 115, Code: "9wh", NormalCode: "whm", UberCode: "9wh", UltraCode: "7wh", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 35, MaxDamage: 58, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 20, StrengthBonus: 110, DexterityBonus: 0, RequiredStrength: 100, RequiredDexterity: 0, Durability: 55, RequiredLevel: 25, MaxSockets: 4, Range: 0, Type: "hamm"},
+	116: {Name: "War Club", ID: 116, Code: "9m9", NormalCode: "mau", UberCode: "9m9", UltraCode: "7m7", InventoryWidth: 2, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 53, TwoHandMaxDamage: 78, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 10, StrengthBonus: 110, DexterityBonus: 0, RequiredStrength: 124, RequiredDexterity: 0, Durability: 60, RequiredLevel: 25, MaxSockets: 6, Range: 1, Type: "hamm"},
+	117: {Name: "Martel de Fer", ID: 117, Code: "9gm", NormalCode: "gma", UberCode: "9gm", UltraCode: "7gm", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 61, TwoHandMaxDamage: 99, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 20, StrengthBonus: 110, DexterityBonus: 0, RequiredStrength: 169, RequiredDexterity: 0, Durability: 60, RequiredLevel: 25, MaxSockets: 6, Range: 2, Type: "hamm"},
+	118: {Name: "Gladius", ID: 118, Code: "9ss", NormalCode: "ssd", UberCode: "9ss", UltraCode: "7ss", InventoryWidth: 1, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 8, MaxDamage: 22, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 25, RequiredDexterity: 0, Durability: 24, RequiredLevel: 18, MaxSockets: 2, Range: 0, Type: "swor"},
+	119: {Name: "Cutlass", ID: 119, Code: "9sm", NormalCode: "scm", UberCode: "9sm", UltraCode: "7sm", InventoryWidth: 1, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 8, MaxDamage: 21, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -30, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 25, RequiredDexterity: 52, Durability: 22, RequiredLevel: 25, MaxSockets: 2, Range: 0, Type: "swor"},
+	120: {Name: "Shamshir", ID: 120, Code: "9sb", NormalCode: "sbr", UberCode: "9sb", UltraCode: "7sb", InventoryWidth: 1, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 10, MaxDamage: 24, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 58, RequiredDexterity: 58, Durability: 32, RequiredLevel: 23, MaxSockets: 2, Range: 0, Type: "swor"},
+	121: {Name: "Tulwar", ID: 121, Code: "9fc", NormalCode: "flc", UberCode: "9fc", UltraCode: "7fc", InventoryWidth: 1, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 16, MaxDamage: 35, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 20, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 70, RequiredDexterity: 42, Durability: 32, RequiredLevel: 25, MaxSockets: 2, Range: 0, Type: "swor"},
+	122: {Name: "Dimensional Blade", ID: 122, Code: "9cr", NormalCode: "crs", UberCode: "9cr", UltraCode: "7cr", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 13, MaxDamage: 35, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 85, RequiredDexterity: 60, Durability: 20, RequiredLevel: 25, MaxSockets: 6, Range: 1, Type: "swor"},
+	123: {Name: "Battle Sword", ID: 123, Code: "9bs", NormalCode: "bsd", UberCode: "9bs", UltraCode: "7bs", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 16, MaxDamage: 34, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 92, RequiredDexterity: 43, Durability: 32, RequiredLevel: 25, MaxSockets: 4, Range: 0, Type: "swor"},
+	124: {Name: "Rune Sword", ID: 124, Code: "9ls", NormalCode: "lsd", UberCode: "9ls", UltraCode: "7ls", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 10, MaxDamage: 42, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 103, RequiredDexterity: 79, Durability: 44, RequiredLevel: 25, MaxSockets: 4, Range: 1, Type: "swor"},
+	125: {Name: "Ancient Sword", ID: 125, Code: "9wd", NormalCode: "wsd", UberCode: "9wd", UltraCode: "7wd", InventoryWidth: 1, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 18, MaxDamage: 43, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 127, RequiredDexterity: 88, Durability: 44, RequiredLevel: 25, MaxSockets: 3, Range: 1, Type: "swor"},
+	126: {Name: "Espandon", ID: 126, Code: "92h", NormalCode: "2hs", UberCode: "92h", UltraCode: "72h", InventoryWidth: 1, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 8, MaxDamage: 26, TwoHandMinDamage: 18, TwoHandMaxDamage: 40, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 73, RequiredDexterity: 61, Durability: 44, RequiredLevel: 25, MaxSockets: 3, Range: 2, Type: "swor"},
+	127: {Name: "Dacian Falx", ID: 127, Code: "9cm", NormalCode: "clm", UberCode: "9cm", UltraCode: "7cm", InventoryWidth: 1, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 13, MaxDamage: 30, TwoHandMinDamage: 26, TwoHandMaxDamage: 61, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 91, RequiredDexterity: 20, Durability: 50, RequiredLevel: 25, MaxSockets: 4, Range: 2, Type: "swor"},
+	128: {Name: "Tusk Sword", ID: 128, Code: "9gs", NormalCode: "gis", UberCode: "9gs", UltraCode: "7gs", InventoryWidth: 1, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 10, MaxDamage: 37, TwoHandMinDamage: 19, TwoHandMaxDamage: 58, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 104, RequiredDexterity: 71, Durability: 50, RequiredLevel: 25, MaxSockets: 4, Range: 2, Type: "swor"},
+	129: {Name: "Gothic Sword", ID: 129, Code: "9b9", NormalCode: "bsw", UberCode: "9b9", UltraCode: "7b7", InventoryWidth: 1, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 14, MaxDamage: 40, TwoHandMinDamage: 39, TwoHandMaxDamage: 60, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 113, RequiredDexterity: 20, Durability: 40, RequiredLevel: 25, MaxSockets: 4, Range: 1, Type: "swor"},
+	130: {Name: "Zweihander", ID: 130, Code: "9fb", NormalCode: "flb", UberCode: "9fb", UltraCode: "7fb", InventoryWidth: 2, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 19, MaxDamage: 35, TwoHandMinDamage: 29, TwoHandMaxDamage: 54, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 125, RequiredDexterity: 94, Durability: 50, RequiredLevel: 25, MaxSockets: 5, Range: 2, Type: "swor"},
+	131: {Name: "Executioner Sword", ID: 131, Code: "9gd", NormalCode: "gsd", UberCode: "9gd", UltraCode: "7gd", InventoryWidth: 2, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 24, MaxDamage: 40, TwoHandMinDamage: 47, TwoHandMaxDamage: 80, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 170, RequiredDexterity: 110, Durability: 50, RequiredLevel: 25, MaxSockets: 6, Range: 2, Type: "swor"},
+	132: {Name: "Poignard", ID: 132, Code: "9dg", NormalCode: "dgr", UberCode: "9dg", UltraCode: "7dg", InventoryWidth: 1, InventoryHeight: 2, MinDefense: 0, MaxDefense: 0, MinDamage: 6, MaxDamage: 18, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -20, StrengthBonus: 75, DexterityBonus: 75, RequiredStrength: 25, RequiredDexterity: 0, Durability: 16, RequiredLevel: 19, MaxSockets: 1, Range: 0, Type: "knif"},
+	133: {Name: "Rondel", ID: 133, Code: "9di", NormalCode: "dir", UberCode: "9di", UltraCode: "7di", InventoryWidth: 1, InventoryHeight: 2, MinDefense: 0, MaxDefense: 0, MinDamage: 10, MaxDamage: 26, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 75, DexterityBonus: 75, RequiredStrength: 25, RequiredDexterity: 58, Durability: 20, RequiredLevel: 24, MaxSockets: 1, Range: 0, Type: "knif"},
+	134: {Name: "Cinquedeas", ID: 134, Code: "9kr", NormalCode: "kri", UberCode: "9kr", UltraCode: "7kr", InventoryWidth: 1, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 15, MaxDamage: 31, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -20, StrengthBonus: 75, DexterityBonus: 75, RequiredStrength: 25, RequiredDexterity: 88, Durability: 24, RequiredLevel: 25, MaxSockets: 3, Range: 0, Type: "knif"},
+	135: {Name: "Stilleto", ID: 135, Code: "9bl", NormalCode: "bld", UberCode: "9bl", UltraCode: "7bl", InventoryWidth: 1, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 19, MaxDamage: 36, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -10, StrengthBonus: 75, DexterityBonus: 75, RequiredStrength: 47, RequiredDexterity: 97, Durability: 24, RequiredLevel: 25, MaxSockets: 2, Range: 0, Type: "knif"},
+	136: {Name: "Battle Dart", ID: 136, Code: "9tk", NormalCode: "tkf", UberCode: "9tk", UltraCode: "7tk", InventoryWidth: 1, InventoryHeight: 2, MinDefense: 0, MaxDefense: 0, MinDamage: 8, MaxDamage: 16, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 11, MaxMissileDamage: 24, Speed: 0, StrengthBonus: 75, DexterityBonus: 75, RequiredStrength: 25, RequiredDexterity: 52, Durability: 6, RequiredLevel: 19, MaxSockets: 0, Range: 0, Type: "tkni"},
+	137: {Name: "Francisca", ID: 137, Code: "9ta", NormalCode: "tax", UberCode: "9ta", UltraCode: "7ta", InventoryWidth: 1, InventoryHeight: 2, MinDefense: 0, MaxDefense: 0, MinDamage: 11, MaxDamage: 22, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 18, MaxMissileDamage: 33, Speed: 10, StrengthBonus: 75, DexterityBonus: 75, RequiredStrength: 25, RequiredDexterity: 80, Durability: 15, RequiredLevel: 22, MaxSockets: 0, Range: 0, Type: "taxe"},
+	138: {Name: "War Dart", ID: 138, Code: "9bk", NormalCode: "bkf", UberCode: "9bk", UltraCode: "7bk", InventoryWidth: 1, InventoryHeight: 2, MinDefense: 0, MaxDefense: 0, MinDamage: 6, MaxDamage: 24, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 14, MaxMissileDamage: 27, Speed: -20, StrengthBonus: 75, DexterityBonus: 75, RequiredStrength: 25, RequiredDexterity: 97, Durability: 20, RequiredLevel: 25, MaxSockets: 0, Range: 0, Type: "tkni"},
+	139: {Name: "Hurlbat", ID: 139, Code: "9b8", NormalCode: "bal", UberCode: "9b8", UltraCode: "7b8", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 13, MaxDamage: 27, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 24, MaxMissileDamage: 34, Speed: -10, StrengthBonus: 75, DexterityBonus: 75, RequiredStrength: 25, RequiredDexterity: 106, Durability: 16, RequiredLevel: 25, MaxSockets: 0, Range: 0, Type: "taxe"},
+	140: {Name: "War Javelin", ID: 140, Code: "9ja", NormalCode: "jav", UberCode: "9ja", UltraCode: "7ja", InventoryWidth: 1, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 6, MaxDamage: 19, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 14, MaxMissileDamage: 32, Speed: -10, StrengthBonus: 75, DexterityBonus: 75, RequiredStrength: 25, RequiredDexterity: 25, Durability: 10, RequiredLevel: 18, MaxSockets: 0, Range: 2, Type: "jave"},
+	141: {Name: "Great Pilum", ID: 141, Code: "9pi", NormalCode: "pil", UberCode: "9pi", UltraCode: "7pi", InventoryWidth: 1, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 11, MaxDamage: 26, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 16, MaxMissileDamage: 42, Speed: 0, StrengthBonus: 75, DexterityBonus: 75, RequiredStrength: 25, RequiredDexterity: 88, Durability: 12, RequiredLevel: 25, MaxSockets: 0, Range: 2, Type: "jave"},
+	142: {Name: "Simbilan", ID: 142, Code: "9s9", NormalCode: "ssp", UberCode: "9s9", UltraCode: "7s7", InventoryWidth: 1, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 8, MaxDamage: 32, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 27, MaxMissileDamage: 50, Speed: 10, StrengthBonus: 75, DexterityBonus: 75, RequiredStrength: 80, RequiredDexterity: 80, Durability: 14, RequiredLevel: 25, MaxSockets: 0, Range: 2, Type: "jave"},
+	143: {Name: "Spiculum", ID: 143, Code: "9gl", NormalCode: "glv", UberCode: "9gl", UltraCode: "7gl", InventoryWidth: 1, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 13, MaxDamage: 38, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 32, MaxMissileDamage: 60, Speed: 20, StrengthBonus: 75, DexterityBonus: 75, RequiredStrength: 98, RequiredDexterity: 73, Durability: 16, RequiredLevel: 25, MaxSockets: 0, Range: 2, Type: "jave"},
+	144: {Name: "Harpoon", ID: 144, Code: "9ts", NormalCode: "tsp", UberCode: "9ts", UltraCode: "7ts", InventoryWidth: 1, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 13, MaxDamage: 35, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 18, MaxMissileDamage: 54, Speed: -10, StrengthBonus: 75, DexterityBonus: 75, RequiredStrength: 25, RequiredDexterity: 118, Durability: 18, RequiredLevel: 25, MaxSockets: 0, Range: 2, Type: "jave"},
+	145: {Name: "War Spear", ID: 145, Code: "9sr", NormalCode: "spr", UberCode: "9sr", UltraCode: "7sr", InventoryWidth: 2, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 10, TwoHandMaxDamage: 37, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 25, RequiredDexterity: 25, Durability: 30, RequiredLevel: 21, MaxSockets: 3, Range: 3, Type: "spea"},
+	146: {Name: "Fuscina", ID: 146, Code: "9tr", NormalCode: "tri", UberCode: "9tr", UltraCode: "7tr", InventoryWidth: 2, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 19, TwoHandMaxDamage: 37, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 77, RequiredDexterity: 25, Durability: 35, RequiredLevel: 24, MaxSockets: 4, Range: 3, Type: "spea"},
+	147: {Name: "War Fork", ID: 147, Code: "9br", NormalCode: "brn", UberCode: "9br", UltraCode: "7br", InventoryWidth: 2, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 16, TwoHandMaxDamage: 40, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -20, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 80, RequiredDexterity: 95, Durability: 28, RequiredLevel: 25, MaxSockets: 5, Range: 4, Type: "spea"},
+	148: {Name: "Yari", ID: 148, Code: "9st", NormalCode: "spt", UberCode: "9st", UltraCode: "7st", InventoryWidth: 2, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 29, TwoHandMaxDamage: 59, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 101, RequiredDexterity: 0, Durability: 28, RequiredLevel: 25, MaxSockets: 6, Range: 4, Type: "spea"},
+	149: {Name: "Lance", ID: 149, Code: "9p9", NormalCode: "pik", UberCode: "9p9", UltraCode: "7p7", InventoryWidth: 2, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 27, TwoHandMaxDamage: 114, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 20, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 110, RequiredDexterity: 88, Durability: 25, RequiredLevel: 25, MaxSockets: 6, Range: 4, Type: "spea"},
+	150: {Name: "Lochaber Axe", ID: 150, Code: "9b7", NormalCode: "bar", UberCode: "9b7", UltraCode: "7o7", InventoryWidth: 2, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 6, TwoHandMaxDamage: 58, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 80, RequiredDexterity: 0, Durability: 50, RequiredLevel: 21, MaxSockets: 3, Range: 2, Type: "pole"},
+	151: {Name: "Bill", ID: 151, Code: "9vo", NormalCode: "vou", UberCode: "9vo", UltraCode: "7vo", InventoryWidth: 2, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 14, TwoHandMaxDamage: 53, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 95, RequiredDexterity: 0, Durability: 50, RequiredLevel: 25, MaxSockets: 4, Range: 2, Type: "pole"},
+	152: {Name: "Battle Scythe", ID: 152, Code: "9s8", NormalCode: "scy", UberCode: "9s8", UltraCode: "7s8", InventoryWidth: 2, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 18, TwoHandMaxDamage: 45, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 82, RequiredDexterity: 82, Durability: 65, RequiredLevel: 25, MaxSockets: 5, Range: 1, Type: "pole"},
+	153: {Name: "Partizan", ID: 153, Code: "9pa", NormalCode: "pax", UberCode: "9pa", UltraCode: "7pa", InventoryWidth: 2, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 34, TwoHandMaxDamage: 75, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 113, RequiredDexterity: 67, Durability: 65, RequiredLevel: 23, MaxSockets: 5, Range: 3, Type: "pole"},
+	154: {Name: "Bec-de-Corbin", ID: 154, Code: "9h9", NormalCode: "hal", UberCode: "9h9", UltraCode: "7h7", InventoryWidth: 2, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 13, TwoHandMaxDamage: 85, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 133, RequiredDexterity: 91, Durability: 55, RequiredLevel: 25, MaxSockets: 6, Range: 4, Type: "pole"},
+	155: {Name: "Grim Scythe", ID: 155, Code: "9wc", NormalCode: "wsc", UberCode: "9wc", UltraCode: "7wc", InventoryWidth: 2, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 30, TwoHandMaxDamage: 70, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 140, RequiredDexterity: 140, Durability: 55, RequiredLevel: 25, MaxSockets: 6, Range: 4, Type: "pole"},
+	156: {Name: "Jo Staff", ID: 156, Code: "8ss", NormalCode: "sst", UberCode: "8ss", UltraCode: "6ss", InventoryWidth: 1, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 6, TwoHandMaxDamage: 21, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 25, RequiredDexterity: 0, Durability: 20, RequiredLevel: 18, MaxSockets: 2, Range: 1, Type: "staf"},
+	157: {Name: "Quarterstaff", ID: 157, Code: "8ls", NormalCode: "lst", UberCode: "8ls", UltraCode: "6ls", InventoryWidth: 1, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 8, TwoHandMaxDamage: 26, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 25, RequiredDexterity: 0, Durability: 30, RequiredLevel: 23, MaxSockets: 3, Range: 1, Type: "staf"},
+	158: {Name: "Cedar Staff", ID: 158, Code: "8cs", NormalCode: "cst", UberCode: "8cs", UltraCode: "6cs", InventoryWidth: 1, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 11, TwoHandMaxDamage: 32, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 25, RequiredDexterity: 0, Durability: 35, RequiredLevel: 25, MaxSockets: 4, Range: 1, Type: "staf"},
+	159: {Name: "Gothic Staff", ID: 159, Code: "8bs", NormalCode: "bst", UberCode: "8bs", UltraCode: "6bs", InventoryWidth: 1, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 14, TwoHandMaxDamage: 34, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 25, RequiredDexterity: 0, Durability: 40, RequiredLevel: 25, MaxSockets: 4, Range: 1, Type: "staf"},
+	160: {Name: "Rune Staff", ID: 160, Code: "8ws", NormalCode: "wst", UberCode: "8ws", UltraCode: "6ws", InventoryWidth: 2, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 24, TwoHandMaxDamage: 58, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 20, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 25, RequiredDexterity: 0, Durability: 50, RequiredLevel: 25, MaxSockets: 6, Range: 1, Type: "staf"},
+	161: {Name: "Edge Bow", ID: 161, Code: "8sb", NormalCode: "sbw", UberCode: "8sb", UltraCode: "6sb", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 6, TwoHandMaxDamage: 19, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 5, StrengthBonus: 0, DexterityBonus: 100, RequiredStrength: 25, RequiredDexterity: 43, Durability: 20, RequiredLevel: 18, MaxSockets: 3, Range: 0, Type: "bow"},
+	162: {Name: "Razor Bow", ID: 162, Code: "8hb", NormalCode: "hbw", UberCode: "8hb", UltraCode: "6hb", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 8, TwoHandMaxDamage: 22, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -10, StrengthBonus: 0, DexterityBonus: 100, RequiredStrength: 25, RequiredDexterity: 62, Durability: 32, RequiredLevel: 21, MaxSockets: 4, Range: 0, Type: "bow"},
+	163: {Name: "Cedar Bow", ID: 163, Code: "8lb", NormalCode: "lbw", UberCode: "8lb", UltraCode: "6lb", InventoryWidth: 2, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 10, TwoHandMaxDamage: 29, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 100, RequiredStrength: 53, RequiredDexterity: 49, Durability: 28, RequiredLevel: 23, MaxSockets: 5, Range: 0, Type: "bow"},
+	164: {Name: "Double Bow", ID: 164, Code: "8cb", NormalCode: "cbw", UberCode: "8cb", UltraCode: "6cb", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 11, TwoHandMaxDamage: 26, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -10, StrengthBonus: 0, DexterityBonus: 100, RequiredStrength: 58, RequiredDexterity: 73, Durability: 36, RequiredLevel: 25, MaxSockets: 4, Range: 0, Type: "bow"},
+	165: {Name: "Short Siege Bow", ID: 165, Code: "8s8", NormalCode: "sbb", UberCode: "8s8", UltraCode: "6s7", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 13, TwoHandMaxDamage: 30, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 100, RequiredStrength: 65, RequiredDexterity: 80, Durability: 40, RequiredLevel: 25, MaxSockets: 5, Range: 0, Type: "bow"},
+	166: {Name: "Long Siege Bow", ID: 166, Code: "8l8", NormalCode: "lbb", UberCode: "8l8", UltraCode: "6l7", InventoryWidth: 2, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 10, TwoHandMaxDamage: 42, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 10, StrengthBonus: 0, DexterityBonus: 100, RequiredStrength: 80, RequiredDexterity: 95, Durability: 44, RequiredLevel: 25, MaxSockets: 6, Range: 0, Type: "bow"},
+	167: {Name: "Rune Bow", ID: 167, Code: "8sw", NormalCode: "swb", UberCode: "8sw", UltraCode: "6sw", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 14, TwoHandMaxDamage: 35, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 100, RequiredStrength: 73, RequiredDexterity: 103, Durability: 48, RequiredLevel: 25, MaxSockets: 5, Range: 0, Type: "bow"},
+	168: {Name: "Gothic Bow", ID: 168, Code: "8lw", NormalCode: "lwb", UberCode: "8lw", UltraCode: "6lw", InventoryWidth: 2, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 10, TwoHandMaxDamage: 50, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 10, StrengthBonus: 0, DexterityBonus: 100, RequiredStrength: 95, RequiredDexterity: 118, Durability: 55, RequiredLevel: 25, MaxSockets: 6, Range: 0, Type: "bow"},
+	169: {Name: "Arbalest", ID: 169, Code: "8lx", NormalCode: "lxb", UberCode: "8lx", UltraCode: "6lx", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 14, TwoHandMaxDamage: 27, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -10, StrengthBonus: 0, DexterityBonus: 100, RequiredStrength: 52, RequiredDexterity: 61, Durability: 30, RequiredLevel: 22, MaxSockets: 3, Range: 0, Type: "xbow"},
+	170: {Name: "Siege Crossbow", ID: 170, Code: "8mx", NormalCode: "mxb", UberCode: "8mx", UltraCode: "6mx", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 20, TwoHandMaxDamage: 42, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 100, RequiredStrength: 80, RequiredDexterity: 70, Durability: 40, RequiredLevel: 25, MaxSockets: 4, Range: 0, Type: "xbow"},
+	171: {Name: "Ballista", ID: 171, Code: "8hx", NormalCode: "hxb", UberCode: "8hx", UltraCode: "6hx", InventoryWidth: 2, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 33, TwoHandMaxDamage: 55, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 10, StrengthBonus: 0, DexterityBonus: 100, RequiredStrength: 110, RequiredDexterity: 80, Durability: 50, RequiredLevel: 25, MaxSockets: 6, Range: 0, Type: "xbow"},
+	172: {Name: "Chu-Ko-Nu", ID: 172, Code: "8rx", NormalCode: "rxb", UberCode: "8rx", UltraCode: "6rx", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 14, TwoHandMaxDamage: 32, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -60, StrengthBonus: 0, DexterityBonus: 100, RequiredStrength: 80, RequiredDexterity: 95, Durability: 40, RequiredLevel: 25, MaxSockets: 5, Range: 0, Type: "xbow"},
+	173: {Name: "Khalim's Flail", ID: 173, Code: "qf1", NormalCode: "fla", UberCode: "", UltraCode: "", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 1, MaxDamage: 15, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 41, RequiredDexterity: 35, Durability: 30, RequiredLevel: 0, MaxSockets: 3, Range: 0, Type: "mace"},
+	174: {Name: "Khalim's Will", ID: 174, Code: "qf2", NormalCode: "fla", UberCode: "", UltraCode: "", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 1, MaxDamage: 15, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 30, RequiredLevel: 0, MaxSockets: 3, Range: 0, Type: "mace"},
+	175: {Name: "Katar", ID: 175, Code: "ktr", NormalCode: "ktr", UberCode: "9ar", UltraCode: "7ar", InventoryWidth: 1, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 4, MaxDamage: 7, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -10, StrengthBonus: 75, DexterityBonus: 75, RequiredStrength: 20, RequiredDexterity: 20, Durability: 48, RequiredLevel: 0, MaxSockets: 2, Range: 1, Type: "h2h"},
+	176: {Name: "Wrist Blade", ID: 176, Code: "wrb", NormalCode: "wrb", UberCode: "9wb", UltraCode: "7wb", InventoryWidth: 1, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 5, MaxDamage: 9, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 75, DexterityBonus: 75, RequiredStrength: 33, RequiredDexterity: 33, Durability: 52, RequiredLevel: 0, MaxSockets: 2, Range: 1, Type: "h2h"},
+	177: {Name: "Hatchet Hands", ID: 177, Code: "axf", NormalCode: "axf", UberCode: "9xf", UltraCode: "7xf", InventoryWidth: 1, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 2, MaxDamage: 15, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 10, StrengthBonus: 75, DexterityBonus: 75, RequiredStrength: 37, RequiredDexterity: 37, Durability: 56, RequiredLevel: 0, MaxSockets: 2, Range: 1, Type: "h2h"},
+	178: {Name: "Cestus", ID: 178, Code: "ces", NormalCode: "ces", UberCode: "9cs", UltraCode: "7cs", InventoryWidth: 1, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 7, MaxDamage: 15, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 75, DexterityBonus: 75, RequiredStrength: 42, RequiredDexterity: 42, Durability: 72, RequiredLevel: 0, MaxSockets: 2, Range: 1, Type: "h2h"},
+	179: {Name: "Claws", ID: 179, Code: "clw", NormalCode: "clw", UberCode: "9lw", UltraCode: "7lw", InventoryWidth: 1, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 8, MaxDamage: 15, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -10, StrengthBonus: 75, DexterityBonus: 75, RequiredStrength: 46, RequiredDexterity: 46, Durability: 64, RequiredLevel: 0, MaxSockets: 3, Range: 1, Type: "h2h"},
+	180: {Name: "Blade Talons", ID: 180, Code: "btl", NormalCode: "btl", UberCode: "9tw", UltraCode: "7tw", InventoryWidth: 1, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 10, MaxDamage: 14, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -20, StrengthBonus: 75, DexterityBonus: 75, RequiredStrength: 50, RequiredDexterity: 50, Durability: 69, RequiredLevel: 0, MaxSockets: 3, Range: 1, Type: "h2h"},
+	181: {Name: "Scissors Katar", ID: 181, Code: "skr", NormalCode: "skr", UberCode: "9qr", UltraCode: "7qr", InventoryWidth: 1, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 9, MaxDamage: 17, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -10, StrengthBonus: 75, DexterityBonus: 75, RequiredStrength: 55, RequiredDexterity: 55, Durability: 68, RequiredLevel: 0, MaxSockets: 3, Range: 1, Type: "h2h"},
+	182: {Name: "Quhab", ID: 182, Code: "9ar", NormalCode: "ktr", UberCode: "9ar", UltraCode: "7ar", InventoryWidth: 1, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 11, MaxDamage: 24, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 75, DexterityBonus: 75, RequiredStrength: 57, RequiredDexterity: 57, Durability: 48, RequiredLevel: 21, MaxSockets: 3, Range: 1, Type: "h2h"},
+	183: {Name: "Wrist Spike", ID: 183, Code: "9wb", NormalCode: "wrb", UberCode: "9wb", UltraCode: "7wb", InventoryWidth: 1, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 13, MaxDamage: 27, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -10, StrengthBonus: 75, DexterityBonus: 75, RequiredStrength: 66, RequiredDexterity: 66, Durability: 56, RequiredLevel: 24, MaxSockets: 2, Range: 1, Type: "h2h"},
+	184: {Name: "Fascia", ID: 184, Code: "9xf", NormalCode: "axf", UberCode: "9xf", UltraCode: "7xf", InventoryWidth: 1, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 8, MaxDamage: 37, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 10, StrengthBonus: 75, DexterityBonus: 75, RequiredStrength: 69, RequiredDexterity: 69, Durability: 64, RequiredLevel: 27, MaxSockets: 2, Range: 1, Type: "h2h"},
+	185: {Name: "Hand Scythe", ID: 185, Code: "9cs", NormalCode: "ces", UberCode: "9cs", UltraCode: "7cs", InventoryWidth: 1, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 16, MaxDamage: 37, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -10, StrengthBonus: 75, DexterityBonus: 75, RequiredStrength: 73, RequiredDexterity: 73, Durability: 72, RequiredLevel: 30, MaxSockets: 2, Range: 1, Type: "h2h2"},
+	186: {Name: "Greater Claws", ID: 186, Code: "9lw", NormalCode: "clw", UberCode: "9lw", UltraCode: "7lw", InventoryWidth: 1, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 18, MaxDamage: 37, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -20, StrengthBonus: 75, DexterityBonus: 75, RequiredStrength: 76, RequiredDexterity: 76, Durability: 52, RequiredLevel: 33, MaxSockets: 3, Range: 1, Type: "h2h2"},
+	187: {Name: "Greater Talons", ID: 187, Code: "9tw", NormalCode: "btl", UberCode: "9tw", UltraCode: "7tw", InventoryWidth: 1, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 21, MaxDamage: 35, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -30, StrengthBonus: 75, DexterityBonus: 75, RequiredStrength: 79, RequiredDexterity: 79, Durability: 69, RequiredLevel: 37, MaxSockets: 3, Range: 1, Type: "h2h2"},
+	188: {Name: "Scissors Quhab", ID: 188, Code: "9qr", NormalCode: "skr", UberCode: "9qr", UltraCode: "7qr", InventoryWidth: 1, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 19, MaxDamage: 40, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 75, DexterityBonus: 75, RequiredStrength: 82, RequiredDexterity: 82, Durability: 68, RequiredLevel: 40, MaxSockets: 3, Range: 1, Type: "h2h2"},
+	189: {Name: "Suwayyah", ID: 189, Code: "7ar", NormalCode: "ktr", UberCode: "9ar", UltraCode: "7ar", InventoryWidth: 1, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 39, MaxDamage: 52, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 75, DexterityBonus: 75, RequiredStrength: 99, RequiredDexterity: 99, Durability: 48, RequiredLevel: 44, MaxSockets: 3, Range: 1, Type: "h2h2"},
+	190: {Name: "Wrist Sword", ID: 190, Code: "7wb", NormalCode: "wrb", UberCode: "9wb", UltraCode: "7wb", InventoryWidth: 1, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 34, MaxDamage: 45, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -10, StrengthBonus: 75, DexterityBonus: 75, RequiredStrength: 105, RequiredDexterity: 105, Durability: 56, RequiredLevel: 46, MaxSockets: 3, Range: 1, Type: "h2h2"},
+	191: {Name: "War Fist", ID: 191, Code: "7xf", NormalCode: "axf", UberCode: "9xf", UltraCode: "7xf", InventoryWidth: 1, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 44, MaxDamage: 53, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 10, StrengthBonus: 75, DexterityBonus: 75, RequiredStrength: 108, RequiredDexterity: 108, Durability: 64, RequiredLevel: 51, MaxSockets: 2, Range: 1, Type: "h2h2"},
+	192: {Name: "Battle Cestus", ID: 192, Code: "7cs", NormalCode: "ces", UberCode: "9cs", UltraCode: "7cs", InventoryWidth: 1, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 36, MaxDamage: 42, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -10, StrengthBonus: 75, DexterityBonus: 75, RequiredStrength: 110, RequiredDexterity: 110, Durability: 72, RequiredLevel: 54, MaxSockets: 2, Range: 1, Type: "h2h2"},
+	193: {Name: "Feral Claws", ID: 193, Code: "7lw", NormalCode: "clw", UberCode: "9lw", UltraCode: "7lw", InventoryWidth: 1, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 22, MaxDamage: 53, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -20, StrengthBonus: 75, DexterityBonus: 75, RequiredStrength: 113, RequiredDexterity: 113, Durability: 52, RequiredLevel: 58, MaxSockets: 3, Range: 1, Type: "h2h2"},
+	194: {Name: "Runic Talons", ID: 194, Code: "7tw", NormalCode: "btl", UberCode: "9tw", UltraCode: "7tw", InventoryWidth: 1, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 24, MaxDamage: 44, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -30, StrengthBonus: 75, DexterityBonus: 75, RequiredStrength: 115, RequiredDexterity: 115, Durability: 69, RequiredLevel: 60, MaxSockets: 3, Range: 1, Type: "h2h2"},
+	195: {Name: "Scissors Suwayyah", ID: 195, Code: "7qr", NormalCode: "skr", UberCode: "9qr", UltraCode: "7qr", InventoryWidth: 1, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 40, MaxDamage: 51, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 75, DexterityBonus: 75, RequiredStrength: 118, RequiredDexterity: 118, Durability: 68, RequiredLevel: 64, MaxSockets: 3, Range: 1, Type: "h2h2"},
+	196: {Name: "Tomahawk", ID: 196, Code: "7ha", NormalCode: "hax", UberCode: "9ha", UltraCode: "7ha", InventoryWidth: 1, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 33, MaxDamage: 58, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 125, RequiredDexterity: 67, Durability: 28, RequiredLevel: 40, MaxSockets: 2, Range: 0, Type: "axe"},
+	197: {Name: "Small Crescent", ID: 197, Code: "7ax", NormalCode: "axe", UberCode: "9ax", UltraCode: "7ax", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 38, MaxDamage: 60, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 115, RequiredDexterity: 83, Durability: 24, RequiredLevel: 45, MaxSockets: 4, Range: 1, Type: "axe"},
+	198: {Name: "Ettin Axe", ID: 198, Code: "72a", NormalCode: "2ax", UberCode: "92a", UltraCode: "72a", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 33, MaxDamage: 66, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 145, RequiredDexterity: 45, Durability: 24, RequiredLevel: 52, MaxSockets: 5, Range: 1, Type: "axe"},
+	199: {Name: "War Spike", ID: 199, Code: "7mp", NormalCode: "mpi", UberCode: "9mp", UltraCode: "7mp", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 30, MaxDamage: 48, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 133, RequiredDexterity: 54, Durability: 26, RequiredLevel: 59, MaxSockets: 6, Range: 1, Type: "axe"},
+	200: {Name: "Berserker Axe", ID: 200, Code: "7wa", NormalCode: "wax", UberCode: "9wa", UltraCode: "7wa", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 24, MaxDamage: 71, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 138, RequiredDexterity: 59, Durability: 26, RequiredLevel: 64, MaxSockets: 6, Range: 2, Type: "axe"},
+	201: {Name: "Feral Axe", ID: 201, Code: "7la", NormalCode: "lax", UberCode: "9la", UltraCode: "7la", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 25, TwoHandMaxDamage: 123, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -15, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 196, RequiredDexterity: 0, Durability: 30, RequiredLevel: 42, MaxSockets: 4, Range: 2, Type: "axe"},
+	202: {Name: "Silver-edged Axe", ID: 202, Code: "7ba", NormalCode: "bax", UberCode: "9ba", UltraCode: "7ba", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 62, TwoHandMaxDamage: 110, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 166, RequiredDexterity: 65, Durability: 35, RequiredLevel: 48, MaxSockets: 5, Range: 2, Type: "axe"},
+	203: {Name: "Decapitator", ID: 203, Code: "7bt", NormalCode: "btx", UberCode: "9bt", UltraCode: "7bt", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 49, TwoHandMaxDamage: 137, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 189, RequiredDexterity: 33, Durability: 40, RequiredLevel: 54, MaxSockets: 5, Range: 2, Type: "axe"},
+	204: {Name: "Champion Axe", ID: 204, Code: "7ga", NormalCode: "gax", UberCode: "9ga", UltraCode: "7ga", InventoryWidth: 2, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 59, TwoHandMaxDamage: 94, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 167, RequiredDexterity: 59, Durability: 50, RequiredLevel: 61, MaxSockets: 6, Range: 2, Type: "axe"},
+	205: {Name: "Glorious Axe", ID: 205, Code: "7gi", NormalCode: "gix", UberCode: "9gi", UltraCode: "7gi", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 60, TwoHandMaxDamage: 124, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 164, RequiredDexterity: 55, Durability: 50, RequiredLevel: 66, MaxSockets: 6, Range: 3, Type: "axe"},
+	206: {Name: "Polished Wand", ID: 206, Code: "7wn", NormalCode: "wnd", UberCode: "9wn", UltraCode: "7wn", InventoryWidth: 1, InventoryHeight: 2, MinDefense: 0, MaxDefense: 0, MinDamage: 18, MaxDamage: 33, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 25, RequiredDexterity: 0, Durability: 22, RequiredLevel: 41, MaxSockets: 2, Range: 0, Type: "wand"},
+	207: {Name: "Ghost Wand", ID: 207, Code: "7yw", NormalCode: "ywn", UberCode: "9yw", UltraCode: "7yw", InventoryWidth: 1, InventoryHeight: 2, MinDefense: 0, MaxDefense: 0, MinDamage: 20, MaxDamage: 40, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 25, RequiredDexterity: 0, Durability: 14, RequiredLevel: 48, MaxSockets: 2, Range: 0, Type: "wand"},
+	208: {Name: "Lich Wand", ID: 208, Code: "7bw", NormalCode: "bwn", UberCode: "9bw", UltraCode: "7bw", InventoryWidth: 1, InventoryHeight: 2, MinDefense: 0, MaxDefense: 0, MinDamage: 10, MaxDamage: 31, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -20, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 25, RequiredDexterity: 0, Durability: 17, RequiredLevel: 56, MaxSockets: 2, Range: 0, Type: "wand"},
+	209: {Name: "Unearthed Wand", ID: 209, Code: "7gw", NormalCode: "gwn", UberCode: "9gw", UltraCode: "7gw", InventoryWidth: 1, InventoryHeight: 2, MinDefense: 0, MaxDefense: 0, MinDamage: 22, MaxDamage: 28, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 25, RequiredDexterity: 0, Durability: 18, RequiredLevel: 64, MaxSockets: 2, Range: 0, Type: "wand"},
+	210: {Name: "Truncheon", ID: 210, Code: "7cl", NormalCode: "clb", UberCode: "9cl", UltraCode: "7cl", InventoryWidth: 1, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 35, MaxDamage: 43, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 88, RequiredDexterity: 43, Durability: 55, RequiredLevel: 39, MaxSockets: 2, Range: 0, Type: "club"},
+	211: {Name: "Mighty Scepter", ID: 211, Code: "7sc", NormalCode: "scp", UberCode: "9sc", UltraCode: "7sc", InventoryWidth: 1, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 40, MaxDamage: 52, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 125, RequiredDexterity: 65, Durability: 50, RequiredLevel: 46, MaxSockets: 2, Range: 0, Type: "scep"},
+	212: {Name: "Seraph Rod", ID: 212, Code: "7qs", NormalCode: "gsc", UberCode: "9qs", UltraCode: "7qs", InventoryWidth: 1, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 45, MaxDamage: 54, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 108, RequiredDexterity: 69, Durability: 60, RequiredLevel: 57, MaxSockets: 3, Range: 1, Type: "scep"},
+	213: {Name: "Caduceus", ID: 213, Code: "7ws", NormalCode: "wsp", UberCode: "9ws", UltraCode: "7ws", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 37, MaxDamage: 43, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 97, RequiredDexterity: 70, Durability: 70, RequiredLevel: 66, MaxSockets: 5, Range: 1, Type: "scep"},
+	214: {Name: "Tyrant Club", ID: 214, Code: "7sp", NormalCode: "spc", UberCode: "9sp", UltraCode: "7sp", InventoryWidth: 1, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 32, MaxDamage: 58, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 133, RequiredDexterity: 0, Durability: 65, RequiredLevel: 42, MaxSockets: 3, Range: 1, Type: "club"},
+	215: {Name: "Reinforced Mace", ID: 215, Code: "7ma", NormalCode: "mac", UberCode: "9ma", UltraCode: "7ma", InventoryWidth: 1, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 41, MaxDamage: 49, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 145, RequiredDexterity: 46, Durability: 60, RequiredLevel: 47, MaxSockets: 2, Range: 0, Type: "mace"},
+	216: {Name: "Devil Star", ID: 216, Code: "7mt", NormalCode: "mst", UberCode: "9mt", UltraCode: "7mt", InventoryWidth: 1, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 43, MaxDamage: 53, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 153, RequiredDexterity: 44, Durability: 72, RequiredLevel: 52, MaxSockets: 3, Range: 1, Type: "mace"},
+	217: {Name: "Scourge", ID: 217, Code: "7fl", NormalCode: "fla", UberCode: "9fl", UltraCode: "7fl", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 3, MaxDamage: 80, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 125, RequiredDexterity: 77, Durability: 65, RequiredLevel: 57, MaxSockets: 5, Range: 2, Type: "mace"},
+	218: {Name: "Legendary Mallet", ID: 218, Code: "7wh", NormalCode: "whm", UberCode: "9wh", UltraCode: "7wh", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 50, MaxDamage: 61, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 20, StrengthBonus: 110, DexterityBonus: 0, RequiredStrength: 189, RequiredDexterity: 0, Durability: 65, RequiredLevel: 61, MaxSockets: 4, Range: 1, Type: "hamm"},
+	219: {Name: "Ogre Maul", ID: 219, Code: "7m7", NormalCode: "mau", UberCode: "9m9", UltraCode: "7m7", InventoryWidth: 2, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 77, TwoHandMaxDamage: 106, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 10, StrengthBonus: 110, DexterityBonus: 0, RequiredStrength: 225, RequiredDexterity: 0, Durability: 60, RequiredLevel: 51, MaxSockets: 6, Range: 1, Type: "hamm"},
+	220: {Name: "Thunder Maul", ID: 220, Code: "7gm", NormalCode: "gma", UberCode: "9gm", UltraCode: "7gm", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 33, TwoHandMaxDamage: 180, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 20, StrengthBonus: 110, DexterityBonus: 0, RequiredStrength: 253, RequiredDexterity: 0, Durability: 60, RequiredLevel: 65, MaxSockets: 6, Range: 2, Type: "hamm"},
+	221: {Name: "Falcata", ID: 221, Code: "7ss", NormalCode: "ssd", UberCode: "9ss", UltraCode: "7ss", InventoryWidth: 1, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 31, MaxDamage: 59, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 150, RequiredDexterity: 88, Durability: 24, RequiredLevel: 42, MaxSockets: 2, Range: 0, Type: "swor"},
+	222: {Name: "Ataghan", ID: 222, Code: "7sm", NormalCode: "scm", UberCode: "9sm", UltraCode: "7sm", InventoryWidth: 1, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 26, MaxDamage: 46, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -20, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 138, RequiredDexterity: 95, Durability: 22, RequiredLevel: 45, MaxSockets: 2, Range: 0, Type: "swor"},
+	223: {Name: "Elegant Blade", ID: 223, Code: "7sb", NormalCode: "sbr", UberCode: "9sb", UltraCode: "7sb", InventoryWidth: 1, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 33, MaxDamage: 45, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 109, RequiredDexterity: 122, Durability: 32, RequiredLevel: 47, MaxSockets: 2, Range: 0, Type: "swor"},
+	224: {Name: "Hydra Edge", ID: 224, Code: "7fc", NormalCode: "flc", UberCode: "9fc", UltraCode: "7fc", InventoryWidth: 1, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 28, MaxDamage: 68, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 142, RequiredDexterity: 105, Durability: 32, RequiredLevel: 51, MaxSockets: 2, Range: 0, Type: "swor"},
+	225: {Name: "Phase Blade", ID: 225, Code: "7cr", NormalCode: "crs", UberCode: "9cr", UltraCode: "7cr", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 31, MaxDamage: 35, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -30, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 25, RequiredDexterity: 136, Durability: 0, RequiredLevel: 54, MaxSockets: 6, Range: 1, Type: "swor"},
+	226: {Name: "Conquest Sword", ID: 226, Code: "7bs", NormalCode: "bsd", UberCode: "9bs", UltraCode: "7bs", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 37, MaxDamage: 53, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 142, RequiredDexterity: 112, Durability: 32, RequiredLevel: 58, MaxSockets: 4, Range: 0, Type: "swor"},
+	227: {Name: "Cryptic Sword", ID: 227, Code: "7ls", NormalCode: "lsd", UberCode: "9ls", UltraCode: "7ls", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 5, MaxDamage: 77, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 99, RequiredDexterity: 109, Durability: 44, RequiredLevel: 61, MaxSockets: 4, Range: 1, Type: "swor"},
+	228: {Name: "Mythical Sword", ID: 228, Code: "7wd", NormalCode: "wsd", UberCode: "9wd", UltraCode: "7wd", InventoryWidth: 1, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 40, MaxDamage: 50, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 147, RequiredDexterity: 124, Durability: 44, RequiredLevel: 66, MaxSockets: 3, Range: 1, Type: "swor"},
+	229: {Name: "Legend Sword", ID: 229, Code: "72h", NormalCode: "2hs", UberCode: "92h", UltraCode: "72h", InventoryWidth: 1, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 22, MaxDamage: 56, TwoHandMinDamage: 50, TwoHandMaxDamage: 94, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -15, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 175, RequiredDexterity: 100, Durability: 44, RequiredLevel: 44, MaxSockets: 3, Range: 2, Type: "swor"},
+	230: {Name: "Highland Blade", ID: 230, Code: "7cm", NormalCode: "clm", UberCode: "9cm", UltraCode: "7cm", InventoryWidth: 1, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 22, MaxDamage: 62, TwoHandMinDamage: 67, TwoHandMaxDamage: 96, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -5, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 171, RequiredDexterity: 104, Durability: 50, RequiredLevel: 49, MaxSockets: 4, Range: 2, Type: "swor"},
+	231: {Name: "Balrog Blade", ID: 231, Code: "7gs", NormalCode: "gis", UberCode: "9gs", UltraCode: "7gs", InventoryWidth: 1, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 15, MaxDamage: 75, TwoHandMinDamage: 55, TwoHandMaxDamage: 118, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 185, RequiredDexterity: 87, Durability: 50, RequiredLevel: 53, MaxSockets: 4, Range: 2, Type: "swor"},
+	232: {Name: "Champion Sword", ID: 232, Code: "7b7", NormalCode: "bsw", UberCode: "9b9", UltraCode: "7b7", InventoryWidth: 1, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 24, MaxDamage: 54, TwoHandMinDamage: 71, TwoHandMaxDamage: 83, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 163, RequiredDexterity: 103, Durability: 40, RequiredLevel: 57, MaxSockets: 4, Range: 2, Type: "swor"},
+	233: {Name: "Colossus Sword", ID: 233, Code: "7fb", NormalCode: "flb", UberCode: "9fb", UltraCode: "7fb", InventoryWidth: 2, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 26, MaxDamage: 70, TwoHandMinDamage: 61, TwoHandMaxDamage: 121, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 182, RequiredDexterity: 95, Durability: 50, RequiredLevel: 60, MaxSockets: 5, Range: 2, Type: "swor"},
+	234: {Name: "Colossus Blade", ID: 234, Code: "7gd", NormalCode: "gsd", UberCode: "9gd", UltraCode: "7gd", InventoryWidth: 2, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 25, MaxDamage: 65, TwoHandMinDamage: 58, TwoHandMaxDamage: 115, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 5, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 189, RequiredDexterity: 110, Durability: 50, RequiredLevel: 63, MaxSockets: 6, Range: 2, Type: "swor"},
+	235: {Name: "Bone Knife", ID: 235, Code: "7dg", NormalCode: "dgr", UberCode: "9dg", UltraCode: "7dg", InventoryWidth: 1, InventoryHeight: 2, MinDefense: 0, MaxDefense: 0, MinDamage: 23, MaxDamage: 49, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -20, StrengthBonus: 75, DexterityBonus: 75, RequiredStrength: 38, RequiredDexterity: 75, Durability: 26, RequiredLevel: 43, MaxSockets: 1, Range: 0, Type: "knif"},
+	236: {Name: "Mithral Point", ID: 236, Code: "7di", NormalCode: "dir", UberCode: "9di", UltraCode: "7di", InventoryWidth: 1, InventoryHeight: 2, MinDefense: 0, MaxDefense: 0, MinDamage: 37, MaxDamage: 53, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 75, DexterityBonus: 75, RequiredStrength: 55, RequiredDexterity: 98, Durability: 55, RequiredLevel: 52, MaxSockets: 1, Range: 0, Type: "knif"},
+	237: {Name: "Fanged Knife", ID: 237, Code: "7kr", NormalCode: "kri", UberCode: "9kr", UltraCode: "7kr", InventoryWidth: 1, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 15, MaxDamage: 57, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -20, StrengthBonus: 75, DexterityBonus: 75, RequiredStrength: 42, RequiredDexterity: 86, Durability: 36, RequiredLevel: 62, MaxSockets: 3, Range: 0, Type: "knif"},
+	238: {Name: "Legend Spike", ID: 238, Code: "7bl", NormalCode: "bld", UberCode: "9bl", UltraCode: "7bl", InventoryWidth: 1, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 31, MaxDamage: 47, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -10, StrengthBonus: 75, DexterityBonus: 75, RequiredStrength: 65, RequiredDexterity: 67, Durability: 47, RequiredLevel: 66, MaxSockets: 2, Range: 0, Type: "knif"},
+	239: {Name: "Flying Knife", ID: 239, Code: "7tk", NormalCode: "tkf", UberCode: "9tk", UltraCode: "7tk", InventoryWidth: 1, InventoryHeight: 2, MinDefense: 0, MaxDefense: 0, MinDamage: 23, MaxDamage: 54, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 23, MaxMissileDamage: 54, Speed: 0, StrengthBonus: 75, DexterityBonus: 75, RequiredStrength: 48, RequiredDexterity: 141, Durability: 6, RequiredLevel: 48, MaxSockets: 0, Range: 0, Type: "tkni"},
+	240: {Name: "Flying Axe", ID: 240, Code: "7ta", NormalCode: "tax", UberCode: "9ta", UltraCode: "7ta", InventoryWidth: 1, InventoryHeight: 2, MinDefense: 0, MaxDefense: 0, MinDamage: 17, MaxDamage: 65, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 15, MaxMissileDamage: 66, Speed: 10, StrengthBonus: 75, DexterityBonus: 75, RequiredStrength: 88, RequiredDexterity: 108, Durability: 15, RequiredLevel: 42, MaxSockets: 0, Range: 0, Type: "taxe"},
+	241: {Name: "Winged Knife", ID: 241, Code: "7bk", NormalCode: "bkf", UberCode: "9bk", UltraCode: "7bk", InventoryWidth: 1, InventoryHeight: 2, MinDefense: 0, MaxDefense: 0, MinDamage: 27, MaxDamage: 35, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 23, MaxMissileDamage: 39, Speed: -20, StrengthBonus: 75, DexterityBonus: 75, RequiredStrength: 45, RequiredDexterity: 142, Durability: 20, RequiredLevel: 57, MaxSockets: 0, Range: 0, Type: "tkni"},
+	242: {Name: "Winged Axe", ID: 242, Code: "7b8", NormalCode: "bal", UberCode: "9b8", UltraCode: "7b8", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 11, MaxDamage: 56, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 7, MaxMissileDamage: 60, Speed: -10, StrengthBonus: 75, DexterityBonus: 75, RequiredStrength: 96, RequiredDexterity: 122, Durability: 16, RequiredLevel: 60, MaxSockets: 0, Range: 0, Type: "taxe"},
+	243: {Name: "Hyperion Javelin", ID: 243, Code: "7ja", NormalCode: "jav", UberCode: "9ja", UltraCode: "7ja", InventoryWidth: 1, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 21, MaxDamage: 57, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 28, MaxMissileDamage: 55, Speed: -10, StrengthBonus: 75, DexterityBonus: 75, RequiredStrength: 98, RequiredDexterity: 123, Durability: 10, RequiredLevel: 40, MaxSockets: 0, Range: 2, Type: "jave"},
+	244: {Name: "Stygian Pilum", ID: 244, Code: "7pi", NormalCode: "pil", UberCode: "9pi", UltraCode: "7pi", InventoryWidth: 1, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 14, MaxDamage: 64, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 21, MaxMissileDamage: 75, Speed: 0, StrengthBonus: 75, DexterityBonus: 75, RequiredStrength: 118, RequiredDexterity: 112, Durability: 12, RequiredLevel: 46, MaxSockets: 0, Range: 2, Type: "jave"},
+	245: {Name: "Balrog Spear", ID: 245, Code: "7s7", NormalCode: "ssp", UberCode: "9s9", UltraCode: "7s7", InventoryWidth: 1, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 33, MaxDamage: 63, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 40, MaxMissileDamage: 62, Speed: 10, StrengthBonus: 75, DexterityBonus: 75, RequiredStrength: 127, RequiredDexterity: 95, Durability: 14, RequiredLevel: 53, MaxSockets: 0, Range: 2, Type: "jave"},
+	246: {Name: "Ghost Glaive", ID: 246, Code: "7gl", NormalCode: "glv", UberCode: "9gl", UltraCode: "7gl", InventoryWidth: 1, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 19, MaxDamage: 60, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 30, MaxMissileDamage: 85, Speed: 20, StrengthBonus: 75, DexterityBonus: 75, RequiredStrength: 89, RequiredDexterity: 137, Durability: 16, RequiredLevel: 59, MaxSockets: 0, Range: 2, Type: "jave"},
+	247: {Name: "Winged Harpoon", ID: 247, Code: "7ts", NormalCode: "tsp", UberCode: "9ts", UltraCode: "7ts", InventoryWidth: 1, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 27, MaxDamage: 35, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 11, MaxMissileDamage: 77, Speed: -10, StrengthBonus: 75, DexterityBonus: 75, RequiredStrength: 76, RequiredDexterity: 145, Durability: 18, RequiredLevel: 65, MaxSockets: 0, Range: 2, Type: "jave"},
+	248: {Name: "Hyperion Spear", ID: 248, Code: "7sr", NormalCode: "spr", UberCode: "9sr", UltraCode: "7sr", InventoryWidth: 2, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 35, TwoHandMaxDamage: 119, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 155, RequiredDexterity: 120, Durability: 30, RequiredLevel: 43, MaxSockets: 3, Range: 3, Type: "spea"},
+	249: {Name: "Stygian Pike", ID: 249, Code: "7tr", NormalCode: "tri", UberCode: "9tr", UltraCode: "7tr", InventoryWidth: 2, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 29, TwoHandMaxDamage: 144, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 168, RequiredDexterity: 97, Durability: 35, RequiredLevel: 49, MaxSockets: 4, Range: 3, Type: "spea"},
+	250: {Name: "Mancatcher", ID: 250, Code: "7br", NormalCode: "brn", UberCode: "9br", UltraCode: "7br", InventoryWidth: 2, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 42, TwoHandMaxDamage: 92, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -20, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 132, RequiredDexterity: 134, Durability: 28, RequiredLevel: 55, MaxSockets: 5, Range: 4, Type: "spea"},
+	251: {Name: "Ghost Spear", ID: 251, Code: "7st", NormalCode: "spt", UberCode: "9st", UltraCode: "7st", InventoryWidth: 2, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 18, TwoHandMaxDamage: 155, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 122, RequiredDexterity: 163, Durability: 28, RequiredLevel: 62, MaxSockets: 6, Range: 4, Type: "spea"},
+	252: {Name: "War Pike", ID: 252, Code: "7p7", NormalCode: "pik", UberCode: "9p9", UltraCode: "7p7", InventoryWidth: 2, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 33, TwoHandMaxDamage: 178, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 20, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 165, RequiredDexterity: 106, Durability: 25, RequiredLevel: 66, MaxSockets: 6, Range: 4, Type: "spea"},
+	253: {Name: "Ogre Axe", ID: 253, Code: "7o7", NormalCode: "bar", UberCode: "9b7", UltraCode: "7o7", InventoryWidth: 2, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 28, TwoHandMaxDamage: 145, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 195, RequiredDexterity: 75, Durability: 50, RequiredLevel: 45, MaxSockets: 3, Range: 2, Type: "pole"},
+	254: {Name: "Colossus Voulge", ID: 254, Code: "7vo", NormalCode: "vou", UberCode: "9vo", UltraCode: "7vo", InventoryWidth: 2, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 17, TwoHandMaxDamage: 165, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 210, RequiredDexterity: 55, Durability: 50, RequiredLevel: 48, MaxSockets: 4, Range: 2, Type: "pole"},
+	255: {Name: "Thresher", ID: 255, Code: "7s8", NormalCode: "scy", UberCode: "9s8", UltraCode: "7s8", InventoryWidth: 2, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 12, TwoHandMaxDamage: 141, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 152, RequiredDexterity: 118, Durability: 65, RequiredLevel: 53, MaxSockets: 5, Range: 1, Type: "pole"},
+	256: {Name: "Cryptic Axe", ID: 256, Code: "7pa", NormalCode: "pax", UberCode: "9pa", UltraCode: "7pa", InventoryWidth: 2, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 33, TwoHandMaxDamage: 150, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 165, RequiredDexterity: 103, Durability: 65, RequiredLevel: 59, MaxSockets: 5, Range: 3, Type: "pole"},
+	257: {Name: "Great Poleaxe", ID: 257, Code: "7h7", NormalCode: "hal", UberCode: "9h9", UltraCode: "7h7", InventoryWidth: 2, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 46, TwoHandMaxDamage: 127, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 179, RequiredDexterity: 99, Durability: 55, RequiredLevel: 63, MaxSockets: 6, Range: 4, Type: "pole"},
+	258: {Name: "Giant Thresher", ID: 258, Code: "7wc", NormalCode: "wsc", UberCode: "9wc", UltraCode: "7wc", InventoryWidth: 2, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 40, TwoHandMaxDamage: 114, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 188, RequiredDexterity: 140, Durability: 55, RequiredLevel: 66, MaxSockets: 6, Range: 4, Type: "pole"},
+	259: {Name: "Walking Stick", ID: 259, Code: "6ss", NormalCode: "sst", UberCode: "8ss", UltraCode: "6ss", InventoryWidth: 1, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 69, TwoHandMaxDamage: 85, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 25, RequiredDexterity: 0, Durability: 20, RequiredLevel: 43, MaxSockets: 2, Range: 1, Type: "staf"},
+	260: {Name: "Stalagmite", ID: 260, Code: "6ls", NormalCode: "lst", UberCode: "8ls", UltraCode: "6ls", InventoryWidth: 1, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 75, TwoHandMaxDamage: 107, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 63, RequiredDexterity: 35, Durability: 30, RequiredLevel: 49, MaxSockets: 3, Range: 1, Type: "staf"},
+	261: {Name: "Elder Staff", ID: 261, Code: "6cs", NormalCode: "cst", UberCode: "8cs", UltraCode: "6cs", InventoryWidth: 1, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 80, TwoHandMaxDamage: 93, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 44, RequiredDexterity: 37, Durability: 35, RequiredLevel: 55, MaxSockets: 4, Range: 1, Type: "staf"},
+	262: {Name: "Shillelagh", ID: 262, Code: "6bs", NormalCode: "bst", UberCode: "8bs", UltraCode: "6bs", InventoryWidth: 1, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 65, TwoHandMaxDamage: 108, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 52, RequiredDexterity: 27, Durability: 40, RequiredLevel: 62, MaxSockets: 4, Range: 1, Type: "staf"},
+	263: {Name: "Archon Staff", ID: 263, Code: "6ws", NormalCode: "wst", UberCode: "8ws", UltraCode: "6ws", InventoryWidth: 2, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 83, TwoHandMaxDamage: 99, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 34, RequiredDexterity: 0, Durability: 26, RequiredLevel: 66, MaxSockets: 6, Range: 1, Type: "staf"},
+	264: {Name: "Spider Bow", ID: 264, Code: "6sb", NormalCode: "sbw", UberCode: "8sb", UltraCode: "6sb", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 23, TwoHandMaxDamage: 50, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 5, StrengthBonus: 0, DexterityBonus: 100, RequiredStrength: 64, RequiredDexterity: 143, Durability: 20, RequiredLevel: 41, MaxSockets: 3, Range: 0, Type: "bow"},
+	265: {Name: "Blade Bow", ID: 265, Code: "6hb", NormalCode: "hbw", UberCode: "8hb", UltraCode: "6hb", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 21, TwoHandMaxDamage: 41, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -10, StrengthBonus: 0, DexterityBonus: 100, RequiredStrength: 76, RequiredDexterity: 119, Durability: 32, RequiredLevel: 45, MaxSockets: 4, Range: 0, Type: "bow"},
+	266: {Name: "Shadow Bow", ID: 266, Code: "6lb", NormalCode: "lbw", UberCode: "8lb", UltraCode: "6lb", InventoryWidth: 2, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 15, TwoHandMaxDamage: 59, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 100, RequiredStrength: 52, RequiredDexterity: 188, Durability: 28, RequiredLevel: 47, MaxSockets: 5, Range: 0, Type: "bow"},
+	267: {Name: "Great Bow", ID: 267, Code: "6cb", NormalCode: "cbw", UberCode: "8cb", UltraCode: "6cb", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 12, TwoHandMaxDamage: 52, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -10, StrengthBonus: 0, DexterityBonus: 100, RequiredStrength: 121, RequiredDexterity: 107, Durability: 36, RequiredLevel: 51, MaxSockets: 4, Range: 0, Type: "bow"},
+	268: {Name: "Diamond Bow", ID: 268, Code: "6s7", NormalCode: "sbb", UberCode: "8s8", UltraCode: "6s7", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 33, TwoHandMaxDamage: 40, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 100, RequiredStrength: 89, RequiredDexterity: 132, Durability: 40, RequiredLevel: 54, MaxSockets: 5, Range: 0, Type: "bow"},
+	269: {Name: "Crusader Bow", ID: 269, Code: "6l7", NormalCode: "lbb", UberCode: "8l8", UltraCode: "6l7", InventoryWidth: 2, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 15, TwoHandMaxDamage: 63, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 10, StrengthBonus: 0, DexterityBonus: 100, RequiredStrength: 97, RequiredDexterity: 121, Durability: 44, RequiredLevel: 57, MaxSockets: 6, Range: 0, Type: "bow"},
+	270: {Name: "Ward Bow", ID: 270, Code: "6sw", NormalCode: "swb", UberCode: "8sw", UltraCode: "6sw", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 20, TwoHandMaxDamage: 53, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 100, RequiredStrength: 72, RequiredDexterity: 146, Durability: 48, RequiredLevel: 60, MaxSockets: 5, Range: 0, Type: "bow"},
+	271: {Name: "Hydra Bow", ID: 271, Code: "6lw", NormalCode: "lwb", UberCode: "8lw", UltraCode: "6lw", InventoryWidth: 2, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 10, TwoHandMaxDamage: 68, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 10, StrengthBonus: 0, DexterityBonus: 100, RequiredStrength: 134, RequiredDexterity: 167, Durability: 55, RequiredLevel: 63, MaxSockets: 6, Range: 0, Type: "bow"},
+	272: {Name: "Pellet Bow", ID: 272, Code: "6lx", NormalCode: "lxb", UberCode: "8lx", UltraCode: "6lx", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 28, TwoHandMaxDamage: 73, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -10, StrengthBonus: 0, DexterityBonus: 100, RequiredStrength: 83, RequiredDexterity: 155, Durability: 30, RequiredLevel: 42, MaxSockets: 3, Range: 0, Type: "xbow"},
+	273: {Name: "Gorgon Crossbow", ID: 273, Code: "6mx", NormalCode: "mxb", UberCode: "8mx", UltraCode: "6mx", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 25, TwoHandMaxDamage: 87, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 100, RequiredStrength: 117, RequiredDexterity: 105, Durability: 40, RequiredLevel: 50, MaxSockets: 4, Range: 0, Type: "xbow"},
+	274: {Name: "Colossus Crossbow", ID: 274, Code: "6hx", NormalCode: "hxb", UberCode: "8hx", UltraCode: "6hx", InventoryWidth: 2, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 32, TwoHandMaxDamage: 91, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 10, StrengthBonus: 0, DexterityBonus: 100, RequiredStrength: 163, RequiredDexterity: 77, Durability: 50, RequiredLevel: 56, MaxSockets: 6, Range: 0, Type: "xbow"},
+	275: {Name: "Demon Crossbow", ID: 275, Code: "6rx", NormalCode: "rxb", UberCode: "8rx", UltraCode: "6rx", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 26, TwoHandMaxDamage: 40, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -60, StrengthBonus: 0, DexterityBonus: 100, RequiredStrength: 141, RequiredDexterity: 98, Durability: 40, RequiredLevel: 63, MaxSockets: 5, Range: 0, Type: "xbow"},
+	276: {Name: "Eagle Orb", ID: 276, Code: "ob1", NormalCode: "ob1", UberCode: "ob6", UltraCode: "obb", InventoryWidth: 1, InventoryHeight: 2, MinDefense: 0, MaxDefense: 0, MinDamage: 2, MaxDamage: 5, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 20, RequiredLevel: 0, MaxSockets: 4, Range: 0, Type: "orb"},
+	277: {Name: "Sacred Globe", ID: 277, Code: "ob2", NormalCode: "ob2", UberCode: "ob7", UltraCode: "obc", InventoryWidth: 1, InventoryHeight: 2, MinDefense: 0, MaxDefense: 0, MinDamage: 3, MaxDamage: 8, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 30, RequiredLevel: 0, MaxSockets: 4, Range: 0, Type: "orb"},
+	278: {Name: "Smoked Sphere", ID: 278, Code: "ob3", NormalCode: "ob3", UberCode: "ob8", UltraCode: "obd", InventoryWidth: 1, InventoryHeight: 2, MinDefense: 0, MaxDefense: 0, MinDamage: 4, MaxDamage: 10, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 35, RequiredLevel: 8, MaxSockets: 4, Range: 0, Type: "orb"},
+	279: {Name: "Clasped Orb", ID: 279, Code: "ob4", NormalCode: "ob4", UberCode: "ob9", UltraCode: "obe", InventoryWidth: 1, InventoryHeight: 2, MinDefense: 0, MaxDefense: 0, MinDamage: 5, MaxDamage: 12, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 40, RequiredLevel: 13, MaxSockets: 4, Range: 0, Type: "orb"},
+	280: {Name: "Jared's Stone", ID: 280, Code: "ob5", NormalCode: "ob5", UberCode: "oba", UltraCode: "obf", InventoryWidth: 1, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 8, MaxDamage: 18, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 50, RequiredLevel: 18, MaxSockets: 4, Range: 0, Type: "orb"},
+	281: {Name: "Stag Bow", ID: 281, Code: "am1", NormalCode: "am1", UberCode: "am6", UltraCode: "amb", InventoryWidth: 2, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 7, TwoHandMaxDamage: 12, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 100, RequiredStrength: 30, RequiredDexterity: 45, Durability: 48, RequiredLevel: 14, MaxSockets: 5, Range: 0, Type: "abow"},
+	282: {Name: "Reflex Bow", ID: 282, Code: "am2", NormalCode: "am2", UberCode: "am7", UltraCode: "amc", InventoryWidth: 2, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 9, TwoHandMaxDamage: 19, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 10, StrengthBonus: 0, DexterityBonus: 100, RequiredStrength: 35, RequiredDexterity: 60, Durability: 55, RequiredLevel: 20, MaxSockets: 5, Range: 0, Type: "abow"},
+	283: {Name: "Maiden Spear", ID: 283, Code: "am3", NormalCode: "am3", UberCode: "am8", UltraCode: "amd", InventoryWidth: 2, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 18, TwoHandMaxDamage: 24, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 80, DexterityBonus: 50, RequiredStrength: 54, RequiredDexterity: 40, Durability: 28, RequiredLevel: 14, MaxSockets: 6, Range: 4, Type: "aspe"},
+	284: {Name: "Maiden Pike", ID: 284, Code: "am4", NormalCode: "am4", UberCode: "am9", UltraCode: "ame", InventoryWidth: 2, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 23, TwoHandMaxDamage: 55, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 10, StrengthBonus: 80, DexterityBonus: 50, RequiredStrength: 63, RequiredDexterity: 52, Durability: 25, RequiredLevel: 20, MaxSockets: 6, Range: 4, Type: "aspe"},
+	285: {Name: "Maiden Javelin", ID: 285, Code: "am5", NormalCode: "am5", UberCode: "ama", UltraCode: "amf", InventoryWidth: 1, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 8, MaxDamage: 14, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 6, MaxMissileDamage: 22, Speed: -10, StrengthBonus: 80, DexterityBonus: 50, RequiredStrength: 33, RequiredDexterity: 47, Durability: 6, RequiredLevel: 17, MaxSockets: 0, Range: 2, Type: "ajav"},
+	286: {Name: "Glowing Orb", ID: 286, Code: "ob6", NormalCode: "ob1", UberCode: "ob6", UltraCode: "obb", InventoryWidth: 1, InventoryHeight: 2, MinDefense: 0, MaxDefense: 0, MinDamage: 8, MaxDamage: 21, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 20, RequiredLevel: 24, MaxSockets: 4, Range: 0, Type: "orb"},
+	287: {Name: "Crystalline Globe", ID: 287, Code: "ob7", NormalCode: "ob2", UberCode: "ob7", UltraCode: "obc", InventoryWidth: 1, InventoryHeight: 2, MinDefense: 0, MaxDefense: 0, MinDamage: 10, MaxDamage: 26, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 30, RequiredLevel: 27, MaxSockets: 4, Range: 0, Type: "orb"},
+	288: {Name: "Cloudy Sphere", ID: 288, Code: "ob8", NormalCode: "ob3", UberCode: "ob8", UltraCode: "obd", InventoryWidth: 1, InventoryHeight: 2, MinDefense: 0, MaxDefense: 0, MinDamage: 11, MaxDamage: 29, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 35, RequiredLevel: 30, MaxSockets: 4, Range: 0, Type: "orb"},
+	289: {Name: "Sparkling Ball", ID: 289, Code: "ob9", NormalCode: "ob4", UberCode: "ob9", UltraCode: "obe", InventoryWidth: 1, InventoryHeight: 2, MinDefense: 0, MaxDefense: 0, MinDamage: 13, MaxDamage: 32, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 40, RequiredLevel: 34, MaxSockets: 4, Range: 0, Type: "orb"},
+	290: {Name: "Swirling Crystal", ID: 290, Code: "oba", NormalCode: "ob5", UberCode: "oba", UltraCode: "obf", InventoryWidth: 1, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 18, MaxDamage: 42, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 50, RequiredLevel: 37, MaxSockets: 4, Range: 0, Type: "orb"},
+	291: {Name: "Ashwood Bow", ID: 291, Code: "am6", NormalCode: "am1", UberCode: "am6", UltraCode: "amb", InventoryWidth: 2, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 16, TwoHandMaxDamage: 29, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 100, RequiredStrength: 56, RequiredDexterity: 77, Durability: 48, RequiredLevel: 29, MaxSockets: 5, Range: 0, Type: "abow"},
+	292: {Name: "Ceremonial Bow", ID: 292, Code: "am7", NormalCode: "am2", UberCode: "am7", UltraCode: "amc", InventoryWidth: 2, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 19, TwoHandMaxDamage: 41, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 10, StrengthBonus: 0, DexterityBonus: 100, RequiredStrength: 73, RequiredDexterity: 110, Durability: 55, RequiredLevel: 35, MaxSockets: 5, Range: 0, Type: "abow"},
+	293: {Name: "Ceremonial Spear", ID: 293, Code: "am8", NormalCode: "am3", UberCode: "am8", UltraCode: "amd", InventoryWidth: 2, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 34, TwoHandMaxDamage: 51, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 80, DexterityBonus: 50, RequiredStrength: 101, RequiredDexterity: 80, Durability: 28, RequiredLevel: 32, MaxSockets: 6, Range: 4, Type: "aspe"},
+	294: {Name: "Ceremonial Pike", ID: 294, Code: "am9", NormalCode: "am4", UberCode: "am9", UltraCode: "ame", InventoryWidth: 2, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 42, TwoHandMaxDamage: 101, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 20, StrengthBonus: 80, DexterityBonus: 50, RequiredStrength: 115, RequiredDexterity: 98, Durability: 25, RequiredLevel: 38, MaxSockets: 6, Range: 4, Type: "aspe"},
+	295: {Name: "Ceremonial Javelin", ID: 295, Code: "ama", NormalCode: "am5", UberCode: "ama", UltraCode: "amf", InventoryWidth: 1, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 18, MaxDamage: 35, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 18, MaxMissileDamage: 54, Speed: -10, StrengthBonus: 80, DexterityBonus: 50, RequiredStrength: 25, RequiredDexterity: 109, Durability: 6, RequiredLevel: 26, MaxSockets: 0, Range: 2, Type: "ajav"},
+	296: {Name: "Heavenly Stone", ID: 296, Code: "obb", NormalCode: "ob1", UberCode: "ob6", UltraCode: "obb", InventoryWidth: 1, InventoryHeight: 2, MinDefense: 0, MaxDefense: 0, MinDamage: 21, MaxDamage: 46, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 20, RequiredLevel: 44, MaxSockets: 4, Range: 0, Type: "orb"},
+	297: {Name: "Eldritch Orb", ID: 297, Code: "obc", NormalCode: "ob2", UberCode: "ob7", UltraCode: "obc", InventoryWidth: 1, InventoryHeight: 2, MinDefense: 0, MaxDefense: 0, MinDamage: 18, MaxDamage: 50, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 30, RequiredLevel: 50, MaxSockets: 4, Range: 0, Type: "orb"},
+	298: {Name: "Demon Heart", ID: 298, Code: "obd", NormalCode: "ob3", UberCode: "ob8", UltraCode: "obd", InventoryWidth: 1, InventoryHeight: 2, MinDefense: 0, MaxDefense: 0, MinDamage: 23, MaxDamage: 55, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 35, RequiredLevel: 56, MaxSockets: 4, Range: 0, Type: "orb"},
+	299: {Name: "Vortex Orb", ID: 299, Code: "obe", NormalCode: "ob4", UberCode: "ob9", UltraCode: "obe", InventoryWidth: 1, InventoryHeight: 2, MinDefense: 0, MaxDefense: 0, MinDamage: 12, MaxDamage: 66, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 40, RequiredLevel: 63, MaxSockets: 4, Range: 0, Type: "orb"},
+	300: {Name: "Dimensional Shard", ID: 300, Code: "obf", NormalCode: "ob5", UberCode: "oba", UltraCode: "obf", InventoryWidth: 1, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 30, MaxDamage: 53, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 50, RequiredLevel: 66, MaxSockets: 4, Range: 0, Type: "orb"},
+	301: {Name: "Matriarchal Bow", ID: 301, Code: "amb", NormalCode: "am1", UberCode: "am6", UltraCode: "amb", InventoryWidth: 2, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 20, TwoHandMaxDamage: 47, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: -10, StrengthBonus: 0, DexterityBonus: 100, RequiredStrength: 87, RequiredDexterity: 187, Durability: 48, RequiredLevel: 39, MaxSockets: 5, Range: 0, Type: "abow"},
+	302: {Name: "Grand Matron Bow", ID: 302, Code: "amc", NormalCode: "am2", UberCode: "am7", UltraCode: "amc", InventoryWidth: 2, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 14, TwoHandMaxDamage: 72, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 10, StrengthBonus: 0, DexterityBonus: 100, RequiredStrength: 108, RequiredDexterity: 152, Durability: 55, RequiredLevel: 58, MaxSockets: 5, Range: 0, Type: "abow"},
+	303: {Name: "Matriarchal Spear", ID: 303, Code: "amd", NormalCode: "am3", UberCode: "am8", UltraCode: "amd", InventoryWidth: 2, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 65, TwoHandMaxDamage: 95, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 80, DexterityBonus: 50, RequiredStrength: 114, RequiredDexterity: 142, Durability: 28, RequiredLevel: 45, MaxSockets: 6, Range: 4, Type: "aspe"},
+	304: {Name: "Matriarchal Pike", ID: 304, Code: "ame", NormalCode: "am4", UberCode: "am9", UltraCode: "ame", InventoryWidth: 2, InventoryHeight: 4, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 37, TwoHandMaxDamage: 153, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 20, StrengthBonus: 80, DexterityBonus: 50, RequiredStrength: 132, RequiredDexterity: 149, Durability: 25, RequiredLevel: 60, MaxSockets: 6, Range: 4, Type: "aspe"},
+	305: {Name: "Matriarchal Javelin", ID: 305, Code: "amf", NormalCode: "am5", UberCode: "ama", UltraCode: "amf", InventoryWidth: 1, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 30, MaxDamage: 54, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 35, MaxMissileDamage: 66, Speed: -10, StrengthBonus: 80, DexterityBonus: 50, RequiredStrength: 107, RequiredDexterity: 151, Durability: 6, RequiredLevel: 48, MaxSockets: 0, Range: 2, Type: "ajav"},
+	306: {Name: "Cap", ID: 306, Code: "cap", NormalCode: "cap", UberCode: "xap", UltraCode: "uap", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 3, MaxDefense: 5, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 12, RequiredLevel: 0, MaxSockets: 2, Range: 0, Type: "helm"},
+	307: {Name: "Skull Cap", ID: 307, Code: "skp", NormalCode: "skp", UberCode: "xkp", UltraCode: "ukp", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 8, MaxDefense: 11, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 15, RequiredDexterity: 0, Durability: 18, RequiredLevel: 0, MaxSockets: 2, Range: 0, Type: "helm"},
+	308: {Name: "Helm", ID: 308, Code: "hlm", NormalCode: "hlm", UberCode: "xlm", UltraCode: "ulm", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 15, MaxDefense: 18, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 26, RequiredDexterity: 0, Durability: 24, RequiredLevel: 0, MaxSockets: 2, Range: 0, Type: "helm"},
+	309: {Name: "Full Helm", ID: 309, Code: "fhl", NormalCode: "fhl", UberCode: "xhl", UltraCode: "uhl", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 23, MaxDefense: 26, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 41, RequiredDexterity: 0, Durability: 30, RequiredLevel: 0, MaxSockets: 2, Range: 0, Type: "helm"},
+	310: {Name: "Great Helm", ID: 310, Code: "ghm", NormalCode: "ghm", UberCode: "xhm", UltraCode: "uhm", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 30, MaxDefense: 35, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 63, RequiredDexterity: 0, Durability: 40, RequiredLevel: 0, MaxSockets: 3, Range: 0, Type: "helm"},
+	311: {Name: "Crown", ID: 311, Code: "crn", NormalCode: "crn", UberCode: "xrn", UltraCode: "urn", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 25, MaxDefense: 45, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 55, RequiredDexterity: 0, Durability: 50, RequiredLevel: 0, MaxSockets: 3, Range: 0, Type: "helm"},
+	312: {Name: "Mask", ID: 312, Code: "msk", NormalCode: "msk", UberCode: "xsk", UltraCode: "usk", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 9, MaxDefense: 27, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 23, RequiredDexterity: 0, Durability: 20, RequiredLevel: 0, MaxSockets: 3, Range: 0, Type: "helm"},
+	313: {Name: "Quilted Armor", ID: 313, Code: "qui", NormalCode: "qui", UberCode: "xui", UltraCode: "uui", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 8, MaxDefense: 11, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 12, RequiredDexterity: 0, Durability: 20, RequiredLevel: 0, MaxSockets: 2, Range: 0, Type: "tors"},
+	314: {Name: "Leather Armor", ID: 314, Code: "lea", NormalCode: "lea", UberCode: "xea", UltraCode: "uea", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 14, MaxDefense: 17, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 15, RequiredDexterity: 0, Durability: 24, RequiredLevel: 0, MaxSockets: 2, Range: 0, Type: "tors"},
+	315: {Name: "Hard Leather Armor", ID: 315, Code: "hla", NormalCode: "hla", UberCode: "xla", UltraCode: "ula", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 21, MaxDefense: 24, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 20, RequiredDexterity: 0, Durability: 28, RequiredLevel: 0, MaxSockets: 2, Range: 0, Type: "tors"},
+	316: {Name: "Studded Leather ", ID: 316, Code: "stu", NormalCode: "stu", UberCode: "xtu", UltraCode: "utu", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 32, MaxDefense: 35, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 27, RequiredDexterity: 0, Durability: 32, RequiredLevel: 0, MaxSockets: 2, Range: 0, Type: "tors"},
+	317: {Name: "Ring Mail", ID: 317, Code: "rng", NormalCode: "rng", UberCode: "xng", UltraCode: "ung", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 45, MaxDefense: 48, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 5, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 36, RequiredDexterity: 0, Durability: 26, RequiredLevel: 0, MaxSockets: 3, Range: 0, Type: "tors"},
+	318: {Name: "Scale Mail", ID: 318, Code: "scl", NormalCode: "scl", UberCode: "xcl", UltraCode: "ucl", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 57, MaxDefense: 60, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 10, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 44, RequiredDexterity: 0, Durability: 36, RequiredLevel: 0, MaxSockets: 2, Range: 0, Type: "tors"},
+	319: {Name: "Chain Mail", ID: 319, Code: "chn", NormalCode: "chn", UberCode: "xhn", UltraCode: "uhn", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 72, MaxDefense: 75, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 5, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 48, RequiredDexterity: 0, Durability: 45, RequiredLevel: 0, MaxSockets: 2, Range: 0, Type: "tors"},
+	320: {Name: "Breast Plate", ID: 320, Code: "brs", NormalCode: "brs", UberCode: "xrs", UltraCode: "urs", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 65, MaxDefense: 68, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 30, RequiredDexterity: 0, Durability: 50, RequiredLevel: 0, MaxSockets: 3, Range: 0, Type: "tors"},
+	321: {Name: "Splint Mail", ID: 321, Code: "spl", NormalCode: "spl", UberCode: "xpl", UltraCode: "upl", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 90, MaxDefense: 95, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 5, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 51, RequiredDexterity: 0, Durability: 30, RequiredLevel: 0, MaxSockets: 2, Range: 0, Type: "tors"},
+	322: {Name: "Plate Mail", ID: 322, Code: "plt", NormalCode: "plt", UberCode: "xlt", UltraCode: "ult", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 108, MaxDefense: 116, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 10, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 65, RequiredDexterity: 0, Durability: 60, RequiredLevel: 0, MaxSockets: 2, Range: 0, Type: "tors"},
+	323: {Name: "Field Plate", ID: 323, Code: "fld", NormalCode: "fld", UberCode: "xld", UltraCode: "uld", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 101, MaxDefense: 105, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 5, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 55, RequiredDexterity: 0, Durability: 48, RequiredLevel: 0, MaxSockets: 2, Range: 0, Type: "tors"},
+	324: {Name: "Gothic Plate", ID: 324, Code: "gth", NormalCode: "gth", UberCode: "xth", UltraCode: "uth", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 128, MaxDefense: 135, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 5, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 70, RequiredDexterity: 0, Durability: 55, RequiredLevel: 0, MaxSockets: 4, Range: 0, Type: "tors"},
+	325: {Name: "Full Plate Mail", ID: 325, Code: "ful", NormalCode: "ful", UberCode: "xul", UltraCode: "uul", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 150, MaxDefense: 161, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 10, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 80, RequiredDexterity: 0, Durability: 70, RequiredLevel: 0, MaxSockets: 4, Range: 0, Type: "tors"},
+	326: {Name: "Ancient Armor", ID: 326, Code: "aar", NormalCode: "aar", UberCode: "xar", UltraCode: "uar", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 218, MaxDefense: 233, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 5, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 100, RequiredDexterity: 0, Durability: 60, RequiredLevel: 0, MaxSockets: 4, Range: 0, Type: "tors"},
+	327: {Name: "Light Plate", ID: 327, Code: "ltp", NormalCode: "ltp", UberCode: "xtp", UltraCode: "utp", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 90, MaxDefense: 107, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 41, RequiredDexterity: 0, Durability: 60, RequiredLevel: 0, MaxSockets: 3, Range: 0, Type: "tors"},
+	328: {Name: "Buckler", ID: 328, Code: "buc", NormalCode: "buc", UberCode: "xuc", UltraCode: "uuc", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 4, MaxDefense: 6, MinDamage: 1, MaxDamage: 3, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 12, RequiredDexterity: 0, Durability: 12, RequiredLevel: 0, MaxSockets: 1, Range: 0, Type: "shie"},
+	329: {Name: "Small Shield", ID: 329, Code: "sml", NormalCode: "sml", UberCode: "xml", UltraCode: "uml", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 8, MaxDefense: 10, MinDamage: 2, MaxDamage: 3, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 22, RequiredDexterity: 0, Durability: 16, RequiredLevel: 0, MaxSockets: 2, Range: 0, Type: "shie"},
+	330: {Name: "Large Shield", ID: 330, Code: "lrg", NormalCode: "lrg", UberCode: "xrg", UltraCode: "urg", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 12, MaxDefense: 14, MinDamage: 2, MaxDamage: 4, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 5, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 34, RequiredDexterity: 0, Durability: 24, RequiredLevel: 0, MaxSockets: 3, Range: 0, Type: "shie"},
+	331: {Name: "Kite Shield", ID: 331, Code: "kit", NormalCode: "kit", UberCode: "xit", UltraCode: "uit", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 16, MaxDefense: 18, MinDamage: 2, MaxDamage: 5, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 47, RequiredDexterity: 0, Durability: 30, RequiredLevel: 0, MaxSockets: 3, Range: 0, Type: "shie"},
+	332: {Name: "Tower Shield", ID: 332, Code: "tow", NormalCode: "tow", UberCode: "xow", UltraCode: "uow", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 22, MaxDefense: 25, MinDamage: 1, MaxDamage: 5, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 75, RequiredDexterity: 0, Durability: 60, RequiredLevel: 0, MaxSockets: 3, Range: 0, Type: "shie"},
+	333: {Name: "Gothic Shield", ID: 333, Code: "gts", NormalCode: "gts", UberCode: "xts", UltraCode: "uts", InventoryWidth: 2, InventoryHeight: 4, MinDefense: 30, MaxDefense: 35, MinDamage: 2, MaxDamage: 6, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 5, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 60, RequiredDexterity: 0, Durability: 40, RequiredLevel: 0, MaxSockets: 3, Range: 0, Type: "shie"},
+	334: {Name: "Leather Gloves", ID: 334, Code: "lgl", NormalCode: "lgl", UberCode: "xlg", UltraCode: "ulg", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 2, MaxDefense: 3, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 12, RequiredLevel: 0, MaxSockets: 0, Range: 0, Type: "glov"},
+	335: {Name: "Heavy Gloves", ID: 335, Code: "vgl", NormalCode: "vgl", UberCode: "xvg", UltraCode: "uvg", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 5, MaxDefense: 6, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 14, RequiredLevel: 0, MaxSockets: 0, Range: 0, Type: "glov"},
+	336: {Name: "Chain Gloves", ID: 336, Code: "mgl", NormalCode: "mgl", UberCode: "xmg", UltraCode: "umg", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 8, MaxDefense: 9, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 25, RequiredDexterity: 0, Durability: 16, RequiredLevel: 0, MaxSockets: 0, Range: 0, Type: "glov"},
+	337: {Name: "Light Gauntlets", ID: 337, Code: "tgl", NormalCode: "tgl", UberCode: "xtg", UltraCode: "utg", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 9, MaxDefense: 11, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 45, RequiredDexterity: 0, Durability: 18, RequiredLevel: 0, MaxSockets: 0, Range: 0, Type: "glov"},
+	338: {Name: "Gauntlets", ID: 338, Code: "hgl", NormalCode: "hgl", UberCode: "xhg", UltraCode: "uhg", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 12, MaxDefense: 15, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 60, RequiredDexterity: 0, Durability: 24, RequiredLevel: 0, MaxSockets: 0, Range: 0, Type: "glov"},
+	339: {Name: "Boots", ID: 339, Code: "lbt", NormalCode: "lbt", UberCode: "xlb", UltraCode: "ulb", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 2, MaxDefense: 3, MinDamage: 3, MaxDamage: 8, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 120, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 12, RequiredLevel: 0, MaxSockets: 0, Range: 0, Type: "boot"},
+	340: {Name: "Heavy Boots", ID: 340, Code: "vbt", NormalCode: "vbt", UberCode: "xvb", UltraCode: "uvb", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 5, MaxDefense: 6, MinDamage: 4, MaxDamage: 10, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 120, DexterityBonus: 0, RequiredStrength: 18, RequiredDexterity: 0, Durability: 14, RequiredLevel: 0, MaxSockets: 0, Range: 0, Type: "boot"},
+	341: {Name: "Chain Boots", ID: 341, Code: "mbt", NormalCode: "mbt", UberCode: "xmb", UltraCode: "umb", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 8, MaxDefense: 9, MinDamage: 6, MaxDamage: 12, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 120, DexterityBonus: 0, RequiredStrength: 30, RequiredDexterity: 0, Durability: 16, RequiredLevel: 0, MaxSockets: 0, Range: 0, Type: "boot"},
+	342: {Name: "Light Plated Boots", ID: 342, Code: "tbt", NormalCode: "tbt", UberCode: "xtb", UltraCode: "utb", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 9, MaxDefense: 11, MinDamage: 8, MaxDamage: 16, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 120, DexterityBonus: 0, RequiredStrength: 50, RequiredDexterity: 0, Durability: 18, RequiredLevel: 0, MaxSockets: 0, Range: 0, Type: "boot"},
+	343: {Name: "Greaves", ID: 343, Code: "hbt", NormalCode: "hbt", UberCode: "xhb", UltraCode: "uhb", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 12, MaxDefense: 15, MinDamage: 10, MaxDamage: 20, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 120, DexterityBonus: 0, RequiredStrength: 70, RequiredDexterity: 0, Durability: 24, RequiredLevel: 0, MaxSockets: 0, Range: 0, Type: "boot"},
+	344: {Name: "Sash", ID: 344, Code: "lbl", NormalCode: "lbl", UberCode: "zlb", UltraCode: "ulc", InventoryWidth: 2, InventoryHeight: 1, MinDefense: 2, MaxDefense: 2, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 12, RequiredLevel: 0, MaxSockets: 0, Range: 0, Type: "belt"},
+	345: {Name: "Light Belt", ID: 345, Code: "vbl", NormalCode: "vbl", UberCode: "zvb", UltraCode: "uvc", InventoryWidth: 2, InventoryHeight: 1, MinDefense: 3, MaxDefense: 3, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 14, RequiredLevel: 0, MaxSockets: 0, Range: 0, Type: "belt"},
+	346: {Name: "Belt", ID: 346, Code: "mbl", NormalCode: "mbl", UberCode: "zmb", UltraCode: "umc", InventoryWidth: 2, InventoryHeight: 1, MinDefense: 5, MaxDefense: 5, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 25, RequiredDexterity: 0, Durability: 16, RequiredLevel: 0, MaxSockets: 0, Range: 0, Type: "belt"},
+	347: {Name: "Heavy Belt", ID: 347, Code: "tbl", NormalCode: "tbl", UberCode: "ztb", UltraCode: "utc", InventoryWidth: 2, InventoryHeight: 1, MinDefense: 6, MaxDefense: 6, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 45, RequiredDexterity: 0, Durability: 18, RequiredLevel: 0, MaxSockets: 0, Range: 0, Type: "belt"},
+	348: {Name: "Plated Belt", ID: 348, Code: "hbl", NormalCode: "hbl", UberCode: "zhb", UltraCode: "uhc", InventoryWidth: 2, InventoryHeight: 1, MinDefense: 8, MaxDefense: 11, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 60, RequiredDexterity: 0, Durability: 24, RequiredLevel: 0, MaxSockets: 0, Range: 0, Type: "belt"},
+	349: {Name: "Bone Helm", ID: 349, Code: "bhm", NormalCode: "bhm", UberCode: "xh9", UltraCode: "uh9", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 33, MaxDefense: 36, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 25, RequiredDexterity: 0, Durability: 40, RequiredLevel: 0, MaxSockets: 2, Range: 0, Type: "helm"},
+	350: {Name: "Bone Shield", ID: 350, Code: "bsh", NormalCode: "bsh", UberCode: "xsh", UltraCode: "ush", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 10, MaxDefense: 30, MinDamage: 3, MaxDamage: 6, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 25, RequiredDexterity: 0, Durability: 40, RequiredLevel: 0, MaxSockets: 2, Range: 0, Type: "shie"},
+	351: {Name: "Spiked Shield", ID: 351, Code: "spk", NormalCode: "spk", UberCode: "xpk", UltraCode: "upk", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 15, MaxDefense: 25, MinDamage: 5, MaxDamage: 9, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 30, RequiredDexterity: 0, Durability: 40, RequiredLevel: 0, MaxSockets: 2, Range: 0, Type: "shie"},
+	352: {Name: "War Hat", ID: 352, Code: "xap", NormalCode: "cap", UberCode: "xap", UltraCode: "uap", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 45, MaxDefense: 53, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 20, RequiredDexterity: 0, Durability: 12, RequiredLevel: 22, MaxSockets: 2, Range: 0, Type: "helm"},
+	353: {Name: "Sallet", ID: 353, Code: "xkp", NormalCode: "skp", UberCode: "xkp", UltraCode: "ukp", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 52, MaxDefense: 62, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 43, RequiredDexterity: 0, Durability: 18, RequiredLevel: 25, MaxSockets: 2, Range: 0, Type: "helm"},
+	354: {Name: "Casque", ID: 354, Code: "xlm", NormalCode: "hlm", UberCode: "xlm", UltraCode: "ulm", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 63, MaxDefense: 72, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 59, RequiredDexterity: 0, Durability: 24, RequiredLevel: 25, MaxSockets: 2, Range: 0, Type: "helm"},
+	355: {Name: "Basinet", ID: 355, Code: "xhl", NormalCode: "fhl", UberCode: "xhl", UltraCode: "uhl", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 75, MaxDefense: 84, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 82, RequiredDexterity: 0, Durability: 30, RequiredLevel: 25, MaxSockets: 2, Range: 0, Type: "helm"},
+	356: {Name: "Winged Helm", ID: 356, Code: "xhm", NormalCode: "ghm", UberCode: "xhm", UltraCode: "uhm", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 85, MaxDefense: 98, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 115, RequiredDexterity: 0, Durability: 40, RequiredLevel: 25, MaxSockets: 3, Range: 0, Type: "helm"},
+	357: {Name: "Grand Crown", ID: 357, Code: "xrn", NormalCode: "crn", UberCode: "xrn", UltraCode: "urn", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 78, MaxDefense: 113, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 103, RequiredDexterity: 0, Durability: 50, RequiredLevel: 25, MaxSockets: 3, Range: 0, Type: "helm"},
+	358: {Name: "Death Mask", ID: 358, Code: "xsk", NormalCode: "msk", UberCode: "xsk", UltraCode: "usk", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 54, MaxDefense: 86, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 55, RequiredDexterity: 0, Durability: 20, RequiredLevel: 25, MaxSockets: 3, Range: 0, Type: "helm"},
+	359: {Name: "Ghost Armor", ID: 359, Code: "xui", NormalCode: "qui", UberCode: "xui", UltraCode: "uui", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 102, MaxDefense: 117, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 38, RequiredDexterity: 0, Durability: 20, RequiredLevel: 22, MaxSockets: 2, Range: 0, Type: "tors"},
+	360: {Name: "Serpentskin Armor", ID: 360, Code: "xea", NormalCode: "lea", UberCode: "xea", UltraCode: "uea", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 111, MaxDefense: 126, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 43, RequiredDexterity: 0, Durability: 24, RequiredLevel: 24, MaxSockets: 2, Range: 0, Type: "tors"},
+	361: {Name: "Demonhide Armor", ID: 361, Code: "xla", NormalCode: "hla", UberCode: "xla", UltraCode: "ula", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 122, MaxDefense: 136, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 50, RequiredDexterity: 0, Durability: 28, RequiredLevel: 25, MaxSockets: 2, Range: 0, Type: "tors"},
+	362: {Name: "Trellised Armor", ID: 362, Code: "xtu", NormalCode: "stu", UberCode: "xtu", UltraCode: "utu", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 138, MaxDefense: 153, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 61, RequiredDexterity: 0, Durability: 32, RequiredLevel: 25, MaxSockets: 2, Range: 0, Type: "tors"},
+	363: {Name: "Linked Mail", ID: 363, Code: "xng", NormalCode: "rng", UberCode: "xng", UltraCode: "ung", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 158, MaxDefense: 172, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 5, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 74, RequiredDexterity: 0, Durability: 26, RequiredLevel: 25, MaxSockets: 3, Range: 0, Type: "tors"},
+	364: {Name: "Tigulated Mail", ID: 364, Code: "xcl", NormalCode: "scl", UberCode: "xcl", UltraCode: "ucl", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 176, MaxDefense: 190, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 10, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 86, RequiredDexterity: 0, Durability: 36, RequiredLevel: 25, MaxSockets: 3, Range: 0, Type: "tors"},
+	365: {Name: "Mesh Armor", ID: 365, Code: "xhn", NormalCode: "chn", UberCode: "xhn", UltraCode: "uhn", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 198, MaxDefense: 213, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 5, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 92, RequiredDexterity: 0, Durability: 45, RequiredLevel: 25, MaxSockets: 3, Range: 0, Type: "tors"},
+	366: {Name: "Cuirass", ID: 366, Code: "xrs", NormalCode: "brs", UberCode: "xrs", UltraCode: "urs", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 188, MaxDefense: 202, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 65, RequiredDexterity: 0, Durability: 50, RequiredLevel: 25, MaxSockets: 3, Range: 0, Type: "tors"},
+	367: {Name: "Russet Armor", ID: 367, Code: "xpl", NormalCode: "spl", UberCode: "xpl", UltraCode: "upl", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 225, MaxDefense: 243, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 5, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 97, RequiredDexterity: 0, Durability: 30, RequiredLevel: 25, MaxSockets: 3, Range: 0, Type: "tors"},
+	368: {Name: "Templar Coat", ID: 368, Code: "xlt", NormalCode: "plt", UberCode: "xlt", UltraCode: "ult", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 252, MaxDefense: 274, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 10, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 118, RequiredDexterity: 0, Durability: 60, RequiredLevel: 25, MaxSockets: 3, Range: 0, Type: "tors"},
+	369: {Name: "Sharktooth Armor", ID: 369, Code: "xld", NormalCode: "fld", UberCode: "xld", UltraCode: "uld", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 242, MaxDefense: 258, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 5, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 103, RequiredDexterity: 0, Durability: 48, RequiredLevel: 25, MaxSockets: 3, Range: 0, Type: "tors"},
+	370: {Name: "Embossed Plate", ID: 370, Code: "xth", NormalCode: "gth", UberCode: "xth", UltraCode: "uth", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 282, MaxDefense: 303, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 5, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 125, RequiredDexterity: 0, Durability: 55, RequiredLevel: 25, MaxSockets: 4, Range: 0, Type: "tors"},
+	371: {Name: "Chaos Armor", ID: 371, Code: "xul", NormalCode: "ful", UberCode: "xul", UltraCode: "uul", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 315, MaxDefense: 342, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 10, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 140, RequiredDexterity: 0, Durability: 70, RequiredLevel: 25, MaxSockets: 4, Range: 0, Type: "tors"},
+	372: {Name: "Ornate Plate", ID: 372, Code: "xar", NormalCode: "aar", UberCode: "xar", UltraCode: "uar", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 417, MaxDefense: 450, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 5, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 170, RequiredDexterity: 0, Durability: 60, RequiredLevel: 25, MaxSockets: 4, Range: 0, Type: "tors"},
+	373: {Name: "Mage Plate", ID: 373, Code: "xtp", NormalCode: "ltp", UberCode: "xtp", UltraCode: "utp", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 225, MaxDefense: 261, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 55, RequiredDexterity: 0, Durability: 60, RequiredLevel: 25, MaxSockets: 3, Range: 0, Type: "tors"},
+	374: {Name: "Defender", ID: 374, Code: "xuc", NormalCode: "buc", UberCode: "xuc", UltraCode: "uuc", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 41, MaxDefense: 49, MinDamage: 8, MaxDamage: 12, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 38, RequiredDexterity: 0, Durability: 68, RequiredLevel: 22, MaxSockets: 1, Range: 0, Type: "shie"},
+	375: {Name: "Round Shield", ID: 375, Code: "xml", NormalCode: "sml", UberCode: "xml", UltraCode: "uml", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 47, MaxDefense: 55, MinDamage: 7, MaxDamage: 14, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 53, RequiredDexterity: 0, Durability: 64, RequiredLevel: 25, MaxSockets: 2, Range: 0, Type: "shie"},
+	376: {Name: "Scutum", ID: 376, Code: "xrg", NormalCode: "lrg", UberCode: "xrg", UltraCode: "urg", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 53, MaxDefense: 61, MinDamage: 11, MaxDamage: 15, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 5, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 71, RequiredDexterity: 0, Durability: 62, RequiredLevel: 25, MaxSockets: 3, Range: 0, Type: "shie"},
+	377: {Name: "Dragon Shield", ID: 377, Code: "xit", NormalCode: "kit", UberCode: "xit", UltraCode: "uit", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 59, MaxDefense: 67, MinDamage: 15, MaxDamage: 24, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 91, RequiredDexterity: 0, Durability: 76, RequiredLevel: 25, MaxSockets: 3, Range: 0, Type: "shie"},
+	378: {Name: "Pavise", ID: 378, Code: "xow", NormalCode: "tow", UberCode: "xow", UltraCode: "uow", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 68, MaxDefense: 78, MinDamage: 10, MaxDamage: 17, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 133, RequiredDexterity: 0, Durability: 72, RequiredLevel: 25, MaxSockets: 3, Range: 0, Type: "shie"},
+	379: {Name: "Kurast Shield", ID: 379, Code: "xts", NormalCode: "gts", UberCode: "xts", UltraCode: "uts", InventoryWidth: 2, InventoryHeight: 4, MinDefense: 80, MaxDefense: 93, MinDamage: 12, MaxDamage: 16, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 5, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 110, RequiredDexterity: 0, Durability: 80, RequiredLevel: 25, MaxSockets: 3, Range: 0, Type: "shie"},
+	380: {Name: "Demonhide Gloves", ID: 380, Code: "xlg", NormalCode: "lgl", UberCode: "xlg", UltraCode: "ulg", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 28, MaxDefense: 35, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 20, RequiredDexterity: 0, Durability: 12, RequiredLevel: 21, MaxSockets: 0, Range: 0, Type: "glov"},
+	381: {Name: "Sharkskin Gloves", ID: 381, Code: "xvg", NormalCode: "vgl", UberCode: "xvg", UltraCode: "uvg", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 33, MaxDefense: 39, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 20, RequiredDexterity: 0, Durability: 14, RequiredLevel: 25, MaxSockets: 0, Range: 0, Type: "glov"},
+	382: {Name: "Heavy Bracers", ID: 382, Code: "xmg", NormalCode: "mgl", UberCode: "xmg", UltraCode: "umg", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 37, MaxDefense: 44, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 58, RequiredDexterity: 0, Durability: 16, RequiredLevel: 25, MaxSockets: 0, Range: 0, Type: "glov"},
+	383: {Name: "Battle Gauntlets", ID: 383, Code: "xtg", NormalCode: "tgl", UberCode: "xtg", UltraCode: "utg", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 39, MaxDefense: 47, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 88, RequiredDexterity: 0, Durability: 18, RequiredLevel: 25, MaxSockets: 0, Range: 0, Type: "glov"},
+	384: {Name: "War Gauntlets", ID: 384, Code: "xhg", NormalCode: "hgl", UberCode: "xhg", UltraCode: "uhg", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 43, MaxDefense: 53, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 110, RequiredDexterity: 0, Durability: 24, RequiredLevel: 25, MaxSockets: 0, Range: 0, Type: "glov"},
+	385: {Name: "Demonhide Boots", ID: 385, Code: "xlb", NormalCode: "lbt", UberCode: "xlb", UltraCode: "ulb", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 28, MaxDefense: 35, MinDamage: 26, MaxDamage: 46, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 120, DexterityBonus: 0, RequiredStrength: 20, RequiredDexterity: 0, Durability: 12, RequiredLevel: 24, MaxSockets: 0, Range: 0, Type: "boot"},
+	386: {Name: "Sharkskin Boots", ID: 386, Code: "xvb", NormalCode: "vbt", UberCode: "xvb", UltraCode: "uvb", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 33, MaxDefense: 39, MinDamage: 28, MaxDamage: 50, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 120, DexterityBonus: 0, RequiredStrength: 47, RequiredDexterity: 0, Durability: 14, RequiredLevel: 25, MaxSockets: 0, Range: 0, Type: "boot"},
+	387: {Name: "Mesh Boots", ID: 387, Code: "xmb", NormalCode: "mbt", UberCode: "xmb", UltraCode: "umb", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 37, MaxDefense: 44, MinDamage: 23, MaxDamage: 52, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 120, DexterityBonus: 0, RequiredStrength: 65, RequiredDexterity: 0, Durability: 16, RequiredLevel: 25, MaxSockets: 0, Range: 0, Type: "boot"},
+	388: {Name: "Battle Boots", ID: 388, Code: "xtb", NormalCode: "tbt", UberCode: "xtb", UltraCode: "utb", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 39, MaxDefense: 47, MinDamage: 37, MaxDamage: 64, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 120, DexterityBonus: 0, RequiredStrength: 95, RequiredDexterity: 0, Durability: 18, RequiredLevel: 25, MaxSockets: 0, Range: 0, Type: "boot"},
+	389: {Name: "War Boots", ID: 389, Code: "xhb", NormalCode: "hbt", UberCode: "xhb", UltraCode: "uhb", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 43, MaxDefense: 53, MinDamage: 39, MaxDamage: 80, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 120, DexterityBonus: 0, RequiredStrength: 125, RequiredDexterity: 0, Durability: 24, RequiredLevel: 25, MaxSockets: 0, Range: 0, Type: "boot"},
+	390: {Name: "Demonhide Sash", ID: 390, Code: "zlb", NormalCode: "lbl", UberCode: "zlb", UltraCode: "ulc", InventoryWidth: 2, InventoryHeight: 1, MinDefense: 29, MaxDefense: 34, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 20, RequiredDexterity: 0, Durability: 12, RequiredLevel: 24, MaxSockets: 0, Range: 0, Type: "belt"},
+	391: {Name: "Sharkskin Belt", ID: 391, Code: "zvb", NormalCode: "vbl", UberCode: "zvb", UltraCode: "uvc", InventoryWidth: 2, InventoryHeight: 1, MinDefense: 31, MaxDefense: 36, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 20, RequiredDexterity: 0, Durability: 14, RequiredLevel: 25, MaxSockets: 0, Range: 0, Type: "belt"},
+	392: {Name: "Mesh Belt", ID: 392, Code: "zmb", NormalCode: "mbl", UberCode: "zmb", UltraCode: "umc", InventoryWidth: 2, InventoryHeight: 1, MinDefense: 35, MaxDefense: 40, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 58, RequiredDexterity: 0, Durability: 16, RequiredLevel: 25, MaxSockets: 0, Range: 0, Type: "belt"},
+	393: {Name: "Battle Belt", ID: 393, Code: "ztb", NormalCode: "tbl", UberCode: "ztb", UltraCode: "utc", InventoryWidth: 2, InventoryHeight: 1, MinDefense: 37, MaxDefense: 42, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 88, RequiredDexterity: 0, Durability: 18, RequiredLevel: 25, MaxSockets: 0, Range: 0, Type: "belt"},
+	394: {Name: "War Belt", ID: 394, Code: "zhb", NormalCode: "hbl", UberCode: "zhb", UltraCode: "uhc", InventoryWidth: 2, InventoryHeight: 1, MinDefense: 41, MaxDefense: 52, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 110, RequiredDexterity: 0, Durability: 24, RequiredLevel: 25, MaxSockets: 0, Range: 0, Type: "belt"},
+	395: {Name: "Grim Helm", ID: 395, Code: "xh9", NormalCode: "bhm", UberCode: "xh9", UltraCode: "uh9", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 60, MaxDefense: 125, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 58, RequiredDexterity: 0, Durability: 40, RequiredLevel: 25, MaxSockets: 2, Range: 0, Type: "helm"},
+	396: {Name: "Grim Shield", ID: 396, Code: "xsh", NormalCode: "bsh", UberCode: "xsh", UltraCode: "ush", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 50, MaxDefense: 150, MinDamage: 14, MaxDamage: 20, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 58, RequiredDexterity: 0, Durability: 70, RequiredLevel: 25, MaxSockets: 2, Range: 0, Type: "shie"},
+	397: {Name: "Barbed Shield", ID: 397, Code: "xpk", NormalCode: "spk", UberCode: "xpk", UltraCode: "upk", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 58, MaxDefense: 78, MinDamage: 18, MaxDamage: 35, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 65, RequiredDexterity: 0, Durability: 55, RequiredLevel: 25, MaxSockets: 2, Range: 0, Type: "shie"},
+	398: {Name: "Wolf Head", ID: 398, Code: "dr1", NormalCode: "dr1", UberCode: "dr6", UltraCode: "drb", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 8, MaxDefense: 11, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 16, RequiredDexterity: 0, Durability: 20, RequiredLevel: 3, MaxSockets: 3, Range: 0, Type: "pelt"},
+	399: {Name: "Hawk Helm", ID: 399, Code: "dr2", NormalCode: "dr2", UberCode: "dr7", UltraCode: "drc", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 4, MaxDefense: 15, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 20, RequiredDexterity: 0, Durability: 20, RequiredLevel: 6, MaxSockets: 3, Range: 0, Type: "pelt"},
+	400: {Name: "Antlers", ID: 400, Code: "dr3", NormalCode: "dr3", UberCode: "dr8", UltraCode: "drd", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 18, MaxDefense: 24, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 24, RequiredDexterity: 0, Durability: 20, RequiredLevel: 12, MaxSockets: 3, Range: 0, Type: "pelt"},
+	401: {Name: "Falcon Mask", ID: 401, Code: "dr4", NormalCode: "dr4", UberCode: "dr9", UltraCode: "dre", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 12, MaxDefense: 28, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 28, RequiredDexterity: 0, Durability: 20, RequiredLevel: 15, MaxSockets: 3, Range: 0, Type: "pelt"},
+	402: {Name: "Spirit Mask", ID: 402, Code: "dr5", NormalCode: "dr5", UberCode: "dra", UltraCode: "drf", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 22, MaxDefense: 35, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 30, RequiredDexterity: 0, Durability: 20, RequiredLevel: 18, MaxSockets: 3, Range: 0, Type: "pelt"},
+	403: {Name: "Jawbone Cap", ID: 403, Code: "ba1", NormalCode: "ba1", UberCode: "ba6", UltraCode: "bab", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 10, MaxDefense: 15, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 25, RequiredDexterity: 0, Durability: 25, RequiredLevel: 3, MaxSockets: 3, Range: 0, Type: "phlm"},
+	404: {Name: "Fanged Helm", ID: 404, Code: "ba2", NormalCode: "ba2", UberCode: "ba7", UltraCode: "bac", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 15, MaxDefense: 20, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 35, RequiredDexterity: 0, Durability: 35, RequiredLevel: 6, MaxSockets: 3, Range: 0, Type: "phlm"},
+	405: {Name: "Horned Helm", ID: 405, Code: "ba3", NormalCode: "ba3", UberCode: "ba8", UltraCode: "bad", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 25, MaxDefense: 30, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 45, RequiredDexterity: 0, Durability: 45, RequiredLevel: 12, MaxSockets: 3, Range: 0, Type: "phlm"},
+	406: {Name: "Assault Helmet", ID: 406, Code: "ba4", NormalCode: "ba4", UberCode: "ba9", UltraCode: "bae", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 30, MaxDefense: 35, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 55, RequiredDexterity: 0, Durability: 50, RequiredLevel: 15, MaxSockets: 3, Range: 0, Type: "phlm"},
+	407: {Name: "Avenger Guard", ID: 407, Code: "ba5", NormalCode: "ba5", UberCode: "baa", UltraCode: "baf", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 35, MaxDefense: 50, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 65, RequiredDexterity: 0, Durability: 55, RequiredLevel: 18, MaxSockets: 3, Range: 0, Type: "phlm"},
+	408: {Name: "Targe", ID: 408, Code: "pa1", NormalCode: "pa1", UberCode: "pa6", UltraCode: "pab", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 8, MaxDefense: 12, MinDamage: 2, MaxDamage: 6, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 16, RequiredDexterity: 0, Durability: 20, RequiredLevel: 3, MaxSockets: 4, Range: 0, Type: "ashd"},
+	409: {Name: "Rondache", ID: 409, Code: "pa2", NormalCode: "pa2", UberCode: "pa7", UltraCode: "pac", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 10, MaxDefense: 18, MinDamage: 2, MaxDamage: 8, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 26, RequiredDexterity: 0, Durability: 30, RequiredLevel: 6, MaxSockets: 4, Range: 0, Type: "ashd"},
+	410: {Name: "Heraldic Shield", ID: 410, Code: "pa3", NormalCode: "pa3", UberCode: "pa8", UltraCode: "pad", InventoryWidth: 2, InventoryHeight: 4, MinDefense: 16, MaxDefense: 26, MinDamage: 3, MaxDamage: 9, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 40, RequiredDexterity: 0, Durability: 40, RequiredLevel: 12, MaxSockets: 4, Range: 0, Type: "ashd"},
+	411: {Name: "Aerin Shield", ID: 411, Code: "pa4", NormalCode: "pa4", UberCode: "pa9", UltraCode: "pae", InventoryWidth: 2, InventoryHeight: 4, MinDefense: 26, MaxDefense: 36, MinDamage: 4, MaxDamage: 10, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 50, RequiredDexterity: 0, Durability: 50, RequiredLevel: 15, MaxSockets: 4, Range: 0, Type: "ashd"},
+	412: {Name: "Crown Shield", ID: 412, Code: "pa5", NormalCode: "pa5", UberCode: "paa", UltraCode: "paf", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 30, MaxDefense: 40, MinDamage: 4, MaxDamage: 12, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 65, RequiredDexterity: 0, Durability: 60, RequiredLevel: 18, MaxSockets: 4, Range: 0, Type: "ashd"},
+	413: {Name: "Preserved Head", ID: 413, Code: "ne1", NormalCode: "ne1", UberCode: "ne6", UltraCode: "neb", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 2, MaxDefense: 5, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 12, RequiredDexterity: 0, Durability: 20, RequiredLevel: 3, MaxSockets: 2, Range: 0, Type: "head"},
+	414: {Name: "Zombie Head", ID: 414, Code: "ne2", NormalCode: "ne2", UberCode: "ne7", UltraCode: "neg", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 4, MaxDefense: 8, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 14, RequiredDexterity: 0, Durability: 20, RequiredLevel: 6, MaxSockets: 2, Range: 0, Type: "head"},
+	415: {Name: "Unraveller Head", ID: 415, Code: "ne3", NormalCode: "ne3", UberCode: "ne8", UltraCode: "ned", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 6, MaxDefense: 10, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 18, RequiredDexterity: 0, Durability: 20, RequiredLevel: 12, MaxSockets: 2, Range: 0, Type: "head"},
+	416: {Name: "Gargoyle Head", ID: 416, Code: "ne4", NormalCode: "ne4", UberCode: "ne9", UltraCode: "nee", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 10, MaxDefense: 16, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 20, RequiredDexterity: 0, Durability: 20, RequiredLevel: 15, MaxSockets: 2, Range: 0, Type: "head"},
+	417: {Name: "Demon Head", ID: 417, Code: "ne5", NormalCode: "ne5", UberCode: "nea", UltraCode: "nef", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 15, MaxDefense: 20, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 25, RequiredDexterity: 0, Durability: 20, RequiredLevel: 18, MaxSockets: 2, Range: 0, Type: "head"},
+	418: {Name: "Circlet", ID: 418, Code: "ci0", NormalCode: "ci0", UberCode: "ci2", UltraCode: "ci3", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 20, MaxDefense: 30, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 35, RequiredLevel: 16, MaxSockets: 2, Range: 0, Type: "circ"},
+	419: {Name: "Coronet", ID: 419, Code: "ci1", NormalCode: "ci1", UberCode: "ci2", UltraCode: "ci3", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 30, MaxDefense: 40, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 30, RequiredLevel: 39, MaxSockets: 2, Range: 0, Type: "circ"},
+	420: {Name: "Tiara", ID: 420, Code: "ci2", NormalCode: "ci1", UberCode: "ci2", UltraCode: "ci3", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 40, MaxDefense: 50, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 25, RequiredLevel: 52, MaxSockets: 3, Range: 0, Type: "circ"},
+	421: {Name: "Diadem", ID: 421, Code: "ci3", NormalCode: "ci1", UberCode: "ci2", UltraCode: "ci3", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 50, MaxDefense: 60, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 20, RequiredLevel: 64, MaxSockets: 3, Range: 0, Type: "circ"},
+	422: {Name: "Shako", ID: 422, Code: "uap", NormalCode: "cap", UberCode: "xap", UltraCode: "uap", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 98, MaxDefense: 141, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 50, RequiredDexterity: 0, Durability: 12, RequiredLevel: 43, MaxSockets: 2, Range: 0, Type: "helm"},
+	423: {Name: "Hydraskull", ID: 423, Code: "ukp", NormalCode: "skp", UberCode: "xkp", UltraCode: "ukp", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 101, MaxDefense: 145, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 84, RequiredDexterity: 0, Durability: 18, RequiredLevel: 47, MaxSockets: 2, Range: 0, Type: "helm"},
+	424: {Name: "Armet", ID: 424, Code: "ulm", NormalCode: "hlm", UberCode: "xlm", UltraCode: "ulm", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 105, MaxDefense: 149, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 109, RequiredDexterity: 0, Durability: 24, RequiredLevel: 51, MaxSockets: 2, Range: 0, Type: "helm"},
+	425: {Name: "Giant Conch", ID: 425, Code: "uhl", NormalCode: "fhl", UberCode: "xhl", UltraCode: "uhl", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 110, MaxDefense: 154, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 142, RequiredDexterity: 0, Durability: 30, RequiredLevel: 40, MaxSockets: 2, Range: 0, Type: "helm"},
+	426: {Name: "Spired Helm", ID: 426, Code: "uhm", NormalCode: "ghm", UberCode: "xhm", UltraCode: "uhm", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 114, MaxDefense: 159, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 192, RequiredDexterity: 0, Durability: 40, RequiredLevel: 59, MaxSockets: 3, Range: 0, Type: "helm"},
+	427: {Name: "Corona", ID: 427, Code: "urn", NormalCode: "crn", UberCode: "xrn", UltraCode: "urn", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 111, MaxDefense: 165, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 174, RequiredDexterity: 0, Durability: 50, RequiredLevel: 66, MaxSockets: 3, Range: 0, Type: "helm"},
+	428: {Name: "Demonhead", ID: 428, Code: "usk", NormalCode: "msk", UberCode: "xsk", UltraCode: "usk", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 101, MaxDefense: 154, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 102, RequiredDexterity: 0, Durability: 20, RequiredLevel: 55, MaxSockets: 3, Range: 0, Type: "helm"},
+	429: {Name: "Dusk Shroud", ID: 429, Code: "uui", NormalCode: "qui", UberCode: "xui", UltraCode: "uui", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 361, MaxDefense: 467, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 77, RequiredDexterity: 0, Durability: 20, RequiredLevel: 49, MaxSockets: 4, Range: 0, Type: "tors"},
+	430: {Name: "Wyrmhide", ID: 430, Code: "uea", NormalCode: "lea", UberCode: "xea", UltraCode: "uea", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 364, MaxDefense: 470, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 84, RequiredDexterity: 0, Durability: 24, RequiredLevel: 50, MaxSockets: 4, Range: 0, Type: "tors"},
+	431: {Name: "Scarab Husk", ID: 431, Code: "ula", NormalCode: "hla", UberCode: "xla", UltraCode: "ula", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 369, MaxDefense: 474, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 95, RequiredDexterity: 0, Durability: 28, RequiredLevel: 51, MaxSockets: 4, Range: 0, Type: "tors"},
+	432: {Name: "Wire Fleece", ID: 432, Code: "utu", NormalCode: "stu", UberCode: "xtu", UltraCode: "utu", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 375, MaxDefense: 481, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 111, RequiredDexterity: 0, Durability: 32, RequiredLevel: 53, MaxSockets: 4, Range: 0, Type: "tors"},
+	433: {Name: "Diamond Mail", ID: 433, Code: "ung", NormalCode: "rng", UberCode: "xng", UltraCode: "ung", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 383, MaxDefense: 489, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 5, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 131, RequiredDexterity: 0, Durability: 26, RequiredLevel: 54, MaxSockets: 4, Range: 0, Type: "tors"},
+	434: {Name: "Loricated Mail", ID: 434, Code: "ucl", NormalCode: "scl", UberCode: "xcl", UltraCode: "ucl", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 390, MaxDefense: 496, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 10, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 149, RequiredDexterity: 0, Durability: 36, RequiredLevel: 55, MaxSockets: 4, Range: 0, Type: "tors"},
+	435: {Name: "Boneweave", ID: 435, Code: "uhn", NormalCode: "chn", UberCode: "xhn", UltraCode: "uhn", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 399, MaxDefense: 505, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 5, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 158, RequiredDexterity: 0, Durability: 45, RequiredLevel: 47, MaxSockets: 4, Range: 0, Type: "tors"},
+	436: {Name: "Great Hauberk", ID: 436, Code: "urs", NormalCode: "brs", UberCode: "xrs", UltraCode: "urs", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 395, MaxDefense: 501, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 118, RequiredDexterity: 0, Durability: 50, RequiredLevel: 56, MaxSockets: 4, Range: 0, Type: "tors"},
+	437: {Name: "Balrog Skin", ID: 437, Code: "upl", NormalCode: "spl", UberCode: "xpl", UltraCode: "upl", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 410, MaxDefense: 517, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 5, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 165, RequiredDexterity: 0, Durability: 30, RequiredLevel: 57, MaxSockets: 4, Range: 0, Type: "tors"},
+	438: {Name: "Hellforge Plate", ID: 438, Code: "ult", NormalCode: "plt", UberCode: "xlt", UltraCode: "ult", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 421, MaxDefense: 530, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 10, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 196, RequiredDexterity: 0, Durability: 60, RequiredLevel: 59, MaxSockets: 4, Range: 0, Type: "tors"},
+	439: {Name: "Kraken Shell", ID: 439, Code: "uld", NormalCode: "fld", UberCode: "xld", UltraCode: "uld", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 417, MaxDefense: 523, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 5, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 174, RequiredDexterity: 0, Durability: 48, RequiredLevel: 61, MaxSockets: 4, Range: 0, Type: "tors"},
+	440: {Name: "Lacquered Plate", ID: 440, Code: "uth", NormalCode: "gth", UberCode: "xth", UltraCode: "uth", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 433, MaxDefense: 541, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 5, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 208, RequiredDexterity: 0, Durability: 55, RequiredLevel: 62, MaxSockets: 4, Range: 0, Type: "tors"},
+	441: {Name: "Shadow Plate", ID: 441, Code: "uul", NormalCode: "ful", UberCode: "xul", UltraCode: "uul", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 446, MaxDefense: 557, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 10, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 230, RequiredDexterity: 0, Durability: 70, RequiredLevel: 64, MaxSockets: 4, Range: 0, Type: "tors"},
+	442: {Name: "Sacred Armor", ID: 442, Code: "uar", NormalCode: "aar", UberCode: "xar", UltraCode: "uar", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 487, MaxDefense: 600, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 5, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 232, RequiredDexterity: 0, Durability: 60, RequiredLevel: 66, MaxSockets: 4, Range: 0, Type: "tors"},
+	443: {Name: "Archon Plate", ID: 443, Code: "utp", NormalCode: "ltp", UberCode: "xtp", UltraCode: "utp", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 410, MaxDefense: 524, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 103, RequiredDexterity: 0, Durability: 60, RequiredLevel: 63, MaxSockets: 4, Range: 0, Type: "tors"},
+	444: {Name: "Heater", ID: 444, Code: "uuc", NormalCode: "buc", UberCode: "xuc", UltraCode: "uuc", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 95, MaxDefense: 110, MinDamage: 16, MaxDamage: 30, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 77, RequiredDexterity: 0, Durability: 88, RequiredLevel: 43, MaxSockets: 2, Range: 0, Type: "shie"},
+	445: {Name: "Luna", ID: 445, Code: "uml", NormalCode: "sml", UberCode: "xml", UltraCode: "uml", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 108, MaxDefense: 123, MinDamage: 17, MaxDamage: 29, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 100, RequiredDexterity: 0, Durability: 84, RequiredLevel: 45, MaxSockets: 2, Range: 0, Type: "shie"},
+	446: {Name: "Hyperion", ID: 446, Code: "urg", NormalCode: "lrg", UberCode: "xrg", UltraCode: "urg", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 119, MaxDefense: 135, MinDamage: 14, MaxDamage: 32, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 5, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 127, RequiredDexterity: 0, Durability: 82, RequiredLevel: 48, MaxSockets: 3, Range: 0, Type: "shie"},
+	447: {Name: "Monarch", ID: 447, Code: "uit", NormalCode: "kit", UberCode: "xit", UltraCode: "uit", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 133, MaxDefense: 148, MinDamage: 12, MaxDamage: 34, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 156, RequiredDexterity: 0, Durability: 86, RequiredLevel: 54, MaxSockets: 4, Range: 0, Type: "shie"},
+	448: {Name: "Aegis", ID: 448, Code: "uow", NormalCode: "tow", UberCode: "xow", UltraCode: "uow", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 145, MaxDefense: 161, MinDamage: 18, MaxDamage: 28, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 10, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 219, RequiredDexterity: 0, Durability: 92, RequiredLevel: 59, MaxSockets: 4, Range: 0, Type: "shie"},
+	449: {Name: "Ward", ID: 449, Code: "uts", NormalCode: "gts", UberCode: "xts", UltraCode: "uts", InventoryWidth: 2, InventoryHeight: 4, MinDefense: 153, MaxDefense: 170, MinDamage: 11, MaxDamage: 35, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 5, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 185, RequiredDexterity: 0, Durability: 100, RequiredLevel: 63, MaxSockets: 4, Range: 0, Type: "shie"},
+	450: {Name: "Bramble Mitts", ID: 450, Code: "ulg", NormalCode: "lgl", UberCode: "xlg", UltraCode: "ulg", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 54, MaxDefense: 62, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 50, RequiredDexterity: 0, Durability: 12, RequiredLevel: 42, MaxSockets: 0, Range: 0, Type: "glov"},
+	451: {Name: "Vampirebone Gloves", ID: 451, Code: "uvg", NormalCode: "vgl", UberCode: "xvg", UltraCode: "uvg", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 56, MaxDefense: 65, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 50, RequiredDexterity: 0, Durability: 14, RequiredLevel: 47, MaxSockets: 0, Range: 0, Type: "glov"},
+	452: {Name: "Vambraces", ID: 452, Code: "umg", NormalCode: "mgl", UberCode: "xmg", UltraCode: "umg", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 59, MaxDefense: 67, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 106, RequiredDexterity: 0, Durability: 16, RequiredLevel: 51, MaxSockets: 0, Range: 0, Type: "glov"},
+	453: {Name: "Crusader Gauntlets", ID: 453, Code: "utg", NormalCode: "tgl", UberCode: "xtg", UltraCode: "utg", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 59, MaxDefense: 68, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 151, RequiredDexterity: 0, Durability: 18, RequiredLevel: 57, MaxSockets: 0, Range: 0, Type: "glov"},
+	454: {Name: "Ogre Gauntlets", ID: 454, Code: "uhg", NormalCode: "hgl", UberCode: "xhg", UltraCode: "uhg", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 62, MaxDefense: 71, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 185, RequiredDexterity: 0, Durability: 24, RequiredLevel: 64, MaxSockets: 0, Range: 0, Type: "glov"},
+	455: {Name: "Wyrmhide Boots", ID: 455, Code: "ulb", NormalCode: "lbt", UberCode: "xlb", UltraCode: "ulb", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 54, MaxDefense: 62, MinDamage: 65, MaxDamage: 100, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 120, DexterityBonus: 0, RequiredStrength: 50, RequiredDexterity: 0, Durability: 12, RequiredLevel: 45, MaxSockets: 0, Range: 0, Type: "boot"},
+	456: {Name: "Scarabshell Boots", ID: 456, Code: "uvb", NormalCode: "vbt", UberCode: "xvb", UltraCode: "uvb", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 56, MaxDefense: 65, MinDamage: 60, MaxDamage: 110, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 120, DexterityBonus: 0, RequiredStrength: 91, RequiredDexterity: 0, Durability: 14, RequiredLevel: 49, MaxSockets: 0, Range: 0, Type: "boot"},
+	457: {Name: "Boneweave Boots", ID: 457, Code: "umb", NormalCode: "mbt", UberCode: "xmb", UltraCode: "umb", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 59, MaxDefense: 67, MinDamage: 69, MaxDamage: 118, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 120, DexterityBonus: 0, RequiredStrength: 118, RequiredDexterity: 0, Durability: 16, RequiredLevel: 54, MaxSockets: 0, Range: 0, Type: "boot"},
+	458: {Name: "Mirrored Boots", ID: 458, Code: "utb", NormalCode: "tbt", UberCode: "xtb", UltraCode: "utb", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 59, MaxDefense: 68, MinDamage: 50, MaxDamage: 145, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 120, DexterityBonus: 0, RequiredStrength: 163, RequiredDexterity: 0, Durability: 18, RequiredLevel: 60, MaxSockets: 0, Range: 0, Type: "boot"},
+	459: {Name: "Myrmidon Greaves", ID: 459, Code: "uhb", NormalCode: "hbt", UberCode: "xhb", UltraCode: "uhb", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 62, MaxDefense: 71, MinDamage: 83, MaxDamage: 149, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 120, DexterityBonus: 0, RequiredStrength: 208, RequiredDexterity: 0, Durability: 24, RequiredLevel: 65, MaxSockets: 0, Range: 0, Type: "boot"},
+	460: {Name: "Spiderweb Sash", ID: 460, Code: "ulc", NormalCode: "lbl", UberCode: "zlb", UltraCode: "ulc", InventoryWidth: 2, InventoryHeight: 1, MinDefense: 55, MaxDefense: 62, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 50, RequiredDexterity: 0, Durability: 12, RequiredLevel: 46, MaxSockets: 0, Range: 0, Type: "belt"},
+	461: {Name: "Vampirefang Belt", ID: 461, Code: "uvc", NormalCode: "vbl", UberCode: "zvb", UltraCode: "uvc", InventoryWidth: 2, InventoryHeight: 1, MinDefense: 56, MaxDefense: 63, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 50, RequiredDexterity: 0, Durability: 14, RequiredLevel: 51, MaxSockets: 0, Range: 0, Type: "belt"},
+	462: {Name: "Mithril Coil", ID: 462, Code: "umc", NormalCode: "mbl", UberCode: "zmb", UltraCode: "umc", InventoryWidth: 2, InventoryHeight: 1, MinDefense: 58, MaxDefense: 65, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 106, RequiredDexterity: 0, Durability: 16, RequiredLevel: 56, MaxSockets: 0, Range: 0, Type: "belt"},
+	463: {Name: "Troll Belt", ID: 463, Code: "utc", NormalCode: "tbl", UberCode: "ztb", UltraCode: "utc", InventoryWidth: 2, InventoryHeight: 1, MinDefense: 59, MaxDefense: 66, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 151, RequiredDexterity: 0, Durability: 18, RequiredLevel: 62, MaxSockets: 0, Range: 0, Type: "belt"},
+	464: {Name: "Colossus Girdle", ID: 464, Code: "uhc", NormalCode: "hbl", UberCode: "zhb", UltraCode: "uhc", InventoryWidth: 2, InventoryHeight: 1, MinDefense: 61, MaxDefense: 71, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 185, RequiredDexterity: 0, Durability: 24, RequiredLevel: 67, MaxSockets: 0, Range: 0, Type: "belt"},
+	465: {Name: "Bone Visage", ID: 465, Code: "uh9", NormalCode: "bhm", UberCode: "xh9", UltraCode: "uh9", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 100, MaxDefense: 157, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 106, RequiredDexterity: 0, Durability: 40, RequiredLevel: 63, MaxSockets: 3, Range: 0, Type: "helm"},
+	466: {Name: "Troll Nest", ID: 466, Code: "ush", NormalCode: "bsh", UberCode: "xsh", UltraCode: "ush", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 158, MaxDefense: 173, MinDamage: 24, MaxDamage: 38, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 106, RequiredDexterity: 0, Durability: 74, RequiredLevel: 57, MaxSockets: 3, Range: 0, Type: "shie"},
+	467: {Name: "Blade Barrier", ID: 467, Code: "upk", NormalCode: "spk", UberCode: "xpk", UltraCode: "upk", InventoryWidth: 2, InventoryHeight: 3, MinDefense: 147, MaxDefense: 163, MinDamage: 26, MaxDamage: 40, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 118, RequiredDexterity: 0, Durability: 83, RequiredLevel: 51, MaxSockets: 3, Range: 0, Type: "shie"},
+	468: {Name: "Alpha Helm", ID: 468, Code: "dr6", NormalCode: "dr1", UberCode: "dr6", UltraCode: "drb", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 52, MaxDefense: 62, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 44, RequiredDexterity: 0, Durability: 20, RequiredLevel: 26, MaxSockets: 3, Range: 0, Type: "pelt"},
+	469: {Name: "Griffon Headress", ID: 469, Code: "dr7", NormalCode: "dr2", UberCode: "dr7", UltraCode: "drc", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 46, MaxDefense: 68, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 50, RequiredDexterity: 0, Durability: 20, RequiredLevel: 30, MaxSockets: 3, Range: 0, Type: "pelt"},
+	470: {Name: "Hunter's Guise", ID: 470, Code: "dr8", NormalCode: "dr3", UberCode: "dr8", UltraCode: "drd", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 67, MaxDefense: 81, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 56, RequiredDexterity: 0, Durability: 20, RequiredLevel: 29, MaxSockets: 3, Range: 0, Type: "pelt"},
+	471: {Name: "Sacred Feathers", ID: 471, Code: "dr9", NormalCode: "dr4", UberCode: "dr9", UltraCode: "dre", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 58, MaxDefense: 87, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 62, RequiredDexterity: 0, Durability: 20, RequiredLevel: 32, MaxSockets: 3, Range: 0, Type: "pelt"},
+	472: {Name: "Totemic Mask", ID: 472, Code: "dra", NormalCode: "dr5", UberCode: "dra", UltraCode: "drf", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 73, MaxDefense: 98, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 65, RequiredDexterity: 0, Durability: 20, RequiredLevel: 41, MaxSockets: 3, Range: 0, Type: "pelt"},
+	473: {Name: "Jawbone Visor", ID: 473, Code: "ba6", NormalCode: "ba1", UberCode: "ba6", UltraCode: "bab", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 55, MaxDefense: 68, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 58, RequiredDexterity: 0, Durability: 25, RequiredLevel: 25, MaxSockets: 3, Range: 0, Type: "phlm"},
+	474: {Name: "Lion Helm", ID: 474, Code: "ba7", NormalCode: "ba2", UberCode: "ba7", UltraCode: "bac", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 63, MaxDefense: 75, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 73, RequiredDexterity: 0, Durability: 35, RequiredLevel: 29, MaxSockets: 3, Range: 0, Type: "phlm"},
+	475: {Name: "Rage Mask", ID: 475, Code: "ba8", NormalCode: "ba3", UberCode: "ba8", UltraCode: "bad", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 78, MaxDefense: 90, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 88, RequiredDexterity: 0, Durability: 45, RequiredLevel: 29, MaxSockets: 3, Range: 0, Type: "phlm"},
+	476: {Name: "Savage Helmet", ID: 476, Code: "ba9", NormalCode: "ba4", UberCode: "ba9", UltraCode: "bae", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 85, MaxDefense: 98, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 103, RequiredDexterity: 0, Durability: 50, RequiredLevel: 32, MaxSockets: 3, Range: 0, Type: "phlm"},
+	477: {Name: "Slayer Guard", ID: 477, Code: "baa", NormalCode: "ba5", UberCode: "baa", UltraCode: "baf", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 93, MaxDefense: 120, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 118, RequiredDexterity: 0, Durability: 55, RequiredLevel: 40, MaxSockets: 3, Range: 0, Type: "phlm"},
+	478: {Name: "Akaran Targe", ID: 478, Code: "pa6", NormalCode: "pa1", UberCode: "pa6", UltraCode: "pab", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 101, MaxDefense: 125, MinDamage: 12, MaxDamage: 16, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 44, RequiredDexterity: 0, Durability: 20, RequiredLevel: 26, MaxSockets: 4, Range: 0, Type: "ashd"},
+	479: {Name: "Akaran Rondache", ID: 479, Code: "pa7", NormalCode: "pa2", UberCode: "pa7", UltraCode: "pac", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 113, MaxDefense: 137, MinDamage: 15, MaxDamage: 20, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 59, RequiredDexterity: 0, Durability: 30, RequiredLevel: 30, MaxSockets: 4, Range: 0, Type: "ashd"},
+	480: {Name: "Protector Shield", ID: 480, Code: "pa8", NormalCode: "pa3", UberCode: "pa8", UltraCode: "pad", InventoryWidth: 2, InventoryHeight: 4, MinDefense: 129, MaxDefense: 153, MinDamage: 18, MaxDamage: 24, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 69, RequiredDexterity: 0, Durability: 40, RequiredLevel: 34, MaxSockets: 4, Range: 0, Type: "ashd"},
+	481: {Name: "Gilded Shield", ID: 481, Code: "pa9", NormalCode: "pa4", UberCode: "pa9", UltraCode: "pae", InventoryWidth: 2, InventoryHeight: 4, MinDefense: 144, MaxDefense: 168, MinDamage: 20, MaxDamage: 28, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 89, RequiredDexterity: 0, Durability: 50, RequiredLevel: 38, MaxSockets: 4, Range: 0, Type: "ashd"},
+	482: {Name: "Royal Shield", ID: 482, Code: "paa", NormalCode: "pa5", UberCode: "paa", UltraCode: "paf", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 156, MaxDefense: 181, MinDamage: 24, MaxDamage: 32, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 114, RequiredDexterity: 0, Durability: 60, RequiredLevel: 41, MaxSockets: 4, Range: 0, Type: "ashd"},
+	483: {Name: "Mummified Trophy", ID: 483, Code: "ne6", NormalCode: "ne1", UberCode: "ne6", UltraCode: "neb", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 38, MaxDefense: 48, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 38, RequiredDexterity: 0, Durability: 20, RequiredLevel: 24, MaxSockets: 2, Range: 0, Type: "head"},
+	484: {Name: "Fetish Trophy", ID: 484, Code: "ne7", NormalCode: "ne2", UberCode: "ne7", UltraCode: "neg", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 41, MaxDefense: 52, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 41, RequiredDexterity: 0, Durability: 20, RequiredLevel: 29, MaxSockets: 2, Range: 0, Type: "head"},
+	485: {Name: "Sexton Trophy", ID: 485, Code: "ne8", NormalCode: "ne3", UberCode: "ne8", UltraCode: "ned", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 44, MaxDefense: 55, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 47, RequiredDexterity: 0, Durability: 20, RequiredLevel: 33, MaxSockets: 2, Range: 0, Type: "head"},
+	486: {Name: "Cantor Trophy", ID: 486, Code: "ne9", NormalCode: "ne4", UberCode: "ne9", UltraCode: "nee", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 50, MaxDefense: 64, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 50, RequiredDexterity: 0, Durability: 20, RequiredLevel: 36, MaxSockets: 2, Range: 0, Type: "head"},
+	487: {Name: "Hierophant Trophy", ID: 487, Code: "nea", NormalCode: "ne5", UberCode: "nea", UltraCode: "nef", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 58, MaxDefense: 70, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 58, RequiredDexterity: 0, Durability: 20, RequiredLevel: 40, MaxSockets: 2, Range: 0, Type: "head"},
+	488: {Name: "Blood Spirit", ID: 488, Code: "drb", NormalCode: "dr1", UberCode: "dr6", UltraCode: "drb", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 101, MaxDefense: 145, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 86, RequiredDexterity: 0, Durability: 20, RequiredLevel: 46, MaxSockets: 3, Range: 0, Type: "pelt"},
+	489: {Name: "Sun Spirit", ID: 489, Code: "drc", NormalCode: "dr2", UberCode: "dr7", UltraCode: "drc", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 98, MaxDefense: 147, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 95, RequiredDexterity: 0, Durability: 20, RequiredLevel: 51, MaxSockets: 3, Range: 0, Type: "pelt"},
+	490: {Name: "Earth Spirit", ID: 490, Code: "drd", NormalCode: "dr3", UberCode: "dr8", UltraCode: "drd", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 107, MaxDefense: 152, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 104, RequiredDexterity: 0, Durability: 20, RequiredLevel: 57, MaxSockets: 3, Range: 0, Type: "pelt"},
+	491: {Name: "Sky Spirit", ID: 491, Code: "dre", NormalCode: "dr4", UberCode: "dr9", UltraCode: "dre", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 103, MaxDefense: 155, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 113, RequiredDexterity: 0, Durability: 20, RequiredLevel: 62, MaxSockets: 3, Range: 0, Type: "pelt"},
+	492: {Name: "Dream Spirit", ID: 492, Code: "drf", NormalCode: "dr5", UberCode: "dra", UltraCode: "drf", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 109, MaxDefense: 159, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 118, RequiredDexterity: 0, Durability: 20, RequiredLevel: 66, MaxSockets: 3, Range: 0, Type: "pelt"},
+	493: {Name: "Carnage Helm", ID: 493, Code: "bab", NormalCode: "ba1", UberCode: "ba6", UltraCode: "bab", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 102, MaxDefense: 147, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 106, RequiredDexterity: 0, Durability: 25, RequiredLevel: 45, MaxSockets: 3, Range: 0, Type: "phlm"},
+	494: {Name: "Fury Visor", ID: 494, Code: "bac", NormalCode: "ba2", UberCode: "ba7", UltraCode: "bac", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 105, MaxDefense: 150, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 129, RequiredDexterity: 0, Durability: 35, RequiredLevel: 49, MaxSockets: 3, Range: 0, Type: "phlm"},
+	495: {Name: "Destroyer Helm", ID: 495, Code: "bad", NormalCode: "ba3", UberCode: "ba8", UltraCode: "bad", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 111, MaxDefense: 156, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 151, RequiredDexterity: 0, Durability: 45, RequiredLevel: 54, MaxSockets: 3, Range: 0, Type: "phlm"},
+	496: {Name: "Conqueror Crown", ID: 496, Code: "bae", NormalCode: "ba4", UberCode: "ba9", UltraCode: "bae", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 114, MaxDefense: 159, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 174, RequiredDexterity: 0, Durability: 50, RequiredLevel: 60, MaxSockets: 3, Range: 0, Type: "phlm"},
+	497: {Name: "Guardian Crown", ID: 497, Code: "baf", NormalCode: "ba5", UberCode: "baa", UltraCode: "baf", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 117, MaxDefense: 168, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 196, RequiredDexterity: 0, Durability: 55, RequiredLevel: 65, MaxSockets: 3, Range: 0, Type: "phlm"},
+	498: {Name: "Sacred Targe", ID: 498, Code: "pab", NormalCode: "pa1", UberCode: "pa6", UltraCode: "pab", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 126, MaxDefense: 158, MinDamage: 22, MaxDamage: 70, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 86, RequiredDexterity: 0, Durability: 45, RequiredLevel: 47, MaxSockets: 4, Range: 0, Type: "ashd"},
+	499: {Name: "Sacred Rondache", ID: 499, Code: "pac", NormalCode: "pa2", UberCode: "pa7", UltraCode: "pac", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 138, MaxDefense: 164, MinDamage: 35, MaxDamage: 58, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 109, RequiredDexterity: 0, Durability: 68, RequiredLevel: 52, MaxSockets: 4, Range: 0, Type: "ashd"},
+	500: {Name: "Kurast Shield", ID: 500, Code: "pad", NormalCode: "pa3", UberCode: "pa8", UltraCode: "pad", InventoryWidth: 2, InventoryHeight: 4, MinDefense: 154, MaxDefense: 172, MinDamage: 10, MaxDamage: 82, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 124, RequiredDexterity: 0, Durability: 55, RequiredLevel: 55, MaxSockets: 4, Range: 0, Type: "ashd"},
+	501: {Name: "Zakarum Shield", ID: 501, Code: "pae", NormalCode: "pa4", UberCode: "pa9", UltraCode: "pae", InventoryWidth: 2, InventoryHeight: 4, MinDefense: 169, MaxDefense: 193, MinDamage: 46, MaxDamage: 46, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 142, RequiredDexterity: 0, Durability: 65, RequiredLevel: 61, MaxSockets: 4, Range: 0, Type: "ashd"},
+	502: {Name: "Vortex Shield", ID: 502, Code: "paf", NormalCode: "pa5", UberCode: "paa", UltraCode: "paf", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 182, MaxDefense: 225, MinDamage: 5, MaxDamage: 87, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 100, DexterityBonus: 0, RequiredStrength: 148, RequiredDexterity: 0, Durability: 90, RequiredLevel: 66, MaxSockets: 4, Range: 0, Type: "ashd"},
+	503: {Name: "Minion Skull", ID: 503, Code: "neb", NormalCode: "ne1", UberCode: "ne6", UltraCode: "neb", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 95, MaxDefense: 139, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 77, RequiredDexterity: 0, Durability: 20, RequiredLevel: 44, MaxSockets: 2, Range: 0, Type: "head"},
+	504: {Name: "Hellspawn Skull", ID: 504, Code: "neg", NormalCode: "ne2", UberCode: "ne7", UltraCode: "neg", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 96, MaxDefense: 141, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 82, RequiredDexterity: 0, Durability: 20, RequiredLevel: 50, MaxSockets: 2, Range: 0, Type: "head"},
+	505: {Name: "Overseer Skull", ID: 505, Code: "ned", NormalCode: "ne3", UberCode: "ne8", UltraCode: "ned", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 98, MaxDefense: 142, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 91, RequiredDexterity: 0, Durability: 20, RequiredLevel: 49, MaxSockets: 2, Range: 0, Type: "head"},
+	506: {Name: "Succubus Skull", ID: 506, Code: "nee", NormalCode: "ne4", UberCode: "ne9", UltraCode: "nee", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 100, MaxDefense: 146, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 95, RequiredDexterity: 0, Durability: 20, RequiredLevel: 60, MaxSockets: 2, Range: 0, Type: "head"},
+	507: {Name: "Bloodlord Skull", ID: 507, Code: "nef", NormalCode: "ne5", UberCode: "nea", UltraCode: "nef", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 103, MaxDefense: 148, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 106, RequiredDexterity: 0, Durability: 20, RequiredLevel: 65, MaxSockets: 2, Range: 0, Type: "head"},
 
-	508: {Name: "Elixir", ID: 508, Code: "elx", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 0, MaxSockets: 0, Type: "elix"},
-	509: {Name: "Healing Potion", ID: 509, Code: "hpo", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 0, MaxSockets: 0, Type: "hpot"},
-	510: {Name: "Mana Potion", ID: 510, Code: "mpo", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 0, MaxSockets: 0, Type: "mpot"},
-	511: {Name: "Full Healing Potion", ID: 511, Code: "hpf", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 0, MaxSockets: 0, Type: "hpot"},
-	512: {Name: "Full Mana Potion", ID: 512, Code: "mpf", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 0, MaxSockets: 0, Type: "mpot"},
-	513: {Name: "Stamina Potion", ID: 513, Code: "vps", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 0, MaxSockets: 0, Type: "spot"},
-	514: {Name: "Antidote Potion", ID: 514, Code: "yps", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 0, MaxSockets: 0, Type: "apot"},
-	515: {Name: "Rejuvenation Potion", ID: 515, Code: "rvs", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 0, MaxSockets: 0, Type: "rpot"},
-	516: {Name: "Full Rejuvenation Potion", ID: 516, Code: "rvl", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 0, MaxSockets: 0, Type: "rpot"},
-	517: {Name: "Thawing Potion", ID: 517, Code: "wms", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 0, MaxSockets: 0, Type: "wpot"},
-	518: {Name: "Tome of Town Portal", ID: 518, Code: "tbk", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 2, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 0, MaxSockets: 0, Type: "book"},
-	519: {Name: "Tome of Identify", ID: 519, Code: "ibk", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 2, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 0, MaxSockets: 0, Type: "book"},
-	520: {Name: "Amulet", ID: 520, Code: "amu", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 0, MaxSockets: 1, Type: "amul"},
-	521: {Name: "Amulet of the Viper", ID: 521, Code: "vip", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 0, MaxSockets: 0, Type: "amul"},
-	522: {Name: "Ring", ID: 522, Code: "rin", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 0, MaxSockets: 1, Type: "ring"},
-	523: {Name: "Gold", ID: 523, Code: "gld", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 0, MaxSockets: 0, Type: "gold"},
-	524: {Name: "Scroll of Inifuss", ID: 524, Code: "bks", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 0, MaxSockets: 0, Type: "ques"},
-	525: {Name: "Key to the Cairn Stones", ID: 525, Code: "bkd", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 0, MaxSockets: 0, Type: "ques"},
-	526: {Name: "Arrows", ID: 526, Code: "aqv", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 0, MaxSockets: 0, Type: "bowq"},
-	527: {Name: "Torch", ID: 527, Code: "tch", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 2, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 0, MaxSockets: 0, Type: "torc"},
-	528: {Name: "Bolts", ID: 528, Code: "cqv", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 0, MaxSockets: 0, Type: "xboq"},
-	529: {Name: "Scroll of Town Portal", ID: 529, Code: "tsc", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 0, MaxSockets: 0, Type: "scro"},
-	530: {Name: "Scroll of Identify", ID: 530, Code: "isc", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 0, MaxSockets: 0, Type: "scro"},
-	531: {Name: "Heart", ID: 531, Code: "hrt", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 0, MaxSockets: 0, Type: "body"},
-	532: {Name: "Brain", ID: 532, Code: "brz", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 0, MaxSockets: 0, Type: "body"},
-	533: {Name: "Jawbone", ID: 533, Code: "jaw", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 0, MaxSockets: 0, Type: "body"},
-	534: {Name: "Eye", ID: 534, Code: "eyz", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 0, MaxSockets: 0, Type: "body"},
-	535: {Name: "Horn", ID: 535, Code: "hrn", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 0, MaxSockets: 0, Type: "body"},
-	536: {Name: "Tail", ID: 536, Code: "tal", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 0, MaxSockets: 0, Type: "body"},
-	537: {Name: "Flag", ID: 537, Code: "flg", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 0, MaxSockets: 0, Type: "body"},
-	538: {Name: "Fang", ID: 538, Code: "fng", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 0, MaxSockets: 0, Type: "body"},
-	539: {Name: "Quill", ID: 539, Code: "qll", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 0, MaxSockets: 0, Type: "body"},
-	540: {Name: "Soul", ID: 540, Code: "sol", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 0, MaxSockets: 0, Type: "body"},
-	541: {Name: "Scalp", ID: 541, Code: "scz", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 0, MaxSockets: 0, Type: "body"},
-	542: {Name: "Spleen", ID: 542, Code: "spe", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 0, MaxSockets: 0, Type: "body"},
-	543: {Name: "Key", ID: 543, Code: "key", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 0, MaxSockets: 0, Type: "key"},
-	544: {Name: "The Black Tower Key", ID: 544, Code: "luv", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 2, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 0, MaxSockets: 0, Type: "key"},
-	545: {Name: "Potion of Life", ID: 545, Code: "xyz", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 0, MaxSockets: 0, Type: "ques"},
-	546: {Name: "A Jade Figurine", ID: 546, Code: "j34", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 2, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 0, MaxSockets: 0, Type: "ques"},
-	547: {Name: "The Golden Bird", ID: 547, Code: "g34", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 2, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 0, MaxSockets: 0, Type: "ques"},
-	548: {Name: "Lam Esen's Tome", ID: 548, Code: "bbb", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 0, MaxSockets: 0, Type: "ques"},
-	549: {Name: "Horadric Cube", ID: 549, Code: "box", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 0, MaxSockets: 0, Type: "ques"},
-	550: {Name: "Horadric Scroll", ID: 550, Code: "tr1", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 0, MaxSockets: 0, Type: "ques"},
-	551: {Name: "Mephisto's Soulstone", ID: 551, Code: "mss", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 0, MaxSockets: 0, Type: "ques"},
-	552: {Name: "Book of Skill", ID: 552, Code: "ass", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 0, MaxSockets: 0, Type: "ques"},
-	553: {Name: "Khalim's Eye", ID: 553, Code: "qey", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 0, MaxSockets: 0, Type: "ques"},
-	554: {Name: "Khalim's Heart", ID: 554, Code: "qhr", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 0, MaxSockets: 0, Type: "ques"},
-	555: {Name: "Khalim's Brain", ID: 555, Code: "qbr", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 0, MaxSockets: 0, Type: "ques"},
-	556: {Name: "Ear", ID: 556, Code: "ear", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 0, MaxSockets: 0, Type: "play"},
-	557: {Name: "Chipped Amethyst", ID: 557, Code: "gcv", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 1, MaxSockets: 0, Type: "gema"},
-	558: {Name: "Flawed Amethyst", ID: 558, Code: "gfv", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 5, MaxSockets: 0, Type: "gema"},
-	559: {Name: "Amethyst", ID: 559, Code: "gsv", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 12, MaxSockets: 0, Type: "gema"},
-	560: {Name: "Flawless Amethyst", ID: 560, Code: "gzv", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 15, MaxSockets: 0, Type: "gema"},
-	561: {Name: "Perfect Amethyst", ID: 561, Code: "gpv", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 18, MaxSockets: 0, Type: "gema"},
-	562: {Name: "Chipped Topaz", ID: 562, Code: "gcy", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 1, MaxSockets: 0, Type: "gemt"},
-	563: {Name: "Flawed Topaz", ID: 563, Code: "gfy", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 5, MaxSockets: 0, Type: "gemt"},
-	564: {Name: "Topaz", ID: 564, Code: "gsy", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 12, MaxSockets: 0, Type: "gemt"},
-	565: {Name: "Flawless Topaz", ID: 565, Code: "gly", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 15, MaxSockets: 0, Type: "gemt"},
-	566: {Name: "Perfect Topaz", ID: 566, Code: "gpy", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 18, MaxSockets: 0, Type: "gemt"},
-	567: {Name: "Chipped Sapphire", ID: 567, Code: "gcb", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 1, MaxSockets: 0, Type: "gems"},
-	568: {Name: "Flawed Sapphire", ID: 568, Code: "gfb", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 5, MaxSockets: 0, Type: "gems"},
-	569: {Name: "Sapphire", ID: 569, Code: "gsb", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 12, MaxSockets: 0, Type: "gems"},
-	570: {Name: "Flawless Sapphire", ID: 570, Code: "glb", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 15, MaxSockets: 0, Type: "gems"},
-	571: {Name: "Perfect Sapphire", ID: 571, Code: "gpb", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 18, MaxSockets: 0, Type: "gems"},
-	572: {Name: "Chipped Emerald", ID: 572, Code: "gcg", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 1, MaxSockets: 0, Type: "geme"},
-	573: {Name: "Flawed Emerald", ID: 573, Code: "gfg", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 5, MaxSockets: 0, Type: "geme"},
-	574: {Name: "Emerald", ID: 574, Code: "gsg", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 12, MaxSockets: 0, Type: "geme"},
-	575: {Name: "Flawless Emerald", ID: 575, Code: "glg", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 15, MaxSockets: 0, Type: "geme"},
-	576: {Name: "Perfect Emerald", ID: 576, Code: "gpg", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 18, MaxSockets: 0, Type: "geme"},
-	577: {Name: "Chipped Ruby", ID: 577, Code: "gcr", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 1, MaxSockets: 0, Type: "gemr"},
-	578: {Name: "Flawed Ruby", ID: 578, Code: "gfr", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 5, MaxSockets: 0, Type: "gemr"},
-	579: {Name: "Ruby", ID: 579, Code: "gsr", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 12, MaxSockets: 0, Type: "gemr"},
-	580: {Name: "Flawless Ruby", ID: 580, Code: "glr", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 15, MaxSockets: 0, Type: "gemr"},
-	581: {Name: "Perfect Ruby", ID: 581, Code: "gpr", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 18, MaxSockets: 0, Type: "gemr"},
-	582: {Name: "Chipped Diamond", ID: 582, Code: "gcw", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 1, MaxSockets: 0, Type: "gemd"},
-	583: {Name: "Flawed Diamond", ID: 583, Code: "gfw", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 5, MaxSockets: 0, Type: "gemd"},
-	584: {Name: "Diamond", ID: 584, Code: "gsw", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 12, MaxSockets: 0, Type: "gemd"},
-	585: {Name: "Flawless Diamond", ID: 585, Code: "glw", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 15, MaxSockets: 0, Type: "gemd"},
-	586: {Name: "Perfect Diamond", ID: 586, Code: "gpw", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 18, MaxSockets: 0, Type: "gemd"},
-	587: {Name: "Minor Healing Potion", ID: 587, Code: "hp1", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 0, MaxSockets: 0, Type: "hpot"},
-	588: {Name: "Light Healing Potion", ID: 588, Code: "hp2", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 0, MaxSockets: 0, Type: "hpot"},
-	589: {Name: "Healing Potion", ID: 589, Code: "hp3", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 0, MaxSockets: 0, Type: "hpot"},
-	590: {Name: "Greater Healing Potion", ID: 590, Code: "hp4", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 0, MaxSockets: 0, Type: "hpot"},
-	591: {Name: "Super Healing Potion", ID: 591, Code: "hp5", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 0, MaxSockets: 0, Type: "hpot"},
-	592: {Name: "Minor Mana Potion", ID: 592, Code: "mp1", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 0, MaxSockets: 0, Type: "mpot"},
-	593: {Name: "Light Mana Potion", ID: 593, Code: "mp2", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 0, MaxSockets: 0, Type: "mpot"},
-	594: {Name: "Mana Potion", ID: 594, Code: "mp3", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 0, MaxSockets: 0, Type: "mpot"},
-	595: {Name: "Greater Mana Potion", ID: 595, Code: "mp4", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 0, MaxSockets: 0, Type: "mpot"},
-	596: {Name: "Super Mana Potion", ID: 596, Code: "mp5", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 0, MaxSockets: 0, Type: "mpot"},
-	597: {Name: "Chipped Skull", ID: 597, Code: "skc", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 1, MaxSockets: 0, Type: "gemz"},
-	598: {Name: "Flawed Skull", ID: 598, Code: "skf", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 5, MaxSockets: 0, Type: "gemz"},
-	599: {Name: "Skull", ID: 599, Code: "sku", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 12, MaxSockets: 0, Type: "gemz"},
-	600: {Name: "Flawless Skull", ID: 600, Code: "skl", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 15, MaxSockets: 0, Type: "gemz"},
-	601: {Name: "Perfect Skull", ID: 601, Code: "skz", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 18, MaxSockets: 0, Type: "gemz"},
-	602: {Name: "Herb", ID: 602, Code: "hrb", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 0, MaxSockets: 0, Type: "herb"},
-	603: {Name: "Small Charm", ID: 603, Code: "cm1", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 0, MaxSockets: 0, Type: "scha"},
-	604: {Name: "Large Charm", ID: 604, Code: "cm2", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 2, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 0, MaxSockets: 0, Type: "mcha"},
-	605: {Name: "Grand Charm", ID: 605, Code: "cm3", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 0, MaxSockets: 0, Type: "lcha"},
-	606: {Name: "Small Red Potion", ID: 606, Code: "rps", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 0, MaxSockets: 0, Type: "hpot"},
-	607: {Name: "Large Red Potion", ID: 607, Code: "rpl", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 0, MaxSockets: 0, Type: "hpot"},
-	608: {Name: "Small Blue Potion", ID: 608, Code: "bps", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 0, MaxSockets: 0, Type: "mpot"},
-	609: {Name: "Large Blue Potion", ID: 609, Code: "bpl", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 0, MaxSockets: 0, Type: "mpot"},
-	610: {Name: "El Rune", ID: 610, Code: "r01", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 11, MaxSockets: 0, Type: "rune"},
-	611: {Name: "Eld Rune", ID: 611, Code: "r02", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 11, MaxSockets: 0, Type: "rune"},
-	612: {Name: "Tir Rune", ID: 612, Code: "r03", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 13, MaxSockets: 0, Type: "rune"},
-	613: {Name: "Nef Rune", ID: 613, Code: "r04", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 13, MaxSockets: 0, Type: "rune"},
-	614: {Name: "Eth Rune", ID: 614, Code: "r05", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 15, MaxSockets: 0, Type: "rune"},
-	615: {Name: "Ith Rune", ID: 615, Code: "r06", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 15, MaxSockets: 0, Type: "rune"},
-	616: {Name: "Tal Rune", ID: 616, Code: "r07", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 17, MaxSockets: 0, Type: "rune"},
-	617: {Name: "Ral Rune", ID: 617, Code: "r08", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 19, MaxSockets: 0, Type: "rune"},
-	618: {Name: "Ort Rune", ID: 618, Code: "r09", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 21, MaxSockets: 0, Type: "rune"},
-	619: {Name: "Thul Rune", ID: 619, Code: "r10", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 23, MaxSockets: 0, Type: "rune"},
-	620: {Name: "Amn Rune", ID: 620, Code: "r11", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 25, MaxSockets: 0, Type: "rune"},
-	621: {Name: "Sol Rune", ID: 621, Code: "r12", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 27, MaxSockets: 0, Type: "rune"},
-	622: {Name: "Shael Rune", ID: 622, Code: "r13", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 29, MaxSockets: 0, Type: "rune"},
-	623: {Name: "Dol Rune", ID: 623, Code: "r14", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 31, MaxSockets: 0, Type: "rune"},
-	624: {Name: "Hel Rune", ID: 624, Code: "r15", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 0, MaxSockets: 0, Type: "rune"},
-	625: {Name: "Io Rune", ID: 625, Code: "r16", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 35, MaxSockets: 0, Type: "rune"},
-	626: {Name: "Lum Rune", ID: 626, Code: "r17", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 37, MaxSockets: 0, Type: "rune"},
-	627: {Name: "Ko Rune", ID: 627, Code: "r18", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 39, MaxSockets: 0, Type: "rune"},
-	628: {Name: "Fal Rune", ID: 628, Code: "r19", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 41, MaxSockets: 0, Type: "rune"},
-	629: {Name: "Lem Rune", ID: 629, Code: "r20", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 43, MaxSockets: 0, Type: "rune"},
-	630: {Name: "Pul Rune", ID: 630, Code: "r21", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 45, MaxSockets: 0, Type: "rune"},
-	631: {Name: "Um Rune", ID: 631, Code: "r22", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 47, MaxSockets: 0, Type: "rune"},
-	632: {Name: "Mal Rune", ID: 632, Code: "r23", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 49, MaxSockets: 0, Type: "rune"},
-	633: {Name: "Ist Rune", ID: 633, Code: "r24", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 51, MaxSockets: 0, Type: "rune"},
-	634: {Name: "Gul Rune", ID: 634, Code: "r25", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 53, MaxSockets: 0, Type: "rune"},
-	635: {Name: "Vex Rune", ID: 635, Code: "r26", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 55, MaxSockets: 0, Type: "rune"},
-	636: {Name: "Ohm Rune", ID: 636, Code: "r27", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 57, MaxSockets: 0, Type: "rune"},
-	637: {Name: "Lo Rune", ID: 637, Code: "r28", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 59, MaxSockets: 0, Type: "rune"},
-	638: {Name: "Sur Rune", ID: 638, Code: "r29", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 61, MaxSockets: 0, Type: "rune"},
-	639: {Name: "Ber Rune", ID: 639, Code: "r30", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 63, MaxSockets: 0, Type: "rune"},
-	640: {Name: "Jah Rune", ID: 640, Code: "r31", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 65, MaxSockets: 0, Type: "rune"},
-	641: {Name: "Cham Rune", ID: 641, Code: "r32", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 67, MaxSockets: 0, Type: "rune"},
-	642: {Name: "Zod Rune", ID: 642, Code: "r33", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 69, MaxSockets: 0, Type: "rune"},
-	643: {Name: "Jewel", ID: 643, Code: "jew", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 0, MaxSockets: 0, Type: "jewl"},
-	644: {Name: "Malah's Potion", ID: 644, Code: "ice", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 0, MaxSockets: 0, Type: "ques"},
-	645: {Name: "Scroll of Knowledge", ID: 645, Code: "0sc", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 0, MaxSockets: 0, Type: "scro"},
-	646: {Name: "Scroll of Resistance", ID: 646, Code: "tr2", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 0, MaxSockets: 0, Type: "ques"},
-	647: {Name: "Key of Terror", ID: 647, Code: "pk1", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 2, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 0, MaxSockets: 0, Type: "ques"},
-	648: {Name: "Key of Hate", ID: 648, Code: "pk2", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 2, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 0, MaxSockets: 0, Type: "ques"},
-	649: {Name: "Key of Destruction", ID: 649, Code: "pk3", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 2, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 0, MaxSockets: 0, Type: "ques"},
-	650: {Name: "Diablo's Horn", ID: 650, Code: "dhn", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 0, MaxSockets: 0, Type: "ques"},
-	651: {Name: "Baal's Eye", ID: 651, Code: "bey", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 0, MaxSockets: 0, Type: "ques"},
-	652: {Name: "Mephisto's Brain", ID: 652, Code: "mbr", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 0, MaxSockets: 0, Type: "ques"},
-	653: {Name: "Token of Absolution", ID: 653, Code: "toa", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 0, MaxSockets: 0, Type: "ques"},
-	654: {Name: "Twisted Essence of Suffering", ID: 654, Code: "tes", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 0, MaxSockets: 0, Type: "ques"},
-	655: {Name: "Charged Essence of Hatred", ID: 655, Code: "ceh", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 0, MaxSockets: 0, Type: "ques"},
-	656: {Name: "Burning Essence of Terror", ID: 656, Code: "bet", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 0, MaxSockets: 0, Type: "ques"},
-	657: {Name: "Festering Essence of Destruction", ID: 657, Code: "fed", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 0, MaxSockets: 0, Type: "ques"},
-	658: {Name: "Standard of Heroes", ID: 658, Code: "std", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 90, MaxSockets: 0, Type: "ques"},
+	508: {Name: "Elixir", ID: 508, Code: "elx", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 0, MaxSockets: 0, Range: 0, Type: "elix"},
+	509: {Name: "Healing Potion", ID: 509, Code: "hpo", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 0, MaxSockets: 0, Range: 0, Type: "hpot"},
+	510: {Name: "Mana Potion", ID: 510, Code: "mpo", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 0, MaxSockets: 0, Range: 0, Type: "mpot"},
+	511: {Name: "Full Healing Potion", ID: 511, Code: "hpf", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 0, MaxSockets: 0, Range: 0, Type: "hpot"},
+	512: {Name: "Full Mana Potion", ID: 512, Code: "mpf", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 0, MaxSockets: 0, Range: 0, Type: "mpot"},
+	513: {Name: "Stamina Potion", ID: 513, Code: "vps", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 0, MaxSockets: 0, Range: 0, Type: "spot"},
+	514: {Name: "Antidote Potion", ID: 514, Code: "yps", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 0, MaxSockets: 0, Range: 0, Type: "apot"},
+	515: {Name: "Rejuvenation Potion", ID: 515, Code: "rvs", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 0, MaxSockets: 0, Range: 0, Type: "rpot"},
+	516: {Name: "Full Rejuvenation Potion", ID: 516, Code: "rvl", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 0, MaxSockets: 0, Range: 0, Type: "rpot"},
+	517: {Name: "Thawing Potion", ID: 517, Code: "wms", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 0, MaxSockets: 0, Range: 0, Type: "wpot"},
+	518: {Name: "Tome of Town Portal", ID: 518, Code: "tbk", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 2, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 0, MaxSockets: 0, Range: 0, Type: "book"},
+	519: {Name: "Tome of Identify", ID: 519, Code: "ibk", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 2, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 0, MaxSockets: 0, Range: 0, Type: "book"},
+	520: {Name: "Amulet", ID: 520, Code: "amu", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 0, MaxSockets: 1, Range: 0, Type: "amul"},
+	521: {Name: "Amulet of the Viper", ID: 521, Code: "vip", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 0, MaxSockets: 0, Range: 0, Type: "amul"},
+	522: {Name: "Ring", ID: 522, Code: "rin", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 0, MaxSockets: 1, Range: 0, Type: "ring"},
+	523: {Name: "Gold", ID: 523, Code: "gld", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 0, MaxSockets: 0, Range: 0, Type: "gold"},
+	524: {Name: "Scroll of Inifuss", ID: 524, Code: "bks", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 0, MaxSockets: 0, Range: 0, Type: "ques"},
+	525: {Name: "Key to the Cairn Stones", ID: 525, Code: "bkd", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 0, MaxSockets: 0, Range: 0, Type: "ques"},
+	526: {Name: "Arrows", ID: 526, Code: "aqv", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 0, MaxSockets: 0, Range: 0, Type: "bowq"},
+	527: {Name: "Torch", ID: 527, Code: "tch", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 2, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 0, MaxSockets: 0, Range: 0, Type: "torc"},
+	528: {Name: "Bolts", ID: 528, Code: "cqv", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 0, MaxSockets: 0, Range: 0, Type: "xboq"},
+	529: {Name: "Scroll of Town Portal", ID: 529, Code: "tsc", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 0, MaxSockets: 0, Range: 0, Type: "scro"},
+	530: {Name: "Scroll of Identify", ID: 530, Code: "isc", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 0, MaxSockets: 0, Range: 0, Type: "scro"},
+	531: {Name: "Heart", ID: 531, Code: "hrt", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 0, MaxSockets: 0, Range: 0, Type: "body"},
+	532: {Name: "Brain", ID: 532, Code: "brz", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 0, MaxSockets: 0, Range: 0, Type: "body"},
+	533: {Name: "Jawbone", ID: 533, Code: "jaw", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 0, MaxSockets: 0, Range: 0, Type: "body"},
+	534: {Name: "Eye", ID: 534, Code: "eyz", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 0, MaxSockets: 0, Range: 0, Type: "body"},
+	535: {Name: "Horn", ID: 535, Code: "hrn", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 0, MaxSockets: 0, Range: 0, Type: "body"},
+	536: {Name: "Tail", ID: 536, Code: "tal", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 0, MaxSockets: 0, Range: 0, Type: "body"},
+	537: {Name: "Flag", ID: 537, Code: "flg", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 0, MaxSockets: 0, Range: 0, Type: "body"},
+	538: {Name: "Fang", ID: 538, Code: "fng", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 0, MaxSockets: 0, Range: 0, Type: "body"},
+	539: {Name: "Quill", ID: 539, Code: "qll", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 0, MaxSockets: 0, Range: 0, Type: "body"},
+	540: {Name: "Soul", ID: 540, Code: "sol", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 0, MaxSockets: 0, Range: 0, Type: "body"},
+	541: {Name: "Scalp", ID: 541, Code: "scz", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 0, MaxSockets: 0, Range: 0, Type: "body"},
+	542: {Name: "Spleen", ID: 542, Code: "spe", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 0, MaxSockets: 0, Range: 0, Type: "body"},
+	543: {Name: "Key", ID: 543, Code: "key", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 0, MaxSockets: 0, Range: 0, Type: "key"},
+	544: {Name: "The Black Tower Key", ID: 544, Code: "luv", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 2, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 0, MaxSockets: 0, Range: 0, Type: "key"},
+	545: {Name: "Potion of Life", ID: 545, Code: "xyz", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 0, MaxSockets: 0, Range: 0, Type: "ques"},
+	546: {Name: "A Jade Figurine", ID: 546, Code: "j34", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 2, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 0, MaxSockets: 0, Range: 0, Type: "ques"},
+	547: {Name: "The Golden Bird", ID: 547, Code: "g34", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 2, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 0, MaxSockets: 0, Range: 0, Type: "ques"},
+	548: {Name: "Lam Esen's Tome", ID: 548, Code: "bbb", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 0, MaxSockets: 0, Range: 0, Type: "ques"},
+	549: {Name: "Horadric Cube", ID: 549, Code: "box", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 0, MaxSockets: 0, Range: 0, Type: "ques"},
+	550: {Name: "Horadric Scroll", ID: 550, Code: "tr1", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 0, MaxSockets: 0, Range: 0, Type: "ques"},
+	551: {Name: "Mephisto's Soulstone", ID: 551, Code: "mss", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 0, MaxSockets: 0, Range: 0, Type: "ques"},
+	552: {Name: "Book of Skill", ID: 552, Code: "ass", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 0, MaxSockets: 0, Range: 0, Type: "ques"},
+	553: {Name: "Khalim's Eye", ID: 553, Code: "qey", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 0, MaxSockets: 0, Range: 0, Type: "ques"},
+	554: {Name: "Khalim's Heart", ID: 554, Code: "qhr", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 0, MaxSockets: 0, Range: 0, Type: "ques"},
+	555: {Name: "Khalim's Brain", ID: 555, Code: "qbr", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 0, MaxSockets: 0, Range: 0, Type: "ques"},
+	556: {Name: "Ear", ID: 556, Code: "ear", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 0, MaxSockets: 0, Range: 0, Type: "play"},
+	557: {Name: "Chipped Amethyst", ID: 557, Code: "gcv", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 1, MaxSockets: 0, Range: 0, Type: "gema"},
+	558: {Name: "Flawed Amethyst", ID: 558, Code: "gfv", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 5, MaxSockets: 0, Range: 0, Type: "gema"},
+	559: {Name: "Amethyst", ID: 559, Code: "gsv", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 12, MaxSockets: 0, Range: 0, Type: "gema"},
+	560: {Name: "Flawless Amethyst", ID: 560, Code: "gzv", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 15, MaxSockets: 0, Range: 0, Type: "gema"},
+	561: {Name: "Perfect Amethyst", ID: 561, Code: "gpv", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 18, MaxSockets: 0, Range: 0, Type: "gema"},
+	562: {Name: "Chipped Topaz", ID: 562, Code: "gcy", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 1, MaxSockets: 0, Range: 0, Type: "gemt"},
+	563: {Name: "Flawed Topaz", ID: 563, Code: "gfy", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 5, MaxSockets: 0, Range: 0, Type: "gemt"},
+	564: {Name: "Topaz", ID: 564, Code: "gsy", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 12, MaxSockets: 0, Range: 0, Type: "gemt"},
+	565: {Name: "Flawless Topaz", ID: 565, Code: "gly", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 15, MaxSockets: 0, Range: 0, Type: "gemt"},
+	566: {Name: "Perfect Topaz", ID: 566, Code: "gpy", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 18, MaxSockets: 0, Range: 0, Type: "gemt"},
+	567: {Name: "Chipped Sapphire", ID: 567, Code: "gcb", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 1, MaxSockets: 0, Range: 0, Type: "gems"},
+	568: {Name: "Flawed Sapphire", ID: 568, Code: "gfb", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 5, MaxSockets: 0, Range: 0, Type: "gems"},
+	569: {Name: "Sapphire", ID: 569, Code: "gsb", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 12, MaxSockets: 0, Range: 0, Type: "gems"},
+	570: {Name: "Flawless Sapphire", ID: 570, Code: "glb", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 15, MaxSockets: 0, Range: 0, Type: "gems"},
+	571: {Name: "Perfect Sapphire", ID: 571, Code: "gpb", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 18, MaxSockets: 0, Range: 0, Type: "gems"},
+	572: {Name: "Chipped Emerald", ID: 572, Code: "gcg", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 1, MaxSockets: 0, Range: 0, Type: "geme"},
+	573: {Name: "Flawed Emerald", ID: 573, Code: "gfg", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 5, MaxSockets: 0, Range: 0, Type: "geme"},
+	574: {Name: "Emerald", ID: 574, Code: "gsg", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 12, MaxSockets: 0, Range: 0, Type: "geme"},
+	575: {Name: "Flawless Emerald", ID: 575, Code: "glg", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 15, MaxSockets: 0, Range: 0, Type: "geme"},
+	576: {Name: "Perfect Emerald", ID: 576, Code: "gpg", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 18, MaxSockets: 0, Range: 0, Type: "geme"},
+	577: {Name: "Chipped Ruby", ID: 577, Code: "gcr", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 1, MaxSockets: 0, Range: 0, Type: "gemr"},
+	578: {Name: "Flawed Ruby", ID: 578, Code: "gfr", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 5, MaxSockets: 0, Range: 0, Type: "gemr"},
+	579: {Name: "Ruby", ID: 579, Code: "gsr", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 12, MaxSockets: 0, Range: 0, Type: "gemr"},
+	580: {Name: "Flawless Ruby", ID: 580, Code: "glr", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 15, MaxSockets: 0, Range: 0, Type: "gemr"},
+	581: {Name: "Perfect Ruby", ID: 581, Code: "gpr", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 18, MaxSockets: 0, Range: 0, Type: "gemr"},
+	582: {Name: "Chipped Diamond", ID: 582, Code: "gcw", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 1, MaxSockets: 0, Range: 0, Type: "gemd"},
+	583: {Name: "Flawed Diamond", ID: 583, Code: "gfw", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 5, MaxSockets: 0, Range: 0, Type: "gemd"},
+	584: {Name: "Diamond", ID: 584, Code: "gsw", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 12, MaxSockets: 0, Range: 0, Type: "gemd"},
+	585: {Name: "Flawless Diamond", ID: 585, Code: "glw", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 15, MaxSockets: 0, Range: 0, Type: "gemd"},
+	586: {Name: "Perfect Diamond", ID: 586, Code: "gpw", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 18, MaxSockets: 0, Range: 0, Type: "gemd"},
+	587: {Name: "Minor Healing Potion", ID: 587, Code: "hp1", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 0, MaxSockets: 0, Range: 0, Type: "hpot"},
+	588: {Name: "Light Healing Potion", ID: 588, Code: "hp2", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 0, MaxSockets: 0, Range: 0, Type: "hpot"},
+	589: {Name: "Healing Potion", ID: 589, Code: "hp3", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 0, MaxSockets: 0, Range: 0, Type: "hpot"},
+	590: {Name: "Greater Healing Potion", ID: 590, Code: "hp4", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 0, MaxSockets: 0, Range: 0, Type: "hpot"},
+	591: {Name: "Super Healing Potion", ID: 591, Code: "hp5", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 0, MaxSockets: 0, Range: 0, Type: "hpot"},
+	592: {Name: "Minor Mana Potion", ID: 592, Code: "mp1", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 0, MaxSockets: 0, Range: 0, Type: "mpot"},
+	593: {Name: "Light Mana Potion", ID: 593, Code: "mp2", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 0, MaxSockets: 0, Range: 0, Type: "mpot"},
+	594: {Name: "Mana Potion", ID: 594, Code: "mp3", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 0, MaxSockets: 0, Range: 0, Type: "mpot"},
+	595: {Name: "Greater Mana Potion", ID: 595, Code: "mp4", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 0, MaxSockets: 0, Range: 0, Type: "mpot"},
+	596: {Name: "Super Mana Potion", ID: 596, Code: "mp5", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 0, MaxSockets: 0, Range: 0, Type: "mpot"},
+	597: {Name: "Chipped Skull", ID: 597, Code: "skc", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 1, MaxSockets: 0, Range: 0, Type: "gemz"},
+	598: {Name: "Flawed Skull", ID: 598, Code: "skf", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 5, MaxSockets: 0, Range: 0, Type: "gemz"},
+	599: {Name: "Skull", ID: 599, Code: "sku", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 12, MaxSockets: 0, Range: 0, Type: "gemz"},
+	600: {Name: "Flawless Skull", ID: 600, Code: "skl", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 15, MaxSockets: 0, Range: 0, Type: "gemz"},
+	601: {Name: "Perfect Skull", ID: 601, Code: "skz", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 18, MaxSockets: 0, Range: 0, Type: "gemz"},
+	602: {Name: "Herb", ID: 602, Code: "hrb", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 0, MaxSockets: 0, Range: 0, Type: "herb"},
+	603: {Name: "Small Charm", ID: 603, Code: "cm1", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 0, MaxSockets: 0, Range: 0, Type: "scha"},
+	604: {Name: "Large Charm", ID: 604, Code: "cm2", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 2, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 0, MaxSockets: 0, Range: 0, Type: "mcha"},
+	605: {Name: "Grand Charm", ID: 605, Code: "cm3", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 3, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 0, MaxSockets: 0, Range: 0, Type: "lcha"},
+	606: {Name: "Small Red Potion", ID: 606, Code: "rps", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 0, MaxSockets: 0, Range: 0, Type: "hpot"},
+	607: {Name: "Large Red Potion", ID: 607, Code: "rpl", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 0, MaxSockets: 0, Range: 0, Type: "hpot"},
+	608: {Name: "Small Blue Potion", ID: 608, Code: "bps", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 0, MaxSockets: 0, Range: 0, Type: "mpot"},
+	609: {Name: "Large Blue Potion", ID: 609, Code: "bpl", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 0, MaxSockets: 0, Range: 0, Type: "mpot"},
+	610: {Name: "El Rune", ID: 610, Code: "r01", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 11, MaxSockets: 0, Range: 0, Type: "rune"},
+	611: {Name: "Eld Rune", ID: 611, Code: "r02", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 11, MaxSockets: 0, Range: 0, Type: "rune"},
+	612: {Name: "Tir Rune", ID: 612, Code: "r03", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 13, MaxSockets: 0, Range: 0, Type: "rune"},
+	613: {Name: "Nef Rune", ID: 613, Code: "r04", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 13, MaxSockets: 0, Range: 0, Type: "rune"},
+	614: {Name: "Eth Rune", ID: 614, Code: "r05", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 15, MaxSockets: 0, Range: 0, Type: "rune"},
+	615: {Name: "Ith Rune", ID: 615, Code: "r06", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 15, MaxSockets: 0, Range: 0, Type: "rune"},
+	616: {Name: "Tal Rune", ID: 616, Code: "r07", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 17, MaxSockets: 0, Range: 0, Type: "rune"},
+	617: {Name: "Ral Rune", ID: 617, Code: "r08", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 19, MaxSockets: 0, Range: 0, Type: "rune"},
+	618: {Name: "Ort Rune", ID: 618, Code: "r09", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 21, MaxSockets: 0, Range: 0, Type: "rune"},
+	619: {Name: "Thul Rune", ID: 619, Code: "r10", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 23, MaxSockets: 0, Range: 0, Type: "rune"},
+	620: {Name: "Amn Rune", ID: 620, Code: "r11", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 25, MaxSockets: 0, Range: 0, Type: "rune"},
+	621: {Name: "Sol Rune", ID: 621, Code: "r12", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 27, MaxSockets: 0, Range: 0, Type: "rune"},
+	622: {Name: "Shael Rune", ID: 622, Code: "r13", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 29, MaxSockets: 0, Range: 0, Type: "rune"},
+	623: {Name: "Dol Rune", ID: 623, Code: "r14", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 31, MaxSockets: 0, Range: 0, Type: "rune"},
+	624: {Name: "Hel Rune", ID: 624, Code: "r15", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 0, MaxSockets: 0, Range: 0, Type: "rune"},
+	625: {Name: "Io Rune", ID: 625, Code: "r16", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 35, MaxSockets: 0, Range: 0, Type: "rune"},
+	626: {Name: "Lum Rune", ID: 626, Code: "r17", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 37, MaxSockets: 0, Range: 0, Type: "rune"},
+	627: {Name: "Ko Rune", ID: 627, Code: "r18", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 39, MaxSockets: 0, Range: 0, Type: "rune"},
+	628: {Name: "Fal Rune", ID: 628, Code: "r19", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 41, MaxSockets: 0, Range: 0, Type: "rune"},
+	629: {Name: "Lem Rune", ID: 629, Code: "r20", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 43, MaxSockets: 0, Range: 0, Type: "rune"},
+	630: {Name: "Pul Rune", ID: 630, Code: "r21", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 45, MaxSockets: 0, Range: 0, Type: "rune"},
+	631: {Name: "Um Rune", ID: 631, Code: "r22", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 47, MaxSockets: 0, Range: 0, Type: "rune"},
+	632: {Name: "Mal Rune", ID: 632, Code: "r23", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 49, MaxSockets: 0, Range: 0, Type: "rune"},
+	633: {Name: "Ist Rune", ID: 633, Code: "r24", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 51, MaxSockets: 0, Range: 0, Type: "rune"},
+	634: {Name: "Gul Rune", ID: 634, Code: "r25", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 53, MaxSockets: 0, Range: 0, Type: "rune"},
+	635: {Name: "Vex Rune", ID: 635, Code: "r26", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 55, MaxSockets: 0, Range: 0, Type: "rune"},
+	636: {Name: "Ohm Rune", ID: 636, Code: "r27", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 57, MaxSockets: 0, Range: 0, Type: "rune"},
+	637: {Name: "Lo Rune", ID: 637, Code: "r28", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 59, MaxSockets: 0, Range: 0, Type: "rune"},
+	638: {Name: "Sur Rune", ID: 638, Code: "r29", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 61, MaxSockets: 0, Range: 0, Type: "rune"},
+	639: {Name: "Ber Rune", ID: 639, Code: "r30", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 63, MaxSockets: 0, Range: 0, Type: "rune"},
+	640: {Name: "Jah Rune", ID: 640, Code: "r31", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 65, MaxSockets: 0, Range: 0, Type: "rune"},
+	641: {Name: "Cham Rune", ID: 641, Code: "r32", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 67, MaxSockets: 0, Range: 0, Type: "rune"},
+	642: {Name: "Zod Rune", ID: 642, Code: "r33", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 69, MaxSockets: 0, Range: 0, Type: "rune"},
+	643: {Name: "Jewel", ID: 643, Code: "jew", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 0, MaxSockets: 0, Range: 0, Type: "jewl"},
+	644: {Name: "Malah's Potion", ID: 644, Code: "ice", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 0, MaxSockets: 0, Range: 0, Type: "ques"},
+	645: {Name: "Scroll of Knowledge", ID: 645, Code: "0sc", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 0, MaxSockets: 0, Range: 0, Type: "scro"},
+	646: {Name: "Scroll of Resistance", ID: 646, Code: "tr2", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 2, InventoryHeight: 2, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 0, MaxSockets: 0, Range: 0, Type: "ques"},
+	647: {Name: "Key of Terror", ID: 647, Code: "pk1", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 2, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 0, MaxSockets: 0, Range: 0, Type: "ques"},
+	648: {Name: "Key of Hate", ID: 648, Code: "pk2", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 2, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 0, MaxSockets: 0, Range: 0, Type: "ques"},
+	649: {Name: "Key of Destruction", ID: 649, Code: "pk3", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 2, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 0, MaxSockets: 0, Range: 0, Type: "ques"},
+	650: {Name: "Diablo's Horn", ID: 650, Code: "dhn", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 0, MaxSockets: 0, Range: 0, Type: "ques"},
+	651: {Name: "Baal's Eye", ID: 651, Code: "bey", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 0, MaxSockets: 0, Range: 0, Type: "ques"},
+	652: {Name: "Mephisto's Brain", ID: 652, Code: "mbr", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 0, MaxSockets: 0, Range: 0, Type: "ques"},
+	653: {Name: "Token of Absolution", ID: 653, Code: "toa", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 0, MaxSockets: 0, Range: 0, Type: "ques"},
+	654: {Name: "Twisted Essence of Suffering", ID: 654, Code: "tes", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 0, MaxSockets: 0, Range: 0, Type: "ques"},
+	655: {Name: "Charged Essence of Hatred", ID: 655, Code: "ceh", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 0, MaxSockets: 0, Range: 0, Type: "ques"},
+	656: {Name: "Burning Essence of Terror", ID: 656, Code: "bet", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 0, MaxSockets: 0, Range: 0, Type: "ques"},
+	657: {Name: "Festering Essence of Destruction", ID: 657, Code: "fed", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 0, MaxSockets: 0, Range: 0, Type: "ques"},
+	658: {Name: "Standard of Heroes", ID: 658, Code: "std", NormalCode: "", UberCode: "", UltraCode: "", InventoryWidth: 1, InventoryHeight: 1, MinDefense: 0, MaxDefense: 0, MinDamage: 0, MaxDamage: 0, TwoHandMinDamage: 0, TwoHandMaxDamage: 0, MinMissileDamage: 0, MaxMissileDamage: 0, Speed: 0, StrengthBonus: 0, DexterityBonus: 0, RequiredStrength: 0, RequiredDexterity: 0, Durability: 0, RequiredLevel: 90, MaxSockets: 0, Range: 0, Type: "ques"},
 }
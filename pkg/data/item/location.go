@@ -22,6 +22,7 @@ const (
 	LocationCursor      LocationType = "cursor"
 	LocationEquipped    LocationType = "equipped"
 	LocationMercenary   LocationType = "mercenary"
+	LocationTrade       LocationType = "trade" // Item slot of the anvil screen, only while it is open
 
 	// Body locations
 	LocNone              LocationType = "none"
@@ -38,3 +39,67 @@ const (
 	LocLeftArmSecondary  LocationType = "left_arm_secondary"
 	LocRightArmSecondary LocationType = "right_arm_secondary"
 )
+
+// BodyLocation is an equipment slot, values are the slot ids used by the game (memory and save files)
+type BodyLocation uint8
+
+const (
+	BodyNone BodyLocation = iota
+	BodyHead
+	BodyNeck
+	BodyTorso
+	BodyLeftArm
+	BodyRightArm
+	BodyLeftRing
+	BodyRightRing
+	BodyBelt
+	BodyFeet
+	BodyGloves
+	BodyLeftArmSecondary
+	BodyRightArmSecondary
+)
+
+// BodyLocations are all the equipment slots, sorted by slot id
+var BodyLocations = []BodyLocation{
+	BodyHead, BodyNeck, BodyTorso, BodyLeftArm, BodyRightArm, BodyLeftRing, BodyRightRing, BodyBelt, BodyFeet,
+	BodyGloves, BodyLeftArmSecondary, BodyRightArmSecondary,
+}
+
+var bodyLocationTypes = map[BodyLocation]LocationType{
+	BodyHead:              LocHead,
+	BodyNeck:              LocNeck,
+	BodyTorso:             LocTorso,
+	BodyLeftArm:           LocLeftArm,
+	BodyRightArm:          LocRightArm,
+	BodyLeftRing:          LocLeftRing,
+	BodyRightRing:         LocRightRing,
+	BodyBelt:              LocBelt,
+	BodyFeet:              LocFeet,
+	BodyGloves:            LocGloves,
+	BodyLeftArmSecondary:  LocLeftArmSecondary,
+	BodyRightArmSecondary: LocRightArmSecondary,
+}
+
+// LocationType returns the body location as stored in Location.BodyLocation, LocNone for unknown slots
+func (b BodyLocation) LocationType() LocationType {
+	if l, found := bodyLocationTypes[b]; found {
+		return l
+	}
+
+	return LocNone
+}
+
+func (b BodyLocation) String() string {
+	return string(b.LocationType())
+}
+
+// Slot returns the equipment slot of the item, BodyNone if it's not equipped
+func (l Location) Slot() BodyLocation {
+	for b, t := range bodyLocationTypes {
+		if t == l.BodyLocation {
+			return b
+		}
+	}
+
+	return BodyNone
+}
@@ -0,0 +1,192 @@
+package item
+
+// Runeword describes the recipe of a runeword: runes in socketing order and the allowed base types
+type Runeword struct {
+	Name         RunewordName
+	Runes        []Name
+	ItemTypes    []string // Base types allowed, parent types included (e.g. "weap" allows any weapon)
+	ExcludeTypes []string
+}
+
+var Runewords = []Runeword{
+	{Name: RunewordAncientsPledge, Runes: []Name{"RalRune", "OrtRune", "TalRune"}, ItemTypes: []string{"shld"}},
+	{Name: RunewordBeast, Runes: []Name{"BerRune", "TirRune", "UmRune", "MalRune", "LumRune"}, ItemTypes: []string{"axe", "scep", "hamm"}},
+	{Name: RunewordBlack, Runes: []Name{"ThulRune", "IoRune", "NefRune"}, ItemTypes: []string{"club", "hamm", "mace"}},
+	{Name: RunewordBone, Runes: []Name{"SolRune", "UmRune", "UmRune"}, ItemTypes: []string{"tors"}},
+	{Name: RunewordBramble, Runes: []Name{"RalRune", "OhmRune", "SolRune", "EldRune"}, ItemTypes: []string{"tors"}},
+	{Name: RunewordBrand, Runes: []Name{"JahRune", "LoRune", "MalRune", "GulRune"}, ItemTypes: []string{"miss"}},
+	{Name: RunewordBreathOfTheDying, Runes: []Name{"VexRune", "HelRune", "ElRune", "EldRune", "ZodRune", "EthRune"}, ItemTypes: []string{"weap"}},
+	{Name: RunewordCallToArms, Runes: []Name{"AmnRune", "RalRune", "MalRune", "IstRune", "OhmRune"}, ItemTypes: []string{"weap"}},
+	{Name: RunewordChainsOfHonor, Runes: []Name{"DolRune", "UmRune", "BerRune", "IstRune"}, ItemTypes: []string{"tors"}},
+	{Name: RunewordChaos, Runes: []Name{"FalRune", "OhmRune", "UmRune"}, ItemTypes: []string{"h2h"}},
+	{Name: RunewordCrescentMoon, Runes: []Name{"ShaelRune", "UmRune", "TirRune"}, ItemTypes: []string{"axe", "swor", "pole"}},
+	{Name: RunewordDeath, Runes: []Name{"HelRune", "ElRune", "VexRune", "OrtRune", "GulRune"}, ItemTypes: []string{"swor", "axe"}},
+	{Name: RunewordDelerium, Runes: []Name{"LemRune", "IstRune", "IoRune"}, ItemTypes: []string{"helm"}},
+	{Name: RunewordDestruction, Runes: []Name{"VexRune", "LoRune", "BerRune", "JahRune", "KoRune"}, ItemTypes: []string{"pole", "swor"}},
+	{Name: RunewordDoom, Runes: []Name{"HelRune", "OhmRune", "UmRune", "LoRune", "ChamRune"}, ItemTypes: []string{"axe", "pole", "hamm"}},
+	{Name: RunewordDragon, Runes: []Name{"SurRune", "LoRune", "SolRune"}, ItemTypes: []string{"tors", "shld"}},
+	{Name: RunewordDream, Runes: []Name{"IoRune", "JahRune", "PulRune"}, ItemTypes: []string{"helm", "shld"}},
+	{Name: RunewordDuress, Runes: []Name{"ShaelRune", "UmRune", "ThulRune"}, ItemTypes: []string{"tors"}},
+	{Name: RunewordEdge, Runes: []Name{"TirRune", "TalRune", "AmnRune"}, ItemTypes: []string{"miss"}},
+	{Name: RunewordEnigma, Runes: []Name{"JahRune", "IthRune", "BerRune"}, ItemTypes: []string{"tors"}},
+	{Name: RunewordEnlightenment, Runes: []Name{"PulRune", "RalRune", "SolRune"}, ItemTypes: []string{"tors"}},
+	{Name: RunewordEternity, Runes: []Name{"AmnRune", "BerRune", "IstRune", "SolRune", "SurRune"}, ItemTypes: []string{"mele"}},
+	{Name: RunewordExile, Runes: []Name{"VexRune", "OhmRune", "IstRune", "DolRune"}, ItemTypes: []string{"ashd"}},
+	{Name: RunewordFaith, Runes: []Name{"OhmRune", "JahRune", "LemRune", "EldRune"}, ItemTypes: []string{"miss"}},
+	{Name: RunewordFamine, Runes: []Name{"FalRune", "OhmRune", "OrtRune", "JahRune"}, ItemTypes: []string{"axe", "hamm"}},
+	{Name: RunewordFlickeringFlame, Runes: []Name{"NefRune", "PulRune", "VexRune"}, ItemTypes: []string{"helm"}},
+	{Name: RunewordFortitude, Runes: []Name{"ElRune", "SolRune", "DolRune", "LoRune"}, ItemTypes: []string{"weap", "tors"}},
+	{Name: RunewordFury, Runes: []Name{"JahRune", "GulRune", "EthRune"}, ItemTypes: []string{"mele"}},
+	{Name: RunewordGloom, Runes: []Name{"FalRune", "UmRune", "PulRune"}, ItemTypes: []string{"tors"}},
+	{Name: RunewordGrief, Runes: []Name{"EthRune", "TirRune", "LoRune", "MalRune", "RalRune"}, ItemTypes: []string{"swor", "axe"}},
+	{Name: RunewordHandOfJustice, Runes: []Name{"SurRune", "ChamRune", "AmnRune", "LoRune"}, ItemTypes: []string{"weap"}},
+	{Name: RunewordHarmony, Runes: []Name{"TirRune", "IthRune", "SolRune", "KoRune"}, ItemTypes: []string{"miss"}},
+	{Name: RunewordHeartOfTheOak, Runes: []Name{"KoRune", "VexRune", "PulRune", "ThulRune"}, ItemTypes: []string{"mace", "staf"}},
+	{Name: RunewordHolyThunder, Runes: []Name{"EthRune", "RalRune", "OrtRune", "TalRune"}, ItemTypes: []string{"scep"}},
+	{Name: RunewordHonor, Runes: []Name{"AmnRune", "ElRune", "IthRune", "TirRune", "SolRune"}, ItemTypes: []string{"mele"}},
+	{Name: RunewordIce, Runes: []Name{"AmnRune", "ShaelRune", "JahRune", "LoRune"}, ItemTypes: []string{"miss"}},
+	{Name: RunewordInfinity, Runes: []Name{"BerRune", "MalRune", "BerRune", "IstRune"}, ItemTypes: []string{"pole", "spea"}},
+	{Name: RunewordInsight, Runes: []Name{"RalRune", "TirRune", "TalRune", "SolRune"}, ItemTypes: []string{"pole", "staf", "miss"}},
+	{Name: RunewordKingsGrace, Runes: []Name{"AmnRune", "RalRune", "ThulRune"}, ItemTypes: []string{"swor", "scep"}},
+	{Name: RunewordKingslayer, Runes: []Name{"MalRune", "UmRune", "GulRune", "FalRune"}, ItemTypes: []string{"swor", "axe"}},
+	{Name: RunewordLastWish, Runes: []Name{"JahRune", "MalRune", "JahRune", "SurRune", "JahRune", "BerRune"}, ItemTypes: []string{"swor", "hamm", "axe"}},
+	{Name: RunewordLawbringer, Runes: []Name{"AmnRune", "LemRune", "KoRune"}, ItemTypes: []string{"swor", "hamm", "scep"}},
+	{Name: RunewordLeaf, Runes: []Name{"TirRune", "RalRune"}, ItemTypes: []string{"staf"}},
+	{Name: RunewordLionheart, Runes: []Name{"HelRune", "LumRune", "FalRune"}, ItemTypes: []string{"tors"}},
+	{Name: RunewordLore, Runes: []Name{"OrtRune", "SolRune"}, ItemTypes: []string{"helm"}},
+	{Name: RunewordMalice, Runes: []Name{"IthRune", "ElRune", "EthRune"}, ItemTypes: []string{"mele"}},
+	{Name: RunewordMelody, Runes: []Name{"ShaelRune", "KoRune", "NefRune"}, ItemTypes: []string{"miss"}},
+	{Name: RunewordMemory, Runes: []Name{"LumRune", "IoRune", "SolRune", "EthRune"}, ItemTypes: []string{"staf"}},
+	{Name: RunewordMist, Runes: []Name{"ChamRune", "ShaelRune", "GulRune", "ThulRune", "IthRune"}, ItemTypes: []string{"miss"}},
+	{Name: RunewordMyth, Runes: []Name{"HelRune", "AmnRune", "NefRune"}, ItemTypes: []string{"tors"}},
+	{Name: RunewordNadir, Runes: []Name{"NefRune", "TirRune"}, ItemTypes: []string{"helm"}},
+	{Name: RunewordOath, Runes: []Name{"ShaelRune", "PulRune", "MalRune", "LumRune"}, ItemTypes: []string{"swor", "axe", "mace"}},
+	{Name: RunewordObedience, Runes: []Name{"HelRune", "KoRune", "ThulRune", "EthRune", "FalRune"}, ItemTypes: []string{"pole", "spea"}},
+	{Name: RunewordObsession, Runes: []Name{"ZodRune", "IstRune", "LemRune", "LumRune", "IoRune", "NefRune"}, ItemTypes: []string{"staf"}},
+	{Name: RunewordPassion, Runes: []Name{"DolRune", "OrtRune", "EldRune", "LemRune"}, ItemTypes: []string{"weap"}},
+	{Name: RunewordPattern, Runes: []Name{"TalRune", "OrtRune", "ThulRune"}, ItemTypes: []string{"h2h"}},
+	{Name: RunewordPeace, Runes: []Name{"ShaelRune", "ThulRune", "AmnRune"}, ItemTypes: []string{"tors"}},
+	{Name: RunewordPhoenix, Runes: []Name{"VexRune", "VexRune", "LoRune", "JahRune"}, ItemTypes: []string{"weap", "shld"}},
+	{Name: RunewordPlague, Runes: []Name{"ChamRune", "ShaelRune", "UmRune"}, ItemTypes: []string{"swor", "h2h", "knif"}},
+	{Name: RunewordPride, Runes: []Name{"ChamRune", "SurRune", "IoRune", "LoRune"}, ItemTypes: []string{"pole", "spea"}},
+	{Name: RunewordPrinciple, Runes: []Name{"RalRune", "GulRune", "EldRune"}, ItemTypes: []string{"tors"}},
+	{Name: RunewordPrudence, Runes: []Name{"MalRune", "TirRune"}, ItemTypes: []string{"tors"}},
+	{Name: RunewordRadiance, Runes: []Name{"NefRune", "SolRune", "IthRune"}, ItemTypes: []string{"helm"}},
+	{Name: RunewordRain, Runes: []Name{"OrtRune", "MalRune", "IthRune"}, ItemTypes: []string{"tors"}},
+	{Name: RunewordRhyme, Runes: []Name{"ShaelRune", "EthRune"}, ItemTypes: []string{"shld"}},
+	{Name: RunewordRift, Runes: []Name{"HelRune", "KoRune", "LemRune", "GulRune"}, ItemTypes: []string{"pole", "scep"}},
+	{Name: RunewordSanctuary, Runes: []Name{"KoRune", "KoRune", "MalRune"}, ItemTypes: []string{"shld"}},
+	{Name: RunewordSilence, Runes: []Name{"DolRune", "EldRune", "HelRune", "IstRune", "TirRune", "VexRune"}, ItemTypes: []string{"weap"}},
+	{Name: RunewordSmoke, Runes: []Name{"NefRune", "LumRune"}, ItemTypes: []string{"tors"}},
+	{Name: RunewordSpirit, Runes: []Name{"TalRune", "ThulRune", "OrtRune", "AmnRune"}, ItemTypes: []string{"swor", "shld"}},
+	{Name: RunewordSplendor, Runes: []Name{"EthRune", "LumRune"}, ItemTypes: []string{"shld"}},
+	{Name: RunewordStealth, Runes: []Name{"TalRune", "EthRune"}, ItemTypes: []string{"tors"}},
+	{Name: RunewordSteel, Runes: []Name{"TirRune", "ElRune"}, ItemTypes: []string{"swor", "axe", "mace"}},
+	{Name: RunewordStone, Runes: []Name{"ShaelRune", "UmRune", "PulRune", "LumRune"}, ItemTypes: []string{"tors"}},
+	{Name: RunewordStrength, Runes: []Name{"AmnRune", "TirRune"}, ItemTypes: []string{"mele"}},
+	{Name: RunewordTreachery, Runes: []Name{"ShaelRune", "ThulRune", "LemRune"}, ItemTypes: []string{"tors"}},
+	{Name: RunewordUnbendingWill, Runes: []Name{"FalRune", "IoRune", "IthRune", "EldRune", "ElRune", "HelRune"}, ItemTypes: []string{"swor"}},
+	{Name: RunewordVenom, Runes: []Name{"TalRune", "DolRune", "MalRune"}, ItemTypes: []string{"weap"}},
+	{Name: RunewordVoiceOfReason, Runes: []Name{"LemRune", "KoRune", "ElRune", "EldRune"}, ItemTypes: []string{"swor", "mace"}},
+	{Name: RunewordWealth, Runes: []Name{"LemRune", "KoRune", "TirRune"}, ItemTypes: []string{"tors"}},
+	{Name: RunewordWhite, Runes: []Name{"DolRune", "IoRune"}, ItemTypes: []string{"wand"}},
+	{Name: RunewordWind, Runes: []Name{"SurRune", "ElRune"}, ItemTypes: []string{"mele"}},
+	{Name: RunewordWisdom, Runes: []Name{"PulRune", "IthRune", "EldRune"}, ItemTypes: []string{"helm"}},
+	{Name: RunewordWrath, Runes: []Name{"PulRune", "LumRune", "BerRune", "MalRune"}, ItemTypes: []string{"miss"}},
+	{Name: RunewordZephyr, Runes: []Name{"OrtRune", "EthRune"}, ItemTypes: []string{"miss"}},
+	{Name: RunewordHustle, Runes: []Name{"ShaelRune", "KoRune", "EldRune"}, ItemTypes: []string{"weap", "tors"}},
+	{Name: RunewordMosaic, Runes: []Name{"MalRune", "GulRune", "AmnRune"}, ItemTypes: []string{"h2h"}},
+	{Name: RunewordMetamorphosis, Runes: []Name{"IoRune", "ChamRune", "FalRune"}, ItemTypes: []string{"helm"}},
+	{Name: RunewordGround, Runes: []Name{"ShaelRune", "IoRune", "OrtRune"}, ItemTypes: []string{"helm"}},
+	{Name: RunewordTemper, Runes: []Name{"ShaelRune", "IoRune", "RalRune"}, ItemTypes: []string{"helm"}},
+	{Name: RunewordHearth, Runes: []Name{"ShaelRune", "IoRune", "ThulRune"}, ItemTypes: []string{"helm"}},
+	{Name: RunewordCure, Runes: []Name{"ShaelRune", "IoRune", "TalRune"}, ItemTypes: []string{"helm"}},
+	{Name: RunewordBulwark, Runes: []Name{"ShaelRune", "IoRune", "SolRune"}, ItemTypes: []string{"helm"}},
+}
+
+// RuneMods contains the bonuses granted by each rune depending on where it is socketed
+var RuneMods = map[Name]struct {
+	Weapon []Mod
+	Armor  []Mod // Body armors and helms
+	Shield []Mod
+}{
+	"ElRune":    {Weapon: []Mod{{"att", "", 50, 50}, {"light", "", 1, 1}}, Armor: []Mod{{"ac", "", 15, 15}, {"light", "", 1, 1}}, Shield: []Mod{{"ac", "", 15, 15}, {"light", "", 1, 1}}},
+	"EldRune":   {Weapon: []Mod{{"dmg-undead", "", 75, 75}, {"att-undead", "", 50, 50}}, Armor: []Mod{{"stamdrain", "", 15, 15}}, Shield: []Mod{{"block", "", 7, 7}}},
+	"TirRune":   {Weapon: []Mod{{"mana-kill", "", 2, 2}}, Armor: []Mod{{"mana-kill", "", 2, 2}}, Shield: []Mod{{"mana-kill", "", 2, 2}}},
+	"NefRune":   {Weapon: []Mod{{"knock", "", 1, 1}}, Armor: []Mod{{"ac-miss", "", 30, 30}}, Shield: []Mod{{"ac-miss", "", 30, 30}}},
+	"EthRune":   {Weapon: []Mod{{"reduce-ac", "", 25, 25}}, Armor: []Mod{{"regen-mana", "", 15, 15}}, Shield: []Mod{{"regen-mana", "", 15, 15}}},
+	"IthRune":   {Weapon: []Mod{{"dmg-max", "", 9, 9}}, Armor: []Mod{{"dmg-to-mana", "", 15, 15}}, Shield: []Mod{{"dmg-to-mana", "", 15, 15}}},
+	"TalRune":   {Weapon: []Mod{{"dmg-pois", "125", 154, 154}}, Armor: []Mod{{"res-pois", "", 30, 30}}, Shield: []Mod{{"res-pois", "", 35, 35}}},
+	"RalRune":   {Weapon: []Mod{{"dmg-fire", "", 5, 30}}, Armor: []Mod{{"res-fire", "", 30, 30}}, Shield: []Mod{{"res-fire", "", 35, 35}}},
+	"OrtRune":   {Weapon: []Mod{{"dmg-ltng", "", 1, 50}}, Armor: []Mod{{"res-ltng", "", 30, 30}}, Shield: []Mod{{"res-ltng", "", 35, 35}}},
+	"ThulRune":  {Weapon: []Mod{{"dmg-cold", "75", 3, 14}}, Armor: []Mod{{"res-cold", "", 30, 30}}, Shield: []Mod{{"res-cold", "", 35, 35}}},
+	"AmnRune":   {Weapon: []Mod{{"lifesteal", "", 7, 7}}, Armor: []Mod{{"thorns", "", 14, 14}}, Shield: []Mod{{"thorns", "", 14, 14}}},
+	"SolRune":   {Weapon: []Mod{{"dmg-min", "", 9, 9}}, Armor: []Mod{{"red-dmg", "", 7, 7}}, Shield: []Mod{{"red-dmg", "", 7, 7}}},
+	"ShaelRune": {Weapon: []Mod{{"swing2", "", 20, 20}}, Armor: []Mod{{"balance2", "", 20, 20}}, Shield: []Mod{{"block2", "", 20, 20}}},
+	"DolRune":   {Weapon: []Mod{{"howl", "", 25, 25}}, Armor: []Mod{{"regen", "", 7, 7}}, Shield: []Mod{{"regen", "", 7, 7}}},
+	"HelRune":   {Weapon: []Mod{{"ease", "", -20, -20}}, Armor: []Mod{{"ease", "", -15, -15}}, Shield: []Mod{{"ease", "", -15, -15}}},
+	"IoRune":    {Weapon: []Mod{{"vit", "", 10, 10}}, Armor: []Mod{{"vit", "", 10, 10}}, Shield: []Mod{{"vit", "", 10, 10}}},
+	"LumRune":   {Weapon: []Mod{{"enr", "", 10, 10}}, Armor: []Mod{{"enr", "", 10, 10}}, Shield: []Mod{{"enr", "", 10, 10}}},
+	"KoRune":    {Weapon: []Mod{{"dex", "", 10, 10}}, Armor: []Mod{{"dex", "", 10, 10}}, Shield: []Mod{{"dex", "", 10, 10}}},
+	"FalRune":   {Weapon: []Mod{{"str", "", 10, 10}}, Armor: []Mod{{"str", "", 10, 10}}, Shield: []Mod{{"str", "", 10, 10}}},
+	"LemRune":   {Weapon: []Mod{{"gold%", "", 75, 75}}, Armor: []Mod{{"gold%", "", 50, 50}}, Shield: []Mod{{"gold%", "", 50, 50}}},
+	"PulRune":   {Weapon: []Mod{{"dmg-demon", "", 75, 75}, {"att-demon", "", 100, 100}}, Armor: []Mod{{"ac%", "", 30, 30}}, Shield: []Mod{{"ac%", "", 30, 30}}},
+	"UmRune":    {Weapon: []Mod{{"openwounds", "", 25, 25}}, Armor: []Mod{{"res-all", "", 15, 15}}, Shield: []Mod{{"res-all", "", 22, 22}}},
+	"MalRune":   {Weapon: []Mod{{"noheal", "", 1, 1}}, Armor: []Mod{{"red-mag", "", 7, 7}}, Shield: []Mod{{"red-mag", "", 7, 7}}},
+	"IstRune":   {Weapon: []Mod{{"mag%", "", 30, 30}}, Armor: []Mod{{"mag%", "", 25, 25}}, Shield: []Mod{{"mag%", "", 25, 25}}},
+	"GulRune":   {Weapon: []Mod{{"att%", "", 20, 20}}, Armor: []Mod{{"res-pois-max", "", 5, 5}}, Shield: []Mod{{"res-pois-max", "", 5, 5}}},
+	"VexRune":   {Weapon: []Mod{{"manasteal", "", 7, 7}}, Armor: []Mod{{"res-fire-max", "", 5, 5}}, Shield: []Mod{{"res-fire-max", "", 5, 5}}},
+	"OhmRune":   {Weapon: []Mod{{"dmg%", "", 50, 50}}, Armor: []Mod{{"res-cold-max", "", 5, 5}}, Shield: []Mod{{"res-cold-max", "", 5, 5}}},
+	"LoRune":    {Weapon: []Mod{{"deadly", "", 20, 20}}, Armor: []Mod{{"res-ltng-max", "", 5, 5}}, Shield: []Mod{{"res-ltng-max", "", 5, 5}}},
+	"SurRune":   {Weapon: []Mod{{"stupidity", "", 1, 1}}, Armor: []Mod{{"mana%", "", 5, 5}}, Shield: []Mod{{"mana", "", 50, 50}}},
+	"BerRune":   {Weapon: []Mod{{"crush", "", 20, 20}}, Armor: []Mod{{"red-dmg%", "", 8, 8}}, Shield: []Mod{{"red-dmg%", "", 8, 8}}},
+	"JahRune":   {Weapon: []Mod{{"ignore-ac", "", 1, 1}}, Armor: []Mod{{"hp%", "", 5, 5}}, Shield: []Mod{{"hp", "", 50, 50}}},
+	"ChamRune":  {Weapon: []Mod{{"freeze", "", 3, 3}}, Armor: []Mod{{"nofreeze", "", 1, 1}}, Shield: []Mod{{"nofreeze", "", 1, 1}}},
+	"ZodRune":   {Weapon: []Mod{{"indestruct", "", 1, 1}}, Armor: []Mod{{"indestruct", "", 1, 1}}, Shield: []Mod{{"indestruct", "", 1, 1}}},
+}
+
+func FindRuneword(name RunewordName) (Runeword, bool) {
+	for _, rw := range Runewords {
+		if rw.Name == name {
+			return rw, true
+		}
+	}
+
+	return Runeword{}, false
+}
+
+// AllowsBase returns true if the runeword can be made using the given base type
+func (r Runeword) AllowsBase(t Type) bool {
+	for _, excluded := range r.ExcludeTypes {
+		if t.Is(excluded) {
+			return false
+		}
+	}
+
+	for _, allowed := range r.ItemTypes {
+		if t.Is(allowed) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// RuneMods returns the bonuses granted by the runes once socketed in the given base type, runeword bonuses are not
+// included
+func (r Runeword) RuneMods(t Type) []Mod {
+	mods := make([]Mod, 0, len(r.Runes))
+	for _, runeName := range r.Runes {
+		runeMods := RuneMods[runeName]
+		switch {
+		case t.Is(TypeWeapon):
+			mods = append(mods, runeMods.Weapon...)
+		case t.Is(TypeAnyShield):
+			mods = append(mods, runeMods.Shield...)
+		default:
+			mods = append(mods, runeMods.Armor...)
+		}
+	}
+
+	return mods
+}
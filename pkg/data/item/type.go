@@ -7,9 +7,26 @@ type Type struct {
 	Throwable bool
 	Beltable  bool
 	BodyLocs  []LocationType
+	Equiv     []string // Parent types
 }
 
 // TODO: Refactor to support parent types
 func (t Type) IsType(typeName string) bool {
 	return t.Code == typeName
 }
+
+// Is returns true if the type is the given one or any of its parent types is, e.g. a Sword is also a Melee Weapon and
+// a Weapon. Use IsType to check only the exact type
+func (t Type) Is(code string) bool {
+	if t.Code == code {
+		return true
+	}
+
+	for _, parent := range t.Equiv {
+		if parentType, found := ItemTypes[parent]; found && parentType.Is(code) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,77 @@
+package data
+
+import (
+	"github.com/hectorgimenez/d2go/pkg/data/stat"
+)
+
+// Ethereal items have lower strength and dexterity requirements
+const etherealRequirementReduction = 10
+
+type ItemRequirements struct {
+	Strength  int
+	Dexterity int
+	Level     int
+}
+
+// Requirements returns the stats needed to equip the item, including ethereal and "Requirements -X%" modifiers
+func (i Item) Requirements() ItemRequirements {
+	desc := i.Desc()
+	str := desc.RequiredStrength
+	dex := desc.RequiredDexterity
+
+	if reqPercent, found := i.FindStat(stat.Requirements, 0); found {
+		str = str * (100 + reqPercent.Value) / 100
+		dex = dex * (100 + reqPercent.Value) / 100
+	}
+	if i.Ethereal {
+		str -= etherealRequirementReduction
+		dex -= etherealRequirementReduction
+	}
+
+	return ItemRequirements{
+		Strength:  max(str, 0),
+		Dexterity: max(dex, 0),
+		Level:     max(i.LevelReq, desc.RequiredLevel),
+	}
+}
+
+// CanEquip returns true if the player meets the item strength, dexterity and level requirements, class restrictions and
+// item slots are not checked
+func (pu PlayerUnit) CanEquip(i Item) bool {
+	req := i.Requirements()
+	str, _ := pu.FindStat(stat.Strength, 0)
+	dex, _ := pu.FindStat(stat.Dexterity, 0)
+	lvl, _ := pu.FindStat(stat.Level, 0)
+
+	return str.Value >= req.Strength && dex.Value >= req.Dexterity && lvl.Value >= req.Level
+}
+
+// BaseDefenseRoll returns where the item base defense rolled between the min and max base defense, from 0 (min) to
+// 1 (max). It returns false for items without defense or with enhanced defense, the roll can not be calculated for them
+func (i Item) BaseDefenseRoll() (float64, bool) {
+	desc := i.Desc()
+	if desc.MaxDefense == 0 {
+		return 0, false
+	}
+	if _, found := i.FindStat(stat.EnhancedDefense, 0); found {
+		return 0, false
+	}
+
+	defense, found := i.BaseStats.FindStat(stat.Defense, 0)
+	if !found {
+		return 0, false
+	}
+
+	minDef, maxDef := desc.MinDefense, desc.MaxDefense
+	value := defense.Value
+	if i.Ethereal {
+		value = value * 2 / 3
+	}
+	if maxDef == minDef {
+		return 1, true
+	}
+
+	roll := float64(value-minDef) / float64(maxDef-minDef)
+
+	return min(max(roll, 0), 1), true
+}
@@ -1,9 +1,14 @@
 package data
 
 import (
+	"encoding/binary"
+	"hash/fnv"
+	"slices"
+	"sort"
 	"strings"
 
 	"github.com/hectorgimenez/d2go/pkg/data/item"
+	"github.com/hectorgimenez/d2go/pkg/data/mode"
 	"github.com/hectorgimenez/d2go/pkg/data/stat"
 )
 
@@ -43,6 +48,45 @@ func (i Inventory) FindByID(unitID UnitID) (Item, bool) {
 	return Item{}, false
 }
 
+type PickupStatus string
+
+const (
+	PickupStatusMissing  PickupStatus = "missing"   // Not found, out of range or picked up by someone else
+	PickupStatusDropping PickupStatus = "dropping"  // Still falling, it can't be picked up yet
+	PickupStatusOnGround PickupStatus = "on_ground" // Pickup not done yet, or it failed
+	PickupStatusOnCursor PickupStatus = "on_cursor" // Picked up but not placed, usually because the inventory is full
+	PickupStatusPickedUp PickupStatus = "picked_up"
+)
+
+// PickupStatus returns where the given item is in the pickup flow (ground -> cursor -> inventory), so pickup code can
+// confirm it succeeded by polling the same item instead of scanning the ground again
+func (i Inventory) PickupStatus(unitID UnitID) PickupStatus {
+	it, found := i.FindByID(unitID)
+	if !found {
+		for _, beltItem := range i.Belt.Items {
+			if beltItem.UnitID == unitID {
+				return PickupStatusPickedUp
+			}
+		}
+
+		return PickupStatusMissing
+	}
+
+	switch it.Mode {
+	case mode.ItemDropping:
+		return PickupStatusDropping
+	case mode.ItemOnGround:
+		return PickupStatusOnGround
+	case mode.ItemOnCursor:
+		return PickupStatusOnCursor
+	}
+	if isPlayerOwnedLocation(it.Location.LocationType) {
+		return PickupStatusPickedUp
+	}
+
+	return PickupStatusMissing
+}
+
 func (i Inventory) ByLocation(locations ...item.LocationType) []Item {
 	var items []Item
 
@@ -57,6 +101,143 @@ func (i Inventory) ByLocation(locations ...item.LocationType) []Item {
 	return items
 }
 
+// FindAllMatching returns the items matching the given function, only in the given locations if any is specified
+func (i Inventory) FindAllMatching(fn func(Item) bool, locations ...item.LocationType) []Item {
+	var items []Item
+	for _, it := range i.AllItems {
+		if !fn(it) {
+			continue
+		}
+		if len(locations) == 0 || slices.Contains(locations, it.Location.LocationType) {
+			items = append(items, it)
+		}
+	}
+
+	return items
+}
+
+// FindByQuality returns the items of the given quality, only in the given locations if any is specified
+func (i Inventory) FindByQuality(quality item.Quality, locations ...item.LocationType) []Item {
+	return i.FindAllMatching(func(it Item) bool { return it.Quality == quality }, locations...)
+}
+
+// FindWithStat returns the items having the given stat (layer 0) with at least the given value, only in the given
+// locations if any is specified
+func (i Inventory) FindWithStat(id stat.ID, minValue int, locations ...item.LocationType) []Item {
+	return i.FindAllMatching(func(it Item) bool {
+		st, found := it.FindStat(id, 0)
+		return found && st.Value >= minValue
+	}, locations...)
+}
+
+// runeLocations are the locations searched by HasRunes by default, the ones holding runes owned by the player that can
+// still be used. Runes already socketed in an item are not included, they can't be taken out
+var runeLocations = []item.LocationType{item.LocationInventory, item.LocationStash, item.LocationSharedStash, item.LocationCube}
+
+// HasRunes returns true if all the runes are found, repeated runes need to be found as many times as they are
+// listed, e.g. []item.Name{"UmRune", "UmRune"} needs two Um runes. If no location is given the inventory, stashes and
+// cube are searched, runes on the ground or sold by vendors are not counted
+func (i Inventory) HasRunes(runes []item.Name, locations ...item.LocationType) bool {
+	if len(locations) == 0 {
+		locations = runeLocations
+	}
+
+	needed := make(map[string]int, len(runes))
+	for _, r := range runes {
+		needed[strings.ToLower(string(r))]++
+	}
+
+	for _, it := range i.FindAllMatching(func(Item) bool { return true }, locations...) {
+		name := strings.ToLower(string(it.Name))
+		if needed[name] > 0 {
+			needed[name]--
+		}
+	}
+
+	for _, n := range needed {
+		if n > 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// FreeSpaceFor returns the top-left inventory position where an item of the given size fits, scanning by columns
+// like the game does when an item is picked up. Returns false if the inventory is full for that size
+func (i Inventory) FreeSpaceFor(width, height int) (Position, bool) {
+	return i.Grid(item.LocationInventory, 0).FindSpace(width, height)
+}
+
+// Equipped returns the items equipped by the player keyed by equipment slot, switch weapons included
+func (i Inventory) Equipped() map[item.BodyLocation]Item {
+	return equippedBySlot(i.ByLocation(item.LocationEquipped))
+}
+
+// MercEquipped returns the items equipped by the mercenary keyed by equipment slot
+func (i Inventory) MercEquipped() map[item.BodyLocation]Item {
+	return equippedBySlot(i.ByLocation(item.LocationMercenary))
+}
+
+// EquippedAt returns the item equipped by the player in the given slot
+func (i Inventory) EquippedAt(slot item.BodyLocation) (Item, bool) {
+	for _, it := range i.ByLocation(item.LocationEquipped) {
+		if it.Location.Slot() == slot {
+			return it, true
+		}
+	}
+
+	return Item{}, false
+}
+
+func equippedBySlot(items []Item) map[item.BodyLocation]Item {
+	equipped := make(map[item.BodyLocation]Item, len(items))
+	for _, it := range items {
+		if slot := it.Location.Slot(); slot != item.BodyNone {
+			equipped[slot] = it
+		}
+	}
+
+	return equipped
+}
+
+// AnvilItem returns the item placed in the item slot of the anvil screen, so it can be checked before confirming the
+// imbue/socket/personalize quest reward. Returns false if the anvil screen is not open or the slot is empty
+func (d Data) AnvilItem() (Item, bool) {
+	if !d.OpenMenus.Anvil {
+		return Item{}, false
+	}
+
+	for _, it := range d.Inventory.AllItems {
+		if it.Location.LocationType == item.LocationTrade {
+			return it, true
+		}
+	}
+
+	return Item{}, false
+}
+
+// VendorStockID returns an identifier of the vendor items currently loaded, it changes every time the vendor stock is
+// re-rolled (e.g. leaving and entering the shop area) so the stock only needs to be checked again when it changes.
+// Returns 0 if there are no vendor items loaded
+func (i Inventory) VendorStockID() uint64 {
+	vendorItems := i.ByLocation(item.LocationVendor)
+	if len(vendorItems) == 0 {
+		return 0
+	}
+
+	sort.Slice(vendorItems, func(a, b int) bool { return vendorItems[a].UnitID < vendorItems[b].UnitID })
+	h := fnv.New64a()
+	buf := make([]byte, 8)
+	for _, it := range vendorItems {
+		binary.LittleEndian.PutUint32(buf, uint32(it.UnitID))
+		binary.LittleEndian.PutUint32(buf[4:], it.Seed)
+		h.Write(buf)
+	}
+
+	return h.Sum64()
+}
+
 func (i Inventory) Matrix() [4][10]bool {
 	invMatrix := [4][10]bool{} // false = empty, true = occupied
 	for _, itm := range i.ByLocation(item.LocationInventory) {
@@ -91,6 +272,9 @@ type Item struct {
 	IdentifiedName       string
 	RunewordName         item.RunewordName
 	LevelReq             int
+	ItemLevel            int
+	Seed                 uint32 // Seed the item was generated with, it doesn't change during the item lifetime
+	Mode                 mode.ItemMode
 	Position             Position
 	Location             item.Location
 	Ethereal             bool
@@ -216,6 +400,36 @@ func (a ItemAffixes) GetMagicSuffixes() []item.MagicSuffix {
 	return suffixes
 }
 
+// PrefixNames returns the human-readable names of the item prefixes: the first word of the name of rare and crafted
+// items, followed by the magic prefixes. Magic items have at most one magic prefix, shown in their name, while rare and
+// crafted items can have up to three, they only add stats and are not part of the item name
+func (a ItemAffixes) PrefixNames() []string {
+	names := make([]string, 0, 3)
+	if prefix, found := a.GetRarePrefix(); found {
+		names = append(names, prefix.Name)
+	}
+	for _, prefix := range a.GetMagicPrefixes() {
+		names = append(names, prefix.Name)
+	}
+
+	return names
+}
+
+// SuffixNames returns the human-readable names of the item suffixes: the second word of the name of rare and crafted
+// items, followed by the magic suffixes. Same as prefixes, magic items have at most one and rare and crafted items up to
+// three that are not part of the item name
+func (a ItemAffixes) SuffixNames() []string {
+	names := make([]string, 0, 3)
+	if suffix, found := a.GetRareSuffix(); found {
+		names = append(names, suffix.Name)
+	}
+	for _, suffix := range a.GetMagicSuffixes() {
+		names = append(names, suffix.Name)
+	}
+
+	return names
+}
+
 func (i Item) GetSocketedItems() []Item {
 	return i.Sockets
 }
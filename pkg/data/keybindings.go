@@ -1,6 +1,12 @@
 package data
 
-import "github.com/hectorgimenez/d2go/pkg/data/skill"
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hectorgimenez/d2go/pkg/data/skill"
+)
 
 type KeyBindings struct {
 	CharacterScreen KeyBinding
@@ -80,3 +86,147 @@ func (kb KeyBindings) MustKBForSkill(skillID skill.ID) KeyBinding {
 
 	return k
 }
+
+type KeyBindingProblemType string
+
+const (
+	KeyBindingProblemConflict      KeyBindingProblemType = "conflict"
+	KeyBindingProblemMissing       KeyBindingProblemType = "missing"
+	KeyBindingProblemMissingSkill  KeyBindingProblemType = "missing_skill"
+	KeyBindingProblemUnknownAction KeyBindingProblemType = "unknown_action"
+)
+
+// KeyBindingRequirements are the skills and actions that need a key bound, actions use the names returned by Named
+type KeyBindingRequirements struct {
+	Skills  []skill.ID
+	Actions []string
+}
+
+type KeyBindingProblem struct {
+	Type    KeyBindingProblemType
+	Skill   skill.ID // Only for KeyBindingProblemMissingSkill
+	Actions []string // Actions involved, for conflicts all the actions sharing the key
+	Key     [2]byte  // Only for KeyBindingProblemConflict
+}
+
+func (p KeyBindingProblem) String() string {
+	switch p.Type {
+	case KeyBindingProblemConflict:
+		return fmt.Sprintf("key %v is bound to multiple actions: %s", p.Key, strings.Join(p.Actions, ", "))
+	case KeyBindingProblemMissingSkill:
+		return fmt.Sprintf("no key bound to skill %s", skill.Skills[p.Skill].Name)
+	case KeyBindingProblemUnknownAction:
+		return fmt.Sprintf("unknown action %s", strings.Join(p.Actions, ", "))
+	default:
+		return fmt.Sprintf("no key bound to %s", strings.Join(p.Actions, ", "))
+	}
+}
+
+// IsBound returns true if any of the keys is assigned
+func (kb KeyBinding) IsBound() bool {
+	return isKeyBound(kb.Key1) || isKeyBound(kb.Key2)
+}
+
+func isKeyBound(key [2]byte) bool {
+	return key[0] != 0 && key[0] != 0xFF
+}
+
+// Named returns every action binding by name, skill bindings are named "Skill1" to "Skill16" and belt ones "UseBelt1"
+// to "UseBelt4"
+func (kb KeyBindings) Named() map[string]KeyBinding {
+	named := map[string]KeyBinding{
+		"CharacterScreen":     kb.CharacterScreen,
+		"Inventory":           kb.Inventory,
+		"HoradricCube":        kb.HoradricCube,
+		"PartyScreen":         kb.PartyScreen,
+		"MercenaryScreen":     kb.MercenaryScreen,
+		"MessageLog":          kb.MessageLog,
+		"QuestLog":            kb.QuestLog,
+		"HelpScreen":          kb.HelpScreen,
+		"SkillTree":           kb.SkillTree,
+		"SkillSpeedBar":       kb.SkillSpeedBar,
+		"SelectPreviousSkill": kb.SelectPreviousSkill,
+		"SelectNextSkill":     kb.SelectNextSkill,
+		"ShowBelt":            kb.ShowBelt,
+		"SwapWeapons":         kb.SwapWeapons,
+		"Chat":                kb.Chat,
+		"Run":                 kb.Run,
+		"ToggleRunWalk":       kb.ToggleRunWalk,
+		"StandStill":          kb.StandStill,
+		"ForceMove":           kb.ForceMove,
+		"ShowItems":           kb.ShowItems,
+		"ShowPortraits":       kb.ShowPortraits,
+		"Automap":             kb.Automap,
+		"CenterAutomap":       kb.CenterAutomap,
+		"FadeAutomap":         kb.FadeAutomap,
+		"PartyOnAutomap":      kb.PartyOnAutomap,
+		"NamesOnAutomap":      kb.NamesOnAutomap,
+		"ToggleMiniMap":       kb.ToggleMiniMap,
+		"SayHelp":             kb.SayHelp,
+		"SayFollowMe":         kb.SayFollowMe,
+		"SayThisIsForYou":     kb.SayThisIsForYou,
+		"SayThanks":           kb.SayThanks,
+		"SaySorry":            kb.SaySorry,
+		"SayBye":              kb.SayBye,
+		"SayNowYouDie":        kb.SayNowYouDie,
+		"SayRetreat":          kb.SayRetreat,
+		"ClearScreen":         kb.ClearScreen,
+		"ClearMessages":       kb.ClearMessages,
+		"Zoom":                kb.Zoom,
+		"LegacyToggle":        kb.LegacyToggle,
+		"PreviousStashPage":   kb.PreviousStashPage,
+		"NextStashPage":       kb.NextStashPage,
+	}
+	for i, sk := range kb.Skills {
+		named[fmt.Sprintf("Skill%d", i+1)] = sk.KeyBinding
+	}
+	for i, b := range kb.UseBelt {
+		named[fmt.Sprintf("UseBelt%d", i+1)] = b
+	}
+
+	return named
+}
+
+// Validate returns the problems found in the key bindings: keys bound to more than one action and required skills or
+// actions without a key. Problems are sorted, so the result is stable between calls
+func (kb KeyBindings) Validate(req KeyBindingRequirements) []KeyBindingProblem {
+	problems := make([]KeyBindingProblem, 0)
+	named := kb.Named()
+
+	keyActions := make(map[[2]byte][]string)
+	for name, b := range named {
+		if isKeyBound(b.Key1) {
+			keyActions[b.Key1] = append(keyActions[b.Key1], name)
+		}
+		if isKeyBound(b.Key2) && b.Key2 != b.Key1 {
+			keyActions[b.Key2] = append(keyActions[b.Key2], name)
+		}
+	}
+	conflicts := make([]KeyBindingProblem, 0)
+	for key, actions := range keyActions {
+		if len(actions) > 1 {
+			sort.Strings(actions)
+			conflicts = append(conflicts, KeyBindingProblem{Type: KeyBindingProblemConflict, Actions: actions, Key: key})
+		}
+	}
+	sort.Slice(conflicts, func(i, j int) bool { return conflicts[i].Actions[0] < conflicts[j].Actions[0] })
+	problems = append(problems, conflicts...)
+
+	for _, sk := range req.Skills {
+		if b, found := kb.KeyBindingForSkill(sk); !found || !b.IsBound() {
+			problems = append(problems, KeyBindingProblem{Type: KeyBindingProblemMissingSkill, Skill: sk})
+		}
+	}
+	for _, action := range req.Actions {
+		b, found := named[action]
+		if !found {
+			problems = append(problems, KeyBindingProblem{Type: KeyBindingProblemUnknownAction, Actions: []string{action}})
+			continue
+		}
+		if !b.IsBound() {
+			problems = append(problems, KeyBindingProblem{Type: KeyBindingProblemMissing, Actions: []string{action}})
+		}
+	}
+
+	return problems
+}
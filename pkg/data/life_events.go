@@ -0,0 +1,111 @@
+package data
+
+import (
+	"github.com/hectorgimenez/d2go/pkg/data/mode"
+	"github.com/hectorgimenez/d2go/pkg/data/stat"
+	"github.com/hectorgimenez/d2go/pkg/data/state"
+)
+
+type LifeEventType string
+
+const (
+	LifeEventDeath   LifeEventType = "death"
+	LifeEventLowLife LifeEventType = "low_life" // Life went below the given threshold, the usual chicken trigger
+)
+
+// DamageCause is the best-effort guess of what caused the life loss, based on the previous snapshot
+type DamageCause string
+
+const (
+	DamageCauseUnknown       DamageCause = "unknown"
+	DamageCauseIronMaiden    DamageCause = "iron_maiden"    // Cursed with Iron Maiden, melee damage is reflected
+	DamageCausePoison        DamageCause = "poison"         // Poisoned and no monster close enough to be the cause
+	DamageCauseEliteDeath    DamageCause = "elite_death"    // Elite died next to the player, likely Fire Enchanted
+	DamageCauseMonsterAttack DamageCause = "monster_attack" // Closest monster is reported as the attacker
+)
+
+const (
+	// Distances are in sub-tiles, monster explosions cover a small radius while monsters can attack from far away
+	eliteDeathMaxDistance    = 5
+	monsterAttackMaxDistance = 15
+)
+
+type LifeEvent struct {
+	Type      LifeEventType
+	HPPercent int
+	LifeLost  int
+	Cause     DamageCause
+	Monster   Monster // Monster related to the cause, only set for elite_death and monster_attack
+}
+
+// LifeEvents returns the death and low life events that happened between both snapshots, lowLifePercent is the life
+// threshold to report (0 disables it). A death doesn't report a low life event as well
+func LifeEvents(old, new Data, lowLifePercent int) []LifeEvent {
+	oldLife, _ := old.PlayerUnit.FindStat(stat.Life, 0)
+	newLife, _ := new.PlayerUnit.FindStat(stat.Life, 0)
+	_, oldFound := old.PlayerUnit.FindStat(stat.MaxLife, 0)
+	maxLife, found := new.PlayerUnit.FindStat(stat.MaxLife, 0)
+	// Empty snapshots (loading screens, out of game) have no stats, ignore them as well as an already dead player
+	if !oldFound || !found || maxLife.Value <= 0 || old.PlayerUnit.IsDead() {
+		return nil
+	}
+
+	event := LifeEvent{HPPercent: new.PlayerUnit.HPPercent(), LifeLost: oldLife.Value - newLife.Value}
+	switch {
+	case new.PlayerUnit.IsDead():
+		event.Type = LifeEventDeath
+	case lowLifePercent > 0 && old.PlayerUnit.HPPercent() > lowLifePercent && event.HPPercent <= lowLifePercent:
+		event.Type = LifeEventLowLife
+	default:
+		return nil
+	}
+	event.Cause, event.Monster = damageCause(old, new)
+
+	return []LifeEvent{event}
+}
+
+func damageCause(old, new Data) (DamageCause, Monster) {
+	player := old.PlayerUnit
+	if player.States.HasState(state.Ironmaiden) && isAttacking(player) {
+		return DamageCauseIronMaiden, Monster{}
+	}
+
+	alive := make(map[UnitID]Monster, len(old.Monsters))
+	for _, m := range old.Monsters {
+		alive[m.UnitID] = m
+	}
+	for _, c := range new.Corpses {
+		m, found := alive[c.UnitID]
+		if found && m.IsElite() && sqDistance(player.Position, c.Position) <= eliteDeathMaxDistance*eliteDeathMaxDistance {
+			return DamageCauseEliteDeath, m
+		}
+	}
+
+	closest, closestDistance := Monster{}, monsterAttackMaxDistance*monsterAttackMaxDistance+1
+	for _, m := range old.Monsters {
+		if m.IsPet() || m.IsMerc() || m.IsGoodNPC() {
+			continue
+		}
+		if d := sqDistance(player.Position, m.Position); d < closestDistance {
+			closest, closestDistance = m, d
+		}
+	}
+	if closest.UnitID != 0 {
+		return DamageCauseMonsterAttack, closest
+	}
+	if player.States.HasState(state.Poison) {
+		return DamageCausePoison, Monster{}
+	}
+
+	return DamageCauseUnknown, Monster{}
+}
+
+// isAttacking returns true if the player was attacking or using a skill, melee skills use the skill modes too
+func isAttacking(pu PlayerUnit) bool {
+	switch pu.Mode.Animation() {
+	case mode.AnimationAttack, mode.AnimationCast, mode.AnimationSequence:
+		return true
+	}
+
+	return false
+}
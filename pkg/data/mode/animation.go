@@ -0,0 +1,67 @@
+package mode
+
+// Animation groups the player and NPC modes by the kind of animation they play
+type Animation string
+
+const (
+	AnimationIdle      Animation = "idle"
+	AnimationWalk      Animation = "walk"
+	AnimationRun       Animation = "run"
+	AnimationAttack    Animation = "attack"
+	AnimationCast      Animation = "cast"
+	AnimationGetHit    Animation = "gethit"
+	AnimationBlock     Animation = "block"
+	AnimationKnockBack Animation = "knockback"
+	AnimationDeath     Animation = "death"
+	AnimationSequence  Animation = "sequence"
+)
+
+func (m PlayerMode) Animation() Animation {
+	switch m {
+	case Walking, WalkingInTown:
+		return AnimationWalk
+	case Running:
+		return AnimationRun
+	case Attacking1, Attacking2, Kicking, ThrowingItem:
+		return AnimationAttack
+	case CastingSkill, UsingSkill1, UsingSkill2, UsingSkill3, UsingSkill4:
+		return AnimationCast
+	case GettingHit:
+		return AnimationGetHit
+	case Blocking:
+		return AnimationBlock
+	case KnockedBack:
+		return AnimationKnockBack
+	case Death, Dead:
+		return AnimationDeath
+	case SkillActionSequence:
+		return AnimationSequence
+	default:
+		return AnimationIdle
+	}
+}
+
+func (m NpcMode) Animation() Animation {
+	switch m {
+	case NpcWalking:
+		return AnimationWalk
+	case NpcRunning:
+		return AnimationRun
+	case NpcAttacking1, NpcAttacking2:
+		return AnimationAttack
+	case NpcCastingSpell, NpcUsingSkill1, NpcUsingSkill2, NpcUsingSkill3, NpcUsingSkill4:
+		return AnimationCast
+	case NpcGettingHit:
+		return AnimationGetHit
+	case NpcBlocking:
+		return AnimationBlock
+	case NpcKnockedBack:
+		return AnimationKnockBack
+	case NpcDeath, NpcDead:
+		return AnimationDeath
+	case NpcActionSequence:
+		return AnimationSequence
+	default:
+		return AnimationIdle
+	}
+}
@@ -0,0 +1,13 @@
+package mode
+
+type ItemMode uint32
+
+const (
+	ItemStored   ItemMode = iota // Inventory, stash, cube, vendor...
+	ItemEquipped                 // Equipped by the player or the mercenary
+	ItemInBelt
+	ItemOnGround
+	ItemOnCursor
+	ItemDropping // Falling to the ground, it can't be picked up until it lands
+	ItemSocketed
+)
@@ -17,13 +17,14 @@ type MonsterType string
 
 type Monster struct {
 	UnitID
-	Name      npc.ID
-	IsHovered bool
-	Position  Position
-	Stats     map[stat.ID]int
-	Type      MonsterType
-	States    state.States
-	Mode      mode.NpcMode
+	Name       npc.ID
+	IsHovered  bool
+	Position   Position
+	PathTarget Position // Where the monster is moving to, same as Position if it's not moving
+	Stats      map[stat.ID]int
+	Type       MonsterType
+	States     state.States
+	Mode       mode.NpcMode
 }
 
 type Monsters []Monster
@@ -87,6 +88,25 @@ func MonsterAnyFilter() MonsterFilter {
 	}
 }
 
+// MonsterAttackableFilter keeps only the monsters that can be attacked right now, see Monster.IsAttackable
+func MonsterAttackableFilter() MonsterFilter {
+	return func(m Monsters) []Monster {
+		return m.Attackable()
+	}
+}
+
+// Attackable returns the monsters that can be attacked right now, see Monster.IsAttackable
+func (m Monsters) Attackable() Monsters {
+	attackable := make(Monsters, 0)
+	for _, mo := range m {
+		if mo.IsAttackable() {
+			attackable = append(attackable, mo)
+		}
+	}
+
+	return attackable
+}
+
 func (m Monsters) FindByID(id UnitID) (Monster, bool) {
 	for _, monster := range m {
 		if monster.UnitID == id {
@@ -124,6 +144,11 @@ func (m Monster) IsImmune(resist stat.Resist) bool {
 	return false
 }
 
+// IsMoving returns true if the monster has a path destination different from its current position
+func (m Monster) IsMoving() bool {
+	return m.PathTarget != m.Position
+}
+
 func (m Monster) IsMerc() bool {
 	if m.Name == npc.Guard || m.Name == npc.Act5Hireling1Hand || m.Name == npc.Act5Hireling2Hand || m.Name == npc.IronWolf || m.Name == npc.Rogue2 {
 		return true
@@ -213,6 +238,27 @@ func (m Monster) IsSkip() bool {
 	return false
 }
 
+// IsAttackable returns true if the monster is alive, hostile and can be targeted right now. Friendly units are
+// detected from the monstats flags (NPCs, not killable units) on top of the mercenary and pet checks, so it also covers
+// town guards and summons not listed in IsGoodNPC
+func (m Monster) IsAttackable() bool {
+	if m.Stats[stat.Life] <= 0 || m.Mode.Animation() == mode.AnimationDeath {
+		return false
+	}
+	if m.IsMerc() || m.IsPet() || m.IsGoodNPC() || m.IsSkip() {
+		return false
+	}
+	// Airborne or hidden in water
+	if m.IsEscapingType() && m.Mode == mode.NpcUsingSkill1 {
+		return false
+	}
+	if flags, found := npc.MonStatsFlagsForID(m.Name); found && (flags.IsNPC || !flags.IsKillable) {
+		return false
+	}
+
+	return true
+}
+
 // IsEscapingType returns true if the monster cannot be attacked when airborne or hiding in water (NpcMode 8).
 func (m Monster) IsEscapingType() bool {
 	switch m.Name {
@@ -247,3 +293,79 @@ func (m Monster) IsUndeadOrDemon() bool {
 	flags, ok := npc.MonStatsFlagsForID(m.Name)
 	return ok && (flags.IsLUndead || flags.IsHUndead || flags.IsDemon)
 }
+
+// Town NPCs further than this from their preset position are considered wandering
+const townNPCWanderDistance = 4
+
+type TownNPC struct {
+	ID        npc.ID
+	UnitID    UnitID   // 0 if the NPC unit is not loaded yet, too far from the player
+	Position  Position // Current position, or the anchor one if the unit is not loaded
+	Anchor    Position // Preset position from the map data, where the NPC returns to after wandering
+	Loaded    bool
+	Wandering bool
+}
+
+// TownNPCs returns the NPCs of the current town, the loaded ones come from Monsters. The memory reader doesn't know the
+// preset positions, so they have to be given from the map data, they are used as anchor to detect wandering NPCs and
+// to locate the NPCs not loaded yet. Without presets only the loaded NPCs are returned, never wandering. Empty if the
+// player is not in town
+func (d Data) TownNPCs(presets NPCs) []TownNPC {
+	if !d.PlayerUnit.Area.IsTown() {
+		return []TownNPC{}
+	}
+
+	npcs := make([]TownNPC, 0)
+	seen := make(map[npc.ID]bool)
+	for _, m := range d.Monsters {
+		if !isTownNPC(m.Name) || seen[m.Name] {
+			continue
+		}
+		seen[m.Name] = true
+
+		tn := TownNPC{ID: m.Name, UnitID: m.UnitID, Position: m.Position, Anchor: m.Position, Loaded: true}
+		if preset, found := presets.FindOne(m.Name); found && len(preset.Positions) > 0 {
+			tn.Anchor = closestPosition(m.Position, preset.Positions)
+			tn.Wandering = !inRange(tn.Anchor, m.Position, townNPCWanderDistance)
+		}
+		npcs = append(npcs, tn)
+	}
+
+	for _, preset := range presets {
+		if seen[preset.ID] || !isTownNPC(preset.ID) || len(preset.Positions) == 0 {
+			continue
+		}
+		seen[preset.ID] = true
+		npcs = append(npcs, TownNPC{ID: preset.ID, Position: preset.Positions[0], Anchor: preset.Positions[0]})
+	}
+
+	return npcs
+}
+
+// FindTownNPC returns the given NPC from the current town, presets are the same as for TownNPCs
+func (d Data) FindTownNPC(id npc.ID, presets NPCs) (TownNPC, bool) {
+	for _, tn := range d.TownNPCs(presets) {
+		if tn.ID == id {
+			return tn, true
+		}
+	}
+
+	return TownNPC{}, false
+}
+
+func isTownNPC(id npc.ID) bool {
+	flags, found := npc.MonStatsFlagsForID(id)
+
+	return found && flags.IsNPC && flags.IsInTown && flags.CanInteract
+}
+
+func closestPosition(from Position, positions []Position) Position {
+	closest := positions[0]
+	for _, p := range positions[1:] {
+		if sqDistance(from, p) < sqDistance(from, closest) {
+			closest = p
+		}
+	}
+
+	return closest
+}
@@ -771,3 +771,763 @@ func MonStatsFlagsForID(id ID) (MonStatsFlags, bool) {
 	flags, ok := MonStatsFlagsByID[id]
 	return flags, ok
 }
+
+// MonStats contains the base stats of each monster, indexed by difficulty (normal, nightmare and hell)
+type MonStats struct {
+	ID          int
+	Level       [3]int // Monsters spawned by the area use the area level instead on nightmare and hell
+	MinHP       [3]int // HP and experience are percentages of the base values by monster level (monlvl.txt)
+	MaxHP       [3]int
+	Experience  [3]int
+	Resistances [3]Resistances
+}
+
+var MonStatsByID = map[ID]MonStats{
+	0:   {ID: 0, Level: [3]int{2, 37, 68}, MinHP: [3]int{86, 70, 70}, MaxHP: [3]int{129, 100, 100}, Experience: [3]int{86, 85, 85}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 50}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 75}, {Physical: 33, Magic: 0, Fire: 0, Lightning: 100, Cold: 0, Poison: 75}}},
+	1:   {ID: 1, Level: [3]int{6, 38, 69}, MinHP: [3]int{87, 70, 70}, MaxHP: [3]int{127, 100, 100}, Experience: [3]int{86, 85, 85}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 50}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 75}, {Physical: 33, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 100}}},
+	2:   {ID: 2, Level: [3]int{7, 39, 85}, MinHP: [3]int{89, 70, 70}, MaxHP: [3]int{124, 100, 100}, Experience: [3]int{85, 85, 85}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 40, Poison: 50}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 60, Poison: 75}, {Physical: 33, Magic: 0, Fire: 0, Lightning: 0, Cold: 110, Poison: 75}}},
+	3:   {ID: 3, Level: [3]int{13, 43, 74}, MinHP: [3]int{93, 70, 70}, MaxHP: [3]int{133, 100, 100}, Experience: [3]int{85, 85, 85}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 50, Lightning: 0, Cold: 0, Poison: 50}, {Physical: 0, Magic: 0, Fire: 75, Lightning: 0, Cold: 0, Poison: 75}, {Physical: 33, Magic: 0, Fire: 100, Lightning: 0, Cold: 0, Poison: 75}}},
+	4:   {ID: 4, Level: [3]int{14, 44, 78}, MinHP: [3]int{94, 70, 70}, MaxHP: [3]int{131, 100, 100}, Experience: [3]int{85, 85, 85}, Resistances: [3]Resistances{{Physical: 0, Magic: 20, Fire: 0, Lightning: 60, Cold: 0, Poison: 50}, {Physical: 0, Magic: 40, Fire: 0, Lightning: 80, Cold: 0, Poison: 75}, {Physical: 33, Magic: 70, Fire: 0, Lightning: 120, Cold: 0, Poison: 75}}},
+	5:   {ID: 5, Level: [3]int{1, 36, 67}, MinHP: [3]int{101, 105, 105}, MaxHP: [3]int{181, 150, 150}, Experience: [3]int{111, 105, 105}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 50}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 75}, {Physical: 50, Magic: 0, Fire: 0, Lightning: 0, Cold: 120, Poison: 75}}},
+	6:   {ID: 6, Level: [3]int{2, 37, 68}, MinHP: [3]int{101, 105, 105}, MaxHP: [3]int{186, 150, 150}, Experience: [3]int{111, 105, 105}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 50}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 75}, {Physical: 50, Magic: 0, Fire: 0, Lightning: 110, Cold: 0, Poison: 75}}},
+	7:   {ID: 7, Level: [3]int{12, 43, 73}, MinHP: [3]int{108, 105, 105}, MaxHP: [3]int{197, 150, 150}, Experience: [3]int{109, 105, 105}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 50}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 75}, {Physical: 50, Magic: 0, Fire: 100, Lightning: 0, Cold: 0, Poison: 75}}},
+	8:   {ID: 8, Level: [3]int{22, 50, 80}, MinHP: [3]int{107, 105, 105}, MaxHP: [3]int{192, 150, 150}, Experience: [3]int{109, 105, 105}, Resistances: [3]Resistances{{Physical: 20, Magic: 0, Fire: 50, Lightning: 0, Cold: 0, Poison: 50}, {Physical: 50, Magic: 0, Fire: 75, Lightning: 0, Cold: 0, Poison: 75}, {Physical: 50, Magic: 0, Fire: 110, Lightning: 0, Cold: 0, Poison: 75}}},
+	9:   {ID: 9, Level: [3]int{17, 46, 77}, MinHP: [3]int{109, 105, 105}, MaxHP: [3]int{192, 150, 150}, Experience: [3]int{109, 105, 105}, Resistances: [3]Resistances{{Physical: 0, Magic: 50, Fire: 0, Lightning: 0, Cold: 0, Poison: 50}, {Physical: 0, Magic: 75, Fire: 0, Lightning: 0, Cold: 0, Poison: 75}, {Physical: 50, Magic: 100, Fire: 0, Lightning: 0, Cold: 0, Poison: 75}}},
+	10:  {ID: 10, Level: [3]int{12, 43, 73}, MinHP: [3]int{66, 65, 65}, MaxHP: [3]int{127, 120, 120}, Experience: [3]int{81, 125, 125}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 70, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 85, Cold: 0, Poison: 75}, {Physical: 25, Magic: 0, Fire: 0, Lightning: 100, Cold: 0, Poison: 0}}},
+	11:  {ID: 11, Level: [3]int{11, 42, 72}, MinHP: [3]int{67, 65, 65}, MaxHP: [3]int{130, 120, 120}, Experience: [3]int{81, 125, 125}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 60, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 80, Cold: 0, Poison: 75}, {Physical: 25, Magic: 0, Fire: 0, Lightning: 105, Cold: 0, Poison: 0}}},
+	12:  {ID: 12, Level: [3]int{5, 37, 69}, MinHP: [3]int{62, 65, 65}, MaxHP: [3]int{124, 120, 120}, Experience: [3]int{81, 125, 125}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 40, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 70, Cold: 0, Poison: 0}, {Physical: 25, Magic: 0, Fire: 0, Lightning: 110, Cold: 0, Poison: 0}}},
+	13:  {ID: 13, Level: [3]int{8, 40, 70}, MinHP: [3]int{67, 65, 65}, MaxHP: [3]int{128, 120, 120}, Experience: [3]int{81, 125, 125}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 50, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 75, Cold: 0, Poison: 0}, {Physical: 25, Magic: 0, Fire: 0, Lightning: 115, Cold: 0, Poison: 0}}},
+	14:  {ID: 14, Level: [3]int{27, 57, 84}, MinHP: [3]int{65, 65, 65}, MaxHP: [3]int{126, 120, 120}, Experience: [3]int{88, 125, 125}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 80, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 100, Cold: 0, Poison: 0}, {Physical: 25, Magic: 0, Fire: 0, Lightning: 120, Cold: 0, Poison: 0}}},
+	15:  {ID: 15, Level: [3]int{4, 37, 68}, MinHP: [3]int{19, 20, 20}, MaxHP: [3]int{46, 45, 45}, Experience: [3]int{37, 40, 40}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 25, Lightning: 0, Cold: 0, Poison: 100}}},
+	16:  {ID: 16, Level: [3]int{6, 38, 69}, MinHP: [3]int{21, 20, 20}, MaxHP: [3]int{41, 45, 45}, Experience: [3]int{37, 40, 40}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 25, Lightning: 0, Cold: 0, Poison: 105}}},
+	17:  {ID: 17, Level: [3]int{16, 45, 76}, MinHP: [3]int{21, 20, 20}, MaxHP: [3]int{45, 45, 45}, Experience: [3]int{37, 40, 40}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 60}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 80}, {Physical: 0, Magic: 0, Fire: 25, Lightning: 0, Cold: 0, Poison: 110}}},
+	18:  {ID: 18, Level: [3]int{22, 49, 79}, MinHP: [3]int{22, 20, 20}, MaxHP: [3]int{44, 45, 45}, Experience: [3]int{36, 40, 40}, Resistances: [3]Resistances{{Physical: 30, Magic: 0, Fire: 20, Lightning: 60, Cold: 0, Poison: 0}, {Physical: 40, Magic: 0, Fire: 50, Lightning: 80, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 50, Lightning: 100, Cold: 0, Poison: 0}}},
+	19:  {ID: 19, Level: [3]int{1, 36, 67}, MinHP: [3]int{21, 25, 25}, MaxHP: [3]int{61, 55, 55}, Experience: [3]int{61, 65, 65}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 15, Magic: 0, Fire: 100, Lightning: 0, Cold: 40, Poison: 0}}},
+	20:  {ID: 20, Level: [3]int{5, 38, 68}, MinHP: [3]int{24, 25, 25}, MaxHP: [3]int{54, 55, 55}, Experience: [3]int{61, 65, 65}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 15, Magic: 0, Fire: 110, Lightning: 0, Cold: 40, Poison: 0}}},
+	21:  {ID: 21, Level: [3]int{7, 39, 85}, MinHP: [3]int{18, 25, 25}, MaxHP: [3]int{53, 55, 55}, Experience: [3]int{59, 65, 65}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 15, Magic: 0, Fire: 120, Lightning: 0, Cold: 40, Poison: 0}}},
+	22:  {ID: 22, Level: [3]int{10, 41, 71}, MinHP: [3]int{23, 25, 25}, MaxHP: [3]int{55, 55, 55}, Experience: [3]int{60, 65, 65}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 15, Magic: 0, Fire: 125, Lightning: 0, Cold: 40, Poison: 0}}},
+	23:  {ID: 23, Level: [3]int{40, 55, 83}, MinHP: [3]int{21, 25, 25}, MaxHP: [3]int{55, 55, 55}, Experience: [3]int{93, 65, 65}, Resistances: [3]Resistances{{Physical: 50, Magic: 50, Fire: 50, Lightning: 50, Cold: 50, Poison: 50}, {Physical: 66, Magic: 66, Fire: 66, Lightning: 66, Cold: 66, Poison: 66}, {Physical: 25, Magic: 75, Fire: 75, Lightning: 75, Cold: 75, Poison: 75}}},
+	24:  {ID: 24, Level: [3]int{5, 38, 69}, MinHP: [3]int{139, 145, 145}, MaxHP: [3]int{224, 210, 210}, Experience: [3]int{121, 120, 120}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 50, Magic: 0, Fire: 0, Lightning: 0, Cold: 120, Poison: 0}}},
+	25:  {ID: 25, Level: [3]int{9, 40, 70}, MinHP: [3]int{146, 145, 145}, MaxHP: [3]int{236, 210, 210}, Experience: [3]int{121, 120, 120}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 20, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 60, Poison: 0}, {Physical: 50, Magic: 0, Fire: 0, Lightning: 0, Cold: 130, Poison: 0}}},
+	26:  {ID: 26, Level: [3]int{19, 48, 79}, MinHP: [3]int{143, 145, 145}, MaxHP: [3]int{231, 210, 210}, Experience: [3]int{120, 120, 120}, Resistances: [3]Resistances{{Physical: 0, Magic: 60, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 50, Magic: 0, Fire: 0, Lightning: 50, Cold: 140, Poison: 0}}},
+	27:  {ID: 27, Level: [3]int{23, 53, 84}, MinHP: [3]int{142, 145, 145}, MaxHP: [3]int{230, 210, 210}, Experience: [3]int{121, 120, 120}, Resistances: [3]Resistances{{Physical: 0, Magic: 60, Fire: 20, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 80, Fire: 50, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 50, Magic: 100, Fire: 75, Lightning: 0, Cold: 0, Poison: 0}}},
+	28:  {ID: 28, Level: [3]int{2, 36, 68}, MinHP: [3]int{129, 145, 145}, MaxHP: [3]int{215, 210, 210}, Experience: [3]int{121, 120, 120}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 50, Magic: 0, Fire: 0, Lightning: 0, Cold: 100, Poison: 0}}},
+	29:  {ID: 29, Level: [3]int{13, 43, 74}, MinHP: [3]int{136, 135, 135}, MaxHP: [3]int{211, 180, 180}, Experience: [3]int{117, 130, 130}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 25, Magic: 0, Fire: 100, Lightning: 50, Cold: 0, Poison: 33}}},
+	30:  {ID: 30, Level: [3]int{17, 46, 77}, MinHP: [3]int{134, 135, 135}, MaxHP: [3]int{212, 180, 180}, Experience: [3]int{117, 130, 130}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 25, Magic: 0, Fire: 50, Lightning: 0, Cold: 100, Poison: 33}}},
+	31:  {ID: 31, Level: [3]int{18, 47, 78}, MinHP: [3]int{135, 135, 135}, MaxHP: [3]int{211, 180, 180}, Experience: [3]int{117, 130, 130}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 25, Magic: 0, Fire: 110, Lightning: 50, Cold: 0, Poison: 33}}},
+	32:  {ID: 32, Level: [3]int{24, 53, 81}, MinHP: [3]int{135, 135, 135}, MaxHP: [3]int{211, 180, 180}, Experience: [3]int{116, 130, 130}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 25, Magic: 0, Fire: 50, Lightning: 0, Cold: 110, Poison: 33}}},
+	33:  {ID: 33, Level: [3]int{26, 59, 84}, MinHP: [3]int{134, 135, 135}, MaxHP: [3]int{210, 180, 180}, Experience: [3]int{123, 130, 130}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 25, Magic: 0, Fire: 120, Lightning: 50, Cold: 0, Poison: 33}}},
+	34:  {ID: 34, Level: [3]int{0, 0, 0}, MinHP: [3]int{0, 0, 0}, MaxHP: [3]int{0, 0, 0}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	35:  {ID: 35, Level: [3]int{0, 0, 0}, MinHP: [3]int{0, 0, 0}, MaxHP: [3]int{0, 0, 0}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	36:  {ID: 36, Level: [3]int{0, 0, 0}, MinHP: [3]int{0, 0, 0}, MaxHP: [3]int{0, 0, 0}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	37:  {ID: 37, Level: [3]int{0, 0, 0}, MinHP: [3]int{0, 0, 0}, MaxHP: [3]int{0, 0, 0}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	38:  {ID: 38, Level: [3]int{7, 38, 75}, MinHP: [3]int{83, 65, 65}, MaxHP: [3]int{165, 90, 90}, Experience: [3]int{93, 105, 105}, Resistances: [3]Resistances{{Physical: 20, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 50}, {Physical: 30, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 60}, {Physical: 100, Magic: 50, Fire: 0, Lightning: 0, Cold: 50, Poison: 75}}},
+	39:  {ID: 39, Level: [3]int{10, 41, 71}, MinHP: [3]int{87, 65, 65}, MaxHP: [3]int{173, 90, 90}, Experience: [3]int{92, 105, 105}, Resistances: [3]Resistances{{Physical: 30, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 50}, {Physical: 40, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 60}, {Physical: 100, Magic: 50, Fire: 0, Lightning: 0, Cold: 60, Poison: 75}}},
+	40:  {ID: 40, Level: [3]int{19, 48, 79}, MinHP: [3]int{86, 65, 65}, MaxHP: [3]int{173, 90, 90}, Experience: [3]int{92, 105, 105}, Resistances: [3]Resistances{{Physical: 40, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 50}, {Physical: 50, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 60}, {Physical: 100, Magic: 50, Fire: 0, Lightning: 0, Cold: 70, Poison: 75}}},
+	41:  {ID: 41, Level: [3]int{20, 49, 80}, MinHP: [3]int{86, 65, 65}, MaxHP: [3]int{172, 90, 90}, Experience: [3]int{93, 105, 105}, Resistances: [3]Resistances{{Physical: 50, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 50}, {Physical: 60, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 60}, {Physical: 100, Magic: 50, Fire: 0, Lightning: 0, Cold: 75, Poison: 75}}},
+	42:  {ID: 42, Level: [3]int{22, 51, 81}, MinHP: [3]int{85, 65, 65}, MaxHP: [3]int{172, 90, 90}, Experience: [3]int{93, 105, 105}, Resistances: [3]Resistances{{Physical: 60, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 50}, {Physical: 75, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 60}, {Physical: 100, Magic: 50, Fire: 0, Lightning: 0, Cold: 80, Poison: 75}}},
+	43:  {ID: 43, Level: [3]int{2, 36, 67}, MinHP: [3]int{58, 60, 60}, MaxHP: [3]int{101, 100, 100}, Experience: [3]int{78, 95, 95}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 20, Magic: 20, Fire: 33, Lightning: 33, Cold: 100, Poison: 20}}},
+	44:  {ID: 44, Level: [3]int{5, 38, 68}, MinHP: [3]int{62, 60, 60}, MaxHP: [3]int{101, 100, 100}, Experience: [3]int{78, 95, 95}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 20, Magic: 20, Fire: 33, Lightning: 33, Cold: 120, Poison: 20}}},
+	45:  {ID: 45, Level: [3]int{8, 39, 69}, MinHP: [3]int{67, 60, 60}, MaxHP: [3]int{112, 100, 100}, Experience: [3]int{79, 95, 95}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 20, Magic: 20, Fire: 33, Lightning: 33, Cold: 130, Poison: 20}}},
+	46:  {ID: 46, Level: [3]int{9, 40, 70}, MinHP: [3]int{66, 60, 60}, MaxHP: [3]int{111, 100, 100}, Experience: [3]int{79, 95, 95}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 20, Magic: 20, Fire: 33, Lightning: 33, Cold: 140, Poison: 20}}},
+	47:  {ID: 47, Level: [3]int{23, 53, 84}, MinHP: [3]int{65, 60, 60}, MaxHP: [3]int{107, 100, 100}, Experience: [3]int{78, 95, 95}, Resistances: [3]Resistances{{Physical: 20, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 20, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 40, Magic: 20, Fire: 33, Lightning: 33, Cold: 150, Poison: 20}}},
+	48:  {ID: 48, Level: [3]int{18, 47, 78}, MinHP: [3]int{108, 90, 90}, MaxHP: [3]int{151, 135, 135}, Experience: [3]int{96, 110, 110}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 33, Magic: 0, Fire: 0, Lightning: 100, Cold: 50, Poison: 25}}},
+	49:  {ID: 49, Level: [3]int{18, 55, 83}, MinHP: [3]int{108, 90, 90}, MaxHP: [3]int{151, 135, 135}, Experience: [3]int{96, 110, 110}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 33, Magic: 0, Fire: 0, Lightning: 100, Cold: 50, Poison: 25}}},
+	50:  {ID: 50, Level: [3]int{21, 49, 79}, MinHP: [3]int{107, 90, 90}, MaxHP: [3]int{151, 135, 135}, Experience: [3]int{97, 110, 110}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 45, Magic: 0, Fire: 0, Lightning: 105, Cold: 50, Poison: 25}}},
+	51:  {ID: 51, Level: [3]int{22, 52, 80}, MinHP: [3]int{107, 90, 90}, MaxHP: [3]int{151, 135, 135}, Experience: [3]int{96, 110, 110}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 45, Magic: 0, Fire: 0, Lightning: 105, Cold: 50, Poison: 25}}},
+	52:  {ID: 52, Level: [3]int{24, 53, 81}, MinHP: [3]int{107, 90, 90}, MaxHP: [3]int{151, 135, 135}, Experience: [3]int{97, 110, 110}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 50, Magic: 0, Fire: 0, Lightning: 110, Cold: 50, Poison: 25}}},
+	53:  {ID: 53, Level: [3]int{4, 37, 68}, MinHP: [3]int{110, 100, 100}, MaxHP: [3]int{137, 130, 130}, Experience: [3]int{91, 90, 90}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 50, Magic: 0, Fire: 0, Lightning: 0, Cold: 100, Poison: 0}}},
+	54:  {ID: 54, Level: [3]int{6, 38, 69}, MinHP: [3]int{114, 100, 100}, MaxHP: [3]int{141, 130, 130}, Experience: [3]int{91, 90, 90}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 50, Magic: 0, Fire: 0, Lightning: 110, Cold: 0, Poison: 0}}},
+	55:  {ID: 55, Level: [3]int{7, 38, 75}, MinHP: [3]int{112, 100, 100}, MaxHP: [3]int{136, 130, 130}, Experience: [3]int{91, 90, 90}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 50, Magic: 0, Fire: 100, Lightning: 0, Cold: 0, Poison: 0}}},
+	56:  {ID: 56, Level: [3]int{19, 48, 79}, MinHP: [3]int{116, 100, 100}, MaxHP: [3]int{143, 130, 130}, Experience: [3]int{90, 90, 90}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 40, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 70, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 50, Magic: 0, Fire: 110, Lightning: 0, Cold: 0, Poison: 0}}},
+	57:  {ID: 57, Level: [3]int{10, 40, 70}, MinHP: [3]int{119, 100, 100}, MaxHP: [3]int{146, 130, 130}, Experience: [3]int{91, 90, 90}, Resistances: [3]Resistances{{Physical: 0, Magic: 30, Fire: 20, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 50, Fire: 50, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 50, Magic: 50, Fire: 75, Lightning: 0, Cold: 0, Poison: 0}}},
+	58:  {ID: 58, Level: [3]int{2, 36, 68}, MinHP: [3]int{58, 55, 55}, MaxHP: [3]int{101, 90, 90}, Experience: [3]int{81, 150, 150}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 25, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 50, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 15, Magic: 0, Fire: 100, Lightning: 0, Cold: 0, Poison: 0}}},
+	59:  {ID: 59, Level: [3]int{6, 38, 69}, MinHP: [3]int{61, 55, 55}, MaxHP: [3]int{101, 90, 90}, Experience: [3]int{81, 150, 150}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 30, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 60, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 15, Magic: 0, Fire: 105, Lightning: 0, Cold: 0, Poison: 0}}},
+	60:  {ID: 60, Level: [3]int{9, 40, 70}, MinHP: [3]int{66, 55, 55}, MaxHP: [3]int{111, 90, 90}, Experience: [3]int{81, 150, 150}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 35, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 70, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 15, Magic: 0, Fire: 110, Lightning: 0, Cold: 0, Poison: 0}}},
+	61:  {ID: 61, Level: [3]int{11, 42, 72}, MinHP: [3]int{67, 55, 55}, MaxHP: [3]int{109, 90, 90}, Experience: [3]int{81, 150, 150}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 40, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 80, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 15, Magic: 0, Fire: 115, Lightning: 0, Cold: 0, Poison: 0}}},
+	62:  {ID: 62, Level: [3]int{40, 55, 83}, MinHP: [3]int{65, 55, 55}, MaxHP: [3]int{107, 90, 90}, Experience: [3]int{125, 150, 150}, Resistances: [3]Resistances{{Physical: 0, Magic: 20, Fire: 70, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 50, Fire: 100, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 25, Magic: 50, Fire: 120, Lightning: 0, Cold: 0, Poison: 0}}},
+	63:  {ID: 63, Level: [3]int{1, 36, 67}, MinHP: [3]int{21, 20, 20}, MaxHP: [3]int{81, 70, 70}, Experience: [3]int{71, 70, 70}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 50, Magic: 0, Fire: 0, Lightning: 0, Cold: 50, Poison: 0}}},
+	64:  {ID: 64, Level: [3]int{5, 38, 68}, MinHP: [3]int{24, 20, 20}, MaxHP: [3]int{85, 70, 70}, Experience: [3]int{71, 70, 70}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 50, Magic: 0, Fire: 0, Lightning: 0, Cold: 50, Poison: 0}}},
+	65:  {ID: 65, Level: [3]int{8, 39, 69}, MinHP: [3]int{23, 20, 20}, MaxHP: [3]int{89, 70, 70}, Experience: [3]int{71, 70, 70}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 50, Magic: 0, Fire: 0, Lightning: 0, Cold: 50, Poison: 0}}},
+	66:  {ID: 66, Level: [3]int{9, 40, 70}, MinHP: [3]int{21, 20, 20}, MaxHP: [3]int{86, 70, 70}, Experience: [3]int{71, 70, 70}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 50, Magic: 0, Fire: 0, Lightning: 0, Cold: 50, Poison: 0}}},
+	67:  {ID: 67, Level: [3]int{18, 48, 79}, MinHP: [3]int{22, 20, 20}, MaxHP: [3]int{87, 70, 70}, Experience: [3]int{71, 70, 70}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 50, Magic: 0, Fire: 0, Lightning: 0, Cold: 50, Poison: 0}}},
+	68:  {ID: 68, Level: [3]int{16, 45, 76}, MinHP: [3]int{139, 110, 110}, MaxHP: [3]int{174, 150, 150}, Experience: [3]int{117, 125, 125}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 66, Magic: 0, Fire: 33, Lightning: 0, Cold: 0, Poison: 105}}},
+	69:  {ID: 69, Level: [3]int{17, 45, 84}, MinHP: [3]int{139, 110, 110}, MaxHP: [3]int{173, 150, 150}, Experience: [3]int{117, 125, 125}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 66, Magic: 0, Fire: 33, Lightning: 0, Cold: 0, Poison: 110}}},
+	70:  {ID: 70, Level: [3]int{19, 48, 79}, MinHP: [3]int{141, 110, 110}, MaxHP: [3]int{173, 150, 150}, Experience: [3]int{116, 125, 125}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 66, Magic: 0, Fire: 33, Lightning: 0, Cold: 0, Poison: 115}}},
+	71:  {ID: 71, Level: [3]int{21, 50, 79}, MinHP: [3]int{139, 110, 110}, MaxHP: [3]int{171, 150, 150}, Experience: [3]int{117, 125, 125}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 66, Magic: 0, Fire: 33, Lightning: 0, Cold: 0, Poison: 120}}},
+	72:  {ID: 72, Level: [3]int{27, 57, 85}, MinHP: [3]int{140, 110, 110}, MaxHP: [3]int{172, 150, 150}, Experience: [3]int{127, 125, 125}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 66, Magic: 0, Fire: 33, Lightning: 0, Cold: 0, Poison: 125}}},
+	73:  {ID: 73, Level: [3]int{11, 43, 75}, MinHP: [3]int{67, 75, 75}, MaxHP: [3]int{109, 110, 110}, Experience: [3]int{78, 100, 100}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 40, Magic: 0, Fire: 33, Lightning: 0, Cold: 100, Poison: 0}}},
+	74:  {ID: 74, Level: [3]int{15, 45, 76}, MinHP: [3]int{66, 75, 75}, MaxHP: [3]int{107, 110, 110}, Experience: [3]int{78, 100, 100}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 20, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 40, Poison: 0}, {Physical: 40, Magic: 0, Fire: 33, Lightning: 0, Cold: 120, Poison: 0}}},
+	75:  {ID: 75, Level: [3]int{18, 46, 77}, MinHP: [3]int{66, 75, 75}, MaxHP: [3]int{108, 110, 110}, Experience: [3]int{78, 100, 100}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 40, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 70, Poison: 0}, {Physical: 40, Magic: 0, Fire: 33, Lightning: 0, Cold: 110, Poison: 0}}},
+	76:  {ID: 76, Level: [3]int{22, 51, 82}, MinHP: [3]int{66, 75, 75}, MaxHP: [3]int{107, 110, 110}, Experience: [3]int{78, 100, 100}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 60, Poison: 60}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 80, Poison: 60}, {Physical: 40, Magic: 0, Fire: 33, Lightning: 0, Cold: 130, Poison: 60}}},
+	77:  {ID: 77, Level: [3]int{24, 54, 85}, MinHP: [3]int{65, 75, 75}, MaxHP: [3]int{107, 110, 110}, Experience: [3]int{78, 100, 100}, Resistances: [3]Resistances{{Physical: 0, Magic: 40, Fire: 0, Lightning: 0, Cold: 80, Poison: 20}, {Physical: 0, Magic: 70, Fire: 0, Lightning: 0, Cold: 80, Poison: 60}, {Physical: 40, Magic: 70, Fire: 33, Lightning: 0, Cold: 120, Poison: 60}}},
+	78:  {ID: 78, Level: [3]int{14, 43, 75}, MinHP: [3]int{67, 40, 40}, MaxHP: [3]int{194, 80, 80}, Experience: [3]int{94, 95, 95}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 50, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 100, Lightning: 0, Cold: 0, Poison: 0}}},
+	79:  {ID: 79, Level: [3]int{15, 44, 76}, MinHP: [3]int{66, 40, 40}, MaxHP: [3]int{194, 80, 80}, Experience: [3]int{94, 95, 95}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 50, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 100, Poison: 0}}},
+	80:  {ID: 80, Level: [3]int{17, 46, 77}, MinHP: [3]int{64, 40, 40}, MaxHP: [3]int{192, 80, 80}, Experience: [3]int{94, 95, 95}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 40, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 60, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 105, Lightning: 0, Cold: 0, Poison: 0}}},
+	81:  {ID: 81, Level: [3]int{22, 52, 80}, MinHP: [3]int{66, 40, 40}, MaxHP: [3]int{192, 80, 80}, Experience: [3]int{94, 95, 95}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 20, Lightning: 40, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 70, Lightning: 70, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 80, Lightning: 100, Cold: 0, Poison: 0}}},
+	82:  {ID: 82, Level: [3]int{26, 56, 82}, MinHP: [3]int{65, 40, 40}, MaxHP: [3]int{193, 80, 80}, Experience: [3]int{99, 95, 95}, Resistances: [3]Resistances{{Physical: 20, Magic: 0, Fire: 60, Lightning: 40, Cold: 0, Poison: 0}, {Physical: 20, Magic: 0, Fire: 80, Lightning: 70, Cold: 0, Poison: 0}, {Physical: 25, Magic: 0, Fire: 80, Lightning: 100, Cold: 0, Poison: 0}}},
+	83:  {ID: 83, Level: [3]int{14, 43, 75}, MinHP: [3]int{57, 60, 60}, MaxHP: [3]int{97, 100, 100}, Experience: [3]int{71, 80, 80}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 15, Magic: 0, Fire: 0, Lightning: 0, Cold: 100, Poison: 0}}},
+	84:  {ID: 84, Level: [3]int{15, 44, 76}, MinHP: [3]int{57, 60, 60}, MaxHP: [3]int{97, 100, 100}, Experience: [3]int{70, 80, 80}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 15, Magic: 0, Fire: 100, Lightning: 0, Cold: 0, Poison: 0}}},
+	85:  {ID: 85, Level: [3]int{17, 46, 77}, MinHP: [3]int{59, 60, 60}, MaxHP: [3]int{95, 100, 100}, Experience: [3]int{71, 80, 80}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 15, Magic: 0, Fire: 0, Lightning: 100, Cold: 0, Poison: 0}}},
+	86:  {ID: 86, Level: [3]int{19, 48, 79}, MinHP: [3]int{58, 60, 60}, MaxHP: [3]int{96, 100, 100}, Experience: [3]int{70, 80, 80}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 15, Magic: 0, Fire: 0, Lightning: 0, Cold: 120, Poison: 0}}},
+	87:  {ID: 87, Level: [3]int{16, 45, 76}, MinHP: [3]int{21, 20, 20}, MaxHP: [3]int{45, 40, 40}, Experience: [3]int{61, 60, 60}, Resistances: [3]Resistances{{Physical: 60, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 70, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 100, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	88:  {ID: 88, Level: [3]int{17, 45, 84}, MinHP: [3]int{23, 20, 20}, MaxHP: [3]int{45, 40, 40}, Experience: [3]int{61, 60, 60}, Resistances: [3]Resistances{{Physical: 70, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 80, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 100, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	89:  {ID: 89, Level: [3]int{21, 48, 79}, MinHP: [3]int{21, 20, 20}, MaxHP: [3]int{44, 40, 40}, Experience: [3]int{61, 60, 60}, Resistances: [3]Resistances{{Physical: 80, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 90, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 100, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	90:  {ID: 90, Level: [3]int{22, 52, 81}, MinHP: [3]int{22, 20, 20}, MaxHP: [3]int{44, 40, 40}, Experience: [3]int{61, 60, 60}, Resistances: [3]Resistances{{Physical: 90, Magic: 0, Fire: 0, Lightning: 40, Cold: 0, Poison: 0}, {Physical: 90, Magic: 0, Fire: 0, Lightning: 70, Cold: 0, Poison: 0}, {Physical: 100, Magic: 0, Fire: 0, Lightning: 70, Cold: 0, Poison: 0}}},
+	91:  {ID: 91, Level: [3]int{14, 43, 75}, MinHP: [3]int{67, 70, 70}, MaxHP: [3]int{111, 110, 110}, Experience: [3]int{125, 125, 125}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 50, Magic: 0, Fire: 0, Lightning: 100, Cold: 0, Poison: 0}}},
+	92:  {ID: 92, Level: [3]int{16, 45, 76}, MinHP: [3]int{68, 70, 70}, MaxHP: [3]int{112, 110, 110}, Experience: [3]int{125, 125, 125}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 50, Magic: 0, Fire: 0, Lightning: 105, Cold: 0, Poison: 0}}},
+	93:  {ID: 93, Level: [3]int{17, 45, 84}, MinHP: [3]int{67, 70, 70}, MaxHP: [3]int{112, 110, 110}, Experience: [3]int{125, 125, 125}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 50, Magic: 0, Fire: 0, Lightning: 110, Cold: 0, Poison: 0}}},
+	94:  {ID: 94, Level: [3]int{19, 48, 79}, MinHP: [3]int{68, 70, 70}, MaxHP: [3]int{111, 110, 110}, Experience: [3]int{125, 125, 125}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 50, Magic: 0, Fire: 0, Lightning: 115, Cold: 0, Poison: 0}}},
+	95:  {ID: 95, Level: [3]int{24, 54, 85}, MinHP: [3]int{67, 70, 70}, MaxHP: [3]int{111, 110, 110}, Experience: [3]int{125, 125, 125}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 50, Magic: 0, Fire: 0, Lightning: 120, Cold: 0, Poison: 0}}},
+	96:  {ID: 96, Level: [3]int{13, 43, 74}, MinHP: [3]int{68, 100, 100}, MaxHP: [3]int{158, 140, 140}, Experience: [3]int{86, 100, 100}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 70}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 80}, {Physical: 50, Magic: 0, Fire: 0, Lightning: 33, Cold: 33, Poison: 100}}},
+	97:  {ID: 97, Level: [3]int{15, 44, 79}, MinHP: [3]int{69, 100, 100}, MaxHP: [3]int{157, 140, 140}, Experience: [3]int{87, 100, 100}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 70}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 80}, {Physical: 50, Magic: 0, Fire: 0, Lightning: 33, Cold: 33, Poison: 100}}},
+	98:  {ID: 98, Level: [3]int{18, 46, 77}, MinHP: [3]int{69, 100, 100}, MaxHP: [3]int{156, 140, 140}, Experience: [3]int{86, 100, 100}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 70}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 80}, {Physical: 50, Magic: 0, Fire: 0, Lightning: 33, Cold: 33, Poison: 105}}},
+	99:  {ID: 99, Level: [3]int{23, 49, 80}, MinHP: [3]int{69, 100, 100}, MaxHP: [3]int{155, 140, 140}, Experience: [3]int{86, 100, 100}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 70}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 80}, {Physical: 50, Magic: 0, Fire: 0, Lightning: 33, Cold: 33, Poison: 105}}},
+	100: {ID: 100, Level: [3]int{25, 55, 83}, MinHP: [3]int{70, 100, 100}, MaxHP: [3]int{154, 140, 140}, Experience: [3]int{89, 100, 100}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 70}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 80}, {Physical: 50, Magic: 0, Fire: 0, Lightning: 33, Cold: 33, Poison: 110}}},
+	101: {ID: 101, Level: [3]int{15, 44, 79}, MinHP: [3]int{194, 170, 170}, MaxHP: [3]int{238, 210, 210}, Experience: [3]int{136, 300, 300}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 50}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 50}, {Physical: 33, Magic: 20, Fire: 20, Lightning: 100, Cold: 20, Poison: 50}}},
+	102: {ID: 102, Level: [3]int{18, 46, 77}, MinHP: [3]int{193, 170, 170}, MaxHP: [3]int{237, 210, 210}, Experience: [3]int{136, 300, 300}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 50}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 50}, {Physical: 33, Magic: 100, Fire: 100, Lightning: 20, Cold: 20, Poison: 50}}},
+	103: {ID: 103, Level: [3]int{20, 49, 80}, MinHP: [3]int{193, 170, 170}, MaxHP: [3]int{236, 210, 210}, Experience: [3]int{136, 300, 300}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 50}, {Physical: 0, Magic: 50, Fire: 0, Lightning: 0, Cold: 0, Poison: 50}, {Physical: 33, Magic: 100, Fire: 20, Lightning: 20, Cold: 100, Poison: 50}}},
+	104: {ID: 104, Level: [3]int{23, 52, 84}, MinHP: [3]int{192, 170, 170}, MaxHP: [3]int{236, 210, 210}, Experience: [3]int{136, 300, 300}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 50}, {Physical: 0, Magic: 25, Fire: 25, Lightning: 25, Cold: 25, Poison: 50}, {Physical: 33, Magic: 100, Fire: 50, Lightning: 50, Cold: 50, Poison: 50}}},
+	105: {ID: 105, Level: [3]int{40, 64, 85}, MinHP: [3]int{192, 170, 170}, MaxHP: [3]int{235, 210, 210}, Experience: [3]int{211, 300, 300}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 50}, {Physical: 0, Magic: 25, Fire: 25, Lightning: 25, Cold: 25, Poison: 50}, {Physical: 33, Magic: 100, Fire: 66, Lightning: 66, Cold: 66, Poison: 50}}},
+	106: {ID: 106, Level: [3]int{0, 0, 0}, MinHP: [3]int{0, 0, 0}, MaxHP: [3]int{0, 0, 0}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	107: {ID: 107, Level: [3]int{0, 0, 0}, MinHP: [3]int{0, 0, 0}, MaxHP: [3]int{0, 0, 0}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	108: {ID: 108, Level: [3]int{0, 0, 0}, MinHP: [3]int{0, 0, 0}, MaxHP: [3]int{0, 0, 0}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	109: {ID: 109, Level: [3]int{0, 0, 0}, MinHP: [3]int{0, 0, 0}, MaxHP: [3]int{0, 0, 0}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	110: {ID: 110, Level: [3]int{14, 43, 75}, MinHP: [3]int{67, 60, 60}, MaxHP: [3]int{107, 90, 90}, Experience: [3]int{83, 80, 80}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 25, Lightning: 0, Cold: 0, Poison: 110}}},
+	111: {ID: 111, Level: [3]int{15, 44, 76}, MinHP: [3]int{66, 60, 60}, MaxHP: [3]int{107, 90, 90}, Experience: [3]int{83, 80, 80}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 25, Lightning: 100, Cold: 0, Poison: 25}}},
+	112: {ID: 112, Level: [3]int{22, 52, 80}, MinHP: [3]int{66, 60, 60}, MaxHP: [3]int{107, 90, 90}, Experience: [3]int{83, 80, 80}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 100, Lightning: 0, Cold: 0, Poison: 25}}},
+	113: {ID: 113, Level: [3]int{23, 50, 81}, MinHP: [3]int{65, 60, 60}, MaxHP: [3]int{107, 90, 90}, Experience: [3]int{83, 80, 80}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 25, Lightning: 100, Cold: 0, Poison: 25}}},
+	114: {ID: 114, Level: [3]int{21, 49, 79}, MinHP: [3]int{21, 20, 20}, MaxHP: [3]int{107, 100, 100}, Experience: [3]int{74, 75, 75}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 33, Cold: 0, Poison: 105}}},
+	115: {ID: 115, Level: [3]int{23, 51, 82}, MinHP: [3]int{21, 20, 20}, MaxHP: [3]int{107, 100, 100}, Experience: [3]int{74, 75, 75}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 33, Cold: 0, Poison: 110}}},
+	116: {ID: 116, Level: [3]int{22, 50, 81}, MinHP: [3]int{22, 20, 20}, MaxHP: [3]int{107, 100, 100}, Experience: [3]int{74, 75, 75}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 33, Cold: 0, Poison: 115}}},
+	117: {ID: 117, Level: [3]int{24, 53, 85}, MinHP: [3]int{21, 20, 20}, MaxHP: [3]int{107, 100, 100}, Experience: [3]int{74, 75, 75}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 33, Cold: 0, Poison: 120}}},
+	118: {ID: 118, Level: [3]int{21, 50, 80}, MinHP: [3]int{44, 45, 45}, MaxHP: [3]int{87, 85, 85}, Experience: [3]int{74, 120, 120}, Resistances: [3]Resistances{{Physical: 40, Magic: 0, Fire: 0, Lightning: 50, Cold: 0, Poison: 0}, {Physical: 40, Magic: 0, Fire: 0, Lightning: 70, Cold: 0, Poison: 0}, {Physical: 60, Magic: 0, Fire: 0, Lightning: 100, Cold: 0, Poison: 0}}},
+	119: {ID: 119, Level: [3]int{23, 53, 81}, MinHP: [3]int{44, 45, 45}, MaxHP: [3]int{86, 85, 85}, Experience: [3]int{74, 120, 120}, Resistances: [3]Resistances{{Physical: 50, Magic: 0, Fire: 0, Lightning: 60, Cold: 0, Poison: 0}, {Physical: 50, Magic: 0, Fire: 0, Lightning: 80, Cold: 0, Poison: 0}, {Physical: 70, Magic: 0, Fire: 0, Lightning: 105, Cold: 0, Poison: 0}}},
+	120: {ID: 120, Level: [3]int{26, 56, 83}, MinHP: [3]int{45, 45, 45}, MaxHP: [3]int{86, 85, 85}, Experience: [3]int{78, 120, 120}, Resistances: [3]Resistances{{Physical: 60, Magic: 0, Fire: 0, Lightning: 70, Cold: 0, Poison: 0}, {Physical: 60, Magic: 0, Fire: 0, Lightning: 85, Cold: 0, Poison: 0}, {Physical: 80, Magic: 0, Fire: 0, Lightning: 110, Cold: 0, Poison: 0}}},
+	121: {ID: 121, Level: [3]int{28, 58, 85}, MinHP: [3]int{44, 45, 45}, MaxHP: [3]int{87, 85, 85}, Experience: [3]int{82, 120, 120}, Resistances: [3]Resistances{{Physical: 70, Magic: 0, Fire: 0, Lightning: 80, Cold: 0, Poison: 0}, {Physical: 70, Magic: 0, Fire: 0, Lightning: 100, Cold: 0, Poison: 0}, {Physical: 90, Magic: 0, Fire: 0, Lightning: 115, Cold: 0, Poison: 0}}},
+	122: {ID: 122, Level: [3]int{11, 42, 73}, MinHP: [3]int{155, 150, 150}, MaxHP: [3]int{192, 190, 190}, Experience: [3]int{114, 110, 110}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 50, Magic: 0, Fire: 110, Lightning: 0, Cold: 0, Poison: 50}}},
+	123: {ID: 123, Level: [3]int{15, 45, 75}, MinHP: [3]int{151, 150, 150}, MaxHP: [3]int{191, 190, 190}, Experience: [3]int{113, 110, 110}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 50, Magic: 0, Fire: 0, Lightning: 105, Cold: 0, Poison: 55}}},
+	124: {ID: 124, Level: [3]int{21, 49, 78}, MinHP: [3]int{151, 150, 150}, MaxHP: [3]int{191, 190, 190}, Experience: [3]int{113, 110, 110}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 50, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 110}}},
+	125: {ID: 125, Level: [3]int{22, 50, 79}, MinHP: [3]int{151, 150, 150}, MaxHP: [3]int{192, 190, 190}, Experience: [3]int{113, 110, 110}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 50, Magic: 0, Fire: 115, Lightning: 0, Cold: 0, Poison: 60}}},
+	126: {ID: 126, Level: [3]int{23, 53, 84}, MinHP: [3]int{151, 150, 150}, MaxHP: [3]int{190, 190, 190}, Experience: [3]int{114, 110, 110}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 50, Magic: 0, Fire: 120, Lightning: 0, Cold: 0, Poison: 65}}},
+	127: {ID: 127, Level: [3]int{21, 49, 79}, MinHP: [3]int{153, 190, 190}, MaxHP: [3]int{248, 230, 230}, Experience: [3]int{123, 130, 130}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 25, Magic: 0, Fire: 0, Lightning: 0, Cold: 120, Poison: 0}}},
+	128: {ID: 128, Level: [3]int{22, 50, 80}, MinHP: [3]int{153, 190, 190}, MaxHP: [3]int{248, 230, 230}, Experience: [3]int{123, 130, 130}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 25, Magic: 0, Fire: 0, Lightning: 0, Cold: 130, Poison: 0}}},
+	129: {ID: 129, Level: [3]int{23, 52, 81}, MinHP: [3]int{153, 190, 190}, MaxHP: [3]int{248, 230, 230}, Experience: [3]int{123, 130, 130}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 25, Magic: 0, Fire: 0, Lightning: 0, Cold: 140, Poison: 0}}},
+	130: {ID: 130, Level: [3]int{27, 57, 84}, MinHP: [3]int{152, 190, 190}, MaxHP: [3]int{247, 230, 230}, Experience: [3]int{133, 130, 130}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 25, Magic: 0, Fire: 0, Lightning: 0, Cold: 150, Poison: 0}}},
+	131: {ID: 131, Level: [3]int{19, 49, 80}, MinHP: [3]int{153, 150, 150}, MaxHP: [3]int{211, 200, 200}, Experience: [3]int{110, 150, 150}, Resistances: [3]Resistances{{Physical: 30, Magic: 0, Fire: 0, Lightning: 0, Cold: 50, Poison: 0}, {Physical: 30, Magic: 0, Fire: 0, Lightning: 0, Cold: 50, Poison: 50}, {Physical: 30, Magic: 0, Fire: 33, Lightning: 25, Cold: 110, Poison: 50}}},
+	132: {ID: 132, Level: [3]int{23, 54, 82}, MinHP: [3]int{153, 150, 150}, MaxHP: [3]int{209, 200, 200}, Experience: [3]int{110, 150, 150}, Resistances: [3]Resistances{{Physical: 40, Magic: 0, Fire: 0, Lightning: 0, Cold: 50, Poison: 0}, {Physical: 35, Magic: 0, Fire: 0, Lightning: 0, Cold: 50, Poison: 50}, {Physical: 35, Magic: 0, Fire: 33, Lightning: 25, Cold: 120, Poison: 50}}},
+	133: {ID: 133, Level: [3]int{24, 55, 83}, MinHP: [3]int{153, 150, 150}, MaxHP: [3]int{211, 200, 200}, Experience: [3]int{110, 150, 150}, Resistances: [3]Resistances{{Physical: 50, Magic: 0, Fire: 0, Lightning: 0, Cold: 50, Poison: 0}, {Physical: 40, Magic: 0, Fire: 0, Lightning: 0, Cold: 50, Poison: 50}, {Physical: 40, Magic: 0, Fire: 33, Lightning: 25, Cold: 130, Poison: 50}}},
+	134: {ID: 134, Level: [3]int{25, 55, 83}, MinHP: [3]int{152, 150, 150}, MaxHP: [3]int{210, 200, 200}, Experience: [3]int{113, 150, 150}, Resistances: [3]Resistances{{Physical: 60, Magic: 0, Fire: 0, Lightning: 0, Cold: 50, Poison: 0}, {Physical: 45, Magic: 0, Fire: 0, Lightning: 0, Cold: 50, Poison: 50}, {Physical: 45, Magic: 0, Fire: 33, Lightning: 25, Cold: 140, Poison: 50}}},
+	135: {ID: 135, Level: [3]int{12, 43, 73}, MinHP: [3]int{154, 150, 150}, MaxHP: [3]int{212, 200, 200}, Experience: [3]int{111, 150, 150}, Resistances: [3]Resistances{{Physical: 20, Magic: 0, Fire: 0, Lightning: 0, Cold: 50, Poison: 0}, {Physical: 20, Magic: 0, Fire: 0, Lightning: 0, Cold: 50, Poison: 50}, {Physical: 50, Magic: 0, Fire: 33, Lightning: 25, Cold: 150, Poison: 50}}},
+	136: {ID: 136, Level: [3]int{15, 44, 79}, MinHP: [3]int{44, 70, 70}, MaxHP: [3]int{151, 110, 110}, Experience: [3]int{82, 80, 80}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 15, Magic: 25, Fire: 100, Lightning: 0, Cold: 0, Poison: 0}}},
+	137: {ID: 137, Level: [3]int{19, 50, 79}, MinHP: [3]int{46, 70, 70}, MaxHP: [3]int{151, 110, 110}, Experience: [3]int{82, 80, 80}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 15, Magic: 25, Fire: 0, Lightning: 110, Cold: 0, Poison: 0}}},
+	138: {ID: 138, Level: [3]int{22, 51, 81}, MinHP: [3]int{44, 70, 70}, MaxHP: [3]int{151, 110, 110}, Experience: [3]int{82, 80, 80}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 15, Magic: 25, Fire: 0, Lightning: 0, Cold: 100, Poison: 0}}},
+	139: {ID: 139, Level: [3]int{24, 54, 85}, MinHP: [3]int{45, 70, 70}, MaxHP: [3]int{151, 110, 110}, Experience: [3]int{82, 80, 80}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 15, Magic: 25, Fire: 105, Lightning: 0, Cold: 0, Poison: 0}}},
+	140: {ID: 140, Level: [3]int{27, 57, 84}, MinHP: [3]int{43, 70, 70}, MaxHP: [3]int{151, 110, 110}, Experience: [3]int{89, 80, 80}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 15, Magic: 25, Fire: 0, Lightning: 120, Cold: 0, Poison: 0}}},
+	141: {ID: 141, Level: [3]int{10, 42, 72}, MinHP: [3]int{64, 55, 55}, MaxHP: [3]int{110, 80, 80}, Experience: [3]int{80, 75, 75}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 100, Lightning: 33, Cold: 33, Poison: 50}}},
+	142: {ID: 142, Level: [3]int{21, 49, 79}, MinHP: [3]int{64, 55, 55}, MaxHP: [3]int{107, 80, 80}, Experience: [3]int{79, 75, 75}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 33, Lightning: 100, Cold: 33, Poison: 50}}},
+	143: {ID: 143, Level: [3]int{22, 50, 80}, MinHP: [3]int{66, 55, 55}, MaxHP: [3]int{107, 80, 80}, Experience: [3]int{79, 75, 75}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 33, Lightning: 33, Cold: 100, Poison: 50}}},
+	144: {ID: 144, Level: [3]int{23, 51, 81}, MinHP: [3]int{65, 55, 55}, MaxHP: [3]int{107, 80, 80}, Experience: [3]int{79, 75, 75}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 33, Lightning: 110, Cold: 33, Poison: 50}}},
+	145: {ID: 145, Level: [3]int{24, 54, 83}, MinHP: [3]int{65, 55, 55}, MaxHP: [3]int{107, 80, 80}, Experience: [3]int{79, 75, 75}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 100, Lightning: 33, Cold: 33, Poison: 50}}},
+	146: {ID: 146, Level: [3]int{0, 0, 0}, MinHP: [3]int{0, 0, 0}, MaxHP: [3]int{0, 0, 0}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	147: {ID: 147, Level: [3]int{0, 0, 0}, MinHP: [3]int{0, 0, 0}, MaxHP: [3]int{0, 0, 0}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	148: {ID: 148, Level: [3]int{0, 0, 0}, MinHP: [3]int{0, 0, 0}, MaxHP: [3]int{0, 0, 0}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	149: {ID: 149, Level: [3]int{0, 0, 0}, MinHP: [3]int{0, 0, 0}, MaxHP: [3]int{0, 0, 0}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	150: {ID: 150, Level: [3]int{0, 0, 0}, MinHP: [3]int{0, 0, 0}, MaxHP: [3]int{0, 0, 0}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	151: {ID: 151, Level: [3]int{0, 0, 0}, MinHP: [3]int{0, 0, 0}, MaxHP: [3]int{0, 0, 0}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	152: {ID: 152, Level: [3]int{0, 0, 0}, MinHP: [3]int{142, 180, 180}, MaxHP: [3]int{284, 210, 210}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	153: {ID: 153, Level: [3]int{0, 33, 66}, MinHP: [3]int{0, 0, 0}, MaxHP: [3]int{0, 0, 0}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	154: {ID: 154, Level: [3]int{0, 0, 0}, MinHP: [3]int{0, 0, 0}, MaxHP: [3]int{0, 0, 0}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	155: {ID: 155, Level: [3]int{0, 0, 0}, MinHP: [3]int{0, 0, 0}, MaxHP: [3]int{0, 0, 0}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	156: {ID: 156, Level: [3]int{12, 49, 75}, MinHP: [3]int{2562, 2562, 1193}, MaxHP: [3]int{2562, 2562, 1193}, Experience: [3]int{916, 1492, 1492}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: -50, Lightning: 50, Cold: 50, Poison: 80}, {Physical: 0, Magic: 0, Fire: -50, Lightning: 50, Cold: 50, Poison: 50}, {Physical: 66, Magic: 0, Fire: -50, Lightning: 66, Cold: 66, Poison: 66}}},
+	157: {ID: 157, Level: [3]int{0, 0, 0}, MinHP: [3]int{0, 0, 0}, MaxHP: [3]int{0, 0, 0}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	158: {ID: 158, Level: [3]int{0, 0, 0}, MinHP: [3]int{0, 0, 0}, MaxHP: [3]int{0, 0, 0}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	159: {ID: 159, Level: [3]int{0, 0, 0}, MinHP: [3]int{0, 0, 0}, MaxHP: [3]int{0, 0, 0}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	160: {ID: 160, Level: [3]int{4, 37, 68}, MinHP: [3]int{91, 55, 55}, MaxHP: [3]int{128, 95, 95}, Experience: [3]int{91, 100, 100}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 15, Magic: 0, Fire: 25, Lightning: 100, Cold: 25, Poison: 25}}},
+	161: {ID: 161, Level: [3]int{5, 38, 69}, MinHP: [3]int{93, 55, 55}, MaxHP: [3]int{131, 95, 95}, Experience: [3]int{91, 100, 100}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 15, Magic: 0, Fire: 110, Lightning: 25, Cold: 25, Poison: 25}}},
+	162: {ID: 162, Level: [3]int{7, 39, 83}, MinHP: [3]int{95, 55, 55}, MaxHP: [3]int{136, 95, 95}, Experience: [3]int{91, 100, 100}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 15, Magic: 0, Fire: 25, Lightning: 110, Cold: 25, Poison: 25}}},
+	163: {ID: 163, Level: [3]int{10, 41, 72}, MinHP: [3]int{96, 55, 55}, MaxHP: [3]int{141, 95, 95}, Experience: [3]int{91, 100, 100}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 15, Magic: 0, Fire: 25, Lightning: 115, Cold: 25, Poison: 25}}},
+	164: {ID: 164, Level: [3]int{24, 54, 85}, MinHP: [3]int{97, 55, 55}, MaxHP: [3]int{137, 95, 95}, Experience: [3]int{91, 100, 100}, Resistances: [3]Resistances{{Physical: 20, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 20, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 15, Magic: 0, Fire: 25, Lightning: 25, Cold: 130, Poison: 25}}},
+	165: {ID: 165, Level: [3]int{2, 36, 67}, MinHP: [3]int{86, 80, 80}, MaxHP: [3]int{129, 115, 115}, Experience: [3]int{91, 85, 85}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 45, Magic: 0, Fire: 25, Lightning: 100, Cold: 25, Poison: 25}}},
+	166: {ID: 166, Level: [3]int{5, 38, 68}, MinHP: [3]int{85, 80, 80}, MaxHP: [3]int{124, 115, 115}, Experience: [3]int{91, 85, 85}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 45, Magic: 0, Fire: 110, Lightning: 25, Cold: 25, Poison: 25}}},
+	167: {ID: 167, Level: [3]int{8, 39, 69}, MinHP: [3]int{89, 80, 80}, MaxHP: [3]int{134, 115, 115}, Experience: [3]int{91, 85, 85}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 45, Magic: 0, Fire: 25, Lightning: 110, Cold: 25, Poison: 25}}},
+	168: {ID: 168, Level: [3]int{9, 40, 71}, MinHP: [3]int{86, 80, 80}, MaxHP: [3]int{131, 115, 115}, Experience: [3]int{91, 85, 85}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 45, Magic: 0, Fire: 25, Lightning: 115, Cold: 25, Poison: 25}}},
+	169: {ID: 169, Level: [3]int{24, 53, 84}, MinHP: [3]int{87, 80, 80}, MaxHP: [3]int{131, 115, 115}, Experience: [3]int{90, 85, 85}, Resistances: [3]Resistances{{Physical: 20, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 20, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 45, Magic: 0, Fire: 25, Lightning: 25, Cold: 130, Poison: 25}}},
+	170: {ID: 170, Level: [3]int{5, 38, 68}, MinHP: [3]int{70, 70, 70}, MaxHP: [3]int{147, 100, 100}, Experience: [3]int{89, 95, 95}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 50}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 60}, {Physical: 40, Magic: 0, Fire: 33, Lightning: 0, Cold: 33, Poison: 100}}},
+	171: {ID: 171, Level: [3]int{8, 39, 69}, MinHP: [3]int{78, 70, 70}, MaxHP: [3]int{156, 100, 100}, Experience: [3]int{90, 95, 95}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 50}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 60}, {Physical: 40, Magic: 0, Fire: 33, Lightning: 0, Cold: 33, Poison: 105}}},
+	172: {ID: 172, Level: [3]int{9, 40, 70}, MinHP: [3]int{76, 70, 70}, MaxHP: [3]int{156, 100, 100}, Experience: [3]int{90, 95, 95}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 20, Poison: 50}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 50, Poison: 60}, {Physical: 45, Magic: 0, Fire: 33, Lightning: 0, Cold: 75, Poison: 105}}},
+	173: {ID: 173, Level: [3]int{13, 43, 74}, MinHP: [3]int{76, 70, 70}, MaxHP: [3]int{151, 100, 100}, Experience: [3]int{89, 95, 95}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 30, Lightning: 0, Cold: 0, Poison: 50}, {Physical: 0, Magic: 0, Fire: 50, Lightning: 0, Cold: 0, Poison: 60}, {Physical: 45, Magic: 0, Fire: 75, Lightning: 0, Cold: 33, Poison: 110}}},
+	174: {ID: 174, Level: [3]int{18, 47, 78}, MinHP: [3]int{74, 70, 70}, MaxHP: [3]int{151, 100, 100}, Experience: [3]int{90, 95, 95}, Resistances: [3]Resistances{{Physical: 0, Magic: 20, Fire: 0, Lightning: 40, Cold: 0, Poison: 50}, {Physical: 0, Magic: 50, Fire: 0, Lightning: 70, Cold: 0, Poison: 60}, {Physical: 50, Magic: 75, Fire: 0, Lightning: 75, Cold: 0, Poison: 110}}},
+	175: {ID: 175, Level: [3]int{0, 0, 0}, MinHP: [3]int{0, 0, 0}, MaxHP: [3]int{0, 0, 0}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	176: {ID: 176, Level: [3]int{0, 0, 0}, MinHP: [3]int{0, 0, 0}, MaxHP: [3]int{0, 0, 0}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	177: {ID: 177, Level: [3]int{0, 0, 0}, MinHP: [3]int{0, 0, 0}, MaxHP: [3]int{0, 0, 0}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	178: {ID: 178, Level: [3]int{0, 0, 0}, MinHP: [3]int{0, 0, 0}, MaxHP: [3]int{0, 0, 0}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	179: {ID: 179, Level: [3]int{0, 0, 0}, MinHP: [3]int{0, 0, 0}, MaxHP: [3]int{0, 0, 0}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	180: {ID: 180, Level: [3]int{16, 45, 76}, MinHP: [3]int{21, 20, 20}, MaxHP: [3]int{65, 45, 45}, Experience: [3]int{55, 30, 30}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 100}}},
+	181: {ID: 181, Level: [3]int{17, 45, 84}, MinHP: [3]int{23, 20, 20}, MaxHP: [3]int{64, 45, 45}, Experience: [3]int{55, 30, 30}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 100}}},
+	182: {ID: 182, Level: [3]int{19, 48, 79}, MinHP: [3]int{21, 20, 20}, MaxHP: [3]int{66, 45, 45}, Experience: [3]int{54, 30, 30}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 100}}},
+	183: {ID: 183, Level: [3]int{21, 50, 79}, MinHP: [3]int{21, 20, 20}, MaxHP: [3]int{64, 45, 45}, Experience: [3]int{54, 30, 30}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 100}}},
+	184: {ID: 184, Level: [3]int{24, 57, 85}, MinHP: [3]int{21, 20, 20}, MaxHP: [3]int{65, 45, 45}, Experience: [3]int{55, 30, 30}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 100}}},
+	185: {ID: 185, Level: [3]int{0, 0, 0}, MinHP: [3]int{0, 0, 0}, MaxHP: [3]int{0, 0, 0}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	186: {ID: 186, Level: [3]int{18, 47, 78}, MinHP: [3]int{160, 180, 180}, MaxHP: [3]int{200, 220, 220}, Experience: [3]int{114, 130, 130}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 55, Magic: 0, Fire: 115, Lightning: 50, Cold: 50, Poison: 0}}},
+	187: {ID: 187, Level: [3]int{20, 49, 80}, MinHP: [3]int{160, 180, 180}, MaxHP: [3]int{200, 220, 220}, Experience: [3]int{114, 130, 130}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 55, Magic: 0, Fire: 50, Lightning: 50, Cold: 140, Poison: 0}}},
+	188: {ID: 188, Level: [3]int{25, 55, 83}, MinHP: [3]int{160, 180, 180}, MaxHP: [3]int{200, 220, 220}, Experience: [3]int{118, 130, 130}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 55, Magic: 0, Fire: 50, Lightning: 100, Cold: 50, Poison: 0}}},
+	189: {ID: 189, Level: [3]int{32, 57, 85}, MinHP: [3]int{160, 180, 180}, MaxHP: [3]int{200, 220, 220}, Experience: [3]int{142, 130, 130}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 25, Lightning: 25, Cold: 50, Poison: 25}, {Physical: 0, Magic: 0, Fire: 50, Lightning: 50, Cold: 50, Poison: 50}, {Physical: 55, Magic: 0, Fire: 75, Lightning: 75, Cold: 75, Poison: 0}}},
+	190: {ID: 190, Level: [3]int{16, 45, 76}, MinHP: [3]int{77, 60, 60}, MaxHP: [3]int{98, 80, 80}, Experience: [3]int{50, 25, 25}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	191: {ID: 191, Level: [3]int{17, 45, 84}, MinHP: [3]int{78, 60, 60}, MaxHP: [3]int{95, 80, 80}, Experience: [3]int{50, 25, 25}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	192: {ID: 192, Level: [3]int{19, 48, 79}, MinHP: [3]int{78, 60, 60}, MaxHP: [3]int{96, 80, 80}, Experience: [3]int{50, 25, 25}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	193: {ID: 193, Level: [3]int{21, 50, 79}, MinHP: [3]int{78, 60, 60}, MaxHP: [3]int{96, 80, 80}, Experience: [3]int{50, 25, 25}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	194: {ID: 194, Level: [3]int{27, 57, 85}, MinHP: [3]int{77, 60, 60}, MaxHP: [3]int{95, 80, 80}, Experience: [3]int{54, 25, 25}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	195: {ID: 195, Level: [3]int{0, 0, 0}, MinHP: [3]int{0, 0, 0}, MaxHP: [3]int{0, 0, 0}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	196: {ID: 196, Level: [3]int{0, 0, 0}, MinHP: [3]int{0, 0, 0}, MaxHP: [3]int{0, 0, 0}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	197: {ID: 197, Level: [3]int{0, 0, 0}, MinHP: [3]int{0, 0, 0}, MaxHP: [3]int{0, 0, 0}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	198: {ID: 198, Level: [3]int{0, 0, 0}, MinHP: [3]int{0, 0, 0}, MaxHP: [3]int{0, 0, 0}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	199: {ID: 199, Level: [3]int{0, 0, 0}, MinHP: [3]int{0, 0, 0}, MaxHP: [3]int{0, 0, 0}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	200: {ID: 200, Level: [3]int{0, 0, 0}, MinHP: [3]int{0, 0, 0}, MaxHP: [3]int{0, 0, 0}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	201: {ID: 201, Level: [3]int{0, 0, 0}, MinHP: [3]int{0, 0, 0}, MaxHP: [3]int{0, 0, 0}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	202: {ID: 202, Level: [3]int{0, 0, 0}, MinHP: [3]int{0, 0, 0}, MaxHP: [3]int{0, 0, 0}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	203: {ID: 203, Level: [3]int{0, 0, 0}, MinHP: [3]int{0, 0, 0}, MaxHP: [3]int{0, 0, 0}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	204: {ID: 204, Level: [3]int{0, 0, 0}, MinHP: [3]int{0, 0, 0}, MaxHP: [3]int{0, 0, 0}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	205: {ID: 205, Level: [3]int{0, 0, 0}, MinHP: [3]int{0, 0, 0}, MaxHP: [3]int{0, 0, 0}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	206: {ID: 206, Level: [3]int{3, 37, 68}, MinHP: [3]int{456, 450, 450}, MaxHP: [3]int{545, 575, 575}, Experience: [3]int{177, 300, 300}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	207: {ID: 207, Level: [3]int{8, 38, 69}, MinHP: [3]int{489, 450, 450}, MaxHP: [3]int{584, 575, 575}, Experience: [3]int{178, 300, 300}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	208: {ID: 208, Level: [3]int{15, 45, 76}, MinHP: [3]int{479, 450, 450}, MaxHP: [3]int{576, 575, 575}, Experience: [3]int{177, 300, 300}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	209: {ID: 209, Level: [3]int{22, 48, 79}, MinHP: [3]int{477, 450, 450}, MaxHP: [3]int{572, 575, 575}, Experience: [3]int{177, 300, 300}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	210: {ID: 210, Level: [3]int{0, 0, 0}, MinHP: [3]int{0, 0, 0}, MaxHP: [3]int{0, 0, 0}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	211: {ID: 211, Level: [3]int{22, 55, 88}, MinHP: [3]int{4757, 4757, 1295}, MaxHP: [3]int{4757, 4757, 1295}, Experience: [3]int{1655, 1655, 1655}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 20, Lightning: 20, Cold: 50, Poison: 20}, {Physical: 0, Magic: 0, Fire: 50, Lightning: 50, Cold: 75, Poison: 50}, {Physical: 50, Magic: 33, Fire: 75, Lightning: 75, Cold: 95, Poison: 75}}},
+	212: {ID: 212, Level: [3]int{10, 41, 73}, MinHP: [3]int{64, 65, 65}, MaxHP: [3]int{110, 105, 105}, Experience: [3]int{80, 125, 125}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 50}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 60}, {Physical: 25, Magic: 0, Fire: 33, Lightning: 0, Cold: 75, Poison: 100}}},
+	213: {ID: 213, Level: [3]int{21, 50, 80}, MinHP: [3]int{64, 65, 65}, MaxHP: [3]int{107, 105, 105}, Experience: [3]int{79, 125, 125}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 50}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 60}, {Physical: 25, Magic: 0, Fire: 33, Lightning: 0, Cold: 75, Poison: 105}}},
+	214: {ID: 214, Level: [3]int{22, 51, 81}, MinHP: [3]int{66, 65, 65}, MaxHP: [3]int{107, 105, 105}, Experience: [3]int{79, 125, 125}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 50}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 60}, {Physical: 25, Magic: 0, Fire: 33, Lightning: 0, Cold: 75, Poison: 110}}},
+	215: {ID: 215, Level: [3]int{23, 52, 82}, MinHP: [3]int{65, 65, 65}, MaxHP: [3]int{107, 105, 105}, Experience: [3]int{79, 125, 125}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 50}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 60}, {Physical: 25, Magic: 0, Fire: 33, Lightning: 0, Cold: 75, Poison: 115}}},
+	216: {ID: 216, Level: [3]int{24, 53, 83}, MinHP: [3]int{65, 65, 65}, MaxHP: [3]int{107, 105, 105}, Experience: [3]int{79, 125, 125}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 50}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 60}, {Physical: 25, Magic: 0, Fire: 33, Lightning: 0, Cold: 75, Poison: 120}}},
+	217: {ID: 217, Level: [3]int{0, 0, 0}, MinHP: [3]int{0, 0, 0}, MaxHP: [3]int{0, 0, 0}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	218: {ID: 218, Level: [3]int{0, 0, 0}, MinHP: [3]int{0, 0, 0}, MaxHP: [3]int{0, 0, 0}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	219: {ID: 219, Level: [3]int{0, 0, 0}, MinHP: [3]int{0, 0, 0}, MaxHP: [3]int{0, 0, 0}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	220: {ID: 220, Level: [3]int{0, 0, 0}, MinHP: [3]int{0, 0, 0}, MaxHP: [3]int{0, 0, 0}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	221: {ID: 221, Level: [3]int{0, 0, 0}, MinHP: [3]int{0, 0, 0}, MaxHP: [3]int{0, 0, 0}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	222: {ID: 222, Level: [3]int{0, 0, 0}, MinHP: [3]int{0, 0, 0}, MaxHP: [3]int{0, 0, 0}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	223: {ID: 223, Level: [3]int{0, 0, 0}, MinHP: [3]int{0, 0, 0}, MaxHP: [3]int{0, 0, 0}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	224: {ID: 224, Level: [3]int{0, 0, 0}, MinHP: [3]int{0, 0, 0}, MaxHP: [3]int{0, 0, 0}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	225: {ID: 225, Level: [3]int{0, 0, 0}, MinHP: [3]int{0, 0, 0}, MaxHP: [3]int{0, 0, 0}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	226: {ID: 226, Level: [3]int{0, 0, 0}, MinHP: [3]int{0, 0, 0}, MaxHP: [3]int{0, 0, 0}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	227: {ID: 227, Level: [3]int{0, 0, 0}, MinHP: [3]int{0, 0, 0}, MaxHP: [3]int{0, 0, 0}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	228: {ID: 228, Level: [3]int{15, 49, 79}, MinHP: [3]int{957, 950, 950}, MaxHP: [3]int{957, 950, 950}, Experience: [3]int{327, 340, 340}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 50, Poison: 50}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 50, Poison: 50}, {Physical: 25, Magic: 0, Fire: 0, Lightning: 25, Cold: 150, Poison: 50}}},
+	229: {ID: 229, Level: [3]int{16, 49, 83}, MinHP: [3]int{498, 500, 500}, MaxHP: [3]int{498, 500, 500}, Experience: [3]int{218, 315, 315}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 40, Poison: 50}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 60, Poison: 60}, {Physical: 50, Magic: 0, Fire: 0, Lightning: 0, Cold: 60, Poison: 80}}},
+	230: {ID: 230, Level: [3]int{0, 0, 0}, MinHP: [3]int{0, 0, 0}, MaxHP: [3]int{0, 0, 0}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 70, Poison: 70}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	231: {ID: 231, Level: [3]int{0, 0, 0}, MinHP: [3]int{0, 0, 0}, MaxHP: [3]int{0, 0, 0}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 70, Poison: 70}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	232: {ID: 232, Level: [3]int{0, 0, 0}, MinHP: [3]int{0, 0, 0}, MaxHP: [3]int{0, 0, 0}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 70, Poison: 70}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	233: {ID: 233, Level: [3]int{0, 0, 0}, MinHP: [3]int{0, 0, 0}, MaxHP: [3]int{0, 0, 0}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 70, Poison: 70}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	234: {ID: 234, Level: [3]int{12, 45, 78}, MinHP: [3]int{97, 100, 100}, MaxHP: [3]int{131, 135, 135}, Experience: [3]int{83, 140, 140}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 70}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 100, Cold: 0, Poison: 0}, {Physical: 25, Magic: 0, Fire: 100, Lightning: 100, Cold: 0, Poison: 0}}},
+	235: {ID: 235, Level: [3]int{20, 50, 80}, MinHP: [3]int{129, 120, 120}, MaxHP: [3]int{172, 150, 150}, Experience: [3]int{100, 80, 80}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 10, Magic: 0, Fire: 100, Lightning: 33, Cold: 33, Poison: 20}}},
+	236: {ID: 236, Level: [3]int{22, 51, 81}, MinHP: [3]int{131, 120, 120}, MaxHP: [3]int{172, 150, 150}, Experience: [3]int{100, 80, 80}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 10, Magic: 0, Fire: 25, Lightning: 33, Cold: 140, Poison: 20}}},
+	237: {ID: 237, Level: [3]int{24, 53, 82}, MinHP: [3]int{131, 120, 120}, MaxHP: [3]int{173, 150, 150}, Experience: [3]int{100, 80, 80}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 10, Magic: 0, Fire: 25, Lightning: 100, Cold: 33, Poison: 20}}},
+	238: {ID: 238, Level: [3]int{22, 50, 80}, MinHP: [3]int{131, 90, 90}, MaxHP: [3]int{172, 130, 130}, Experience: [3]int{105, 130, 130}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 50, Poison: 0}, {Physical: 10, Magic: 0, Fire: 25, Lightning: 33, Cold: 140, Poison: 20}}},
+	239: {ID: 239, Level: [3]int{23, 51, 81}, MinHP: [3]int{130, 90, 90}, MaxHP: [3]int{171, 130, 130}, Experience: [3]int{106, 130, 130}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 50, Cold: 50, Poison: 0}, {Physical: 10, Magic: 0, Fire: 25, Lightning: 100, Cold: 75, Poison: 20}}},
+	240: {ID: 240, Level: [3]int{24, 53, 82}, MinHP: [3]int{131, 90, 90}, MaxHP: [3]int{173, 130, 130}, Experience: [3]int{106, 130, 130}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 50, Lightning: 0, Cold: 75, Poison: 0}, {Physical: 10, Magic: 0, Fire: 25, Lightning: 33, Cold: 160, Poison: 20}}},
+	241: {ID: 241, Level: [3]int{24, 57, 84}, MinHP: [3]int{131, 90, 90}, MaxHP: [3]int{173, 130, 130}, Experience: [3]int{106, 130, 130}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 10, Magic: 0, Fire: 33, Lightning: 75, Cold: 75, Poison: 20}}},
+	242: {ID: 242, Level: [3]int{26, 59, 87}, MinHP: [3]int{5695, 5695, 1471}, MaxHP: [3]int{5695, 5695, 1471}, Experience: [3]int{2131, 2131, 2131}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 33, Lightning: 33, Cold: 25, Poison: 50}, {Physical: 0, Magic: 0, Fire: 50, Lightning: 50, Cold: 25, Poison: 50}, {Physical: 20, Magic: 50, Fire: 75, Lightning: 75, Cold: 75, Poison: 75}}},
+	243: {ID: 243, Level: [3]int{40, 62, 94}, MinHP: [3]int{6427, 6427, 1577}, MaxHP: [3]int{6427, 6427, 1577}, Experience: [3]int{3462, 3462, 3462}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 33, Lightning: 33, Cold: 33, Poison: 50}, {Physical: 0, Magic: 0, Fire: 50, Lightning: 50, Cold: 50, Poison: 50}, {Physical: 45, Magic: 0, Fire: 50, Lightning: 50, Cold: 50, Poison: 50}}},
+	244: {ID: 244, Level: [3]int{0, 0, 0}, MinHP: [3]int{0, 0, 0}, MaxHP: [3]int{0, 0, 0}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	245: {ID: 245, Level: [3]int{0, 0, 0}, MinHP: [3]int{0, 0, 0}, MaxHP: [3]int{0, 0, 0}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	246: {ID: 246, Level: [3]int{0, 0, 0}, MinHP: [3]int{0, 0, 0}, MaxHP: [3]int{0, 0, 0}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	247: {ID: 247, Level: [3]int{21, 49, 79}, MinHP: [3]int{130, 110, 110}, MaxHP: [3]int{171, 140, 140}, Experience: [3]int{110, 90, 90}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 15, Magic: 0, Fire: 100, Lightning: 0, Cold: 25, Poison: 50}}},
+	248: {ID: 248, Level: [3]int{22, 50, 80}, MinHP: [3]int{131, 110, 110}, MaxHP: [3]int{172, 140, 140}, Experience: [3]int{110, 90, 90}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 15, Magic: 0, Fire: 0, Lightning: 0, Cold: 120, Poison: 50}}},
+	249: {ID: 249, Level: [3]int{24, 51, 81}, MinHP: [3]int{131, 110, 110}, MaxHP: [3]int{173, 140, 140}, Experience: [3]int{110, 90, 90}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 15, Magic: 0, Fire: 0, Lightning: 0, Cold: 25, Poison: 110}}},
+	250: {ID: 250, Level: [3]int{18, 55, 80}, MinHP: [3]int{129, 110, 110}, MaxHP: [3]int{172, 140, 140}, Experience: [3]int{123, 293, 293}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 50, Lightning: 50, Cold: 50, Poison: 0}, {Physical: 0, Magic: 0, Fire: 50, Lightning: 50, Cold: 50, Poison: 0}, {Physical: 0, Magic: 0, Fire: 75, Lightning: 75, Cold: 75, Poison: 0}}},
+	251: {ID: 251, Level: [3]int{0, 0, 0}, MinHP: [3]int{0, 0, 0}, MaxHP: [3]int{0, 0, 0}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	252: {ID: 252, Level: [3]int{0, 0, 0}, MinHP: [3]int{0, 0, 0}, MaxHP: [3]int{0, 0, 0}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	253: {ID: 253, Level: [3]int{0, 0, 0}, MinHP: [3]int{0, 0, 0}, MaxHP: [3]int{0, 0, 0}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	254: {ID: 254, Level: [3]int{0, 0, 0}, MinHP: [3]int{0, 0, 0}, MaxHP: [3]int{0, 0, 0}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	255: {ID: 255, Level: [3]int{0, 0, 0}, MinHP: [3]int{0, 0, 0}, MaxHP: [3]int{0, 0, 0}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	256: {ID: 256, Level: [3]int{29, 60, 86}, MinHP: [3]int{9481, 3111, 911}, MaxHP: [3]int{9481, 3111, 911}, Experience: [3]int{3695, 1987, 1987}, Resistances: [3]Resistances{{Physical: 30, Magic: 30, Fire: 30, Lightning: 30, Cold: 75, Poison: 30}, {Physical: 30, Magic: 30, Fire: 30, Lightning: 30, Cold: 75, Poison: 30}, {Physical: 30, Magic: 30, Fire: 30, Lightning: 30, Cold: 75, Poison: 30}}},
+	257: {ID: 257, Level: [3]int{0, 0, 0}, MinHP: [3]int{0, 0, 0}, MaxHP: [3]int{0, 0, 0}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	258: {ID: 258, Level: [3]int{18, 48, 75}, MinHP: [3]int{129, 70, 70}, MaxHP: [3]int{172, 100, 100}, Experience: [3]int{107, 75, 75}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 15, Magic: 0, Fire: 50, Lightning: 0, Cold: 0, Poison: 100}}},
+	259: {ID: 259, Level: [3]int{20, 50, 78}, MinHP: [3]int{129, 70, 70}, MaxHP: [3]int{172, 100, 100}, Experience: [3]int{107, 75, 75}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 15, Magic: 0, Fire: 50, Lightning: 0, Cold: 0, Poison: 105}}},
+	260: {ID: 260, Level: [3]int{22, 53, 80}, MinHP: [3]int{131, 70, 70}, MaxHP: [3]int{172, 100, 100}, Experience: [3]int{107, 75, 75}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 15, Magic: 0, Fire: 50, Lightning: 0, Cold: 0, Poison: 110}}},
+	261: {ID: 261, Level: [3]int{18, 48, 75}, MinHP: [3]int{129, 90, 90}, MaxHP: [3]int{172, 120, 120}, Experience: [3]int{86, 130, 130}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 15, Magic: 0, Fire: 50, Lightning: 0, Cold: 0, Poison: 100}}},
+	262: {ID: 262, Level: [3]int{20, 50, 78}, MinHP: [3]int{129, 90, 90}, MaxHP: [3]int{172, 120, 120}, Experience: [3]int{87, 130, 130}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 15, Magic: 0, Fire: 50, Lightning: 0, Cold: 0, Poison: 105}}},
+	263: {ID: 263, Level: [3]int{22, 53, 80}, MinHP: [3]int{131, 90, 90}, MaxHP: [3]int{172, 120, 120}, Experience: [3]int{86, 130, 130}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 15, Magic: 0, Fire: 50, Lightning: 0, Cold: 0, Poison: 110}}},
+	264: {ID: 264, Level: [3]int{0, 0, 0}, MinHP: [3]int{0, 0, 0}, MaxHP: [3]int{0, 0, 0}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	265: {ID: 265, Level: [3]int{0, 0, 0}, MinHP: [3]int{0, 0, 0}, MaxHP: [3]int{0, 0, 0}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	266: {ID: 266, Level: [3]int{1, 34, 67}, MinHP: [3]int{9981, 4281, 1075}, MaxHP: [3]int{9981, 4281, 1075}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	267: {ID: 267, Level: [3]int{10, 43, 88}, MinHP: [3]int{323, 1287, 886}, MaxHP: [3]int{323, 1287, 886}, Experience: [3]int{151, 977, 977}, Resistances: [3]Resistances{{Physical: 0, Magic: 50, Fire: 50, Lightning: 50, Cold: 50, Poison: 50}, {Physical: 25, Magic: 50, Fire: 50, Lightning: 50, Cold: 50, Poison: 50}, {Physical: 50, Magic: 50, Fire: 50, Lightning: 50, Cold: 50, Poison: 50}}},
+	268: {ID: 268, Level: [3]int{0, 0, 0}, MinHP: [3]int{0, 0, 0}, MaxHP: [3]int{0, 0, 0}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	269: {ID: 269, Level: [3]int{0, 0, 0}, MinHP: [3]int{0, 0, 0}, MaxHP: [3]int{0, 0, 0}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	270: {ID: 270, Level: [3]int{5, 38, 71}, MinHP: [3]int{93, 180, 180}, MaxHP: [3]int{231, 210, 210}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	271: {ID: 271, Level: [3]int{1, 34, 67}, MinHP: [3]int{0, 0, 0}, MaxHP: [3]int{0, 0, 0}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	272: {ID: 272, Level: [3]int{5, 38, 71}, MinHP: [3]int{189, 180, 180}, MaxHP: [3]int{331, 210, 210}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	273: {ID: 273, Level: [3]int{15, 40, 79}, MinHP: [3]int{132, 140, 140}, MaxHP: [3]int{172, 190, 190}, Experience: [3]int{106, 250, 250}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 70, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 100, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 1000, Poison: 0}}},
+	274: {ID: 274, Level: [3]int{8, 39, 71}, MinHP: [3]int{56, 55, 55}, MaxHP: [3]int{84, 80, 80}, Experience: [3]int{56, 110, 110}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 70}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 60}, {Physical: 33, Magic: 0, Fire: 0, Lightning: 0, Cold: 15, Poison: 75}}},
+	275: {ID: 275, Level: [3]int{10, 41, 75}, MinHP: [3]int{55, 55, 55}, MaxHP: [3]int{82, 80, 80}, Experience: [3]int{56, 110, 110}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 70}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 60}, {Physical: 33, Magic: 0, Fire: 0, Lightning: 0, Cold: 15, Poison: 75}}},
+	276: {ID: 276, Level: [3]int{14, 44, 79}, MinHP: [3]int{57, 55, 55}, MaxHP: [3]int{81, 80, 80}, Experience: [3]int{56, 110, 110}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 70}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 60}, {Physical: 33, Magic: 0, Fire: 0, Lightning: 0, Cold: 15, Poison: 75}}},
+	277: {ID: 277, Level: [3]int{17, 47, 78}, MinHP: [3]int{56, 55, 55}, MaxHP: [3]int{81, 80, 80}, Experience: [3]int{55, 110, 110}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 70}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 60}, {Physical: 33, Magic: 0, Fire: 0, Lightning: 0, Cold: 15, Poison: 75}}},
+	278: {ID: 278, Level: [3]int{11, 39, 72}, MinHP: [3]int{67, 65, 65}, MaxHP: [3]int{109, 105, 105}, Experience: [3]int{77, 260, 260}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 25, Magic: 25, Fire: 105, Lightning: 25, Cold: 0, Poison: 50}}},
+	279: {ID: 279, Level: [3]int{22, 49, 80}, MinHP: [3]int{66, 65, 65}, MaxHP: [3]int{107, 105, 105}, Experience: [3]int{77, 260, 260}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 25, Magic: 25, Fire: 110, Lightning: 25, Cold: 0, Poison: 50}}},
+	280: {ID: 280, Level: [3]int{23, 50, 81}, MinHP: [3]int{65, 65, 65}, MaxHP: [3]int{107, 105, 105}, Experience: [3]int{76, 260, 260}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 25, Magic: 25, Fire: 115, Lightning: 25, Cold: 0, Poison: 50}}},
+	281: {ID: 281, Level: [3]int{24, 51, 82}, MinHP: [3]int{65, 65, 65}, MaxHP: [3]int{107, 105, 105}, Experience: [3]int{77, 260, 260}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 25, Magic: 25, Fire: 120, Lightning: 25, Cold: 0, Poison: 50}}},
+	282: {ID: 282, Level: [3]int{24, 53, 84}, MinHP: [3]int{65, 65, 65}, MaxHP: [3]int{107, 105, 105}, Experience: [3]int{77, 260, 260}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 25, Magic: 25, Fire: 125, Lightning: 25, Cold: 0, Poison: 50}}},
+	283: {ID: 283, Level: [3]int{0, 0, 0}, MinHP: [3]int{0, 0, 0}, MaxHP: [3]int{0, 0, 0}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	284: {ID: 284, Level: [3]int{11, 46, 81}, MinHP: [3]int{159, 220, 220}, MaxHP: [3]int{259, 270, 270}, Experience: [3]int{134, 185, 185}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 50, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 120}}},
+	285: {ID: 285, Level: [3]int{14, 47, 82}, MinHP: [3]int{161, 220, 220}, MaxHP: [3]int{261, 270, 270}, Experience: [3]int{134, 185, 185}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 50, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 120}}},
+	286: {ID: 286, Level: [3]int{17, 48, 83}, MinHP: [3]int{159, 220, 220}, MaxHP: [3]int{259, 270, 270}, Experience: [3]int{134, 185, 185}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 50, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 120}}},
+	287: {ID: 287, Level: [3]int{21, 49, 84}, MinHP: [3]int{160, 220, 220}, MaxHP: [3]int{257, 270, 270}, Experience: [3]int{134, 185, 185}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 50, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 120}}},
+	288: {ID: 288, Level: [3]int{29, 50, 85}, MinHP: [3]int{157, 220, 220}, MaxHP: [3]int{257, 270, 270}, Experience: [3]int{153, 185, 185}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 50, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 120}}},
+	289: {ID: 289, Level: [3]int{0, 0, 0}, MinHP: [3]int{100, 175, 275}, MaxHP: [3]int{100, 175, 275}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 25, Magic: 0, Fire: 0, Lightning: 20, Cold: 50, Poison: 0}, {Physical: 25, Magic: 0, Fire: 0, Lightning: 20, Cold: 50, Poison: 0}, {Physical: 25, Magic: 0, Fire: 0, Lightning: 20, Cold: 50, Poison: 0}}},
+	290: {ID: 290, Level: [3]int{0, 0, 0}, MinHP: [3]int{201, 388, 637}, MaxHP: [3]int{201, 388, 637}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 20, Fire: 0, Lightning: 0, Cold: 0, Poison: 20}, {Physical: 0, Magic: 20, Fire: 0, Lightning: 0, Cold: 0, Poison: 20}, {Physical: 0, Magic: 20, Fire: 0, Lightning: 0, Cold: 0, Poison: 20}}},
+	291: {ID: 291, Level: [3]int{0, 0, 0}, MinHP: [3]int{306, 595, 980}, MaxHP: [3]int{306, 595, 980}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 50, Cold: 0, Poison: 100}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 50, Cold: 0, Poison: 100}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 50, Cold: 0, Poison: 100}}},
+	292: {ID: 292, Level: [3]int{0, 0, 0}, MinHP: [3]int{313, 613, 1013}, MaxHP: [3]int{313, 613, 1013}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 100, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 100, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 100, Lightning: 0, Cold: 0, Poison: 0}}},
+	293: {ID: 293, Level: [3]int{0, 0, 0}, MinHP: [3]int{0, 0, 0}, MaxHP: [3]int{0, 0, 0}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	294: {ID: 294, Level: [3]int{0, 0, 0}, MinHP: [3]int{0, 0, 0}, MaxHP: [3]int{0, 0, 0}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	295: {ID: 295, Level: [3]int{24, 59, 85}, MinHP: [3]int{107, 80, 80}, MaxHP: [3]int{151, 125, 125}, Experience: [3]int{97, 135, 135}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 100, Magic: 0, Fire: 0, Lightning: 0, Cold: 33, Poison: 105}}},
+	296: {ID: 296, Level: [3]int{0, 0, 0}, MinHP: [3]int{0, 0, 0}, MaxHP: [3]int{0, 0, 0}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	297: {ID: 297, Level: [3]int{0, 0, 0}, MinHP: [3]int{0, 0, 0}, MaxHP: [3]int{0, 0, 0}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	298: {ID: 298, Level: [3]int{24, 56, 82}, MinHP: [3]int{229, 200, 200}, MaxHP: [3]int{295, 250, 250}, Experience: [3]int{142, 130, 130}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 66, Magic: 0, Fire: 0, Lightning: 0, Cold: 140, Poison: 0}}},
+	299: {ID: 299, Level: [3]int{27, 57, 84}, MinHP: [3]int{229, 200, 200}, MaxHP: [3]int{295, 250, 250}, Experience: [3]int{154, 130, 130}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 25, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 50, Poison: 0}, {Physical: 66, Magic: 0, Fire: 0, Lightning: 0, Cold: 150, Poison: 0}}},
+	300: {ID: 300, Level: [3]int{30, 57, 85}, MinHP: [3]int{228, 200, 200}, MaxHP: [3]int{294, 250, 250}, Experience: [3]int{168, 130, 130}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 40, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 70, Poison: 0}, {Physical: 66, Magic: 0, Fire: 0, Lightning: 0, Cold: 160, Poison: 0}}},
+	301: {ID: 301, Level: [3]int{24, 56, 82}, MinHP: [3]int{57, 50, 50}, MaxHP: [3]int{85, 70, 70}, Experience: [3]int{76, 40, 40}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 25, Magic: 0, Fire: 0, Lightning: 0, Cold: 140, Poison: 0}}},
+	302: {ID: 302, Level: [3]int{27, 57, 84}, MinHP: [3]int{56, 50, 50}, MaxHP: [3]int{84, 70, 70}, Experience: [3]int{82, 40, 40}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 25, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 50, Poison: 0}, {Physical: 25, Magic: 0, Fire: 0, Lightning: 0, Cold: 150, Poison: 0}}},
+	303: {ID: 303, Level: [3]int{30, 57, 85}, MinHP: [3]int{55, 50, 50}, MaxHP: [3]int{84, 70, 70}, Experience: [3]int{89, 40, 40}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 40, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 70, Poison: 0}, {Physical: 25, Magic: 0, Fire: 0, Lightning: 0, Cold: 160, Poison: 0}}},
+	304: {ID: 304, Level: [3]int{24, 56, 82}, MinHP: [3]int{153, 80, 80}, MaxHP: [3]int{211, 110, 110}, Experience: [3]int{110, 135, 135}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 40, Lightning: 40, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 50, Lightning: 50, Cold: 0, Poison: 0}, {Physical: 50, Magic: 0, Fire: 0, Lightning: 100, Cold: 0, Poison: 0}}},
+	305: {ID: 305, Level: [3]int{27, 57, 84}, MinHP: [3]int{152, 80, 80}, MaxHP: [3]int{209, 110, 110}, Experience: [3]int{120, 135, 135}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 50, Lightning: 50, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 60, Lightning: 60, Cold: 0, Poison: 0}, {Physical: 50, Magic: 0, Fire: 0, Lightning: 100, Cold: 0, Poison: 0}}},
+	306: {ID: 306, Level: [3]int{30, 58, 85}, MinHP: [3]int{152, 80, 80}, MaxHP: [3]int{209, 110, 110}, Experience: [3]int{130, 135, 135}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 60, Lightning: 60, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 75, Lightning: 75, Cold: 0, Poison: 0}, {Physical: 50, Magic: 0, Fire: 0, Lightning: 100, Cold: 0, Poison: 0}}},
+	307: {ID: 307, Level: [3]int{24, 56, 82}, MinHP: [3]int{211, 240, 240}, MaxHP: [3]int{285, 320, 320}, Experience: [3]int{138, 140, 140}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 50, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 100}}},
+	308: {ID: 308, Level: [3]int{27, 57, 84}, MinHP: [3]int{209, 240, 240}, MaxHP: [3]int{283, 320, 320}, Experience: [3]int{150, 140, 140}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 50, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 105}}},
+	309: {ID: 309, Level: [3]int{30, 57, 85}, MinHP: [3]int{209, 240, 240}, MaxHP: [3]int{283, 320, 320}, Experience: [3]int{163, 140, 140}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 50, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 110}}},
+	310: {ID: 310, Level: [3]int{24, 56, 82}, MinHP: [3]int{131, 120, 120}, MaxHP: [3]int{173, 150, 150}, Experience: [3]int{111, 100, 100}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 80, Lightning: 20, Cold: 20, Poison: 40}, {Physical: 0, Magic: 0, Fire: 100, Lightning: 20, Cold: 20, Poison: 40}, {Physical: 33, Magic: 0, Fire: 110, Lightning: 20, Cold: 20, Poison: 50}}},
+	311: {ID: 311, Level: [3]int{27, 57, 84}, MinHP: [3]int{129, 120, 120}, MaxHP: [3]int{172, 150, 150}, Experience: [3]int{121, 140, 140}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 40, Lightning: 80, Cold: 40, Poison: 40}, {Physical: 0, Magic: 0, Fire: 40, Lightning: 80, Cold: 40, Poison: 40}, {Physical: 33, Magic: 0, Fire: 40, Lightning: 100, Cold: 40, Poison: 50}}},
+	312: {ID: 312, Level: [3]int{30, 58, 85}, MinHP: [3]int{130, 120, 120}, MaxHP: [3]int{171, 150, 150}, Experience: [3]int{131, 210, 210}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 60, Lightning: 60, Cold: 60, Poison: 60}, {Physical: 0, Magic: 0, Fire: 60, Lightning: 60, Cold: 80, Poison: 60}, {Physical: 33, Magic: 25, Fire: 60, Lightning: 60, Cold: 180, Poison: 75}}},
+	313: {ID: 313, Level: [3]int{0, 0, 0}, MinHP: [3]int{0, 0, 0}, MaxHP: [3]int{0, 0, 0}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	314: {ID: 314, Level: [3]int{0, 0, 0}, MinHP: [3]int{0, 0, 0}, MaxHP: [3]int{0, 0, 0}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	315: {ID: 315, Level: [3]int{0, 0, 0}, MinHP: [3]int{0, 0, 0}, MaxHP: [3]int{0, 0, 0}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	316: {ID: 316, Level: [3]int{0, 0, 0}, MinHP: [3]int{0, 0, 0}, MaxHP: [3]int{0, 0, 0}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	317: {ID: 317, Level: [3]int{0, 0, 0}, MinHP: [3]int{0, 0, 0}, MaxHP: [3]int{0, 0, 0}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	318: {ID: 318, Level: [3]int{0, 0, 0}, MinHP: [3]int{0, 0, 0}, MaxHP: [3]int{0, 0, 0}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	319: {ID: 319, Level: [3]int{0, 0, 0}, MinHP: [3]int{0, 0, 0}, MaxHP: [3]int{0, 0, 0}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	320: {ID: 320, Level: [3]int{0, 0, 0}, MinHP: [3]int{0, 0, 0}, MaxHP: [3]int{0, 0, 0}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	321: {ID: 321, Level: [3]int{1, 34, 67}, MinHP: [3]int{0, 0, 0}, MaxHP: [3]int{0, 0, 0}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	322: {ID: 322, Level: [3]int{4, 37, 70}, MinHP: [3]int{0, 0, 0}, MaxHP: [3]int{0, 0, 0}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	323: {ID: 323, Level: [3]int{7, 40, 73}, MinHP: [3]int{0, 0, 0}, MaxHP: [3]int{0, 0, 0}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	324: {ID: 324, Level: [3]int{9, 42, 75}, MinHP: [3]int{0, 0, 0}, MaxHP: [3]int{0, 0, 0}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	325: {ID: 325, Level: [3]int{22, 55, 83}, MinHP: [3]int{0, 0, 0}, MaxHP: [3]int{0, 0, 0}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	326: {ID: 326, Level: [3]int{0, 0, 0}, MinHP: [3]int{0, 0, 0}, MaxHP: [3]int{0, 0, 0}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	327: {ID: 327, Level: [3]int{1, 34, 67}, MinHP: [3]int{0, 0, 0}, MaxHP: [3]int{0, 0, 0}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	328: {ID: 328, Level: [3]int{1, 34, 67}, MinHP: [3]int{0, 0, 0}, MaxHP: [3]int{0, 0, 0}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	329: {ID: 329, Level: [3]int{0, 0, 0}, MinHP: [3]int{0, 0, 0}, MaxHP: [3]int{0, 0, 0}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	330: {ID: 330, Level: [3]int{0, 0, 0}, MinHP: [3]int{0, 0, 0}, MaxHP: [3]int{0, 0, 0}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	331: {ID: 331, Level: [3]int{0, 0, 0}, MinHP: [3]int{0, 0, 0}, MaxHP: [3]int{0, 0, 0}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	332: {ID: 332, Level: [3]int{0, 0, 0}, MinHP: [3]int{0, 0, 0}, MaxHP: [3]int{0, 0, 0}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	333: {ID: 333, Level: [3]int{110, 110, 110}, MinHP: [3]int{6427, 6427, 6427}, MaxHP: [3]int{6427, 6427, 6427}, Experience: [3]int{3462, 3462, 3462}, Resistances: [3]Resistances{{Physical: 50, Magic: 50, Fire: 75, Lightning: 75, Cold: 75, Poison: 75}, {Physical: 50, Magic: 50, Fire: 75, Lightning: 75, Cold: 75, Poison: 75}, {Physical: 50, Magic: 50, Fire: 95, Lightning: 95, Cold: 95, Poison: 95}}},
+	334: {ID: 334, Level: [3]int{0, 0, 0}, MinHP: [3]int{207, 400, 400}, MaxHP: [3]int{550, 550, 550}, Experience: [3]int{196, 350, 350}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	335: {ID: 335, Level: [3]int{0, 0, 0}, MinHP: [3]int{216, 400, 400}, MaxHP: [3]int{550, 550, 550}, Experience: [3]int{196, 350, 350}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	336: {ID: 336, Level: [3]int{0, 0, 0}, MinHP: [3]int{214, 400, 400}, MaxHP: [3]int{550, 550, 550}, Experience: [3]int{196, 350, 350}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	337: {ID: 337, Level: [3]int{0, 0, 0}, MinHP: [3]int{214, 400, 400}, MaxHP: [3]int{550, 550, 550}, Experience: [3]int{201, 350, 350}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	338: {ID: 338, Level: [3]int{1, 34, 67}, MinHP: [3]int{0, 0, 0}, MaxHP: [3]int{0, 0, 0}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	339: {ID: 339, Level: [3]int{0, 0, 0}, MinHP: [3]int{0, 0, 0}, MaxHP: [3]int{0, 0, 0}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	340: {ID: 340, Level: [3]int{12, 45, 78}, MinHP: [3]int{193, 250, 250}, MaxHP: [3]int{385, 250, 250}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 70, Poison: 70}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 90, Poison: 200}, {Physical: 50, Magic: 0, Fire: 0, Lightning: 0, Cold: 90, Poison: 200}}},
+	341: {ID: 341, Level: [3]int{12, 45, 78}, MinHP: [3]int{193, 250, 250}, MaxHP: [3]int{385, 250, 250}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 70, Poison: 70}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 90, Poison: 200}, {Physical: 50, Magic: 0, Fire: 0, Lightning: 0, Cold: 90, Poison: 200}}},
+	342: {ID: 342, Level: [3]int{12, 45, 78}, MinHP: [3]int{193, 250, 250}, MaxHP: [3]int{385, 250, 250}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 70, Poison: 70}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 90, Poison: 200}, {Physical: 50, Magic: 0, Fire: 0, Lightning: 0, Cold: 90, Poison: 200}}},
+	343: {ID: 343, Level: [3]int{12, 45, 78}, MinHP: [3]int{193, 250, 250}, MaxHP: [3]int{385, 250, 250}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 70, Poison: 70}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 90, Poison: 200}, {Physical: 50, Magic: 0, Fire: 0, Lightning: 0, Cold: 90, Poison: 200}}},
+	344: {ID: 344, Level: [3]int{7, 40, 73}, MinHP: [3]int{19, 147, 431}, MaxHP: [3]int{19, 147, 431}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 70, Poison: 70}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 90, Poison: 200}, {Physical: 50, Magic: 0, Fire: 0, Lightning: 0, Cold: 90, Poison: 200}}},
+	345: {ID: 345, Level: [3]int{25, 56, 88}, MinHP: [3]int{191, 200, 200}, MaxHP: [3]int{381, 350, 350}, Experience: [3]int{149, 150, 150}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 50, Magic: 0, Fire: 120, Lightning: 33, Cold: 33, Poison: 33}}},
+	346: {ID: 346, Level: [3]int{25, 56, 88}, MinHP: [3]int{191, 200, 200}, MaxHP: [3]int{381, 350, 350}, Experience: [3]int{149, 150, 150}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 50, Magic: 0, Fire: 33, Lightning: 100, Cold: 33, Poison: 33}}},
+	347: {ID: 347, Level: [3]int{25, 56, 88}, MinHP: [3]int{191, 200, 200}, MaxHP: [3]int{381, 350, 350}, Experience: [3]int{149, 150, 150}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 50, Magic: 0, Fire: 33, Lightning: 100, Cold: 33, Poison: 33}}},
+	348: {ID: 348, Level: [3]int{10, 43, 76}, MinHP: [3]int{291, 400, 400}, MaxHP: [3]int{387, 450, 450}, Experience: [3]int{95, 400, 400}, Resistances: [3]Resistances{{Physical: 0, Magic: 50, Fire: 95, Lightning: 50, Cold: 85, Poison: 1000}, {Physical: 0, Magic: 50, Fire: 99, Lightning: 50, Cold: 85, Poison: 1000}, {Physical: 25, Magic: 50, Fire: 99, Lightning: 50, Cold: 85, Poison: 1000}}},
+	349: {ID: 349, Level: [3]int{10, 43, 76}, MinHP: [3]int{291, 400, 400}, MaxHP: [3]int{387, 450, 450}, Experience: [3]int{95, 400, 400}, Resistances: [3]Resistances{{Physical: 0, Magic: 50, Fire: 95, Lightning: 50, Cold: 85, Poison: 1000}, {Physical: 0, Magic: 50, Fire: 99, Lightning: 50, Cold: 85, Poison: 1000}, {Physical: 25, Magic: 50, Fire: 99, Lightning: 50, Cold: 85, Poison: 1000}}},
+	350: {ID: 350, Level: [3]int{10, 43, 76}, MinHP: [3]int{291, 400, 400}, MaxHP: [3]int{387, 450, 450}, Experience: [3]int{95, 400, 400}, Resistances: [3]Resistances{{Physical: 0, Magic: 50, Fire: 95, Lightning: 50, Cold: 85, Poison: 1000}, {Physical: 0, Magic: 50, Fire: 99, Lightning: 50, Cold: 85, Poison: 1000}, {Physical: 25, Magic: 50, Fire: 99, Lightning: 50, Cold: 85, Poison: 1000}}},
+	351: {ID: 351, Level: [3]int{0, 0, 0}, MinHP: [3]int{0, 0, 0}, MaxHP: [3]int{0, 0, 0}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	352: {ID: 352, Level: [3]int{0, 0, 0}, MinHP: [3]int{0, 0, 0}, MaxHP: [3]int{0, 0, 0}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	353: {ID: 353, Level: [3]int{0, 0, 0}, MinHP: [3]int{0, 0, 0}, MaxHP: [3]int{0, 0, 0}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	354: {ID: 354, Level: [3]int{0, 0, 0}, MinHP: [3]int{284, 0, 0}, MaxHP: [3]int{379, 0, 0}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	355: {ID: 355, Level: [3]int{0, 0, 0}, MinHP: [3]int{0, 0, 0}, MaxHP: [3]int{0, 0, 0}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	356: {ID: 356, Level: [3]int{0, 0, 0}, MinHP: [3]int{10, 10, 10}, MaxHP: [3]int{10, 10, 10}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	357: {ID: 357, Level: [3]int{0, 0, 0}, MinHP: [3]int{400, 400, 400}, MaxHP: [3]int{480, 480, 480}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	358: {ID: 358, Level: [3]int{0, 0, 0}, MinHP: [3]int{577, 0, 120}, MaxHP: [3]int{770, 0, 170}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 70}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 70}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 70}}},
+	359: {ID: 359, Level: [3]int{1, 34, 67}, MinHP: [3]int{0, 0, 0}, MaxHP: [3]int{0, 0, 0}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	360: {ID: 360, Level: [3]int{24, 55, 82}, MinHP: [3]int{191, 210, 210}, MaxHP: [3]int{229, 250, 250}, Experience: [3]int{129, 130, 130}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 70, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 80, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 50, Magic: 0, Fire: 110, Lightning: 75, Cold: 50, Poison: 75}}},
+	361: {ID: 361, Level: [3]int{27, 56, 83}, MinHP: [3]int{190, 210, 210}, MaxHP: [3]int{229, 250, 250}, Experience: [3]int{140, 130, 130}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 70, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 80, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 50, Magic: 0, Fire: 120, Lightning: 75, Cold: 50, Poison: 75}}},
+	362: {ID: 362, Level: [3]int{30, 57, 84}, MinHP: [3]int{191, 210, 210}, MaxHP: [3]int{228, 250, 250}, Experience: [3]int{152, 130, 130}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 70, Lightning: 0, Cold: 0, Poison: 70}, {Physical: 0, Magic: 0, Fire: 80, Lightning: 0, Cold: 0, Poison: 100}, {Physical: 50, Magic: 0, Fire: 130, Lightning: 75, Cold: 50, Poison: 75}}},
+	363: {ID: 363, Level: [3]int{0, 0, 0}, MinHP: [3]int{21, 30, 42}, MaxHP: [3]int{21, 30, 42}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	364: {ID: 364, Level: [3]int{0, 0, 0}, MinHP: [3]int{61, 88, 123}, MaxHP: [3]int{61, 88, 123}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	365: {ID: 365, Level: [3]int{5, 39, 84}, MinHP: [3]int{608, 608, 608}, MaxHP: [3]int{608, 608, 608}, Experience: [3]int{273, 273, 273}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 50}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 50}, {Physical: 50, Magic: 0, Fire: 0, Lightning: 50, Cold: 0, Poison: 120}}},
+	366: {ID: 366, Level: [3]int{40, 55, 80}, MinHP: [3]int{152, 303, 303}, MaxHP: [3]int{303, 303, 303}, Experience: [3]int{0, 1010, 1010}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 1000}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 1000}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 1000}}},
+	367: {ID: 367, Level: [3]int{0, 0, 0}, MinHP: [3]int{0, 0, 0}, MaxHP: [3]int{0, 0, 0}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	368: {ID: 368, Level: [3]int{0, 0, 0}, MinHP: [3]int{0, 0, 0}, MaxHP: [3]int{0, 0, 0}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	369: {ID: 369, Level: [3]int{0, 0, 0}, MinHP: [3]int{0, 0, 0}, MaxHP: [3]int{0, 0, 0}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 90, Magic: 90, Fire: 90, Lightning: 90, Cold: 90, Poison: 90}, {Physical: 90, Magic: 90, Fire: 90, Lightning: 90, Cold: 90, Poison: 90}, {Physical: 50, Magic: 90, Fire: 90, Lightning: 90, Cold: 90, Poison: 90}}},
+	370: {ID: 370, Level: [3]int{24, 57, 85}, MinHP: [3]int{0, 0, 0}, MaxHP: [3]int{0, 0, 0}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	371: {ID: 371, Level: [3]int{1, 48, 79}, MinHP: [3]int{1001, 500, 500}, MaxHP: [3]int{2021, 600, 600}, Experience: [3]int{944, 944, 944}, Resistances: [3]Resistances{{Physical: 0, Magic: 90, Fire: 90, Lightning: 90, Cold: 70, Poison: 1000}, {Physical: 0, Magic: 90, Fire: 90, Lightning: 100, Cold: 0, Poison: 1000}, {Physical: 0, Magic: 90, Fire: 90, Lightning: 100, Cold: 0, Poison: 1000}}},
+	372: {ID: 372, Level: [3]int{1, 46, 77}, MinHP: [3]int{1001, 1400, 1400}, MaxHP: [3]int{2021, 1700, 1700}, Experience: [3]int{944, 944, 944}, Resistances: [3]Resistances{{Physical: 90, Magic: 90, Fire: 90, Lightning: 0, Cold: 0, Poison: 70}, {Physical: 90, Magic: 90, Fire: 90, Lightning: 0, Cold: 0, Poison: 90}, {Physical: 40, Magic: 50, Fire: 99, Lightning: 50, Cold: 85, Poison: 1000}}},
+	373: {ID: 373, Level: [3]int{14, 43, 75}, MinHP: [3]int{54, 55, 55}, MaxHP: [3]int{87, 85, 85}, Experience: [3]int{67, 80, 80}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 10, Magic: 0, Fire: 25, Lightning: 0, Cold: 120, Poison: 0}}},
+	374: {ID: 374, Level: [3]int{15, 44, 77}, MinHP: [3]int{54, 55, 55}, MaxHP: [3]int{88, 85, 85}, Experience: [3]int{67, 80, 80}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 10, Magic: 0, Fire: 25, Lightning: 0, Cold: 140, Poison: 0}}},
+	375: {ID: 375, Level: [3]int{17, 46, 79}, MinHP: [3]int{53, 55, 55}, MaxHP: [3]int{87, 85, 85}, Experience: [3]int{67, 80, 80}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 10, Magic: 0, Fire: 25, Lightning: 100, Cold: 0, Poison: 0}}},
+	376: {ID: 376, Level: [3]int{19, 48, 80}, MinHP: [3]int{53, 55, 55}, MaxHP: [3]int{86, 85, 85}, Experience: [3]int{67, 80, 80}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 10, Magic: 0, Fire: 105, Lightning: 0, Cold: 0, Poison: 0}}},
+	377: {ID: 377, Level: [3]int{0, 0, 0}, MinHP: [3]int{0, 0, 0}, MaxHP: [3]int{0, 0, 0}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	378: {ID: 378, Level: [3]int{0, 0, 0}, MinHP: [3]int{0, 0, 0}, MaxHP: [3]int{0, 0, 0}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	379: {ID: 379, Level: [3]int{8, 39, 69}, MinHP: [3]int{56, 55, 55}, MaxHP: [3]int{84, 80, 80}, Experience: [3]int{56, 110, 110}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 30, Poison: 50}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 50, Poison: 60}, {Physical: 33, Magic: 0, Fire: 0, Lightning: 0, Cold: 140, Poison: 75}}},
+	380: {ID: 380, Level: [3]int{10, 40, 70}, MinHP: [3]int{55, 55, 55}, MaxHP: [3]int{82, 80, 80}, Experience: [3]int{56, 110, 110}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 30, Poison: 50}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 50, Poison: 60}, {Physical: 33, Magic: 0, Fire: 0, Lightning: 0, Cold: 150, Poison: 75}}},
+	381: {ID: 381, Level: [3]int{14, 43, 74}, MinHP: [3]int{57, 55, 55}, MaxHP: [3]int{81, 80, 80}, Experience: [3]int{56, 110, 110}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 50, Lightning: 0, Cold: 30, Poison: 50}, {Physical: 0, Magic: 0, Fire: 50, Lightning: 0, Cold: 50, Poison: 60}, {Physical: 33, Magic: 0, Fire: 75, Lightning: 0, Cold: 160, Poison: 75}}},
+	382: {ID: 382, Level: [3]int{17, 47, 78}, MinHP: [3]int{56, 55, 55}, MaxHP: [3]int{81, 80, 80}, Experience: [3]int{55, 110, 110}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 30, Poison: 50}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 50, Poison: 60}, {Physical: 33, Magic: 0, Fire: 0, Lightning: 0, Cold: 170, Poison: 75}}},
+	383: {ID: 383, Level: [3]int{8, 39, 69}, MinHP: [3]int{56, 55, 55}, MaxHP: [3]int{84, 80, 80}, Experience: [3]int{56, 110, 110}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 30, Lightning: 0, Cold: 0, Poison: 50}, {Physical: 0, Magic: 0, Fire: 50, Lightning: 0, Cold: 0, Poison: 60}, {Physical: 33, Magic: 0, Fire: 100, Lightning: 0, Cold: 0, Poison: 75}}},
+	384: {ID: 384, Level: [3]int{10, 40, 70}, MinHP: [3]int{55, 55, 55}, MaxHP: [3]int{82, 80, 80}, Experience: [3]int{56, 110, 110}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 30, Lightning: 0, Cold: 0, Poison: 50}, {Physical: 0, Magic: 0, Fire: 50, Lightning: 0, Cold: 0, Poison: 60}, {Physical: 33, Magic: 0, Fire: 105, Lightning: 0, Cold: 0, Poison: 75}}},
+	385: {ID: 385, Level: [3]int{14, 44, 75}, MinHP: [3]int{57, 55, 55}, MaxHP: [3]int{81, 80, 80}, Experience: [3]int{56, 110, 110}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 70, Lightning: 0, Cold: 0, Poison: 50}, {Physical: 0, Magic: 0, Fire: 100, Lightning: 0, Cold: 0, Poison: 60}, {Physical: 33, Magic: 0, Fire: 110, Lightning: 0, Cold: 0, Poison: 75}}},
+	386: {ID: 386, Level: [3]int{17, 47, 78}, MinHP: [3]int{56, 55, 55}, MaxHP: [3]int{81, 80, 80}, Experience: [3]int{55, 110, 110}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 30, Lightning: 0, Cold: 0, Poison: 50}, {Physical: 0, Magic: 0, Fire: 50, Lightning: 0, Cold: 0, Poison: 60}, {Physical: 33, Magic: 0, Fire: 115, Lightning: 0, Cold: 0, Poison: 75}}},
+	387: {ID: 387, Level: [3]int{8, 40, 70}, MinHP: [3]int{56, 55, 55}, MaxHP: [3]int{84, 80, 80}, Experience: [3]int{56, 110, 110}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 30, Cold: 0, Poison: 50}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 50, Cold: 0, Poison: 60}, {Physical: 33, Magic: 0, Fire: 0, Lightning: 100, Cold: 0, Poison: 75}}},
+	388: {ID: 388, Level: [3]int{10, 41, 71}, MinHP: [3]int{55, 55, 55}, MaxHP: [3]int{82, 80, 80}, Experience: [3]int{56, 110, 110}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 30, Cold: 0, Poison: 50}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 50, Cold: 0, Poison: 60}, {Physical: 33, Magic: 0, Fire: 0, Lightning: 105, Cold: 0, Poison: 75}}},
+	389: {ID: 389, Level: [3]int{14, 44, 78}, MinHP: [3]int{57, 55, 55}, MaxHP: [3]int{81, 80, 80}, Experience: [3]int{56, 110, 110}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 50, Lightning: 30, Cold: 0, Poison: 50}, {Physical: 0, Magic: 0, Fire: 50, Lightning: 50, Cold: 0, Poison: 60}, {Physical: 33, Magic: 0, Fire: 0, Lightning: 110, Cold: 0, Poison: 75}}},
+	390: {ID: 390, Level: [3]int{17, 48, 79}, MinHP: [3]int{56, 55, 55}, MaxHP: [3]int{81, 80, 80}, Experience: [3]int{55, 110, 110}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 30, Cold: 0, Poison: 50}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 50, Cold: 0, Poison: 60}, {Physical: 33, Magic: 0, Fire: 0, Lightning: 115, Cold: 0, Poison: 75}}},
+	391: {ID: 391, Level: [3]int{28, 61, 81}, MinHP: [3]int{133, 220, 220}, MaxHP: [3]int{285, 270, 270}, Experience: [3]int{140, 80, 80}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 33, Magic: 15, Fire: 25, Lightning: 25, Cold: 25, Poison: 25}, {Physical: 50, Magic: 33, Fire: 50, Lightning: 50, Cold: 50, Poison: 33}}},
+	392: {ID: 392, Level: [3]int{1, 1, 1}, MinHP: [3]int{0, 50, 50}, MaxHP: [3]int{100, 50, 50}, Experience: [3]int{491, 15, 15}, Resistances: [3]Resistances{{Physical: 90, Magic: 90, Fire: 90, Lightning: 90, Cold: 90, Poison: 70}, {Physical: 90, Magic: 90, Fire: 90, Lightning: 90, Cold: 90, Poison: 90}, {Physical: 40, Magic: 90, Fire: 90, Lightning: 90, Cold: 90, Poison: 90}}},
+	393: {ID: 393, Level: [3]int{1, 1, 1}, MinHP: [3]int{0, 50, 50}, MaxHP: [3]int{100, 50, 50}, Experience: [3]int{491, 15, 15}, Resistances: [3]Resistances{{Physical: 90, Magic: 90, Fire: 90, Lightning: 90, Cold: 90, Poison: 70}, {Physical: 90, Magic: 90, Fire: 90, Lightning: 90, Cold: 90, Poison: 90}, {Physical: 40, Magic: 90, Fire: 90, Lightning: 90, Cold: 90, Poison: 90}}},
+	394: {ID: 394, Level: [3]int{15, 44, 76}, MinHP: [3]int{47, 55, 55}, MaxHP: [3]int{82, 85, 85}, Experience: [3]int{64, 100, 100}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 15, Magic: 0, Fire: 75, Lightning: 50, Cold: 0, Poison: 0}}},
+	395: {ID: 395, Level: [3]int{17, 46, 77}, MinHP: [3]int{48, 55, 55}, MaxHP: [3]int{81, 85, 85}, Experience: [3]int{64, 100, 100}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 15, Magic: 0, Fire: 75, Lightning: 50, Cold: 0, Poison: 0}}},
+	396: {ID: 396, Level: [3]int{9, 39, 72}, MinHP: [3]int{66, 45, 45}, MaxHP: [3]int{111, 75, 75}, Experience: [3]int{65, 90, 90}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 100, Lightning: 0, Cold: 0, Poison: 50}}},
+	397: {ID: 397, Level: [3]int{21, 49, 79}, MinHP: [3]int{64, 45, 45}, MaxHP: [3]int{107, 75, 75}, Experience: [3]int{65, 90, 90}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 100, Cold: 0, Poison: 50}}},
+	398: {ID: 398, Level: [3]int{22, 50, 80}, MinHP: [3]int{66, 45, 45}, MaxHP: [3]int{107, 75, 75}, Experience: [3]int{65, 90, 90}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 140, Poison: 50}}},
+	399: {ID: 399, Level: [3]int{23, 52, 82}, MinHP: [3]int{65, 45, 45}, MaxHP: [3]int{107, 75, 75}, Experience: [3]int{65, 90, 90}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 110}}},
+	400: {ID: 400, Level: [3]int{24, 53, 83}, MinHP: [3]int{65, 45, 45}, MaxHP: [3]int{107, 75, 75}, Experience: [3]int{65, 90, 90}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 100, Lightning: 0, Cold: 0, Poison: 50}}},
+	401: {ID: 401, Level: [3]int{24, 55, 85}, MinHP: [3]int{1267, 0, 0}, MaxHP: [3]int{0, 0, 0}, Experience: [3]int{503, 0, 0}, Resistances: [3]Resistances{{Physical: 90, Magic: 90, Fire: 90, Lightning: 90, Cold: 90, Poison: 70}, {Physical: 90, Magic: 90, Fire: 90, Lightning: 90, Cold: 90, Poison: 90}, {Physical: 40, Magic: 90, Fire: 90, Lightning: 90, Cold: 90, Poison: 90}}},
+	402: {ID: 402, Level: [3]int{10, 42, 79}, MinHP: [3]int{291, 400, 400}, MaxHP: [3]int{291, 450, 450}, Experience: [3]int{151, 227, 227}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: -50}, {Physical: 10, Magic: 0, Fire: 25, Lightning: 0, Cold: 0, Poison: -50}, {Physical: 25, Magic: 0, Fire: 50, Lightning: 0, Cold: 0, Poison: -50}}},
+	403: {ID: 403, Level: [3]int{30, 57, 75}, MinHP: [3]int{57, 50, 50}, MaxHP: [3]int{76, 70, 70}, Experience: [3]int{1, 30, 30}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 70, Poison: 70}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 100, Poison: 90}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 150, Poison: 90}}},
+	404: {ID: 404, Level: [3]int{30, 57, 75}, MinHP: [3]int{57, 50, 50}, MaxHP: [3]int{76, 70, 70}, Experience: [3]int{1, 30, 30}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 70, Poison: 70}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 100, Poison: 90}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 150, Poison: 90}}},
+	405: {ID: 405, Level: [3]int{0, 0, 0}, MinHP: [3]int{0, 0, 0}, MaxHP: [3]int{0, 0, 0}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	406: {ID: 406, Level: [3]int{0, 0, 0}, MinHP: [3]int{0, 0, 0}, MaxHP: [3]int{0, 0, 0}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	407: {ID: 407, Level: [3]int{10, 43, 76}, MinHP: [3]int{64, 50, 50}, MaxHP: [3]int{110, 80, 80}, Experience: [3]int{76, 85, 85}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	408: {ID: 408, Level: [3]int{0, 0, 0}, MinHP: [3]int{0, 0, 0}, MaxHP: [3]int{0, 0, 0}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	409: {ID: 409, Level: [3]int{25, 60, 88}, MinHP: [3]int{760, 760, 760}, MaxHP: [3]int{760, 760, 760}, Experience: [3]int{339, 339, 339}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: -50}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: -50}, {Physical: 50, Magic: 0, Fire: 75, Lightning: 25, Cold: 25, Poison: 0}}},
+	410: {ID: 410, Level: [3]int{0, 0, 0}, MinHP: [3]int{100, 100, 100}, MaxHP: [3]int{100, 100, 100}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	411: {ID: 411, Level: [3]int{0, 0, 0}, MinHP: [3]int{100, 100, 100}, MaxHP: [3]int{100, 100, 100}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	412: {ID: 412, Level: [3]int{0, 0, 0}, MinHP: [3]int{100, 100, 100}, MaxHP: [3]int{100, 100, 100}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	413: {ID: 413, Level: [3]int{0, 0, 0}, MinHP: [3]int{100, 100, 100}, MaxHP: [3]int{100, 100, 100}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	414: {ID: 414, Level: [3]int{0, 0, 0}, MinHP: [3]int{0, 0, 0}, MaxHP: [3]int{0, 0, 0}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	415: {ID: 415, Level: [3]int{0, 0, 0}, MinHP: [3]int{100, 100, 100}, MaxHP: [3]int{100, 100, 100}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	416: {ID: 416, Level: [3]int{0, 0, 0}, MinHP: [3]int{100, 100, 100}, MaxHP: [3]int{100, 100, 100}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	417: {ID: 417, Level: [3]int{0, 0, 0}, MinHP: [3]int{376, 376, 376}, MaxHP: [3]int{376, 376, 376}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 40, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 40, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 40, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	418: {ID: 418, Level: [3]int{0, 0, 0}, MinHP: [3]int{376, 376, 376}, MaxHP: [3]int{376, 376, 376}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 40, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 40, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 40, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	419: {ID: 419, Level: [3]int{0, 0, 0}, MinHP: [3]int{26, 26, 26}, MaxHP: [3]int{26, 26, 26}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	420: {ID: 420, Level: [3]int{0, 0, 0}, MinHP: [3]int{130, 130, 130}, MaxHP: [3]int{130, 130, 130}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	421: {ID: 421, Level: [3]int{0, 0, 0}, MinHP: [3]int{216, 216, 216}, MaxHP: [3]int{216, 216, 216}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	422: {ID: 422, Level: [3]int{0, 0, 0}, MinHP: [3]int{213, 213, 213}, MaxHP: [3]int{213, 213, 213}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 25, Magic: 25, Fire: 25, Lightning: 25, Cold: 25, Poison: 70}, {Physical: 25, Magic: 25, Fire: 25, Lightning: 25, Cold: 25, Poison: 100}, {Physical: 25, Magic: 25, Fire: 25, Lightning: 25, Cold: 25, Poison: 100}}},
+	423: {ID: 423, Level: [3]int{0, 0, 0}, MinHP: [3]int{136, 136, 136}, MaxHP: [3]int{136, 136, 136}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 25, Magic: 25, Fire: 25, Lightning: 25, Cold: 25, Poison: 70}, {Physical: 25, Magic: 25, Fire: 25, Lightning: 25, Cold: 25, Poison: 100}, {Physical: 25, Magic: 25, Fire: 25, Lightning: 25, Cold: 25, Poison: 100}}},
+	424: {ID: 424, Level: [3]int{0, 0, 0}, MinHP: [3]int{60, 60, 60}, MaxHP: [3]int{60, 60, 60}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 25, Magic: 25, Fire: 25, Lightning: 25, Cold: 25, Poison: 70}, {Physical: 25, Magic: 25, Fire: 25, Lightning: 25, Cold: 25, Poison: 100}, {Physical: 25, Magic: 25, Fire: 25, Lightning: 25, Cold: 25, Poison: 100}}},
+	425: {ID: 425, Level: [3]int{0, 0, 0}, MinHP: [3]int{50, 50, 50}, MaxHP: [3]int{50, 50, 50}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	426: {ID: 426, Level: [3]int{0, 0, 0}, MinHP: [3]int{95, 95, 95}, MaxHP: [3]int{95, 95, 95}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	427: {ID: 427, Level: [3]int{0, 0, 0}, MinHP: [3]int{165, 165, 165}, MaxHP: [3]int{165, 165, 165}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	428: {ID: 428, Level: [3]int{0, 0, 0}, MinHP: [3]int{750, 650, 650}, MaxHP: [3]int{750, 650, 650}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	429: {ID: 429, Level: [3]int{0, 0, 0}, MinHP: [3]int{36, 115, 199}, MaxHP: [3]int{58, 184, 319}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	430: {ID: 430, Level: [3]int{0, 0, 0}, MinHP: [3]int{16, 46, 79}, MaxHP: [3]int{25, 74, 127}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	431: {ID: 431, Level: [3]int{0, 0, 0}, MinHP: [3]int{12, 34, 57}, MaxHP: [3]int{19, 54, 92}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	432: {ID: 432, Level: [3]int{26, 35, 67}, MinHP: [3]int{95, 150, 150}, MaxHP: [3]int{152, 200, 200}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 50, Poison: 1000}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 50, Poison: 1000}, {Physical: 50, Magic: 0, Fire: 0, Lightning: 0, Cold: 66, Poison: 1000}}},
+	433: {ID: 433, Level: [3]int{26, 35, 67}, MinHP: [3]int{95, 150, 150}, MaxHP: [3]int{152, 200, 200}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 50, Poison: 1000}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 50, Poison: 1000}, {Physical: 50, Magic: 0, Fire: 0, Lightning: 0, Cold: 66, Poison: 1000}}},
+	434: {ID: 434, Level: [3]int{26, 35, 67}, MinHP: [3]int{49, 50, 50}, MaxHP: [3]int{95, 95, 95}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 25, Magic: 25, Fire: 25, Lightning: 25, Cold: 25, Poison: 1000}, {Physical: 25, Magic: 25, Fire: 25, Lightning: 25, Cold: 25, Poison: 1000}, {Physical: 25, Magic: 25, Fire: 25, Lightning: 25, Cold: 66, Poison: 1000}}},
+	435: {ID: 435, Level: [3]int{15, 35, 67}, MinHP: [3]int{385, 700, 700}, MaxHP: [3]int{576, 800, 800}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 50, Poison: 1000}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 50, Poison: 1000}, {Physical: 75, Magic: 0, Fire: 110, Lightning: 0, Cold: 66, Poison: 1000}}},
+	436: {ID: 436, Level: [3]int{31, 61, 81}, MinHP: [3]int{115, 110, 110}, MaxHP: [3]int{171, 135, 135}, Experience: [3]int{128, 105, 105}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 70}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 70}, {Physical: 50, Magic: 0, Fire: 0, Lightning: 33, Cold: 130, Poison: 70}}},
+	437: {ID: 437, Level: [3]int{33, 62, 82}, MinHP: [3]int{114, 110, 110}, MaxHP: [3]int{171, 135, 135}, Experience: [3]int{135, 105, 105}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 80}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 80}, {Physical: 50, Magic: 0, Fire: 0, Lightning: 33, Cold: 150, Poison: 80}}},
+	438: {ID: 438, Level: [3]int{35, 63, 83}, MinHP: [3]int{114, 110, 110}, MaxHP: [3]int{171, 135, 135}, Experience: [3]int{143, 105, 105}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 90}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 90}, {Physical: 50, Magic: 0, Fire: 0, Lightning: 33, Cold: 170, Poison: 90}}},
+	439: {ID: 439, Level: [3]int{40, 64, 84}, MinHP: [3]int{114, 110, 110}, MaxHP: [3]int{171, 135, 135}, Experience: [3]int{164, 105, 105}, Resistances: [3]Resistances{{Physical: 20, Magic: 0, Fire: 50, Lightning: 0, Cold: 0, Poison: 70}, {Physical: 20, Magic: 0, Fire: 50, Lightning: 0, Cold: 0, Poison: 100}, {Physical: 50, Magic: 0, Fire: 50, Lightning: 33, Cold: 50, Poison: 100}}},
+	440: {ID: 440, Level: [3]int{42, 65, 85}, MinHP: [3]int{114, 110, 110}, MaxHP: [3]int{170, 135, 135}, Experience: [3]int{173, 105, 105}, Resistances: [3]Resistances{{Physical: 0, Magic: 50, Fire: 0, Lightning: 0, Cold: 0, Poison: 70}, {Physical: 0, Magic: 50, Fire: 0, Lightning: 0, Cold: 0, Poison: 100}, {Physical: 50, Magic: 50, Fire: 0, Lightning: 33, Cold: 50, Poison: 100}}},
+	441: {ID: 441, Level: [3]int{37, 59, 81}, MinHP: [3]int{379, 360, 360}, MaxHP: [3]int{379, 420, 420}, Experience: [3]int{287, 260, 260}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 50, Magic: 0, Fire: 110, Lightning: 33, Cold: 50, Poison: 0}}},
+	442: {ID: 442, Level: [3]int{38, 60, 81}, MinHP: [3]int{379, 360, 360}, MaxHP: [3]int{379, 420, 420}, Experience: [3]int{295, 260, 260}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 50, Magic: 0, Fire: 120, Lightning: 33, Cold: 50, Poison: 0}}},
+	443: {ID: 443, Level: [3]int{38, 60, 81}, MinHP: [3]int{379, 360, 360}, MaxHP: [3]int{379, 420, 420}, Experience: [3]int{295, 260, 260}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 50, Magic: 0, Fire: 75, Lightning: 33, Cold: 120, Poison: 0}}},
+	444: {ID: 444, Level: [3]int{38, 63, 84}, MinHP: [3]int{379, 360, 360}, MaxHP: [3]int{379, 420, 420}, Experience: [3]int{295, 260, 260}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 50, Magic: 0, Fire: 75, Lightning: 33, Cold: 130, Poison: 0}}},
+	445: {ID: 445, Level: [3]int{38, 64, 85}, MinHP: [3]int{379, 360, 360}, MaxHP: [3]int{379, 420, 420}, Experience: [3]int{295, 260, 260}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 50, Magic: 0, Fire: 75, Lightning: 33, Cold: 140, Poison: 0}}},
+	446: {ID: 446, Level: [3]int{40, 61, 82}, MinHP: [3]int{190, 155, 155}, MaxHP: [3]int{227, 210, 210}, Experience: [3]int{198, 110, 110}, Resistances: [3]Resistances{{Physical: 0, Magic: 20, Fire: 0, Lightning: 0, Cold: 75, Poison: 0}, {Physical: 25, Magic: 0, Fire: 0, Lightning: 0, Cold: 85, Poison: 0}, {Physical: 40, Magic: 0, Fire: 0, Lightning: 25, Cold: 150, Poison: 0}}},
+	447: {ID: 447, Level: [3]int{41, 61, 83}, MinHP: [3]int{190, 155, 155}, MaxHP: [3]int{228, 210, 210}, Experience: [3]int{204, 110, 110}, Resistances: [3]Resistances{{Physical: 0, Magic: 30, Fire: 0, Lightning: 0, Cold: 75, Poison: 0}, {Physical: 25, Magic: 0, Fire: 0, Lightning: 0, Cold: 85, Poison: 0}, {Physical: 40, Magic: 0, Fire: 0, Lightning: 25, Cold: 170, Poison: 0}}},
+	448: {ID: 448, Level: [3]int{42, 62, 84}, MinHP: [3]int{190, 155, 155}, MaxHP: [3]int{227, 210, 210}, Experience: [3]int{209, 110, 110}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 75, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 85, Poison: 0}, {Physical: 40, Magic: 0, Fire: 0, Lightning: 25, Cold: 190, Poison: 0}}},
+	449: {ID: 449, Level: [3]int{43, 63, 85}, MinHP: [3]int{189, 155, 155}, MaxHP: [3]int{228, 210, 210}, Experience: [3]int{215, 110, 110}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 75, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 85, Poison: 0}, {Physical: 40, Magic: 0, Fire: 0, Lightning: 25, Cold: 210, Poison: 0}}},
+	450: {ID: 450, Level: [3]int{0, 0, 0}, MinHP: [3]int{0, 0, 0}, MaxHP: [3]int{0, 0, 0}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	451: {ID: 451, Level: [3]int{0, 0, 0}, MinHP: [3]int{0, 0, 0}, MaxHP: [3]int{0, 0, 0}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	452: {ID: 452, Level: [3]int{0, 0, 0}, MinHP: [3]int{0, 0, 0}, MaxHP: [3]int{0, 0, 0}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	453: {ID: 453, Level: [3]int{31, 58, 79}, MinHP: [3]int{190, 100, 100}, MaxHP: [3]int{238, 125, 125}, Experience: [3]int{167, 80, 80}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 25, Lightning: 0, Cold: 50, Poison: 25}, {Physical: 25, Magic: 0, Fire: 66, Lightning: 15, Cold: 130, Poison: 15}}},
+	454: {ID: 454, Level: [3]int{33, 59, 81}, MinHP: [3]int{190, 100, 100}, MaxHP: [3]int{237, 125, 125}, Experience: [3]int{176, 80, 80}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 25, Magic: 0, Fire: 105, Lightning: 15, Cold: 33, Poison: 15}}},
+	455: {ID: 455, Level: [3]int{35, 60, 80}, MinHP: [3]int{189, 100, 100}, MaxHP: [3]int{237, 125, 125}, Experience: [3]int{186, 80, 80}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 25, Magic: 0, Fire: 33, Lightning: 15, Cold: 140, Poison: 15}}},
+	456: {ID: 456, Level: [3]int{36, 60, 81}, MinHP: [3]int{190, 100, 100}, MaxHP: [3]int{237, 125, 125}, Experience: [3]int{191, 80, 80}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 25, Magic: 0, Fire: 110, Lightning: 15, Cold: 33, Poison: 15}}},
+	457: {ID: 457, Level: [3]int{37, 62, 81}, MinHP: [3]int{190, 100, 100}, MaxHP: [3]int{237, 125, 125}, Experience: [3]int{197, 80, 80}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 33, Magic: 0, Fire: 115, Lightning: 15, Cold: 33, Poison: 15}}},
+	458: {ID: 458, Level: [3]int{40, 64, 81}, MinHP: [3]int{190, 100, 100}, MaxHP: [3]int{237, 125, 125}, Experience: [3]int{214, 80, 80}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 33, Magic: 0, Fire: 33, Lightning: 15, Cold: 150, Poison: 15}}},
+	459: {ID: 459, Level: [3]int{41, 65, 82}, MinHP: [3]int{190, 100, 100}, MaxHP: [3]int{236, 125, 125}, Experience: [3]int{220, 80, 80}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 33, Magic: 0, Fire: 120, Lightning: 15, Cold: 33, Poison: 15}}},
+	460: {ID: 460, Level: [3]int{42, 66, 82}, MinHP: [3]int{190, 100, 100}, MaxHP: [3]int{237, 125, 125}, Experience: [3]int{226, 80, 80}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 33, Magic: 0, Fire: 33, Lightning: 15, Cold: 160, Poison: 15}}},
+	461: {ID: 461, Level: [3]int{31, 58, 79}, MinHP: [3]int{38, 20, 20}, MaxHP: [3]int{57, 40, 40}, Experience: [3]int{178, 70, 70}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 25, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 25, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 25, Magic: 0, Fire: 66, Lightning: 15, Cold: 130, Poison: 15}}},
+	462: {ID: 462, Level: [3]int{33, 59, 79}, MinHP: [3]int{39, 20, 20}, MaxHP: [3]int{58, 40, 40}, Experience: [3]int{188, 70, 70}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 30, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 30, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 25, Magic: 0, Fire: 105, Lightning: 15, Cold: 33, Poison: 15}}},
+	463: {ID: 463, Level: [3]int{35, 60, 80}, MinHP: [3]int{38, 20, 20}, MaxHP: [3]int{57, 40, 40}, Experience: [3]int{198, 70, 70}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 35, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 35, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 25, Magic: 0, Fire: 33, Lightning: 15, Cold: 140, Poison: 15}}},
+	464: {ID: 464, Level: [3]int{36, 61, 80}, MinHP: [3]int{38, 20, 20}, MaxHP: [3]int{57, 40, 40}, Experience: [3]int{204, 70, 70}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 40, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 40, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 25, Magic: 0, Fire: 110, Lightning: 15, Cold: 33, Poison: 15}}},
+	465: {ID: 465, Level: [3]int{37, 62, 81}, MinHP: [3]int{39, 20, 20}, MaxHP: [3]int{57, 40, 40}, Experience: [3]int{210, 70, 70}, Resistances: [3]Resistances{{Physical: 0, Magic: 20, Fire: 70, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 20, Fire: 100, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 33, Magic: 20, Fire: 115, Lightning: 15, Cold: 33, Poison: 15}}},
+	466: {ID: 466, Level: [3]int{40, 64, 81}, MinHP: [3]int{38, 20, 20}, MaxHP: [3]int{58, 40, 40}, Experience: [3]int{228, 70, 70}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 35, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 35, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 33, Magic: 0, Fire: 33, Lightning: 15, Cold: 150, Poison: 15}}},
+	467: {ID: 467, Level: [3]int{41, 65, 82}, MinHP: [3]int{39, 20, 20}, MaxHP: [3]int{58, 40, 40}, Experience: [3]int{234, 70, 70}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 40, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 40, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 33, Magic: 0, Fire: 120, Lightning: 15, Cold: 33, Poison: 15}}},
+	468: {ID: 468, Level: [3]int{42, 66, 82}, MinHP: [3]int{39, 20, 20}, MaxHP: [3]int{57, 40, 40}, Experience: [3]int{241, 70, 70}, Resistances: [3]Resistances{{Physical: 0, Magic: 20, Fire: 70, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 20, Fire: 100, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 33, Magic: 20, Fire: 33, Lightning: 15, Cold: 160, Poison: 15}}},
+	469: {ID: 469, Level: [3]int{32, 61, 82}, MinHP: [3]int{96, 60, 60}, MaxHP: [3]int{152, 90, 90}, Experience: [3]int{121, 90, 90}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 10, Magic: 0, Fire: 120, Lightning: 50, Cold: 50, Poison: 25}}},
+	470: {ID: 470, Level: [3]int{34, 61, 82}, MinHP: [3]int{95, 60, 60}, MaxHP: [3]int{152, 90, 90}, Experience: [3]int{127, 90, 90}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 10, Magic: 0, Fire: 66, Lightning: 50, Cold: 50, Poison: 110}}},
+	471: {ID: 471, Level: [3]int{35, 62, 83}, MinHP: [3]int{95, 60, 60}, MaxHP: [3]int{152, 90, 90}, Experience: [3]int{131, 90, 90}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 10, Magic: 0, Fire: 66, Lightning: 100, Cold: 50, Poison: 25}}},
+	472: {ID: 472, Level: [3]int{40, 63, 84}, MinHP: [3]int{96, 60, 60}, MaxHP: [3]int{152, 90, 90}, Experience: [3]int{150, 90, 90}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 100, Magic: 0, Fire: 66, Lightning: 50, Cold: 50, Poison: 25}}},
+	473: {ID: 473, Level: [3]int{42, 64, 85}, MinHP: [3]int{95, 60, 60}, MaxHP: [3]int{152, 90, 90}, Experience: [3]int{159, 90, 90}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 25, Magic: 0, Fire: 66, Lightning: 50, Cold: 50, Poison: 120}}},
+	474: {ID: 474, Level: [3]int{32, 60, 81}, MinHP: [3]int{96, 60, 60}, MaxHP: [3]int{152, 90, 90}, Experience: [3]int{122, 145, 145}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 10, Magic: 0, Fire: 66, Lightning: 66, Cold: 140, Poison: 25}}},
+	475: {ID: 475, Level: [3]int{34, 61, 82}, MinHP: [3]int{95, 60, 60}, MaxHP: [3]int{152, 90, 90}, Experience: [3]int{130, 145, 145}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 10, Magic: 0, Fire: 110, Lightning: 66, Cold: 50, Poison: 25}}},
+	476: {ID: 476, Level: [3]int{35, 62, 83}, MinHP: [3]int{95, 60, 60}, MaxHP: [3]int{152, 90, 90}, Experience: [3]int{133, 145, 145}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 100, Magic: 0, Fire: 25, Lightning: 66, Cold: 50, Poison: 25}}},
+	477: {ID: 477, Level: [3]int{40, 63, 84}, MinHP: [3]int{96, 60, 60}, MaxHP: [3]int{152, 90, 90}, Experience: [3]int{153, 145, 145}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 10, Magic: 0, Fire: 120, Lightning: 66, Cold: 50, Poison: 25}}},
+	478: {ID: 478, Level: [3]int{42, 64, 85}, MinHP: [3]int{95, 60, 60}, MaxHP: [3]int{152, 90, 90}, Experience: [3]int{161, 145, 145}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 80, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 100, Poison: 0}, {Physical: 25, Magic: 0, Fire: 25, Lightning: 66, Cold: 160, Poison: 25}}},
+	479: {ID: 479, Level: [3]int{33, 58, 80}, MinHP: [3]int{380, 150, 150}, MaxHP: [3]int{380, 190, 190}, Experience: [3]int{121, 125, 125}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 40, Magic: 0, Fire: 33, Lightning: 20, Cold: 120, Poison: 0}}},
+	480: {ID: 480, Level: [3]int{34, 59, 81}, MinHP: [3]int{379, 150, 150}, MaxHP: [3]int{379, 190, 190}, Experience: [3]int{124, 125, 125}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 40, Magic: 0, Fire: 33, Lightning: 20, Cold: 130, Poison: 0}}},
+	481: {ID: 481, Level: [3]int{36, 61, 82}, MinHP: [3]int{379, 150, 150}, MaxHP: [3]int{379, 190, 190}, Experience: [3]int{131, 125, 125}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 40, Magic: 0, Fire: 33, Lightning: 20, Cold: 140, Poison: 0}}},
+	482: {ID: 482, Level: [3]int{37, 62, 83}, MinHP: [3]int{379, 150, 150}, MaxHP: [3]int{379, 190, 190}, Experience: [3]int{135, 125, 125}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 40, Magic: 0, Fire: 33, Lightning: 20, Cold: 150, Poison: 0}}},
+	483: {ID: 483, Level: [3]int{39, 60, 81}, MinHP: [3]int{379, 150, 150}, MaxHP: [3]int{379, 190, 190}, Experience: [3]int{142, 125, 125}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 40, Magic: 0, Fire: 33, Lightning: 20, Cold: 160, Poison: 0}}},
+	484: {ID: 484, Level: [3]int{14, 58, 79}, MinHP: [3]int{151, 50, 50}, MaxHP: [3]int{106, 90, 90}, Experience: [3]int{93, 100, 100}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	485: {ID: 485, Level: [3]int{14, 59, 79}, MinHP: [3]int{67, 50, 50}, MaxHP: [3]int{107, 90, 90}, Experience: [3]int{93, 100, 100}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	486: {ID: 486, Level: [3]int{14, 60, 80}, MinHP: [3]int{67, 50, 50}, MaxHP: [3]int{107, 90, 90}, Experience: [3]int{93, 100, 100}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	487: {ID: 487, Level: [3]int{14, 61, 80}, MinHP: [3]int{67, 50, 50}, MaxHP: [3]int{107, 90, 90}, Experience: [3]int{93, 100, 100}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	488: {ID: 488, Level: [3]int{14, 62, 81}, MinHP: [3]int{67, 50, 50}, MaxHP: [3]int{107, 90, 90}, Experience: [3]int{93, 100, 100}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	489: {ID: 489, Level: [3]int{14, 64, 81}, MinHP: [3]int{67, 50, 50}, MaxHP: [3]int{107, 90, 90}, Experience: [3]int{93, 100, 100}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	490: {ID: 490, Level: [3]int{14, 65, 82}, MinHP: [3]int{67, 50, 50}, MaxHP: [3]int{107, 90, 90}, Experience: [3]int{93, 100, 100}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	491: {ID: 491, Level: [3]int{14, 66, 82}, MinHP: [3]int{67, 50, 50}, MaxHP: [3]int{107, 90, 90}, Experience: [3]int{93, 100, 100}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	492: {ID: 492, Level: [3]int{31, 58, 80}, MinHP: [3]int{45, 45, 45}, MaxHP: [3]int{65, 65, 65}, Experience: [3]int{113, 120, 120}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 25, Fire: 100, Lightning: 25, Cold: 33, Poison: 0}}},
+	493: {ID: 493, Level: [3]int{33, 60, 83}, MinHP: [3]int{45, 45, 45}, MaxHP: [3]int{65, 65, 65}, Experience: [3]int{119, 120, 120}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 25, Fire: 105, Lightning: 25, Cold: 33, Poison: 0}}},
+	494: {ID: 494, Level: [3]int{34, 59, 81}, MinHP: [3]int{45, 45, 45}, MaxHP: [3]int{65, 65, 65}, Experience: [3]int{122, 120, 120}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 25, Fire: 110, Lightning: 25, Cold: 33, Poison: 0}}},
+	495: {ID: 495, Level: [3]int{36, 62, 84}, MinHP: [3]int{45, 45, 45}, MaxHP: [3]int{65, 65, 65}, Experience: [3]int{129, 120, 120}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 25, Fire: 115, Lightning: 25, Cold: 33, Poison: 0}}},
+	496: {ID: 496, Level: [3]int{38, 63, 85}, MinHP: [3]int{45, 45, 45}, MaxHP: [3]int{65, 65, 65}, Experience: [3]int{137, 120, 120}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 25, Fire: 120, Lightning: 25, Cold: 33, Poison: 0}}},
+	497: {ID: 497, Level: [3]int{34, 60, 80}, MinHP: [3]int{191, 300, 300}, MaxHP: [3]int{379, 350, 350}, Experience: [3]int{130, 200, 200}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 1000}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 1000}, {Physical: 33, Magic: 20, Fire: 0, Lightning: 0, Cold: 0, Poison: 1000}}},
+	498: {ID: 498, Level: [3]int{34, 60, 80}, MinHP: [3]int{191, 300, 300}, MaxHP: [3]int{379, 350, 350}, Experience: [3]int{130, 200, 200}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 1000}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 1000}, {Physical: 33, Magic: 20, Fire: 0, Lightning: 0, Cold: 0, Poison: 1000}}},
+	499: {ID: 499, Level: [3]int{32, 60, 80}, MinHP: [3]int{190, 300, 300}, MaxHP: [3]int{379, 350, 350}, Experience: [3]int{123, 200, 200}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 1000}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 1000}, {Physical: 33, Magic: 20, Fire: 0, Lightning: 0, Cold: 0, Poison: 1000}}},
+	500: {ID: 500, Level: [3]int{34, 60, 80}, MinHP: [3]int{191, 300, 300}, MaxHP: [3]int{379, 350, 350}, Experience: [3]int{130, 200, 200}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 1000}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 1000}, {Physical: 33, Magic: 20, Fire: 0, Lightning: 0, Cold: 0, Poison: 1000}}},
+	501: {ID: 501, Level: [3]int{37, 61, 82}, MinHP: [3]int{285, 190, 190}, MaxHP: [3]int{379, 230, 230}, Experience: [3]int{242, 120, 120}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 75, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 100, Poison: 0}, {Physical: 25, Magic: 0, Fire: 0, Lightning: 33, Cold: 150, Poison: 33}}},
+	502: {ID: 502, Level: [3]int{38, 61, 83}, MinHP: [3]int{284, 190, 190}, MaxHP: [3]int{379, 230, 230}, Experience: [3]int{249, 120, 120}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 75, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 100, Poison: 0}, {Physical: 25, Magic: 0, Fire: 0, Lightning: 33, Cold: 160, Poison: 33}}},
+	503: {ID: 503, Level: [3]int{39, 61, 84}, MinHP: [3]int{284, 190, 190}, MaxHP: [3]int{379, 230, 230}, Experience: [3]int{256, 120, 120}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 75, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 100, Poison: 0}, {Physical: 25, Magic: 0, Fire: 0, Lightning: 33, Cold: 170, Poison: 33}}},
+	504: {ID: 504, Level: [3]int{40, 63, 84}, MinHP: [3]int{285, 190, 190}, MaxHP: [3]int{379, 230, 230}, Experience: [3]int{263, 120, 120}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 75, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 100, Poison: 0}, {Physical: 25, Magic: 0, Fire: 0, Lightning: 33, Cold: 180, Poison: 33}}},
+	505: {ID: 505, Level: [3]int{41, 64, 85}, MinHP: [3]int{284, 190, 190}, MaxHP: [3]int{379, 230, 230}, Experience: [3]int{271, 120, 120}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 75, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 100, Poison: 0}, {Physical: 25, Magic: 0, Fire: 0, Lightning: 33, Cold: 190, Poison: 33}}},
+	506: {ID: 506, Level: [3]int{33, 61, 82}, MinHP: [3]int{284, 240, 240}, MaxHP: [3]int{380, 300, 300}, Experience: [3]int{240, 210, 210}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 66, Magic: 33, Fire: 110, Lightning: 33, Cold: 33, Poison: 33}}},
+	507: {ID: 507, Level: [3]int{35, 61, 82}, MinHP: [3]int{284, 240, 240}, MaxHP: [3]int{380, 300, 300}, Experience: [3]int{253, 210, 210}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 66, Magic: 33, Fire: 33, Lightning: 100, Cold: 33, Poison: 33}}},
+	508: {ID: 508, Level: [3]int{36, 61, 82}, MinHP: [3]int{284, 240, 240}, MaxHP: [3]int{379, 300, 300}, Experience: [3]int{260, 210, 210}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 100, Magic: 33, Fire: 33, Lightning: 33, Cold: 33, Poison: 33}}},
+	509: {ID: 509, Level: [3]int{40, 63, 84}, MinHP: [3]int{285, 240, 240}, MaxHP: [3]int{379, 300, 300}, Experience: [3]int{291, 210, 210}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 66, Magic: 33, Fire: 33, Lightning: 33, Cold: 135, Poison: 33}}},
+	510: {ID: 510, Level: [3]int{43, 65, 85}, MinHP: [3]int{283, 240, 240}, MaxHP: [3]int{379, 300, 300}, Experience: [3]int{316, 210, 210}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 66, Magic: 33, Fire: 120, Lightning: 33, Cold: 33, Poison: 33}}},
+	511: {ID: 511, Level: [3]int{0, 0, 0}, MinHP: [3]int{0, 0, 0}, MaxHP: [3]int{0, 0, 0}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	512: {ID: 512, Level: [3]int{0, 0, 0}, MinHP: [3]int{0, 0, 0}, MaxHP: [3]int{0, 0, 0}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	513: {ID: 513, Level: [3]int{0, 0, 0}, MinHP: [3]int{0, 0, 0}, MaxHP: [3]int{0, 0, 0}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	514: {ID: 514, Level: [3]int{0, 0, 0}, MinHP: [3]int{0, 0, 0}, MaxHP: [3]int{0, 0, 0}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	515: {ID: 515, Level: [3]int{0, 0, 0}, MinHP: [3]int{0, 0, 0}, MaxHP: [3]int{0, 0, 0}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	516: {ID: 516, Level: [3]int{0, 0, 0}, MinHP: [3]int{0, 0, 0}, MaxHP: [3]int{0, 0, 0}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	517: {ID: 517, Level: [3]int{0, 0, 0}, MinHP: [3]int{0, 0, 0}, MaxHP: [3]int{0, 0, 0}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	518: {ID: 518, Level: [3]int{0, 0, 0}, MinHP: [3]int{0, 0, 0}, MaxHP: [3]int{0, 0, 0}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	519: {ID: 519, Level: [3]int{0, 0, 0}, MinHP: [3]int{0, 0, 0}, MaxHP: [3]int{0, 0, 0}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	520: {ID: 520, Level: [3]int{0, 0, 0}, MinHP: [3]int{0, 0, 0}, MaxHP: [3]int{0, 0, 0}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	521: {ID: 521, Level: [3]int{0, 0, 0}, MinHP: [3]int{0, 0, 0}, MaxHP: [3]int{0, 0, 0}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	522: {ID: 522, Level: [3]int{24, 55, 73}, MinHP: [3]int{571, 60, 30}, MaxHP: [3]int{761, 80, 50}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 70}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 70}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 70}}},
+	523: {ID: 523, Level: [3]int{30, 55, 73}, MinHP: [3]int{226, 60, 30}, MaxHP: [3]int{355, 80, 50}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 70}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 70}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 70}}},
+	524: {ID: 524, Level: [3]int{26, 59, 75}, MinHP: [3]int{95, 250, 250}, MaxHP: [3]int{152, 300, 300}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 50, Poison: 1000}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 50, Poison: 1000}, {Physical: 66, Magic: 20, Fire: 50, Lightning: 0, Cold: 50, Poison: 1000}}},
+	525: {ID: 525, Level: [3]int{26, 59, 75}, MinHP: [3]int{95, 250, 250}, MaxHP: [3]int{152, 300, 300}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 50, Poison: 1000}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 50, Poison: 1000}, {Physical: 66, Magic: 20, Fire: 50, Lightning: 0, Cold: 50, Poison: 1000}}},
+	526: {ID: 526, Level: [3]int{65, 70, 92}, MinHP: [3]int{191, 191, 191}, MaxHP: [3]int{191, 191, 191}, Experience: [3]int{277, 277, 277}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 70, Poison: 70}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 70, Poison: 70}, {Physical: 0, Magic: 25, Fire: 33, Lightning: 33, Cold: 70, Poison: 70}}},
+	527: {ID: 527, Level: [3]int{0, 0, 0}, MinHP: [3]int{0, 0, 0}, MaxHP: [3]int{0, 0, 0}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	528: {ID: 528, Level: [3]int{30, 53, 81}, MinHP: [3]int{284, 330, 330}, MaxHP: [3]int{475, 400, 400}, Experience: [3]int{123, 123, 123}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 50, Lightning: 70, Cold: 70, Poison: 0}, {Physical: 0, Magic: 0, Fire: 70, Lightning: 70, Cold: 70, Poison: 0}, {Physical: 0, Magic: 0, Fire: 70, Lightning: 70, Cold: 70, Poison: 0}}},
+	529: {ID: 529, Level: [3]int{32, 58, 80}, MinHP: [3]int{123, 115, 115}, MaxHP: [3]int{152, 145, 145}, Experience: [3]int{153, 105, 105}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 25, Lightning: 50, Cold: 25, Poison: 0}, {Physical: 33, Magic: 0, Fire: 50, Lightning: 100, Cold: 50, Poison: 0}}},
+	530: {ID: 530, Level: [3]int{35, 59, 81}, MinHP: [3]int{124, 115, 115}, MaxHP: [3]int{152, 145, 145}, Experience: [3]int{166, 105, 105}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 33, Magic: 0, Fire: 50, Lightning: 100, Cold: 50, Poison: 0}}},
+	531: {ID: 531, Level: [3]int{38, 60, 81}, MinHP: [3]int{124, 115, 115}, MaxHP: [3]int{152, 145, 145}, Experience: [3]int{180, 105, 105}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 33, Magic: 0, Fire: 50, Lightning: 50, Cold: 135, Poison: 0}}},
+	532: {ID: 532, Level: [3]int{40, 61, 82}, MinHP: [3]int{124, 115, 115}, MaxHP: [3]int{152, 145, 145}, Experience: [3]int{191, 105, 105}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 100, Magic: 0, Fire: 25, Lightning: 25, Cold: 25, Poison: 0}}},
+	533: {ID: 533, Level: [3]int{41, 62, 83}, MinHP: [3]int{123, 115, 115}, MaxHP: [3]int{152, 145, 145}, Experience: [3]int{196, 105, 105}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 33, Magic: 0, Fire: 50, Lightning: 50, Cold: 145, Poison: 0}}},
+	534: {ID: 534, Level: [3]int{40, 55, 73}, MinHP: [3]int{946, 1100, 1100}, MaxHP: [3]int{1324, 1400, 1400}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 50, Fire: 50, Lightning: 50, Cold: 50, Poison: 50}, {Physical: 0, Magic: 50, Fire: 50, Lightning: 50, Cold: 50, Poison: 50}, {Physical: 0, Magic: 50, Fire: 50, Lightning: 50, Cold: 50, Poison: 50}}},
+	535: {ID: 535, Level: [3]int{30, 55, 73}, MinHP: [3]int{0, 0, 0}, MaxHP: [3]int{0, 0, 0}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 70}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	536: {ID: 536, Level: [3]int{30, 55, 73}, MinHP: [3]int{0, 0, 0}, MaxHP: [3]int{0, 0, 0}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	537: {ID: 537, Level: [3]int{40, 70, 90}, MinHP: [3]int{0, 0, 0}, MaxHP: [3]int{0, 0, 0}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	538: {ID: 538, Level: [3]int{40, 70, 90}, MinHP: [3]int{0, 0, 0}, MaxHP: [3]int{0, 0, 0}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	539: {ID: 539, Level: [3]int{40, 70, 90}, MinHP: [3]int{0, 0, 0}, MaxHP: [3]int{0, 0, 0}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	540: {ID: 540, Level: [3]int{37, 68, 87}, MinHP: [3]int{500, 600, 800}, MaxHP: [3]int{600, 800, 1000}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 70, Magic: 0, Fire: 70, Lightning: 0, Cold: 0, Poison: 70}, {Physical: 70, Magic: 0, Fire: 70, Lightning: 0, Cold: 0, Poison: 70}, {Physical: 40, Magic: 0, Fire: 110, Lightning: 50, Cold: 50, Poison: 50}}},
+	541: {ID: 541, Level: [3]int{37, 68, 87}, MinHP: [3]int{500, 600, 800}, MaxHP: [3]int{600, 800, 1000}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 70, Cold: 70, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 70, Cold: 70, Poison: 0}, {Physical: 40, Magic: 0, Fire: 50, Lightning: 100, Cold: 70, Poison: 50}}},
+	542: {ID: 542, Level: [3]int{37, 68, 87}, MinHP: [3]int{500, 600, 800}, MaxHP: [3]int{600, 800, 1000}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 70, Magic: 0, Fire: 70, Lightning: 0, Cold: 0, Poison: 70}, {Physical: 70, Magic: 0, Fire: 70, Lightning: 0, Cold: 0, Poison: 70}, {Physical: 40, Magic: 0, Fire: 70, Lightning: 50, Cold: 150, Poison: 50}}},
+	543: {ID: 543, Level: [3]int{60, 70, 90}, MinHP: [3]int{6336, 6336, 6336}, MaxHP: [3]int{6336, 6336, 6336}, Experience: [3]int{6333, 6333, 6333}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 33, Lightning: 33, Cold: 33, Poison: 50}, {Physical: 0, Magic: 0, Fire: 50, Lightning: 50, Cold: 50, Poison: 50}, {Physical: 50, Magic: 0, Fire: 50, Lightning: 50, Cold: 50, Poison: 50}}},
+	544: {ID: 544, Level: [3]int{60, 75, 99}, MinHP: [3]int{6336, 6336, 6336}, MaxHP: [3]int{6336, 6336, 6336}, Experience: [3]int{6460, 6460, 6460}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 33, Lightning: 33, Cold: 33, Poison: 50}, {Physical: 0, Magic: 0, Fire: 50, Lightning: 50, Cold: 50, Poison: 50}, {Physical: 50, Magic: 0, Fire: 50, Lightning: 50, Cold: 50, Poison: 50}}},
+	545: {ID: 545, Level: [3]int{0, 33, 66}, MinHP: [3]int{0, 0, 0}, MaxHP: [3]int{0, 0, 0}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	546: {ID: 546, Level: [3]int{35, 61, 80}, MinHP: [3]int{114, 80, 80}, MaxHP: [3]int{189, 130, 130}, Experience: [3]int{149, 110, 110}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 33, Lightning: 33, Cold: 33, Poison: 50}, {Physical: 0, Magic: 0, Fire: 50, Lightning: 50, Cold: 50, Poison: 50}, {Physical: 0, Magic: 0, Fire: 50, Lightning: 50, Cold: 50, Poison: 100}}},
+	547: {ID: 547, Level: [3]int{37, 62, 81}, MinHP: [3]int{114, 80, 80}, MaxHP: [3]int{190, 130, 130}, Experience: [3]int{157, 110, 110}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 33, Lightning: 33, Cold: 33, Poison: 50}, {Physical: 0, Magic: 0, Fire: 50, Lightning: 50, Cold: 50, Poison: 50}, {Physical: 0, Magic: 0, Fire: 50, Lightning: 50, Cold: 50, Poison: 105}}},
+	548: {ID: 548, Level: [3]int{38, 63, 82}, MinHP: [3]int{115, 80, 80}, MaxHP: [3]int{190, 130, 130}, Experience: [3]int{162, 110, 110}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 33, Lightning: 33, Cold: 33, Poison: 50}, {Physical: 0, Magic: 0, Fire: 50, Lightning: 50, Cold: 50, Poison: 50}, {Physical: 0, Magic: 0, Fire: 50, Lightning: 50, Cold: 50, Poison: 110}}},
+	549: {ID: 549, Level: [3]int{39, 64, 83}, MinHP: [3]int{114, 80, 80}, MaxHP: [3]int{189, 130, 130}, Experience: [3]int{166, 110, 110}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 33, Lightning: 33, Cold: 33, Poison: 50}, {Physical: 0, Magic: 0, Fire: 50, Lightning: 50, Cold: 50, Poison: 50}, {Physical: 0, Magic: 0, Fire: 50, Lightning: 50, Cold: 50, Poison: 115}}},
+	550: {ID: 550, Level: [3]int{40, 65, 84}, MinHP: [3]int{114, 80, 80}, MaxHP: [3]int{190, 130, 130}, Experience: [3]int{171, 110, 110}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 33, Lightning: 33, Cold: 33, Poison: 50}, {Physical: 0, Magic: 0, Fire: 50, Lightning: 50, Cold: 50, Poison: 50}, {Physical: 0, Magic: 0, Fire: 50, Lightning: 50, Cold: 50, Poison: 120}}},
+	551: {ID: 551, Level: [3]int{34, 61, 80}, MinHP: [3]int{65, 35, 35}, MaxHP: [3]int{106, 65, 65}, Experience: [3]int{5, 5, 5}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 100, Lightning: 0, Cold: 50, Poison: 0}}},
+	552: {ID: 552, Level: [3]int{35, 62, 81}, MinHP: [3]int{64, 35, 35}, MaxHP: [3]int{106, 65, 65}, Experience: [3]int{5, 5, 5}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 100, Cold: 50, Poison: 0}}},
+	553: {ID: 553, Level: [3]int{36, 63, 82}, MinHP: [3]int{65, 35, 35}, MaxHP: [3]int{106, 65, 65}, Experience: [3]int{5, 5, 5}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 140, Poison: 0}}},
+	554: {ID: 554, Level: [3]int{37, 64, 83}, MinHP: [3]int{65, 35, 35}, MaxHP: [3]int{107, 65, 65}, Experience: [3]int{5, 5, 5}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 50, Poison: 100}}},
+	555: {ID: 555, Level: [3]int{42, 65, 84}, MinHP: [3]int{64, 35, 35}, MaxHP: [3]int{106, 65, 65}, Experience: [3]int{6, 5, 5}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 150, Poison: 0}}},
+	556: {ID: 556, Level: [3]int{0, 0, 0}, MinHP: [3]int{0, 0, 0}, MaxHP: [3]int{0, 0, 0}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	557: {ID: 557, Level: [3]int{40, 68, 93}, MinHP: [3]int{190, 220, 220}, MaxHP: [3]int{379, 270, 270}, Experience: [3]int{225, 225, 225}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	558: {ID: 558, Level: [3]int{40, 68, 93}, MinHP: [3]int{190, 300, 300}, MaxHP: [3]int{379, 350, 350}, Experience: [3]int{239, 239, 239}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 70, Lightning: 0, Cold: 0, Poison: 70}, {Physical: 0, Magic: 0, Fire: 80, Lightning: 0, Cold: 0, Poison: 100}, {Physical: 0, Magic: 0, Fire: 95, Lightning: 0, Cold: 0, Poison: 120}}},
+	559: {ID: 559, Level: [3]int{60, 70, 96}, MinHP: [3]int{0, 0, 0}, MaxHP: [3]int{0, 0, 0}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 33, Lightning: 33, Cold: 33, Poison: 50}, {Physical: 0, Magic: 0, Fire: 50, Lightning: 50, Cold: 50, Poison: 50}, {Physical: 0, Magic: 0, Fire: 50, Lightning: 50, Cold: 50, Poison: 50}}},
+	560: {ID: 560, Level: [3]int{1, 1, 1}, MinHP: [3]int{0, 0, 0}, MaxHP: [3]int{0, 0, 0}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 70}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 70}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 70}}},
+	561: {ID: 561, Level: [3]int{1, 1, 1}, MinHP: [3]int{0, 0, 0}, MaxHP: [3]int{0, 0, 0}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 70}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 70}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 70}}},
+	562: {ID: 562, Level: [3]int{34, 67, 87}, MinHP: [3]int{65, 50, 50}, MaxHP: [3]int{106, 80, 80}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 25, Magic: 0, Fire: 25, Lightning: 0, Cold: 110, Poison: 0}}},
+	563: {ID: 563, Level: [3]int{35, 67, 87}, MinHP: [3]int{64, 50, 50}, MaxHP: [3]int{106, 80, 80}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 25, Magic: 0, Fire: 25, Lightning: 0, Cold: 115, Poison: 0}}},
+	564: {ID: 564, Level: [3]int{36, 67, 87}, MinHP: [3]int{65, 50, 50}, MaxHP: [3]int{106, 80, 80}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 25, Magic: 0, Fire: 25, Lightning: 0, Cold: 120, Poison: 0}}},
+	565: {ID: 565, Level: [3]int{37, 67, 87}, MinHP: [3]int{65, 50, 50}, MaxHP: [3]int{107, 80, 80}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 25, Magic: 0, Fire: 25, Lightning: 0, Cold: 125, Poison: 0}}},
+	566: {ID: 566, Level: [3]int{42, 67, 87}, MinHP: [3]int{64, 50, 50}, MaxHP: [3]int{106, 80, 80}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 25, Magic: 0, Fire: 25, Lightning: 0, Cold: 130, Poison: 0}}},
+	567: {ID: 567, Level: [3]int{1, 1, 1}, MinHP: [3]int{0, 0, 0}, MaxHP: [3]int{0, 0, 0}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	568: {ID: 568, Level: [3]int{1, 1, 1}, MinHP: [3]int{0, 0, 0}, MaxHP: [3]int{0, 0, 0}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	569: {ID: 569, Level: [3]int{1, 1, 1}, MinHP: [3]int{0, 0, 0}, MaxHP: [3]int{0, 0, 0}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	570: {ID: 570, Level: [3]int{60, 69, 95}, MinHP: [3]int{1901, 2801, 2801}, MaxHP: [3]int{1901, 2801, 2801}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 15, Lightning: 15, Cold: 15, Poison: 33}, {Physical: 0, Magic: 0, Fire: 25, Lightning: 25, Cold: 25, Poison: 25}, {Physical: 50, Magic: 0, Fire: 25, Lightning: 25, Cold: 25, Poison: 25}}},
+	571: {ID: 571, Level: [3]int{55, 68, 92}, MinHP: [3]int{472, 430, 430}, MaxHP: [3]int{660, 480, 480}, Experience: [3]int{634, 247, 247}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 50, Lightning: 0, Cold: 50, Poison: 95}, {Physical: 0, Magic: 0, Fire: 95, Lightning: 0, Cold: 50, Poison: 95}, {Physical: 50, Magic: 0, Fire: 120, Lightning: 33, Cold: 50, Poison: 95}}},
+	572: {ID: 572, Level: [3]int{57, 68, 92}, MinHP: [3]int{472, 430, 430}, MaxHP: [3]int{661, 480, 480}, Experience: [3]int{683, 247, 247}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 50, Lightning: 0, Cold: 50, Poison: 95}, {Physical: 0, Magic: 0, Fire: 95, Lightning: 0, Cold: 50, Poison: 95}, {Physical: 50, Magic: 0, Fire: 120, Lightning: 33, Cold: 50, Poison: 95}}},
+	573: {ID: 573, Level: [3]int{60, 68, 92}, MinHP: [3]int{476, 430, 430}, MaxHP: [3]int{666, 480, 480}, Experience: [3]int{763, 247, 247}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 50, Lightning: 0, Cold: 50, Poison: 95}, {Physical: 0, Magic: 0, Fire: 95, Lightning: 0, Cold: 50, Poison: 95}, {Physical: 50, Magic: 0, Fire: 120, Lightning: 33, Cold: 50, Poison: 95}}},
+	574: {ID: 574, Level: [3]int{0, 0, 0}, MinHP: [3]int{0, 0, 0}, MaxHP: [3]int{0, 0, 0}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	575: {ID: 575, Level: [3]int{32, 58, 80}, MinHP: [3]int{70, 70, 70}, MaxHP: [3]int{100, 100, 100}, Experience: [3]int{95, 95, 95}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 50, Lightning: 25, Cold: 0, Poison: 100}, {Physical: 0, Magic: 0, Fire: 75, Lightning: 33, Cold: 0, Poison: 100}, {Physical: 33, Magic: 10, Fire: 90, Lightning: 50, Cold: 25, Poison: 100}}},
+	576: {ID: 576, Level: [3]int{35, 60, 71}, MinHP: [3]int{70, 70, 70}, MaxHP: [3]int{100, 100, 100}, Experience: [3]int{95, 95, 95}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 25, Cold: 50, Poison: 110}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 33, Cold: 75, Poison: 110}, {Physical: 33, Magic: 10, Fire: 25, Lightning: 50, Cold: 90, Poison: 110}}},
+	577: {ID: 577, Level: [3]int{38, 61, 77}, MinHP: [3]int{70, 70, 70}, MaxHP: [3]int{100, 100, 100}, Experience: [3]int{95, 95, 95}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 50, Lightning: 25, Cold: 0, Poison: 120}, {Physical: 0, Magic: 0, Fire: 75, Lightning: 33, Cold: 0, Poison: 120}, {Physical: 33, Magic: 10, Fire: 90, Lightning: 50, Cold: 25, Poison: 120}}},
+	578: {ID: 578, Level: [3]int{40, 62, 83}, MinHP: [3]int{70, 70, 70}, MaxHP: [3]int{100, 100, 100}, Experience: [3]int{95, 95, 95}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 25, Cold: 0, Poison: 130}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 33, Cold: 75, Poison: 130}, {Physical: 33, Magic: 10, Fire: 25, Lightning: 50, Cold: 90, Poison: 130}}},
+	579: {ID: 579, Level: [3]int{41, 63, 85}, MinHP: [3]int{70, 70, 70}, MaxHP: [3]int{100, 100, 100}, Experience: [3]int{95, 95, 95}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 33, Lightning: 33, Cold: 33, Poison: 140}, {Physical: 0, Magic: 0, Fire: 50, Lightning: 50, Cold: 50, Poison: 140}, {Physical: 33, Magic: 10, Fire: 66, Lightning: 66, Cold: 66, Poison: 140}}},
+	580: {ID: 580, Level: [3]int{32, 58, 70}, MinHP: [3]int{80, 80, 80}, MaxHP: [3]int{130, 120, 120}, Experience: [3]int{100, 125, 125}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 33, Lightning: 80, Cold: 50, Poison: 0}, {Physical: 0, Magic: 0, Fire: 33, Lightning: 100, Cold: 75, Poison: 0}, {Physical: 25, Magic: 0, Fire: 50, Lightning: 100, Cold: 90, Poison: 15}}},
+	581: {ID: 581, Level: [3]int{34, 60, 71}, MinHP: [3]int{80, 80, 80}, MaxHP: [3]int{130, 120, 120}, Experience: [3]int{100, 125, 125}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 50, Lightning: 80, Cold: 33, Poison: 0}, {Physical: 0, Magic: 0, Fire: 75, Lightning: 100, Cold: 33, Poison: 0}, {Physical: 25, Magic: 0, Fire: 90, Lightning: 100, Cold: 50, Poison: 15}}},
+	582: {ID: 582, Level: [3]int{37, 61, 77}, MinHP: [3]int{80, 80, 80}, MaxHP: [3]int{130, 120, 120}, Experience: [3]int{100, 125, 125}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 50, Lightning: 80, Cold: 50, Poison: 0}, {Physical: 0, Magic: 0, Fire: 50, Lightning: 100, Cold: 50, Poison: 0}, {Physical: 25, Magic: 0, Fire: 75, Lightning: 100, Cold: 75, Poison: 15}}},
+	583: {ID: 583, Level: [3]int{39, 62, 83}, MinHP: [3]int{80, 80, 80}, MaxHP: [3]int{130, 120, 120}, Experience: [3]int{100, 125, 125}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 75, Lightning: 80, Cold: 50, Poison: 0}, {Physical: 0, Magic: 0, Fire: 75, Lightning: 100, Cold: 50, Poison: 0}, {Physical: 25, Magic: 0, Fire: 90, Lightning: 100, Cold: 50, Poison: 15}}},
+	584: {ID: 584, Level: [3]int{41, 63, 85}, MinHP: [3]int{80, 80, 80}, MaxHP: [3]int{130, 120, 120}, Experience: [3]int{100, 125, 125}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 50, Lightning: 80, Cold: 75, Poison: 33}, {Physical: 0, Magic: 0, Fire: 50, Lightning: 100, Cold: 75, Poison: 33}, {Physical: 25, Magic: 0, Fire: 85, Lightning: 100, Cold: 85, Poison: 50}}},
+	585: {ID: 585, Level: [3]int{32, 59, 81}, MinHP: [3]int{115, 115, 115}, MaxHP: [3]int{140, 140, 140}, Experience: [3]int{100, 100, 100}, Resistances: [3]Resistances{{Physical: 0, Magic: 30, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 50, Fire: 0, Lightning: 100, Cold: 0, Poison: 25}, {Physical: 40, Magic: 50, Fire: 50, Lightning: 100, Cold: 25, Poison: 25}}},
+	586: {ID: 586, Level: [3]int{35, 61, 83}, MinHP: [3]int{115, 115, 115}, MaxHP: [3]int{140, 140, 140}, Experience: [3]int{100, 100, 100}, Resistances: [3]Resistances{{Physical: 0, Magic: 30, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 50, Fire: 0, Lightning: 0, Cold: 100, Poison: 25}, {Physical: 40, Magic: 50, Fire: 25, Lightning: 50, Cold: 135, Poison: 25}}},
+	587: {ID: 587, Level: [3]int{38, 61, 84}, MinHP: [3]int{115, 115, 115}, MaxHP: [3]int{140, 140, 140}, Experience: [3]int{100, 100, 100}, Resistances: [3]Resistances{{Physical: 0, Magic: 30, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 50, Fire: 0, Lightning: 0, Cold: 100, Poison: 25}, {Physical: 40, Magic: 50, Fire: 25, Lightning: 50, Cold: 155, Poison: 25}}},
+	588: {ID: 588, Level: [3]int{40, 62, 81}, MinHP: [3]int{115, 115, 115}, MaxHP: [3]int{140, 140, 140}, Experience: [3]int{100, 100, 100}, Resistances: [3]Resistances{{Physical: 0, Magic: 30, Fire: 60, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 50, Fire: 100, Lightning: 0, Cold: 0, Poison: 25}, {Physical: 40, Magic: 50, Fire: 110, Lightning: 25, Cold: 50, Poison: 25}}},
+	589: {ID: 589, Level: [3]int{41, 63, 84}, MinHP: [3]int{115, 115, 115}, MaxHP: [3]int{140, 140, 140}, Experience: [3]int{100, 100, 100}, Resistances: [3]Resistances{{Physical: 0, Magic: 30, Fire: 30, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 50, Fire: 0, Lightning: 0, Cold: 100, Poison: 50}, {Physical: 40, Magic: 50, Fire: 33, Lightning: 33, Cold: 165, Poison: 50}}},
+	590: {ID: 590, Level: [3]int{32, 58, 80}, MinHP: [3]int{40, 40, 40}, MaxHP: [3]int{55, 55, 55}, Experience: [3]int{75, 75, 75}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 60, Poison: 0}, {Physical: 0, Magic: 40, Fire: 0, Lightning: 0, Cold: 80, Poison: 0}, {Physical: 0, Magic: 50, Fire: 33, Lightning: 25, Cold: 120, Poison: 0}}},
+	591: {ID: 591, Level: [3]int{35, 60, 71}, MinHP: [3]int{40, 40, 40}, MaxHP: [3]int{55, 55, 55}, Experience: [3]int{75, 75, 75}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 60, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 40, Fire: 80, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 50, Fire: 100, Lightning: 25, Cold: 15, Poison: 0}}},
+	592: {ID: 592, Level: [3]int{38, 61, 77}, MinHP: [3]int{40, 40, 40}, MaxHP: [3]int{55, 55, 55}, Experience: [3]int{75, 75, 75}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 60}, {Physical: 0, Magic: 40, Fire: 0, Lightning: 0, Cold: 0, Poison: 100}, {Physical: 0, Magic: 50, Fire: 33, Lightning: 25, Cold: 15, Poison: 110}}},
+	593: {ID: 593, Level: [3]int{40, 62, 82}, MinHP: [3]int{40, 40, 40}, MaxHP: [3]int{55, 55, 55}, Experience: [3]int{75, 75, 75}, Resistances: [3]Resistances{{Physical: 30, Magic: 0, Fire: 20, Lightning: 60, Cold: 0, Poison: 0}, {Physical: 40, Magic: 0, Fire: 50, Lightning: 100, Cold: 0, Poison: 0}, {Physical: 60, Magic: 0, Fire: 50, Lightning: 100, Cold: 15, Poison: 0}}},
+	594: {ID: 594, Level: [3]int{32, 61, 82}, MinHP: [3]int{70, 70, 70}, MaxHP: [3]int{95, 95, 95}, Experience: [3]int{100, 100, 100}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 50, Cold: 50, Poison: 50}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 75, Cold: 75, Poison: 75}, {Physical: 25, Magic: 25, Fire: 25, Lightning: 90, Cold: 90, Poison: 90}}},
+	595: {ID: 595, Level: [3]int{35, 61, 83}, MinHP: [3]int{70, 70, 70}, MaxHP: [3]int{95, 95, 95}, Experience: [3]int{100, 100, 100}, Resistances: [3]Resistances{{Physical: 25, Magic: 0, Fire: 50, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 50, Magic: 0, Fire: 50, Lightning: 0, Cold: 0, Poison: 50}, {Physical: 70, Magic: 0, Fire: 70, Lightning: 25, Cold: 145, Poison: 110}}},
+	596: {ID: 596, Level: [3]int{38, 61, 77}, MinHP: [3]int{70, 70, 70}, MaxHP: [3]int{95, 95, 95}, Experience: [3]int{100, 100, 100}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 75, Lightning: 0, Cold: 60, Poison: 0}, {Physical: 0, Magic: 0, Fire: 100, Lightning: 0, Cold: 75, Poison: 0}, {Physical: 25, Magic: 0, Fire: 115, Lightning: 25, Cold: 0, Poison: 50}}},
+	597: {ID: 597, Level: [3]int{40, 62, 81}, MinHP: [3]int{70, 70, 70}, MaxHP: [3]int{95, 95, 95}, Experience: [3]int{100, 100, 100}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 60, Cold: 0, Poison: 75}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 80, Cold: 0, Poison: 100}, {Physical: 25, Magic: 0, Fire: 33, Lightning: 100, Cold: 0, Poison: 110}}},
+	598: {ID: 598, Level: [3]int{41, 63, 85}, MinHP: [3]int{70, 70, 70}, MaxHP: [3]int{95, 95, 95}, Experience: [3]int{100, 100, 100}, Resistances: [3]Resistances{{Physical: 0, Magic: 40, Fire: 20, Lightning: 20, Cold: 20, Poison: 20}, {Physical: 0, Magic: 60, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 25, Magic: 70, Fire: 33, Lightning: 25, Cold: 0, Poison: 115}}},
+	599: {ID: 599, Level: [3]int{33, 59, 66}, MinHP: [3]int{120, 120, 120}, MaxHP: [3]int{150, 150, 150}, Experience: [3]int{120, 120, 120}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 80, Lightning: 50, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 100, Lightning: 70, Cold: 0, Poison: 0}, {Physical: 35, Magic: 0, Fire: 120, Lightning: 85, Cold: 40, Poison: 0}}},
+	600: {ID: 600, Level: [3]int{36, 60, 72}, MinHP: [3]int{120, 120, 120}, MaxHP: [3]int{150, 150, 150}, Experience: [3]int{120, 120, 120}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 35, Magic: 0, Fire: 50, Lightning: 50, Cold: 150, Poison: 25}}},
+	601: {ID: 601, Level: [3]int{39, 61, 78}, MinHP: [3]int{120, 120, 120}, MaxHP: [3]int{150, 150, 150}, Experience: [3]int{120, 120, 120}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 35, Magic: 0, Fire: 125, Lightning: 50, Cold: 50, Poison: 25}}},
+	602: {ID: 602, Level: [3]int{40, 62, 82}, MinHP: [3]int{120, 120, 120}, MaxHP: [3]int{150, 150, 150}, Experience: [3]int{120, 120, 120}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 35, Magic: 0, Fire: 50, Lightning: 50, Cold: 160, Poison: 25}}},
+	603: {ID: 603, Level: [3]int{41, 63, 84}, MinHP: [3]int{120, 120, 120}, MaxHP: [3]int{150, 150, 150}, Experience: [3]int{120, 120, 120}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 35, Magic: 0, Fire: 130, Lightning: 50, Cold: 50, Poison: 25}}},
+	604: {ID: 604, Level: [3]int{32, 49, 74}, MinHP: [3]int{123, 115, 115}, MaxHP: [3]int{152, 145, 145}, Experience: [3]int{153, 105, 105}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 25, Magic: 0, Fire: 15, Lightning: 100, Cold: 15, Poison: 15}}},
+	605: {ID: 605, Level: [3]int{32, 58, 80}, MinHP: [3]int{90, 90, 90}, MaxHP: [3]int{120, 120, 120}, Experience: [3]int{71, 70, 70}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 40, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 33, Magic: 0, Fire: 100, Lightning: 50, Cold: 25, Poison: 33}}},
+	606: {ID: 606, Level: [3]int{32, 58, 80}, MinHP: [3]int{90, 90, 90}, MaxHP: [3]int{120, 120, 120}, Experience: [3]int{71, 70, 70}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 50, Poison: 0}, {Physical: 33, Magic: 0, Fire: 25, Lightning: 50, Cold: 100, Poison: 33}}},
+	607: {ID: 607, Level: [3]int{32, 58, 80}, MinHP: [3]int{90, 90, 90}, MaxHP: [3]int{120, 120, 120}, Experience: [3]int{71, 70, 70}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 50, Poison: 0}, {Physical: 33, Magic: 0, Fire: 25, Lightning: 100, Cold: 75, Poison: 33}}},
+	608: {ID: 608, Level: [3]int{14, 58, 80}, MinHP: [3]int{67, 60, 60}, MaxHP: [3]int{107, 90, 90}, Experience: [3]int{83, 80, 80}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 80}, {Physical: 10, Magic: 0, Fire: 0, Lightning: 0, Cold: 33, Poison: 100}}},
+	609: {ID: 609, Level: [3]int{27, 58, 80}, MinHP: [3]int{152, 190, 190}, MaxHP: [3]int{247, 250, 250}, Experience: [3]int{133, 130, 130}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 66, Magic: 0, Fire: 0, Lightning: 100, Cold: 40, Poison: 66}}},
+	610: {ID: 610, Level: [3]int{19, 58, 80}, MinHP: [3]int{53, 55, 55}, MaxHP: [3]int{86, 85, 85}, Experience: [3]int{67, 80, 80}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 25, Magic: 0, Fire: 110, Lightning: 15, Cold: 15, Poison: 15}}},
+	611: {ID: 611, Level: [3]int{19, 59, 81}, MinHP: [3]int{53, 55, 55}, MaxHP: [3]int{86, 85, 85}, Experience: [3]int{67, 80, 80}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 25, Lightning: 0, Cold: 50, Poison: 0}, {Physical: 25, Magic: 0, Fire: 50, Lightning: 15, Cold: 150, Poison: 15}}},
+	612: {ID: 612, Level: [3]int{19, 60, 81}, MinHP: [3]int{53, 55, 55}, MaxHP: [3]int{86, 85, 85}, Experience: [3]int{67, 80, 80}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 25, Fire: 25, Lightning: 25, Cold: 25, Poison: 50}, {Physical: 25, Magic: 0, Fire: 50, Lightning: 50, Cold: 50, Poison: 100}}},
+	613: {ID: 613, Level: [3]int{5, 59, 81}, MinHP: [3]int{93, 55, 55}, MaxHP: [3]int{131, 95, 95}, Experience: [3]int{91, 100, 100}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 50, Lightning: 25, Cold: 0, Poison: 0}, {Physical: 15, Magic: 0, Fire: 115, Lightning: 50, Cold: 0, Poison: 15}}},
+	614: {ID: 614, Level: [3]int{5, 61, 82}, MinHP: [3]int{93, 55, 55}, MaxHP: [3]int{131, 95, 95}, Experience: [3]int{91, 100, 100}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 25, Lightning: 25, Cold: 50, Poison: 0}, {Physical: 15, Magic: 0, Fire: 50, Lightning: 50, Cold: 140, Poison: 15}}},
+	615: {ID: 615, Level: [3]int{5, 59, 81}, MinHP: [3]int{85, 80, 80}, MaxHP: [3]int{124, 115, 115}, Experience: [3]int{91, 85, 85}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 50, Cold: 25, Poison: 0}, {Physical: 33, Magic: 0, Fire: 25, Lightning: 100, Cold: 50, Poison: 15}}},
+	616: {ID: 616, Level: [3]int{5, 59, 81}, MinHP: [3]int{85, 80, 80}, MaxHP: [3]int{124, 115, 115}, Experience: [3]int{91, 85, 85}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 50, Cold: 25, Poison: 0}, {Physical: 33, Magic: 0, Fire: 25, Lightning: 100, Cold: 50, Poison: 15}}},
+	617: {ID: 617, Level: [3]int{5, 59, 81}, MinHP: [3]int{85, 80, 80}, MaxHP: [3]int{124, 115, 115}, Experience: [3]int{91, 85, 85}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 50, Cold: 25, Poison: 0}, {Physical: 33, Magic: 0, Fire: 25, Lightning: 100, Cold: 50, Poison: 15}}},
+	618: {ID: 618, Level: [3]int{18, 59, 81}, MinHP: [3]int{108, 200, 200}, MaxHP: [3]int{193, 260, 260}, Experience: [3]int{114, 130, 130}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 40, Magic: 0, Fire: 15, Lightning: 15, Cold: 15, Poison: 0}, {Physical: 75, Magic: 0, Fire: 33, Lightning: 33, Cold: 33, Poison: 0}}},
+	619: {ID: 619, Level: [3]int{18, 59, 81}, MinHP: [3]int{108, 200, 200}, MaxHP: [3]int{193, 260, 260}, Experience: [3]int{114, 130, 130}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 40, Magic: 0, Fire: 15, Lightning: 15, Cold: 15, Poison: 0}, {Physical: 75, Magic: 0, Fire: 33, Lightning: 33, Cold: 33, Poison: 0}}},
+	620: {ID: 620, Level: [3]int{8, 59, 81}, MinHP: [3]int{56, 55, 55}, MaxHP: [3]int{84, 80, 80}, Experience: [3]int{56, 110, 110}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 30, Lightning: 0, Cold: 0, Poison: 50}, {Physical: 0, Magic: 0, Fire: 75, Lightning: 0, Cold: 0, Poison: 100}, {Physical: 33, Magic: 0, Fire: 125, Lightning: 0, Cold: 50, Poison: 110}}},
+	621: {ID: 621, Level: [3]int{8, 59, 81}, MinHP: [3]int{56, 55, 55}, MaxHP: [3]int{84, 80, 80}, Experience: [3]int{56, 110, 110}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 30, Lightning: 0, Cold: 0, Poison: 50}, {Physical: 0, Magic: 0, Fire: 75, Lightning: 0, Cold: 0, Poison: 100}, {Physical: 33, Magic: 0, Fire: 130, Lightning: 0, Cold: 50, Poison: 110}}},
+	622: {ID: 622, Level: [3]int{8, 59, 81}, MinHP: [3]int{56, 55, 55}, MaxHP: [3]int{84, 80, 80}, Experience: [3]int{56, 110, 110}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 30, Cold: 0, Poison: 50}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 75, Cold: 0, Poison: 100}, {Physical: 33, Magic: 50, Fire: 0, Lightning: 120, Cold: 0, Poison: 110}}},
+	623: {ID: 623, Level: [3]int{8, 59, 81}, MinHP: [3]int{56, 55, 55}, MaxHP: [3]int{84, 80, 80}, Experience: [3]int{56, 110, 110}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 30, Cold: 0, Poison: 50}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 75, Cold: 0, Poison: 100}, {Physical: 33, Magic: 50, Fire: 0, Lightning: 130, Cold: 0, Poison: 110}}},
+	624: {ID: 624, Level: [3]int{15, 61, 82}, MinHP: [3]int{56, 55, 55}, MaxHP: [3]int{84, 80, 80}, Experience: [3]int{56, 110, 110}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 30, Poison: 50}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 75, Poison: 100}, {Physical: 33, Magic: 0, Fire: 50, Lightning: 0, Cold: 160, Poison: 110}}},
+	625: {ID: 625, Level: [3]int{17, 47, 78}, MinHP: [3]int{56, 55, 55}, MaxHP: [3]int{81, 80, 80}, Experience: [3]int{55, 110, 110}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 70}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 60}, {Physical: 33, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 75}}},
+	626: {ID: 626, Level: [3]int{17, 47, 78}, MinHP: [3]int{56, 55, 55}, MaxHP: [3]int{81, 80, 80}, Experience: [3]int{55, 110, 110}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 70}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 60}, {Physical: 33, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 75}}},
+	627: {ID: 627, Level: [3]int{14, 59, 81}, MinHP: [3]int{57, 60, 60}, MaxHP: [3]int{97, 100, 100}, Experience: [3]int{71, 80, 80}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 33, Magic: 0, Fire: 0, Lightning: 25, Cold: 25, Poison: 25}, {Physical: 50, Magic: 0, Fire: 50, Lightning: 50, Cold: 50, Poison: 50}}},
+	628: {ID: 628, Level: [3]int{14, 60, 81}, MinHP: [3]int{57, 60, 60}, MaxHP: [3]int{97, 100, 100}, Experience: [3]int{71, 80, 80}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 33, Magic: 0, Fire: 0, Lightning: 25, Cold: 25, Poison: 25}, {Physical: 50, Magic: 0, Fire: 50, Lightning: 50, Cold: 50, Poison: 50}}},
+	629: {ID: 629, Level: [3]int{15, 61, 82}, MinHP: [3]int{66, 40, 40}, MaxHP: [3]int{194, 80, 80}, Experience: [3]int{94, 95, 95}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 25, Cold: 50, Poison: 0}, {Physical: 0, Magic: 25, Fire: 33, Lightning: 0, Cold: 100, Poison: 15}}},
+	630: {ID: 630, Level: [3]int{15, 61, 82}, MinHP: [3]int{66, 40, 40}, MaxHP: [3]int{194, 80, 80}, Experience: [3]int{94, 95, 95}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 25, Cold: 50, Poison: 0}, {Physical: 0, Magic: 25, Fire: 33, Lightning: 0, Cold: 120, Poison: 15}}},
+	631: {ID: 631, Level: [3]int{15, 61, 82}, MinHP: [3]int{83, 65, 65}, MaxHP: [3]int{165, 90, 90}, Experience: [3]int{93, 105, 105}, Resistances: [3]Resistances{{Physical: 20, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 50}, {Physical: 30, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 60}, {Physical: 100, Magic: 50, Fire: 0, Lightning: 0, Cold: 50, Poison: 100}}},
+	632: {ID: 632, Level: [3]int{15, 61, 82}, MinHP: [3]int{83, 65, 65}, MaxHP: [3]int{165, 90, 90}, Experience: [3]int{93, 105, 105}, Resistances: [3]Resistances{{Physical: 20, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 50}, {Physical: 30, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 60}, {Physical: 100, Magic: 50, Fire: 0, Lightning: 0, Cold: 50, Poison: 100}}},
+	633: {ID: 633, Level: [3]int{15, 61, 82}, MinHP: [3]int{83, 65, 65}, MaxHP: [3]int{165, 90, 90}, Experience: [3]int{93, 105, 105}, Resistances: [3]Resistances{{Physical: 20, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 50}, {Physical: 30, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 60}, {Physical: 100, Magic: 50, Fire: 0, Lightning: 0, Cold: 50, Poison: 100}}},
+	634: {ID: 634, Level: [3]int{42, 61, 83}, MinHP: [3]int{95, 60, 60}, MaxHP: [3]int{152, 90, 90}, Experience: [3]int{159, 90, 90}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 33, Fire: 33, Lightning: 15, Cold: 33, Poison: 33}, {Physical: 25, Magic: 66, Fire: 66, Lightning: 33, Cold: 66, Poison: 66}}},
+	635: {ID: 635, Level: [3]int{42, 61, 83}, MinHP: [3]int{95, 60, 60}, MaxHP: [3]int{152, 90, 90}, Experience: [3]int{159, 90, 90}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 33, Fire: 33, Lightning: 15, Cold: 33, Poison: 33}, {Physical: 25, Magic: 66, Fire: 66, Lightning: 33, Cold: 66, Poison: 66}}},
+	636: {ID: 636, Level: [3]int{42, 61, 83}, MinHP: [3]int{95, 60, 60}, MaxHP: [3]int{152, 90, 90}, Experience: [3]int{161, 145, 145}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 80, Poison: 0}, {Physical: 0, Magic: 33, Fire: 33, Lightning: 15, Cold: 100, Poison: 33}, {Physical: 25, Magic: 66, Fire: 66, Lightning: 33, Cold: 125, Poison: 66}}},
+	637: {ID: 637, Level: [3]int{42, 61, 83}, MinHP: [3]int{95, 60, 60}, MaxHP: [3]int{152, 90, 90}, Experience: [3]int{161, 145, 145}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 80, Poison: 0}, {Physical: 0, Magic: 33, Fire: 33, Lightning: 15, Cold: 100, Poison: 33}, {Physical: 25, Magic: 66, Fire: 66, Lightning: 33, Cold: 145, Poison: 66}}},
+	638: {ID: 638, Level: [3]int{42, 61, 83}, MinHP: [3]int{95, 60, 60}, MaxHP: [3]int{152, 90, 90}, Experience: [3]int{161, 145, 145}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 80, Poison: 0}, {Physical: 0, Magic: 33, Fire: 33, Lightning: 15, Cold: 100, Poison: 33}, {Physical: 25, Magic: 66, Fire: 66, Lightning: 33, Cold: 155, Poison: 66}}},
+	639: {ID: 639, Level: [3]int{42, 61, 83}, MinHP: [3]int{44, 45, 45}, MaxHP: [3]int{87, 85, 85}, Experience: [3]int{82, 120, 120}, Resistances: [3]Resistances{{Physical: 70, Magic: 0, Fire: 0, Lightning: 80, Cold: 0, Poison: 0}, {Physical: 70, Magic: 0, Fire: 0, Lightning: 100, Cold: 0, Poison: 50}, {Physical: 90, Magic: 50, Fire: 0, Lightning: 100, Cold: 33, Poison: 105}}},
+	640: {ID: 640, Level: [3]int{42, 61, 83}, MinHP: [3]int{44, 45, 45}, MaxHP: [3]int{87, 85, 85}, Experience: [3]int{82, 120, 120}, Resistances: [3]Resistances{{Physical: 70, Magic: 0, Fire: 0, Lightning: 80, Cold: 0, Poison: 0}, {Physical: 70, Magic: 0, Fire: 0, Lightning: 100, Cold: 0, Poison: 50}, {Physical: 90, Magic: 50, Fire: 0, Lightning: 100, Cold: 33, Poison: 110}}},
+	641: {ID: 641, Level: [3]int{42, 61, 83}, MinHP: [3]int{44, 45, 45}, MaxHP: [3]int{87, 85, 85}, Experience: [3]int{82, 120, 120}, Resistances: [3]Resistances{{Physical: 70, Magic: 0, Fire: 0, Lightning: 80, Cold: 0, Poison: 0}, {Physical: 70, Magic: 0, Fire: 0, Lightning: 100, Cold: 0, Poison: 50}, {Physical: 90, Magic: 50, Fire: 0, Lightning: 100, Cold: 33, Poison: 115}}},
+	642: {ID: 642, Level: [3]int{40, 61, 83}, MinHP: [3]int{21, 25, 25}, MaxHP: [3]int{55, 55, 55}, Experience: [3]int{93, 65, 65}, Resistances: [3]Resistances{{Physical: 50, Magic: 50, Fire: 50, Lightning: 50, Cold: 50, Poison: 50}, {Physical: 66, Magic: 66, Fire: 66, Lightning: 66, Cold: 66, Poison: 66}, {Physical: 25, Magic: 75, Fire: 75, Lightning: 75, Cold: 75, Poison: 75}}},
+	643: {ID: 643, Level: [3]int{40, 61, 83}, MinHP: [3]int{21, 25, 25}, MaxHP: [3]int{55, 55, 55}, Experience: [3]int{93, 65, 65}, Resistances: [3]Resistances{{Physical: 50, Magic: 50, Fire: 50, Lightning: 50, Cold: 50, Poison: 50}, {Physical: 66, Magic: 66, Fire: 66, Lightning: 66, Cold: 66, Poison: 66}, {Physical: 25, Magic: 75, Fire: 75, Lightning: 75, Cold: 75, Poison: 75}}},
+	644: {ID: 644, Level: [3]int{40, 61, 83}, MinHP: [3]int{21, 25, 25}, MaxHP: [3]int{55, 55, 55}, Experience: [3]int{93, 65, 65}, Resistances: [3]Resistances{{Physical: 50, Magic: 50, Fire: 50, Lightning: 50, Cold: 50, Poison: 50}, {Physical: 66, Magic: 66, Fire: 66, Lightning: 66, Cold: 66, Poison: 66}, {Physical: 25, Magic: 75, Fire: 75, Lightning: 75, Cold: 75, Poison: 75}}},
+	645: {ID: 645, Level: [3]int{40, 61, 83}, MinHP: [3]int{65, 55, 55}, MaxHP: [3]int{107, 90, 90}, Experience: [3]int{125, 150, 150}, Resistances: [3]Resistances{{Physical: 0, Magic: 20, Fire: 70, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 50, Fire: 0, Lightning: 25, Cold: 50, Poison: 0}, {Physical: 25, Magic: 50, Fire: 66, Lightning: 50, Cold: 75, Poison: 0}}},
+	646: {ID: 646, Level: [3]int{40, 61, 83}, MinHP: [3]int{65, 55, 55}, MaxHP: [3]int{107, 90, 90}, Experience: [3]int{125, 150, 150}, Resistances: [3]Resistances{{Physical: 0, Magic: 20, Fire: 70, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 50, Fire: 0, Lightning: 25, Cold: 50, Poison: 0}, {Physical: 25, Magic: 50, Fire: 66, Lightning: 50, Cold: 75, Poison: 0}}},
+	647: {ID: 647, Level: [3]int{40, 61, 83}, MinHP: [3]int{65, 55, 55}, MaxHP: [3]int{107, 90, 90}, Experience: [3]int{125, 150, 150}, Resistances: [3]Resistances{{Physical: 0, Magic: 20, Fire: 70, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 50, Fire: 0, Lightning: 25, Cold: 50, Poison: 0}, {Physical: 25, Magic: 50, Fire: 66, Lightning: 50, Cold: 75, Poison: 0}}},
+	648: {ID: 648, Level: [3]int{14, 61, 83}, MinHP: [3]int{94, 70, 70}, MaxHP: [3]int{131, 100, 100}, Experience: [3]int{85, 85, 85}, Resistances: [3]Resistances{{Physical: 0, Magic: 20, Fire: 0, Lightning: 60, Cold: 0, Poison: 50}, {Physical: 0, Magic: 40, Fire: 25, Lightning: 80, Cold: 0, Poison: 75}, {Physical: 33, Magic: 70, Fire: 50, Lightning: 100, Cold: 25, Poison: 105}}},
+	649: {ID: 649, Level: [3]int{14, 61, 83}, MinHP: [3]int{94, 70, 70}, MaxHP: [3]int{131, 100, 100}, Experience: [3]int{85, 85, 85}, Resistances: [3]Resistances{{Physical: 0, Magic: 20, Fire: 0, Lightning: 60, Cold: 0, Poison: 50}, {Physical: 0, Magic: 40, Fire: 25, Lightning: 80, Cold: 0, Poison: 75}, {Physical: 33, Magic: 70, Fire: 50, Lightning: 100, Cold: 25, Poison: 110}}},
+	650: {ID: 650, Level: [3]int{27, 57, 84}, MinHP: [3]int{43, 70, 70}, MaxHP: [3]int{151, 110, 110}, Experience: [3]int{89, 80, 80}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 50, Cold: 0, Poison: 0}, {Physical: 10, Magic: 0, Fire: 33, Lightning: 100, Cold: 33, Poison: 0}}},
+	651: {ID: 651, Level: [3]int{27, 57, 84}, MinHP: [3]int{43, 70, 70}, MaxHP: [3]int{151, 110, 110}, Experience: [3]int{89, 80, 80}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 50, Cold: 0, Poison: 0}, {Physical: 10, Magic: 0, Fire: 33, Lightning: 100, Cold: 33, Poison: 0}}},
+	652: {ID: 652, Level: [3]int{36, 61, 82}, MinHP: [3]int{284, 240, 240}, MaxHP: [3]int{379, 300, 300}, Experience: [3]int{260, 210, 210}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 50, Magic: 25, Fire: 25, Lightning: 25, Cold: 50, Poison: 0}, {Physical: 75, Magic: 50, Fire: 140, Lightning: 50, Cold: 75, Poison: 0}}},
+	653: {ID: 653, Level: [3]int{36, 62, 82}, MinHP: [3]int{284, 240, 240}, MaxHP: [3]int{379, 300, 300}, Experience: [3]int{260, 210, 210}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 50, Magic: 25, Fire: 25, Lightning: 25, Cold: 50, Poison: 0}, {Physical: 75, Magic: 50, Fire: 50, Lightning: 100, Cold: 75, Poison: 0}}},
+	654: {ID: 654, Level: [3]int{24, 54, 85}, MinHP: [3]int{67, 70, 70}, MaxHP: [3]int{111, 110, 110}, Experience: [3]int{83, 110, 110}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 50, Magic: 0, Fire: 0, Lightning: 100, Cold: 0, Poison: 0}}},
+	655: {ID: 655, Level: [3]int{24, 54, 85}, MinHP: [3]int{67, 70, 70}, MaxHP: [3]int{111, 110, 110}, Experience: [3]int{83, 110, 110}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 50, Magic: 0, Fire: 0, Lightning: 100, Cold: 0, Poison: 0}}},
+	656: {ID: 656, Level: [3]int{24, 54, 83}, MinHP: [3]int{65, 55, 55}, MaxHP: [3]int{107, 80, 80}, Experience: [3]int{79, 75, 75}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 15, Magic: 0, Fire: 105, Lightning: 25, Cold: 25, Poison: 33}}},
+	657: {ID: 657, Level: [3]int{24, 54, 83}, MinHP: [3]int{65, 55, 55}, MaxHP: [3]int{107, 80, 80}, Experience: [3]int{79, 75, 75}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 15, Magic: 0, Fire: 110, Lightning: 25, Cold: 25, Poison: 33}}},
+	658: {ID: 658, Level: [3]int{24, 54, 83}, MinHP: [3]int{65, 55, 55}, MaxHP: [3]int{107, 80, 80}, Experience: [3]int{79, 75, 75}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 15, Magic: 0, Fire: 115, Lightning: 25, Cold: 25, Poison: 33}}},
+	659: {ID: 659, Level: [3]int{24, 53, 83}, MinHP: [3]int{65, 45, 45}, MaxHP: [3]int{107, 75, 75}, Experience: [3]int{65, 90, 90}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 15, Magic: 0, Fire: 105, Lightning: 25, Cold: 25, Poison: 33}}},
+	660: {ID: 660, Level: [3]int{24, 53, 83}, MinHP: [3]int{65, 45, 45}, MaxHP: [3]int{107, 75, 75}, Experience: [3]int{65, 90, 90}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 15, Magic: 0, Fire: 110, Lightning: 25, Cold: 25, Poison: 33}}},
+	661: {ID: 661, Level: [3]int{24, 53, 83}, MinHP: [3]int{65, 45, 45}, MaxHP: [3]int{107, 75, 75}, Experience: [3]int{65, 90, 90}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 15, Magic: 0, Fire: 115, Lightning: 25, Cold: 25, Poison: 33}}},
+	662: {ID: 662, Level: [3]int{24, 53, 84}, MinHP: [3]int{65, 65, 65}, MaxHP: [3]int{107, 105, 105}, Experience: [3]int{77, 260, 260}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 15, Magic: 10, Fire: 120, Lightning: 25, Cold: 25, Poison: 33}}},
+	663: {ID: 663, Level: [3]int{24, 53, 84}, MinHP: [3]int{65, 65, 65}, MaxHP: [3]int{107, 105, 105}, Experience: [3]int{77, 260, 260}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 15, Magic: 10, Fire: 125, Lightning: 25, Cold: 25, Poison: 33}}},
+	664: {ID: 664, Level: [3]int{24, 53, 84}, MinHP: [3]int{65, 65, 65}, MaxHP: [3]int{107, 105, 105}, Experience: [3]int{77, 260, 260}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 15, Magic: 10, Fire: 130, Lightning: 25, Cold: 25, Poison: 33}}},
+	665: {ID: 665, Level: [3]int{24, 53, 81}, MinHP: [3]int{107, 90, 90}, MaxHP: [3]int{151, 135, 135}, Experience: [3]int{97, 110, 110}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 33, Magic: 0, Fire: 0, Lightning: 100, Cold: 33, Poison: 0}}},
+	666: {ID: 666, Level: [3]int{24, 53, 81}, MinHP: [3]int{107, 90, 90}, MaxHP: [3]int{151, 135, 135}, Experience: [3]int{97, 110, 110}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 33, Magic: 0, Fire: 0, Lightning: 100, Cold: 33, Poison: 0}}},
+	667: {ID: 667, Level: [3]int{40, 64, 85}, MinHP: [3]int{192, 170, 170}, MaxHP: [3]int{235, 210, 210}, Experience: [3]int{211, 300, 300}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 50}, {Physical: 0, Magic: 25, Fire: 25, Lightning: 25, Cold: 25, Poison: 50}, {Physical: 33, Magic: 100, Fire: 50, Lightning: 50, Cold: 25, Poison: 50}}},
+	668: {ID: 668, Level: [3]int{40, 64, 85}, MinHP: [3]int{192, 170, 170}, MaxHP: [3]int{235, 210, 210}, Experience: [3]int{211, 300, 300}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 50}, {Physical: 0, Magic: 25, Fire: 25, Lightning: 25, Cold: 25, Poison: 50}, {Physical: 33, Magic: 100, Fire: 50, Lightning: 25, Cold: 50, Poison: 50}}},
+	669: {ID: 669, Level: [3]int{40, 64, 85}, MinHP: [3]int{192, 170, 170}, MaxHP: [3]int{235, 210, 210}, Experience: [3]int{211, 300, 300}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 50}, {Physical: 0, Magic: 25, Fire: 25, Lightning: 25, Cold: 25, Poison: 50}, {Physical: 33, Magic: 100, Fire: 25, Lightning: 50, Cold: 50, Poison: 50}}},
+	670: {ID: 670, Level: [3]int{40, 64, 85}, MinHP: [3]int{192, 170, 170}, MaxHP: [3]int{235, 210, 210}, Experience: [3]int{211, 300, 300}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 50}, {Physical: 0, Magic: 25, Fire: 25, Lightning: 25, Cold: 25, Poison: 50}, {Physical: 33, Magic: 100, Fire: 50, Lightning: 50, Cold: 50, Poison: 25}}},
+	671: {ID: 671, Level: [3]int{24, 53, 82}, MinHP: [3]int{131, 120, 120}, MaxHP: [3]int{173, 150, 150}, Experience: [3]int{100, 80, 80}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 15, Magic: 0, Fire: 20, Lightning: 100, Cold: 50, Poison: 0}}},
+	672: {ID: 672, Level: [3]int{24, 53, 82}, MinHP: [3]int{131, 120, 120}, MaxHP: [3]int{173, 150, 150}, Experience: [3]int{100, 80, 80}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 15, Magic: 0, Fire: 20, Lightning: 100, Cold: 50, Poison: 0}}},
+	673: {ID: 673, Level: [3]int{24, 57, 84}, MinHP: [3]int{131, 90, 90}, MaxHP: [3]int{173, 130, 130}, Experience: [3]int{106, 130, 130}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 15, Magic: 0, Fire: 20, Lightning: 50, Cold: 20, Poison: 0}}},
+	674: {ID: 674, Level: [3]int{24, 57, 84}, MinHP: [3]int{131, 90, 90}, MaxHP: [3]int{173, 130, 130}, Experience: [3]int{106, 130, 130}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 15, Magic: 0, Fire: 20, Lightning: 50, Cold: 20, Poison: 0}}},
+	675: {ID: 675, Level: [3]int{30, 57, 85}, MinHP: [3]int{228, 200, 200}, MaxHP: [3]int{294, 250, 250}, Experience: [3]int{168, 130, 130}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 40, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 70, Poison: 0}, {Physical: 45, Magic: 0, Fire: 33, Lightning: 33, Cold: 140, Poison: 25}}},
+	676: {ID: 676, Level: [3]int{30, 57, 85}, MinHP: [3]int{228, 200, 200}, MaxHP: [3]int{294, 250, 250}, Experience: [3]int{168, 130, 130}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 40, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 70, Poison: 0}, {Physical: 45, Magic: 0, Fire: 33, Lightning: 33, Cold: 145, Poison: 25}}},
+	677: {ID: 677, Level: [3]int{30, 57, 85}, MinHP: [3]int{55, 50, 50}, MaxHP: [3]int{84, 70, 70}, Experience: [3]int{89, 40, 40}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 40, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 70, Poison: 0}, {Physical: 0, Magic: 0, Fire: 33, Lightning: 33, Cold: 140, Poison: 25}}},
+	678: {ID: 678, Level: [3]int{30, 57, 85}, MinHP: [3]int{55, 50, 50}, MaxHP: [3]int{84, 70, 70}, Experience: [3]int{89, 40, 40}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 40, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 70, Poison: 0}, {Physical: 0, Magic: 0, Fire: 33, Lightning: 33, Cold: 145, Poison: 25}}},
+	679: {ID: 679, Level: [3]int{27, 57, 85}, MinHP: [3]int{140, 110, 110}, MaxHP: [3]int{172, 150, 150}, Experience: [3]int{127, 125, 125}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 40, Magic: 0, Fire: 25, Lightning: 100, Cold: 25, Poison: 105}}},
+	680: {ID: 680, Level: [3]int{24, 57, 85}, MinHP: [3]int{21, 20, 20}, MaxHP: [3]int{65, 45, 45}, Experience: [3]int{55, 30, 30}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 10, Magic: 0, Fire: 15, Lightning: 33, Cold: 15, Poison: 105}}},
+	681: {ID: 681, Level: [3]int{27, 57, 85}, MinHP: [3]int{77, 60, 60}, MaxHP: [3]int{95, 80, 80}, Experience: [3]int{54, 25, 25}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 10, Magic: 0, Fire: 15, Lightning: 33, Cold: 15, Poison: 0}}},
+	682: {ID: 682, Level: [3]int{42, 66, 82}, MinHP: [3]int{190, 100, 100}, MaxHP: [3]int{237, 125, 125}, Experience: [3]int{226, 80, 80}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 33, Magic: 0, Fire: 50, Lightning: 25, Cold: 130, Poison: 0}}},
+	683: {ID: 683, Level: [3]int{42, 66, 82}, MinHP: [3]int{190, 100, 100}, MaxHP: [3]int{237, 125, 125}, Experience: [3]int{226, 80, 80}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 33, Magic: 0, Fire: 110, Lightning: 25, Cold: 50, Poison: 0}}},
+	684: {ID: 684, Level: [3]int{42, 66, 82}, MinHP: [3]int{190, 100, 100}, MaxHP: [3]int{237, 125, 125}, Experience: [3]int{226, 80, 80}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 33, Magic: 0, Fire: 50, Lightning: 25, Cold: 135, Poison: 0}}},
+	685: {ID: 685, Level: [3]int{23, 53, 84}, MinHP: [3]int{151, 150, 150}, MaxHP: [3]int{190, 190, 190}, Experience: [3]int{114, 110, 110}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 50, Magic: 0, Fire: 120, Lightning: 25, Cold: 50, Poison: 0}}},
+	686: {ID: 686, Level: [3]int{30, 57, 84}, MinHP: [3]int{191, 210, 210}, MaxHP: [3]int{228, 250, 250}, Experience: [3]int{152, 130, 130}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 70, Lightning: 0, Cold: 0, Poison: 70}, {Physical: 0, Magic: 0, Fire: 80, Lightning: 0, Cold: 0, Poison: 100}, {Physical: 50, Magic: 20, Fire: 140, Lightning: 33, Cold: 0, Poison: 50}}},
+	687: {ID: 687, Level: [3]int{30, 57, 84}, MinHP: [3]int{191, 210, 210}, MaxHP: [3]int{228, 250, 250}, Experience: [3]int{152, 130, 130}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 70, Lightning: 0, Cold: 0, Poison: 70}, {Physical: 0, Magic: 0, Fire: 80, Lightning: 0, Cold: 0, Poison: 100}, {Physical: 50, Magic: 20, Fire: 145, Lightning: 33, Cold: 0, Poison: 50}}},
+	688: {ID: 688, Level: [3]int{38, 63, 85}, MinHP: [3]int{65, 45, 45}, MaxHP: [3]int{107, 65, 65}, Experience: [3]int{137, 120, 120}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 110, Lightning: 25, Cold: 25, Poison: 0}}},
+	689: {ID: 689, Level: [3]int{38, 63, 85}, MinHP: [3]int{65, 45, 45}, MaxHP: [3]int{107, 65, 65}, Experience: [3]int{137, 120, 120}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 115, Lightning: 25, Cold: 25, Poison: 0}}},
+	690: {ID: 690, Level: [3]int{24, 53, 83}, MinHP: [3]int{65, 65, 65}, MaxHP: [3]int{107, 105, 105}, Experience: [3]int{79, 125, 125}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 50}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 60}, {Physical: 33, Magic: 0, Fire: 25, Lightning: 0, Cold: 33, Poison: 105}}},
+	691: {ID: 691, Level: [3]int{24, 53, 83}, MinHP: [3]int{65, 65, 65}, MaxHP: [3]int{107, 105, 105}, Experience: [3]int{79, 125, 125}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 50}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 60}, {Physical: 33, Magic: 0, Fire: 25, Lightning: 0, Cold: 33, Poison: 110}}},
+	692: {ID: 692, Level: [3]int{30, 58, 85}, MinHP: [3]int{152, 80, 80}, MaxHP: [3]int{209, 110, 110}, Experience: [3]int{130, 135, 135}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 60, Lightning: 60, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 75, Lightning: 75, Cold: 0, Poison: 0}, {Physical: 40, Magic: 0, Fire: 66, Lightning: 100, Cold: 25, Poison: 25}}},
+	693: {ID: 693, Level: [3]int{30, 58, 85}, MinHP: [3]int{152, 80, 80}, MaxHP: [3]int{209, 110, 110}, Experience: [3]int{130, 135, 135}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 60, Lightning: 60, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 75, Lightning: 75, Cold: 0, Poison: 0}, {Physical: 40, Magic: 0, Fire: 66, Lightning: 100, Cold: 25, Poison: 25}}},
+	694: {ID: 694, Level: [3]int{30, 57, 85}, MinHP: [3]int{209, 240, 240}, MaxHP: [3]int{283, 320, 320}, Experience: [3]int{163, 140, 140}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 50, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 110}}},
+	695: {ID: 695, Level: [3]int{25, 55, 83}, MinHP: [3]int{152, 150, 150}, MaxHP: [3]int{210, 200, 200}, Experience: [3]int{113, 150, 150}, Resistances: [3]Resistances{{Physical: 60, Magic: 0, Fire: 0, Lightning: 0, Cold: 50, Poison: 0}, {Physical: 60, Magic: 0, Fire: 0, Lightning: 0, Cold: 50, Poison: 50}, {Physical: 75, Magic: 0, Fire: 33, Lightning: 33, Cold: 125, Poison: 50}}},
+	696: {ID: 696, Level: [3]int{25, 55, 83}, MinHP: [3]int{152, 150, 150}, MaxHP: [3]int{210, 200, 200}, Experience: [3]int{113, 150, 150}, Resistances: [3]Resistances{{Physical: 60, Magic: 0, Fire: 0, Lightning: 0, Cold: 50, Poison: 0}, {Physical: 60, Magic: 0, Fire: 0, Lightning: 0, Cold: 50, Poison: 50}, {Physical: 75, Magic: 0, Fire: 33, Lightning: 33, Cold: 130, Poison: 50}}},
+	697: {ID: 697, Level: [3]int{25, 55, 83}, MinHP: [3]int{152, 150, 150}, MaxHP: [3]int{210, 200, 200}, Experience: [3]int{113, 150, 150}, Resistances: [3]Resistances{{Physical: 60, Magic: 0, Fire: 0, Lightning: 0, Cold: 50, Poison: 0}, {Physical: 60, Magic: 0, Fire: 0, Lightning: 0, Cold: 50, Poison: 50}, {Physical: 75, Magic: 0, Fire: 33, Lightning: 33, Cold: 135, Poison: 50}}},
+	698: {ID: 698, Level: [3]int{42, 65, 85}, MinHP: [3]int{114, 110, 110}, MaxHP: [3]int{170, 135, 135}, Experience: [3]int{173, 105, 105}, Resistances: [3]Resistances{{Physical: 0, Magic: 50, Fire: 0, Lightning: 0, Cold: 0, Poison: 70}, {Physical: 0, Magic: 50, Fire: 0, Lightning: 0, Cold: 0, Poison: 100}, {Physical: 50, Magic: 50, Fire: 0, Lightning: 0, Cold: 0, Poison: 120}}},
+	699: {ID: 699, Level: [3]int{24, 56, 82}, MinHP: [3]int{131, 120, 120}, MaxHP: [3]int{173, 150, 150}, Experience: [3]int{111, 100, 100}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 80, Lightning: 20, Cold: 20, Poison: 40}, {Physical: 0, Magic: 0, Fire: 100, Lightning: 20, Cold: 20, Poison: 40}, {Physical: 33, Magic: 0, Fire: 130, Lightning: 25, Cold: 25, Poison: 50}}},
+	700: {ID: 700, Level: [3]int{24, 56, 82}, MinHP: [3]int{131, 120, 120}, MaxHP: [3]int{173, 150, 150}, Experience: [3]int{111, 100, 100}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 80, Lightning: 20, Cold: 20, Poison: 40}, {Physical: 0, Magic: 0, Fire: 100, Lightning: 20, Cold: 20, Poison: 40}, {Physical: 33, Magic: 0, Fire: 140, Lightning: 25, Cold: 25, Poison: 50}}},
+	701: {ID: 701, Level: [3]int{30, 58, 85}, MinHP: [3]int{130, 120, 120}, MaxHP: [3]int{171, 150, 150}, Experience: [3]int{131, 210, 210}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 60, Lightning: 60, Cold: 60, Poison: 60}, {Physical: 0, Magic: 0, Fire: 60, Lightning: 60, Cold: 80, Poison: 60}, {Physical: 33, Magic: 0, Fire: 60, Lightning: 60, Cold: 140, Poison: 75}}},
+	702: {ID: 702, Level: [3]int{30, 58, 85}, MinHP: [3]int{130, 120, 120}, MaxHP: [3]int{171, 150, 150}, Experience: [3]int{131, 210, 210}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 60, Lightning: 60, Cold: 60, Poison: 60}, {Physical: 0, Magic: 0, Fire: 60, Lightning: 60, Cold: 80, Poison: 60}, {Physical: 33, Magic: 0, Fire: 60, Lightning: 60, Cold: 145, Poison: 75}}},
+	703: {ID: 703, Level: [3]int{25, 55, 83}, MinHP: [3]int{70, 100, 100}, MaxHP: [3]int{154, 140, 140}, Experience: [3]int{89, 100, 100}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 70}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 80}, {Physical: 50, Magic: 0, Fire: 0, Lightning: 50, Cold: 50, Poison: 130}}},
+	704: {ID: 704, Level: [3]int{110, 110, 110}, MinHP: [3]int{5695, 5695, 6500}, MaxHP: [3]int{5695, 5695, 6600}, Experience: [3]int{2131, 2131, 2131}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 33, Lightning: 33, Cold: 25, Poison: 50}, {Physical: 0, Magic: 0, Fire: 50, Lightning: 50, Cold: 25, Poison: 50}, {Physical: 20, Magic: 75, Fire: 75, Lightning: 110, Cold: 75, Poison: 110}}},
+	705: {ID: 705, Level: [3]int{110, 110, 110}, MinHP: [3]int{6427, 6427, 6500}, MaxHP: [3]int{6427, 6427, 6600}, Experience: [3]int{3462, 3462, 3462}, Resistances: [3]Resistances{{Physical: 50, Magic: 50, Fire: 75, Lightning: 75, Cold: 75, Poison: 75}, {Physical: 50, Magic: 50, Fire: 75, Lightning: 75, Cold: 75, Poison: 75}, {Physical: 50, Magic: 75, Fire: 110, Lightning: 75, Cold: 75, Poison: 75}}},
+	706: {ID: 706, Level: [3]int{110, 110, 110}, MinHP: [3]int{9481, 3111, 6500}, MaxHP: [3]int{9481, 3111, 6600}, Experience: [3]int{3695, 1987, 1987}, Resistances: [3]Resistances{{Physical: 30, Magic: 30, Fire: 30, Lightning: 30, Cold: 75, Poison: 30}, {Physical: 30, Magic: 30, Fire: 30, Lightning: 30, Cold: 75, Poison: 30}, {Physical: 30, Magic: 75, Fire: 75, Lightning: 75, Cold: 110, Poison: 75}}},
+	707: {ID: 707, Level: [3]int{110, 110, 110}, MinHP: [3]int{2562, 2562, 6500}, MaxHP: [3]int{2562, 2562, 6600}, Experience: [3]int{916, 1492, 1492}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: -50, Lightning: 50, Cold: 50, Poison: 80}, {Physical: 0, Magic: 0, Fire: -50, Lightning: 50, Cold: 50, Poison: 50}, {Physical: 66, Magic: 75, Fire: 75, Lightning: 75, Cold: 75, Poison: 110}}},
+	708: {ID: 708, Level: [3]int{110, 110, 110}, MinHP: [3]int{4757, 4757, 6500}, MaxHP: [3]int{4757, 4757, 6600}, Experience: [3]int{1655, 1655, 1655}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 20, Lightning: 20, Cold: 50, Poison: 20}, {Physical: 0, Magic: 0, Fire: 50, Lightning: 50, Cold: 75, Poison: 50}, {Physical: 50, Magic: 75, Fire: 75, Lightning: 75, Cold: 75, Poison: 75}}},
+	709: {ID: 709, Level: [3]int{110, 110, 110}, MinHP: [3]int{6336, 6336, 6500}, MaxHP: [3]int{6336, 6336, 6600}, Experience: [3]int{6460, 6460, 6460}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 33, Lightning: 33, Cold: 33, Poison: 50}, {Physical: 0, Magic: 0, Fire: 50, Lightning: 50, Cold: 50, Poison: 50}, {Physical: 50, Magic: 75, Fire: 75, Lightning: 75, Cold: 110, Poison: 75}}},
+	710: {ID: 710, Level: [3]int{14, 66, 82}, MinHP: [3]int{67, 50, 400}, MaxHP: [3]int{107, 90, 500}, Experience: [3]int{93, 100, 100}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	711: {ID: 711, Level: [3]int{1, 34, 67}, MinHP: [3]int{0, 0, 0}, MaxHP: [3]int{0, 0, 0}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	712: {ID: 712, Level: [3]int{30, 57, 84}, MinHP: [3]int{191, 400, 400}, MaxHP: [3]int{228, 500, 500}, Experience: [3]int{152, 130, 260}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 70, Lightning: 0, Cold: 0, Poison: 70}, {Physical: 0, Magic: 0, Fire: 80, Lightning: 0, Cold: 0, Poison: 100}, {Physical: 50, Magic: 145, Fire: 145, Lightning: 145, Cold: 145, Poison: 145}}},
+	713: {ID: 713, Level: [3]int{30, 58, 85}, MinHP: [3]int{130, 400, 400}, MaxHP: [3]int{171, 500, 500}, Experience: [3]int{131, 210, 420}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 60, Lightning: 60, Cold: 60, Poison: 60}, {Physical: 0, Magic: 0, Fire: 60, Lightning: 60, Cold: 80, Poison: 60}, {Physical: 33, Magic: 0, Fire: 60, Lightning: 60, Cold: 145, Poison: 75}}},
+	714: {ID: 714, Level: [3]int{38, 63, 85}, MinHP: [3]int{65, 400, 400}, MaxHP: [3]int{107, 500, 500}, Experience: [3]int{137, 120, 240}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 115, Lightning: 25, Cold: 25, Poison: 0}}},
+	715: {ID: 715, Level: [3]int{22, 52, 81}, MinHP: [3]int{22, 400, 400}, MaxHP: [3]int{44, 500, 500}, Experience: [3]int{61, 60, 120}, Resistances: [3]Resistances{{Physical: 90, Magic: 0, Fire: 0, Lightning: 40, Cold: 0, Poison: 0}, {Physical: 90, Magic: 0, Fire: 0, Lightning: 70, Cold: 0, Poison: 0}, {Physical: 100, Magic: 0, Fire: 0, Lightning: 70, Cold: 0, Poison: 0}}},
+	716: {ID: 716, Level: [3]int{27, 57, 85}, MinHP: [3]int{140, 400, 400}, MaxHP: [3]int{172, 500, 500}, Experience: [3]int{127, 125, 250}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 40, Magic: 0, Fire: 25, Lightning: 100, Cold: 25, Poison: 105}}},
+	717: {ID: 717, Level: [3]int{23, 53, 84}, MinHP: [3]int{151, 400, 400}, MaxHP: [3]int{190, 500, 500}, Experience: [3]int{114, 110, 220}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 50, Magic: 0, Fire: 120, Lightning: 25, Cold: 50, Poison: 0}}},
+	718: {ID: 718, Level: [3]int{24, 54, 85}, MinHP: [3]int{67, 400, 400}, MaxHP: [3]int{111, 500, 500}, Experience: [3]int{83, 110, 220}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 50, Magic: 0, Fire: 0, Lightning: 100, Cold: 0, Poison: 0}}},
+	719: {ID: 719, Level: [3]int{42, 61, 83}, MinHP: [3]int{95, 400, 400}, MaxHP: [3]int{152, 500, 500}, Experience: [3]int{159, 90, 180}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 33, Fire: 33, Lightning: 15, Cold: 33, Poison: 33}, {Physical: 25, Magic: 66, Fire: 66, Lightning: 33, Cold: 66, Poison: 66}}},
+	720: {ID: 720, Level: [3]int{42, 61, 83}, MinHP: [3]int{95, 400, 400}, MaxHP: [3]int{152, 500, 500}, Experience: [3]int{161, 145, 290}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 80, Poison: 0}, {Physical: 0, Magic: 33, Fire: 33, Lightning: 15, Cold: 100, Poison: 33}, {Physical: 25, Magic: 66, Fire: 66, Lightning: 33, Cold: 155, Poison: 66}}},
+	721: {ID: 721, Level: [3]int{23, 53, 84}, MinHP: [3]int{65, 400, 400}, MaxHP: [3]int{107, 500, 500}, Experience: [3]int{78, 95, 190}, Resistances: [3]Resistances{{Physical: 20, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 20, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 40, Magic: 20, Fire: 33, Lightning: 33, Cold: 150, Poison: 20}}},
+	722: {ID: 722, Level: [3]int{5, 61, 82}, MinHP: [3]int{93, 400, 400}, MaxHP: [3]int{131, 500, 500}, Experience: [3]int{91, 100, 200}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 25, Lightning: 25, Cold: 50, Poison: 0}, {Physical: 15, Magic: 0, Fire: 50, Lightning: 50, Cold: 140, Poison: 15}}},
+	723: {ID: 723, Level: [3]int{5, 59, 81}, MinHP: [3]int{85, 400, 400}, MaxHP: [3]int{124, 500, 500}, Experience: [3]int{91, 85, 170}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 50, Cold: 25, Poison: 0}, {Physical: 33, Magic: 0, Fire: 25, Lightning: 100, Cold: 50, Poison: 15}}},
+	724: {ID: 724, Level: [3]int{39, 60, 81}, MinHP: [3]int{379, 400, 400}, MaxHP: [3]int{379, 500, 500}, Experience: [3]int{142, 125, 250}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 40, Magic: 0, Fire: 33, Lightning: 20, Cold: 160, Poison: 0}}},
+	725: {ID: 725, Level: [3]int{14, 61, 83}, MinHP: [3]int{94, 70, 70}, MaxHP: [3]int{131, 100, 240}, Experience: [3]int{85, 85, 85}, Resistances: [3]Resistances{{Physical: 0, Magic: 20, Fire: 0, Lightning: 60, Cold: 0, Poison: 50}, {Physical: 0, Magic: 40, Fire: 25, Lightning: 80, Cold: 0, Poison: 75}, {Physical: 33, Magic: 70, Fire: 50, Lightning: 100, Cold: 25, Poison: 110}}},
+	726: {ID: 726, Level: [3]int{41, 63, 85}, MinHP: [3]int{70, 70, 70}, MaxHP: [3]int{100, 100, 240}, Experience: [3]int{95, 95, 95}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 33, Lightning: 33, Cold: 33, Poison: 140}, {Physical: 0, Magic: 0, Fire: 50, Lightning: 50, Cold: 50, Poison: 140}, {Physical: 33, Magic: 10, Fire: 66, Lightning: 66, Cold: 66, Poison: 140}}},
+	727: {ID: 727, Level: [3]int{8, 59, 81}, MinHP: [3]int{56, 55, 55}, MaxHP: [3]int{84, 80, 200}, Experience: [3]int{56, 110, 110}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 30, Lightning: 0, Cold: 0, Poison: 50}, {Physical: 0, Magic: 0, Fire: 75, Lightning: 0, Cold: 0, Poison: 100}, {Physical: 33, Magic: 0, Fire: 130, Lightning: 0, Cold: 50, Poison: 110}}},
+	728: {ID: 728, Level: [3]int{8, 59, 81}, MinHP: [3]int{56, 55, 55}, MaxHP: [3]int{84, 80, 200}, Experience: [3]int{56, 110, 110}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 30, Cold: 0, Poison: 50}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 75, Cold: 0, Poison: 100}, {Physical: 33, Magic: 50, Fire: 0, Lightning: 130, Cold: 0, Poison: 110}}},
+	729: {ID: 729, Level: [3]int{15, 61, 82}, MinHP: [3]int{56, 55, 55}, MaxHP: [3]int{84, 80, 200}, Experience: [3]int{56, 110, 110}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 30, Poison: 50}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 75, Poison: 100}, {Physical: 33, Magic: 0, Fire: 50, Lightning: 0, Cold: 160, Poison: 110}}},
+	730: {ID: 730, Level: [3]int{17, 47, 78}, MinHP: [3]int{56, 55, 55}, MaxHP: [3]int{81, 80, 200}, Experience: [3]int{55, 110, 110}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 70}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 60}, {Physical: 33, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 75}}},
+	731: {ID: 731, Level: [3]int{25, 55, 83}, MinHP: [3]int{152, 150, 150}, MaxHP: [3]int{210, 200, 200}, Experience: [3]int{113, 150, 150}, Resistances: [3]Resistances{{Physical: 60, Magic: 0, Fire: 0, Lightning: 0, Cold: 50, Poison: 0}, {Physical: 60, Magic: 0, Fire: 0, Lightning: 0, Cold: 50, Poison: 50}, {Physical: 100, Magic: 0, Fire: 33, Lightning: 33, Cold: 135, Poison: 50}}},
+	732: {ID: 732, Level: [3]int{15, 61, 82}, MinHP: [3]int{83, 65, 65}, MaxHP: [3]int{165, 90, 90}, Experience: [3]int{93, 105, 105}, Resistances: [3]Resistances{{Physical: 20, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 50}, {Physical: 30, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 60}, {Physical: 100, Magic: 50, Fire: 0, Lightning: 0, Cold: 50, Poison: 100}}},
+	733: {ID: 733, Level: [3]int{42, 61, 83}, MinHP: [3]int{44, 45, 45}, MaxHP: [3]int{87, 85, 85}, Experience: [3]int{82, 120, 120}, Resistances: [3]Resistances{{Physical: 70, Magic: 0, Fire: 0, Lightning: 80, Cold: 0, Poison: 0}, {Physical: 70, Magic: 0, Fire: 0, Lightning: 100, Cold: 0, Poison: 50}, {Physical: 90, Magic: 50, Fire: 0, Lightning: 100, Cold: 33, Poison: 115}}},
+	734: {ID: 734, Level: [3]int{0, 0, 0}, MinHP: [3]int{0, 0, 0}, MaxHP: [3]int{0, 0, 0}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	735: {ID: 735, Level: [3]int{28, 61, 81}, MinHP: [3]int{133, 220, 220}, MaxHP: [3]int{285, 270, 270}, Experience: [3]int{140, 80, 80}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 33, Magic: 15, Fire: 25, Lightning: 25, Cold: 25, Poison: 25}, {Physical: 50, Magic: 33, Fire: 50, Lightning: 50, Cold: 50, Poison: 33}}},
+	736: {ID: 736, Level: [3]int{17, 46, 77}, MinHP: [3]int{109, 105, 105}, MaxHP: [3]int{192, 150, 150}, Experience: [3]int{109, 105, 105}, Resistances: [3]Resistances{{Physical: 0, Magic: 50, Fire: 0, Lightning: 0, Cold: 0, Poison: 50}, {Physical: 0, Magic: 75, Fire: 0, Lightning: 0, Cold: 0, Poison: 75}, {Physical: 50, Magic: 100, Fire: 0, Lightning: 0, Cold: 0, Poison: 75}}},
+	737: {ID: 737, Level: [3]int{0, 0, 0}, MinHP: [3]int{0, 0, 0}, MaxHP: [3]int{0, 0, 0}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	738: {ID: 738, Level: [3]int{0, 0, 0}, MinHP: [3]int{0, 0, 0}, MaxHP: [3]int{0, 0, 0}, Experience: [3]int{0, 0, 0}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 0, Lightning: 0, Cold: 0, Poison: 0}}},
+	739: {ID: 739, Level: [3]int{42, 66, 80}, MinHP: [3]int{39, 20, 20}, MaxHP: [3]int{58, 40, 40}, Experience: [3]int{188, 70, 70}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 30, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 30, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 33, Magic: 0, Fire: 50, Lightning: 25, Cold: 130, Poison: 0}}},
+	740: {ID: 740, Level: [3]int{42, 66, 82}, MinHP: [3]int{39, 20, 20}, MaxHP: [3]int{57, 40, 40}, Experience: [3]int{210, 70, 70}, Resistances: [3]Resistances{{Physical: 0, Magic: 20, Fire: 70, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 20, Fire: 100, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 33, Magic: 20, Fire: 110, Lightning: 25, Cold: 50, Poison: 0}}},
+	741: {ID: 741, Level: [3]int{42, 66, 82}, MinHP: [3]int{39, 20, 20}, MaxHP: [3]int{58, 40, 40}, Experience: [3]int{234, 70, 70}, Resistances: [3]Resistances{{Physical: 0, Magic: 0, Fire: 40, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 0, Magic: 0, Fire: 40, Lightning: 0, Cold: 0, Poison: 0}, {Physical: 33, Magic: 0, Fire: 50, Lightning: 25, Cold: 135, Poison: 0}}},
+}
+
+func MonStatsForID(id ID) (MonStats, bool) {
+	stats, ok := MonStatsByID[id]
+	return stats, ok
+}
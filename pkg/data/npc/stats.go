@@ -0,0 +1,69 @@
+package npc
+
+import (
+	"github.com/hectorgimenez/d2go/pkg/data/difficulty"
+	"github.com/hectorgimenez/d2go/pkg/data/stat"
+)
+
+type Resistances struct {
+	Physical  int
+	Magic     int
+	Fire      int
+	Lightning int
+	Cold      int
+	Poison    int
+}
+
+type DifficultyStats struct {
+	Level       int
+	MinHP       int
+	MaxHP       int
+	Experience  int
+	Resistances Resistances
+}
+
+// For returns the monster base stats for the given difficulty, normal is used for unknown values
+func (s MonStats) For(d difficulty.Difficulty) DifficultyStats {
+	idx := 0
+	switch d {
+	case difficulty.Nightmare:
+		idx = 1
+	case difficulty.Hell:
+		idx = 2
+	}
+
+	return DifficultyStats{
+		Level:       s.Level[idx],
+		MinHP:       s.MinHP[idx],
+		MaxHP:       s.MaxHP[idx],
+		Experience:  s.Experience[idx],
+		Resistances: s.Resistances[idx],
+	}
+}
+
+// Immunities returns the base immunities (resistance of 100 or more), monster modifiers like Cold Enchanted or
+// Conviction may change them in game, use Monster.IsImmune for spawned monsters
+func (r Resistances) Immunities() []stat.Resist {
+	immunities := make([]stat.Resist, 0)
+	for _, res := range []struct {
+		value  int
+		resist stat.Resist
+	}{
+		{r.Magic, stat.MagicImmune},
+		{r.Fire, stat.FireImmune},
+		{r.Lightning, stat.LightImmune},
+		{r.Cold, stat.ColdImmune},
+		{r.Poison, stat.PoisonImmune},
+	} {
+		if res.value >= 100 {
+			immunities = append(immunities, res.resist)
+		}
+	}
+
+	return immunities
+}
+
+// IsPhysicalImmune returns true if the monster base physical resistance is 100 or more
+func (r Resistances) IsPhysicalImmune() bool {
+	return r.Physical >= 100
+}
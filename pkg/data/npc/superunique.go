@@ -0,0 +1,110 @@
+package npc
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/hectorgimenez/d2go/pkg/data/area"
+	"github.com/hectorgimenez/d2go/pkg/data/object"
+)
+
+type SuperUnique struct {
+	Name         string
+	Area         area.ID
+	OtherAreas   []area.ID     // The spawn area is chosen on each game, it can be Area or any of these
+	NearWaypoint bool          // Spawns close to the area waypoint
+	NearEntrance area.ID       // Spawns close to the entrance to this area, 0 if not applicable
+	NearObjects  []object.Name // Preset objects close to the spawn location, any of them can be used to locate it
+}
+
+// SuperUniques contains the spawn location of the super unique monsters. Maps are generated on each game, so the
+// location is given relative to a waypoint, entrance or preset object when the spawn is always close to them,
+// otherwise the monster can be anywhere in the area. Absolute coordinates are not given, they change on every game
+var SuperUniques = []SuperUnique{
+	// Act 1
+	{Name: "Corpsefire", Area: area.DenOfEvil},
+	{Name: "Bishibosh", Area: area.ColdPlains},
+	{Name: "Bonebreaker", Area: area.Crypt},
+	{Name: "Coldcrow", Area: area.CaveLevel1},
+	{Name: "Rakanishu", Area: area.StonyField, NearObjects: []object.Name{object.CairnStoneAlpha, object.CairnStoneBeta, object.CairnStoneGamma, object.CairnStoneDelta, object.CairnStoneLambda, object.CairnStoneTheta}},
+	{Name: "Treehead WoodFist", Area: area.DarkWood, NearObjects: []object.Name{object.InifussTree}},
+	{Name: "Griswold", Area: area.Tristram},
+	{Name: "The Countess", Area: area.TowerCellarLevel5},
+	{Name: "Pitspawn Fouldog", Area: area.JailLevel2},
+	{Name: "Boneash", Area: area.Cathedral},
+	{Name: "Blood Raven", Area: area.BurialGrounds},
+	{Name: "The Smith", Area: area.Barracks},
+	{Name: "The Cow King", Area: area.MooMooFarm},
+
+	// Act 2
+	{Name: "Radament", Area: area.SewersLevel3Act2},
+	{Name: "Bloodwitch the Wild", Area: area.HallsOfTheDeadLevel3},
+	{Name: "Fangskin", Area: area.ClawViperTempleLevel2},
+	{Name: "Beetleburst", Area: area.FarOasis},
+	{Name: "Creeping Feature", Area: area.StonyTombLevel2},
+	{Name: "Coldworm the Burrower", Area: area.MaggotLairLevel3},
+	{Name: "Fire Eye", Area: area.PalaceCellarLevel3},
+	{Name: "Dark Elder", Area: area.LostCity},
+	{Name: "The Summoner", Area: area.ArcaneSanctuary},
+	{Name: "Ancient Kaa the Soulless", Area: area.TalRashasTomb1, OtherAreas: []area.ID{area.TalRashasTomb2, area.TalRashasTomb3, area.TalRashasTomb4, area.TalRashasTomb5, area.TalRashasTomb6, area.TalRashasTomb7}, NearObjects: []object.Name{object.HoradricOrifice}},
+
+	// Act 3
+	{Name: "Sszark the Burning", Area: area.SpiderCavern},
+	{Name: "Witch Doctor Endugu", Area: area.FlayerDungeonLevel3},
+	{Name: "Stormtree", Area: area.LowerKurast},
+	{Name: "Battlemaid Sarina", Area: area.RuinedTemple},
+	{Name: "Icehawk Riftwing", Area: area.SewersLevel1Act3},
+	{Name: "Ismail Vilehand", Area: area.Travincal},
+	{Name: "Toorc Icefist", Area: area.Travincal},
+	{Name: "Geleb Flamefinger", Area: area.Travincal},
+	{Name: "Bremm Sparkfist", Area: area.DuranceOfHateLevel3},
+	{Name: "Wyand Voidbringer", Area: area.DuranceOfHateLevel3},
+	{Name: "Maffer Dragonhand", Area: area.DuranceOfHateLevel3},
+
+	// Act 4
+	{Name: "Grand Vizier of Chaos", Area: area.ChaosSanctuary},
+	{Name: "Lord De Seis", Area: area.ChaosSanctuary},
+	{Name: "Infector of Souls", Area: area.ChaosSanctuary},
+	{Name: "Hephasto the Armorer", Area: area.RiverOfFlame, NearObjects: []object.Name{object.HellForge}},
+
+	// Act 5
+	{Name: "Shenk the Overseer", Area: area.BloodyFoothills, NearEntrance: area.FrigidHighlands},
+	{Name: "Dac Farren", Area: area.BloodyFoothills},
+	{Name: "Eldritch the Rectifier", Area: area.FrigidHighlands, NearWaypoint: true},
+	{Name: "Thresh Socket", Area: area.ArreatPlateau},
+	{Name: "Pindleskin", Area: area.NihlathaksTemple},
+	{Name: "Frozenstein", Area: area.FrozenRiver},
+	{Name: "Bonesaw Breaker", Area: area.GlacialTrail},
+	{Name: "Snapchip Shatter", Area: area.IcyCellar},
+	{Name: "Nihlathak", Area: area.HallsOfVaught},
+	{Name: "Talic the Defender", Area: area.ArreatSummit, NearObjects: []object.Name{object.AncientsAltar}},
+	{Name: "Madawc the Guardian", Area: area.ArreatSummit, NearObjects: []object.Name{object.AncientsAltar}},
+	{Name: "Korlic the Protector", Area: area.ArreatSummit, NearObjects: []object.Name{object.AncientsAltar}},
+	{Name: "Colenzo the Annihilator", Area: area.ThroneOfDestruction},
+	{Name: "Achmel the Cursed", Area: area.ThroneOfDestruction},
+	{Name: "Bartuc the Bloody", Area: area.ThroneOfDestruction},
+	{Name: "Ventar the Unholy", Area: area.ThroneOfDestruction},
+	{Name: "Lister the Tormentor", Area: area.ThroneOfDestruction},
+}
+
+func FindSuperUnique(name string) (SuperUnique, bool) {
+	for _, su := range SuperUniques {
+		if strings.EqualFold(su.Name, name) {
+			return su, true
+		}
+	}
+
+	return SuperUnique{}, false
+}
+
+// SuperUniquesInArea returns the super unique monsters spawning in the given area
+func SuperUniquesInArea(a area.ID) []SuperUnique {
+	superUniques := make([]SuperUnique, 0)
+	for _, su := range SuperUniques {
+		if su.Area == a || slices.Contains(su.OtherAreas, a) {
+			superUniques = append(superUniques, su)
+		}
+	}
+
+	return superUniques
+}
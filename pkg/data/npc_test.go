@@ -0,0 +1,41 @@
+package data
+
+import (
+	"testing"
+
+	"github.com/hectorgimenez/d2go/pkg/data/area"
+	"github.com/hectorgimenez/d2go/pkg/data/npc"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTownNPCs(t *testing.T) {
+	d := Data{
+		PlayerUnit: PlayerUnit{Area: area.RogueEncampment},
+		Monsters: Monsters{
+			{UnitID: 1, Name: npc.Akara, Position: Position{X: 120, Y: 100}},
+			{UnitID: 2, Name: npc.FallenShaman, Position: Position{X: 50, Y: 50}},
+		},
+	}
+
+	// Without presets only the loaded NPCs are known
+	npcs := d.TownNPCs(nil)
+	require.Len(t, npcs, 1)
+	require.Equal(t, TownNPC{ID: npc.Akara, UnitID: 1, Position: Position{X: 120, Y: 100}, Anchor: Position{X: 120, Y: 100}, Loaded: true}, npcs[0])
+
+	presets := NPCs{
+		{ID: npc.Akara, Positions: []Position{{X: 100, Y: 100}}},
+		{ID: npc.Kashya, Positions: []Position{{X: 80, Y: 90}}},
+	}
+	akara, found := d.FindTownNPC(npc.Akara, presets)
+	require.True(t, found)
+	require.True(t, akara.Wandering)
+	require.Equal(t, Position{X: 100, Y: 100}, akara.Anchor)
+
+	kashya, found := d.FindTownNPC(npc.Kashya, presets)
+	require.True(t, found)
+	require.False(t, kashya.Loaded)
+	require.Equal(t, Position{X: 80, Y: 90}, kashya.Position)
+
+	d.PlayerUnit.Area = area.ColdPlains
+	require.Empty(t, d.TownNPCs(presets))
+}
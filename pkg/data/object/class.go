@@ -0,0 +1,68 @@
+package object
+
+// SubClass flags from objects.txt
+const (
+	SubClassShrine     = 0x01
+	SubClassObelisk    = 0x02
+	SubClassTownPortal = 0x04
+	SubClassContainer  = 0x08
+	SubClassArcaneGate = 0x10
+	SubClassWell       = 0x20
+	SubClassWaypoint   = 0x40
+)
+
+// Operate functions from objects.txt, the function executed by the game when the object is interacted with
+const (
+	OperateFnCasket          = 1
+	OperateFnShrine          = 2
+	OperateFnUrn             = 3
+	OperateFnChest           = 4
+	OperateFnBarrel          = 5
+	OperateFnExplodingBarrel = 7
+	OperateFnDoor            = 8
+	OperateFnSearch          = 14 // Corpses, hidden stashes, rocks...
+	OperateFnPortal          = 15
+	OperateFnWell            = 22
+	OperateFnWaypoint        = 23
+	OperateFnEvilUrn         = 68
+)
+
+func (d Description) IsShrine() bool {
+	return d.SubClass&SubClassShrine != 0
+}
+
+func (d Description) IsWell() bool {
+	return d.SubClass&SubClassWell != 0
+}
+
+func (d Description) IsWaypoint() bool {
+	return d.SubClass&SubClassWaypoint != 0
+}
+
+func (d Description) IsDoor() bool {
+	return d.OperateFn == OperateFnDoor
+}
+
+// IsContainer returns true if the object can drop items when opened: chests, caskets, corpses, urns...
+func (d Description) IsContainer() bool {
+	if d.SubClass&SubClassContainer != 0 {
+		return true
+	}
+
+	switch d.OperateFn {
+	case OperateFnCasket, OperateFnUrn, OperateFnChest, OperateFnBarrel, OperateFnExplodingBarrel, OperateFnSearch, OperateFnEvilUrn:
+		return true
+	}
+
+	return false
+}
+
+// IsDestructible returns true if the object is broken instead of opened, like barrels or urns
+func (d Description) IsDestructible() bool {
+	switch d.OperateFn {
+	case OperateFnUrn, OperateFnBarrel, OperateFnExplodingBarrel, OperateFnEvilUrn:
+		return true
+	}
+
+	return false
+}
@@ -12,4 +12,6 @@ type Description struct {
 	Height       int
 	Xoffset      int
 	Yoffset      int
+	OperateFn    int
+	SubClass     int
 }
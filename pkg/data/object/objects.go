@@ -3,587 +3,587 @@
 package object
 
 var Desc = map[int]Description{
-	0:   {Name: "Dummy", ID: 0, SizeX: 0, SizeY: 0, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	1:   {Name: "Casket", ID: 1, SizeX: 5, SizeY: 3, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	2:   {Name: "Shrine", ID: 2, SizeX: 3, SizeY: 3, Left: 3, Top: 84, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	3:   {Name: "Casket", ID: 3, SizeX: 5, SizeY: 3, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	4:   {Name: "LargeUrn", ID: 4, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	5:   {Name: "chest", ID: 5, SizeX: 1, SizeY: 2, Left: 15, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	6:   {Name: "chest", ID: 6, SizeX: 2, SizeY: 1, Left: 15, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	7:   {Name: "Barrel", ID: 7, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	8:   {Name: "TowerTome", ID: 8, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	9:   {Name: "Urn", ID: 9, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	10:  {Name: "Dummy", ID: 10, SizeX: 7, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	11:  {Name: "Barrel", ID: 11, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	12:  {Name: "Dummy", ID: 12, SizeX: 3, SizeY: 3, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: false},
-	13:  {Name: "Door", ID: 13, SizeX: 1, SizeY: 3, Left: -20, Top: -80, Width: 40, Height: 40, Yoffset: 4, Xoffset: 1, HasCollision: true},
-	14:  {Name: "Door", ID: 14, SizeX: 3, SizeY: 1, Left: -20, Top: -80, Width: 40, Height: 40, Yoffset: 4, Xoffset: 1, HasCollision: true},
-	15:  {Name: "Door", ID: 15, SizeX: 1, SizeY: 3, Left: -20, Top: -80, Width: 40, Height: 40, Yoffset: 4, Xoffset: 0, HasCollision: true},
-	16:  {Name: "Door", ID: 16, SizeX: 3, SizeY: 1, Left: -20, Top: -80, Width: 40, Height: 40, Yoffset: 3, Xoffset: 1, HasCollision: true},
-	17:  {Name: "StoneAlpha", ID: 17, SizeX: 3, SizeY: 3, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	18:  {Name: "StoneBeta", ID: 18, SizeX: 3, SizeY: 3, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	19:  {Name: "StoneGamma", ID: 19, SizeX: 3, SizeY: 3, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	20:  {Name: "StoneDelta", ID: 20, SizeX: 3, SizeY: 3, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	21:  {Name: "StoneLambda", ID: 21, SizeX: 3, SizeY: 3, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	22:  {Name: "StoneTheta", ID: 22, SizeX: 3, SizeY: 3, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	23:  {Name: "Door", ID: 23, SizeX: 1, SizeY: 7, Left: -20, Top: -80, Width: 40, Height: 40, Yoffset: 1, Xoffset: 1, HasCollision: true},
-	24:  {Name: "Door", ID: 24, SizeX: 7, SizeY: 1, Left: -20, Top: -80, Width: 40, Height: 40, Yoffset: 1, Xoffset: 1, HasCollision: true},
-	25:  {Name: "Door", ID: 25, SizeX: 9, SizeY: 1, Left: -20, Top: -80, Width: 40, Height: 40, Yoffset: -11, Xoffset: 0, HasCollision: true},
-	26:  {Name: "Gibbet", ID: 26, SizeX: 3, SizeY: 3, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 15, Xoffset: -14, HasCollision: true},
-	27:  {Name: "Door", ID: 27, SizeX: 7, SizeY: 1, Left: -20, Top: -80, Width: 40, Height: 40, Yoffset: 1, Xoffset: 1, HasCollision: true},
-	28:  {Name: "HoleAnim", ID: 28, SizeX: 5, SizeY: 3, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 1, Xoffset: 1, HasCollision: true},
-	29:  {Name: "Dummy", ID: 29, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	30:  {Name: "Inifuss", ID: 30, SizeX: 5, SizeY: 5, Left: -40, Top: -40, Width: 80, Height: 80, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	31:  {Name: "Dummy", ID: 31, SizeX: 3, SizeY: 3, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	32:  {Name: "Dummy", ID: 32, SizeX: 3, SizeY: 3, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	33:  {Name: "Dummy", ID: 33, SizeX: 3, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	34:  {Name: "Dummy", ID: 34, SizeX: 1, SizeY: 3, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	35:  {Name: "Dummy", ID: 35, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	36:  {Name: "Dummy", ID: 36, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	37:  {Name: "Dummy", ID: 37, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	38:  {Name: "Dummy", ID: 38, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	39:  {Name: "fire", ID: 39, SizeX: 3, SizeY: 3, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: false},
-	40:  {Name: "Dummy", ID: 40, SizeX: 0, SizeY: 0, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: -9, Xoffset: 0, HasCollision: false},
-	41:  {Name: "Dummy", ID: 41, SizeX: 0, SizeY: 0, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: -9, Xoffset: 0, HasCollision: false},
-	42:  {Name: "Dummy", ID: 42, SizeX: 0, SizeY: 0, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: -9, Xoffset: 0, HasCollision: false},
-	43:  {Name: "Dummy", ID: 43, SizeX: 0, SizeY: 0, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: -9, Xoffset: 0, HasCollision: false},
-	44:  {Name: "Dummy", ID: 44, SizeX: 0, SizeY: 0, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: -9, Xoffset: 0, HasCollision: false},
-	45:  {Name: "AmbientSound", ID: 45, SizeX: 0, SizeY: 0, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: false},
-	46:  {Name: "Crate", ID: 46, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	47:  {Name: "Door", ID: 47, SizeX: 7, SizeY: 1, Left: -20, Top: -80, Width: 40, Height: 40, Yoffset: 1, Xoffset: 1, HasCollision: true},
-	48:  {Name: "Dummy", ID: 48, SizeX: 2, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	49:  {Name: "Dummy", ID: 49, SizeX: 1, SizeY: 2, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	50:  {Name: "Casket", ID: 50, SizeX: 5, SizeY: 3, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 9, Xoffset: 0, HasCollision: true},
-	51:  {Name: "Casket", ID: 51, SizeX: 3, SizeY: 5, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	52:  {Name: "Urn", ID: 52, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	53:  {Name: "Casket", ID: 53, SizeX: 5, SizeY: 3, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 4, Xoffset: 0, HasCollision: true},
-	54:  {Name: "RogueCorpse", ID: 54, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	55:  {Name: "RogueCorpse", ID: 55, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	56:  {Name: "RogueCorpse", ID: 56, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	57:  {Name: "CorpseOnStick", ID: 57, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	58:  {Name: "CorpseOnStick", ID: 58, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	59:  {Name: "Portal", ID: 59, SizeX: 4, SizeY: 4, Left: -40, Top: -100, Width: 80, Height: 110, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	60:  {Name: "Portal", ID: 60, SizeX: 1, SizeY: 1, Left: -40, Top: -100, Width: 80, Height: 110, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	61:  {Name: "Dummy", ID: 61, SizeX: 5, SizeY: 5, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: false},
-	62:  {Name: "Door", ID: 62, SizeX: 1, SizeY: 4, Left: -20, Top: -80, Width: 40, Height: 40, Yoffset: 1, Xoffset: 1, HasCollision: true},
-	63:  {Name: "Door", ID: 63, SizeX: 4, SizeY: 1, Left: -20, Top: -80, Width: 40, Height: 40, Yoffset: 1, Xoffset: 1, HasCollision: true},
-	64:  {Name: "Door", ID: 64, SizeX: 1, SizeY: 4, Left: -20, Top: -80, Width: 40, Height: 40, Yoffset: 4, Xoffset: -1, HasCollision: true},
-	65:  {Name: "Dummy", ID: 65, SizeX: 0, SizeY: 32, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: false},
-	66:  {Name: "Dummy", ID: 66, SizeX: 32, SizeY: 0, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: false},
-	67:  {Name: "Dummy", ID: 67, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: false},
-	68:  {Name: "Dummy", ID: 68, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: false},
-	69:  {Name: "Dummy", ID: 69, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: false},
-	70:  {Name: "Dummy", ID: 70, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: false},
-	71:  {Name: "Dummy", ID: 71, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: false},
-	72:  {Name: "Dummy", ID: 72, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: false},
-	73:  {Name: "Dummy", ID: 73, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	74:  {Name: "TrappDoor", ID: 74, SizeX: 3, SizeY: 3, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	75:  {Name: "Door", ID: 75, SizeX: 1, SizeY: 3, Left: -20, Top: -80, Width: 40, Height: 40, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	76:  {Name: "Dummy", ID: 76, SizeX: 5, SizeY: 5, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: false},
-	77:  {Name: "Shrine", ID: 77, SizeX: 3, SizeY: 3, Left: 1, Top: 84, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	78:  {Name: "Dummy", ID: 78, SizeX: 0, SizeY: 0, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: false},
-	79:  {Name: "Casket", ID: 79, SizeX: 4, SizeY: 6, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: -4, Xoffset: 0, HasCollision: true},
-	80:  {Name: "Obelisk", ID: 80, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	81:  {Name: "Shrine", ID: 81, SizeX: 3, SizeY: 3, Left: 3, Top: 84, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	82:  {Name: "Dummy", ID: 82, SizeX: 3, SizeY: 3, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	83:  {Name: "Shrine", ID: 83, SizeX: 3, SizeY: 3, Left: 3, Top: 84, Width: 0, Height: 0, Yoffset: 0, Xoffset: -20, HasCollision: true},
-	84:  {Name: "Shrine", ID: 84, SizeX: 3, SizeY: 3, Left: 1, Top: 84, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	85:  {Name: "Shrine", ID: 85, SizeX: 3, SizeY: 3, Left: 1, Top: 85, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	86:  {Name: "Dummy", ID: 86, SizeX: 3, SizeY: 3, Left: 3, Top: 85, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	87:  {Name: "Chest3", ID: 87, SizeX: 1, SizeY: 3, Left: 20, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	88:  {Name: "Chest3", ID: 88, SizeX: 3, SizeY: 1, Left: 20, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	89:  {Name: "Sarcophagus", ID: 89, SizeX: 3, SizeY: 5, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	90:  {Name: "Obelisk", ID: 90, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	91:  {Name: "Door", ID: 91, SizeX: 1, SizeY: 7, Left: -20, Top: -80, Width: 40, Height: 40, Yoffset: -8, Xoffset: -11, HasCollision: true},
-	92:  {Name: "Door", ID: 92, SizeX: 7, SizeY: 1, Left: -20, Top: -80, Width: 40, Height: 40, Yoffset: 2, Xoffset: 18, HasCollision: true},
-	93:  {Name: "Shrine", ID: 93, SizeX: 3, SizeY: 3, Left: 2, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	94:  {Name: "LargeUrn", ID: 94, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	95:  {Name: "LargeUrn", ID: 95, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	96:  {Name: "Shrine", ID: 96, SizeX: 3, SizeY: 3, Left: 1, Top: 96, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	97:  {Name: "Shrine", ID: 97, SizeX: 4, SizeY: 4, Left: 3, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	98:  {Name: "Door", ID: 98, SizeX: 1, SizeY: 7, Left: -20, Top: -80, Width: 40, Height: 40, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	99:  {Name: "Door", ID: 99, SizeX: 7, SizeY: 1, Left: -20, Top: -80, Width: 40, Height: 40, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	100: {Name: "Duriel's Lair", ID: 100, SizeX: 1, SizeY: 3, Left: -40, Top: -80, Width: 80, Height: 80, Yoffset: -38, Xoffset: -14, HasCollision: true},
-	101: {Name: "Dummy", ID: 101, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	102: {Name: "Dummy", ID: 102, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	103: {Name: "Dummy", ID: 103, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: false},
-	104: {Name: "ArmorStand", ID: 104, SizeX: 2, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	105: {Name: "ArmorStand", ID: 105, SizeX: 1, SizeY: 2, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	106: {Name: "WeaponRack", ID: 106, SizeX: 2, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	107: {Name: "WeaponRack", ID: 107, SizeX: 1, SizeY: 2, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	108: {Name: "Malus", ID: 108, SizeX: 2, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	109: {Name: "Shrine", ID: 109, SizeX: 3, SizeY: 3, Left: 1, Top: 109, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	110: {Name: "not used", ID: 110, SizeX: 0, SizeY: 0, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: -10, Xoffset: -35, HasCollision: false},
-	111: {Name: "well", ID: 111, SizeX: 3, SizeY: 3, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	112: {Name: "not used", ID: 112, SizeX: 0, SizeY: 0, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 10, Xoffset: 0, HasCollision: false},
-	113: {Name: "well", ID: 113, SizeX: 3, SizeY: 3, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	114: {Name: "not used", ID: 114, SizeX: 0, SizeY: 0, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: -5, Xoffset: -5, HasCollision: false},
-	115: {Name: "well", ID: 115, SizeX: 3, SizeY: 3, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	116: {Name: "Shrine", ID: 116, SizeX: 3, SizeY: 3, Left: 3, Top: 109, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	117: {Name: "Dummy", ID: 117, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	118: {Name: "Well", ID: 118, SizeX: 3, SizeY: 3, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	119: {Name: "Waypoint", ID: 119, SizeX: 5, SizeY: 5, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: false},
-	120: {Name: "Dummy", ID: 120, SizeX: 3, SizeY: 3, Left: 1, Top: 120, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	121: {Name: "jerhyn", ID: 121, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: false},
-	122: {Name: "jerhyn", ID: 122, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: false},
-	123: {Name: "Shrine", ID: 123, SizeX: 3, SizeY: 3, Left: 3, Top: 96, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	124: {Name: "Shrine", ID: 124, SizeX: 4, SizeY: 3, Left: 3, Top: 96, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	125: {Name: "hidden stash", ID: 125, SizeX: 1, SizeY: 1, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	126: {Name: "skull pile", ID: 126, SizeX: 1, SizeY: 1, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	127: {Name: "hidden stash", ID: 127, SizeX: 4, SizeY: 4, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	128: {Name: "hidden stash", ID: 128, SizeX: 1, SizeY: 1, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	129: {Name: "Door", ID: 129, SizeX: 1, SizeY: 5, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	130: {Name: "Well", ID: 130, SizeX: 3, SizeY: 3, Left: 750, Top: 128, Width: 1, Height: 3, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	131: {Name: "Dummy", ID: 131, SizeX: 0, SizeY: 0, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	132: {Name: "Well", ID: 132, SizeX: 3, SizeY: 3, Left: 750, Top: 128, Width: 1, Height: 3, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	133: {Name: "shrine", ID: 133, SizeX: 3, SizeY: 3, Left: 3, Top: 85, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	134: {Name: "shrine", ID: 134, SizeX: 3, SizeY: 4, Left: 3, Top: 85, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	135: {Name: "shrine", ID: 135, SizeX: 2, SizeY: 2, Left: 3, Top: 85, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	136: {Name: "shrine", ID: 136, SizeX: 6, SizeY: 3, Left: 3, Top: 85, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	137: {Name: "Well", ID: 137, SizeX: 3, SizeY: 3, Left: 750, Top: 128, Width: 1, Height: 3, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	138: {Name: "Well", ID: 138, SizeX: 4, SizeY: 4, Left: 750, Top: 128, Width: 1, Height: 3, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	139: {Name: "chest", ID: 139, SizeX: 3, SizeY: 2, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	140: {Name: "chest", ID: 140, SizeX: 1, SizeY: 1, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	141: {Name: "chest", ID: 141, SizeX: 3, SizeY: 2, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	142: {Name: "jug", ID: 142, SizeX: 1, SizeY: 1, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	143: {Name: "jug", ID: 143, SizeX: 1, SizeY: 1, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	144: {Name: "chest", ID: 144, SizeX: 1, SizeY: 1, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	145: {Name: "Waypoint", ID: 145, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: false},
-	146: {Name: "chest", ID: 146, SizeX: 3, SizeY: 1, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	147: {Name: "chest", ID: 147, SizeX: 3, SizeY: 1, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	148: {Name: "chest", ID: 148, SizeX: 2, SizeY: 2, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	149: {Name: "taintedsunaltar", ID: 149, SizeX: 4, SizeY: 2, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	150: {Name: "shrine", ID: 150, SizeX: 5, SizeY: 5, Left: 3, Top: 85, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	151: {Name: "shrine", ID: 151, SizeX: 5, SizeY: 4, Left: 3, Top: 85, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	152: {Name: "orifice", ID: 152, SizeX: 3, SizeY: 3, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	153: {Name: "Door", ID: 153, SizeX: 1, SizeY: 5, Left: -20, Top: -80, Width: 40, Height: 40, Yoffset: -18, Xoffset: -8, HasCollision: true},
-	154: {Name: "corpse", ID: 154, SizeX: 3, SizeY: 3, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	155: {Name: "hidden stash", ID: 155, SizeX: 1, SizeY: 1, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	156: {Name: "Waypoint", ID: 156, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: false},
-	157: {Name: "Waypoint", ID: 157, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: false},
-	158: {Name: "skeleton", ID: 158, SizeX: 3, SizeY: 3, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	159: {Name: "hidden stash", ID: 159, SizeX: 1, SizeY: 1, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	160: {Name: "fire", ID: 160, SizeX: 0, SizeY: 0, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: false},
-	161: {Name: "fire", ID: 161, SizeX: 0, SizeY: 0, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: false},
-	162: {Name: "fire", ID: 162, SizeX: 0, SizeY: 0, Left: 1, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: false},
-	163: {Name: "hiding spot", ID: 163, SizeX: 1, SizeY: 1, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	164: {Name: "Shrine", ID: 164, SizeX: 3, SizeY: 3, Left: 2, Top: 85, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	165: {Name: "Shrine", ID: 165, SizeX: 3, SizeY: 3, Left: 2, Top: 77, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	166: {Name: "Shrine", ID: 166, SizeX: 3, SizeY: 3, Left: 2, Top: 85, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	167: {Name: "Shrine", ID: 167, SizeX: 3, SizeY: 3, Left: 2, Top: 85, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	168: {Name: "Shrine", ID: 168, SizeX: 3, SizeY: 3, Left: 2, Top: 85, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	169: {Name: "hollow log", ID: 169, SizeX: 5, SizeY: 1, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	170: {Name: "Shrine", ID: 170, SizeX: 3, SizeY: 3, Left: 1, Top: 170, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	171: {Name: "skeleton", ID: 171, SizeX: 3, SizeY: 3, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	172: {Name: "Shrine", ID: 172, SizeX: 2, SizeY: 2, Left: 1, Top: 172, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	173: {Name: "Shrine", ID: 173, SizeX: 2, SizeY: 2, Left: 2, Top: 172, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	174: {Name: "loose rock", ID: 174, SizeX: 1, SizeY: 1, Left: -18, Top: -20, Width: 36, Height: 30, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	175: {Name: "loose boulder", ID: 175, SizeX: 1, SizeY: 1, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	176: {Name: "chest", ID: 176, SizeX: 1, SizeY: 1, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	177: {Name: "chest", ID: 177, SizeX: 1, SizeY: 1, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	178: {Name: "GuardCorpse", ID: 178, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	179: {Name: "bookshelf", ID: 179, SizeX: 1, SizeY: 1, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	180: {Name: "bookshelf", ID: 180, SizeX: 1, SizeY: 1, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	181: {Name: "chest", ID: 181, SizeX: 3, SizeY: 1, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	182: {Name: "coffin", ID: 182, SizeX: 1, SizeY: 1, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	183: {Name: "chest", ID: 183, SizeX: 1, SizeY: 1, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	184: {Name: "Shrine", ID: 184, SizeX: 3, SizeY: 3, Left: 3, Top: 184, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	185: {Name: "stash", ID: 185, SizeX: 2, SizeY: 2, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	186: {Name: "stash", ID: 186, SizeX: 1, SizeY: 1, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	187: {Name: "stash", ID: 187, SizeX: 2, SizeY: 2, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	188: {Name: "stash", ID: 188, SizeX: 1, SizeY: 1, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	189: {Name: "Dummy", ID: 189, SizeX: 0, SizeY: 0, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	190: {Name: "Shrine", ID: 190, SizeX: 3, SizeY: 4, Left: 3, Top: 184, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	191: {Name: "Shrine", ID: 191, SizeX: 3, SizeY: 3, Left: 3, Top: 184, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	192: {Name: "teleport pad", ID: 192, SizeX: 1, SizeY: 1, Left: -30, Top: -80, Width: 60, Height: 80, Yoffset: 0, Xoffset: 0, HasCollision: false},
-	193: {Name: "LamTome", ID: 193, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	194: {Name: "stair", ID: 194, SizeX: 6, SizeY: 10, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	195: {Name: "stair", ID: 195, SizeX: 10, SizeY: 6, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	196: {Name: "a trap", ID: 196, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: -18, Xoffset: 2, HasCollision: false},
-	197: {Name: "Shrine", ID: 197, SizeX: 3, SizeY: 3, Left: 3, Top: 184, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	198: {Name: "chest", ID: 198, SizeX: 1, SizeY: 1, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	199: {Name: "Shrine", ID: 199, SizeX: 3, SizeY: 4, Left: 3, Top: 206, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	200: {Name: "Shrine", ID: 200, SizeX: 5, SizeY: 3, Left: 3, Top: 206, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	201: {Name: "Shrine", ID: 201, SizeX: 4, SizeY: 4, Left: 3, Top: 206, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	202: {Name: "Shrine", ID: 202, SizeX: 3, SizeY: 4, Left: 2, Top: 206, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	203: {Name: "stash", ID: 203, SizeX: 1, SizeY: 1, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	204: {Name: "stash", ID: 204, SizeX: 1, SizeY: 3, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	205: {Name: "stash", ID: 205, SizeX: 2, SizeY: 2, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	206: {Name: "Shrine", ID: 206, SizeX: 3, SizeY: 4, Left: 1, Top: 206, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	207: {Name: "dummy", ID: 207, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	208: {Name: "Basket", ID: 208, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	209: {Name: "Basket", ID: 209, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	210: {Name: "Dummy", ID: 210, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	211: {Name: "Dummy", ID: 211, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	212: {Name: "Dummy", ID: 212, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	213: {Name: "Dummy", ID: 213, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	214: {Name: "Dummy", ID: 214, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	215: {Name: "Dummy", ID: 215, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	216: {Name: "Dummy", ID: 216, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	217: {Name: "Dummy", ID: 217, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	218: {Name: "Dummy", ID: 218, SizeX: 3, SizeY: 9, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	219: {Name: "Dummy", ID: 219, SizeX: 9, SizeY: 3, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	220: {Name: "Dummy", ID: 220, SizeX: 3, SizeY: 3, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	221: {Name: "Dummy", ID: 221, SizeX: 3, SizeY: 3, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	222: {Name: "pillar", ID: 222, SizeX: 2, SizeY: 2, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	223: {Name: "cocoon", ID: 223, SizeX: 1, SizeY: 1, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	224: {Name: "cocoon", ID: 224, SizeX: 1, SizeY: 1, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	225: {Name: "skullpile", ID: 225, SizeX: 1, SizeY: 1, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	226: {Name: "Shrine", ID: 226, SizeX: 3, SizeY: 4, Left: 3, Top: 96, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	227: {Name: "dummy", ID: 227, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	228: {Name: "dummy", ID: 228, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	229: {Name: "door", ID: 229, SizeX: 7, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 5, Xoffset: 20, HasCollision: true},
-	230: {Name: "door", ID: 230, SizeX: 1, SizeY: 7, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 3, Xoffset: -23, HasCollision: true},
-	231: {Name: "Shrine", ID: 231, SizeX: 3, SizeY: 4, Left: 3, Top: 96, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	232: {Name: "Shrine", ID: 232, SizeX: 4, SizeY: 4, Left: 3, Top: 96, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	233: {Name: "pillar", ID: 233, SizeX: 3, SizeY: 3, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	234: {Name: "dummy", ID: 234, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	235: {Name: "dummy", ID: 235, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	236: {Name: "Shrine", ID: 236, SizeX: 3, SizeY: 3, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	237: {Name: "Waypoint", ID: 237, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: false},
-	238: {Name: "Waypoint", ID: 238, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: false},
-	239: {Name: "body", ID: 239, SizeX: 3, SizeY: 3, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	240: {Name: "chest", ID: 240, SizeX: 1, SizeY: 3, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	241: {Name: "chest", ID: 241, SizeX: 1, SizeY: 1, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	242: {Name: "chest", ID: 242, SizeX: 1, SizeY: 1, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	243: {Name: "chest", ID: 243, SizeX: 1, SizeY: 1, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	244: {Name: "ratnest", ID: 244, SizeX: 1, SizeY: 1, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	245: {Name: "body", ID: 245, SizeX: 1, SizeY: 1, Left: 1, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: false},
-	246: {Name: "ratnest", ID: 246, SizeX: 1, SizeY: 1, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	247: {Name: "bed", ID: 247, SizeX: 4, SizeY: 2, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	248: {Name: "bed", ID: 248, SizeX: 2, SizeY: 4, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	249: {Name: "manashrine", ID: 249, SizeX: 3, SizeY: 3, Left: 2, Top: 96, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	250: {Name: "a trap", ID: 250, SizeX: 3, SizeY: 1, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	251: {Name: "gidbinn altar", ID: 251, SizeX: 3, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	252: {Name: "gidbinn", ID: 252, SizeX: 3, SizeY: 3, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	253: {Name: "Dummy", ID: 253, SizeX: 0, SizeY: 0, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: false},
-	254: {Name: "Dummy", ID: 254, SizeX: 0, SizeY: 0, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: false},
-	255: {Name: "Dummy", ID: 255, SizeX: 0, SizeY: 0, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: false},
-	256: {Name: "Dummy", ID: 256, SizeX: 3, SizeY: 1, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	257: {Name: "Dummy", ID: 257, SizeX: 3, SizeY: 1, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	258: {Name: "Dummy", ID: 258, SizeX: 3, SizeY: 1, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	259: {Name: "Dummy", ID: 259, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: false},
-	260: {Name: "Shrine", ID: 260, SizeX: 1, SizeY: 1, Left: 3, Top: 77, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	261: {Name: "a trap", ID: 261, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: -15, Xoffset: 5, HasCollision: false},
-	262: {Name: "Shrine", ID: 262, SizeX: 3, SizeY: 3, Left: 3, Top: 77, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	263: {Name: "Shrine", ID: 263, SizeX: 3, SizeY: 3, Left: 3, Top: 77, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	264: {Name: "Shrine", ID: 264, SizeX: 3, SizeY: 3, Left: 1, Top: 264, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	265: {Name: "Shrine", ID: 265, SizeX: 3, SizeY: 3, Left: 2, Top: 264, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	266: {Name: "goo pile", ID: 266, SizeX: 1, SizeY: 1, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	267: {Name: "bank", ID: 267, SizeX: 1, SizeY: 1, Left: 1, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	268: {Name: "wirt's body", ID: 268, SizeX: 1, SizeY: 1, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	269: {Name: "dummy", ID: 269, SizeX: 0, SizeY: 0, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: false},
-	270: {Name: "corpse", ID: 270, SizeX: 3, SizeY: 3, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	271: {Name: "corpse", ID: 271, SizeX: 3, SizeY: 3, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	272: {Name: "corpse", ID: 272, SizeX: 3, SizeY: 3, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	273: {Name: "Dummy", ID: 273, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	274: {Name: "hidden stash", ID: 274, SizeX: 0, SizeY: 0, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: false},
-	275: {Name: "Shrine", ID: 275, SizeX: 3, SizeY: 3, Left: 1, Top: 275, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	276: {Name: "Shrine", ID: 276, SizeX: 3, SizeY: 3, Left: 2, Top: 275, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	277: {Name: "Shrine", ID: 277, SizeX: 3, SizeY: 3, Left: 3, Top: 275, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	278: {Name: "Shrine", ID: 278, SizeX: 3, SizeY: 3, Left: 2, Top: 120, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	279: {Name: "Shrine", ID: 279, SizeX: 2, SizeY: 2, Left: 3, Top: 282, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	280: {Name: "Shrine", ID: 280, SizeX: 3, SizeY: 4, Left: 1, Top: 282, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	281: {Name: "Shrine", ID: 281, SizeX: 3, SizeY: 4, Left: 2, Top: 282, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	282: {Name: "Shrine", ID: 282, SizeX: 3, SizeY: 3, Left: 3, Top: 282, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	283: {Name: "dummy", ID: 283, SizeX: 1, SizeY: 1, Left: 1, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	284: {Name: "sarcophagus", ID: 284, SizeX: 3, SizeY: 5, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	285: {Name: "dummy", ID: 285, SizeX: 1, SizeY: 1, Left: 1, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	286: {Name: "Dummy", ID: 286, SizeX: 3, SizeY: 3, Left: 1, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	287: {Name: "Dummy", ID: 287, SizeX: 2, SizeY: 2, Left: 1, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	288: {Name: "Waypoint", ID: 288, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: false},
-	289: {Name: "bed", ID: 289, SizeX: 5, SizeY: 5, Left: -50, Top: 0, Width: 100, Height: 80, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	290: {Name: "door", ID: 290, SizeX: 1, SizeY: 3, Left: -20, Top: -80, Width: 40, Height: 40, Yoffset: -5, Xoffset: -8, HasCollision: true},
-	291: {Name: "door", ID: 291, SizeX: 3, SizeY: 1, Left: -20, Top: -80, Width: 40, Height: 40, Yoffset: 0, Xoffset: 8, HasCollision: true},
-	292: {Name: "door", ID: 292, SizeX: 1, SizeY: 3, Left: -20, Top: -80, Width: 40, Height: 40, Yoffset: -5, Xoffset: -9, HasCollision: true},
-	293: {Name: "door", ID: 293, SizeX: 3, SizeY: 1, Left: -20, Top: -80, Width: 40, Height: 40, Yoffset: -3, Xoffset: 8, HasCollision: true},
-	294: {Name: "door", ID: 294, SizeX: 1, SizeY: 3, Left: -20, Top: -80, Width: 40, Height: 40, Yoffset: -3, Xoffset: -10, HasCollision: true},
-	295: {Name: "door", ID: 295, SizeX: 3, SizeY: 1, Left: -20, Top: -80, Width: 40, Height: 40, Yoffset: 0, Xoffset: 7, HasCollision: true},
-	296: {Name: "Dummy", ID: 296, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	297: {Name: "Dummy", ID: 297, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	298: {Name: "portal", ID: 298, SizeX: 5, SizeY: 2, Left: -30, Top: -80, Width: 60, Height: 80, Yoffset: 0, Xoffset: 0, HasCollision: false},
-	299: {Name: "magic shrine", ID: 299, SizeX: 3, SizeY: 3, Left: 3, Top: 301, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	300: {Name: "magic shrine", ID: 300, SizeX: 3, SizeY: 3, Left: 3, Top: 301, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	301: {Name: "Dummy", ID: 301, SizeX: 1, SizeY: 1, Left: 1, Top: 301, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	302: {Name: "manashrine", ID: 302, SizeX: 1, SizeY: 1, Left: 2, Top: 301, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	303: {Name: "magic shrine", ID: 303, SizeX: 3, SizeY: 3, Left: 3, Top: 301, Width: 60, Height: 80, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	304: {Name: "teleportation pad", ID: 304, SizeX: 1, SizeY: 1, Left: -30, Top: -80, Width: 60, Height: 80, Yoffset: 0, Xoffset: 0, HasCollision: false},
-	305: {Name: "teleportation pad", ID: 305, SizeX: 1, SizeY: 1, Left: -30, Top: -80, Width: 60, Height: 80, Yoffset: 0, Xoffset: 0, HasCollision: false},
-	306: {Name: "teleportation pad", ID: 306, SizeX: 1, SizeY: 1, Left: -30, Top: -80, Width: 60, Height: 80, Yoffset: 0, Xoffset: 0, HasCollision: false},
-	307: {Name: "Dummy", ID: 307, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: false},
-	308: {Name: "Dummy", ID: 308, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: false},
-	309: {Name: "Dummy", ID: 309, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: false},
-	310: {Name: "Dummy", ID: 310, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: false},
-	311: {Name: "Dummy", ID: 311, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: false},
-	312: {Name: "Dummy", ID: 312, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: false},
-	313: {Name: "Dummy", ID: 313, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: false},
-	314: {Name: "dead guard", ID: 314, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	315: {Name: "dead guard", ID: 315, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	316: {Name: "dead guard", ID: 316, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	317: {Name: "dead guard", ID: 317, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	318: {Name: "eunuch", ID: 318, SizeX: 1, SizeY: 7, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	319: {Name: "Dummy", ID: 319, SizeX: 2, SizeY: 2, Left: 1, Top: 282, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	320: {Name: "manashrine", ID: 320, SizeX: 2, SizeY: 2, Left: 1, Top: 282, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	321: {Name: "Dummy", ID: 321, SizeX: 0, SizeY: 0, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	322: {Name: "Well", ID: 322, SizeX: 3, SizeY: 3, Left: 750, Top: 128, Width: 1, Height: 3, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	323: {Name: "Waypoint", ID: 323, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: false},
-	324: {Name: "Waypoint", ID: 324, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: false},
-	325: {Name: "magic shrine", ID: 325, SizeX: 3, SizeY: 3, Left: 3, Top: 301, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	326: {Name: "dead body", ID: 326, SizeX: 3, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	327: {Name: "dummy", ID: 327, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	328: {Name: "dummy", ID: 328, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	329: {Name: "chest", ID: 329, SizeX: 1, SizeY: 3, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	330: {Name: "chest", ID: 330, SizeX: 1, SizeY: 3, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	331: {Name: "chest", ID: 331, SizeX: 1, SizeY: 1, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	332: {Name: "chest", ID: 332, SizeX: 1, SizeY: 1, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	333: {Name: "chest", ID: 333, SizeX: 1, SizeY: 3, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	334: {Name: "chest", ID: 334, SizeX: 1, SizeY: 1, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	335: {Name: "chest", ID: 335, SizeX: 1, SizeY: 1, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	336: {Name: "chest", ID: 336, SizeX: 1, SizeY: 1, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	337: {Name: "Steeg Stone", ID: 337, SizeX: 2, SizeY: 2, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	338: {Name: "Guild Vault", ID: 338, SizeX: 3, SizeY: 3, Left: 1, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	339: {Name: "Trophy Case", ID: 339, SizeX: 2, SizeY: 3, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	340: {Name: "Message Board", ID: 340, SizeX: 2, SizeY: 3, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	341: {Name: "Dummy", ID: 341, SizeX: 11, SizeY: 5, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: -20, Xoffset: -45, HasCollision: true},
-	342: {Name: "portal", ID: 342, SizeX: 1, SizeY: 1, Left: -40, Top: -80, Width: 80, Height: 80, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	343: {Name: "Shrine", ID: 343, SizeX: 2, SizeY: 2, Left: 1, Top: 282, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	344: {Name: "Shrine", ID: 344, SizeX: 2, SizeY: 2, Left: 1, Top: 282, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	345: {Name: "Dummy", ID: 345, SizeX: 0, SizeY: 0, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: false},
-	346: {Name: "Dummy", ID: 346, SizeX: 0, SizeY: 0, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: false},
-	347: {Name: "Dummy", ID: 347, SizeX: 0, SizeY: 0, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: false},
-	348: {Name: "Dummy", ID: 348, SizeX: 0, SizeY: 0, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: false},
-	349: {Name: "Dummy", ID: 349, SizeX: 0, SizeY: 0, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: false},
-	350: {Name: "Dummy", ID: 350, SizeX: 0, SizeY: 0, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: false},
-	351: {Name: "Dummy", ID: 351, SizeX: 0, SizeY: 0, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: false},
-	352: {Name: "Dummy", ID: 352, SizeX: 0, SizeY: 0, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: false},
-	353: {Name: "Dummy", ID: 353, SizeX: 0, SizeY: 0, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: false},
-	354: {Name: "chest", ID: 354, SizeX: 1, SizeY: 1, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	355: {Name: "chest", ID: 355, SizeX: 1, SizeY: 1, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	356: {Name: "chest", ID: 356, SizeX: 1, SizeY: 1, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	357: {Name: "Tome", ID: 357, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	358: {Name: "fire", ID: 358, SizeX: 3, SizeY: 3, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	359: {Name: "fire", ID: 359, SizeX: 3, SizeY: 3, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	360: {Name: "RockPIle", ID: 360, SizeX: 2, SizeY: 2, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	361: {Name: "magic shrine", ID: 361, SizeX: 3, SizeY: 3, Left: 3, Top: 301, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	362: {Name: "basket", ID: 362, SizeX: 1, SizeY: 1, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	363: {Name: "HungSkeleton", ID: 363, SizeX: 4, SizeY: 4, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	364: {Name: "Dummy", ID: 364, SizeX: 2, SizeY: 1, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	365: {Name: "casket", ID: 365, SizeX: 5, SizeY: 3, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	366: {Name: "sewer stairs", ID: 366, SizeX: 5, SizeY: 5, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	367: {Name: "sewer lever", ID: 367, SizeX: 3, SizeY: 5, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	368: {Name: "darkwanderer", ID: 368, SizeX: 0, SizeY: 0, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: false},
-	369: {Name: "dummy", ID: 369, SizeX: 0, SizeY: 0, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: false},
-	370: {Name: "Dummy", ID: 370, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	371: {Name: "chest", ID: 371, SizeX: 1, SizeY: 1, Left: 15, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	372: {Name: "BoneChest", ID: 372, SizeX: 1, SizeY: 1, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	373: {Name: "Dummy", ID: 373, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: false},
-	374: {Name: "Dummy", ID: 374, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: false},
-	375: {Name: "Dummy", ID: 375, SizeX: 1, SizeY: 3, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	376: {Name: "Hellforge", ID: 376, SizeX: 3, SizeY: 3, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: -10, HasCollision: true},
-	377: {Name: "Guild Portal", ID: 377, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: false},
-	378: {Name: "Dummy", ID: 378, SizeX: 0, SizeY: 0, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: false},
-	379: {Name: "Dummy", ID: 379, SizeX: 0, SizeY: 0, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: false},
-	380: {Name: "TrappedSoul", ID: 380, SizeX: 2, SizeY: 2, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 20, Xoffset: -3, HasCollision: true},
-	381: {Name: "TrappedSoul", ID: 381, SizeX: 2, SizeY: 2, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 20, Xoffset: -3, HasCollision: true},
-	382: {Name: "Dummy", ID: 382, SizeX: 0, SizeY: 0, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: false},
-	383: {Name: "TrappedSoul", ID: 383, SizeX: 2, SizeY: 2, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	384: {Name: "TrappedSoul", ID: 384, SizeX: 2, SizeY: 2, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	385: {Name: "Dummy", ID: 385, SizeX: 0, SizeY: 0, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: false},
-	386: {Name: "Dummy", ID: 386, SizeX: 10, SizeY: 6, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	387: {Name: "chest", ID: 387, SizeX: 1, SizeY: 3, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	388: {Name: "casket", ID: 388, SizeX: 1, SizeY: 3, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	389: {Name: "chest", ID: 389, SizeX: 3, SizeY: 1, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	390: {Name: "chest", ID: 390, SizeX: 1, SizeY: 2, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	391: {Name: "chest", ID: 391, SizeX: 2, SizeY: 1, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	392: {Name: "Seal", ID: 392, SizeX: 5, SizeY: 5, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	393: {Name: "Seal", ID: 393, SizeX: 5, SizeY: 5, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	394: {Name: "Seal", ID: 394, SizeX: 5, SizeY: 5, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	395: {Name: "Seal", ID: 395, SizeX: 5, SizeY: 5, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	396: {Name: "Seal", ID: 396, SizeX: 5, SizeY: 5, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	397: {Name: "chest", ID: 397, SizeX: 2, SizeY: 2, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	398: {Name: "Waypoint", ID: 398, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: false},
-	399: {Name: "fissure", ID: 399, SizeX: 0, SizeY: 0, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	400: {Name: "Dummy", ID: 400, SizeX: 2, SizeY: 2, Left: 1, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	401: {Name: "Dummy", ID: 401, SizeX: 0, SizeY: 0, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: false},
-	402: {Name: "Waypoint", ID: 402, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: false},
-	403: {Name: "fire", ID: 403, SizeX: 2, SizeY: 2, Left: 1, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	404: {Name: "compellingorb", ID: 404, SizeX: 3, SizeY: 3, Left: 1, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	405: {Name: "chest", ID: 405, SizeX: 1, SizeY: 1, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	406: {Name: "chest", ID: 406, SizeX: 1, SizeY: 1, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	407: {Name: "chest", ID: 407, SizeX: 1, SizeY: 1, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	408: {Name: "Dummy", ID: 408, SizeX: 2, SizeY: 2, Left: 1, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	409: {Name: "Dummy", ID: 409, SizeX: 1, SizeY: 1, Left: 1, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	410: {Name: "Siege Control", ID: 410, SizeX: 1, SizeY: 1, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	411: {Name: "ptox", ID: 411, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	412: {Name: "pyox", ID: 412, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	413: {Name: "chestR", ID: 413, SizeX: 2, SizeY: 2, Left: 20, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	414: {Name: "Shrine3wilderness", ID: 414, SizeX: 2, SizeY: 2, Left: 3, Top: 206, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	415: {Name: "Shrine2wilderness", ID: 415, SizeX: 2, SizeY: 2, Left: 3, Top: 206, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	416: {Name: "hiddenstash", ID: 416, SizeX: 1, SizeY: 3, Left: 20, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	417: {Name: "flag wilderness", ID: 417, SizeX: 3, SizeY: 3, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	418: {Name: "barrel wilderness", ID: 418, SizeX: 1, SizeY: 1, Left: 20, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	419: {Name: "barrel wilderness", ID: 419, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	420: {Name: "woodchestL", ID: 420, SizeX: 1, SizeY: 3, Left: 20, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	421: {Name: "Shrine3wilderness", ID: 421, SizeX: 2, SizeY: 2, Left: 3, Top: 206, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	422: {Name: "manashrine", ID: 422, SizeX: 3, SizeY: 3, Left: 2, Top: 172, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	423: {Name: "healthshrine", ID: 423, SizeX: 3, SizeY: 3, Left: 1, Top: 282, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	424: {Name: "burialchestL", ID: 424, SizeX: 2, SizeY: 3, Left: 20, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	425: {Name: "burialchestR", ID: 425, SizeX: 3, SizeY: 2, Left: 20, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	426: {Name: "well", ID: 426, SizeX: 4, SizeY: 4, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	427: {Name: "Shrine2wilderness", ID: 427, SizeX: 3, SizeY: 3, Left: 3, Top: 206, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	428: {Name: "Shrine2wilderness", ID: 428, SizeX: 2, SizeY: 2, Left: 3, Top: 206, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	429: {Name: "Waypoint", ID: 429, SizeX: 5, SizeY: 5, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: false},
-	430: {Name: "ChestL", ID: 430, SizeX: 1, SizeY: 2, Left: 20, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	431: {Name: "woodchestR", ID: 431, SizeX: 2, SizeY: 1, Left: 20, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	432: {Name: "ChestSL", ID: 432, SizeX: 1, SizeY: 2, Left: 20, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	433: {Name: "ChestSR", ID: 433, SizeX: 2, SizeY: 1, Left: 20, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	434: {Name: "etorch1", ID: 434, SizeX: 2, SizeY: 2, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	435: {Name: "ecfra", ID: 435, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	436: {Name: "ettr", ID: 436, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	437: {Name: "etorch2", ID: 437, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	438: {Name: "burningbodies", ID: 438, SizeX: 2, SizeY: 2, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	439: {Name: "burningpit", ID: 439, SizeX: 3, SizeY: 4, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	440: {Name: "tribal flag", ID: 440, SizeX: 3, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	441: {Name: "eflg", ID: 441, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	442: {Name: "chan", ID: 442, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: -130, Xoffset: 0, HasCollision: false},
-	443: {Name: "jar1", ID: 443, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	444: {Name: "jar2", ID: 444, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	445: {Name: "jar3", ID: 445, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	446: {Name: "swingingheads", ID: 446, SizeX: 5, SizeY: 2, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	447: {Name: "pole", ID: 447, SizeX: 4, SizeY: 4, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	448: {Name: "animated skulland rockpile", ID: 448, SizeX: 5, SizeY: 3, Left: 20, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	449: {Name: "gate", ID: 449, SizeX: 1, SizeY: 7, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	450: {Name: "pileofskullsandrocks", ID: 450, SizeX: 5, SizeY: 5, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	451: {Name: "hellgate", ID: 451, SizeX: 3, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	452: {Name: "banner 1", ID: 452, SizeX: 1, SizeY: 1, Left: 1, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	453: {Name: "banner 2", ID: 453, SizeX: 1, SizeY: 1, Left: 1, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	454: {Name: "explodingchest", ID: 454, SizeX: 1, SizeY: 3, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	455: {Name: "chest", ID: 455, SizeX: 2, SizeY: 1, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	456: {Name: "deathpole", ID: 456, SizeX: 3, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	457: {Name: "Ldeathpole", ID: 457, SizeX: 3, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	458: {Name: "Altar", ID: 458, SizeX: 2, SizeY: 3, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	459: {Name: "dummy", ID: 459, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: false},
-	460: {Name: "dummy", ID: 460, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: false},
-	461: {Name: "dummy", ID: 461, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: false},
-	462: {Name: "dummy", ID: 462, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: false},
-	463: {Name: "hidden stash", ID: 463, SizeX: 1, SizeY: 1, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	464: {Name: "healthshrine", ID: 464, SizeX: 2, SizeY: 2, Left: 1, Top: 282, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	465: {Name: "manashrine", ID: 465, SizeX: 2, SizeY: 2, Left: 2, Top: 172, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	466: {Name: "evilurn", ID: 466, SizeX: 1, SizeY: 1, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	467: {Name: "icecavejar1", ID: 467, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	468: {Name: "icecavejar2", ID: 468, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	469: {Name: "icecavejar3", ID: 469, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	470: {Name: "icecavejar4", ID: 470, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	471: {Name: "icecavejar4", ID: 471, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	472: {Name: "icecaveshrine2", ID: 472, SizeX: 2, SizeY: 2, Left: 3, Top: 206, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	473: {Name: "cagedwussie1", ID: 473, SizeX: 3, SizeY: 3, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: false},
-	474: {Name: "Ancient Statue 3", ID: 474, SizeX: 3, SizeY: 3, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: -5, Xoffset: -5, HasCollision: true},
-	475: {Name: "Ancient Statue 1", ID: 475, SizeX: 3, SizeY: 3, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: -5, Xoffset: -5, HasCollision: true},
-	476: {Name: "Ancient Statue 2", ID: 476, SizeX: 3, SizeY: 3, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: -10, Xoffset: -8, HasCollision: true},
-	477: {Name: "deadbarbarian", ID: 477, SizeX: 2, SizeY: 3, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	478: {Name: "clientsmoke", ID: 478, SizeX: 0, SizeY: 0, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: false},
-	479: {Name: "icecaveshrine2", ID: 479, SizeX: 2, SizeY: 2, Left: 3, Top: 206, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	480: {Name: "icecave_torch1", ID: 480, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	481: {Name: "icecave_torch2", ID: 481, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	482: {Name: "ttor", ID: 482, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	483: {Name: "manashrine", ID: 483, SizeX: 2, SizeY: 2, Left: 2, Top: 172, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	484: {Name: "healthshrine", ID: 484, SizeX: 2, SizeY: 2, Left: 2, Top: 172, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	485: {Name: "tomb1", ID: 485, SizeX: 5, SizeY: 3, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	486: {Name: "tomb2", ID: 486, SizeX: 5, SizeY: 3, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	487: {Name: "tomb3", ID: 487, SizeX: 5, SizeY: 3, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	488: {Name: "magic shrine", ID: 488, SizeX: 2, SizeY: 2, Left: 3, Top: 206, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	489: {Name: "torch1", ID: 489, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	490: {Name: "torch2", ID: 490, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	491: {Name: "manashrine", ID: 491, SizeX: 3, SizeY: 3, Left: 2, Top: 172, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	492: {Name: "healthshrine", ID: 492, SizeX: 3, SizeY: 3, Left: 1, Top: 282, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	493: {Name: "well", ID: 493, SizeX: 4, SizeY: 4, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	494: {Name: "Waypoint", ID: 494, SizeX: 5, SizeY: 5, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: false},
-	495: {Name: "magic shrine", ID: 495, SizeX: 2, SizeY: 2, Left: 3, Top: 206, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	496: {Name: "Waypoint", ID: 496, SizeX: 5, SizeY: 5, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: false},
-	497: {Name: "magic shrine", ID: 497, SizeX: 3, SizeY: 3, Left: 3, Top: 206, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	498: {Name: "well", ID: 498, SizeX: 3, SizeY: 3, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	499: {Name: "magic shrine2", ID: 499, SizeX: 2, SizeY: 4, Left: 3, Top: 206, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	500: {Name: "object1", ID: 500, SizeX: 2, SizeY: 2, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	501: {Name: "woodchestL", ID: 501, SizeX: 1, SizeY: 3, Left: 20, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	502: {Name: "woodchestR", ID: 502, SizeX: 3, SizeY: 1, Left: 20, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	503: {Name: "magic shrine", ID: 503, SizeX: 3, SizeY: 3, Left: 3, Top: 206, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	504: {Name: "woodchest2L", ID: 504, SizeX: 2, SizeY: 3, Left: 20, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	505: {Name: "woodchest2R", ID: 505, SizeX: 3, SizeY: 2, Left: 20, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	506: {Name: "swingingheads", ID: 506, SizeX: 5, SizeY: 2, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	507: {Name: "debris", ID: 507, SizeX: 4, SizeY: 4, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	508: {Name: "pene", ID: 508, SizeX: 1, SizeY: 7, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	509: {Name: "magic shrine", ID: 509, SizeX: 4, SizeY: 3, Left: 3, Top: 206, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	510: {Name: "mrpole", ID: 510, SizeX: 5, SizeY: 5, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	511: {Name: "Waypoint", ID: 511, SizeX: 5, SizeY: 5, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: false},
-	512: {Name: "magic shrine", ID: 512, SizeX: 2, SizeY: 2, Left: 3, Top: 206, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	513: {Name: "well", ID: 513, SizeX: 4, SizeY: 4, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	514: {Name: "torch1", ID: 514, SizeX: 1, SizeY: 3, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	515: {Name: "torch1", ID: 515, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	516: {Name: "object1", ID: 516, SizeX: 1, SizeY: 1, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	517: {Name: "object2", ID: 517, SizeX: 2, SizeY: 2, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	518: {Name: "mrbox", ID: 518, SizeX: 3, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	519: {Name: "well", ID: 519, SizeX: 3, SizeY: 3, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	520: {Name: "magic shrine", ID: 520, SizeX: 2, SizeY: 2, Left: 3, Top: 206, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	521: {Name: "healthshrine", ID: 521, SizeX: 3, SizeY: 3, Left: 1, Top: 282, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	522: {Name: "manashrine", ID: 522, SizeX: 3, SizeY: 3, Left: 2, Top: 172, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	523: {Name: "red light", ID: 523, SizeX: 0, SizeY: 0, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	524: {Name: "tomb1L", ID: 524, SizeX: 3, SizeY: 5, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	525: {Name: "tomb2L", ID: 525, SizeX: 3, SizeY: 5, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	526: {Name: "tomb3L", ID: 526, SizeX: 3, SizeY: 5, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	527: {Name: "ubub", ID: 527, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: false},
-	528: {Name: "sbub", ID: 528, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: false},
-	529: {Name: "tomb1", ID: 529, SizeX: 5, SizeY: 3, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	530: {Name: "tomb1L", ID: 530, SizeX: 3, SizeY: 5, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	531: {Name: "tomb2", ID: 531, SizeX: 5, SizeY: 3, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	532: {Name: "tomb2L", ID: 532, SizeX: 3, SizeY: 5, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	533: {Name: "tomb3", ID: 533, SizeX: 5, SizeY: 3, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	534: {Name: "tomb3L", ID: 534, SizeX: 3, SizeY: 5, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	535: {Name: "mrbox", ID: 535, SizeX: 3, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	536: {Name: "torch1", ID: 536, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	537: {Name: "torch2", ID: 537, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	538: {Name: "candles", ID: 538, SizeX: 2, SizeY: 5, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	539: {Name: "Waypoint", ID: 539, SizeX: 5, SizeY: 5, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: false},
-	540: {Name: "deadperson", ID: 540, SizeX: 2, SizeY: 2, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	541: {Name: "groundtomb", ID: 541, SizeX: 5, SizeY: 3, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	542: {Name: "Dummy", ID: 542, SizeX: 0, SizeY: 0, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: false},
-	543: {Name: "Dummy", ID: 543, SizeX: 0, SizeY: 0, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: false},
-	544: {Name: "groundtombL", ID: 544, SizeX: 3, SizeY: 5, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	545: {Name: "deadperson2", ID: 545, SizeX: 2, SizeY: 2, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	546: {Name: "ancientsaltar", ID: 546, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	547: {Name: "To The Worldstone Keep Level 1", ID: 547, SizeX: 5, SizeY: 8, Left: -30, Top: -100, Width: 70, Height: 100, Yoffset: -47, Xoffset: 17, HasCollision: true},
-	548: {Name: "eweaponrackR", ID: 548, SizeX: 2, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	549: {Name: "eweaponrackL", ID: 549, SizeX: 1, SizeY: 2, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	550: {Name: "earmorstandR", ID: 550, SizeX: 2, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	551: {Name: "earmorstandL", ID: 551, SizeX: 1, SizeY: 2, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	552: {Name: "torch2", ID: 552, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	553: {Name: "funeralpire", ID: 553, SizeX: 3, SizeY: 4, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	554: {Name: "burninglogs", ID: 554, SizeX: 3, SizeY: 4, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	555: {Name: "stma", ID: 555, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	556: {Name: "deadperson2", ID: 556, SizeX: 2, SizeY: 2, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	557: {Name: "Dummy", ID: 557, SizeX: 0, SizeY: 0, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: false},
-	558: {Name: "fana", ID: 558, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	559: {Name: "BBQB", ID: 559, SizeX: 3, SizeY: 3, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	560: {Name: "btor", ID: 560, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	561: {Name: "Dummy", ID: 561, SizeX: 0, SizeY: 0, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: false},
-	562: {Name: "Dummy", ID: 562, SizeX: 5, SizeY: 5, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	563: {Name: "The Worldstone Chamber", ID: 563, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	564: {Name: "Glacial Caves Level 1", ID: 564, SizeX: 0, SizeY: 0, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: -33, HasCollision: false},
-	565: {Name: "strlastcinematic", ID: 565, SizeX: 0, SizeY: 0, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: false},
-	566: {Name: "Harrogath", ID: 566, SizeX: 0, SizeY: 0, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	567: {Name: "Zoo", ID: 567, SizeX: 0, SizeY: 0, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	568: {Name: "Keeper", ID: 568, SizeX: 2, SizeY: 2, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	569: {Name: "Throne of Destruction", ID: 569, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: false},
-	570: {Name: "Dummy", ID: 570, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	571: {Name: "Dummy", ID: 571, SizeX: 5, SizeY: 2, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	572: {Name: "Dummy", ID: 572, SizeX: 2, SizeY: 5, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	573: {Name: "ControlObject", ID: 573, SizeX: 0, SizeY: 0, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	574: {Name: "DesertShrineArmor", ID: 574, SizeX: 0, SizeY: 0, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	575: {Name: "DesertShrineCombat", ID: 575, SizeX: 0, SizeY: 0, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	576: {Name: "DesertShrineResist", ID: 576, SizeX: 0, SizeY: 0, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	577: {Name: "DesertShrineSkill", ID: 577, SizeX: 0, SizeY: 0, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	578: {Name: "DesertShrineRecharge", ID: 578, SizeX: 0, SizeY: 0, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	579: {Name: "DesertShrineStamina", ID: 579, SizeX: 0, SizeY: 0, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	580: {Name: "PlaceUniqueChest", ID: 580, SizeX: 0, SizeY: 0, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	581: {Name: "PlaceRandomTreasureChest", ID: 581, SizeX: 0, SizeY: 0, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
-	582: {Name: "PlaceArcaneThingamajig", ID: 582, SizeX: 0, SizeY: 0, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true},
+	0:   {Name: "Dummy", ID: 0, SizeX: 0, SizeY: 0, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 0, SubClass: 0},
+	1:   {Name: "Casket", ID: 1, SizeX: 5, SizeY: 3, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 1, SubClass: 8},
+	2:   {Name: "Shrine", ID: 2, SizeX: 3, SizeY: 3, Left: 3, Top: 84, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 2, SubClass: 1},
+	3:   {Name: "Casket", ID: 3, SizeX: 5, SizeY: 3, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 1, SubClass: 8},
+	4:   {Name: "LargeUrn", ID: 4, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 3, SubClass: 8},
+	5:   {Name: "chest", ID: 5, SizeX: 1, SizeY: 2, Left: 15, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 4, SubClass: 8},
+	6:   {Name: "chest", ID: 6, SizeX: 2, SizeY: 1, Left: 15, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 4, SubClass: 8},
+	7:   {Name: "Barrel", ID: 7, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 5, SubClass: 0},
+	8:   {Name: "TowerTome", ID: 8, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 6, SubClass: 0},
+	9:   {Name: "Urn", ID: 9, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 3, SubClass: 0},
+	10:  {Name: "Dummy", ID: 10, SizeX: 7, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 0, SubClass: 0},
+	11:  {Name: "Barrel", ID: 11, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 7, SubClass: 0},
+	12:  {Name: "Dummy", ID: 12, SizeX: 3, SizeY: 3, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: false, OperateFn: 0, SubClass: 0},
+	13:  {Name: "Door", ID: 13, SizeX: 1, SizeY: 3, Left: -20, Top: -80, Width: 40, Height: 40, Yoffset: 4, Xoffset: 1, HasCollision: true, OperateFn: 8, SubClass: 0},
+	14:  {Name: "Door", ID: 14, SizeX: 3, SizeY: 1, Left: -20, Top: -80, Width: 40, Height: 40, Yoffset: 4, Xoffset: 1, HasCollision: true, OperateFn: 8, SubClass: 0},
+	15:  {Name: "Door", ID: 15, SizeX: 1, SizeY: 3, Left: -20, Top: -80, Width: 40, Height: 40, Yoffset: 4, Xoffset: 0, HasCollision: true, OperateFn: 8, SubClass: 0},
+	16:  {Name: "Door", ID: 16, SizeX: 3, SizeY: 1, Left: -20, Top: -80, Width: 40, Height: 40, Yoffset: 3, Xoffset: 1, HasCollision: true, OperateFn: 8, SubClass: 0},
+	17:  {Name: "StoneAlpha", ID: 17, SizeX: 3, SizeY: 3, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 9, SubClass: 0},
+	18:  {Name: "StoneBeta", ID: 18, SizeX: 3, SizeY: 3, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 9, SubClass: 0},
+	19:  {Name: "StoneGamma", ID: 19, SizeX: 3, SizeY: 3, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 9, SubClass: 0},
+	20:  {Name: "StoneDelta", ID: 20, SizeX: 3, SizeY: 3, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 9, SubClass: 0},
+	21:  {Name: "StoneLambda", ID: 21, SizeX: 3, SizeY: 3, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 9, SubClass: 0},
+	22:  {Name: "StoneTheta", ID: 22, SizeX: 3, SizeY: 3, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 0, SubClass: 0},
+	23:  {Name: "Door", ID: 23, SizeX: 1, SizeY: 7, Left: -20, Top: -80, Width: 40, Height: 40, Yoffset: 1, Xoffset: 1, HasCollision: true, OperateFn: 8, SubClass: 0},
+	24:  {Name: "Door", ID: 24, SizeX: 7, SizeY: 1, Left: -20, Top: -80, Width: 40, Height: 40, Yoffset: 1, Xoffset: 1, HasCollision: true, OperateFn: 8, SubClass: 0},
+	25:  {Name: "Door", ID: 25, SizeX: 9, SizeY: 1, Left: -20, Top: -80, Width: 40, Height: 40, Yoffset: -11, Xoffset: 0, HasCollision: true, OperateFn: 8, SubClass: 0},
+	26:  {Name: "Gibbet", ID: 26, SizeX: 3, SizeY: 3, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 15, Xoffset: -14, HasCollision: true, OperateFn: 10, SubClass: 0},
+	27:  {Name: "Door", ID: 27, SizeX: 7, SizeY: 1, Left: -20, Top: -80, Width: 40, Height: 40, Yoffset: 1, Xoffset: 1, HasCollision: true, OperateFn: 8, SubClass: 0},
+	28:  {Name: "HoleAnim", ID: 28, SizeX: 5, SizeY: 3, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 1, Xoffset: 1, HasCollision: true, OperateFn: 1, SubClass: 0},
+	29:  {Name: "Dummy", ID: 29, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 11, SubClass: 0},
+	30:  {Name: "Inifuss", ID: 30, SizeX: 5, SizeY: 5, Left: -40, Top: -40, Width: 80, Height: 80, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 12, SubClass: 0},
+	31:  {Name: "Dummy", ID: 31, SizeX: 3, SizeY: 3, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 0, SubClass: 0},
+	32:  {Name: "Dummy", ID: 32, SizeX: 3, SizeY: 3, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 0, SubClass: 0},
+	33:  {Name: "Dummy", ID: 33, SizeX: 3, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 0, SubClass: 0},
+	34:  {Name: "Dummy", ID: 34, SizeX: 1, SizeY: 3, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 0, SubClass: 0},
+	35:  {Name: "Dummy", ID: 35, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 0, SubClass: 0},
+	36:  {Name: "Dummy", ID: 36, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 0, SubClass: 0},
+	37:  {Name: "Dummy", ID: 37, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 13, SubClass: 0},
+	38:  {Name: "Dummy", ID: 38, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 11, SubClass: 0},
+	39:  {Name: "fire", ID: 39, SizeX: 3, SizeY: 3, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: false, OperateFn: 0, SubClass: 0},
+	40:  {Name: "Dummy", ID: 40, SizeX: 0, SizeY: 0, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: -9, Xoffset: 0, HasCollision: false, OperateFn: 0, SubClass: 0},
+	41:  {Name: "Dummy", ID: 41, SizeX: 0, SizeY: 0, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: -9, Xoffset: 0, HasCollision: false, OperateFn: 0, SubClass: 0},
+	42:  {Name: "Dummy", ID: 42, SizeX: 0, SizeY: 0, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: -9, Xoffset: 0, HasCollision: false, OperateFn: 0, SubClass: 0},
+	43:  {Name: "Dummy", ID: 43, SizeX: 0, SizeY: 0, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: -9, Xoffset: 0, HasCollision: false, OperateFn: 0, SubClass: 0},
+	44:  {Name: "Dummy", ID: 44, SizeX: 0, SizeY: 0, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: -9, Xoffset: 0, HasCollision: false, OperateFn: 0, SubClass: 0},
+	45:  {Name: "AmbientSound", ID: 45, SizeX: 0, SizeY: 0, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: false, OperateFn: 0, SubClass: 0},
+	46:  {Name: "Crate", ID: 46, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 14, SubClass: 0},
+	47:  {Name: "Door", ID: 47, SizeX: 7, SizeY: 1, Left: -20, Top: -80, Width: 40, Height: 40, Yoffset: 1, Xoffset: 1, HasCollision: true, OperateFn: 8, SubClass: 0},
+	48:  {Name: "Dummy", ID: 48, SizeX: 2, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 0, SubClass: 0},
+	49:  {Name: "Dummy", ID: 49, SizeX: 1, SizeY: 2, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 0, SubClass: 0},
+	50:  {Name: "Casket", ID: 50, SizeX: 5, SizeY: 3, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 9, Xoffset: 0, HasCollision: true, OperateFn: 1, SubClass: 8},
+	51:  {Name: "Casket", ID: 51, SizeX: 3, SizeY: 5, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 1, SubClass: 8},
+	52:  {Name: "Urn", ID: 52, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 3, SubClass: 8},
+	53:  {Name: "Casket", ID: 53, SizeX: 5, SizeY: 3, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 4, Xoffset: 0, HasCollision: true, OperateFn: 1, SubClass: 8},
+	54:  {Name: "RogueCorpse", ID: 54, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 14, SubClass: 0},
+	55:  {Name: "RogueCorpse", ID: 55, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 14, SubClass: 0},
+	56:  {Name: "RogueCorpse", ID: 56, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 14, SubClass: 0},
+	57:  {Name: "CorpseOnStick", ID: 57, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 14, SubClass: 0},
+	58:  {Name: "CorpseOnStick", ID: 58, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 14, SubClass: 0},
+	59:  {Name: "Portal", ID: 59, SizeX: 4, SizeY: 4, Left: -40, Top: -100, Width: 80, Height: 110, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 15, SubClass: 4},
+	60:  {Name: "Portal", ID: 60, SizeX: 1, SizeY: 1, Left: -40, Top: -100, Width: 80, Height: 110, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 15, SubClass: 4},
+	61:  {Name: "Dummy", ID: 61, SizeX: 5, SizeY: 5, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: false, OperateFn: 0, SubClass: 0},
+	62:  {Name: "Door", ID: 62, SizeX: 1, SizeY: 4, Left: -20, Top: -80, Width: 40, Height: 40, Yoffset: 1, Xoffset: 1, HasCollision: true, OperateFn: 8, SubClass: 0},
+	63:  {Name: "Door", ID: 63, SizeX: 4, SizeY: 1, Left: -20, Top: -80, Width: 40, Height: 40, Yoffset: 1, Xoffset: 1, HasCollision: true, OperateFn: 8, SubClass: 0},
+	64:  {Name: "Door", ID: 64, SizeX: 1, SizeY: 4, Left: -20, Top: -80, Width: 40, Height: 40, Yoffset: 4, Xoffset: -1, HasCollision: true, OperateFn: 8, SubClass: 0},
+	65:  {Name: "Dummy", ID: 65, SizeX: 0, SizeY: 32, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: false, OperateFn: 0, SubClass: 0},
+	66:  {Name: "Dummy", ID: 66, SizeX: 32, SizeY: 0, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: false, OperateFn: 0, SubClass: 0},
+	67:  {Name: "Dummy", ID: 67, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: false, OperateFn: 0, SubClass: 0},
+	68:  {Name: "Dummy", ID: 68, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: false, OperateFn: 0, SubClass: 0},
+	69:  {Name: "Dummy", ID: 69, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: false, OperateFn: 0, SubClass: 0},
+	70:  {Name: "Dummy", ID: 70, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: false, OperateFn: 0, SubClass: 0},
+	71:  {Name: "Dummy", ID: 71, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: false, OperateFn: 0, SubClass: 0},
+	72:  {Name: "Dummy", ID: 72, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: false, OperateFn: 0, SubClass: 0},
+	73:  {Name: "Dummy", ID: 73, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 0, SubClass: 0},
+	74:  {Name: "TrappDoor", ID: 74, SizeX: 3, SizeY: 3, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 16, SubClass: 0},
+	75:  {Name: "Door", ID: 75, SizeX: 1, SizeY: 3, Left: -20, Top: -80, Width: 40, Height: 40, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 8, SubClass: 0},
+	76:  {Name: "Dummy", ID: 76, SizeX: 5, SizeY: 5, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: false, OperateFn: 0, SubClass: 0},
+	77:  {Name: "Shrine", ID: 77, SizeX: 3, SizeY: 3, Left: 1, Top: 84, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 2, SubClass: 1},
+	78:  {Name: "Dummy", ID: 78, SizeX: 0, SizeY: 0, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: false, OperateFn: 0, SubClass: 0},
+	79:  {Name: "Casket", ID: 79, SizeX: 4, SizeY: 6, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: -4, Xoffset: 0, HasCollision: true, OperateFn: 1, SubClass: 0},
+	80:  {Name: "Obelisk", ID: 80, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 17, SubClass: 2},
+	81:  {Name: "Shrine", ID: 81, SizeX: 3, SizeY: 3, Left: 3, Top: 84, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 2, SubClass: 1},
+	82:  {Name: "Dummy", ID: 82, SizeX: 3, SizeY: 3, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 0, SubClass: 0},
+	83:  {Name: "Shrine", ID: 83, SizeX: 3, SizeY: 3, Left: 3, Top: 84, Width: 0, Height: 0, Yoffset: 0, Xoffset: -20, HasCollision: true, OperateFn: 2, SubClass: 1},
+	84:  {Name: "Shrine", ID: 84, SizeX: 3, SizeY: 3, Left: 1, Top: 84, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 2, SubClass: 1},
+	85:  {Name: "Shrine", ID: 85, SizeX: 3, SizeY: 3, Left: 1, Top: 85, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 2, SubClass: 1},
+	86:  {Name: "Dummy", ID: 86, SizeX: 3, SizeY: 3, Left: 3, Top: 85, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 2, SubClass: 1},
+	87:  {Name: "Chest3", ID: 87, SizeX: 1, SizeY: 3, Left: 20, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 4, SubClass: 8},
+	88:  {Name: "Chest3", ID: 88, SizeX: 3, SizeY: 1, Left: 20, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 4, SubClass: 8},
+	89:  {Name: "Sarcophagus", ID: 89, SizeX: 3, SizeY: 5, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 1, SubClass: 0},
+	90:  {Name: "Obelisk", ID: 90, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 17, SubClass: 2},
+	91:  {Name: "Door", ID: 91, SizeX: 1, SizeY: 7, Left: -20, Top: -80, Width: 40, Height: 40, Yoffset: -8, Xoffset: -11, HasCollision: true, OperateFn: 8, SubClass: 0},
+	92:  {Name: "Door", ID: 92, SizeX: 7, SizeY: 1, Left: -20, Top: -80, Width: 40, Height: 40, Yoffset: 2, Xoffset: 18, HasCollision: true, OperateFn: 8, SubClass: 0},
+	93:  {Name: "Shrine", ID: 93, SizeX: 3, SizeY: 3, Left: 2, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 2, SubClass: 1},
+	94:  {Name: "LargeUrn", ID: 94, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 3, SubClass: 8},
+	95:  {Name: "LargeUrn", ID: 95, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 3, SubClass: 8},
+	96:  {Name: "Shrine", ID: 96, SizeX: 3, SizeY: 3, Left: 1, Top: 96, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 2, SubClass: 1},
+	97:  {Name: "Shrine", ID: 97, SizeX: 4, SizeY: 4, Left: 3, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 2, SubClass: 1},
+	98:  {Name: "Door", ID: 98, SizeX: 1, SizeY: 7, Left: -20, Top: -80, Width: 40, Height: 40, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 8, SubClass: 0},
+	99:  {Name: "Door", ID: 99, SizeX: 7, SizeY: 1, Left: -20, Top: -80, Width: 40, Height: 40, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 8, SubClass: 0},
+	100: {Name: "Duriel's Lair", ID: 100, SizeX: 1, SizeY: 3, Left: -40, Top: -80, Width: 80, Height: 80, Yoffset: -38, Xoffset: -14, HasCollision: true, OperateFn: 43, SubClass: 0},
+	101: {Name: "Dummy", ID: 101, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 11, SubClass: 0},
+	102: {Name: "Dummy", ID: 102, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 11, SubClass: 0},
+	103: {Name: "Dummy", ID: 103, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: false, OperateFn: 0, SubClass: 0},
+	104: {Name: "ArmorStand", ID: 104, SizeX: 2, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 19, SubClass: 0},
+	105: {Name: "ArmorStand", ID: 105, SizeX: 1, SizeY: 2, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 19, SubClass: 0},
+	106: {Name: "WeaponRack", ID: 106, SizeX: 2, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 20, SubClass: 0},
+	107: {Name: "WeaponRack", ID: 107, SizeX: 1, SizeY: 2, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 20, SubClass: 0},
+	108: {Name: "Malus", ID: 108, SizeX: 2, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 21, SubClass: 0},
+	109: {Name: "Shrine", ID: 109, SizeX: 3, SizeY: 3, Left: 1, Top: 109, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 2, SubClass: 1},
+	110: {Name: "not used", ID: 110, SizeX: 0, SizeY: 0, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: -10, Xoffset: -35, HasCollision: false, OperateFn: 0, SubClass: 0},
+	111: {Name: "well", ID: 111, SizeX: 3, SizeY: 3, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 22, SubClass: 0},
+	112: {Name: "not used", ID: 112, SizeX: 0, SizeY: 0, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 10, Xoffset: 0, HasCollision: false, OperateFn: 0, SubClass: 0},
+	113: {Name: "well", ID: 113, SizeX: 3, SizeY: 3, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 22, SubClass: 0},
+	114: {Name: "not used", ID: 114, SizeX: 0, SizeY: 0, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: -5, Xoffset: -5, HasCollision: false, OperateFn: 0, SubClass: 0},
+	115: {Name: "well", ID: 115, SizeX: 3, SizeY: 3, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 22, SubClass: 0},
+	116: {Name: "Shrine", ID: 116, SizeX: 3, SizeY: 3, Left: 3, Top: 109, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 2, SubClass: 1},
+	117: {Name: "Dummy", ID: 117, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 11, SubClass: 0},
+	118: {Name: "Well", ID: 118, SizeX: 3, SizeY: 3, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 22, SubClass: 0},
+	119: {Name: "Waypoint", ID: 119, SizeX: 5, SizeY: 5, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: false, OperateFn: 23, SubClass: 64},
+	120: {Name: "Dummy", ID: 120, SizeX: 3, SizeY: 3, Left: 1, Top: 120, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 2, SubClass: 1},
+	121: {Name: "jerhyn", ID: 121, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: false, OperateFn: 0, SubClass: 0},
+	122: {Name: "jerhyn", ID: 122, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: false, OperateFn: 0, SubClass: 0},
+	123: {Name: "Shrine", ID: 123, SizeX: 3, SizeY: 3, Left: 3, Top: 96, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 2, SubClass: 1},
+	124: {Name: "Shrine", ID: 124, SizeX: 4, SizeY: 3, Left: 3, Top: 96, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 2, SubClass: 1},
+	125: {Name: "hidden stash", ID: 125, SizeX: 1, SizeY: 1, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 14, SubClass: 0},
+	126: {Name: "skull pile", ID: 126, SizeX: 1, SizeY: 1, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 14, SubClass: 0},
+	127: {Name: "hidden stash", ID: 127, SizeX: 4, SizeY: 4, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 14, SubClass: 0},
+	128: {Name: "hidden stash", ID: 128, SizeX: 1, SizeY: 1, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 14, SubClass: 0},
+	129: {Name: "Door", ID: 129, SizeX: 1, SizeY: 5, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 18, SubClass: 128},
+	130: {Name: "Well", ID: 130, SizeX: 3, SizeY: 3, Left: 750, Top: 128, Width: 1, Height: 3, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 22, SubClass: 32},
+	131: {Name: "Dummy", ID: 131, SizeX: 0, SizeY: 0, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 0, SubClass: 0},
+	132: {Name: "Well", ID: 132, SizeX: 3, SizeY: 3, Left: 750, Top: 128, Width: 1, Height: 3, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 22, SubClass: 32},
+	133: {Name: "shrine", ID: 133, SizeX: 3, SizeY: 3, Left: 3, Top: 85, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 2, SubClass: 1},
+	134: {Name: "shrine", ID: 134, SizeX: 3, SizeY: 4, Left: 3, Top: 85, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 2, SubClass: 1},
+	135: {Name: "shrine", ID: 135, SizeX: 2, SizeY: 2, Left: 3, Top: 85, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 2, SubClass: 1},
+	136: {Name: "shrine", ID: 136, SizeX: 6, SizeY: 3, Left: 3, Top: 85, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 2, SubClass: 1},
+	137: {Name: "Well", ID: 137, SizeX: 3, SizeY: 3, Left: 750, Top: 128, Width: 1, Height: 3, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 22, SubClass: 32},
+	138: {Name: "Well", ID: 138, SizeX: 4, SizeY: 4, Left: 750, Top: 128, Width: 1, Height: 3, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 22, SubClass: 32},
+	139: {Name: "chest", ID: 139, SizeX: 3, SizeY: 2, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 4, SubClass: 8},
+	140: {Name: "chest", ID: 140, SizeX: 1, SizeY: 1, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 4, SubClass: 8},
+	141: {Name: "chest", ID: 141, SizeX: 3, SizeY: 2, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 4, SubClass: 8},
+	142: {Name: "jug", ID: 142, SizeX: 1, SizeY: 1, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 14, SubClass: 0},
+	143: {Name: "jug", ID: 143, SizeX: 1, SizeY: 1, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 14, SubClass: 0},
+	144: {Name: "chest", ID: 144, SizeX: 1, SizeY: 1, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 4, SubClass: 8},
+	145: {Name: "Waypoint", ID: 145, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: false, OperateFn: 23, SubClass: 64},
+	146: {Name: "chest", ID: 146, SizeX: 3, SizeY: 1, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 4, SubClass: 8},
+	147: {Name: "chest", ID: 147, SizeX: 3, SizeY: 1, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 4, SubClass: 8},
+	148: {Name: "chest", ID: 148, SizeX: 2, SizeY: 2, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 4, SubClass: 0},
+	149: {Name: "taintedsunaltar", ID: 149, SizeX: 4, SizeY: 2, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 24, SubClass: 0},
+	150: {Name: "shrine", ID: 150, SizeX: 5, SizeY: 5, Left: 3, Top: 85, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 2, SubClass: 1},
+	151: {Name: "shrine", ID: 151, SizeX: 5, SizeY: 4, Left: 3, Top: 85, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 2, SubClass: 1},
+	152: {Name: "orifice", ID: 152, SizeX: 3, SizeY: 3, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 25, SubClass: 0},
+	153: {Name: "Door", ID: 153, SizeX: 1, SizeY: 5, Left: -20, Top: -80, Width: 40, Height: 40, Yoffset: -18, Xoffset: -8, HasCollision: true, OperateFn: 0, SubClass: 0},
+	154: {Name: "corpse", ID: 154, SizeX: 3, SizeY: 3, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 14, SubClass: 0},
+	155: {Name: "hidden stash", ID: 155, SizeX: 1, SizeY: 1, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 14, SubClass: 0},
+	156: {Name: "Waypoint", ID: 156, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: false, OperateFn: 23, SubClass: 64},
+	157: {Name: "Waypoint", ID: 157, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: false, OperateFn: 23, SubClass: 64},
+	158: {Name: "skeleton", ID: 158, SizeX: 3, SizeY: 3, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 14, SubClass: 8},
+	159: {Name: "hidden stash", ID: 159, SizeX: 1, SizeY: 1, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 14, SubClass: 0},
+	160: {Name: "fire", ID: 160, SizeX: 0, SizeY: 0, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: false, OperateFn: 11, SubClass: 0},
+	161: {Name: "fire", ID: 161, SizeX: 0, SizeY: 0, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: false, OperateFn: 11, SubClass: 0},
+	162: {Name: "fire", ID: 162, SizeX: 0, SizeY: 0, Left: 1, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: false, OperateFn: 11, SubClass: 0},
+	163: {Name: "hiding spot", ID: 163, SizeX: 1, SizeY: 1, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 14, SubClass: 0},
+	164: {Name: "Shrine", ID: 164, SizeX: 3, SizeY: 3, Left: 2, Top: 85, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 2, SubClass: 1},
+	165: {Name: "Shrine", ID: 165, SizeX: 3, SizeY: 3, Left: 2, Top: 77, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 2, SubClass: 1},
+	166: {Name: "Shrine", ID: 166, SizeX: 3, SizeY: 3, Left: 2, Top: 85, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 2, SubClass: 1},
+	167: {Name: "Shrine", ID: 167, SizeX: 3, SizeY: 3, Left: 2, Top: 85, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 2, SubClass: 1},
+	168: {Name: "Shrine", ID: 168, SizeX: 3, SizeY: 3, Left: 2, Top: 85, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 2, SubClass: 1},
+	169: {Name: "hollow log", ID: 169, SizeX: 5, SizeY: 1, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 14, SubClass: 0},
+	170: {Name: "Shrine", ID: 170, SizeX: 3, SizeY: 3, Left: 1, Top: 170, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 2, SubClass: 1},
+	171: {Name: "skeleton", ID: 171, SizeX: 3, SizeY: 3, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 14, SubClass: 8},
+	172: {Name: "Shrine", ID: 172, SizeX: 2, SizeY: 2, Left: 1, Top: 172, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 2, SubClass: 1},
+	173: {Name: "Shrine", ID: 173, SizeX: 2, SizeY: 2, Left: 2, Top: 172, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 2, SubClass: 1},
+	174: {Name: "loose rock", ID: 174, SizeX: 1, SizeY: 1, Left: -18, Top: -20, Width: 36, Height: 30, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 14, SubClass: 0},
+	175: {Name: "loose boulder", ID: 175, SizeX: 1, SizeY: 1, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 14, SubClass: 0},
+	176: {Name: "chest", ID: 176, SizeX: 1, SizeY: 1, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 4, SubClass: 8},
+	177: {Name: "chest", ID: 177, SizeX: 1, SizeY: 1, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 4, SubClass: 8},
+	178: {Name: "GuardCorpse", ID: 178, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 14, SubClass: 8},
+	179: {Name: "bookshelf", ID: 179, SizeX: 1, SizeY: 1, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 26, SubClass: 8},
+	180: {Name: "bookshelf", ID: 180, SizeX: 1, SizeY: 1, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 26, SubClass: 8},
+	181: {Name: "chest", ID: 181, SizeX: 3, SizeY: 1, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 4, SubClass: 8},
+	182: {Name: "coffin", ID: 182, SizeX: 1, SizeY: 1, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 14, SubClass: 8},
+	183: {Name: "chest", ID: 183, SizeX: 1, SizeY: 1, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 4, SubClass: 8},
+	184: {Name: "Shrine", ID: 184, SizeX: 3, SizeY: 3, Left: 3, Top: 184, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 2, SubClass: 1},
+	185: {Name: "stash", ID: 185, SizeX: 2, SizeY: 2, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 51, SubClass: 8},
+	186: {Name: "stash", ID: 186, SizeX: 1, SizeY: 1, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 51, SubClass: 0},
+	187: {Name: "stash", ID: 187, SizeX: 2, SizeY: 2, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 51, SubClass: 0},
+	188: {Name: "stash", ID: 188, SizeX: 1, SizeY: 1, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 51, SubClass: 0},
+	189: {Name: "Dummy", ID: 189, SizeX: 0, SizeY: 0, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 0, SubClass: 0},
+	190: {Name: "Shrine", ID: 190, SizeX: 3, SizeY: 4, Left: 3, Top: 184, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 2, SubClass: 1},
+	191: {Name: "Shrine", ID: 191, SizeX: 3, SizeY: 3, Left: 3, Top: 184, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 2, SubClass: 1},
+	192: {Name: "teleport pad", ID: 192, SizeX: 1, SizeY: 1, Left: -30, Top: -80, Width: 60, Height: 80, Yoffset: 0, Xoffset: 0, HasCollision: false, OperateFn: 27, SubClass: 0},
+	193: {Name: "LamTome", ID: 193, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 28, SubClass: 0},
+	194: {Name: "stair", ID: 194, SizeX: 6, SizeY: 10, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 47, SubClass: 0},
+	195: {Name: "stair", ID: 195, SizeX: 10, SizeY: 6, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 47, SubClass: 0},
+	196: {Name: "a trap", ID: 196, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: -18, Xoffset: 2, HasCollision: false, OperateFn: 11, SubClass: 0},
+	197: {Name: "Shrine", ID: 197, SizeX: 3, SizeY: 3, Left: 3, Top: 184, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 2, SubClass: 1},
+	198: {Name: "chest", ID: 198, SizeX: 1, SizeY: 1, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 4, SubClass: 8},
+	199: {Name: "Shrine", ID: 199, SizeX: 3, SizeY: 4, Left: 3, Top: 206, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 2, SubClass: 1},
+	200: {Name: "Shrine", ID: 200, SizeX: 5, SizeY: 3, Left: 3, Top: 206, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 2, SubClass: 1},
+	201: {Name: "Shrine", ID: 201, SizeX: 4, SizeY: 4, Left: 3, Top: 206, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 2, SubClass: 1},
+	202: {Name: "Shrine", ID: 202, SizeX: 3, SizeY: 4, Left: 2, Top: 206, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 2, SubClass: 1},
+	203: {Name: "stash", ID: 203, SizeX: 1, SizeY: 1, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 14, SubClass: 0},
+	204: {Name: "stash", ID: 204, SizeX: 1, SizeY: 3, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 14, SubClass: 0},
+	205: {Name: "stash", ID: 205, SizeX: 2, SizeY: 2, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 14, SubClass: 0},
+	206: {Name: "Shrine", ID: 206, SizeX: 3, SizeY: 4, Left: 1, Top: 206, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 2, SubClass: 1},
+	207: {Name: "dummy", ID: 207, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 11, SubClass: 0},
+	208: {Name: "Basket", ID: 208, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 3, SubClass: 8},
+	209: {Name: "Basket", ID: 209, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 3, SubClass: 8},
+	210: {Name: "Dummy", ID: 210, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 11, SubClass: 0},
+	211: {Name: "Dummy", ID: 211, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 11, SubClass: 0},
+	212: {Name: "Dummy", ID: 212, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 11, SubClass: 0},
+	213: {Name: "Dummy", ID: 213, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 11, SubClass: 0},
+	214: {Name: "Dummy", ID: 214, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 11, SubClass: 0},
+	215: {Name: "Dummy", ID: 215, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 11, SubClass: 0},
+	216: {Name: "Dummy", ID: 216, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 11, SubClass: 0},
+	217: {Name: "Dummy", ID: 217, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 11, SubClass: 0},
+	218: {Name: "Dummy", ID: 218, SizeX: 3, SizeY: 9, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 0, SubClass: 0},
+	219: {Name: "Dummy", ID: 219, SizeX: 9, SizeY: 3, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 0, SubClass: 0},
+	220: {Name: "Dummy", ID: 220, SizeX: 3, SizeY: 3, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 0, SubClass: 0},
+	221: {Name: "Dummy", ID: 221, SizeX: 3, SizeY: 3, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 0, SubClass: 0},
+	222: {Name: "pillar", ID: 222, SizeX: 2, SizeY: 2, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 14, SubClass: 8},
+	223: {Name: "cocoon", ID: 223, SizeX: 1, SizeY: 1, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 14, SubClass: 8},
+	224: {Name: "cocoon", ID: 224, SizeX: 1, SizeY: 1, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 14, SubClass: 8},
+	225: {Name: "skullpile", ID: 225, SizeX: 1, SizeY: 1, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 14, SubClass: 0},
+	226: {Name: "Shrine", ID: 226, SizeX: 3, SizeY: 4, Left: 3, Top: 96, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 2, SubClass: 1},
+	227: {Name: "dummy", ID: 227, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 0, SubClass: 0},
+	228: {Name: "dummy", ID: 228, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 0, SubClass: 0},
+	229: {Name: "door", ID: 229, SizeX: 7, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 5, Xoffset: 20, HasCollision: true, OperateFn: 29, SubClass: 0},
+	230: {Name: "door", ID: 230, SizeX: 1, SizeY: 7, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 3, Xoffset: -23, HasCollision: true, OperateFn: 29, SubClass: 0},
+	231: {Name: "Shrine", ID: 231, SizeX: 3, SizeY: 4, Left: 3, Top: 96, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 2, SubClass: 1},
+	232: {Name: "Shrine", ID: 232, SizeX: 4, SizeY: 4, Left: 3, Top: 96, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 2, SubClass: 1},
+	233: {Name: "pillar", ID: 233, SizeX: 3, SizeY: 3, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 14, SubClass: 8},
+	234: {Name: "dummy", ID: 234, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 0, SubClass: 0},
+	235: {Name: "dummy", ID: 235, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 0, SubClass: 0},
+	236: {Name: "Shrine", ID: 236, SizeX: 3, SizeY: 3, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 2, SubClass: 1},
+	237: {Name: "Waypoint", ID: 237, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: false, OperateFn: 23, SubClass: 64},
+	238: {Name: "Waypoint", ID: 238, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: false, OperateFn: 23, SubClass: 64},
+	239: {Name: "body", ID: 239, SizeX: 3, SizeY: 3, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 14, SubClass: 8},
+	240: {Name: "chest", ID: 240, SizeX: 1, SizeY: 3, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 4, SubClass: 8},
+	241: {Name: "chest", ID: 241, SizeX: 1, SizeY: 1, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 4, SubClass: 8},
+	242: {Name: "chest", ID: 242, SizeX: 1, SizeY: 1, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 4, SubClass: 8},
+	243: {Name: "chest", ID: 243, SizeX: 1, SizeY: 1, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 4, SubClass: 8},
+	244: {Name: "ratnest", ID: 244, SizeX: 1, SizeY: 1, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 14, SubClass: 8},
+	245: {Name: "body", ID: 245, SizeX: 1, SizeY: 1, Left: 1, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: false, OperateFn: 0, SubClass: 8},
+	246: {Name: "ratnest", ID: 246, SizeX: 1, SizeY: 1, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 4, SubClass: 8},
+	247: {Name: "bed", ID: 247, SizeX: 4, SizeY: 2, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 1, SubClass: 128},
+	248: {Name: "bed", ID: 248, SizeX: 2, SizeY: 4, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 1, SubClass: 128},
+	249: {Name: "manashrine", ID: 249, SizeX: 3, SizeY: 3, Left: 2, Top: 96, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 2, SubClass: 1},
+	250: {Name: "a trap", ID: 250, SizeX: 3, SizeY: 1, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 30, SubClass: 128},
+	251: {Name: "gidbinn altar", ID: 251, SizeX: 3, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 0, SubClass: 0},
+	252: {Name: "gidbinn", ID: 252, SizeX: 3, SizeY: 3, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 31, SubClass: 0},
+	253: {Name: "Dummy", ID: 253, SizeX: 0, SizeY: 0, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: false, OperateFn: 0, SubClass: 0},
+	254: {Name: "Dummy", ID: 254, SizeX: 0, SizeY: 0, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: false, OperateFn: 0, SubClass: 0},
+	255: {Name: "Dummy", ID: 255, SizeX: 0, SizeY: 0, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: false, OperateFn: 0, SubClass: 0},
+	256: {Name: "Dummy", ID: 256, SizeX: 3, SizeY: 1, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 14, SubClass: 128},
+	257: {Name: "Dummy", ID: 257, SizeX: 3, SizeY: 1, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 14, SubClass: 128},
+	258: {Name: "Dummy", ID: 258, SizeX: 3, SizeY: 1, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 14, SubClass: 128},
+	259: {Name: "Dummy", ID: 259, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: false, OperateFn: 14, SubClass: 0},
+	260: {Name: "Shrine", ID: 260, SizeX: 1, SizeY: 1, Left: 3, Top: 77, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 2, SubClass: 1},
+	261: {Name: "a trap", ID: 261, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: -15, Xoffset: 5, HasCollision: false, OperateFn: 11, SubClass: 0},
+	262: {Name: "Shrine", ID: 262, SizeX: 3, SizeY: 3, Left: 3, Top: 77, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 2, SubClass: 1},
+	263: {Name: "Shrine", ID: 263, SizeX: 3, SizeY: 3, Left: 3, Top: 77, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 2, SubClass: 1},
+	264: {Name: "Shrine", ID: 264, SizeX: 3, SizeY: 3, Left: 1, Top: 264, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 2, SubClass: 1},
+	265: {Name: "Shrine", ID: 265, SizeX: 3, SizeY: 3, Left: 2, Top: 264, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 2, SubClass: 1},
+	266: {Name: "goo pile", ID: 266, SizeX: 1, SizeY: 1, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 14, SubClass: 8},
+	267: {Name: "bank", ID: 267, SizeX: 1, SizeY: 1, Left: 1, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 32, SubClass: 0},
+	268: {Name: "wirt's body", ID: 268, SizeX: 1, SizeY: 1, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 33, SubClass: 8},
+	269: {Name: "dummy", ID: 269, SizeX: 0, SizeY: 0, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: false, OperateFn: 14, SubClass: 0},
+	270: {Name: "corpse", ID: 270, SizeX: 3, SizeY: 3, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 14, SubClass: 8},
+	271: {Name: "corpse", ID: 271, SizeX: 3, SizeY: 3, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 14, SubClass: 8},
+	272: {Name: "corpse", ID: 272, SizeX: 3, SizeY: 3, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 14, SubClass: 8},
+	273: {Name: "Dummy", ID: 273, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 14, SubClass: 0},
+	274: {Name: "hidden stash", ID: 274, SizeX: 0, SizeY: 0, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: false, OperateFn: 14, SubClass: 0},
+	275: {Name: "Shrine", ID: 275, SizeX: 3, SizeY: 3, Left: 1, Top: 275, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 2, SubClass: 1},
+	276: {Name: "Shrine", ID: 276, SizeX: 3, SizeY: 3, Left: 2, Top: 275, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 2, SubClass: 1},
+	277: {Name: "Shrine", ID: 277, SizeX: 3, SizeY: 3, Left: 3, Top: 275, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 2, SubClass: 1},
+	278: {Name: "Shrine", ID: 278, SizeX: 3, SizeY: 3, Left: 2, Top: 120, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 2, SubClass: 1},
+	279: {Name: "Shrine", ID: 279, SizeX: 2, SizeY: 2, Left: 3, Top: 282, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 2, SubClass: 1},
+	280: {Name: "Shrine", ID: 280, SizeX: 3, SizeY: 4, Left: 1, Top: 282, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 2, SubClass: 1},
+	281: {Name: "Shrine", ID: 281, SizeX: 3, SizeY: 4, Left: 2, Top: 282, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 2, SubClass: 1},
+	282: {Name: "Shrine", ID: 282, SizeX: 3, SizeY: 3, Left: 3, Top: 282, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 2, SubClass: 1},
+	283: {Name: "dummy", ID: 283, SizeX: 1, SizeY: 1, Left: 1, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 3, SubClass: 8},
+	284: {Name: "sarcophagus", ID: 284, SizeX: 3, SizeY: 5, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 1, SubClass: 0},
+	285: {Name: "dummy", ID: 285, SizeX: 1, SizeY: 1, Left: 1, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 14, SubClass: 8},
+	286: {Name: "Dummy", ID: 286, SizeX: 3, SizeY: 3, Left: 1, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 14, SubClass: 8},
+	287: {Name: "Dummy", ID: 287, SizeX: 2, SizeY: 2, Left: 1, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 14, SubClass: 8},
+	288: {Name: "Waypoint", ID: 288, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: false, OperateFn: 23, SubClass: 64},
+	289: {Name: "bed", ID: 289, SizeX: 5, SizeY: 5, Left: -50, Top: 0, Width: 100, Height: 80, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 14, SubClass: 128},
+	290: {Name: "door", ID: 290, SizeX: 1, SizeY: 3, Left: -20, Top: -80, Width: 40, Height: 40, Yoffset: -5, Xoffset: -8, HasCollision: true, OperateFn: 8, SubClass: 0},
+	291: {Name: "door", ID: 291, SizeX: 3, SizeY: 1, Left: -20, Top: -80, Width: 40, Height: 40, Yoffset: 0, Xoffset: 8, HasCollision: true, OperateFn: 8, SubClass: 0},
+	292: {Name: "door", ID: 292, SizeX: 1, SizeY: 3, Left: -20, Top: -80, Width: 40, Height: 40, Yoffset: -5, Xoffset: -9, HasCollision: true, OperateFn: 8, SubClass: 0},
+	293: {Name: "door", ID: 293, SizeX: 3, SizeY: 1, Left: -20, Top: -80, Width: 40, Height: 40, Yoffset: -3, Xoffset: 8, HasCollision: true, OperateFn: 8, SubClass: 0},
+	294: {Name: "door", ID: 294, SizeX: 1, SizeY: 3, Left: -20, Top: -80, Width: 40, Height: 40, Yoffset: -3, Xoffset: -10, HasCollision: true, OperateFn: 8, SubClass: 0},
+	295: {Name: "door", ID: 295, SizeX: 3, SizeY: 1, Left: -20, Top: -80, Width: 40, Height: 40, Yoffset: 0, Xoffset: 7, HasCollision: true, OperateFn: 8, SubClass: 0},
+	296: {Name: "Dummy", ID: 296, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 14, SubClass: 0},
+	297: {Name: "Dummy", ID: 297, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 14, SubClass: 0},
+	298: {Name: "portal", ID: 298, SizeX: 5, SizeY: 2, Left: -30, Top: -80, Width: 60, Height: 80, Yoffset: 0, Xoffset: 0, HasCollision: false, OperateFn: 34, SubClass: 16},
+	299: {Name: "magic shrine", ID: 299, SizeX: 3, SizeY: 3, Left: 3, Top: 301, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 2, SubClass: 1},
+	300: {Name: "magic shrine", ID: 300, SizeX: 3, SizeY: 3, Left: 3, Top: 301, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 2, SubClass: 1},
+	301: {Name: "Dummy", ID: 301, SizeX: 1, SizeY: 1, Left: 1, Top: 301, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 2, SubClass: 1},
+	302: {Name: "manashrine", ID: 302, SizeX: 1, SizeY: 1, Left: 2, Top: 301, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 2, SubClass: 1},
+	303: {Name: "magic shrine", ID: 303, SizeX: 3, SizeY: 3, Left: 3, Top: 301, Width: 60, Height: 80, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 2, SubClass: 1},
+	304: {Name: "teleportation pad", ID: 304, SizeX: 1, SizeY: 1, Left: -30, Top: -80, Width: 60, Height: 80, Yoffset: 0, Xoffset: 0, HasCollision: false, OperateFn: 27, SubClass: 0},
+	305: {Name: "teleportation pad", ID: 305, SizeX: 1, SizeY: 1, Left: -30, Top: -80, Width: 60, Height: 80, Yoffset: 0, Xoffset: 0, HasCollision: false, OperateFn: 27, SubClass: 0},
+	306: {Name: "teleportation pad", ID: 306, SizeX: 1, SizeY: 1, Left: -30, Top: -80, Width: 60, Height: 80, Yoffset: 0, Xoffset: 0, HasCollision: false, OperateFn: 27, SubClass: 0},
+	307: {Name: "Dummy", ID: 307, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: false, OperateFn: 0, SubClass: 0},
+	308: {Name: "Dummy", ID: 308, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: false, OperateFn: 0, SubClass: 0},
+	309: {Name: "Dummy", ID: 309, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: false, OperateFn: 0, SubClass: 0},
+	310: {Name: "Dummy", ID: 310, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: false, OperateFn: 0, SubClass: 0},
+	311: {Name: "Dummy", ID: 311, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: false, OperateFn: 0, SubClass: 0},
+	312: {Name: "Dummy", ID: 312, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: false, OperateFn: 0, SubClass: 0},
+	313: {Name: "Dummy", ID: 313, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: false, OperateFn: 0, SubClass: 0},
+	314: {Name: "dead guard", ID: 314, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 14, SubClass: 0},
+	315: {Name: "dead guard", ID: 315, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 14, SubClass: 0},
+	316: {Name: "dead guard", ID: 316, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 14, SubClass: 0},
+	317: {Name: "dead guard", ID: 317, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 14, SubClass: 0},
+	318: {Name: "eunuch", ID: 318, SizeX: 1, SizeY: 7, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 0, SubClass: 0},
+	319: {Name: "Dummy", ID: 319, SizeX: 2, SizeY: 2, Left: 1, Top: 282, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 2, SubClass: 1},
+	320: {Name: "manashrine", ID: 320, SizeX: 2, SizeY: 2, Left: 1, Top: 282, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 2, SubClass: 1},
+	321: {Name: "Dummy", ID: 321, SizeX: 0, SizeY: 0, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 14, SubClass: 0},
+	322: {Name: "Well", ID: 322, SizeX: 3, SizeY: 3, Left: 750, Top: 128, Width: 1, Height: 3, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 22, SubClass: 32},
+	323: {Name: "Waypoint", ID: 323, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: false, OperateFn: 23, SubClass: 64},
+	324: {Name: "Waypoint", ID: 324, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: false, OperateFn: 23, SubClass: 64},
+	325: {Name: "magic shrine", ID: 325, SizeX: 3, SizeY: 3, Left: 3, Top: 301, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 2, SubClass: 1},
+	326: {Name: "dead body", ID: 326, SizeX: 3, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 3, SubClass: 0},
+	327: {Name: "dummy", ID: 327, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 0, SubClass: 0},
+	328: {Name: "dummy", ID: 328, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 0, SubClass: 0},
+	329: {Name: "chest", ID: 329, SizeX: 1, SizeY: 3, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 4, SubClass: 8},
+	330: {Name: "chest", ID: 330, SizeX: 1, SizeY: 3, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 4, SubClass: 8},
+	331: {Name: "chest", ID: 331, SizeX: 1, SizeY: 1, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 4, SubClass: 8},
+	332: {Name: "chest", ID: 332, SizeX: 1, SizeY: 1, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 4, SubClass: 8},
+	333: {Name: "chest", ID: 333, SizeX: 1, SizeY: 3, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 4, SubClass: 8},
+	334: {Name: "chest", ID: 334, SizeX: 1, SizeY: 1, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 4, SubClass: 8},
+	335: {Name: "chest", ID: 335, SizeX: 1, SizeY: 1, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 4, SubClass: 8},
+	336: {Name: "chest", ID: 336, SizeX: 1, SizeY: 1, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 4, SubClass: 8},
+	337: {Name: "Steeg Stone", ID: 337, SizeX: 2, SizeY: 2, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 35, SubClass: 0},
+	338: {Name: "Guild Vault", ID: 338, SizeX: 3, SizeY: 3, Left: 1, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 36, SubClass: 0},
+	339: {Name: "Trophy Case", ID: 339, SizeX: 2, SizeY: 3, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 37, SubClass: 0},
+	340: {Name: "Message Board", ID: 340, SizeX: 2, SizeY: 3, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 38, SubClass: 0},
+	341: {Name: "Dummy", ID: 341, SizeX: 11, SizeY: 5, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: -20, Xoffset: -45, HasCollision: true, OperateFn: 4, SubClass: 0},
+	342: {Name: "portal", ID: 342, SizeX: 1, SizeY: 1, Left: -40, Top: -80, Width: 80, Height: 80, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 46, SubClass: 16},
+	343: {Name: "Shrine", ID: 343, SizeX: 2, SizeY: 2, Left: 1, Top: 282, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 2, SubClass: 1},
+	344: {Name: "Shrine", ID: 344, SizeX: 2, SizeY: 2, Left: 1, Top: 282, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 2, SubClass: 1},
+	345: {Name: "Dummy", ID: 345, SizeX: 0, SizeY: 0, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: false, OperateFn: 0, SubClass: 0},
+	346: {Name: "Dummy", ID: 346, SizeX: 0, SizeY: 0, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: false, OperateFn: 0, SubClass: 0},
+	347: {Name: "Dummy", ID: 347, SizeX: 0, SizeY: 0, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: false, OperateFn: 0, SubClass: 0},
+	348: {Name: "Dummy", ID: 348, SizeX: 0, SizeY: 0, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: false, OperateFn: 0, SubClass: 0},
+	349: {Name: "Dummy", ID: 349, SizeX: 0, SizeY: 0, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: false, OperateFn: 0, SubClass: 0},
+	350: {Name: "Dummy", ID: 350, SizeX: 0, SizeY: 0, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: false, OperateFn: 0, SubClass: 0},
+	351: {Name: "Dummy", ID: 351, SizeX: 0, SizeY: 0, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: false, OperateFn: 0, SubClass: 0},
+	352: {Name: "Dummy", ID: 352, SizeX: 0, SizeY: 0, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: false, OperateFn: 0, SubClass: 0},
+	353: {Name: "Dummy", ID: 353, SizeX: 0, SizeY: 0, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: false, OperateFn: 0, SubClass: 0},
+	354: {Name: "chest", ID: 354, SizeX: 1, SizeY: 1, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 39, SubClass: 8},
+	355: {Name: "chest", ID: 355, SizeX: 1, SizeY: 1, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 40, SubClass: 8},
+	356: {Name: "chest", ID: 356, SizeX: 1, SizeY: 1, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 41, SubClass: 8},
+	357: {Name: "Tome", ID: 357, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 42, SubClass: 0},
+	358: {Name: "fire", ID: 358, SizeX: 3, SizeY: 3, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 11, SubClass: 0},
+	359: {Name: "fire", ID: 359, SizeX: 3, SizeY: 3, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 11, SubClass: 0},
+	360: {Name: "RockPIle", ID: 360, SizeX: 2, SizeY: 2, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 3, SubClass: 8},
+	361: {Name: "magic shrine", ID: 361, SizeX: 3, SizeY: 3, Left: 3, Top: 301, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 2, SubClass: 1},
+	362: {Name: "basket", ID: 362, SizeX: 1, SizeY: 1, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 3, SubClass: 8},
+	363: {Name: "HungSkeleton", ID: 363, SizeX: 4, SizeY: 4, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 14, SubClass: 0},
+	364: {Name: "Dummy", ID: 364, SizeX: 2, SizeY: 1, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 1, SubClass: 128},
+	365: {Name: "casket", ID: 365, SizeX: 5, SizeY: 3, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 1, SubClass: 0},
+	366: {Name: "sewer stairs", ID: 366, SizeX: 5, SizeY: 5, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 44, SubClass: 0},
+	367: {Name: "sewer lever", ID: 367, SizeX: 3, SizeY: 5, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 45, SubClass: 0},
+	368: {Name: "darkwanderer", ID: 368, SizeX: 0, SizeY: 0, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: false, OperateFn: 0, SubClass: 0},
+	369: {Name: "dummy", ID: 369, SizeX: 0, SizeY: 0, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: false, OperateFn: 0, SubClass: 0},
+	370: {Name: "Dummy", ID: 370, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 0, SubClass: 0},
+	371: {Name: "chest", ID: 371, SizeX: 1, SizeY: 1, Left: 15, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 0, SubClass: 8},
+	372: {Name: "BoneChest", ID: 372, SizeX: 1, SizeY: 1, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 14, SubClass: 0},
+	373: {Name: "Dummy", ID: 373, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: false, OperateFn: 3, SubClass: 0},
+	374: {Name: "Dummy", ID: 374, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: false, OperateFn: 11, SubClass: 0},
+	375: {Name: "Dummy", ID: 375, SizeX: 1, SizeY: 3, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 0, SubClass: 8},
+	376: {Name: "Hellforge", ID: 376, SizeX: 3, SizeY: 3, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: -10, HasCollision: true, OperateFn: 49, SubClass: 0},
+	377: {Name: "Guild Portal", ID: 377, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: false, OperateFn: 43, SubClass: 0},
+	378: {Name: "Dummy", ID: 378, SizeX: 0, SizeY: 0, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: false, OperateFn: 0, SubClass: 0},
+	379: {Name: "Dummy", ID: 379, SizeX: 0, SizeY: 0, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: false, OperateFn: 0, SubClass: 0},
+	380: {Name: "TrappedSoul", ID: 380, SizeX: 2, SizeY: 2, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 20, Xoffset: -3, HasCollision: true, OperateFn: 48, SubClass: 0},
+	381: {Name: "TrappedSoul", ID: 381, SizeX: 2, SizeY: 2, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 20, Xoffset: -3, HasCollision: true, OperateFn: 48, SubClass: 0},
+	382: {Name: "Dummy", ID: 382, SizeX: 0, SizeY: 0, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: false, OperateFn: 0, SubClass: 0},
+	383: {Name: "TrappedSoul", ID: 383, SizeX: 2, SizeY: 2, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 3, SubClass: 0},
+	384: {Name: "TrappedSoul", ID: 384, SizeX: 2, SizeY: 2, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 3, SubClass: 0},
+	385: {Name: "Dummy", ID: 385, SizeX: 0, SizeY: 0, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: false, OperateFn: 3, SubClass: 0},
+	386: {Name: "Dummy", ID: 386, SizeX: 10, SizeY: 6, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 50, SubClass: 0},
+	387: {Name: "chest", ID: 387, SizeX: 1, SizeY: 3, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 4, SubClass: 8},
+	388: {Name: "casket", ID: 388, SizeX: 1, SizeY: 3, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 4, SubClass: 8},
+	389: {Name: "chest", ID: 389, SizeX: 3, SizeY: 1, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 4, SubClass: 8},
+	390: {Name: "chest", ID: 390, SizeX: 1, SizeY: 2, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 4, SubClass: 8},
+	391: {Name: "chest", ID: 391, SizeX: 2, SizeY: 1, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 4, SubClass: 8},
+	392: {Name: "Seal", ID: 392, SizeX: 5, SizeY: 5, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 54, SubClass: 0},
+	393: {Name: "Seal", ID: 393, SizeX: 5, SizeY: 5, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 52, SubClass: 0},
+	394: {Name: "Seal", ID: 394, SizeX: 5, SizeY: 5, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 55, SubClass: 0},
+	395: {Name: "Seal", ID: 395, SizeX: 5, SizeY: 5, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 52, SubClass: 0},
+	396: {Name: "Seal", ID: 396, SizeX: 5, SizeY: 5, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 56, SubClass: 0},
+	397: {Name: "chest", ID: 397, SizeX: 2, SizeY: 2, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 4, SubClass: 8},
+	398: {Name: "Waypoint", ID: 398, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: false, OperateFn: 23, SubClass: 64},
+	399: {Name: "fissure", ID: 399, SizeX: 0, SizeY: 0, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 0, SubClass: 0},
+	400: {Name: "Dummy", ID: 400, SizeX: 2, SizeY: 2, Left: 1, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 14, SubClass: 8},
+	401: {Name: "Dummy", ID: 401, SizeX: 0, SizeY: 0, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: false, OperateFn: 0, SubClass: 0},
+	402: {Name: "Waypoint", ID: 402, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: false, OperateFn: 23, SubClass: 64},
+	403: {Name: "fire", ID: 403, SizeX: 2, SizeY: 2, Left: 1, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 14, SubClass: 8},
+	404: {Name: "compellingorb", ID: 404, SizeX: 3, SizeY: 3, Left: 1, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 53, SubClass: 0},
+	405: {Name: "chest", ID: 405, SizeX: 1, SizeY: 1, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 57, SubClass: 8},
+	406: {Name: "chest", ID: 406, SizeX: 1, SizeY: 1, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 59, SubClass: 8},
+	407: {Name: "chest", ID: 407, SizeX: 1, SizeY: 1, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 58, SubClass: 8},
+	408: {Name: "Dummy", ID: 408, SizeX: 2, SizeY: 2, Left: 1, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 0, SubClass: 8},
+	409: {Name: "Dummy", ID: 409, SizeX: 1, SizeY: 1, Left: 1, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 0, SubClass: 8},
+	410: {Name: "Siege Control", ID: 410, SizeX: 1, SizeY: 1, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 46, SubClass: 8},
+	411: {Name: "ptox", ID: 411, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 0, SubClass: 0},
+	412: {Name: "pyox", ID: 412, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 0, SubClass: 0},
+	413: {Name: "chestR", ID: 413, SizeX: 2, SizeY: 2, Left: 20, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 4, SubClass: 8},
+	414: {Name: "Shrine3wilderness", ID: 414, SizeX: 2, SizeY: 2, Left: 3, Top: 206, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 2, SubClass: 1},
+	415: {Name: "Shrine2wilderness", ID: 415, SizeX: 2, SizeY: 2, Left: 3, Top: 206, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 2, SubClass: 1},
+	416: {Name: "hiddenstash", ID: 416, SizeX: 1, SizeY: 3, Left: 20, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 4, SubClass: 8},
+	417: {Name: "flag wilderness", ID: 417, SizeX: 3, SizeY: 3, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 0, SubClass: 0},
+	418: {Name: "barrel wilderness", ID: 418, SizeX: 1, SizeY: 1, Left: 20, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 4, SubClass: 8},
+	419: {Name: "barrel wilderness", ID: 419, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 14, SubClass: 0},
+	420: {Name: "woodchestL", ID: 420, SizeX: 1, SizeY: 3, Left: 20, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 4, SubClass: 8},
+	421: {Name: "Shrine3wilderness", ID: 421, SizeX: 2, SizeY: 2, Left: 3, Top: 206, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 2, SubClass: 1},
+	422: {Name: "manashrine", ID: 422, SizeX: 3, SizeY: 3, Left: 2, Top: 172, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 2, SubClass: 1},
+	423: {Name: "healthshrine", ID: 423, SizeX: 3, SizeY: 3, Left: 1, Top: 282, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 2, SubClass: 1},
+	424: {Name: "burialchestL", ID: 424, SizeX: 2, SizeY: 3, Left: 20, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 4, SubClass: 8},
+	425: {Name: "burialchestR", ID: 425, SizeX: 3, SizeY: 2, Left: 20, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 4, SubClass: 8},
+	426: {Name: "well", ID: 426, SizeX: 4, SizeY: 4, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 22, SubClass: 0},
+	427: {Name: "Shrine2wilderness", ID: 427, SizeX: 3, SizeY: 3, Left: 3, Top: 206, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 2, SubClass: 1},
+	428: {Name: "Shrine2wilderness", ID: 428, SizeX: 2, SizeY: 2, Left: 3, Top: 206, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 2, SubClass: 1},
+	429: {Name: "Waypoint", ID: 429, SizeX: 5, SizeY: 5, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: false, OperateFn: 23, SubClass: 64},
+	430: {Name: "ChestL", ID: 430, SizeX: 1, SizeY: 2, Left: 20, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 4, SubClass: 8},
+	431: {Name: "woodchestR", ID: 431, SizeX: 2, SizeY: 1, Left: 20, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 4, SubClass: 8},
+	432: {Name: "ChestSL", ID: 432, SizeX: 1, SizeY: 2, Left: 20, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 4, SubClass: 8},
+	433: {Name: "ChestSR", ID: 433, SizeX: 2, SizeY: 1, Left: 20, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 4, SubClass: 8},
+	434: {Name: "etorch1", ID: 434, SizeX: 2, SizeY: 2, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 0, SubClass: 0},
+	435: {Name: "ecfra", ID: 435, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 0, SubClass: 0},
+	436: {Name: "ettr", ID: 436, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 0, SubClass: 0},
+	437: {Name: "etorch2", ID: 437, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 0, SubClass: 0},
+	438: {Name: "burningbodies", ID: 438, SizeX: 2, SizeY: 2, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 0, SubClass: 0},
+	439: {Name: "burningpit", ID: 439, SizeX: 3, SizeY: 4, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 0, SubClass: 0},
+	440: {Name: "tribal flag", ID: 440, SizeX: 3, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 0, SubClass: 0},
+	441: {Name: "eflg", ID: 441, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 0, SubClass: 0},
+	442: {Name: "chan", ID: 442, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: -130, Xoffset: 0, HasCollision: false, OperateFn: 0, SubClass: 0},
+	443: {Name: "jar1", ID: 443, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 3, SubClass: 8},
+	444: {Name: "jar2", ID: 444, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 3, SubClass: 8},
+	445: {Name: "jar3", ID: 445, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 3, SubClass: 8},
+	446: {Name: "swingingheads", ID: 446, SizeX: 5, SizeY: 2, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 0, SubClass: 0},
+	447: {Name: "pole", ID: 447, SizeX: 4, SizeY: 4, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 0, SubClass: 0},
+	448: {Name: "animated skulland rockpile", ID: 448, SizeX: 5, SizeY: 3, Left: 20, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 4, SubClass: 8},
+	449: {Name: "gate", ID: 449, SizeX: 1, SizeY: 7, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 61, SubClass: 0},
+	450: {Name: "pileofskullsandrocks", ID: 450, SizeX: 5, SizeY: 5, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 14, SubClass: 0},
+	451: {Name: "hellgate", ID: 451, SizeX: 3, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 0, SubClass: 0},
+	452: {Name: "banner 1", ID: 452, SizeX: 1, SizeY: 1, Left: 1, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 0, SubClass: 0},
+	453: {Name: "banner 2", ID: 453, SizeX: 1, SizeY: 1, Left: 1, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 0, SubClass: 0},
+	454: {Name: "explodingchest", ID: 454, SizeX: 1, SizeY: 3, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 30, SubClass: 0},
+	455: {Name: "chest", ID: 455, SizeX: 2, SizeY: 1, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 4, SubClass: 8},
+	456: {Name: "deathpole", ID: 456, SizeX: 3, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 0, SubClass: 0},
+	457: {Name: "Ldeathpole", ID: 457, SizeX: 3, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 0, SubClass: 0},
+	458: {Name: "Altar", ID: 458, SizeX: 2, SizeY: 3, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 0, SubClass: 0},
+	459: {Name: "dummy", ID: 459, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: false, OperateFn: 0, SubClass: 0},
+	460: {Name: "dummy", ID: 460, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: false, OperateFn: 0, SubClass: 0},
+	461: {Name: "dummy", ID: 461, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: false, OperateFn: 0, SubClass: 0},
+	462: {Name: "dummy", ID: 462, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: false, OperateFn: 0, SubClass: 0},
+	463: {Name: "hidden stash", ID: 463, SizeX: 1, SizeY: 1, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 4, SubClass: 8},
+	464: {Name: "healthshrine", ID: 464, SizeX: 2, SizeY: 2, Left: 1, Top: 282, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 2, SubClass: 1},
+	465: {Name: "manashrine", ID: 465, SizeX: 2, SizeY: 2, Left: 2, Top: 172, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 2, SubClass: 1},
+	466: {Name: "evilurn", ID: 466, SizeX: 1, SizeY: 1, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 68, SubClass: 8},
+	467: {Name: "icecavejar1", ID: 467, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 3, SubClass: 8},
+	468: {Name: "icecavejar2", ID: 468, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 3, SubClass: 8},
+	469: {Name: "icecavejar3", ID: 469, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 3, SubClass: 8},
+	470: {Name: "icecavejar4", ID: 470, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 3, SubClass: 8},
+	471: {Name: "icecavejar4", ID: 471, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 3, SubClass: 8},
+	472: {Name: "icecaveshrine2", ID: 472, SizeX: 2, SizeY: 2, Left: 3, Top: 206, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 2, SubClass: 1},
+	473: {Name: "cagedwussie1", ID: 473, SizeX: 3, SizeY: 3, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: false, OperateFn: 0, SubClass: 0},
+	474: {Name: "Ancient Statue 3", ID: 474, SizeX: 3, SizeY: 3, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: -5, Xoffset: -5, HasCollision: true, OperateFn: 62, SubClass: 0},
+	475: {Name: "Ancient Statue 1", ID: 475, SizeX: 3, SizeY: 3, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: -5, Xoffset: -5, HasCollision: true, OperateFn: 63, SubClass: 0},
+	476: {Name: "Ancient Statue 2", ID: 476, SizeX: 3, SizeY: 3, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: -10, Xoffset: -8, HasCollision: true, OperateFn: 64, SubClass: 0},
+	477: {Name: "deadbarbarian", ID: 477, SizeX: 2, SizeY: 3, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 4, SubClass: 8},
+	478: {Name: "clientsmoke", ID: 478, SizeX: 0, SizeY: 0, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: false, OperateFn: 0, SubClass: 0},
+	479: {Name: "icecaveshrine2", ID: 479, SizeX: 2, SizeY: 2, Left: 3, Top: 206, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 2, SubClass: 1},
+	480: {Name: "icecave_torch1", ID: 480, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 0, SubClass: 0},
+	481: {Name: "icecave_torch2", ID: 481, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 0, SubClass: 0},
+	482: {Name: "ttor", ID: 482, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 0, SubClass: 0},
+	483: {Name: "manashrine", ID: 483, SizeX: 2, SizeY: 2, Left: 2, Top: 172, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 2, SubClass: 1},
+	484: {Name: "healthshrine", ID: 484, SizeX: 2, SizeY: 2, Left: 2, Top: 172, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 2, SubClass: 1},
+	485: {Name: "tomb1", ID: 485, SizeX: 5, SizeY: 3, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 4, SubClass: 8},
+	486: {Name: "tomb2", ID: 486, SizeX: 5, SizeY: 3, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 4, SubClass: 8},
+	487: {Name: "tomb3", ID: 487, SizeX: 5, SizeY: 3, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 4, SubClass: 8},
+	488: {Name: "magic shrine", ID: 488, SizeX: 2, SizeY: 2, Left: 3, Top: 206, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 2, SubClass: 1},
+	489: {Name: "torch1", ID: 489, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 0, SubClass: 0},
+	490: {Name: "torch2", ID: 490, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 0, SubClass: 0},
+	491: {Name: "manashrine", ID: 491, SizeX: 3, SizeY: 3, Left: 2, Top: 172, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 2, SubClass: 1},
+	492: {Name: "healthshrine", ID: 492, SizeX: 3, SizeY: 3, Left: 1, Top: 282, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 2, SubClass: 1},
+	493: {Name: "well", ID: 493, SizeX: 4, SizeY: 4, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 22, SubClass: 0},
+	494: {Name: "Waypoint", ID: 494, SizeX: 5, SizeY: 5, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: false, OperateFn: 23, SubClass: 64},
+	495: {Name: "magic shrine", ID: 495, SizeX: 2, SizeY: 2, Left: 3, Top: 206, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 2, SubClass: 1},
+	496: {Name: "Waypoint", ID: 496, SizeX: 5, SizeY: 5, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: false, OperateFn: 23, SubClass: 64},
+	497: {Name: "magic shrine", ID: 497, SizeX: 3, SizeY: 3, Left: 3, Top: 206, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 2, SubClass: 1},
+	498: {Name: "well", ID: 498, SizeX: 3, SizeY: 3, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 22, SubClass: 0},
+	499: {Name: "magic shrine2", ID: 499, SizeX: 2, SizeY: 4, Left: 3, Top: 206, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 2, SubClass: 1},
+	500: {Name: "object1", ID: 500, SizeX: 2, SizeY: 2, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 4, SubClass: 8},
+	501: {Name: "woodchestL", ID: 501, SizeX: 1, SizeY: 3, Left: 20, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 4, SubClass: 8},
+	502: {Name: "woodchestR", ID: 502, SizeX: 3, SizeY: 1, Left: 20, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 4, SubClass: 8},
+	503: {Name: "magic shrine", ID: 503, SizeX: 3, SizeY: 3, Left: 3, Top: 206, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 2, SubClass: 1},
+	504: {Name: "woodchest2L", ID: 504, SizeX: 2, SizeY: 3, Left: 20, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 4, SubClass: 8},
+	505: {Name: "woodchest2R", ID: 505, SizeX: 3, SizeY: 2, Left: 20, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 4, SubClass: 8},
+	506: {Name: "swingingheads", ID: 506, SizeX: 5, SizeY: 2, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 0, SubClass: 0},
+	507: {Name: "debris", ID: 507, SizeX: 4, SizeY: 4, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 0, SubClass: 0},
+	508: {Name: "pene", ID: 508, SizeX: 1, SizeY: 7, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 61, SubClass: 0},
+	509: {Name: "magic shrine", ID: 509, SizeX: 4, SizeY: 3, Left: 3, Top: 206, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 2, SubClass: 1},
+	510: {Name: "mrpole", ID: 510, SizeX: 5, SizeY: 5, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 0, SubClass: 0},
+	511: {Name: "Waypoint", ID: 511, SizeX: 5, SizeY: 5, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: false, OperateFn: 23, SubClass: 64},
+	512: {Name: "magic shrine", ID: 512, SizeX: 2, SizeY: 2, Left: 3, Top: 206, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 2, SubClass: 1},
+	513: {Name: "well", ID: 513, SizeX: 4, SizeY: 4, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 22, SubClass: 0},
+	514: {Name: "torch1", ID: 514, SizeX: 1, SizeY: 3, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 0, SubClass: 0},
+	515: {Name: "torch1", ID: 515, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 0, SubClass: 0},
+	516: {Name: "object1", ID: 516, SizeX: 1, SizeY: 1, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 4, SubClass: 8},
+	517: {Name: "object2", ID: 517, SizeX: 2, SizeY: 2, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 4, SubClass: 8},
+	518: {Name: "mrbox", ID: 518, SizeX: 3, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 3, SubClass: 8},
+	519: {Name: "well", ID: 519, SizeX: 3, SizeY: 3, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 22, SubClass: 0},
+	520: {Name: "magic shrine", ID: 520, SizeX: 2, SizeY: 2, Left: 3, Top: 206, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 2, SubClass: 1},
+	521: {Name: "healthshrine", ID: 521, SizeX: 3, SizeY: 3, Left: 1, Top: 282, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 2, SubClass: 1},
+	522: {Name: "manashrine", ID: 522, SizeX: 3, SizeY: 3, Left: 2, Top: 172, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 2, SubClass: 1},
+	523: {Name: "red light", ID: 523, SizeX: 0, SizeY: 0, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 0, SubClass: 0},
+	524: {Name: "tomb1L", ID: 524, SizeX: 3, SizeY: 5, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 4, SubClass: 8},
+	525: {Name: "tomb2L", ID: 525, SizeX: 3, SizeY: 5, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 4, SubClass: 8},
+	526: {Name: "tomb3L", ID: 526, SizeX: 3, SizeY: 5, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 4, SubClass: 8},
+	527: {Name: "ubub", ID: 527, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: false, OperateFn: 0, SubClass: 0},
+	528: {Name: "sbub", ID: 528, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: false, OperateFn: 0, SubClass: 0},
+	529: {Name: "tomb1", ID: 529, SizeX: 5, SizeY: 3, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 4, SubClass: 8},
+	530: {Name: "tomb1L", ID: 530, SizeX: 3, SizeY: 5, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 4, SubClass: 8},
+	531: {Name: "tomb2", ID: 531, SizeX: 5, SizeY: 3, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 4, SubClass: 8},
+	532: {Name: "tomb2L", ID: 532, SizeX: 3, SizeY: 5, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 4, SubClass: 8},
+	533: {Name: "tomb3", ID: 533, SizeX: 5, SizeY: 3, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 4, SubClass: 8},
+	534: {Name: "tomb3L", ID: 534, SizeX: 3, SizeY: 5, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 4, SubClass: 8},
+	535: {Name: "mrbox", ID: 535, SizeX: 3, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 3, SubClass: 8},
+	536: {Name: "torch1", ID: 536, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 0, SubClass: 0},
+	537: {Name: "torch2", ID: 537, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 0, SubClass: 0},
+	538: {Name: "candles", ID: 538, SizeX: 2, SizeY: 5, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 0, SubClass: 0},
+	539: {Name: "Waypoint", ID: 539, SizeX: 5, SizeY: 5, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: false, OperateFn: 23, SubClass: 64},
+	540: {Name: "deadperson", ID: 540, SizeX: 2, SizeY: 2, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 4, SubClass: 8},
+	541: {Name: "groundtomb", ID: 541, SizeX: 5, SizeY: 3, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 4, SubClass: 8},
+	542: {Name: "Dummy", ID: 542, SizeX: 0, SizeY: 0, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: false, OperateFn: 0, SubClass: 0},
+	543: {Name: "Dummy", ID: 543, SizeX: 0, SizeY: 0, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: false, OperateFn: 0, SubClass: 0},
+	544: {Name: "groundtombL", ID: 544, SizeX: 3, SizeY: 5, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 4, SubClass: 8},
+	545: {Name: "deadperson2", ID: 545, SizeX: 2, SizeY: 2, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 4, SubClass: 8},
+	546: {Name: "ancientsaltar", ID: 546, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 65, SubClass: 0},
+	547: {Name: "To The Worldstone Keep Level 1", ID: 547, SizeX: 5, SizeY: 8, Left: -30, Top: -100, Width: 70, Height: 100, Yoffset: -47, Xoffset: 17, HasCollision: true, OperateFn: 66, SubClass: 0},
+	548: {Name: "eweaponrackR", ID: 548, SizeX: 2, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 20, SubClass: 0},
+	549: {Name: "eweaponrackL", ID: 549, SizeX: 1, SizeY: 2, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 20, SubClass: 0},
+	550: {Name: "earmorstandR", ID: 550, SizeX: 2, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 19, SubClass: 0},
+	551: {Name: "earmorstandL", ID: 551, SizeX: 1, SizeY: 2, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 19, SubClass: 0},
+	552: {Name: "torch2", ID: 552, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 0, SubClass: 0},
+	553: {Name: "funeralpire", ID: 553, SizeX: 3, SizeY: 4, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 0, SubClass: 0},
+	554: {Name: "burninglogs", ID: 554, SizeX: 3, SizeY: 4, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 0, SubClass: 0},
+	555: {Name: "stma", ID: 555, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 0, SubClass: 0},
+	556: {Name: "deadperson2", ID: 556, SizeX: 2, SizeY: 2, Left: 18, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 4, SubClass: 8},
+	557: {Name: "Dummy", ID: 557, SizeX: 0, SizeY: 0, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: false, OperateFn: 0, SubClass: 0},
+	558: {Name: "fana", ID: 558, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 67, SubClass: 0},
+	559: {Name: "BBQB", ID: 559, SizeX: 3, SizeY: 3, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 0, SubClass: 0},
+	560: {Name: "btor", ID: 560, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 0, SubClass: 0},
+	561: {Name: "Dummy", ID: 561, SizeX: 0, SizeY: 0, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: false, OperateFn: 69, SubClass: 0},
+	562: {Name: "Dummy", ID: 562, SizeX: 5, SizeY: 5, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 0, SubClass: 0},
+	563: {Name: "The Worldstone Chamber", ID: 563, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 70, SubClass: 0},
+	564: {Name: "Glacial Caves Level 1", ID: 564, SizeX: 0, SizeY: 0, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: -33, HasCollision: false, OperateFn: 71, SubClass: 0},
+	565: {Name: "strlastcinematic", ID: 565, SizeX: 0, SizeY: 0, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: false, OperateFn: 72, SubClass: 0},
+	566: {Name: "Harrogath", ID: 566, SizeX: 0, SizeY: 0, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 73, SubClass: 0},
+	567: {Name: "Zoo", ID: 567, SizeX: 0, SizeY: 0, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 0, SubClass: 0},
+	568: {Name: "Keeper", ID: 568, SizeX: 2, SizeY: 2, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 0, SubClass: 0},
+	569: {Name: "Throne of Destruction", ID: 569, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: false, OperateFn: 70, SubClass: 0},
+	570: {Name: "Dummy", ID: 570, SizeX: 1, SizeY: 1, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 0, SubClass: 0},
+	571: {Name: "Dummy", ID: 571, SizeX: 5, SizeY: 2, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 0, SubClass: 0},
+	572: {Name: "Dummy", ID: 572, SizeX: 2, SizeY: 5, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 0, SubClass: 0},
+	573: {Name: "ControlObject", ID: 573, SizeX: 0, SizeY: 0, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 0, SubClass: 0},
+	574: {Name: "DesertShrineArmor", ID: 574, SizeX: 0, SizeY: 0, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 0, SubClass: 0},
+	575: {Name: "DesertShrineCombat", ID: 575, SizeX: 0, SizeY: 0, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 0, SubClass: 0},
+	576: {Name: "DesertShrineResist", ID: 576, SizeX: 0, SizeY: 0, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 0, SubClass: 0},
+	577: {Name: "DesertShrineSkill", ID: 577, SizeX: 0, SizeY: 0, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 0, SubClass: 0},
+	578: {Name: "DesertShrineRecharge", ID: 578, SizeX: 0, SizeY: 0, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 0, SubClass: 0},
+	579: {Name: "DesertShrineStamina", ID: 579, SizeX: 0, SizeY: 0, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 0, SubClass: 0},
+	580: {Name: "PlaceUniqueChest", ID: 580, SizeX: 0, SizeY: 0, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 0, SubClass: 0},
+	581: {Name: "PlaceRandomTreasureChest", ID: 581, SizeX: 0, SizeY: 0, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 0, SubClass: 0},
+	582: {Name: "PlaceArcaneThingamajig", ID: 582, SizeX: 0, SizeY: 0, Left: 0, Top: 0, Width: 0, Height: 0, Yoffset: 0, Xoffset: 0, HasCollision: true, OperateFn: 0, SubClass: 0},
 }
@@ -1,6 +1,8 @@
 package data
 
 import (
+	"time"
+
 	"github.com/hectorgimenez/d2go/pkg/data/mode"
 	"github.com/hectorgimenez/d2go/pkg/data/object"
 )
@@ -16,10 +18,65 @@ type Object struct {
 	Owner        string
 	Mode         mode.ObjectMode
 	PortalData   object.PortalData
+	PreOpened    bool // Already open when first seen in this game, opened by other players or spawned open (GetData only)
+	// When the healing object was seen being used, zero if it's not depleted or it was already depleted when first seen
+	DepletedSince time.Time
 }
 
 type Objects []Object
 
+// ObjectState is the object mode simplified to what matters for interaction, it's the same in legacy and resurrected
+// graphics, while the time spent in operating mode depends on the graphics mode animation length
+type ObjectState string
+
+const (
+	ObjectStateClosed    ObjectState = "closed"
+	ObjectStateOperating ObjectState = "operating" // Opening/breaking animation, it can't be interacted with again
+	ObjectStateOpened    ObjectState = "opened"    // Opened, broken or used, special modes included
+)
+
+// State returns the interaction state of the object
+func (o Object) State() ObjectState {
+	switch o.Mode {
+	case mode.ObjectModeIdle:
+		return ObjectStateClosed
+	case mode.ObjectModeOperating:
+		return ObjectStateOperating
+	}
+
+	return ObjectStateOpened
+}
+
+// IsOpen returns true if the object is opened or being opened, operating it again does nothing. Checking the mode
+// against ObjectModeOpened only misses the operating animation and leads to double clicks
+func (o Object) IsOpen() bool {
+	return o.State() != ObjectStateClosed
+}
+
+// Interactable returns true if the object can be operated now
+func (o Object) Interactable() bool {
+	return o.Selectable && o.State() == ObjectStateClosed
+}
+
+// IsHealing returns true for the objects restoring life: wells, health and refill shrines
+func (o Object) IsHealing() bool {
+	return o.IsWell() || o.Shrine.ShrineType == object.HealthShrine || o.Shrine.ShrineType == object.RefillShrine
+}
+
+// Depleted returns true if the healing object is not in idle mode, it has been used and using it now does nothing.
+// Wells have several charges and only the mode is read, so a partially used well is not reported, and whether wells and
+// shrines go back to idle mode once recharged has not been verified on a client
+func (o Object) Depleted() bool {
+	return o.IsHealing() && o.IsOpen()
+}
+
+// HealingAvailable returns the healing objects that can be used now
+func (o Objects) HealingAvailable() Objects {
+	return o.Filter(func(obj Object) bool {
+		return obj.IsHealing() && !obj.Depleted()
+	})
+}
+
 func (o Objects) FindOne(name object.Name) (Object, bool) {
 	for _, obj := range o {
 		if obj.Name == name {
@@ -29,6 +86,17 @@ func (o Objects) FindOne(name object.Name) (Object, bool) {
 	return Object{}, false
 }
 
+// Filter returns the objects matching the given function, e.g. objects.Filter(data.Object.IsChest)
+func (o Objects) Filter(fn func(Object) bool) Objects {
+	filtered := make(Objects, 0)
+	for _, obj := range o {
+		if fn(obj) {
+			filtered = append(filtered, obj)
+		}
+	}
+	return filtered
+}
+
 func (o Objects) FindByID(id UnitID) (Object, bool) {
 	for _, obj := range o {
 		if obj.ID == id {
@@ -144,6 +212,19 @@ func (o Object) IsDoor() bool {
 	return false
 }
 
+// IsContainer returns true for any object that can drop items when opened, IsChest only covers the proper chests
+func (o Object) IsContainer() bool {
+	return o.Desc().IsContainer()
+}
+
+func (o Object) IsDestructible() bool {
+	return o.Desc().IsDestructible()
+}
+
+func (o Object) IsWell() bool {
+	return o.Desc().IsWell()
+}
+
 func (o Object) IsSuperChest() bool {
 	switch o.Name {
 	case 104, 105, 106, 107, 181, 183, 580, 397, 387, 389, 390, 391, 455:
@@ -0,0 +1,95 @@
+package data
+
+import (
+	"github.com/hectorgimenez/d2go/pkg/data/area"
+	"github.com/hectorgimenez/d2go/pkg/data/item"
+	"github.com/hectorgimenez/d2go/pkg/data/npc"
+)
+
+var (
+	PandemoniumKeyNames   = []item.Name{"KeyOfTerror", "KeyOfHate", "KeyOfDestruction"}
+	PandemoniumOrganNames = []item.Name{"DiablosHorn", "BaalsEye", "MephistosBrain"}
+	// Areas the red portals opened in Harrogath with the keys and organs lead to
+	PandemoniumAreas = []area.ID{area.MatronsDen, area.ForgottenSands, area.FurnaceOfPain, area.UberTristram}
+)
+
+// storageLocations are the places where keys and organs are kept between runs
+var storageLocations = []item.LocationType{
+	item.LocationInventory, item.LocationStash, item.LocationSharedStash, item.LocationCube,
+}
+
+// PandemoniumKeys returns the number of each Pandemonium key stored (inventory, stashes and cube)
+func (i Inventory) PandemoniumKeys() map[item.Name]int {
+	return i.countByName(PandemoniumKeyNames)
+}
+
+// PandemoniumOrgans returns the number of each uber organ stored (inventory, stashes and cube)
+func (i Inventory) PandemoniumOrgans() map[item.Name]int {
+	return i.countByName(PandemoniumOrganNames)
+}
+
+// KeySets returns the number of complete key sets (one of each key) stored, each set opens a mini uber portal
+func (i Inventory) KeySets() int {
+	return minCount(i.PandemoniumKeys(), PandemoniumKeyNames)
+}
+
+// OrganSets returns the number of complete organ sets stored, each set opens the Uber Tristram portal
+func (i Inventory) OrganSets() int {
+	return minCount(i.PandemoniumOrgans(), PandemoniumOrganNames)
+}
+
+func (i Inventory) countByName(names []item.Name) map[item.Name]int {
+	count := make(map[item.Name]int, len(names))
+	for _, n := range names {
+		count[n] = 0
+	}
+	for _, it := range i.ByLocation(storageLocations...) {
+		if _, found := count[it.Name]; found {
+			count[it.Name]++
+		}
+	}
+
+	return count
+}
+
+func minCount(count map[item.Name]int, names []item.Name) int {
+	sets := -1
+	for _, n := range names {
+		if sets == -1 || count[n] < sets {
+			sets = count[n]
+		}
+	}
+
+	return max(sets, 0)
+}
+
+type UberPortal struct {
+	Area   area.ID
+	Object Object
+}
+
+// UberPortals returns the red portals to the Pandemonium event areas found around the player
+func (d Data) UberPortals() []UberPortal {
+	portals := make([]UberPortal, 0)
+	for _, o := range d.Objects {
+		for _, a := range PandemoniumAreas {
+			if o.PortalData.DestArea == a {
+				portals = append(portals, UberPortal{Area: a, Object: o})
+			}
+		}
+	}
+
+	return portals
+}
+
+// DeadUbers returns the uber bosses killed around the player, mini ubers and Uber Tristram ones
+func (d Data) DeadUbers() []npc.ID {
+	dead := make([]npc.ID, 0)
+	for _, c := range d.Corpses {
+		if c.IsUber() {
+			dead = append(dead, c.Name)
+		}
+	}
+
+	return dead
+}
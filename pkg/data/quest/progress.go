@@ -0,0 +1,67 @@
+package quest
+
+import "github.com/hectorgimenez/d2go/pkg/data/difficulty"
+
+const (
+	// Set in The Search for Cain once the Cow King is killed, the Cow Level can't be opened again in that difficulty
+	statusCowKingKilled = StatusInProgress6
+	// Set in Siege on Harrogath while Larzuk socket reward is not used
+	statusSocketRewardUnused = StatusRewardPending
+)
+
+// HasCompleted returns true if the given quest is completed, unknown quests are not completed
+func (qs Quests) HasCompleted(q Quest) bool {
+	return qs[q].Completed()
+}
+
+// ByAct returns the quests of the given act, starting from 1
+func (qs Quests) ByAct(act int) Quests {
+	actQuests := make(Quests)
+	for q, st := range qs {
+		if q.Act() == act {
+			actQuests[q] = st
+		}
+	}
+
+	return actQuests
+}
+
+// ActCompleted returns true if all the quests of the given act are completed
+func (qs Quests) ActCompleted(act int) bool {
+	for _, q := range All {
+		if q.Act() == act && !qs.HasCompleted(q) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// CanUseCowPortal returns true if the Cow Level portal can be opened: Baal is killed and the Cow King is not
+func (qs Quests) CanUseCowPortal() bool {
+	return qs.HasCompleted(Act5EveOfDestruction) && !qs[Act1TheSearchForCain].HasStatus(statusCowKingKilled)
+}
+
+// IsSocketQuestAvailable returns true if Larzuk can still add sockets to an item as the Siege on Harrogath reward
+func (qs Quests) IsSocketQuestAvailable() bool {
+	return qs[Act5SiegeOnHarrogath].HasStatus(statusSocketRewardUnused)
+}
+
+// ByDifficulty holds the quests of each difficulty, game memory only has the current difficulty ones but save files
+// have all of them
+type ByDifficulty map[difficulty.Difficulty]Quests
+
+// HasCompleted returns true if the given quest is completed in the given difficulty
+func (bd ByDifficulty) HasCompleted(d difficulty.Difficulty, q Quest) bool {
+	return bd[d].HasCompleted(q)
+}
+
+// CanUseCowPortal returns true if the Cow Level portal can be opened in the given difficulty
+func (bd ByDifficulty) CanUseCowPortal(d difficulty.Difficulty) bool {
+	return bd[d].CanUseCowPortal()
+}
+
+// IsSocketQuestAvailable returns true if Larzuk socket reward is available in the given difficulty
+func (bd ByDifficulty) IsSocketQuestAvailable(d difficulty.Difficulty) bool {
+	return bd[d].IsSocketQuestAvailable()
+}
@@ -46,4 +46,69 @@ const (
 	Act5EveOfDestruction
 )
 
+// All quests, sorted by act
+var All = []Quest{
+	Act1DenOfEvil, Act1SistersBurialGrounds, Act1ToolsOfTheTrade, Act1TheSearchForCain, Act1TheForgottenTower,
+	Act1SistersToTheSlaughter,
+	Act2RadamentsLair, Act2TheHoradricStaff, Act2TaintedSun, Act2ArcaneSanctuary, Act2TheSummoner, Act2TheSevenTombs,
+	Act3LamEsensTome, Act3KhalimsWill, Act3BladeOfTheOldReligion, Act3TheGoldenBird, Act3TheBlackenedTemple,
+	Act3TheGuardian,
+	Act4TheFallenAngel, Act4HellForge, Act4TerrorsEnd,
+	Act5SiegeOnHarrogath, Act5RescueOnMountArreat, Act5PrisonOfIce, Act5BetrayalOfHarrogath, Act5RiteOfPassage,
+	Act5EveOfDestruction,
+}
+
+// Position of each quest status inside the quest flags of a difficulty, in 16 bit words. It's the same layout in game
+// memory and in the .d2s quest section
+var words = map[Quest]int{
+	Act1DenOfEvil:             1,
+	Act1SistersBurialGrounds:  2,
+	Act1ToolsOfTheTrade:       3,
+	Act1TheSearchForCain:      4,
+	Act1TheForgottenTower:     5,
+	Act1SistersToTheSlaughter: 6,
+	Act2RadamentsLair:         9,
+	Act2TheHoradricStaff:      10,
+	Act2TaintedSun:            11,
+	Act2ArcaneSanctuary:       12,
+	Act2TheSummoner:           13,
+	Act2TheSevenTombs:         14,
+	Act3LamEsensTome:          17,
+	Act3KhalimsWill:           18,
+	Act3BladeOfTheOldReligion: 19,
+	Act3TheGoldenBird:         20,
+	Act3TheBlackenedTemple:    21,
+	Act3TheGuardian:           22,
+	Act4TheFallenAngel:        25,
+	Act4TerrorsEnd:            26,
+	Act4HellForge:             27,
+	Act5SiegeOnHarrogath:      35,
+	Act5RescueOnMountArreat:   36,
+	Act5PrisonOfIce:           37,
+	Act5BetrayalOfHarrogath:   38,
+	Act5RiteOfPassage:         39,
+	Act5EveOfDestruction:      40,
+}
+
+// Word returns the position of the quest status in the quest flags, in 16 bit words
+func (q Quest) Word() int {
+	return words[q]
+}
+
+// Act returns the act of the quest, starting from 1
+func (q Quest) Act() int {
+	switch {
+	case q <= Act1SistersToTheSlaughter:
+		return 1
+	case q <= Act2TheSevenTombs:
+		return 2
+	case q <= Act3TheGuardian:
+		return 3
+	case q <= Act4TerrorsEnd:
+		return 4
+	}
+
+	return 5
+}
+
 type Quests map[Quest]Status
@@ -0,0 +1,62 @@
+package quest
+
+import (
+	"testing"
+
+	"github.com/hectorgimenez/d2go/pkg/data/difficulty"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAllQuestsHaveWords(t *testing.T) {
+	require.Len(t, words, len(All))
+	for _, q := range All {
+		require.NotZero(t, q.Word(), "quest %d has no word", q)
+	}
+}
+
+func TestQuestAct(t *testing.T) {
+	require.Equal(t, 1, Act1SistersToTheSlaughter.Act())
+	require.Equal(t, 2, Act2RadamentsLair.Act())
+	require.Equal(t, 4, Act4TerrorsEnd.Act())
+	require.Equal(t, 5, Act5SiegeOnHarrogath.Act())
+}
+
+func TestQuestsPredicates(t *testing.T) {
+	qs := Quests{
+		Act1DenOfEvil:        StatusCompletedBefore,
+		Act1TheSearchForCain: StatusCompletedBefore,
+		Act5SiegeOnHarrogath: StatusRewardPending,
+		Act5EveOfDestruction: StatusCompletedBefore,
+	}
+
+	require.True(t, qs.HasCompleted(Act1DenOfEvil))
+	require.False(t, qs.HasCompleted(Act1ToolsOfTheTrade))
+	require.False(t, qs.ActCompleted(1))
+	require.Len(t, qs.ByAct(5), 2)
+	require.True(t, qs.CanUseCowPortal())
+	require.True(t, qs.IsSocketQuestAvailable())
+
+	qs[Act1TheSearchForCain] |= statusCowKingKilled
+	require.False(t, qs.CanUseCowPortal())
+
+	bd := ByDifficulty{difficulty.Normal: qs}
+	require.True(t, bd.IsSocketQuestAvailable(difficulty.Normal))
+	require.False(t, bd.IsSocketQuestAvailable(difficulty.Hell))
+}
+
+func TestDecode(t *testing.T) {
+	buffer := make([]byte, BufferSize)
+	buffer[Act1DenOfEvil.Word()*2] = byte(StatusRewardGranted)
+	buffer[Act5EveOfDestruction.Word()*2+1] = byte(StatusCompletedBefore >> 8)
+
+	qs, err := Raw{Buffer: buffer, DecoderVersion: DecoderVersion}.Decode()
+	require.NoError(t, err)
+	require.Len(t, qs, len(All))
+	require.True(t, qs.HasCompleted(Act1DenOfEvil))
+	require.True(t, qs.HasCompleted(Act5EveOfDestruction))
+	require.True(t, qs[Act2RadamentsLair].NotStarted())
+
+	_, err = Raw{Buffer: buffer}.Decode()
+	require.Error(t, err)
+	require.Len(t, Decode(nil), len(All))
+}
@@ -0,0 +1,42 @@
+package quest
+
+import "fmt"
+
+const (
+	// BufferSize is the size of the quest flags of the current difficulty in game memory
+	BufferSize = 82
+	// DecoderVersion is increased every time Decode changes its output for the same buffer (new quests, new word
+	// positions, fixes), so raw captures can be checked against the version they were decoded with
+	DecoderVersion = 1
+)
+
+// Raw is the quest flags buffer as read from game memory, kept alongside the decoded quests
+type Raw struct {
+	Buffer         []byte
+	DecoderVersion int // Version of the decoder used to build the decoded quests
+}
+
+// Decode decodes the raw buffer, it fails if the buffer was decoded with a different decoder version, in that case
+// the result of Decode may not match the quests that were decoded together with the buffer
+func (r Raw) Decode() (Quests, error) {
+	if r.DecoderVersion != DecoderVersion {
+		return Decode(r.Buffer), fmt.Errorf("quest buffer decoded with version %d, current version is %d", r.DecoderVersion, DecoderVersion)
+	}
+
+	return Decode(r.Buffer), nil
+}
+
+// Decode reads the status of all the quests from a quest flags buffer, quests out of the buffer are not started
+func Decode(buffer []byte) Quests {
+	quests := make(Quests, len(All))
+	for _, q := range All {
+		offset := q.Word() * 2
+		if offset+1 >= len(buffer) {
+			quests[q] = 0
+			continue
+		}
+		quests[q] = Status(uint16(buffer[offset]) | uint16(buffer[offset+1])<<8)
+	}
+
+	return quests
+}
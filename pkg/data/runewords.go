@@ -0,0 +1,34 @@
+package data
+
+import (
+	"slices"
+
+	"github.com/hectorgimenez/d2go/pkg/data/item"
+	"github.com/hectorgimenez/d2go/pkg/data/stat"
+)
+
+// FormsRuneword returns the runeword that would be created by socketing the given runes into the item, in the same
+// order. The item must be a normal or superior base without socketed items and exactly as many sockets as runes
+func (i Item) FormsRuneword(runes []Item) (item.Runeword, bool) {
+	if i.Quality != item.QualityNormal && i.Quality != item.QualitySuperior || i.IsRuneword || i.HasSocketedItems() {
+		return item.Runeword{}, false
+	}
+
+	sockets, _ := i.FindStat(stat.NumSockets, 0)
+	if sockets.Value != len(runes) {
+		return item.Runeword{}, false
+	}
+
+	names := make([]item.Name, 0, len(runes))
+	for _, r := range runes {
+		names = append(names, r.Name)
+	}
+
+	for _, rw := range item.Runewords {
+		if slices.Equal(rw.Runes, names) && rw.AllowsBase(i.Type()) {
+			return rw, true
+		}
+	}
+
+	return item.Runeword{}, false
+}
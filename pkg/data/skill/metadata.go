@@ -0,0 +1,96 @@
+package skill
+
+import (
+	"strings"
+	"sync"
+	"time"
+)
+
+// Game logic runs at 25 frames per second
+const framesPerSecond = 25
+
+var (
+	skillsByName     map[string]ID
+	skillsByNameOnce sync.Once
+)
+
+// FindByName returns the skill with the given name (as in Skill.Name, case insensitive). Some monster skills share the
+// name with player skills, in that case the lowest ID is returned
+func FindByName(name string) (ID, bool) {
+	skillsByNameOnce.Do(func() {
+		skillsByName = make(map[string]ID, len(Skills))
+		for id, s := range Skills {
+			key := strings.ToLower(s.Name)
+			if existing, found := skillsByName[key]; !found || id < existing {
+				skillsByName[key] = id
+			}
+		}
+	})
+
+	id, found := skillsByName[strings.ToLower(name)]
+
+	return id, found
+}
+
+// ManaCost returns the mana cost of the skill at the given level, using the same formula as the game
+func (s Skill) ManaCost(level int) float64 {
+	if level < 1 {
+		level = 1
+	}
+
+	cost := float64((s.Mana+s.LvlMana*(level-1))<<s.ManaShift) / 256
+
+	return max(cost, float64(s.MinMana))
+}
+
+// CastDelay returns the cooldown of the skill, 0 if it has no cooldown
+func (s Skill) CastDelay() time.Duration {
+	return time.Duration(s.CastDelayFrames) * time.Second / framesPerSecond
+}
+
+// Requirements returns the skills needed to learn this one
+func (s Skill) Requirements() []ID {
+	requirements := make([]ID, 0, 3)
+	for _, name := range []string{s.ReqSkill1, s.ReqSkill2, s.ReqSkill3} {
+		if name == "" {
+			continue
+		}
+		if id, found := FindByName(name); found {
+			requirements = append(requirements, id)
+		}
+	}
+
+	return requirements
+}
+
+// AllRequirements returns the whole prerequisite tree of the skill, each skill is returned only once
+func (s Skill) AllRequirements() []ID {
+	visited := make(map[ID]bool)
+	requirements := make([]ID, 0)
+
+	pending := s.Requirements()
+	for len(pending) > 0 {
+		id := pending[0]
+		pending = pending[1:]
+		if visited[id] {
+			continue
+		}
+		visited[id] = true
+		requirements = append(requirements, id)
+		pending = append(pending, Skills[id].Requirements()...)
+	}
+
+	return requirements
+}
+
+// SynergySkills returns the skills giving synergy bonuses to this one
+func (s Skill) SynergySkills() []ID {
+	synergies := make([]ID, 0, len(s.Synergies))
+	for _, name := range s.Synergies {
+		if id, found := FindByName(name); found {
+			synergies = append(synergies, id)
+		}
+	}
+
+	return synergies
+}
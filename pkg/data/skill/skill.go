@@ -23,6 +23,17 @@ type Skill struct {
 	Class      string
 	ReqSkill1  string
 	ReqSkill2  string
+	ReqSkill3  string
+	MaxLevel   int
+	// Mana cost parameters, see ManaCost
+	Mana      int
+	LvlMana   int
+	MinMana   int
+	ManaShift int
+	// Cooldown in game frames, 0 if the skill has no cooldown
+	CastDelayFrames int
+	// Names of the skills giving synergy bonuses to this one
+	Synergies []string
 }
 
 type Points struct {
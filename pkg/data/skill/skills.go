@@ -3,377 +3,377 @@
 package skill
 
 var Skills = map[ID]Skill{
-	0:   {Name: "Attack", SkillDesc: "attack", ID: 0, LeftSkill: true, RightSkill: true, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: ""},
-	1:   {Name: "Kick", SkillDesc: "kick", ID: 1, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: ""},
-	2:   {Name: "Throw", SkillDesc: "throw", ID: 2, LeftSkill: true, RightSkill: true, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: ""},
-	3:   {Name: "Unsummon", SkillDesc: "unsummon", ID: 3, LeftSkill: false, RightSkill: true, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: ""},
-	4:   {Name: "Left Hand Throw", SkillDesc: "left hand throw", ID: 4, LeftSkill: true, RightSkill: true, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: ""},
-	5:   {Name: "Left Hand Swing", SkillDesc: "left hand swing", ID: 5, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: ""},
-	6:   {Name: "Magic Arrow", SkillDesc: "magic arrow", ID: 6, LeftSkill: true, RightSkill: true, ReqLevel: 1, Class: "ama", ReqSkill1: "", ReqSkill2: ""},
-	7:   {Name: "Fire Arrow", SkillDesc: "fire arrow", ID: 7, LeftSkill: true, RightSkill: true, ReqLevel: 1, Class: "ama", ReqSkill1: "", ReqSkill2: ""},
-	8:   {Name: "Inner Sight", SkillDesc: "inner sight", ID: 8, LeftSkill: false, RightSkill: true, ReqLevel: 1, Class: "ama", ReqSkill1: "", ReqSkill2: ""},
-	9:   {Name: "Critical Strike", SkillDesc: "critical strike", ID: 9, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "ama", ReqSkill1: "", ReqSkill2: ""},
-	10:  {Name: "Jab", SkillDesc: "jab", ID: 10, LeftSkill: true, RightSkill: true, ReqLevel: 1, Class: "ama", ReqSkill1: "", ReqSkill2: ""},
-	11:  {Name: "Cold Arrow", SkillDesc: "cold arrow", ID: 11, LeftSkill: true, RightSkill: true, ReqLevel: 6, Class: "ama", ReqSkill1: "", ReqSkill2: ""},
-	12:  {Name: "Multiple Shot", SkillDesc: "multiple shot", ID: 12, LeftSkill: true, RightSkill: true, ReqLevel: 6, Class: "ama", ReqSkill1: "Magic Arrow", ReqSkill2: ""},
-	13:  {Name: "Dodge", SkillDesc: "dodge", ID: 13, LeftSkill: false, RightSkill: false, ReqLevel: 6, Class: "ama", ReqSkill1: "", ReqSkill2: ""},
-	14:  {Name: "Power Strike", SkillDesc: "power strike", ID: 14, LeftSkill: true, RightSkill: true, ReqLevel: 6, Class: "ama", ReqSkill1: "Jab", ReqSkill2: ""},
-	15:  {Name: "Poison Javelin", SkillDesc: "poison javelin", ID: 15, LeftSkill: true, RightSkill: true, ReqLevel: 6, Class: "ama", ReqSkill1: "", ReqSkill2: ""},
-	16:  {Name: "Exploding Arrow", SkillDesc: "exploding arrow", ID: 16, LeftSkill: true, RightSkill: true, ReqLevel: 12, Class: "ama", ReqSkill1: "Fire Arrow", ReqSkill2: "Multiple Shot"},
-	17:  {Name: "Slow Missiles", SkillDesc: "slow missiles", ID: 17, LeftSkill: false, RightSkill: true, ReqLevel: 12, Class: "ama", ReqSkill1: "Inner Sight", ReqSkill2: ""},
-	18:  {Name: "Avoid", SkillDesc: "avoid", ID: 18, LeftSkill: false, RightSkill: false, ReqLevel: 12, Class: "ama", ReqSkill1: "Dodge", ReqSkill2: ""},
-	19:  {Name: "Impale", SkillDesc: "impale", ID: 19, LeftSkill: true, RightSkill: true, ReqLevel: 12, Class: "ama", ReqSkill1: "Jab", ReqSkill2: ""},
-	20:  {Name: "Lightning Bolt", SkillDesc: "lightning bolt", ID: 20, LeftSkill: true, RightSkill: true, ReqLevel: 12, Class: "ama", ReqSkill1: "Poison Javelin", ReqSkill2: ""},
-	21:  {Name: "Ice Arrow", SkillDesc: "ice arrow", ID: 21, LeftSkill: true, RightSkill: true, ReqLevel: 18, Class: "ama", ReqSkill1: "Cold Arrow", ReqSkill2: ""},
-	22:  {Name: "Guided Arrow", SkillDesc: "guided arrow", ID: 22, LeftSkill: true, RightSkill: true, ReqLevel: 18, Class: "ama", ReqSkill1: "Cold Arrow", ReqSkill2: "Multiple Shot"},
-	23:  {Name: "Penetrate", SkillDesc: "penetrate", ID: 23, LeftSkill: false, RightSkill: false, ReqLevel: 18, Class: "ama", ReqSkill1: "Critical Strike", ReqSkill2: ""},
-	24:  {Name: "Charged Strike", SkillDesc: "charged strike", ID: 24, LeftSkill: true, RightSkill: true, ReqLevel: 18, Class: "ama", ReqSkill1: "Power Strike", ReqSkill2: "Lightning Bolt"},
-	25:  {Name: "Plague Javelin", SkillDesc: "plague javelin", ID: 25, LeftSkill: true, RightSkill: true, ReqLevel: 18, Class: "ama", ReqSkill1: "Lightning Bolt", ReqSkill2: ""},
-	26:  {Name: "Strafe", SkillDesc: "strafe", ID: 26, LeftSkill: true, RightSkill: true, ReqLevel: 24, Class: "ama", ReqSkill1: "Guided Arrow", ReqSkill2: ""},
-	27:  {Name: "Immolation Arrow", SkillDesc: "immolation arrow", ID: 27, LeftSkill: true, RightSkill: true, ReqLevel: 24, Class: "ama", ReqSkill1: "Exploding Arrow", ReqSkill2: ""},
-	28:  {Name: "Dopplezon", SkillDesc: "dopplezon", ID: 28, LeftSkill: false, RightSkill: true, ReqLevel: 24, Class: "ama", ReqSkill1: "Slow Missiles", ReqSkill2: ""},
-	29:  {Name: "Evade", SkillDesc: "evade", ID: 29, LeftSkill: false, RightSkill: false, ReqLevel: 24, Class: "ama", ReqSkill1: "Avoid", ReqSkill2: ""},
-	30:  {Name: "Fend", SkillDesc: "fend", ID: 30, LeftSkill: true, RightSkill: true, ReqLevel: 24, Class: "ama", ReqSkill1: "Impale", ReqSkill2: ""},
-	31:  {Name: "Freezing Arrow", SkillDesc: "freezing arrow", ID: 31, LeftSkill: true, RightSkill: true, ReqLevel: 30, Class: "ama", ReqSkill1: "Ice Arrow", ReqSkill2: ""},
-	32:  {Name: "Valkyrie", SkillDesc: "valkyrie", ID: 32, LeftSkill: false, RightSkill: true, ReqLevel: 30, Class: "ama", ReqSkill1: "Dopplezon", ReqSkill2: "Evade"},
-	33:  {Name: "Pierce", SkillDesc: "pierce", ID: 33, LeftSkill: false, RightSkill: false, ReqLevel: 30, Class: "ama", ReqSkill1: "Penetrate", ReqSkill2: ""},
-	34:  {Name: "Lightning Strike", SkillDesc: "lightning strike", ID: 34, LeftSkill: true, RightSkill: true, ReqLevel: 30, Class: "ama", ReqSkill1: "Charged Strike", ReqSkill2: ""},
-	35:  {Name: "Lightning Fury", SkillDesc: "lightning fury", ID: 35, LeftSkill: true, RightSkill: true, ReqLevel: 30, Class: "ama", ReqSkill1: "Plague Javelin", ReqSkill2: ""},
-	36:  {Name: "Fire Bolt", SkillDesc: "fire bolt", ID: 36, LeftSkill: true, RightSkill: true, ReqLevel: 1, Class: "sor", ReqSkill1: "", ReqSkill2: ""},
-	37:  {Name: "Warmth", SkillDesc: "warmth", ID: 37, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "sor", ReqSkill1: "", ReqSkill2: ""},
-	38:  {Name: "Charged Bolt", SkillDesc: "charged bolt", ID: 38, LeftSkill: true, RightSkill: true, ReqLevel: 1, Class: "sor", ReqSkill1: "", ReqSkill2: ""},
-	39:  {Name: "Ice Bolt", SkillDesc: "ice bolt", ID: 39, LeftSkill: true, RightSkill: true, ReqLevel: 1, Class: "sor", ReqSkill1: "", ReqSkill2: ""},
-	40:  {Name: "Frozen Armor", SkillDesc: "frozen armor", ID: 40, LeftSkill: false, RightSkill: true, ReqLevel: 1, Class: "sor", ReqSkill1: "", ReqSkill2: ""},
-	41:  {Name: "Inferno", SkillDesc: "inferno", ID: 41, LeftSkill: true, RightSkill: true, ReqLevel: 6, Class: "sor", ReqSkill1: "", ReqSkill2: ""},
-	42:  {Name: "Static Field", SkillDesc: "static field", ID: 42, LeftSkill: false, RightSkill: true, ReqLevel: 6, Class: "sor", ReqSkill1: "", ReqSkill2: ""},
-	43:  {Name: "Telekinesis", SkillDesc: "telekinesis", ID: 43, LeftSkill: false, RightSkill: true, ReqLevel: 6, Class: "sor", ReqSkill1: "", ReqSkill2: ""},
-	44:  {Name: "Frost Nova", SkillDesc: "frost nova", ID: 44, LeftSkill: false, RightSkill: true, ReqLevel: 6, Class: "sor", ReqSkill1: "", ReqSkill2: ""},
-	45:  {Name: "Ice Blast", SkillDesc: "ice blast", ID: 45, LeftSkill: true, RightSkill: true, ReqLevel: 6, Class: "sor", ReqSkill1: "Ice Bolt", ReqSkill2: ""},
-	46:  {Name: "Blaze", SkillDesc: "blaze", ID: 46, LeftSkill: false, RightSkill: true, ReqLevel: 12, Class: "sor", ReqSkill1: "Inferno", ReqSkill2: ""},
-	47:  {Name: "Fire Ball", SkillDesc: "fire ball", ID: 47, LeftSkill: true, RightSkill: true, ReqLevel: 12, Class: "sor", ReqSkill1: "Fire Bolt", ReqSkill2: ""},
-	48:  {Name: "Nova", SkillDesc: "nova", ID: 48, LeftSkill: false, RightSkill: true, ReqLevel: 12, Class: "sor", ReqSkill1: "Static Field", ReqSkill2: ""},
-	49:  {Name: "Lightning", SkillDesc: "lightning", ID: 49, LeftSkill: true, RightSkill: true, ReqLevel: 12, Class: "sor", ReqSkill1: "Charged Bolt", ReqSkill2: ""},
-	50:  {Name: "Shiver Armor", SkillDesc: "shiver armor", ID: 50, LeftSkill: false, RightSkill: true, ReqLevel: 12, Class: "sor", ReqSkill1: "Ice Blast", ReqSkill2: "Frozen Armor"},
-	51:  {Name: "Fire Wall", SkillDesc: "fire wall", ID: 51, LeftSkill: false, RightSkill: true, ReqLevel: 18, Class: "sor", ReqSkill1: "Blaze", ReqSkill2: ""},
-	52:  {Name: "Enchant", SkillDesc: "enchant", ID: 52, LeftSkill: false, RightSkill: true, ReqLevel: 18, Class: "sor", ReqSkill1: "Warmth", ReqSkill2: "Fire Ball"},
-	53:  {Name: "Chain Lightning", SkillDesc: "chain lightning", ID: 53, LeftSkill: true, RightSkill: true, ReqLevel: 18, Class: "sor", ReqSkill1: "Lightning", ReqSkill2: ""},
-	54:  {Name: "Teleport", SkillDesc: "teleport", ID: 54, LeftSkill: false, RightSkill: true, ReqLevel: 18, Class: "sor", ReqSkill1: "Telekinesis", ReqSkill2: ""},
-	55:  {Name: "Glacial Spike", SkillDesc: "glacial spike", ID: 55, LeftSkill: true, RightSkill: true, ReqLevel: 18, Class: "sor", ReqSkill1: "Ice Blast", ReqSkill2: ""},
-	56:  {Name: "Meteor", SkillDesc: "meteor", ID: 56, LeftSkill: false, RightSkill: true, ReqLevel: 24, Class: "sor", ReqSkill1: "Fire Ball", ReqSkill2: "Fire Wall"},
-	57:  {Name: "Thunder Storm", SkillDesc: "thunder storm", ID: 57, LeftSkill: false, RightSkill: true, ReqLevel: 24, Class: "sor", ReqSkill1: "Nova", ReqSkill2: "Chain Lightning"},
-	58:  {Name: "Energy Shield", SkillDesc: "energy shield", ID: 58, LeftSkill: false, RightSkill: true, ReqLevel: 24, Class: "sor", ReqSkill1: "Teleport", ReqSkill2: "Chain Lightning"},
-	59:  {Name: "Blizzard", SkillDesc: "blizzard", ID: 59, LeftSkill: false, RightSkill: true, ReqLevel: 24, Class: "sor", ReqSkill1: "Frost Nova", ReqSkill2: "Glacial Spike"},
-	60:  {Name: "Chilling Armor", SkillDesc: "chilling armor", ID: 60, LeftSkill: false, RightSkill: true, ReqLevel: 24, Class: "sor", ReqSkill1: "Shiver Armor", ReqSkill2: ""},
-	61:  {Name: "Fire Mastery", SkillDesc: "fire mastery", ID: 61, LeftSkill: false, RightSkill: false, ReqLevel: 30, Class: "sor", ReqSkill1: "", ReqSkill2: ""},
-	62:  {Name: "Hydra", SkillDesc: "hydra", ID: 62, LeftSkill: false, RightSkill: true, ReqLevel: 30, Class: "sor", ReqSkill1: "Enchant", ReqSkill2: ""},
-	63:  {Name: "Lightning Mastery", SkillDesc: "lightning mastery", ID: 63, LeftSkill: false, RightSkill: false, ReqLevel: 30, Class: "sor", ReqSkill1: "", ReqSkill2: ""},
-	64:  {Name: "Frozen Orb", SkillDesc: "frozen orb", ID: 64, LeftSkill: true, RightSkill: true, ReqLevel: 30, Class: "sor", ReqSkill1: "Blizzard", ReqSkill2: ""},
-	65:  {Name: "Cold Mastery", SkillDesc: "cold mastery", ID: 65, LeftSkill: false, RightSkill: false, ReqLevel: 30, Class: "sor", ReqSkill1: "", ReqSkill2: ""},
-	66:  {Name: "Amplify Damage", SkillDesc: "amplify damage", ID: 66, LeftSkill: false, RightSkill: true, ReqLevel: 1, Class: "nec", ReqSkill1: "", ReqSkill2: ""},
-	67:  {Name: "Teeth", SkillDesc: "teeth", ID: 67, LeftSkill: true, RightSkill: true, ReqLevel: 1, Class: "nec", ReqSkill1: "", ReqSkill2: ""},
-	68:  {Name: "Bone Armor", SkillDesc: "bone armor", ID: 68, LeftSkill: false, RightSkill: true, ReqLevel: 1, Class: "nec", ReqSkill1: "", ReqSkill2: ""},
-	69:  {Name: "Skeleton Mastery", SkillDesc: "skeleton mastery", ID: 69, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "nec", ReqSkill1: "Raise Skeleton", ReqSkill2: ""},
-	70:  {Name: "Raise Skeleton", SkillDesc: "raise skeleton", ID: 70, LeftSkill: false, RightSkill: true, ReqLevel: 1, Class: "nec", ReqSkill1: "", ReqSkill2: ""},
-	71:  {Name: "Dim Vision", SkillDesc: "dim vision", ID: 71, LeftSkill: false, RightSkill: true, ReqLevel: 6, Class: "nec", ReqSkill1: "", ReqSkill2: ""},
-	72:  {Name: "Weaken", SkillDesc: "weaken", ID: 72, LeftSkill: false, RightSkill: true, ReqLevel: 6, Class: "nec", ReqSkill1: "Amplify Damage", ReqSkill2: ""},
-	73:  {Name: "Poison Dagger", SkillDesc: "poison dagger", ID: 73, LeftSkill: true, RightSkill: true, ReqLevel: 6, Class: "nec", ReqSkill1: "", ReqSkill2: ""},
-	74:  {Name: "Corpse Explosion", SkillDesc: "corpse explosion", ID: 74, LeftSkill: false, RightSkill: true, ReqLevel: 6, Class: "nec", ReqSkill1: "Teeth", ReqSkill2: ""},
-	75:  {Name: "Clay Golem", SkillDesc: "clay golem", ID: 75, LeftSkill: false, RightSkill: true, ReqLevel: 6, Class: "nec", ReqSkill1: "", ReqSkill2: ""},
-	76:  {Name: "Iron Maiden", SkillDesc: "iron maiden", ID: 76, LeftSkill: false, RightSkill: true, ReqLevel: 12, Class: "nec", ReqSkill1: "Amplify Damage", ReqSkill2: ""},
-	77:  {Name: "Terror", SkillDesc: "terror", ID: 77, LeftSkill: false, RightSkill: true, ReqLevel: 12, Class: "nec", ReqSkill1: "Weaken", ReqSkill2: ""},
-	78:  {Name: "Bone Wall", SkillDesc: "bone wall", ID: 78, LeftSkill: false, RightSkill: true, ReqLevel: 12, Class: "nec", ReqSkill1: "Bone Armor", ReqSkill2: ""},
-	79:  {Name: "Golem Mastery", SkillDesc: "golem mastery", ID: 79, LeftSkill: false, RightSkill: false, ReqLevel: 12, Class: "nec", ReqSkill1: "Clay Golem", ReqSkill2: ""},
-	80:  {Name: "Raise Skeletal Mage", SkillDesc: "raise skeletal mage", ID: 80, LeftSkill: false, RightSkill: true, ReqLevel: 12, Class: "nec", ReqSkill1: "Raise Skeleton", ReqSkill2: ""},
-	81:  {Name: "Confuse", SkillDesc: "confuse", ID: 81, LeftSkill: false, RightSkill: true, ReqLevel: 18, Class: "nec", ReqSkill1: "Dim Vision", ReqSkill2: ""},
-	82:  {Name: "Life Tap", SkillDesc: "life tap", ID: 82, LeftSkill: false, RightSkill: true, ReqLevel: 18, Class: "nec", ReqSkill1: "Iron Maiden", ReqSkill2: ""},
-	83:  {Name: "Poison Explosion", SkillDesc: "poison explosion", ID: 83, LeftSkill: false, RightSkill: true, ReqLevel: 18, Class: "nec", ReqSkill1: "Poison Dagger", ReqSkill2: "Corpse Explosion"},
-	84:  {Name: "Bone Spear", SkillDesc: "bone spear", ID: 84, LeftSkill: true, RightSkill: true, ReqLevel: 18, Class: "nec", ReqSkill1: "Corpse Explosion", ReqSkill2: ""},
-	85:  {Name: "BloodGolem", SkillDesc: "bloodgolem", ID: 85, LeftSkill: false, RightSkill: true, ReqLevel: 18, Class: "nec", ReqSkill1: "Clay Golem", ReqSkill2: ""},
-	86:  {Name: "Attract", SkillDesc: "attract", ID: 86, LeftSkill: false, RightSkill: true, ReqLevel: 24, Class: "nec", ReqSkill1: "Confuse", ReqSkill2: ""},
-	87:  {Name: "Decrepify", SkillDesc: "decrepify", ID: 87, LeftSkill: false, RightSkill: true, ReqLevel: 24, Class: "nec", ReqSkill1: "Terror", ReqSkill2: ""},
-	88:  {Name: "Bone Prison", SkillDesc: "bone prison", ID: 88, LeftSkill: false, RightSkill: true, ReqLevel: 24, Class: "nec", ReqSkill1: "Bone Wall", ReqSkill2: "Bone Spear"},
-	89:  {Name: "Summon Resist", SkillDesc: "summon resist", ID: 89, LeftSkill: false, RightSkill: false, ReqLevel: 24, Class: "nec", ReqSkill1: "Golem Mastery", ReqSkill2: ""},
-	90:  {Name: "IronGolem", SkillDesc: "irongolem", ID: 90, LeftSkill: false, RightSkill: true, ReqLevel: 24, Class: "nec", ReqSkill1: "BloodGolem", ReqSkill2: ""},
-	91:  {Name: "Lower Resist", SkillDesc: "lower resist", ID: 91, LeftSkill: false, RightSkill: true, ReqLevel: 30, Class: "nec", ReqSkill1: "Life Tap", ReqSkill2: "Decrepify"},
-	92:  {Name: "Poison Nova", SkillDesc: "poison nova", ID: 92, LeftSkill: false, RightSkill: true, ReqLevel: 30, Class: "nec", ReqSkill1: "Poison Explosion", ReqSkill2: ""},
-	93:  {Name: "Bone Spirit", SkillDesc: "bone spirit", ID: 93, LeftSkill: true, RightSkill: true, ReqLevel: 30, Class: "nec", ReqSkill1: "Bone Spear", ReqSkill2: ""},
-	94:  {Name: "FireGolem", SkillDesc: "firegolem", ID: 94, LeftSkill: false, RightSkill: true, ReqLevel: 30, Class: "nec", ReqSkill1: "IronGolem", ReqSkill2: ""},
-	95:  {Name: "Revive", SkillDesc: "revive", ID: 95, LeftSkill: false, RightSkill: true, ReqLevel: 30, Class: "nec", ReqSkill1: "Raise Skeletal Mage", ReqSkill2: "IronGolem"},
-	96:  {Name: "Sacrifice", SkillDesc: "sacrifice", ID: 96, LeftSkill: true, RightSkill: true, ReqLevel: 1, Class: "pal", ReqSkill1: "", ReqSkill2: ""},
-	97:  {Name: "Smite", SkillDesc: "smite", ID: 97, LeftSkill: true, RightSkill: true, ReqLevel: 1, Class: "pal", ReqSkill1: "", ReqSkill2: ""},
-	98:  {Name: "Might", SkillDesc: "might", ID: 98, LeftSkill: false, RightSkill: true, ReqLevel: 1, Class: "pal", ReqSkill1: "", ReqSkill2: ""},
-	99:  {Name: "Prayer", SkillDesc: "prayer", ID: 99, LeftSkill: false, RightSkill: true, ReqLevel: 1, Class: "pal", ReqSkill1: "", ReqSkill2: ""},
-	100: {Name: "Resist Fire", SkillDesc: "resist fire", ID: 100, LeftSkill: false, RightSkill: true, ReqLevel: 1, Class: "pal", ReqSkill1: "", ReqSkill2: ""},
-	101: {Name: "Holy Bolt", SkillDesc: "holy bolt", ID: 101, LeftSkill: true, RightSkill: true, ReqLevel: 6, Class: "pal", ReqSkill1: "", ReqSkill2: ""},
-	102: {Name: "Holy Fire", SkillDesc: "holy fire", ID: 102, LeftSkill: false, RightSkill: true, ReqLevel: 6, Class: "pal", ReqSkill1: "Might", ReqSkill2: ""},
-	103: {Name: "Thorns", SkillDesc: "thorns", ID: 103, LeftSkill: false, RightSkill: true, ReqLevel: 6, Class: "pal", ReqSkill1: "", ReqSkill2: ""},
-	104: {Name: "Defiance", SkillDesc: "defiance", ID: 104, LeftSkill: false, RightSkill: true, ReqLevel: 6, Class: "pal", ReqSkill1: "", ReqSkill2: ""},
-	105: {Name: "Resist Cold", SkillDesc: "resist cold", ID: 105, LeftSkill: false, RightSkill: true, ReqLevel: 6, Class: "pal", ReqSkill1: "", ReqSkill2: ""},
-	106: {Name: "Zeal", SkillDesc: "zeal", ID: 106, LeftSkill: true, RightSkill: true, ReqLevel: 12, Class: "pal", ReqSkill1: "Sacrifice", ReqSkill2: ""},
-	107: {Name: "Charge", SkillDesc: "charge", ID: 107, LeftSkill: true, RightSkill: true, ReqLevel: 12, Class: "pal", ReqSkill1: "Smite", ReqSkill2: ""},
-	108: {Name: "Blessed Aim", SkillDesc: "blessed aim", ID: 108, LeftSkill: false, RightSkill: true, ReqLevel: 12, Class: "pal", ReqSkill1: "Might", ReqSkill2: ""},
-	109: {Name: "Cleansing", SkillDesc: "cleansing", ID: 109, LeftSkill: false, RightSkill: true, ReqLevel: 12, Class: "pal", ReqSkill1: "Prayer", ReqSkill2: ""},
-	110: {Name: "Resist Lightning", SkillDesc: "resist lightning", ID: 110, LeftSkill: false, RightSkill: true, ReqLevel: 12, Class: "pal", ReqSkill1: "", ReqSkill2: ""},
-	111: {Name: "Vengeance", SkillDesc: "vengeance", ID: 111, LeftSkill: true, RightSkill: true, ReqLevel: 18, Class: "pal", ReqSkill1: "Zeal", ReqSkill2: ""},
-	112: {Name: "Blessed Hammer", SkillDesc: "blessed hammer", ID: 112, LeftSkill: true, RightSkill: true, ReqLevel: 18, Class: "pal", ReqSkill1: "Holy Bolt", ReqSkill2: ""},
-	113: {Name: "Concentration", SkillDesc: "concentration", ID: 113, LeftSkill: false, RightSkill: true, ReqLevel: 18, Class: "pal", ReqSkill1: "Blessed Aim", ReqSkill2: ""},
-	114: {Name: "Holy Freeze", SkillDesc: "holy freeze", ID: 114, LeftSkill: false, RightSkill: true, ReqLevel: 18, Class: "pal", ReqSkill1: "Holy Fire", ReqSkill2: ""},
-	115: {Name: "Vigor", SkillDesc: "vigor", ID: 115, LeftSkill: false, RightSkill: true, ReqLevel: 18, Class: "pal", ReqSkill1: "Cleansing", ReqSkill2: "Defiance"},
-	116: {Name: "Conversion", SkillDesc: "conversion", ID: 116, LeftSkill: true, RightSkill: true, ReqLevel: 24, Class: "pal", ReqSkill1: "Vengeance", ReqSkill2: ""},
-	117: {Name: "Holy Shield", SkillDesc: "holy shield", ID: 117, LeftSkill: false, RightSkill: true, ReqLevel: 24, Class: "pal", ReqSkill1: "Charge", ReqSkill2: "Blessed Hammer"},
-	118: {Name: "Holy Shock", SkillDesc: "holy shock", ID: 118, LeftSkill: false, RightSkill: true, ReqLevel: 24, Class: "pal", ReqSkill1: "Holy Freeze", ReqSkill2: ""},
-	119: {Name: "Sanctuary", SkillDesc: "sanctuary", ID: 119, LeftSkill: false, RightSkill: true, ReqLevel: 24, Class: "pal", ReqSkill1: "Thorns", ReqSkill2: "Holy Freeze"},
-	120: {Name: "Meditation", SkillDesc: "meditation", ID: 120, LeftSkill: false, RightSkill: true, ReqLevel: 24, Class: "pal", ReqSkill1: "Cleansing", ReqSkill2: ""},
-	121: {Name: "Fist of the Heavens", SkillDesc: "fist of the heavens", ID: 121, LeftSkill: true, RightSkill: true, ReqLevel: 30, Class: "pal", ReqSkill1: "Blessed Hammer", ReqSkill2: "Conversion"},
-	122: {Name: "Fanaticism", SkillDesc: "fanaticism", ID: 122, LeftSkill: false, RightSkill: true, ReqLevel: 30, Class: "pal", ReqSkill1: "Concentration", ReqSkill2: ""},
-	123: {Name: "Conviction", SkillDesc: "conviction", ID: 123, LeftSkill: false, RightSkill: true, ReqLevel: 30, Class: "pal", ReqSkill1: "Sanctuary", ReqSkill2: ""},
-	124: {Name: "Redemption", SkillDesc: "redemption", ID: 124, LeftSkill: false, RightSkill: true, ReqLevel: 30, Class: "pal", ReqSkill1: "Vigor", ReqSkill2: ""},
-	125: {Name: "Salvation", SkillDesc: "salvation", ID: 125, LeftSkill: false, RightSkill: true, ReqLevel: 30, Class: "pal", ReqSkill1: "", ReqSkill2: ""},
-	126: {Name: "Bash", SkillDesc: "bash", ID: 126, LeftSkill: true, RightSkill: true, ReqLevel: 1, Class: "bar", ReqSkill1: "", ReqSkill2: ""},
-	127: {Name: "Blade Mastery", SkillDesc: "blade mastery", ID: 127, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "bar", ReqSkill1: "", ReqSkill2: ""},
-	128: {Name: "Axe Mastery", SkillDesc: "axe mastery", ID: 128, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "bar", ReqSkill1: "", ReqSkill2: ""},
-	129: {Name: "Mace Mastery", SkillDesc: "mace mastery", ID: 129, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "bar", ReqSkill1: "", ReqSkill2: ""},
-	130: {Name: "Howl", SkillDesc: "howl", ID: 130, LeftSkill: false, RightSkill: true, ReqLevel: 1, Class: "bar", ReqSkill1: "", ReqSkill2: ""},
-	131: {Name: "Find Potion", SkillDesc: "find potion", ID: 131, LeftSkill: false, RightSkill: true, ReqLevel: 1, Class: "bar", ReqSkill1: "", ReqSkill2: ""},
-	132: {Name: "Leap", SkillDesc: "leap", ID: 132, LeftSkill: true, RightSkill: true, ReqLevel: 6, Class: "bar", ReqSkill1: "", ReqSkill2: ""},
-	133: {Name: "Double Swing", SkillDesc: "double swing", ID: 133, LeftSkill: true, RightSkill: true, ReqLevel: 6, Class: "bar", ReqSkill1: "Bash", ReqSkill2: ""},
-	134: {Name: "Pole Arm Mastery", SkillDesc: "pole arm mastery", ID: 134, LeftSkill: false, RightSkill: false, ReqLevel: 6, Class: "bar", ReqSkill1: "", ReqSkill2: ""},
-	135: {Name: "Throwing Mastery", SkillDesc: "throwing mastery", ID: 135, LeftSkill: false, RightSkill: false, ReqLevel: 6, Class: "bar", ReqSkill1: "", ReqSkill2: ""},
-	136: {Name: "Spear Mastery", SkillDesc: "spear mastery", ID: 136, LeftSkill: false, RightSkill: false, ReqLevel: 6, Class: "bar", ReqSkill1: "", ReqSkill2: ""},
-	137: {Name: "Taunt", SkillDesc: "taunt", ID: 137, LeftSkill: false, RightSkill: true, ReqLevel: 6, Class: "bar", ReqSkill1: "Howl", ReqSkill2: ""},
-	138: {Name: "Shout", SkillDesc: "shout", ID: 138, LeftSkill: false, RightSkill: true, ReqLevel: 6, Class: "bar", ReqSkill1: "Howl", ReqSkill2: ""},
-	139: {Name: "Stun", SkillDesc: "stun", ID: 139, LeftSkill: true, RightSkill: true, ReqLevel: 12, Class: "bar", ReqSkill1: "Bash", ReqSkill2: ""},
-	140: {Name: "Double Throw", SkillDesc: "double throw", ID: 140, LeftSkill: true, RightSkill: true, ReqLevel: 12, Class: "bar", ReqSkill1: "Double Swing", ReqSkill2: ""},
-	141: {Name: "Increased Stamina", SkillDesc: "increased stamina", ID: 141, LeftSkill: false, RightSkill: false, ReqLevel: 12, Class: "bar", ReqSkill1: "", ReqSkill2: ""},
-	142: {Name: "Find Item", SkillDesc: "find item", ID: 142, LeftSkill: false, RightSkill: true, ReqLevel: 12, Class: "bar", ReqSkill1: "Find Potion", ReqSkill2: ""},
-	143: {Name: "Leap Attack", SkillDesc: "leap attack", ID: 143, LeftSkill: true, RightSkill: true, ReqLevel: 18, Class: "bar", ReqSkill1: "Leap", ReqSkill2: ""},
-	144: {Name: "Concentrate", SkillDesc: "concentrate", ID: 144, LeftSkill: true, RightSkill: true, ReqLevel: 18, Class: "bar", ReqSkill1: "Stun", ReqSkill2: ""},
-	145: {Name: "Iron Skin", SkillDesc: "iron skin", ID: 145, LeftSkill: false, RightSkill: false, ReqLevel: 18, Class: "bar", ReqSkill1: "", ReqSkill2: ""},
-	146: {Name: "Battle Cry", SkillDesc: "battle cry", ID: 146, LeftSkill: false, RightSkill: true, ReqLevel: 18, Class: "bar", ReqSkill1: "Taunt", ReqSkill2: ""},
-	147: {Name: "Frenzy", SkillDesc: "frenzy", ID: 147, LeftSkill: true, RightSkill: true, ReqLevel: 24, Class: "bar", ReqSkill1: "Double Throw", ReqSkill2: ""},
-	148: {Name: "Increased Speed", SkillDesc: "increased speed", ID: 148, LeftSkill: false, RightSkill: false, ReqLevel: 24, Class: "bar", ReqSkill1: "Increased Stamina", ReqSkill2: ""},
-	149: {Name: "Battle Orders", SkillDesc: "battle orders", ID: 149, LeftSkill: false, RightSkill: true, ReqLevel: 24, Class: "bar", ReqSkill1: "Shout", ReqSkill2: ""},
-	150: {Name: "Grim Ward", SkillDesc: "grim ward", ID: 150, LeftSkill: false, RightSkill: true, ReqLevel: 24, Class: "bar", ReqSkill1: "Find Item", ReqSkill2: ""},
-	151: {Name: "Whirlwind", SkillDesc: "whirlwind", ID: 151, LeftSkill: true, RightSkill: true, ReqLevel: 30, Class: "bar", ReqSkill1: "Leap Attack", ReqSkill2: "Concentrate"},
-	152: {Name: "Berserk", SkillDesc: "berserk", ID: 152, LeftSkill: true, RightSkill: true, ReqLevel: 30, Class: "bar", ReqSkill1: "Concentrate", ReqSkill2: ""},
-	153: {Name: "Natural Resistance", SkillDesc: "natural resistance", ID: 153, LeftSkill: false, RightSkill: false, ReqLevel: 30, Class: "bar", ReqSkill1: "Iron Skin", ReqSkill2: ""},
-	154: {Name: "War Cry", SkillDesc: "war cry", ID: 154, LeftSkill: false, RightSkill: true, ReqLevel: 30, Class: "bar", ReqSkill1: "Battle Cry", ReqSkill2: "Battle Orders"},
-	155: {Name: "Battle Command", SkillDesc: "battle command", ID: 155, LeftSkill: false, RightSkill: true, ReqLevel: 30, Class: "bar", ReqSkill1: "Battle Orders", ReqSkill2: ""},
-	156: {Name: "Fire Hit", SkillDesc: "", ID: 156, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: ""},
-	157: {Name: "UnHolyBolt", SkillDesc: "", ID: 157, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: ""},
-	158: {Name: "SkeletonRaise", SkillDesc: "", ID: 158, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: ""},
-	159: {Name: "MaggotEgg", SkillDesc: "", ID: 159, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: ""},
-	160: {Name: "ShamanFire", SkillDesc: "", ID: 160, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: ""},
-	161: {Name: "MagottUp", SkillDesc: "", ID: 161, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: ""},
-	162: {Name: "MagottDown", SkillDesc: "", ID: 162, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: ""},
-	163: {Name: "MagottLay", SkillDesc: "", ID: 163, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: ""},
-	164: {Name: "AndrialSpray", SkillDesc: "", ID: 164, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: ""},
-	165: {Name: "Jump", SkillDesc: "", ID: 165, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: ""},
-	166: {Name: "Swarm Move", SkillDesc: "", ID: 166, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: ""},
-	167: {Name: "Nest", SkillDesc: "", ID: 167, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: ""},
-	168: {Name: "Quick Strike", SkillDesc: "", ID: 168, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: ""},
-	169: {Name: "VampireFireball", SkillDesc: "", ID: 169, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: ""},
-	170: {Name: "VampireFirewall", SkillDesc: "", ID: 170, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: ""},
-	171: {Name: "VampireMeteor", SkillDesc: "", ID: 171, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: ""},
-	172: {Name: "GargoyleTrap", SkillDesc: "", ID: 172, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: ""},
-	173: {Name: "SpiderLay", SkillDesc: "", ID: 173, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: ""},
-	174: {Name: "VampireHeal", SkillDesc: "", ID: 174, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: ""},
-	175: {Name: "VampireRaise", SkillDesc: "", ID: 175, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: ""},
-	176: {Name: "Submerge", SkillDesc: "", ID: 176, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: ""},
-	177: {Name: "FetishAura", SkillDesc: "", ID: 177, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: ""},
-	178: {Name: "FetishInferno", SkillDesc: "", ID: 178, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: ""},
-	179: {Name: "ZakarumHeal", SkillDesc: "", ID: 179, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: ""},
-	180: {Name: "Emerge", SkillDesc: "", ID: 180, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: ""},
-	181: {Name: "Resurrect", SkillDesc: "", ID: 181, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: ""},
-	182: {Name: "Bestow", SkillDesc: "", ID: 182, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: ""},
-	183: {Name: "MissileSkill1", SkillDesc: "", ID: 183, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: ""},
-	184: {Name: "MonTeleport", SkillDesc: "", ID: 184, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: ""},
-	185: {Name: "PrimeLightning", SkillDesc: "", ID: 185, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: ""},
-	186: {Name: "PrimeBolt", SkillDesc: "", ID: 186, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: ""},
-	187: {Name: "PrimeBlaze", SkillDesc: "", ID: 187, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: ""},
-	188: {Name: "PrimeFirewall", SkillDesc: "", ID: 188, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: ""},
-	189: {Name: "PrimeSpike", SkillDesc: "", ID: 189, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: ""},
-	190: {Name: "PrimeIceNova", SkillDesc: "", ID: 190, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: ""},
-	191: {Name: "PrimePoisonball", SkillDesc: "", ID: 191, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: ""},
-	192: {Name: "PrimePoisonNova", SkillDesc: "", ID: 192, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: ""},
-	193: {Name: "DiabLight", SkillDesc: "", ID: 193, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: ""},
-	194: {Name: "DiabCold", SkillDesc: "", ID: 194, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: ""},
-	195: {Name: "DiabFire", SkillDesc: "", ID: 195, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: ""},
-	196: {Name: "FingerMageSpider", SkillDesc: "", ID: 196, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: ""},
-	197: {Name: "DiabWall", SkillDesc: "firestorm", ID: 197, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: ""},
-	198: {Name: "DiabRun", SkillDesc: "", ID: 198, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: ""},
-	199: {Name: "DiabPrison", SkillDesc: "", ID: 199, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: ""},
-	200: {Name: "PoisonBallTrap", SkillDesc: "", ID: 200, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: ""},
-	201: {Name: "AndyPoisonBolt", SkillDesc: "", ID: 201, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: ""},
-	202: {Name: "HireableMissile", SkillDesc: "", ID: 202, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: ""},
-	203: {Name: "DesertTurret", SkillDesc: "", ID: 203, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: ""},
-	204: {Name: "ArcaneTower", SkillDesc: "", ID: 204, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: ""},
-	205: {Name: "MonBlizzard", SkillDesc: "", ID: 205, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: ""},
-	206: {Name: "Mosquito", SkillDesc: "", ID: 206, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: ""},
-	207: {Name: "CursedBallTrapRight", SkillDesc: "", ID: 207, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: ""},
-	208: {Name: "CursedBallTrapLeft", SkillDesc: "", ID: 208, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: ""},
-	209: {Name: "MonFrozenArmor", SkillDesc: "", ID: 209, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: ""},
-	210: {Name: "MonBoneArmor", SkillDesc: "", ID: 210, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: ""},
-	211: {Name: "MonBoneSpirit", SkillDesc: "", ID: 211, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: ""},
-	212: {Name: "MonCurseCast", SkillDesc: "", ID: 212, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: ""},
-	213: {Name: "HellMeteor", SkillDesc: "", ID: 213, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: ""},
-	214: {Name: "RegurgitatorEat", SkillDesc: "", ID: 214, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: ""},
-	215: {Name: "MonFrenzy", SkillDesc: "", ID: 215, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: ""},
-	216: {Name: "QueenDeath", SkillDesc: "", ID: 216, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: ""},
-	217: {Name: "Scroll of Identify", SkillDesc: "scroll of identify", ID: 217, LeftSkill: false, RightSkill: true, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: ""},
-	218: {Name: "Book of Identify", SkillDesc: "book of identify", ID: 218, LeftSkill: false, RightSkill: true, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: ""},
-	219: {Name: "Scroll of Townportal", SkillDesc: "scroll of townportal", ID: 219, LeftSkill: false, RightSkill: true, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: ""},
-	220: {Name: "Book of Townportal", SkillDesc: "book of townportal", ID: 220, LeftSkill: false, RightSkill: true, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: ""},
-	221: {Name: "Raven", SkillDesc: "raven", ID: 221, LeftSkill: false, RightSkill: true, ReqLevel: 1, Class: "dru", ReqSkill1: "", ReqSkill2: ""},
-	222: {Name: "Plague Poppy", SkillDesc: "plague poppy", ID: 222, LeftSkill: false, RightSkill: true, ReqLevel: 1, Class: "dru", ReqSkill1: "", ReqSkill2: ""},
-	223: {Name: "Wearwolf", SkillDesc: "wearwolf", ID: 223, LeftSkill: false, RightSkill: true, ReqLevel: 1, Class: "dru", ReqSkill1: "", ReqSkill2: ""},
-	224: {Name: "Shape Shifting", SkillDesc: "shape shifting", ID: 224, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "dru", ReqSkill1: "Wearwolf", ReqSkill2: ""},
-	225: {Name: "Firestorm", SkillDesc: "firestorm", ID: 225, LeftSkill: true, RightSkill: true, ReqLevel: 1, Class: "dru", ReqSkill1: "", ReqSkill2: ""},
-	226: {Name: "Oak Sage", SkillDesc: "oak sage", ID: 226, LeftSkill: false, RightSkill: true, ReqLevel: 6, Class: "dru", ReqSkill1: "", ReqSkill2: ""},
-	227: {Name: "Summon Spirit Wolf", SkillDesc: "summon spirit wolf", ID: 227, LeftSkill: false, RightSkill: true, ReqLevel: 6, Class: "dru", ReqSkill1: "Raven", ReqSkill2: ""},
-	228: {Name: "Wearbear", SkillDesc: "wearbear", ID: 228, LeftSkill: false, RightSkill: true, ReqLevel: 6, Class: "dru", ReqSkill1: "", ReqSkill2: ""},
-	229: {Name: "Molten Boulder", SkillDesc: "molten boulder", ID: 229, LeftSkill: true, RightSkill: true, ReqLevel: 6, Class: "dru", ReqSkill1: "Firestorm", ReqSkill2: ""},
-	230: {Name: "Arctic Blast", SkillDesc: "arctic blast", ID: 230, LeftSkill: true, RightSkill: true, ReqLevel: 6, Class: "dru", ReqSkill1: "", ReqSkill2: ""},
-	231: {Name: "Cycle of Life", SkillDesc: "cycle of life", ID: 231, LeftSkill: false, RightSkill: true, ReqLevel: 12, Class: "dru", ReqSkill1: "Plague Poppy", ReqSkill2: ""},
-	232: {Name: "Feral Rage", SkillDesc: "feral rage", ID: 232, LeftSkill: true, RightSkill: true, ReqLevel: 12, Class: "dru", ReqSkill1: "Wearwolf", ReqSkill2: ""},
-	233: {Name: "Maul", SkillDesc: "maul", ID: 233, LeftSkill: true, RightSkill: true, ReqLevel: 12, Class: "dru", ReqSkill1: "Wearbear", ReqSkill2: ""},
-	234: {Name: "Eruption", SkillDesc: "eruption", ID: 234, LeftSkill: false, RightSkill: true, ReqLevel: 12, Class: "dru", ReqSkill1: "Molten Boulder", ReqSkill2: ""},
-	235: {Name: "Cyclone Armor", SkillDesc: "cyclone armor", ID: 235, LeftSkill: false, RightSkill: true, ReqLevel: 12, Class: "dru", ReqSkill1: "Arctic Blast", ReqSkill2: ""},
-	236: {Name: "Heart of Wolverine", SkillDesc: "heart of wolverine", ID: 236, LeftSkill: false, RightSkill: true, ReqLevel: 18, Class: "dru", ReqSkill1: "Oak Sage", ReqSkill2: ""},
-	237: {Name: "Summon Fenris", SkillDesc: "summon fenris", ID: 237, LeftSkill: false, RightSkill: true, ReqLevel: 18, Class: "dru", ReqSkill1: "Oak Sage", ReqSkill2: "Summon Spirit Wolf"},
-	238: {Name: "Rabies", SkillDesc: "rabies", ID: 238, LeftSkill: true, RightSkill: true, ReqLevel: 18, Class: "dru", ReqSkill1: "Feral Rage", ReqSkill2: ""},
-	239: {Name: "Fire Claws", SkillDesc: "fire claws", ID: 239, LeftSkill: true, RightSkill: true, ReqLevel: 18, Class: "dru", ReqSkill1: "Feral Rage", ReqSkill2: "Maul"},
-	240: {Name: "Twister", SkillDesc: "twister", ID: 240, LeftSkill: true, RightSkill: true, ReqLevel: 18, Class: "dru", ReqSkill1: "Cyclone Armor", ReqSkill2: ""},
-	241: {Name: "Vines", SkillDesc: "vines", ID: 241, LeftSkill: false, RightSkill: true, ReqLevel: 24, Class: "dru", ReqSkill1: "Cycle of Life", ReqSkill2: ""},
-	242: {Name: "Hunger", SkillDesc: "hunger", ID: 242, LeftSkill: true, RightSkill: true, ReqLevel: 24, Class: "dru", ReqSkill1: "Fire Claws", ReqSkill2: ""},
-	243: {Name: "Shock Wave", SkillDesc: "shock wave", ID: 243, LeftSkill: true, RightSkill: true, ReqLevel: 24, Class: "dru", ReqSkill1: "Maul", ReqSkill2: ""},
-	244: {Name: "Volcano", SkillDesc: "volcano", ID: 244, LeftSkill: false, RightSkill: true, ReqLevel: 24, Class: "dru", ReqSkill1: "Eruption", ReqSkill2: ""},
-	245: {Name: "Tornado", SkillDesc: "tornado", ID: 245, LeftSkill: true, RightSkill: true, ReqLevel: 24, Class: "dru", ReqSkill1: "Twister", ReqSkill2: ""},
-	246: {Name: "Spirit of Barbs", SkillDesc: "spirit of barbs", ID: 246, LeftSkill: false, RightSkill: true, ReqLevel: 30, Class: "dru", ReqSkill1: "Heart of Wolverine", ReqSkill2: ""},
-	247: {Name: "Summon Grizzly", SkillDesc: "summon grizzly", ID: 247, LeftSkill: false, RightSkill: true, ReqLevel: 30, Class: "dru", ReqSkill1: "Summon Fenris", ReqSkill2: ""},
-	248: {Name: "Fury", SkillDesc: "fury", ID: 248, LeftSkill: true, RightSkill: true, ReqLevel: 30, Class: "dru", ReqSkill1: "Rabies", ReqSkill2: ""},
-	249: {Name: "Armageddon", SkillDesc: "armageddon", ID: 249, LeftSkill: false, RightSkill: true, ReqLevel: 30, Class: "dru", ReqSkill1: "Volcano", ReqSkill2: ""},
-	250: {Name: "Hurricane", SkillDesc: "hurricane", ID: 250, LeftSkill: false, RightSkill: true, ReqLevel: 30, Class: "dru", ReqSkill1: "Tornado", ReqSkill2: ""},
-	251: {Name: "Fire Trauma", SkillDesc: "fire trauma", ID: 251, LeftSkill: true, RightSkill: true, ReqLevel: 1, Class: "ass", ReqSkill1: "", ReqSkill2: ""},
-	252: {Name: "Claw Mastery", SkillDesc: "claw mastery", ID: 252, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "ass", ReqSkill1: "", ReqSkill2: ""},
-	253: {Name: "Psychic Hammer", SkillDesc: "psychic hammer", ID: 253, LeftSkill: false, RightSkill: true, ReqLevel: 1, Class: "ass", ReqSkill1: "", ReqSkill2: ""},
-	254: {Name: "Tiger Strike", SkillDesc: "tiger strike", ID: 254, LeftSkill: true, RightSkill: true, ReqLevel: 1, Class: "ass", ReqSkill1: "", ReqSkill2: ""},
-	255: {Name: "Dragon Talon", SkillDesc: "dragon talon", ID: 255, LeftSkill: true, RightSkill: true, ReqLevel: 1, Class: "ass", ReqSkill1: "", ReqSkill2: ""},
-	256: {Name: "Shock Field", SkillDesc: "shock field", ID: 256, LeftSkill: true, RightSkill: true, ReqLevel: 6, Class: "ass", ReqSkill1: "Fire Trauma", ReqSkill2: ""},
-	257: {Name: "Blade Sentinel", SkillDesc: "blade sentinel", ID: 257, LeftSkill: true, RightSkill: true, ReqLevel: 6, Class: "ass", ReqSkill1: "", ReqSkill2: ""},
-	258: {Name: "Quickness", SkillDesc: "quickness", ID: 258, LeftSkill: false, RightSkill: true, ReqLevel: 6, Class: "ass", ReqSkill1: "Claw Mastery", ReqSkill2: ""},
-	259: {Name: "Fists of Fire", SkillDesc: "fists of fire", ID: 259, LeftSkill: true, RightSkill: true, ReqLevel: 6, Class: "ass", ReqSkill1: "", ReqSkill2: ""},
-	260: {Name: "Dragon Claw", SkillDesc: "dragon claw", ID: 260, LeftSkill: true, RightSkill: true, ReqLevel: 6, Class: "ass", ReqSkill1: "Dragon Talon", ReqSkill2: ""},
-	261: {Name: "Charged Bolt Sentry", SkillDesc: "charged bolt sentry", ID: 261, LeftSkill: false, RightSkill: true, ReqLevel: 12, Class: "ass", ReqSkill1: "Shock Field", ReqSkill2: ""},
-	262: {Name: "Wake of Fire Sentry", SkillDesc: "wake of fire sentry", ID: 262, LeftSkill: false, RightSkill: true, ReqLevel: 12, Class: "ass", ReqSkill1: "Fire Trauma", ReqSkill2: ""},
-	263: {Name: "Weapon Block", SkillDesc: "weapon block", ID: 263, LeftSkill: false, RightSkill: false, ReqLevel: 12, Class: "ass", ReqSkill1: "Claw Mastery", ReqSkill2: ""},
-	264: {Name: "Cloak of Shadows", SkillDesc: "cloak of shadows", ID: 264, LeftSkill: false, RightSkill: true, ReqLevel: 12, Class: "ass", ReqSkill1: "Psychic Hammer", ReqSkill2: ""},
-	265: {Name: "Cobra Strike", SkillDesc: "cobra strike", ID: 265, LeftSkill: true, RightSkill: true, ReqLevel: 12, Class: "ass", ReqSkill1: "Tiger Strike", ReqSkill2: ""},
-	266: {Name: "Blade Fury", SkillDesc: "blade fury", ID: 266, LeftSkill: true, RightSkill: true, ReqLevel: 18, Class: "ass", ReqSkill1: "Blade Sentinel", ReqSkill2: "Wake of Fire Sentry"},
-	267: {Name: "Fade", SkillDesc: "fade", ID: 267, LeftSkill: false, RightSkill: true, ReqLevel: 18, Class: "ass", ReqSkill1: "Quickness", ReqSkill2: ""},
-	268: {Name: "Shadow Warrior", SkillDesc: "shadow warrior", ID: 268, LeftSkill: false, RightSkill: true, ReqLevel: 18, Class: "ass", ReqSkill1: "Cloak of Shadows", ReqSkill2: "Weapon Block"},
-	269: {Name: "Claws of Thunder", SkillDesc: "claws of thunder", ID: 269, LeftSkill: true, RightSkill: true, ReqLevel: 18, Class: "ass", ReqSkill1: "Fists of Fire", ReqSkill2: ""},
-	270: {Name: "Dragon Tail", SkillDesc: "dragon tail", ID: 270, LeftSkill: true, RightSkill: true, ReqLevel: 18, Class: "ass", ReqSkill1: "Dragon Claw", ReqSkill2: ""},
-	271: {Name: "Lightning Sentry", SkillDesc: "lightning sentry", ID: 271, LeftSkill: false, RightSkill: true, ReqLevel: 24, Class: "ass", ReqSkill1: "Charged Bolt Sentry", ReqSkill2: ""},
-	272: {Name: "Inferno Sentry", SkillDesc: "inferno sentry", ID: 272, LeftSkill: false, RightSkill: true, ReqLevel: 24, Class: "ass", ReqSkill1: "Wake of Fire Sentry", ReqSkill2: ""},
-	273: {Name: "Mind Blast", SkillDesc: "mind blast", ID: 273, LeftSkill: false, RightSkill: true, ReqLevel: 24, Class: "ass", ReqSkill1: "Cloak of Shadows", ReqSkill2: ""},
-	274: {Name: "Blades of Ice", SkillDesc: "blades of ice", ID: 274, LeftSkill: true, RightSkill: true, ReqLevel: 24, Class: "ass", ReqSkill1: "Claws of Thunder", ReqSkill2: ""},
-	275: {Name: "Dragon Flight", SkillDesc: "dragon flight", ID: 275, LeftSkill: true, RightSkill: true, ReqLevel: 24, Class: "ass", ReqSkill1: "Dragon Tail", ReqSkill2: ""},
-	276: {Name: "Death Sentry", SkillDesc: "death sentry", ID: 276, LeftSkill: false, RightSkill: true, ReqLevel: 30, Class: "ass", ReqSkill1: "Lightning Sentry", ReqSkill2: ""},
-	277: {Name: "Blade Shield", SkillDesc: "blade shield", ID: 277, LeftSkill: false, RightSkill: true, ReqLevel: 30, Class: "ass", ReqSkill1: "Blade Fury", ReqSkill2: ""},
-	278: {Name: "Venom", SkillDesc: "venom", ID: 278, LeftSkill: false, RightSkill: true, ReqLevel: 30, Class: "ass", ReqSkill1: "Fade", ReqSkill2: ""},
-	279: {Name: "Shadow Master", SkillDesc: "shadow master", ID: 279, LeftSkill: false, RightSkill: true, ReqLevel: 30, Class: "ass", ReqSkill1: "Shadow Warrior", ReqSkill2: ""},
-	280: {Name: "Royal Strike", SkillDesc: "royal strike", ID: 280, LeftSkill: true, RightSkill: true, ReqLevel: 30, Class: "ass", ReqSkill1: "Cobra Strike", ReqSkill2: "Blades of Ice"},
-	281: {Name: "Wake Of Destruction Sentry", SkillDesc: "", ID: 281, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: ""},
-	282: {Name: "Imp Inferno", SkillDesc: "", ID: 282, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: ""},
-	283: {Name: "Imp Fireball", SkillDesc: "", ID: 283, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: ""},
-	284: {Name: "Baal Taunt", SkillDesc: "", ID: 284, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: ""},
-	285: {Name: "Baal Corpse Explode", SkillDesc: "", ID: 285, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: ""},
-	286: {Name: "Baal Monster Spawn", SkillDesc: "", ID: 286, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: ""},
-	287: {Name: "Catapult Charged Ball", SkillDesc: "", ID: 287, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: ""},
-	288: {Name: "Catapult Spike Ball", SkillDesc: "", ID: 288, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: ""},
-	289: {Name: "Suck Blood", SkillDesc: "", ID: 289, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: ""},
-	290: {Name: "Cry Help", SkillDesc: "", ID: 290, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: ""},
-	291: {Name: "Healing Vortex", SkillDesc: "", ID: 291, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: ""},
-	292: {Name: "Teleport 2", SkillDesc: "", ID: 292, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: ""},
-	293: {Name: "Self-resurrect", SkillDesc: "", ID: 293, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: ""},
-	294: {Name: "Vine Attack", SkillDesc: "", ID: 294, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: ""},
-	295: {Name: "Overseer Whip", SkillDesc: "", ID: 295, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: ""},
-	296: {Name: "Barbs Aura", SkillDesc: "", ID: 296, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: ""},
-	297: {Name: "Wolverine Aura", SkillDesc: "", ID: 297, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: ""},
-	298: {Name: "Oak Sage Aura", SkillDesc: "", ID: 298, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: ""},
-	299: {Name: "Imp Fire Missile", SkillDesc: "", ID: 299, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: ""},
-	300: {Name: "Impregnate", SkillDesc: "", ID: 300, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: ""},
-	301: {Name: "Siege Beast Stomp", SkillDesc: "", ID: 301, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: ""},
-	302: {Name: "MinionSpawner", SkillDesc: "", ID: 302, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: ""},
-	303: {Name: "CatapultBlizzard", SkillDesc: "", ID: 303, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: ""},
-	304: {Name: "CatapultPlague", SkillDesc: "", ID: 304, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: ""},
-	305: {Name: "CatapultMeteor", SkillDesc: "", ID: 305, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: ""},
-	306: {Name: "BoltSentry", SkillDesc: "", ID: 306, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: ""},
-	307: {Name: "CorpseCycler", SkillDesc: "", ID: 307, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: ""},
-	308: {Name: "DeathMaul", SkillDesc: "", ID: 308, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: ""},
-	309: {Name: "Defense Curse", SkillDesc: "", ID: 309, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: ""},
-	310: {Name: "Blood Mana", SkillDesc: "", ID: 310, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: ""},
-	311: {Name: "mon inferno sentry", SkillDesc: "", ID: 311, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: ""},
-	312: {Name: "mon death sentry", SkillDesc: "", ID: 312, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: ""},
-	313: {Name: "sentry lightning", SkillDesc: "", ID: 313, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: ""},
-	314: {Name: "fenris rage", SkillDesc: "", ID: 314, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: ""},
-	315: {Name: "Baal Tentacle", SkillDesc: "", ID: 315, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: ""},
-	316: {Name: "Baal Nova", SkillDesc: "", ID: 316, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: ""},
-	317: {Name: "Baal Inferno", SkillDesc: "", ID: 317, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: ""},
-	318: {Name: "Baal Cold Missiles", SkillDesc: "", ID: 318, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: ""},
-	319: {Name: "MegademonInferno", SkillDesc: "", ID: 319, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: ""},
-	320: {Name: "EvilHutSpawner", SkillDesc: "", ID: 320, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: ""},
-	321: {Name: "CountessFirewall", SkillDesc: "", ID: 321, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: ""},
-	322: {Name: "ImpBolt", SkillDesc: "", ID: 322, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: ""},
-	323: {Name: "Horror Arctic Blast", SkillDesc: "", ID: 323, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: ""},
-	324: {Name: "death sentry ltng", SkillDesc: "", ID: 324, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: ""},
-	325: {Name: "VineCycler", SkillDesc: "", ID: 325, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: ""},
-	326: {Name: "BearSmite", SkillDesc: "", ID: 326, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: ""},
-	327: {Name: "Resurrect2", SkillDesc: "", ID: 327, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: ""},
-	328: {Name: "BloodLordFrenzy", SkillDesc: "", ID: 328, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: ""},
-	329: {Name: "Baal Teleport", SkillDesc: "", ID: 329, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: ""},
-	330: {Name: "Imp Teleport", SkillDesc: "", ID: 330, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: ""},
-	331: {Name: "Baal Clone Teleport", SkillDesc: "", ID: 331, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: ""},
-	332: {Name: "ZakarumLightning", SkillDesc: "", ID: 332, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: ""},
-	333: {Name: "VampireMissile", SkillDesc: "", ID: 333, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: ""},
-	334: {Name: "MephistoMissile", SkillDesc: "", ID: 334, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: ""},
-	335: {Name: "DoomKnightMissile", SkillDesc: "", ID: 335, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: ""},
-	336: {Name: "RogueMissile", SkillDesc: "", ID: 336, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: ""},
-	337: {Name: "HydraMissile", SkillDesc: "", ID: 337, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: ""},
-	338: {Name: "NecromageMissile", SkillDesc: "", ID: 338, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: ""},
-	339: {Name: "MonBow", SkillDesc: "", ID: 339, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: ""},
-	340: {Name: "MonFireArrow", SkillDesc: "", ID: 340, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: ""},
-	341: {Name: "MonColdArrow", SkillDesc: "", ID: 341, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: ""},
-	342: {Name: "MonExplodingArrow", SkillDesc: "", ID: 342, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: ""},
-	343: {Name: "MonFreezingArrow", SkillDesc: "", ID: 343, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: ""},
-	344: {Name: "MonPowerStrike", SkillDesc: "", ID: 344, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: ""},
-	345: {Name: "SuccubusBolt", SkillDesc: "", ID: 345, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: ""},
-	346: {Name: "MephFrostNova", SkillDesc: "", ID: 346, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: ""},
-	347: {Name: "MonIceSpear", SkillDesc: "", ID: 347, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: ""},
-	348: {Name: "ShamanIce", SkillDesc: "", ID: 348, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: ""},
-	349: {Name: "Diablogeddon", SkillDesc: "", ID: 349, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: ""},
-	350: {Name: "Delerium Change", SkillDesc: "delerium change", ID: 350, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: ""},
-	351: {Name: "NihlathakCorpseExplosion", SkillDesc: "", ID: 351, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: ""},
-	352: {Name: "SerpentCharge", SkillDesc: "", ID: 352, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: ""},
-	353: {Name: "Trap Nova", SkillDesc: "", ID: 353, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: ""},
-	354: {Name: "UnHolyBoltEx", SkillDesc: "", ID: 354, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: ""},
-	355: {Name: "ShamanFireEx", SkillDesc: "", ID: 355, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: ""},
-	356: {Name: "Imp Fire Missile Ex", SkillDesc: "", ID: 356, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: ""},
-	357: {Name: "Interact", SkillDesc: "interact", ID: 357, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: ""},
-	358: {Name: "Loot", SkillDesc: "loot", ID: 358, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: ""},
-	359: {Name: "TownPortal", SkillDesc: "townportal", ID: 359, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: ""},
-	360: {Name: "EmoteWheel", SkillDesc: "emotewheel", ID: 360, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: ""},
-	361: {Name: "SwapWeapons", SkillDesc: "swapweapons", ID: 361, LeftSkill: false, RightSkill: false, ReqLevel: 0, Class: "", ReqSkill1: "", ReqSkill2: ""},
-	362: {Name: "Map", SkillDesc: "map", ID: 362, LeftSkill: false, RightSkill: false, ReqLevel: 0, Class: "", ReqSkill1: "", ReqSkill2: ""},
-	363: {Name: "ShowItems", SkillDesc: "showitems", ID: 363, LeftSkill: false, RightSkill: false, ReqLevel: 0, Class: "", ReqSkill1: "", ReqSkill2: ""},
-	364: {Name: "RunToggle", SkillDesc: "runtoggle", ID: 364, LeftSkill: false, RightSkill: false, ReqLevel: 0, Class: "", ReqSkill1: "", ReqSkill2: ""},
-	365: {Name: "MonHolyFreeze", SkillDesc: "", ID: 365, LeftSkill: false, RightSkill: true, ReqLevel: 18, Class: "", ReqSkill1: "", ReqSkill2: ""},
-	366: {Name: "MonLeap", SkillDesc: "", ID: 366, LeftSkill: false, RightSkill: false, ReqLevel: 6, Class: "", ReqSkill1: "", ReqSkill2: ""},
-	367: {Name: "MonLeapAttack", SkillDesc: "", ID: 367, LeftSkill: false, RightSkill: false, ReqLevel: 18, Class: "", ReqSkill1: "", ReqSkill2: ""},
-	368: {Name: "MonHolyFire", SkillDesc: "", ID: 368, LeftSkill: false, RightSkill: true, ReqLevel: 6, Class: "", ReqSkill1: "", ReqSkill2: ""},
-	369: {Name: "MonHolyShock", SkillDesc: "", ID: 369, LeftSkill: false, RightSkill: true, ReqLevel: 24, Class: "", ReqSkill1: "", ReqSkill2: ""},
-	370: {Name: "CubeLoot", SkillDesc: "cubeloot", ID: 370, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: ""},
-	371: {Name: "Mark of the Bear", SkillDesc: "mark of the bear", ID: 371, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: ""},
-	372: {Name: "Mark of the Wolf", SkillDesc: "mark of the wolf", ID: 372, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: ""},
+	0:   {Name: "Attack", SkillDesc: "attack", ID: 0, LeftSkill: true, RightSkill: true, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 0, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 8, CastDelayFrames: 0, Synergies: nil},
+	1:   {Name: "Kick", SkillDesc: "kick", ID: 1, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 0, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 8, CastDelayFrames: 0, Synergies: nil},
+	2:   {Name: "Throw", SkillDesc: "throw", ID: 2, LeftSkill: true, RightSkill: true, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 0, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 8, CastDelayFrames: 0, Synergies: nil},
+	3:   {Name: "Unsummon", SkillDesc: "unsummon", ID: 3, LeftSkill: false, RightSkill: true, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 0, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 8, CastDelayFrames: 0, Synergies: nil},
+	4:   {Name: "Left Hand Throw", SkillDesc: "left hand throw", ID: 4, LeftSkill: true, RightSkill: true, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 0, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 8, CastDelayFrames: 0, Synergies: nil},
+	5:   {Name: "Left Hand Swing", SkillDesc: "left hand swing", ID: 5, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 0, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 8, CastDelayFrames: 0, Synergies: nil},
+	6:   {Name: "Magic Arrow", SkillDesc: "magic arrow", ID: 6, LeftSkill: true, RightSkill: true, ReqLevel: 1, Class: "ama", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 12, LvlMana: -1, MinMana: 0, ManaShift: 5, CastDelayFrames: 0, Synergies: nil},
+	7:   {Name: "Fire Arrow", SkillDesc: "fire arrow", ID: 7, LeftSkill: true, RightSkill: true, ReqLevel: 1, Class: "ama", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 24, LvlMana: 0, MinMana: 1, ManaShift: 5, CastDelayFrames: 0, Synergies: []string{"Exploding Arrow"}},
+	8:   {Name: "Inner Sight", SkillDesc: "inner sight", ID: 8, LeftSkill: false, RightSkill: true, ReqLevel: 1, Class: "ama", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 10, LvlMana: 0, MinMana: 1, ManaShift: 7, CastDelayFrames: 0, Synergies: nil},
+	9:   {Name: "Critical Strike", SkillDesc: "critical strike", ID: 9, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "ama", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 8, CastDelayFrames: 0, Synergies: nil},
+	10:  {Name: "Jab", SkillDesc: "jab", ID: 10, LeftSkill: true, RightSkill: true, ReqLevel: 1, Class: "ama", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 8, LvlMana: 1, MinMana: 1, ManaShift: 6, CastDelayFrames: 0, Synergies: nil},
+	11:  {Name: "Cold Arrow", SkillDesc: "cold arrow", ID: 11, LeftSkill: true, RightSkill: true, ReqLevel: 6, Class: "ama", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 28, LvlMana: 1, MinMana: 1, ManaShift: 5, CastDelayFrames: 0, Synergies: []string{"Ice Arrow"}},
+	12:  {Name: "Multiple Shot", SkillDesc: "multiple shot", ID: 12, LeftSkill: true, RightSkill: true, ReqLevel: 6, Class: "ama", ReqSkill1: "Magic Arrow", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 4, LvlMana: 1, MinMana: 1, ManaShift: 8, CastDelayFrames: 0, Synergies: nil},
+	13:  {Name: "Dodge", SkillDesc: "dodge", ID: 13, LeftSkill: false, RightSkill: false, ReqLevel: 6, Class: "ama", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 8, CastDelayFrames: 0, Synergies: nil},
+	14:  {Name: "Power Strike", SkillDesc: "power strike", ID: 14, LeftSkill: true, RightSkill: true, ReqLevel: 6, Class: "ama", ReqSkill1: "Jab", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 8, LvlMana: 1, MinMana: 1, ManaShift: 6, CastDelayFrames: 0, Synergies: []string{"Lightning Strike", "Lightning Bolt", "Charged Strike"}},
+	15:  {Name: "Poison Javelin", SkillDesc: "poison javelin", ID: 15, LeftSkill: true, RightSkill: true, ReqLevel: 6, Class: "ama", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 16, LvlMana: 1, MinMana: 1, ManaShift: 6, CastDelayFrames: 15, Synergies: []string{"Plague Javelin"}},
+	16:  {Name: "Exploding Arrow", SkillDesc: "exploding arrow", ID: 16, LeftSkill: true, RightSkill: true, ReqLevel: 12, Class: "ama", ReqSkill1: "Fire Arrow", ReqSkill2: "Multiple Shot", ReqSkill3: "", MaxLevel: 20, Mana: 20, LvlMana: 1, MinMana: 1, ManaShift: 6, CastDelayFrames: 0, Synergies: []string{"Fire Arrow"}},
+	17:  {Name: "Slow Missiles", SkillDesc: "slow missiles", ID: 17, LeftSkill: false, RightSkill: true, ReqLevel: 12, Class: "ama", ReqSkill1: "Inner Sight", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 10, LvlMana: 0, MinMana: 1, ManaShift: 7, CastDelayFrames: 0, Synergies: nil},
+	18:  {Name: "Avoid", SkillDesc: "avoid", ID: 18, LeftSkill: false, RightSkill: false, ReqLevel: 12, Class: "ama", ReqSkill1: "Dodge", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 8, CastDelayFrames: 0, Synergies: nil},
+	19:  {Name: "Impale", SkillDesc: "impale", ID: 19, LeftSkill: true, RightSkill: true, ReqLevel: 12, Class: "ama", ReqSkill1: "Jab", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 3, LvlMana: 0, MinMana: 1, ManaShift: 8, CastDelayFrames: 0, Synergies: nil},
+	20:  {Name: "Lightning Bolt", SkillDesc: "lightning bolt", ID: 20, LeftSkill: true, RightSkill: true, ReqLevel: 12, Class: "ama", ReqSkill1: "Poison Javelin", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 24, LvlMana: 1, MinMana: 1, ManaShift: 6, CastDelayFrames: 0, Synergies: []string{"Lightning Strike", "Power Strike", "Charged Strike", "Lightning Fury"}},
+	21:  {Name: "Ice Arrow", SkillDesc: "ice arrow", ID: 21, LeftSkill: true, RightSkill: true, ReqLevel: 18, Class: "ama", ReqSkill1: "Cold Arrow", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 16, LvlMana: 1, MinMana: 1, ManaShift: 6, CastDelayFrames: 0, Synergies: []string{"Cold Arrow", "Freezing Arrow"}},
+	22:  {Name: "Guided Arrow", SkillDesc: "guided arrow", ID: 22, LeftSkill: true, RightSkill: true, ReqLevel: 18, Class: "ama", ReqSkill1: "Cold Arrow", ReqSkill2: "Multiple Shot", ReqSkill3: "", MaxLevel: 20, Mana: 32, LvlMana: -1, MinMana: 1, ManaShift: 6, CastDelayFrames: 0, Synergies: nil},
+	23:  {Name: "Penetrate", SkillDesc: "penetrate", ID: 23, LeftSkill: false, RightSkill: false, ReqLevel: 18, Class: "ama", ReqSkill1: "Critical Strike", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 8, CastDelayFrames: 0, Synergies: nil},
+	24:  {Name: "Charged Strike", SkillDesc: "charged strike", ID: 24, LeftSkill: true, RightSkill: true, ReqLevel: 18, Class: "ama", ReqSkill1: "Power Strike", ReqSkill2: "Lightning Bolt", ReqSkill3: "", MaxLevel: 20, Mana: 16, LvlMana: 1, MinMana: 1, ManaShift: 6, CastDelayFrames: 0, Synergies: []string{"Lightning Strike", "Lightning Bolt", "Power Strike"}},
+	25:  {Name: "Plague Javelin", SkillDesc: "plague javelin", ID: 25, LeftSkill: true, RightSkill: true, ReqLevel: 18, Class: "ama", ReqSkill1: "Lightning Bolt", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 14, LvlMana: 1, MinMana: 1, ManaShift: 7, CastDelayFrames: 25, Synergies: []string{"Poison Javelin"}},
+	26:  {Name: "Strafe", SkillDesc: "strafe", ID: 26, LeftSkill: true, RightSkill: true, ReqLevel: 24, Class: "ama", ReqSkill1: "Guided Arrow", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 11, LvlMana: 0, MinMana: 1, ManaShift: 8, CastDelayFrames: 0, Synergies: nil},
+	27:  {Name: "Immolation Arrow", SkillDesc: "immolation arrow", ID: 27, LeftSkill: true, RightSkill: true, ReqLevel: 24, Class: "ama", ReqSkill1: "Exploding Arrow", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 24, LvlMana: 1, MinMana: 1, ManaShift: 6, CastDelayFrames: 15, Synergies: []string{"Exploding Arrow"}},
+	28:  {Name: "Dopplezon", SkillDesc: "dopplezon", ID: 28, LeftSkill: false, RightSkill: true, ReqLevel: 24, Class: "ama", ReqSkill1: "Slow Missiles", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 76, LvlMana: -3, MinMana: 1, ManaShift: 6, CastDelayFrames: 0, Synergies: nil},
+	29:  {Name: "Evade", SkillDesc: "evade", ID: 29, LeftSkill: false, RightSkill: false, ReqLevel: 24, Class: "ama", ReqSkill1: "Avoid", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 8, CastDelayFrames: 0, Synergies: nil},
+	30:  {Name: "Fend", SkillDesc: "fend", ID: 30, LeftSkill: true, RightSkill: true, ReqLevel: 24, Class: "ama", ReqSkill1: "Impale", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 5, LvlMana: 0, MinMana: 1, ManaShift: 8, CastDelayFrames: 0, Synergies: nil},
+	31:  {Name: "Freezing Arrow", SkillDesc: "freezing arrow", ID: 31, LeftSkill: true, RightSkill: true, ReqLevel: 30, Class: "ama", ReqSkill1: "Ice Arrow", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 36, LvlMana: 1, MinMana: 1, ManaShift: 6, CastDelayFrames: 0, Synergies: []string{"Cold Arrow", "Ice Arrow"}},
+	32:  {Name: "Valkyrie", SkillDesc: "valkyrie", ID: 32, LeftSkill: false, RightSkill: true, ReqLevel: 30, Class: "ama", ReqSkill1: "Dopplezon", ReqSkill2: "Evade", ReqSkill3: "", MaxLevel: 20, Mana: 25, LvlMana: 1, MinMana: 1, ManaShift: 8, CastDelayFrames: 15, Synergies: nil},
+	33:  {Name: "Pierce", SkillDesc: "pierce", ID: 33, LeftSkill: false, RightSkill: false, ReqLevel: 30, Class: "ama", ReqSkill1: "Penetrate", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 8, CastDelayFrames: 0, Synergies: nil},
+	34:  {Name: "Lightning Strike", SkillDesc: "lightning strike", ID: 34, LeftSkill: true, RightSkill: true, ReqLevel: 30, Class: "ama", ReqSkill1: "Charged Strike", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 9, LvlMana: 0, MinMana: 1, ManaShift: 8, CastDelayFrames: 0, Synergies: []string{"Charged Strike", "Lightning Bolt", "Power Strike"}},
+	35:  {Name: "Lightning Fury", SkillDesc: "lightning fury", ID: 35, LeftSkill: true, RightSkill: true, ReqLevel: 30, Class: "ama", ReqSkill1: "Plague Javelin", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 20, LvlMana: 1, MinMana: 1, ManaShift: 7, CastDelayFrames: 0, Synergies: []string{"Charged Strike", "Lightning Bolt", "Power Strike", "Lightning Strike"}},
+	36:  {Name: "Fire Bolt", SkillDesc: "fire bolt", ID: 36, LeftSkill: true, RightSkill: true, ReqLevel: 1, Class: "sor", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 5, LvlMana: 0, MinMana: 1, ManaShift: 7, CastDelayFrames: 0, Synergies: []string{"Fire Ball", "Meteor"}},
+	37:  {Name: "Warmth", SkillDesc: "warmth", ID: 37, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "sor", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 8, CastDelayFrames: 0, Synergies: nil},
+	38:  {Name: "Charged Bolt", SkillDesc: "charged bolt", ID: 38, LeftSkill: true, RightSkill: true, ReqLevel: 1, Class: "sor", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 24, LvlMana: 4, MinMana: 1, ManaShift: 5, CastDelayFrames: 0, Synergies: []string{"Lightning"}},
+	39:  {Name: "Ice Bolt", SkillDesc: "ice bolt", ID: 39, LeftSkill: true, RightSkill: true, ReqLevel: 1, Class: "sor", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 3, LvlMana: 0, MinMana: 1, ManaShift: 8, CastDelayFrames: 0, Synergies: []string{"Frost Nova", "Ice Blast", "Glacial Spike", "Blizzard", "Frozen Orb"}},
+	40:  {Name: "Frozen Armor", SkillDesc: "frozen armor", ID: 40, LeftSkill: false, RightSkill: true, ReqLevel: 1, Class: "sor", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 7, LvlMana: 0, MinMana: 1, ManaShift: 8, CastDelayFrames: 0, Synergies: nil},
+	41:  {Name: "Inferno", SkillDesc: "inferno", ID: 41, LeftSkill: true, RightSkill: true, ReqLevel: 6, Class: "sor", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 24, LvlMana: 1, MinMana: 0, ManaShift: 2, CastDelayFrames: 0, Synergies: []string{"Warmth"}},
+	42:  {Name: "Static Field", SkillDesc: "static field", ID: 42, LeftSkill: false, RightSkill: true, ReqLevel: 6, Class: "sor", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 9, LvlMana: 0, MinMana: 1, ManaShift: 8, CastDelayFrames: 0, Synergies: nil},
+	43:  {Name: "Telekinesis", SkillDesc: "telekinesis", ID: 43, LeftSkill: false, RightSkill: true, ReqLevel: 6, Class: "sor", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 7, LvlMana: 0, MinMana: 1, ManaShift: 8, CastDelayFrames: 0, Synergies: nil},
+	44:  {Name: "Frost Nova", SkillDesc: "frost nova", ID: 44, LeftSkill: false, RightSkill: true, ReqLevel: 6, Class: "sor", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 9, LvlMana: 1, MinMana: 1, ManaShift: 8, CastDelayFrames: 0, Synergies: []string{"Blizzard", "Frozen Orb"}},
+	45:  {Name: "Ice Blast", SkillDesc: "ice blast", ID: 45, LeftSkill: true, RightSkill: true, ReqLevel: 6, Class: "sor", ReqSkill1: "Ice Bolt", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 12, LvlMana: 1, MinMana: 1, ManaShift: 7, CastDelayFrames: 0, Synergies: []string{"Ice Bolt", "Blizzard", "Frozen Orb", "Glacial Spike"}},
+	46:  {Name: "Blaze", SkillDesc: "blaze", ID: 46, LeftSkill: false, RightSkill: true, ReqLevel: 12, Class: "sor", ReqSkill1: "Inferno", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 22, LvlMana: 1, MinMana: 1, ManaShift: 7, CastDelayFrames: 0, Synergies: []string{"Warmth"}},
+	47:  {Name: "Fire Ball", SkillDesc: "fire ball", ID: 47, LeftSkill: true, RightSkill: true, ReqLevel: 12, Class: "sor", ReqSkill1: "Fire Bolt", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 10, LvlMana: 1, MinMana: 1, ManaShift: 7, CastDelayFrames: 0, Synergies: []string{"Fire Bolt", "Meteor"}},
+	48:  {Name: "Nova", SkillDesc: "nova", ID: 48, LeftSkill: false, RightSkill: true, ReqLevel: 12, Class: "sor", ReqSkill1: "Static Field", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 13, LvlMana: 1, MinMana: 1, ManaShift: 8, CastDelayFrames: 0, Synergies: []string{"Static Field"}},
+	49:  {Name: "Lightning", SkillDesc: "lightning", ID: 49, LeftSkill: true, RightSkill: true, ReqLevel: 12, Class: "sor", ReqSkill1: "Charged Bolt", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 16, LvlMana: 1, MinMana: 1, ManaShift: 7, CastDelayFrames: 0, Synergies: []string{"Charged Bolt", "Chain Lightning", "Nova"}},
+	50:  {Name: "Shiver Armor", SkillDesc: "shiver armor", ID: 50, LeftSkill: false, RightSkill: true, ReqLevel: 12, Class: "sor", ReqSkill1: "Ice Blast", ReqSkill2: "Frozen Armor", ReqSkill3: "", MaxLevel: 20, Mana: 11, LvlMana: 0, MinMana: 1, ManaShift: 8, CastDelayFrames: 0, Synergies: []string{"Frozen Armor", "Chilling Armor"}},
+	51:  {Name: "Fire Wall", SkillDesc: "fire wall", ID: 51, LeftSkill: false, RightSkill: true, ReqLevel: 18, Class: "sor", ReqSkill1: "Blaze", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 22, LvlMana: 1, MinMana: 1, ManaShift: 8, CastDelayFrames: 35, Synergies: []string{"Warmth", "Inferno"}},
+	52:  {Name: "Enchant", SkillDesc: "enchant", ID: 52, LeftSkill: false, RightSkill: true, ReqLevel: 18, Class: "sor", ReqSkill1: "Warmth", ReqSkill2: "Fire Ball", ReqSkill3: "", MaxLevel: 20, Mana: 25, LvlMana: 1, MinMana: 1, ManaShift: 8, CastDelayFrames: 0, Synergies: []string{"Warmth"}},
+	53:  {Name: "Chain Lightning", SkillDesc: "chain lightning", ID: 53, LeftSkill: true, RightSkill: true, ReqLevel: 18, Class: "sor", ReqSkill1: "Lightning", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 9, LvlMana: 1, MinMana: 1, ManaShift: 8, CastDelayFrames: 0, Synergies: []string{"Charged Bolt", "Lightning", "Nova"}},
+	54:  {Name: "Teleport", SkillDesc: "teleport", ID: 54, LeftSkill: false, RightSkill: true, ReqLevel: 18, Class: "sor", ReqSkill1: "Telekinesis", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 24, LvlMana: -1, MinMana: 1, ManaShift: 8, CastDelayFrames: 0, Synergies: nil},
+	55:  {Name: "Glacial Spike", SkillDesc: "glacial spike", ID: 55, LeftSkill: true, RightSkill: true, ReqLevel: 18, Class: "sor", ReqSkill1: "Ice Blast", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 20, LvlMana: 1, MinMana: 1, ManaShift: 7, CastDelayFrames: 0, Synergies: []string{"Ice Bolt", "Ice Blast", "Frozen Orb"}},
+	56:  {Name: "Meteor", SkillDesc: "meteor", ID: 56, LeftSkill: false, RightSkill: true, ReqLevel: 24, Class: "sor", ReqSkill1: "Fire Ball", ReqSkill2: "Fire Wall", ReqSkill3: "", MaxLevel: 20, Mana: 34, LvlMana: 1, MinMana: 1, ManaShift: 7, CastDelayFrames: 30, Synergies: []string{"Fire Bolt", "Fire Ball"}},
+	57:  {Name: "Thunder Storm", SkillDesc: "thunder storm", ID: 57, LeftSkill: false, RightSkill: true, ReqLevel: 24, Class: "sor", ReqSkill1: "Nova", ReqSkill2: "Chain Lightning", ReqSkill3: "", MaxLevel: 20, Mana: 19, LvlMana: 0, MinMana: 1, ManaShift: 8, CastDelayFrames: 0, Synergies: []string{"Static Field"}},
+	58:  {Name: "Energy Shield", SkillDesc: "energy shield", ID: 58, LeftSkill: false, RightSkill: true, ReqLevel: 24, Class: "sor", ReqSkill1: "Teleport", ReqSkill2: "Chain Lightning", ReqSkill3: "", MaxLevel: 20, Mana: 5, LvlMana: 0, MinMana: 1, ManaShift: 8, CastDelayFrames: 0, Synergies: nil},
+	59:  {Name: "Blizzard", SkillDesc: "blizzard", ID: 59, LeftSkill: false, RightSkill: true, ReqLevel: 24, Class: "sor", ReqSkill1: "Frost Nova", ReqSkill2: "Glacial Spike", ReqSkill3: "", MaxLevel: 20, Mana: 23, LvlMana: 1, MinMana: 1, ManaShift: 8, CastDelayFrames: 45, Synergies: []string{"Ice Bolt", "Ice Blast", "Glacial Spike"}},
+	60:  {Name: "Chilling Armor", SkillDesc: "chilling armor", ID: 60, LeftSkill: false, RightSkill: true, ReqLevel: 24, Class: "sor", ReqSkill1: "Shiver Armor", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 17, LvlMana: 0, MinMana: 1, ManaShift: 8, CastDelayFrames: 0, Synergies: []string{"Frozen Armor", "Shiver Armor"}},
+	61:  {Name: "Fire Mastery", SkillDesc: "fire mastery", ID: 61, LeftSkill: false, RightSkill: false, ReqLevel: 30, Class: "sor", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 8, CastDelayFrames: 0, Synergies: nil},
+	62:  {Name: "Hydra", SkillDesc: "hydra", ID: 62, LeftSkill: false, RightSkill: true, ReqLevel: 30, Class: "sor", ReqSkill1: "Enchant", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 40, LvlMana: 1, MinMana: 1, ManaShift: 7, CastDelayFrames: 0, Synergies: []string{"Fire Bolt", "Fire Ball"}},
+	63:  {Name: "Lightning Mastery", SkillDesc: "lightning mastery", ID: 63, LeftSkill: false, RightSkill: false, ReqLevel: 30, Class: "sor", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 8, CastDelayFrames: 0, Synergies: nil},
+	64:  {Name: "Frozen Orb", SkillDesc: "frozen orb", ID: 64, LeftSkill: true, RightSkill: true, ReqLevel: 30, Class: "sor", ReqSkill1: "Blizzard", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 50, LvlMana: 1, MinMana: 1, ManaShift: 7, CastDelayFrames: 25, Synergies: []string{"Ice Bolt"}},
+	65:  {Name: "Cold Mastery", SkillDesc: "cold mastery", ID: 65, LeftSkill: false, RightSkill: false, ReqLevel: 30, Class: "sor", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 8, CastDelayFrames: 0, Synergies: nil},
+	66:  {Name: "Amplify Damage", SkillDesc: "amplify damage", ID: 66, LeftSkill: false, RightSkill: true, ReqLevel: 1, Class: "nec", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 4, LvlMana: 0, MinMana: 1, ManaShift: 8, CastDelayFrames: 0, Synergies: nil},
+	67:  {Name: "Teeth", SkillDesc: "teeth", ID: 67, LeftSkill: true, RightSkill: true, ReqLevel: 1, Class: "nec", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 6, LvlMana: 1, MinMana: 1, ManaShift: 7, CastDelayFrames: 0, Synergies: []string{"Bone Wall", "Bone Prison", "Bone Spear", "Bone Spirit"}},
+	68:  {Name: "Bone Armor", SkillDesc: "bone armor", ID: 68, LeftSkill: false, RightSkill: true, ReqLevel: 1, Class: "nec", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 11, LvlMana: 1, MinMana: 1, ManaShift: 8, CastDelayFrames: 0, Synergies: nil},
+	69:  {Name: "Skeleton Mastery", SkillDesc: "skeleton mastery", ID: 69, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "nec", ReqSkill1: "Raise Skeleton", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 8, CastDelayFrames: 0, Synergies: nil},
+	70:  {Name: "Raise Skeleton", SkillDesc: "raise skeleton", ID: 70, LeftSkill: false, RightSkill: true, ReqLevel: 1, Class: "nec", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 6, LvlMana: 1, MinMana: 1, ManaShift: 8, CastDelayFrames: 0, Synergies: nil},
+	71:  {Name: "Dim Vision", SkillDesc: "dim vision", ID: 71, LeftSkill: false, RightSkill: true, ReqLevel: 6, Class: "nec", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 9, LvlMana: 0, MinMana: 1, ManaShift: 8, CastDelayFrames: 0, Synergies: nil},
+	72:  {Name: "Weaken", SkillDesc: "weaken", ID: 72, LeftSkill: false, RightSkill: true, ReqLevel: 6, Class: "nec", ReqSkill1: "Amplify Damage", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 4, LvlMana: 0, MinMana: 1, ManaShift: 8, CastDelayFrames: 0, Synergies: nil},
+	73:  {Name: "Poison Dagger", SkillDesc: "poison dagger", ID: 73, LeftSkill: true, RightSkill: true, ReqLevel: 6, Class: "nec", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 12, LvlMana: 1, MinMana: 1, ManaShift: 6, CastDelayFrames: 0, Synergies: []string{"Poison Explosion", "Poison Nova"}},
+	74:  {Name: "Corpse Explosion", SkillDesc: "corpse explosion", ID: 74, LeftSkill: false, RightSkill: true, ReqLevel: 6, Class: "nec", ReqSkill1: "Teeth", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 15, LvlMana: 1, MinMana: 1, ManaShift: 8, CastDelayFrames: 0, Synergies: nil},
+	75:  {Name: "Clay Golem", SkillDesc: "clay golem", ID: 75, LeftSkill: false, RightSkill: true, ReqLevel: 6, Class: "nec", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 15, LvlMana: 3, MinMana: 1, ManaShift: 8, CastDelayFrames: 0, Synergies: nil},
+	76:  {Name: "Iron Maiden", SkillDesc: "iron maiden", ID: 76, LeftSkill: false, RightSkill: true, ReqLevel: 12, Class: "nec", ReqSkill1: "Amplify Damage", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 5, LvlMana: 0, MinMana: 1, ManaShift: 8, CastDelayFrames: 0, Synergies: nil},
+	77:  {Name: "Terror", SkillDesc: "terror", ID: 77, LeftSkill: false, RightSkill: true, ReqLevel: 12, Class: "nec", ReqSkill1: "Weaken", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 7, LvlMana: 0, MinMana: 1, ManaShift: 8, CastDelayFrames: 0, Synergies: nil},
+	78:  {Name: "Bone Wall", SkillDesc: "bone wall", ID: 78, LeftSkill: false, RightSkill: true, ReqLevel: 12, Class: "nec", ReqSkill1: "Bone Armor", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 17, LvlMana: 0, MinMana: 1, ManaShift: 8, CastDelayFrames: 0, Synergies: nil},
+	79:  {Name: "Golem Mastery", SkillDesc: "golem mastery", ID: 79, LeftSkill: false, RightSkill: false, ReqLevel: 12, Class: "nec", ReqSkill1: "Clay Golem", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 8, CastDelayFrames: 0, Synergies: nil},
+	80:  {Name: "Raise Skeletal Mage", SkillDesc: "raise skeletal mage", ID: 80, LeftSkill: false, RightSkill: true, ReqLevel: 12, Class: "nec", ReqSkill1: "Raise Skeleton", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 8, LvlMana: 1, MinMana: 1, ManaShift: 8, CastDelayFrames: 0, Synergies: nil},
+	81:  {Name: "Confuse", SkillDesc: "confuse", ID: 81, LeftSkill: false, RightSkill: true, ReqLevel: 18, Class: "nec", ReqSkill1: "Dim Vision", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 13, LvlMana: 0, MinMana: 1, ManaShift: 8, CastDelayFrames: 0, Synergies: nil},
+	82:  {Name: "Life Tap", SkillDesc: "life tap", ID: 82, LeftSkill: false, RightSkill: true, ReqLevel: 18, Class: "nec", ReqSkill1: "Iron Maiden", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 9, LvlMana: 0, MinMana: 1, ManaShift: 8, CastDelayFrames: 0, Synergies: nil},
+	83:  {Name: "Poison Explosion", SkillDesc: "poison explosion", ID: 83, LeftSkill: false, RightSkill: true, ReqLevel: 18, Class: "nec", ReqSkill1: "Poison Dagger", ReqSkill2: "Corpse Explosion", ReqSkill3: "", MaxLevel: 20, Mana: 8, LvlMana: 0, MinMana: 1, ManaShift: 8, CastDelayFrames: 0, Synergies: []string{"Poison Dagger", "Poison Nova"}},
+	84:  {Name: "Bone Spear", SkillDesc: "bone spear", ID: 84, LeftSkill: true, RightSkill: true, ReqLevel: 18, Class: "nec", ReqSkill1: "Corpse Explosion", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 28, LvlMana: 1, MinMana: 1, ManaShift: 6, CastDelayFrames: 0, Synergies: []string{"Bone Wall", "Bone Prison", "Teeth", "Bone Spirit"}},
+	85:  {Name: "BloodGolem", SkillDesc: "bloodgolem", ID: 85, LeftSkill: false, RightSkill: true, ReqLevel: 18, Class: "nec", ReqSkill1: "Clay Golem", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 25, LvlMana: 3, MinMana: 1, ManaShift: 8, CastDelayFrames: 0, Synergies: nil},
+	86:  {Name: "Attract", SkillDesc: "attract", ID: 86, LeftSkill: false, RightSkill: true, ReqLevel: 24, Class: "nec", ReqSkill1: "Confuse", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 17, LvlMana: 0, MinMana: 1, ManaShift: 8, CastDelayFrames: 0, Synergies: nil},
+	87:  {Name: "Decrepify", SkillDesc: "decrepify", ID: 87, LeftSkill: false, RightSkill: true, ReqLevel: 24, Class: "nec", ReqSkill1: "Terror", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 11, LvlMana: 0, MinMana: 1, ManaShift: 8, CastDelayFrames: 0, Synergies: nil},
+	88:  {Name: "Bone Prison", SkillDesc: "bone prison", ID: 88, LeftSkill: false, RightSkill: true, ReqLevel: 24, Class: "nec", ReqSkill1: "Bone Wall", ReqSkill2: "Bone Spear", ReqSkill3: "", MaxLevel: 20, Mana: 27, LvlMana: -1, MinMana: 1, ManaShift: 8, CastDelayFrames: 0, Synergies: nil},
+	89:  {Name: "Summon Resist", SkillDesc: "summon resist", ID: 89, LeftSkill: false, RightSkill: false, ReqLevel: 24, Class: "nec", ReqSkill1: "Golem Mastery", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 44, LvlMana: -3, MinMana: 1, ManaShift: 8, CastDelayFrames: 0, Synergies: nil},
+	90:  {Name: "IronGolem", SkillDesc: "irongolem", ID: 90, LeftSkill: false, RightSkill: true, ReqLevel: 24, Class: "nec", ReqSkill1: "BloodGolem", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 35, LvlMana: 0, MinMana: 1, ManaShift: 8, CastDelayFrames: 0, Synergies: nil},
+	91:  {Name: "Lower Resist", SkillDesc: "lower resist", ID: 91, LeftSkill: false, RightSkill: true, ReqLevel: 30, Class: "nec", ReqSkill1: "Life Tap", ReqSkill2: "Decrepify", ReqSkill3: "", MaxLevel: 20, Mana: 22, LvlMana: 0, MinMana: 1, ManaShift: 8, CastDelayFrames: 0, Synergies: nil},
+	92:  {Name: "Poison Nova", SkillDesc: "poison nova", ID: 92, LeftSkill: false, RightSkill: true, ReqLevel: 30, Class: "nec", ReqSkill1: "Poison Explosion", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 20, LvlMana: 0, MinMana: 1, ManaShift: 8, CastDelayFrames: 0, Synergies: []string{"Poison Dagger", "Poison Explosion"}},
+	93:  {Name: "Bone Spirit", SkillDesc: "bone spirit", ID: 93, LeftSkill: true, RightSkill: true, ReqLevel: 30, Class: "nec", ReqSkill1: "Bone Spear", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 24, LvlMana: 1, MinMana: 1, ManaShift: 7, CastDelayFrames: 0, Synergies: []string{"Bone Wall", "Bone Prison", "Teeth", "Bone Spear"}},
+	94:  {Name: "FireGolem", SkillDesc: "firegolem", ID: 94, LeftSkill: false, RightSkill: true, ReqLevel: 30, Class: "nec", ReqSkill1: "IronGolem", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 50, LvlMana: 8, MinMana: 1, ManaShift: 8, CastDelayFrames: 0, Synergies: nil},
+	95:  {Name: "Revive", SkillDesc: "revive", ID: 95, LeftSkill: false, RightSkill: true, ReqLevel: 30, Class: "nec", ReqSkill1: "Raise Skeletal Mage", ReqSkill2: "IronGolem", ReqSkill3: "", MaxLevel: 20, Mana: 45, LvlMana: 0, MinMana: 1, ManaShift: 8, CastDelayFrames: 0, Synergies: nil},
+	96:  {Name: "Sacrifice", SkillDesc: "sacrifice", ID: 96, LeftSkill: true, RightSkill: true, ReqLevel: 1, Class: "pal", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 8, CastDelayFrames: 0, Synergies: nil},
+	97:  {Name: "Smite", SkillDesc: "smite", ID: 97, LeftSkill: true, RightSkill: true, ReqLevel: 1, Class: "pal", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 2, LvlMana: 0, MinMana: 1, ManaShift: 8, CastDelayFrames: 0, Synergies: nil},
+	98:  {Name: "Might", SkillDesc: "might", ID: 98, LeftSkill: false, RightSkill: true, ReqLevel: 1, Class: "pal", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 0, LvlMana: 0, MinMana: 1, ManaShift: 8, CastDelayFrames: 0, Synergies: nil},
+	99:  {Name: "Prayer", SkillDesc: "prayer", ID: 99, LeftSkill: false, RightSkill: true, ReqLevel: 1, Class: "pal", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 16, LvlMana: 3, MinMana: 1, ManaShift: 4, CastDelayFrames: 0, Synergies: nil},
+	100: {Name: "Resist Fire", SkillDesc: "resist fire", ID: 100, LeftSkill: false, RightSkill: true, ReqLevel: 1, Class: "pal", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 8, CastDelayFrames: 0, Synergies: nil},
+	101: {Name: "Holy Bolt", SkillDesc: "holy bolt", ID: 101, LeftSkill: true, RightSkill: true, ReqLevel: 6, Class: "pal", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 32, LvlMana: 1, MinMana: 1, ManaShift: 4, CastDelayFrames: 0, Synergies: []string{"Fist of the Heavens"}},
+	102: {Name: "Holy Fire", SkillDesc: "holy fire", ID: 102, LeftSkill: false, RightSkill: true, ReqLevel: 6, Class: "pal", ReqSkill1: "Might", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 8, CastDelayFrames: 0, Synergies: []string{"Resist Fire", "Salvation"}},
+	103: {Name: "Thorns", SkillDesc: "thorns", ID: 103, LeftSkill: false, RightSkill: true, ReqLevel: 6, Class: "pal", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 8, CastDelayFrames: 0, Synergies: nil},
+	104: {Name: "Defiance", SkillDesc: "defiance", ID: 104, LeftSkill: false, RightSkill: true, ReqLevel: 6, Class: "pal", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 8, CastDelayFrames: 0, Synergies: nil},
+	105: {Name: "Resist Cold", SkillDesc: "resist cold", ID: 105, LeftSkill: false, RightSkill: true, ReqLevel: 6, Class: "pal", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 8, CastDelayFrames: 0, Synergies: nil},
+	106: {Name: "Zeal", SkillDesc: "zeal", ID: 106, LeftSkill: true, RightSkill: true, ReqLevel: 12, Class: "pal", ReqSkill1: "Sacrifice", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 2, LvlMana: 0, MinMana: 1, ManaShift: 8, CastDelayFrames: 0, Synergies: nil},
+	107: {Name: "Charge", SkillDesc: "charge", ID: 107, LeftSkill: true, RightSkill: true, ReqLevel: 12, Class: "pal", ReqSkill1: "Smite", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 9, LvlMana: 0, MinMana: 1, ManaShift: 8, CastDelayFrames: 0, Synergies: nil},
+	108: {Name: "Blessed Aim", SkillDesc: "blessed aim", ID: 108, LeftSkill: false, RightSkill: true, ReqLevel: 12, Class: "pal", ReqSkill1: "Might", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 8, CastDelayFrames: 0, Synergies: nil},
+	109: {Name: "Cleansing", SkillDesc: "cleansing", ID: 109, LeftSkill: false, RightSkill: true, ReqLevel: 12, Class: "pal", ReqSkill1: "Prayer", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 8, CastDelayFrames: 0, Synergies: nil},
+	110: {Name: "Resist Lightning", SkillDesc: "resist lightning", ID: 110, LeftSkill: false, RightSkill: true, ReqLevel: 12, Class: "pal", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 8, CastDelayFrames: 0, Synergies: nil},
+	111: {Name: "Vengeance", SkillDesc: "vengeance", ID: 111, LeftSkill: true, RightSkill: true, ReqLevel: 18, Class: "pal", ReqSkill1: "Zeal", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 16, LvlMana: 1, MinMana: 1, ManaShift: 6, CastDelayFrames: 0, Synergies: nil},
+	112: {Name: "Blessed Hammer", SkillDesc: "blessed hammer", ID: 112, LeftSkill: true, RightSkill: true, ReqLevel: 18, Class: "pal", ReqSkill1: "Holy Bolt", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 20, LvlMana: 1, MinMana: 1, ManaShift: 6, CastDelayFrames: 0, Synergies: []string{"Vigor", "Blessed Aim"}},
+	113: {Name: "Concentration", SkillDesc: "concentration", ID: 113, LeftSkill: false, RightSkill: true, ReqLevel: 18, Class: "pal", ReqSkill1: "Blessed Aim", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 8, CastDelayFrames: 0, Synergies: nil},
+	114: {Name: "Holy Freeze", SkillDesc: "holy freeze", ID: 114, LeftSkill: false, RightSkill: true, ReqLevel: 18, Class: "pal", ReqSkill1: "Holy Fire", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 8, CastDelayFrames: 0, Synergies: []string{"Resist Cold", "Salvation"}},
+	115: {Name: "Vigor", SkillDesc: "vigor", ID: 115, LeftSkill: false, RightSkill: true, ReqLevel: 18, Class: "pal", ReqSkill1: "Cleansing", ReqSkill2: "Defiance", ReqSkill3: "", MaxLevel: 20, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 8, CastDelayFrames: 0, Synergies: nil},
+	116: {Name: "Conversion", SkillDesc: "conversion", ID: 116, LeftSkill: true, RightSkill: true, ReqLevel: 24, Class: "pal", ReqSkill1: "Vengeance", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 4, LvlMana: 0, MinMana: 1, ManaShift: 8, CastDelayFrames: 0, Synergies: nil},
+	117: {Name: "Holy Shield", SkillDesc: "holy shield", ID: 117, LeftSkill: false, RightSkill: true, ReqLevel: 24, Class: "pal", ReqSkill1: "Charge", ReqSkill2: "Blessed Hammer", ReqSkill3: "", MaxLevel: 20, Mana: 35, LvlMana: 0, MinMana: 1, ManaShift: 8, CastDelayFrames: 0, Synergies: nil},
+	118: {Name: "Holy Shock", SkillDesc: "holy shock", ID: 118, LeftSkill: false, RightSkill: true, ReqLevel: 24, Class: "pal", ReqSkill1: "Holy Freeze", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 8, CastDelayFrames: 0, Synergies: []string{"Resist Lightning", "Salvation"}},
+	119: {Name: "Sanctuary", SkillDesc: "sanctuary", ID: 119, LeftSkill: false, RightSkill: true, ReqLevel: 24, Class: "pal", ReqSkill1: "Thorns", ReqSkill2: "Holy Freeze", ReqSkill3: "", MaxLevel: 20, Mana: 1, LvlMana: 0, MinMana: 1, ManaShift: 8, CastDelayFrames: 0, Synergies: []string{"Cleansing"}},
+	120: {Name: "Meditation", SkillDesc: "meditation", ID: 120, LeftSkill: false, RightSkill: true, ReqLevel: 24, Class: "pal", ReqSkill1: "Cleansing", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 8, CastDelayFrames: 0, Synergies: nil},
+	121: {Name: "Fist of the Heavens", SkillDesc: "fist of the heavens", ID: 121, LeftSkill: true, RightSkill: true, ReqLevel: 30, Class: "pal", ReqSkill1: "Blessed Hammer", ReqSkill2: "Conversion", ReqSkill3: "", MaxLevel: 20, Mana: 25, LvlMana: 0, MinMana: 1, ManaShift: 8, CastDelayFrames: 10, Synergies: []string{"Holy Shock"}},
+	122: {Name: "Fanaticism", SkillDesc: "fanaticism", ID: 122, LeftSkill: false, RightSkill: true, ReqLevel: 30, Class: "pal", ReqSkill1: "Concentration", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 8, CastDelayFrames: 0, Synergies: nil},
+	123: {Name: "Conviction", SkillDesc: "conviction", ID: 123, LeftSkill: false, RightSkill: true, ReqLevel: 30, Class: "pal", ReqSkill1: "Sanctuary", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 8, CastDelayFrames: 0, Synergies: nil},
+	124: {Name: "Redemption", SkillDesc: "redemption", ID: 124, LeftSkill: false, RightSkill: true, ReqLevel: 30, Class: "pal", ReqSkill1: "Vigor", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 8, CastDelayFrames: 0, Synergies: nil},
+	125: {Name: "Salvation", SkillDesc: "salvation", ID: 125, LeftSkill: false, RightSkill: true, ReqLevel: 30, Class: "pal", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 8, CastDelayFrames: 0, Synergies: nil},
+	126: {Name: "Bash", SkillDesc: "bash", ID: 126, LeftSkill: true, RightSkill: true, ReqLevel: 1, Class: "bar", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 2, LvlMana: 0, MinMana: 1, ManaShift: 8, CastDelayFrames: 0, Synergies: nil},
+	127: {Name: "Blade Mastery", SkillDesc: "blade mastery", ID: 127, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "bar", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 8, CastDelayFrames: 0, Synergies: nil},
+	128: {Name: "Axe Mastery", SkillDesc: "axe mastery", ID: 128, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "bar", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 8, CastDelayFrames: 0, Synergies: nil},
+	129: {Name: "Mace Mastery", SkillDesc: "mace mastery", ID: 129, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "bar", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 8, CastDelayFrames: 0, Synergies: nil},
+	130: {Name: "Howl", SkillDesc: "howl", ID: 130, LeftSkill: false, RightSkill: true, ReqLevel: 1, Class: "bar", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 4, LvlMana: 0, MinMana: 1, ManaShift: 8, CastDelayFrames: 0, Synergies: nil},
+	131: {Name: "Find Potion", SkillDesc: "find potion", ID: 131, LeftSkill: false, RightSkill: true, ReqLevel: 1, Class: "bar", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 2, LvlMana: 0, MinMana: 1, ManaShift: 8, CastDelayFrames: 0, Synergies: nil},
+	132: {Name: "Leap", SkillDesc: "leap", ID: 132, LeftSkill: true, RightSkill: true, ReqLevel: 6, Class: "bar", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 2, LvlMana: 0, MinMana: 1, ManaShift: 8, CastDelayFrames: 0, Synergies: nil},
+	133: {Name: "Double Swing", SkillDesc: "double swing", ID: 133, LeftSkill: true, RightSkill: true, ReqLevel: 6, Class: "bar", ReqSkill1: "Bash", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 8, LvlMana: -1, MinMana: 0, ManaShift: 5, CastDelayFrames: 0, Synergies: nil},
+	134: {Name: "Pole Arm Mastery", SkillDesc: "pole arm mastery", ID: 134, LeftSkill: false, RightSkill: false, ReqLevel: 6, Class: "bar", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 8, CastDelayFrames: 0, Synergies: nil},
+	135: {Name: "Throwing Mastery", SkillDesc: "throwing mastery", ID: 135, LeftSkill: false, RightSkill: false, ReqLevel: 6, Class: "bar", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 8, CastDelayFrames: 0, Synergies: nil},
+	136: {Name: "Spear Mastery", SkillDesc: "spear mastery", ID: 136, LeftSkill: false, RightSkill: false, ReqLevel: 6, Class: "bar", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 8, CastDelayFrames: 0, Synergies: nil},
+	137: {Name: "Taunt", SkillDesc: "taunt", ID: 137, LeftSkill: false, RightSkill: true, ReqLevel: 6, Class: "bar", ReqSkill1: "Howl", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 3, LvlMana: 0, MinMana: 1, ManaShift: 8, CastDelayFrames: 0, Synergies: nil},
+	138: {Name: "Shout", SkillDesc: "shout", ID: 138, LeftSkill: false, RightSkill: true, ReqLevel: 6, Class: "bar", ReqSkill1: "Howl", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 6, LvlMana: 0, MinMana: 1, ManaShift: 8, CastDelayFrames: 0, Synergies: nil},
+	139: {Name: "Stun", SkillDesc: "stun", ID: 139, LeftSkill: true, RightSkill: true, ReqLevel: 12, Class: "bar", ReqSkill1: "Bash", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 2, LvlMana: 0, MinMana: 1, ManaShift: 8, CastDelayFrames: 0, Synergies: []string{"War Cry"}},
+	140: {Name: "Double Throw", SkillDesc: "double throw", ID: 140, LeftSkill: true, RightSkill: true, ReqLevel: 12, Class: "bar", ReqSkill1: "Double Swing", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 1, LvlMana: 0, MinMana: 1, ManaShift: 8, CastDelayFrames: 0, Synergies: nil},
+	141: {Name: "Increased Stamina", SkillDesc: "increased stamina", ID: 141, LeftSkill: false, RightSkill: false, ReqLevel: 12, Class: "bar", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 8, CastDelayFrames: 0, Synergies: nil},
+	142: {Name: "Find Item", SkillDesc: "find item", ID: 142, LeftSkill: false, RightSkill: true, ReqLevel: 12, Class: "bar", ReqSkill1: "Find Potion", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 7, LvlMana: 0, MinMana: 1, ManaShift: 8, CastDelayFrames: 0, Synergies: nil},
+	143: {Name: "Leap Attack", SkillDesc: "leap attack", ID: 143, LeftSkill: true, RightSkill: true, ReqLevel: 18, Class: "bar", ReqSkill1: "Leap", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 10, LvlMana: 0, MinMana: 1, ManaShift: 8, CastDelayFrames: 0, Synergies: []string{"Leap"}},
+	144: {Name: "Concentrate", SkillDesc: "concentrate", ID: 144, LeftSkill: true, RightSkill: true, ReqLevel: 18, Class: "bar", ReqSkill1: "Stun", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 2, LvlMana: 0, MinMana: 1, ManaShift: 8, CastDelayFrames: 0, Synergies: nil},
+	145: {Name: "Iron Skin", SkillDesc: "iron skin", ID: 145, LeftSkill: false, RightSkill: false, ReqLevel: 18, Class: "bar", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 8, CastDelayFrames: 0, Synergies: nil},
+	146: {Name: "Battle Cry", SkillDesc: "battle cry", ID: 146, LeftSkill: false, RightSkill: true, ReqLevel: 18, Class: "bar", ReqSkill1: "Taunt", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 5, LvlMana: 0, MinMana: 1, ManaShift: 8, CastDelayFrames: 0, Synergies: nil},
+	147: {Name: "Frenzy", SkillDesc: "frenzy", ID: 147, LeftSkill: true, RightSkill: true, ReqLevel: 24, Class: "bar", ReqSkill1: "Double Throw", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 3, LvlMana: 0, MinMana: 1, ManaShift: 7, CastDelayFrames: 0, Synergies: nil},
+	148: {Name: "Increased Speed", SkillDesc: "increased speed", ID: 148, LeftSkill: false, RightSkill: false, ReqLevel: 24, Class: "bar", ReqSkill1: "Increased Stamina", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 8, CastDelayFrames: 0, Synergies: nil},
+	149: {Name: "Battle Orders", SkillDesc: "battle orders", ID: 149, LeftSkill: false, RightSkill: true, ReqLevel: 24, Class: "bar", ReqSkill1: "Shout", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 7, LvlMana: 0, MinMana: 1, ManaShift: 8, CastDelayFrames: 0, Synergies: nil},
+	150: {Name: "Grim Ward", SkillDesc: "grim ward", ID: 150, LeftSkill: false, RightSkill: true, ReqLevel: 24, Class: "bar", ReqSkill1: "Find Item", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 4, LvlMana: 0, MinMana: 1, ManaShift: 8, CastDelayFrames: 0, Synergies: nil},
+	151: {Name: "Whirlwind", SkillDesc: "whirlwind", ID: 151, LeftSkill: true, RightSkill: true, ReqLevel: 30, Class: "bar", ReqSkill1: "Leap Attack", ReqSkill2: "Concentrate", ReqSkill3: "", MaxLevel: 20, Mana: 25, LvlMana: 1, MinMana: 1, ManaShift: 7, CastDelayFrames: 0, Synergies: nil},
+	152: {Name: "Berserk", SkillDesc: "berserk", ID: 152, LeftSkill: true, RightSkill: true, ReqLevel: 30, Class: "bar", ReqSkill1: "Concentrate", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 4, LvlMana: 0, MinMana: 1, ManaShift: 8, CastDelayFrames: 0, Synergies: nil},
+	153: {Name: "Natural Resistance", SkillDesc: "natural resistance", ID: 153, LeftSkill: false, RightSkill: false, ReqLevel: 30, Class: "bar", ReqSkill1: "Iron Skin", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 8, CastDelayFrames: 0, Synergies: nil},
+	154: {Name: "War Cry", SkillDesc: "war cry", ID: 154, LeftSkill: false, RightSkill: true, ReqLevel: 30, Class: "bar", ReqSkill1: "Battle Cry", ReqSkill2: "Battle Orders", ReqSkill3: "", MaxLevel: 20, Mana: 40, LvlMana: 3, MinMana: 1, ManaShift: 6, CastDelayFrames: 0, Synergies: []string{"Howl", "Taunt", "Battle Cry"}},
+	155: {Name: "Battle Command", SkillDesc: "battle command", ID: 155, LeftSkill: false, RightSkill: true, ReqLevel: 30, Class: "bar", ReqSkill1: "Battle Orders", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 11, LvlMana: 0, MinMana: 1, ManaShift: 8, CastDelayFrames: 0, Synergies: nil},
+	156: {Name: "Fire Hit", SkillDesc: "", ID: 156, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 0, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 0, CastDelayFrames: 0, Synergies: nil},
+	157: {Name: "UnHolyBolt", SkillDesc: "", ID: 157, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 0, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 0, CastDelayFrames: 0, Synergies: nil},
+	158: {Name: "SkeletonRaise", SkillDesc: "", ID: 158, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 0, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 0, CastDelayFrames: 0, Synergies: nil},
+	159: {Name: "MaggotEgg", SkillDesc: "", ID: 159, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 0, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 0, CastDelayFrames: 0, Synergies: nil},
+	160: {Name: "ShamanFire", SkillDesc: "", ID: 160, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 0, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 0, CastDelayFrames: 0, Synergies: nil},
+	161: {Name: "MagottUp", SkillDesc: "", ID: 161, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 0, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 0, CastDelayFrames: 0, Synergies: nil},
+	162: {Name: "MagottDown", SkillDesc: "", ID: 162, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 0, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 0, CastDelayFrames: 0, Synergies: nil},
+	163: {Name: "MagottLay", SkillDesc: "", ID: 163, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 0, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 0, CastDelayFrames: 0, Synergies: nil},
+	164: {Name: "AndrialSpray", SkillDesc: "", ID: 164, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 0, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 0, CastDelayFrames: 0, Synergies: nil},
+	165: {Name: "Jump", SkillDesc: "", ID: 165, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 0, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 0, CastDelayFrames: 0, Synergies: nil},
+	166: {Name: "Swarm Move", SkillDesc: "", ID: 166, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 0, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 0, CastDelayFrames: 0, Synergies: nil},
+	167: {Name: "Nest", SkillDesc: "", ID: 167, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 0, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 0, CastDelayFrames: 0, Synergies: nil},
+	168: {Name: "Quick Strike", SkillDesc: "", ID: 168, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 0, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 0, CastDelayFrames: 0, Synergies: nil},
+	169: {Name: "VampireFireball", SkillDesc: "", ID: 169, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 0, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 0, CastDelayFrames: 0, Synergies: nil},
+	170: {Name: "VampireFirewall", SkillDesc: "", ID: 170, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 0, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 0, CastDelayFrames: 0, Synergies: nil},
+	171: {Name: "VampireMeteor", SkillDesc: "", ID: 171, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 0, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 0, CastDelayFrames: 0, Synergies: nil},
+	172: {Name: "GargoyleTrap", SkillDesc: "", ID: 172, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 0, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 0, CastDelayFrames: 0, Synergies: nil},
+	173: {Name: "SpiderLay", SkillDesc: "", ID: 173, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 0, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 0, CastDelayFrames: 0, Synergies: nil},
+	174: {Name: "VampireHeal", SkillDesc: "", ID: 174, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 0, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 0, CastDelayFrames: 0, Synergies: nil},
+	175: {Name: "VampireRaise", SkillDesc: "", ID: 175, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 0, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 0, CastDelayFrames: 0, Synergies: nil},
+	176: {Name: "Submerge", SkillDesc: "", ID: 176, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 0, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 0, CastDelayFrames: 0, Synergies: nil},
+	177: {Name: "FetishAura", SkillDesc: "", ID: 177, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 0, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 0, CastDelayFrames: 0, Synergies: nil},
+	178: {Name: "FetishInferno", SkillDesc: "", ID: 178, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 0, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 0, CastDelayFrames: 0, Synergies: nil},
+	179: {Name: "ZakarumHeal", SkillDesc: "", ID: 179, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 0, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 0, CastDelayFrames: 0, Synergies: nil},
+	180: {Name: "Emerge", SkillDesc: "", ID: 180, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 0, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 0, CastDelayFrames: 0, Synergies: nil},
+	181: {Name: "Resurrect", SkillDesc: "", ID: 181, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 0, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 0, CastDelayFrames: 0, Synergies: nil},
+	182: {Name: "Bestow", SkillDesc: "", ID: 182, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 0, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 0, CastDelayFrames: 0, Synergies: nil},
+	183: {Name: "MissileSkill1", SkillDesc: "", ID: 183, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 0, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 0, CastDelayFrames: 0, Synergies: nil},
+	184: {Name: "MonTeleport", SkillDesc: "", ID: 184, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 0, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 0, CastDelayFrames: 0, Synergies: nil},
+	185: {Name: "PrimeLightning", SkillDesc: "", ID: 185, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 0, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 0, CastDelayFrames: 0, Synergies: nil},
+	186: {Name: "PrimeBolt", SkillDesc: "", ID: 186, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 0, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 0, CastDelayFrames: 0, Synergies: nil},
+	187: {Name: "PrimeBlaze", SkillDesc: "", ID: 187, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 0, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 0, CastDelayFrames: 0, Synergies: nil},
+	188: {Name: "PrimeFirewall", SkillDesc: "", ID: 188, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 0, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 0, CastDelayFrames: 0, Synergies: nil},
+	189: {Name: "PrimeSpike", SkillDesc: "", ID: 189, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 0, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 0, CastDelayFrames: 0, Synergies: nil},
+	190: {Name: "PrimeIceNova", SkillDesc: "", ID: 190, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 0, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 0, CastDelayFrames: 0, Synergies: nil},
+	191: {Name: "PrimePoisonball", SkillDesc: "", ID: 191, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 0, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 0, CastDelayFrames: 0, Synergies: nil},
+	192: {Name: "PrimePoisonNova", SkillDesc: "", ID: 192, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 0, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 0, CastDelayFrames: 0, Synergies: nil},
+	193: {Name: "DiabLight", SkillDesc: "", ID: 193, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 0, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 0, CastDelayFrames: 0, Synergies: nil},
+	194: {Name: "DiabCold", SkillDesc: "", ID: 194, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 0, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 0, CastDelayFrames: 0, Synergies: nil},
+	195: {Name: "DiabFire", SkillDesc: "", ID: 195, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 0, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 0, CastDelayFrames: 0, Synergies: nil},
+	196: {Name: "FingerMageSpider", SkillDesc: "", ID: 196, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 0, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 0, CastDelayFrames: 0, Synergies: nil},
+	197: {Name: "DiabWall", SkillDesc: "firestorm", ID: 197, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 0, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 0, CastDelayFrames: 0, Synergies: nil},
+	198: {Name: "DiabRun", SkillDesc: "", ID: 198, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 0, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 0, CastDelayFrames: 0, Synergies: nil},
+	199: {Name: "DiabPrison", SkillDesc: "", ID: 199, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 0, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 0, CastDelayFrames: 0, Synergies: nil},
+	200: {Name: "PoisonBallTrap", SkillDesc: "", ID: 200, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 0, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 0, CastDelayFrames: 0, Synergies: nil},
+	201: {Name: "AndyPoisonBolt", SkillDesc: "", ID: 201, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 0, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 0, CastDelayFrames: 0, Synergies: nil},
+	202: {Name: "HireableMissile", SkillDesc: "", ID: 202, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 0, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 0, CastDelayFrames: 0, Synergies: nil},
+	203: {Name: "DesertTurret", SkillDesc: "", ID: 203, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 0, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 0, CastDelayFrames: 0, Synergies: nil},
+	204: {Name: "ArcaneTower", SkillDesc: "", ID: 204, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 0, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 0, CastDelayFrames: 0, Synergies: nil},
+	205: {Name: "MonBlizzard", SkillDesc: "", ID: 205, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 0, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 0, CastDelayFrames: 0, Synergies: nil},
+	206: {Name: "Mosquito", SkillDesc: "", ID: 206, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 0, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 0, CastDelayFrames: 0, Synergies: nil},
+	207: {Name: "CursedBallTrapRight", SkillDesc: "", ID: 207, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 0, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 0, CastDelayFrames: 0, Synergies: nil},
+	208: {Name: "CursedBallTrapLeft", SkillDesc: "", ID: 208, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 0, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 0, CastDelayFrames: 0, Synergies: nil},
+	209: {Name: "MonFrozenArmor", SkillDesc: "", ID: 209, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 0, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 0, CastDelayFrames: 0, Synergies: nil},
+	210: {Name: "MonBoneArmor", SkillDesc: "", ID: 210, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 0, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 0, CastDelayFrames: 0, Synergies: nil},
+	211: {Name: "MonBoneSpirit", SkillDesc: "", ID: 211, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 0, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 0, CastDelayFrames: 0, Synergies: nil},
+	212: {Name: "MonCurseCast", SkillDesc: "", ID: 212, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 0, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 0, CastDelayFrames: 0, Synergies: nil},
+	213: {Name: "HellMeteor", SkillDesc: "", ID: 213, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 0, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 0, CastDelayFrames: 0, Synergies: nil},
+	214: {Name: "RegurgitatorEat", SkillDesc: "", ID: 214, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 0, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 0, CastDelayFrames: 0, Synergies: nil},
+	215: {Name: "MonFrenzy", SkillDesc: "", ID: 215, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 0, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 0, CastDelayFrames: 0, Synergies: nil},
+	216: {Name: "QueenDeath", SkillDesc: "", ID: 216, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 0, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 0, CastDelayFrames: 0, Synergies: nil},
+	217: {Name: "Scroll of Identify", SkillDesc: "scroll of identify", ID: 217, LeftSkill: false, RightSkill: true, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 0, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 0, CastDelayFrames: 0, Synergies: nil},
+	218: {Name: "Book of Identify", SkillDesc: "book of identify", ID: 218, LeftSkill: false, RightSkill: true, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 0, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 0, CastDelayFrames: 0, Synergies: nil},
+	219: {Name: "Scroll of Townportal", SkillDesc: "scroll of townportal", ID: 219, LeftSkill: false, RightSkill: true, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 0, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 0, CastDelayFrames: 0, Synergies: nil},
+	220: {Name: "Book of Townportal", SkillDesc: "book of townportal", ID: 220, LeftSkill: false, RightSkill: true, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 0, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 0, CastDelayFrames: 0, Synergies: nil},
+	221: {Name: "Raven", SkillDesc: "raven", ID: 221, LeftSkill: false, RightSkill: true, ReqLevel: 1, Class: "dru", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 6, LvlMana: 0, MinMana: 1, ManaShift: 8, CastDelayFrames: 0, Synergies: []string{"Summon Spirit Wolf", "Summon Fenris", "Summon Grizzly"}},
+	222: {Name: "Plague Poppy", SkillDesc: "plague poppy", ID: 222, LeftSkill: false, RightSkill: true, ReqLevel: 1, Class: "dru", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 8, LvlMana: 0, MinMana: 1, ManaShift: 8, CastDelayFrames: 0, Synergies: []string{"Rabies"}},
+	223: {Name: "Wearwolf", SkillDesc: "wearwolf", ID: 223, LeftSkill: false, RightSkill: true, ReqLevel: 1, Class: "dru", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 15, LvlMana: 0, MinMana: 1, ManaShift: 8, CastDelayFrames: 0, Synergies: nil},
+	224: {Name: "Shape Shifting", SkillDesc: "shape shifting", ID: 224, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "dru", ReqSkill1: "Wearwolf", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 8, CastDelayFrames: 0, Synergies: nil},
+	225: {Name: "Firestorm", SkillDesc: "firestorm", ID: 225, LeftSkill: true, RightSkill: true, ReqLevel: 1, Class: "dru", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 4, LvlMana: 0, MinMana: 1, ManaShift: 8, CastDelayFrames: 15, Synergies: []string{"Molten Boulder", "Eruption"}},
+	226: {Name: "Oak Sage", SkillDesc: "oak sage", ID: 226, LeftSkill: false, RightSkill: true, ReqLevel: 6, Class: "dru", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 15, LvlMana: 1, MinMana: 1, ManaShift: 8, CastDelayFrames: 0, Synergies: nil},
+	227: {Name: "Summon Spirit Wolf", SkillDesc: "summon spirit wolf", ID: 227, LeftSkill: false, RightSkill: true, ReqLevel: 6, Class: "dru", ReqSkill1: "Raven", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 15, LvlMana: 0, MinMana: 1, ManaShift: 8, CastDelayFrames: 0, Synergies: nil},
+	228: {Name: "Wearbear", SkillDesc: "wearbear", ID: 228, LeftSkill: false, RightSkill: true, ReqLevel: 6, Class: "dru", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 15, LvlMana: 0, MinMana: 1, ManaShift: 8, CastDelayFrames: 0, Synergies: nil},
+	229: {Name: "Molten Boulder", SkillDesc: "molten boulder", ID: 229, LeftSkill: true, RightSkill: true, ReqLevel: 6, Class: "dru", ReqSkill1: "Firestorm", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 20, LvlMana: 1, MinMana: 1, ManaShift: 7, CastDelayFrames: 25, Synergies: []string{"Volcano", "Firestorm"}},
+	230: {Name: "Arctic Blast", SkillDesc: "arctic blast", ID: 230, LeftSkill: true, RightSkill: true, ReqLevel: 6, Class: "dru", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 24, LvlMana: 1, MinMana: 0, ManaShift: 2, CastDelayFrames: 0, Synergies: []string{"Cyclone Armor"}},
+	231: {Name: "Cycle of Life", SkillDesc: "cycle of life", ID: 231, LeftSkill: false, RightSkill: true, ReqLevel: 12, Class: "dru", ReqSkill1: "Plague Poppy", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 10, LvlMana: 0, MinMana: 1, ManaShift: 8, CastDelayFrames: 0, Synergies: nil},
+	232: {Name: "Feral Rage", SkillDesc: "feral rage", ID: 232, LeftSkill: true, RightSkill: true, ReqLevel: 12, Class: "dru", ReqSkill1: "Wearwolf", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 3, LvlMana: 0, MinMana: 1, ManaShift: 8, CastDelayFrames: 0, Synergies: nil},
+	233: {Name: "Maul", SkillDesc: "maul", ID: 233, LeftSkill: true, RightSkill: true, ReqLevel: 12, Class: "dru", ReqSkill1: "Wearbear", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 3, LvlMana: 0, MinMana: 1, ManaShift: 8, CastDelayFrames: 0, Synergies: nil},
+	234: {Name: "Eruption", SkillDesc: "eruption", ID: 234, LeftSkill: false, RightSkill: true, ReqLevel: 12, Class: "dru", ReqSkill1: "Molten Boulder", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 15, LvlMana: 0, MinMana: 1, ManaShift: 8, CastDelayFrames: 50, Synergies: []string{"Firestorm", "Volcano"}},
+	235: {Name: "Cyclone Armor", SkillDesc: "cyclone armor", ID: 235, LeftSkill: false, RightSkill: true, ReqLevel: 12, Class: "dru", ReqSkill1: "Arctic Blast", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 5, LvlMana: 1, MinMana: 1, ManaShift: 8, CastDelayFrames: 0, Synergies: nil},
+	236: {Name: "Heart of Wolverine", SkillDesc: "heart of wolverine", ID: 236, LeftSkill: false, RightSkill: true, ReqLevel: 18, Class: "dru", ReqSkill1: "Oak Sage", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 20, LvlMana: 1, MinMana: 1, ManaShift: 8, CastDelayFrames: 0, Synergies: nil},
+	237: {Name: "Summon Fenris", SkillDesc: "summon fenris", ID: 237, LeftSkill: false, RightSkill: true, ReqLevel: 18, Class: "dru", ReqSkill1: "Oak Sage", ReqSkill2: "Summon Spirit Wolf", ReqSkill3: "", MaxLevel: 20, Mana: 20, LvlMana: 0, MinMana: 1, ManaShift: 8, CastDelayFrames: 0, Synergies: nil},
+	238: {Name: "Rabies", SkillDesc: "rabies", ID: 238, LeftSkill: true, RightSkill: true, ReqLevel: 18, Class: "dru", ReqSkill1: "Feral Rage", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 10, LvlMana: 0, MinMana: 1, ManaShift: 8, CastDelayFrames: 0, Synergies: []string{"Plague Poppy"}},
+	239: {Name: "Fire Claws", SkillDesc: "fire claws", ID: 239, LeftSkill: true, RightSkill: true, ReqLevel: 18, Class: "dru", ReqSkill1: "Feral Rage", ReqSkill2: "Maul", ReqSkill3: "", MaxLevel: 20, Mana: 4, LvlMana: 0, MinMana: 1, ManaShift: 8, CastDelayFrames: 0, Synergies: []string{"Firestorm", "Molten Boulder"}},
+	240: {Name: "Twister", SkillDesc: "twister", ID: 240, LeftSkill: true, RightSkill: true, ReqLevel: 18, Class: "dru", ReqSkill1: "Cyclone Armor", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 7, LvlMana: 0, MinMana: 1, ManaShift: 8, CastDelayFrames: 0, Synergies: []string{"Tornado", "Hurricane"}},
+	241: {Name: "Vines", SkillDesc: "vines", ID: 241, LeftSkill: false, RightSkill: true, ReqLevel: 24, Class: "dru", ReqSkill1: "Cycle of Life", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 14, LvlMana: 1, MinMana: 1, ManaShift: 8, CastDelayFrames: 0, Synergies: nil},
+	242: {Name: "Hunger", SkillDesc: "hunger", ID: 242, LeftSkill: true, RightSkill: true, ReqLevel: 24, Class: "dru", ReqSkill1: "Fire Claws", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 3, LvlMana: 0, MinMana: 1, ManaShift: 8, CastDelayFrames: 0, Synergies: nil},
+	243: {Name: "Shock Wave", SkillDesc: "shock wave", ID: 243, LeftSkill: true, RightSkill: true, ReqLevel: 24, Class: "dru", ReqSkill1: "Maul", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 7, LvlMana: 0, MinMana: 1, ManaShift: 8, CastDelayFrames: 0, Synergies: []string{"Maul"}},
+	244: {Name: "Volcano", SkillDesc: "volcano", ID: 244, LeftSkill: false, RightSkill: true, ReqLevel: 24, Class: "dru", ReqSkill1: "Eruption", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 25, LvlMana: 0, MinMana: 1, ManaShift: 8, CastDelayFrames: 100, Synergies: []string{"Molten Boulder", "Eruption", "Armageddon"}},
+	245: {Name: "Tornado", SkillDesc: "tornado", ID: 245, LeftSkill: true, RightSkill: true, ReqLevel: 24, Class: "dru", ReqSkill1: "Twister", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 10, LvlMana: 0, MinMana: 1, ManaShift: 8, CastDelayFrames: 0, Synergies: []string{"Cyclone Armor", "Twister", "Hurricane"}},
+	246: {Name: "Spirit of Barbs", SkillDesc: "spirit of barbs", ID: 246, LeftSkill: false, RightSkill: true, ReqLevel: 30, Class: "dru", ReqSkill1: "Heart of Wolverine", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 25, LvlMana: 1, MinMana: 1, ManaShift: 8, CastDelayFrames: 0, Synergies: nil},
+	247: {Name: "Summon Grizzly", SkillDesc: "summon grizzly", ID: 247, LeftSkill: false, RightSkill: true, ReqLevel: 30, Class: "dru", ReqSkill1: "Summon Fenris", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 40, LvlMana: 0, MinMana: 1, ManaShift: 8, CastDelayFrames: 0, Synergies: nil},
+	248: {Name: "Fury", SkillDesc: "fury", ID: 248, LeftSkill: true, RightSkill: true, ReqLevel: 30, Class: "dru", ReqSkill1: "Rabies", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 4, LvlMana: 0, MinMana: 1, ManaShift: 8, CastDelayFrames: 0, Synergies: nil},
+	249: {Name: "Armageddon", SkillDesc: "armageddon", ID: 249, LeftSkill: false, RightSkill: true, ReqLevel: 30, Class: "dru", ReqSkill1: "Volcano", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 35, LvlMana: 0, MinMana: 1, ManaShift: 8, CastDelayFrames: 0, Synergies: []string{"Volcano", "Molten Boulder", "Firestorm"}},
+	250: {Name: "Hurricane", SkillDesc: "hurricane", ID: 250, LeftSkill: false, RightSkill: true, ReqLevel: 30, Class: "dru", ReqSkill1: "Tornado", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 30, LvlMana: 0, MinMana: 1, ManaShift: 8, CastDelayFrames: 0, Synergies: []string{"Twister", "Tornado"}},
+	251: {Name: "Fire Trauma", SkillDesc: "fire trauma", ID: 251, LeftSkill: true, RightSkill: true, ReqLevel: 1, Class: "ass", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 24, LvlMana: 1, MinMana: 1, ManaShift: 5, CastDelayFrames: 0, Synergies: []string{"Shock Field", "Charged Bolt Sentry", "Lightning Sentry", "Wake of Fire Sentry", "Inferno Sentry"}},
+	252: {Name: "Claw Mastery", SkillDesc: "claw mastery", ID: 252, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "ass", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 8, CastDelayFrames: 0, Synergies: nil},
+	253: {Name: "Psychic Hammer", SkillDesc: "psychic hammer", ID: 253, LeftSkill: false, RightSkill: true, ReqLevel: 1, Class: "ass", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 16, LvlMana: 1, MinMana: 1, ManaShift: 6, CastDelayFrames: 0, Synergies: nil},
+	254: {Name: "Tiger Strike", SkillDesc: "tiger strike", ID: 254, LeftSkill: true, RightSkill: true, ReqLevel: 1, Class: "ass", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 1, LvlMana: 0, MinMana: 1, ManaShift: 8, CastDelayFrames: 0, Synergies: nil},
+	255: {Name: "Dragon Talon", SkillDesc: "dragon talon", ID: 255, LeftSkill: true, RightSkill: true, ReqLevel: 1, Class: "ass", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 6, LvlMana: 0, MinMana: 1, ManaShift: 8, CastDelayFrames: 0, Synergies: nil},
+	256: {Name: "Shock Field", SkillDesc: "shock field", ID: 256, LeftSkill: true, RightSkill: true, ReqLevel: 6, Class: "ass", ReqSkill1: "Fire Trauma", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 6, LvlMana: 0, MinMana: 1, ManaShift: 8, CastDelayFrames: 15, Synergies: []string{"Charged Bolt Sentry", "Lightning Sentry"}},
+	257: {Name: "Blade Sentinel", SkillDesc: "blade sentinel", ID: 257, LeftSkill: true, RightSkill: true, ReqLevel: 6, Class: "ass", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 7, LvlMana: 0, MinMana: 1, ManaShift: 8, CastDelayFrames: 25, Synergies: []string{"Blade Fury", "Blade Shield"}},
+	258: {Name: "Quickness", SkillDesc: "quickness", ID: 258, LeftSkill: false, RightSkill: true, ReqLevel: 6, Class: "ass", ReqSkill1: "Claw Mastery", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 10, LvlMana: 0, MinMana: 1, ManaShift: 8, CastDelayFrames: 0, Synergies: nil},
+	259: {Name: "Fists of Fire", SkillDesc: "fists of fire", ID: 259, LeftSkill: true, RightSkill: true, ReqLevel: 6, Class: "ass", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 2, LvlMana: 0, MinMana: 1, ManaShift: 8, CastDelayFrames: 0, Synergies: []string{"Royal Strike"}},
+	260: {Name: "Dragon Claw", SkillDesc: "dragon claw", ID: 260, LeftSkill: true, RightSkill: true, ReqLevel: 6, Class: "ass", ReqSkill1: "Dragon Talon", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 2, LvlMana: 0, MinMana: 1, ManaShift: 8, CastDelayFrames: 0, Synergies: nil},
+	261: {Name: "Charged Bolt Sentry", SkillDesc: "charged bolt sentry", ID: 261, LeftSkill: false, RightSkill: true, ReqLevel: 12, Class: "ass", ReqSkill1: "Shock Field", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 13, LvlMana: 0, MinMana: 1, ManaShift: 8, CastDelayFrames: 0, Synergies: []string{"Fire Trauma", "Lightning Sentry"}},
+	262: {Name: "Wake of Fire Sentry", SkillDesc: "wake of fire sentry", ID: 262, LeftSkill: false, RightSkill: true, ReqLevel: 12, Class: "ass", ReqSkill1: "Fire Trauma", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 13, LvlMana: 0, MinMana: 1, ManaShift: 8, CastDelayFrames: 0, Synergies: []string{"Fire Trauma", "Inferno Sentry"}},
+	263: {Name: "Weapon Block", SkillDesc: "weapon block", ID: 263, LeftSkill: false, RightSkill: false, ReqLevel: 12, Class: "ass", ReqSkill1: "Claw Mastery", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 8, CastDelayFrames: 0, Synergies: nil},
+	264: {Name: "Cloak of Shadows", SkillDesc: "cloak of shadows", ID: 264, LeftSkill: false, RightSkill: true, ReqLevel: 12, Class: "ass", ReqSkill1: "Psychic Hammer", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 13, LvlMana: 0, MinMana: 1, ManaShift: 8, CastDelayFrames: 0, Synergies: nil},
+	265: {Name: "Cobra Strike", SkillDesc: "cobra strike", ID: 265, LeftSkill: true, RightSkill: true, ReqLevel: 12, Class: "ass", ReqSkill1: "Tiger Strike", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 2, LvlMana: 0, MinMana: 1, ManaShift: 8, CastDelayFrames: 0, Synergies: nil},
+	266: {Name: "Blade Fury", SkillDesc: "blade fury", ID: 266, LeftSkill: true, RightSkill: true, ReqLevel: 18, Class: "ass", ReqSkill1: "Blade Sentinel", ReqSkill2: "Wake of Fire Sentry", ReqSkill3: "", MaxLevel: 20, Mana: 8, LvlMana: 1, MinMana: 0, ManaShift: 5, CastDelayFrames: 0, Synergies: []string{"Blade Sentinel", "Blade Shield"}},
+	267: {Name: "Fade", SkillDesc: "fade", ID: 267, LeftSkill: false, RightSkill: true, ReqLevel: 18, Class: "ass", ReqSkill1: "Quickness", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 10, LvlMana: 0, MinMana: 1, ManaShift: 8, CastDelayFrames: 0, Synergies: nil},
+	268: {Name: "Shadow Warrior", SkillDesc: "shadow warrior", ID: 268, LeftSkill: false, RightSkill: true, ReqLevel: 18, Class: "ass", ReqSkill1: "Cloak of Shadows", ReqSkill2: "Weapon Block", ReqSkill3: "", MaxLevel: 20, Mana: 54, LvlMana: 1, MinMana: 1, ManaShift: 7, CastDelayFrames: 15, Synergies: nil},
+	269: {Name: "Claws of Thunder", SkillDesc: "claws of thunder", ID: 269, LeftSkill: true, RightSkill: true, ReqLevel: 18, Class: "ass", ReqSkill1: "Fists of Fire", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 4, LvlMana: 0, MinMana: 1, ManaShift: 8, CastDelayFrames: 0, Synergies: []string{"Royal Strike"}},
+	270: {Name: "Dragon Tail", SkillDesc: "dragon tail", ID: 270, LeftSkill: true, RightSkill: true, ReqLevel: 18, Class: "ass", ReqSkill1: "Dragon Claw", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 10, LvlMana: 0, MinMana: 1, ManaShift: 8, CastDelayFrames: 0, Synergies: nil},
+	271: {Name: "Lightning Sentry", SkillDesc: "lightning sentry", ID: 271, LeftSkill: false, RightSkill: true, ReqLevel: 24, Class: "ass", ReqSkill1: "Charged Bolt Sentry", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 20, LvlMana: 0, MinMana: 1, ManaShift: 8, CastDelayFrames: 0, Synergies: []string{"Shock Field", "Charged Bolt Sentry"}},
+	272: {Name: "Inferno Sentry", SkillDesc: "inferno sentry", ID: 272, LeftSkill: false, RightSkill: true, ReqLevel: 24, Class: "ass", ReqSkill1: "Wake of Fire Sentry", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 20, LvlMana: 0, MinMana: 1, ManaShift: 8, CastDelayFrames: 0, Synergies: []string{"Fire Trauma", "Wake of Fire Sentry"}},
+	273: {Name: "Mind Blast", SkillDesc: "mind blast", ID: 273, LeftSkill: false, RightSkill: true, ReqLevel: 24, Class: "ass", ReqSkill1: "Cloak of Shadows", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 15, LvlMana: 0, MinMana: 1, ManaShift: 8, CastDelayFrames: 0, Synergies: nil},
+	274: {Name: "Blades of Ice", SkillDesc: "blades of ice", ID: 274, LeftSkill: true, RightSkill: true, ReqLevel: 24, Class: "ass", ReqSkill1: "Claws of Thunder", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 3, LvlMana: 0, MinMana: 1, ManaShift: 8, CastDelayFrames: 0, Synergies: []string{"Royal Strike"}},
+	275: {Name: "Dragon Flight", SkillDesc: "dragon flight", ID: 275, LeftSkill: true, RightSkill: true, ReqLevel: 24, Class: "ass", ReqSkill1: "Dragon Tail", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 15, LvlMana: 0, MinMana: 1, ManaShift: 8, CastDelayFrames: 0, Synergies: nil},
+	276: {Name: "Death Sentry", SkillDesc: "death sentry", ID: 276, LeftSkill: false, RightSkill: true, ReqLevel: 30, Class: "ass", ReqSkill1: "Lightning Sentry", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 20, LvlMana: 0, MinMana: 1, ManaShift: 8, CastDelayFrames: 0, Synergies: []string{"Lightning Sentry"}},
+	277: {Name: "Blade Shield", SkillDesc: "blade shield", ID: 277, LeftSkill: false, RightSkill: true, ReqLevel: 30, Class: "ass", ReqSkill1: "Blade Fury", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 27, LvlMana: 2, MinMana: 1, ManaShift: 8, CastDelayFrames: 0, Synergies: []string{"Blade Sentinel", "Blade Fury"}},
+	278: {Name: "Venom", SkillDesc: "venom", ID: 278, LeftSkill: false, RightSkill: true, ReqLevel: 30, Class: "ass", ReqSkill1: "Fade", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 12, LvlMana: 0, MinMana: 1, ManaShift: 8, CastDelayFrames: 0, Synergies: nil},
+	279: {Name: "Shadow Master", SkillDesc: "shadow master", ID: 279, LeftSkill: false, RightSkill: true, ReqLevel: 30, Class: "ass", ReqSkill1: "Shadow Warrior", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 70, LvlMana: 1, MinMana: 1, ManaShift: 7, CastDelayFrames: 15, Synergies: nil},
+	280: {Name: "Royal Strike", SkillDesc: "royal strike", ID: 280, LeftSkill: true, RightSkill: true, ReqLevel: 30, Class: "ass", ReqSkill1: "Cobra Strike", ReqSkill2: "Blades of Ice", ReqSkill3: "", MaxLevel: 20, Mana: 4, LvlMana: 0, MinMana: 1, ManaShift: 8, CastDelayFrames: 0, Synergies: nil},
+	281: {Name: "Wake Of Destruction Sentry", SkillDesc: "", ID: 281, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 0, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 0, CastDelayFrames: 0, Synergies: nil},
+	282: {Name: "Imp Inferno", SkillDesc: "", ID: 282, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 0, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 0, CastDelayFrames: 0, Synergies: nil},
+	283: {Name: "Imp Fireball", SkillDesc: "", ID: 283, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 0, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 0, CastDelayFrames: 0, Synergies: nil},
+	284: {Name: "Baal Taunt", SkillDesc: "", ID: 284, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 0, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 0, CastDelayFrames: 0, Synergies: nil},
+	285: {Name: "Baal Corpse Explode", SkillDesc: "", ID: 285, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 0, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 0, CastDelayFrames: 0, Synergies: nil},
+	286: {Name: "Baal Monster Spawn", SkillDesc: "", ID: 286, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 0, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 0, CastDelayFrames: 0, Synergies: nil},
+	287: {Name: "Catapult Charged Ball", SkillDesc: "", ID: 287, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 0, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 0, CastDelayFrames: 0, Synergies: nil},
+	288: {Name: "Catapult Spike Ball", SkillDesc: "", ID: 288, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 0, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 0, CastDelayFrames: 0, Synergies: nil},
+	289: {Name: "Suck Blood", SkillDesc: "", ID: 289, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 0, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 0, CastDelayFrames: 0, Synergies: nil},
+	290: {Name: "Cry Help", SkillDesc: "", ID: 290, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 0, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 0, CastDelayFrames: 0, Synergies: nil},
+	291: {Name: "Healing Vortex", SkillDesc: "", ID: 291, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 0, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 0, CastDelayFrames: 0, Synergies: nil},
+	292: {Name: "Teleport 2", SkillDesc: "", ID: 292, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 0, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 0, CastDelayFrames: 0, Synergies: nil},
+	293: {Name: "Self-resurrect", SkillDesc: "", ID: 293, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 0, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 0, CastDelayFrames: 0, Synergies: nil},
+	294: {Name: "Vine Attack", SkillDesc: "", ID: 294, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 0, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 0, CastDelayFrames: 0, Synergies: nil},
+	295: {Name: "Overseer Whip", SkillDesc: "", ID: 295, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 0, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 0, CastDelayFrames: 0, Synergies: nil},
+	296: {Name: "Barbs Aura", SkillDesc: "", ID: 296, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 0, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 0, CastDelayFrames: 0, Synergies: nil},
+	297: {Name: "Wolverine Aura", SkillDesc: "", ID: 297, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 0, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 0, CastDelayFrames: 0, Synergies: nil},
+	298: {Name: "Oak Sage Aura", SkillDesc: "", ID: 298, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 0, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 0, CastDelayFrames: 0, Synergies: nil},
+	299: {Name: "Imp Fire Missile", SkillDesc: "", ID: 299, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 0, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 0, CastDelayFrames: 0, Synergies: nil},
+	300: {Name: "Impregnate", SkillDesc: "", ID: 300, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 0, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 0, CastDelayFrames: 0, Synergies: nil},
+	301: {Name: "Siege Beast Stomp", SkillDesc: "", ID: 301, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 0, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 0, CastDelayFrames: 0, Synergies: nil},
+	302: {Name: "MinionSpawner", SkillDesc: "", ID: 302, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 0, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 0, CastDelayFrames: 0, Synergies: nil},
+	303: {Name: "CatapultBlizzard", SkillDesc: "", ID: 303, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 0, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 0, CastDelayFrames: 0, Synergies: nil},
+	304: {Name: "CatapultPlague", SkillDesc: "", ID: 304, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 0, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 0, CastDelayFrames: 0, Synergies: nil},
+	305: {Name: "CatapultMeteor", SkillDesc: "", ID: 305, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 0, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 0, CastDelayFrames: 0, Synergies: nil},
+	306: {Name: "BoltSentry", SkillDesc: "", ID: 306, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 0, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 0, CastDelayFrames: 0, Synergies: nil},
+	307: {Name: "CorpseCycler", SkillDesc: "", ID: 307, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 0, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 0, CastDelayFrames: 0, Synergies: nil},
+	308: {Name: "DeathMaul", SkillDesc: "", ID: 308, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 0, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 0, CastDelayFrames: 0, Synergies: nil},
+	309: {Name: "Defense Curse", SkillDesc: "", ID: 309, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 0, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 0, CastDelayFrames: 0, Synergies: nil},
+	310: {Name: "Blood Mana", SkillDesc: "", ID: 310, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 0, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 0, CastDelayFrames: 0, Synergies: nil},
+	311: {Name: "mon inferno sentry", SkillDesc: "", ID: 311, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 0, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 0, CastDelayFrames: 0, Synergies: nil},
+	312: {Name: "mon death sentry", SkillDesc: "", ID: 312, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 0, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 0, CastDelayFrames: 0, Synergies: nil},
+	313: {Name: "sentry lightning", SkillDesc: "", ID: 313, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 0, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 0, CastDelayFrames: 0, Synergies: nil},
+	314: {Name: "fenris rage", SkillDesc: "", ID: 314, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 0, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 0, CastDelayFrames: 0, Synergies: nil},
+	315: {Name: "Baal Tentacle", SkillDesc: "", ID: 315, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 0, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 0, CastDelayFrames: 0, Synergies: nil},
+	316: {Name: "Baal Nova", SkillDesc: "", ID: 316, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 0, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 0, CastDelayFrames: 0, Synergies: nil},
+	317: {Name: "Baal Inferno", SkillDesc: "", ID: 317, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 0, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 0, CastDelayFrames: 0, Synergies: nil},
+	318: {Name: "Baal Cold Missiles", SkillDesc: "", ID: 318, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 0, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 0, CastDelayFrames: 0, Synergies: nil},
+	319: {Name: "MegademonInferno", SkillDesc: "", ID: 319, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 0, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 0, CastDelayFrames: 0, Synergies: nil},
+	320: {Name: "EvilHutSpawner", SkillDesc: "", ID: 320, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 0, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 0, CastDelayFrames: 0, Synergies: nil},
+	321: {Name: "CountessFirewall", SkillDesc: "", ID: 321, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 0, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 0, CastDelayFrames: 0, Synergies: nil},
+	322: {Name: "ImpBolt", SkillDesc: "", ID: 322, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 0, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 0, CastDelayFrames: 0, Synergies: nil},
+	323: {Name: "Horror Arctic Blast", SkillDesc: "", ID: 323, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 0, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 0, CastDelayFrames: 0, Synergies: nil},
+	324: {Name: "death sentry ltng", SkillDesc: "", ID: 324, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 0, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 0, CastDelayFrames: 0, Synergies: nil},
+	325: {Name: "VineCycler", SkillDesc: "", ID: 325, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 0, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 0, CastDelayFrames: 0, Synergies: nil},
+	326: {Name: "BearSmite", SkillDesc: "", ID: 326, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 0, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 0, CastDelayFrames: 0, Synergies: nil},
+	327: {Name: "Resurrect2", SkillDesc: "", ID: 327, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 0, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 0, CastDelayFrames: 0, Synergies: nil},
+	328: {Name: "BloodLordFrenzy", SkillDesc: "", ID: 328, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 0, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 0, CastDelayFrames: 0, Synergies: nil},
+	329: {Name: "Baal Teleport", SkillDesc: "", ID: 329, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 0, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 0, CastDelayFrames: 0, Synergies: nil},
+	330: {Name: "Imp Teleport", SkillDesc: "", ID: 330, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 0, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 0, CastDelayFrames: 0, Synergies: nil},
+	331: {Name: "Baal Clone Teleport", SkillDesc: "", ID: 331, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 0, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 0, CastDelayFrames: 0, Synergies: nil},
+	332: {Name: "ZakarumLightning", SkillDesc: "", ID: 332, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 0, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 0, CastDelayFrames: 0, Synergies: nil},
+	333: {Name: "VampireMissile", SkillDesc: "", ID: 333, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 0, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 0, CastDelayFrames: 0, Synergies: nil},
+	334: {Name: "MephistoMissile", SkillDesc: "", ID: 334, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 0, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 0, CastDelayFrames: 0, Synergies: nil},
+	335: {Name: "DoomKnightMissile", SkillDesc: "", ID: 335, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 0, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 0, CastDelayFrames: 0, Synergies: nil},
+	336: {Name: "RogueMissile", SkillDesc: "", ID: 336, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 0, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 0, CastDelayFrames: 0, Synergies: nil},
+	337: {Name: "HydraMissile", SkillDesc: "", ID: 337, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 0, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 0, CastDelayFrames: 0, Synergies: nil},
+	338: {Name: "NecromageMissile", SkillDesc: "", ID: 338, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 0, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 0, CastDelayFrames: 0, Synergies: nil},
+	339: {Name: "MonBow", SkillDesc: "", ID: 339, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 0, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 0, CastDelayFrames: 0, Synergies: nil},
+	340: {Name: "MonFireArrow", SkillDesc: "", ID: 340, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 0, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 0, CastDelayFrames: 0, Synergies: nil},
+	341: {Name: "MonColdArrow", SkillDesc: "", ID: 341, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 0, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 0, CastDelayFrames: 0, Synergies: nil},
+	342: {Name: "MonExplodingArrow", SkillDesc: "", ID: 342, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 0, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 0, CastDelayFrames: 0, Synergies: nil},
+	343: {Name: "MonFreezingArrow", SkillDesc: "", ID: 343, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 0, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 0, CastDelayFrames: 0, Synergies: nil},
+	344: {Name: "MonPowerStrike", SkillDesc: "", ID: 344, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 0, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 0, CastDelayFrames: 0, Synergies: nil},
+	345: {Name: "SuccubusBolt", SkillDesc: "", ID: 345, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 0, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 0, CastDelayFrames: 0, Synergies: nil},
+	346: {Name: "MephFrostNova", SkillDesc: "", ID: 346, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 0, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 0, CastDelayFrames: 0, Synergies: nil},
+	347: {Name: "MonIceSpear", SkillDesc: "", ID: 347, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 0, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 0, CastDelayFrames: 0, Synergies: nil},
+	348: {Name: "ShamanIce", SkillDesc: "", ID: 348, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 0, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 0, CastDelayFrames: 0, Synergies: nil},
+	349: {Name: "Diablogeddon", SkillDesc: "", ID: 349, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 0, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 0, CastDelayFrames: 0, Synergies: nil},
+	350: {Name: "Delerium Change", SkillDesc: "delerium change", ID: 350, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 0, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 0, CastDelayFrames: 0, Synergies: nil},
+	351: {Name: "NihlathakCorpseExplosion", SkillDesc: "", ID: 351, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 0, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 0, CastDelayFrames: 0, Synergies: nil},
+	352: {Name: "SerpentCharge", SkillDesc: "", ID: 352, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 0, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 0, CastDelayFrames: 0, Synergies: nil},
+	353: {Name: "Trap Nova", SkillDesc: "", ID: 353, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 0, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 0, CastDelayFrames: 0, Synergies: nil},
+	354: {Name: "UnHolyBoltEx", SkillDesc: "", ID: 354, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 0, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 0, CastDelayFrames: 0, Synergies: nil},
+	355: {Name: "ShamanFireEx", SkillDesc: "", ID: 355, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 0, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 0, CastDelayFrames: 0, Synergies: nil},
+	356: {Name: "Imp Fire Missile Ex", SkillDesc: "", ID: 356, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 0, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 0, CastDelayFrames: 0, Synergies: nil},
+	357: {Name: "Interact", SkillDesc: "interact", ID: 357, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 0, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 0, CastDelayFrames: 0, Synergies: nil},
+	358: {Name: "Loot", SkillDesc: "loot", ID: 358, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 0, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 0, CastDelayFrames: 0, Synergies: nil},
+	359: {Name: "TownPortal", SkillDesc: "townportal", ID: 359, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 0, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 0, CastDelayFrames: 0, Synergies: nil},
+	360: {Name: "EmoteWheel", SkillDesc: "emotewheel", ID: 360, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 0, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 0, CastDelayFrames: 0, Synergies: nil},
+	361: {Name: "SwapWeapons", SkillDesc: "swapweapons", ID: 361, LeftSkill: false, RightSkill: false, ReqLevel: 0, Class: "", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 0, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 0, CastDelayFrames: 0, Synergies: nil},
+	362: {Name: "Map", SkillDesc: "map", ID: 362, LeftSkill: false, RightSkill: false, ReqLevel: 0, Class: "", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 0, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 0, CastDelayFrames: 0, Synergies: nil},
+	363: {Name: "ShowItems", SkillDesc: "showitems", ID: 363, LeftSkill: false, RightSkill: false, ReqLevel: 0, Class: "", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 0, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 0, CastDelayFrames: 0, Synergies: nil},
+	364: {Name: "RunToggle", SkillDesc: "runtoggle", ID: 364, LeftSkill: false, RightSkill: false, ReqLevel: 0, Class: "", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 0, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 0, CastDelayFrames: 0, Synergies: nil},
+	365: {Name: "MonHolyFreeze", SkillDesc: "", ID: 365, LeftSkill: false, RightSkill: true, ReqLevel: 18, Class: "", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 0, CastDelayFrames: 0, Synergies: nil},
+	366: {Name: "MonLeap", SkillDesc: "", ID: 366, LeftSkill: false, RightSkill: false, ReqLevel: 6, Class: "", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 0, CastDelayFrames: 0, Synergies: nil},
+	367: {Name: "MonLeapAttack", SkillDesc: "", ID: 367, LeftSkill: false, RightSkill: false, ReqLevel: 18, Class: "", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 0, CastDelayFrames: 0, Synergies: nil},
+	368: {Name: "MonHolyFire", SkillDesc: "", ID: 368, LeftSkill: false, RightSkill: true, ReqLevel: 6, Class: "", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 0, CastDelayFrames: 0, Synergies: nil},
+	369: {Name: "MonHolyShock", SkillDesc: "", ID: 369, LeftSkill: false, RightSkill: true, ReqLevel: 24, Class: "", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 20, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 0, CastDelayFrames: 0, Synergies: nil},
+	370: {Name: "CubeLoot", SkillDesc: "cubeloot", ID: 370, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 0, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 0, CastDelayFrames: 0, Synergies: nil},
+	371: {Name: "Mark of the Bear", SkillDesc: "mark of the bear", ID: 371, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 0, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 0, CastDelayFrames: 0, Synergies: nil},
+	372: {Name: "Mark of the Wolf", SkillDesc: "mark of the wolf", ID: 372, LeftSkill: false, RightSkill: false, ReqLevel: 1, Class: "", ReqSkill1: "", ReqSkill2: "", ReqSkill3: "", MaxLevel: 0, Mana: 0, LvlMana: 0, MinMana: 0, ManaShift: 0, CastDelayFrames: 0, Synergies: nil},
 }
@@ -0,0 +1,143 @@
+package data
+
+import "slices"
+
+// Grid cell size in sub-tiles, most queries (attack range, teleport distance) cover a few cells at most
+const spatialCellSize = 16
+
+type cellKey struct {
+	X, Y int
+}
+
+// SpatialIndex groups units by position in a coarse grid, so radius queries only check the units in the cells
+// overlapping the radius. Build it once after each refresh (e.g. Monsters.Index) and reuse it for all the queries
+type SpatialIndex[T any] struct {
+	units    []T
+	position func(T) Position
+	cells    map[cellKey][]int
+}
+
+func NewSpatialIndex[T any](units []T, position func(T) Position) *SpatialIndex[T] {
+	idx := &SpatialIndex[T]{
+		units:    units,
+		position: position,
+		cells:    make(map[cellKey][]int, len(units)),
+	}
+	for i, u := range units {
+		key := cellOf(position(u))
+		idx.cells[key] = append(idx.cells[key], i)
+	}
+
+	return idx
+}
+
+// WithinRadius returns the units at the given distance or closer, in the same order they were indexed
+func (idx *SpatialIndex[T]) WithinRadius(p Position, radius int) []T {
+	found := make([]int, 0)
+	minCell := cellOf(Position{X: p.X - radius, Y: p.Y - radius})
+	maxCell := cellOf(Position{X: p.X + radius, Y: p.Y + radius})
+	for x := minCell.X; x <= maxCell.X; x++ {
+		for y := minCell.Y; y <= maxCell.Y; y++ {
+			for _, i := range idx.cells[cellKey{X: x, Y: y}] {
+				if sqDistance(p, idx.position(idx.units[i])) <= radius*radius {
+					found = append(found, i)
+				}
+			}
+		}
+	}
+
+	// Keep the original order, monsters and objects are sorted by distance to the player
+	slices.Sort(found)
+	units := make([]T, 0, len(found))
+	for _, i := range found {
+		units = append(units, idx.units[i])
+	}
+
+	return units
+}
+
+// Closest returns the closest unit to the given position matching the filter, nil filter matches all the units
+func (idx *SpatialIndex[T]) Closest(p Position, filter func(T) bool) (T, bool) {
+	return closestWithFilter(idx.units, idx.position, p, filter)
+}
+
+func closestWithFilter[T any](units []T, position func(T) Position, p Position, filter func(T) bool) (T, bool) {
+	var closest T
+	found, closestDistance := false, 0
+	for _, u := range units {
+		if filter != nil && !filter(u) {
+			continue
+		}
+		if d := sqDistance(p, position(u)); !found || d < closestDistance {
+			closest, closestDistance, found = u, d, true
+		}
+	}
+
+	return closest, found
+}
+
+func withinRadius[T any](units []T, position func(T) Position, p Position, radius int) []T {
+	found := make([]T, 0)
+	for _, u := range units {
+		if sqDistance(p, position(u)) <= radius*radius {
+			found = append(found, u)
+		}
+	}
+
+	return found
+}
+
+func cellOf(p Position) cellKey {
+	return cellKey{X: floorDiv(p.X, spatialCellSize), Y: floorDiv(p.Y, spatialCellSize)}
+}
+
+func floorDiv(a, b int) int {
+	if a < 0 {
+		return (a - b + 1) / b
+	}
+
+	return a / b
+}
+
+func monsterPosition(m Monster) Position { return m.Position }
+func objectPosition(o Object) Position   { return o.Position }
+
+// Index builds a spatial index of the monsters, for many radius queries over the same monsters
+func (m Monsters) Index() *SpatialIndex[Monster] {
+	return NewSpatialIndex(m, monsterPosition)
+}
+
+// WithinRadius returns the monsters at the given distance (in sub-tiles) or closer
+func (m Monsters) WithinRadius(p Position, radius int) Monsters {
+	return withinRadius(m, monsterPosition, p, radius)
+}
+
+// ClosestWithFilter returns the closest monster to the given position matching the filter
+func (m Monsters) ClosestWithFilter(p Position, filter func(Monster) bool) (Monster, bool) {
+	return closestWithFilter(m, monsterPosition, p, filter)
+}
+
+// CountByType returns the number of monsters of each type (normal, champion, unique...)
+func (m Monsters) CountByType() map[MonsterType]int {
+	count := make(map[MonsterType]int)
+	for _, mo := range m {
+		count[mo.Type]++
+	}
+
+	return count
+}
+
+// Index builds a spatial index of the objects, for many radius queries over the same objects
+func (o Objects) Index() *SpatialIndex[Object] {
+	return NewSpatialIndex(o, objectPosition)
+}
+
+// WithinRadius returns the objects at the given distance (in sub-tiles) or closer
+func (o Objects) WithinRadius(p Position, radius int) Objects {
+	return withinRadius(o, objectPosition, p, radius)
+}
+
+// ClosestWithFilter returns the closest object to the given position matching the filter
+func (o Objects) ClosestWithFilter(p Position, filter func(Object) bool) (Object, bool) {
+	return closestWithFilter(o, objectPosition, p, filter)
+}
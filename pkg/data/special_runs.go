@@ -0,0 +1,49 @@
+package data
+
+import (
+	"github.com/hectorgimenez/d2go/pkg/data/area"
+	"github.com/hectorgimenez/d2go/pkg/data/difficulty"
+	"github.com/hectorgimenez/d2go/pkg/data/item"
+)
+
+// countessMaxRunes is the highest rune of the Countess rune treasure class (Countess Rune) on each difficulty
+var countessMaxRunes = map[difficulty.Difficulty]item.Name{
+	difficulty.Normal:    "RalRune",
+	difficulty.Nightmare: "IoRune",
+	difficulty.Hell:      "IstRune",
+}
+
+// CountessMaxRune returns the highest rune the Countess can drop from her rune treasure class on the given difficulty
+func CountessMaxRune(d difficulty.Difficulty) (item.Name, bool) {
+	r, found := countessMaxRunes[d]
+	return r, found
+}
+
+// IsCountessRuneDrop returns true if the rune can be dropped by the Countess rune treasure class on the given
+// difficulty, runes are sorted by rank in the item names so any rune from El up to the highest one is included
+func IsCountessRuneDrop(d difficulty.Difficulty, rune item.Name) bool {
+	maxRune, found := countessMaxRunes[d]
+	if !found {
+		return false
+	}
+
+	id := item.GetIDByName(string(rune))
+	return id >= item.GetIDByName("ElRune") && id <= item.GetIDByName(string(maxRune))
+}
+
+// AtCountess returns true if the player is in the Forgotten Tower level where the Countess spawns
+func (d Data) AtCountess() bool {
+	return d.PlayerUnit.Area == area.TowerCellarLevel5
+}
+
+// LowerKurastSuperChests returns the super chests found in Lower Kurast (the ones next to the bonfires), already
+// opened chests are not selectable anymore. Objects are only read around the player. There is no preset seed data for
+// the chests: the map seed is not read from memory (utils.GetMapSeed needs hash values the GameReader doesn't read)
+// and no per-chest seed has been mapped in the object unit data, so chests are matched by object id instead
+func (d Data) LowerKurastSuperChests() Objects {
+	if d.PlayerUnit.Area != area.LowerKurast {
+		return Objects{}
+	}
+
+	return d.Objects.Filter(Object.IsSuperChest)
+}
@@ -0,0 +1,29 @@
+package state
+
+import (
+	"github.com/hectorgimenez/d2go/pkg/data/skill"
+	"github.com/hectorgimenez/d2go/pkg/data/stat"
+)
+
+// Entry is an active state with the information of what applied it, states without a stat list attached (most of
+// the non-buff states) only have the State field set
+type Entry struct {
+	State      State
+	Skill      skill.ID // Skill that applied the state, 0 if it comes from an item or the game
+	SkillLevel int
+	FromItem   bool // State granted by an equipped item instead of being cast
+	Stacks     int  // Amount of stat lists applying the same state
+	Stats      stat.Stats
+}
+
+type Entries []Entry
+
+func (e Entries) Find(state State) (Entry, bool) {
+	for _, entry := range e {
+		if entry.State == state {
+			return entry, true
+		}
+	}
+
+	return Entry{}, false
+}
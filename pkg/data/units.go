@@ -0,0 +1,79 @@
+package data
+
+// UnitType is the unit table a unit belongs to, same values as HoverData.UnitType
+type UnitType int
+
+const (
+	UnitTypePlayer   UnitType = 0
+	UnitTypeMonster  UnitType = 1
+	UnitTypeObject   UnitType = 2
+	UnitTypeItem     UnitType = 4
+	UnitTypeEntrance UnitType = 5
+)
+
+// Unit is a unit resolved by FindUnit, only the field matching Type is set
+type Unit struct {
+	Type     UnitType
+	ID       UnitID
+	Position Position
+	Player   *PlayerUnit // Only the main player can be resolved
+	Monster  *Monster
+	Object   *Object
+	Item     *Item
+	Entrance *Entrance
+}
+
+// FindUnit returns the unit with the given ID from any category: main player, monsters (corpses included), objects,
+// items and entrances, in that order. Unit IDs are only unique within a unit type, when the type is known (e.g. from
+// HoverData) FindUnitByType should be used instead
+func (d Data) FindUnit(id UnitID) (Unit, bool) {
+	for _, t := range []UnitType{UnitTypePlayer, UnitTypeMonster, UnitTypeObject, UnitTypeItem, UnitTypeEntrance} {
+		if u, found := d.FindUnitByType(t, id); found {
+			return u, true
+		}
+	}
+
+	return Unit{}, false
+}
+
+// FindUnitByType returns the unit with the given type and ID
+func (d Data) FindUnitByType(t UnitType, id UnitID) (Unit, bool) {
+	switch t {
+	case UnitTypePlayer:
+		if d.PlayerUnit.ID == id {
+			pu := d.PlayerUnit
+			return Unit{Type: t, ID: id, Position: pu.Position, Player: &pu}, true
+		}
+	case UnitTypeMonster:
+		m, found := d.Monsters.FindByID(id)
+		if !found {
+			m, found = d.Corpses.FindByID(id)
+		}
+		if found {
+			return Unit{Type: t, ID: id, Position: m.Position, Monster: &m}, true
+		}
+	case UnitTypeObject:
+		if o, found := d.Objects.FindByID(id); found {
+			return Unit{Type: t, ID: id, Position: o.Position, Object: &o}, true
+		}
+	case UnitTypeItem:
+		if itm, found := d.Inventory.FindByID(id); found {
+			return Unit{Type: t, ID: id, Position: itm.Position, Item: &itm}, true
+		}
+	case UnitTypeEntrance:
+		if e, found := d.Entrances.FindByID(id); found {
+			return Unit{Type: t, ID: id, Position: e.Position, Entrance: &e}, true
+		}
+	}
+
+	return Unit{}, false
+}
+
+// HoveredUnit returns the unit under the cursor, false if there is no hovered unit or it's not loaded
+func (d Data) HoveredUnit() (Unit, bool) {
+	if !d.HoverData.IsHovered {
+		return Unit{}, false
+	}
+
+	return d.FindUnitByType(UnitType(d.HoverData.UnitType), d.HoverData.UnitID)
+}
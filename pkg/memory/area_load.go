@@ -0,0 +1,34 @@
+package memory
+
+import (
+	"github.com/hectorgimenez/d2go/pkg/data/area"
+)
+
+// areaLoadTracker keeps the player area of the previous GetData call, right after an area change the unit table still
+// contains units from the previous area, so the new one is only considered loaded from the second read on
+type areaLoadTracker struct {
+	previous area.ID
+}
+
+// update returns true if the area is loaded now and it was already the player area on the previous update
+func (t *areaLoadTracker) update(current area.ID, loaded bool) bool {
+	previous := t.previous
+	t.previous = 0
+	if loaded {
+		t.previous = current
+	}
+
+	return loaded && previous == current
+}
+
+func (t *areaLoadTracker) reset() {
+	t.previous = 0
+}
+
+// isAreaLoaded returns true if the loading screen is gone and the player unit is linked to a level. The player area
+// is read through the unit path, room and level, so a non zero area means the whole chain is set. There is no known
+// flag telling if all the level rooms are built, so this doesn't mean the level collision data is complete
+func isAreaLoaded(mainPlayer RawPlayerUnit, loadingScreen bool) bool {
+	return !loadingScreen && mainPlayer.Address != 0 && mainPlayer.Area != 0 &&
+		mainPlayer.Position.X != 0 && mainPlayer.Position.Y != 0
+}
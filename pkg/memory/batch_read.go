@@ -0,0 +1,78 @@
+package memory
+
+import (
+	"sort"
+)
+
+const (
+	// Reads closer than this are merged into a single one, reading a few unused bytes is cheaper than a syscall
+	batchMaxGap = 0x200
+	// Upper limit for a merged read, so we don't end up reading huge chunks of unrelated memory
+	batchMaxSpanSize = 0x10000
+)
+
+type ReadRequest struct {
+	Address uintptr
+	Size    uint
+}
+
+// ReadBatch reads all the requested memory regions, results are returned in the same order as the requests.
+// Requests are sorted and merged when they are close enough, so many small reads end up in a few syscalls.
+// As with ReadBytesFromMemory, a failed read returns a zeroed buffer of the requested size
+func (p *Process) ReadBatch(requests []ReadRequest) [][]byte {
+	results := make([][]byte, len(requests))
+	if len(requests) == 0 {
+		return results
+	}
+
+	order := make([]int, 0, len(requests))
+	for i, r := range requests {
+		results[i] = make([]byte, r.Size)
+		if r.Size > 0 && r.Address >= minValidAddress {
+			order = append(order, i)
+		}
+	}
+	sort.Slice(order, func(i, j int) bool {
+		return requests[order[i]].Address < requests[order[j]].Address
+	})
+
+	for start := 0; start < len(order); {
+		spanStart := requests[order[start]].Address
+		spanEnd := spanStart + uintptr(requests[order[start]].Size)
+
+		end := start + 1
+		for ; end < len(order); end++ {
+			r := requests[order[end]]
+			rEnd := r.Address + uintptr(r.Size)
+			if r.Address > spanEnd+batchMaxGap || max(spanEnd, rEnd)-spanStart > batchMaxSpanSize {
+				break
+			}
+			spanEnd = max(spanEnd, rEnd)
+		}
+
+		spanBuffer := getReadBuffer(uint(spanEnd - spanStart))
+		span := *spanBuffer
+		if err := p.readMemory(spanStart, span); err == nil {
+			p.metricsOrNop().AddBytesRead(len(span))
+			for _, idx := range order[start:end] {
+				offset := requests[idx].Address - spanStart
+				copy(results[idx], span[offset:offset+uintptr(requests[idx].Size)])
+			}
+		} else {
+			// Part of the span is probably not readable, fallback to individual reads
+			for _, idx := range order[start:end] {
+				if err = p.readMemory(requests[idx].Address, results[idx]); err != nil {
+					p.countReadFailure(ReadSourceBatch, requests[idx].Address)
+					clear(results[idx])
+				} else {
+					p.metricsOrNop().AddBytesRead(len(results[idx]))
+				}
+			}
+		}
+		putReadBuffer(spanBuffer)
+
+		start = end
+	}
+
+	return results
+}
@@ -0,0 +1,58 @@
+package memory
+
+import (
+	"fmt"
+	"sync"
+)
+
+// Bigger buffers are not returned to the pool, so a single huge read doesn't stay in memory forever
+const maxPooledBufferSize = 64 * 1024
+
+var readBufferPool = sync.Pool{
+	New: func() interface{} {
+		b := make([]byte, 0, 256)
+		return &b
+	},
+}
+
+func getReadBuffer(size uint) *[]byte {
+	bp := readBufferPool.Get().(*[]byte)
+	if uint(cap(*bp)) < size {
+		*bp = make([]byte, size)
+	}
+	*bp = (*bp)[:size]
+
+	return bp
+}
+
+func putReadBuffer(bp *[]byte) {
+	if cap(*bp) > maxPooledBufferSize {
+		return
+	}
+	readBufferPool.Put(bp)
+}
+
+// readPooled reads memory into a buffer from the pool, it should be released with putReadBuffer once the data has been
+// consumed, so the returned bytes can not be retained by the caller. As with ReadBytesFromMemory, a failed read returns a
+// zeroed buffer
+func (p *Process) readPooled(source ReadSource, address uintptr, size uint) *[]byte {
+	bp, _ := p.readPooledE(source, address, size)
+
+	return bp
+}
+
+func (p *Process) readPooledE(source ReadSource, address uintptr, size uint) (*[]byte, error) {
+	bp := getReadBuffer(size)
+	if size == 0 {
+		return bp, nil
+	}
+
+	if err := p.readMemory(address, *bp); err != nil {
+		p.countReadFailure(source, address)
+		clear(*bp)
+		return bp, fmt.Errorf("error reading %d bytes at 0x%X: %w", size, address, err)
+	}
+	p.metricsOrNop().AddBytesRead(int(size))
+
+	return bp, nil
+}
@@ -0,0 +1,75 @@
+package memory
+
+import (
+	"sync"
+	"time"
+)
+
+type CinematicKind string
+
+const (
+	CinematicNone  CinematicKind = ""
+	CinematicIntro CinematicKind = "intro" // Logos and intro video played on game start, before the main menu
+	CinematicAct   CinematicKind = "act"   // In game videos: act transitions and the end of the game
+)
+
+// Panel shown while the intro videos play. It has not been verified against the client UI, check it with ReadAllPanels
+// during the intro
+const introVideoPanel = "VideoPanel"
+
+type CinematicState struct {
+	Kind CinematicKind
+	// Since the video was first seen by GetCinematicState, only as accurate as the polling interval. The game state
+	// that allows skipping is not mapped, videos ignore the input for a short while after starting, so callers should
+	// wait some time before sending the escape
+	Elapsed time.Duration
+}
+
+// cinematicTracker keeps when the current video started, the game doesn't expose the playback position
+type cinematicTracker struct {
+	mu      sync.Mutex
+	kind    CinematicKind
+	started time.Time
+}
+
+func (t *cinematicTracker) update(now time.Time, kind CinematicKind) time.Duration {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if kind != t.kind {
+		t.kind, t.started = kind, now
+	}
+	if kind == CinematicNone {
+		return 0
+	}
+
+	return now.Sub(t.started)
+}
+
+func (t *cinematicTracker) reset() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.kind, t.started = CinematicNone, time.Time{}
+}
+
+// GetCinematicState returns the video being played, if any, and for how long. It has to be polled to know when the
+// video started, so the first call during a video always returns a zero Elapsed. Act videos are read from the same UI
+// flag as OpenMenus().Cinematic, intro videos from a panel name not verified yet
+func (gd *GameReader) GetCinematicState() CinematicState {
+	kind := CinematicNone
+	if gd.IsIngame() {
+		if gd.ReadUInt(gd.Process.moduleBaseAddressPtr+gd.offset.UI-0xA+0x11, Uint8) != 0 {
+			kind = CinematicAct
+		}
+	} else if video, found := gd.readRootPanels(introVideoPanel)[introVideoPanel]; found && video.PanelVisible {
+		kind = CinematicIntro
+	}
+
+	elapsed := gd.cinematic.update(time.Now(), kind)
+
+	return CinematicState{
+		Kind:    kind,
+		Elapsed: elapsed,
+	}
+}
@@ -0,0 +1,27 @@
+package memory
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCinematicTracker(t *testing.T) {
+	var tr cinematicTracker
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	require.Equal(t, time.Duration(0), tr.update(start, CinematicNone))
+	require.Equal(t, time.Duration(0), tr.update(start, CinematicIntro))
+	require.Equal(t, 2*time.Second, tr.update(start.Add(2*time.Second), CinematicIntro))
+
+	// A different video starts counting again
+	require.Equal(t, time.Duration(0), tr.update(start.Add(3*time.Second), CinematicAct))
+	require.Equal(t, time.Second, tr.update(start.Add(4*time.Second), CinematicAct))
+
+	require.Equal(t, time.Duration(0), tr.update(start.Add(5*time.Second), CinematicNone))
+	require.Equal(t, time.Duration(0), tr.update(start.Add(6*time.Second), CinematicAct))
+
+	tr.reset()
+	require.Equal(t, time.Duration(0), tr.update(start.Add(7*time.Second), CinematicAct))
+}
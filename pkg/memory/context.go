@@ -0,0 +1,88 @@
+package memory
+
+import (
+	"context"
+
+	"github.com/hectorgimenez/d2go/pkg/data"
+)
+
+type readContext struct {
+	ctx context.Context
+}
+
+// GetDataContext works like GetDataE, but gives up as soon as the context is done. Once cancelled the remaining reads
+// fail fast, so the in-flight GetData finishes right after, unless it's blocked in a syscall on a dying process, in that
+// case it keeps running in background and the next context call waits for it
+func (gd *GameReader) GetDataContext(ctx context.Context) (data.Data, error) {
+	return runWithContext(ctx, gd, gd.GetDataE)
+}
+
+// ReadAllPanelsContext works like ReadAllPanels but gives up as soon as the context is done, see GetDataContext
+func (gd *GameReader) ReadAllPanelsContext(ctx context.Context) (map[string]data.Panel, error) {
+	return runWithContext(ctx, gd, func() (map[string]data.Panel, error) {
+		return gd.ReadAllPanels(), nil
+	})
+}
+
+func runWithContext[T any](ctx context.Context, gd *GameReader, fn func() (T, error)) (T, error) {
+	type result struct {
+		value T
+		err   error
+	}
+
+	var zero T
+	if err := ctx.Err(); err != nil {
+		return zero, err
+	}
+
+	resultCh := make(chan result, 1)
+	go func() {
+		gd.Process.readCtxMu.Lock()
+		defer gd.Process.readCtxMu.Unlock()
+
+		// The caller may have already given up while we were waiting for the previous call
+		if err := ctx.Err(); err != nil {
+			resultCh <- result{err: err}
+			return
+		}
+
+		gd.Process.readCtx.Store(&readContext{ctx: ctx})
+		value, err := fn()
+		gd.Process.readCtx.Store(nil)
+
+		// Data read after the cancellation is garbage, it must not be served from the cache. The caller may be gone and
+		// another read running, so the caches are dropped by the next GetData instead of here
+		if ctx.Err() != nil {
+			gd.cachesStale.Store(true)
+		}
+		resultCh <- result{value: value, err: err}
+	}()
+
+	select {
+	case r := <-resultCh:
+		if err := ctx.Err(); err != nil {
+			return zero, err
+		}
+		return r.value, r.err
+	case <-ctx.Done():
+		return zero, ctx.Err()
+	}
+}
+
+// readCancelled returns the context error if the current context call has been cancelled
+func (p *Process) readCancelled() error {
+	if rc := p.readCtx.Load(); rc != nil {
+		return rc.ctx.Err()
+	}
+
+	return nil
+}
+
+// readContext returns the context of the current context call, or a background context if there is none
+func (p *Process) readContext() context.Context {
+	if rc := p.readCtx.Load(); rc != nil {
+		return rc.ctx
+	}
+
+	return context.Background()
+}
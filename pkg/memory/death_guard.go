@@ -0,0 +1,113 @@
+package memory
+
+import (
+	"context"
+	"time"
+
+	"github.com/hectorgimenez/d2go/pkg/data"
+	"github.com/hectorgimenez/d2go/pkg/data/area"
+	"github.com/hectorgimenez/d2go/pkg/data/mode"
+	"github.com/hectorgimenez/d2go/pkg/data/stat"
+	"github.com/hectorgimenez/d2go/pkg/data/state"
+)
+
+// Vitals is the minimal player state needed by chicken logic, it only takes a few reads compared with GetData
+type Vitals struct {
+	Time     time.Time
+	Area     area.ID
+	Position data.Position
+	Mode     mode.PlayerMode
+	States   state.States
+	Life     int
+	MaxLife  int
+	Mana     int
+	MaxMana  int
+	Dangers  data.Monsters // Attackable monsters within the danger radius
+
+	HasMerc     bool // Alive mercenary found
+	MercLife    int  // Same scale as data.Monster.Stats
+	MercMaxLife int
+}
+
+func (v Vitals) LifePercent() int {
+	if v.MaxLife <= 0 {
+		return 0
+	}
+
+	return v.Life * 100 / v.MaxLife
+}
+
+func (v Vitals) ManaPercent() int {
+	if v.MaxMana <= 0 {
+		return 0
+	}
+
+	return v.Mana * 100 / v.MaxMana
+}
+
+// IsDead returns true if the player is dying or dead, false if the player unit was not found (e.g. loading screens)
+func (v Vitals) IsDead() bool {
+	return v.MaxLife > 0 && (v.Mode == mode.Dead || v.Mode == mode.Death || v.Life <= 0)
+}
+
+// ElitesNearby returns the number of champions, uniques, minions and super uniques within the danger radius
+func (v Vitals) ElitesNearby() int {
+	elites := 0
+	for _, m := range v.Dangers {
+		if m.IsElite() {
+			elites++
+		}
+	}
+
+	return elites
+}
+
+// GetVitals reads only the player life, mana, position and the attackable monsters around it, skipping panels, quests,
+// inventory and objects. The radius is in sub-tiles, 0 skips the monsters read. It doesn't use the GetData caches or
+// modify any GameReader state, so it can run while the refresher or GetData are reading from other goroutines
+func (gd *GameReader) GetVitals(dangerRadius int) Vitals {
+	pu := gd.GetRawPlayerUnits().GetMainPlayer()
+	v := Vitals{
+		Time:     time.Now(),
+		Area:     pu.Area,
+		Position: pu.Position,
+		Mode:     pu.Mode,
+		States:   pu.States,
+		Life:     statValue(pu.Stats, stat.Life),
+		MaxLife:  statValue(pu.Stats, stat.MaxLife),
+		Mana:     statValue(pu.Stats, stat.Mana),
+		MaxMana:  statValue(pu.Stats, stat.MaxMana),
+	}
+	v.setMerc(gd.readStatsLists(gd.mercStatsLists()))
+	if dangerRadius > 0 {
+		monsters := gd.readMonsterUnits(pu.Position, data.HoverData{}, false)
+		v.Dangers = monsters.Attackable().WithinRadius(pu.Position, dangerRadius)
+	}
+
+	return v
+}
+
+func statValue(stats stat.Stats, id stat.ID) int {
+	st, _ := stats.FindStat(id, 0)
+	return st.Value
+}
+
+// WatchVitals reads the vitals every interval and calls fn with them until the context is done or fn returns false.
+// Intervals under 50ms are fine since each read is cheap. It blocks, and it can run together with the refresher
+func (gd *GameReader) WatchVitals(ctx context.Context, interval time.Duration, dangerRadius int,
+	fn func(Vitals) bool) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if !fn(gd.GetVitals(dangerRadius)) {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,27 @@
+package memory
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// Meant to be run with -race, vitals are read while the refresher reads the same GameReader
+func TestWatchVitalsWithRefresher(t *testing.T) {
+	mem := fakeMemory{base: 0x140000000, data: make([]byte, 0x4000)}
+	gd := NewGameReaderWithOffset(NewProcessFromSource(mem, mem.base, uint32(len(mem.data))), Offset{UnitTable: 0x1000})
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	require.NoError(t, gd.StartRefresher(ctx, time.Millisecond))
+
+	reads := 0
+	err := gd.WatchVitals(ctx, time.Millisecond, 30, func(v Vitals) bool {
+		reads++
+		return reads < 20
+	})
+	require.NoError(t, err)
+	require.Equal(t, 20, reads)
+}
@@ -0,0 +1,76 @@
+package memory
+
+import "sync"
+
+type GameMode string
+
+const (
+	GameModeOnline  GameMode = "online"  // Battle.net
+	GameModeOffline GameMode = "offline" // Single player
+	GameModeUnknown GameMode = "unknown" // Not seen yet, or out of game and not in the main menu or lobby
+)
+
+// gameModeTracker keeps the mode seen on the last main menu or lobby read. There is no online flag mapped that can be
+// read in game, so the mode of a game is the one the menus showed before joining it
+type gameModeTracker struct {
+	mu   sync.Mutex
+	last GameMode
+}
+
+func (t *gameModeTracker) update(mode GameMode) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.last = mode
+}
+
+func (t *gameModeTracker) get() GameMode {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.last == "" {
+		return GameModeUnknown
+	}
+
+	return t.last
+}
+
+func (t *gameModeTracker) reset() {
+	t.update("")
+}
+
+// GetGameMode returns whether the client is playing online or offline. Out of game it reads the Battle.net state shown
+// by the menus: the lobby only exists online, and the main menu shows the online/offline switch button (the same one
+// read by IsOnline) only while connected. Only those panels are read, instead of the whole panel tree read by IsOnline.
+// In game the mode seen on the menus before joining is returned, GameModeUnknown if the reader was attached in game
+func (gd *GameReader) GetGameMode() GameMode {
+	if gd.IsIngame() {
+		return gd.gameMode.get()
+	}
+
+	panels := gd.readRootPanels("MainMenuPanel", "LobbyBackgroundPanel")
+	if lobby, found := panels["LobbyBackgroundPanel"]; found && lobby.PanelEnabled && lobby.PanelVisible {
+		gd.gameMode.update(GameModeOnline)
+		return GameModeOnline
+	}
+
+	mainMenu, found := panels["MainMenuPanel"]
+	if !found || !mainMenu.PanelVisible {
+		return GameModeUnknown
+	}
+
+	mode := GameModeOffline
+	button := mainMenu.PanelChildren["SecondaryContextButton"]
+	if button.PanelName != "" && button.PanelEnabled && button.PanelVisible {
+		mode = GameModeOnline
+	}
+	gd.gameMode.update(mode)
+
+	return mode
+}
+
+// IsOfflineGame returns true while playing a single player game, online only features (terror zones rotation, lobby,
+// ladder) are not available. It's false if the mode is unknown, see GetGameMode
+func (gd *GameReader) IsOfflineGame() bool {
+	return gd.IsIngame() && gd.gameMode.get() == GameModeOffline
+}
@@ -0,0 +1,30 @@
+package memory
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGameModeInGame(t *testing.T) {
+	mem := fakeMemory{base: 0x140000000, data: make([]byte, 0x200)}
+	gd := NewGameReaderWithOffset(NewProcessFromSource(mem, mem.base, uint32(len(mem.data))), Offset{UI: 0x100})
+	// In game flag
+	mem.data[0x100-0xA] = 1
+
+	// Attached in game, the menus were never seen
+	require.Equal(t, GameModeUnknown, gd.GetGameMode())
+	require.False(t, gd.IsOfflineGame())
+
+	// Mode seen on the main menu before joining
+	gd.gameMode.update(GameModeOffline)
+	require.Equal(t, GameModeOffline, gd.GetGameMode())
+	require.True(t, gd.IsOfflineGame())
+
+	gd.gameMode.update(GameModeOnline)
+	require.False(t, gd.IsOfflineGame())
+
+	// Caches are dropped on cancelled reads, the mode is kept
+	gd.resetCaches()
+	require.Equal(t, GameModeOnline, gd.GetGameMode())
+}
@@ -3,12 +3,15 @@ package memory
 import (
 	"errors"
 	"fmt"
-	"log"
+	"log/slog"
 	"math"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/hectorgimenez/d2go/pkg/data"
+	"github.com/hectorgimenez/d2go/pkg/data/quest"
 	"github.com/hectorgimenez/d2go/pkg/data/skill"
 	"github.com/hectorgimenez/d2go/pkg/data/stat"
 )
@@ -17,6 +20,12 @@ type GameReader struct {
 	offset Offset
 	*Process
 
+	// Serializes GetData and Reconnect, the only readers modifying the cached data, so the refresher and GetData callers
+	// can run at the same time
+	mu sync.Mutex
+	// Set when a context read was cancelled, the cached data may be garbage and is dropped by the next GetData
+	cachesStale atomic.Bool
+
 	monstersLastUpdate  time.Time
 	inventoryLastUpdate time.Time
 	objectsLastUpdate   time.Time
@@ -24,6 +33,23 @@ type GameReader struct {
 	cachedMonsters  data.Monsters
 	cachedInventory data.Inventory
 	cachedObjects   []data.Object
+	cacheJitter     float64
+
+	network   networkHistory
+	roster    rosterTracker
+	cinematic cinematicTracker
+	// Only updated if enabled with WithMonsterTracking
+	monsterTracks monsterTracker
+	objectModes   objectLoadModes
+	// Not reset with the caches, it's only known from the menus shown before joining a game
+	gameMode gameModeTracker
+
+	// Only updated by GetData, GetPlayerUnit alone has no previous position to compare with
+	velocity velocityTracker
+	areaLoad areaLoadTracker
+
+	latest           atomic.Pointer[latestData]
+	refresherRunning atomic.Bool
 }
 
 type MercOption struct {
@@ -43,21 +69,88 @@ type CharacterFlags struct {
 	Ladder      bool
 }
 
-var WidgetStateFlags = map[string]uint64{
-	"WeaponSwap": 0xF2D7CF8E9CC08212,
-}
+var ErrReadFailed = errors.New("memory read failed")
 
-func NewGameReader(process *Process) *GameReader {
-	return &GameReader{
-		offset:              calculateOffsets(process),
+func NewGameReader(process *Process, opts ...GameReaderOption) *GameReader {
+	gd := &GameReader{
 		Process:             process,
 		monstersLastUpdate:  time.Time{},
 		inventoryLastUpdate: time.Time{},
 		objectsLastUpdate:   time.Time{},
 	}
+	for _, opt := range opts {
+		opt(gd)
+	}
+
+	gd.offset = calculateOffsets(process)
+
+	return gd
+}
+
+// NewGameReaderWithOffset creates a GameReader using the given offsets instead of calculating them from the game
+// executable, mostly useful together with NewProcessFromSource
+func NewGameReaderWithOffset(process *Process, offset Offset, opts ...GameReaderOption) *GameReader {
+	gd := &GameReader{
+		Process: process,
+		offset:  offset,
+	}
+	for _, opt := range opts {
+		opt(gd)
+	}
+
+	return gd
+}
+
+// Reconnect attaches again to the game process (see Process.Reconnect), offsets are calculated again and cached data is
+// discarded, so the same GameReader can be used after a game crash or restart
+func (gd *GameReader) Reconnect() error {
+	// Same lock order as the context reads running GetData
+	gd.Process.readCtxMu.Lock()
+	defer gd.Process.readCtxMu.Unlock()
+	gd.mu.Lock()
+	defer gd.mu.Unlock()
+
+	if err := gd.Process.reconnect(); err != nil {
+		return err
+	}
+
+	gd.offset = calculateOffsets(gd.Process)
+	gd.resetCaches()
+	gd.gameMode.reset()
+
+	return nil
+}
+
+// resetCaches drops the data kept between GetData calls, gd.mu must be held
+func (gd *GameReader) resetCaches() {
+	gd.monstersLastUpdate = time.Time{}
+	gd.inventoryLastUpdate = time.Time{}
+	gd.objectsLastUpdate = time.Time{}
+	gd.cachedMonsters = nil
+	gd.cachedInventory = data.Inventory{}
+	gd.cachedObjects = nil
+	gd.velocity.reset()
+	gd.areaLoad.reset()
+	gd.network.reset()
+	gd.roster.reset()
+	gd.cinematic.reset()
+	gd.monsterTracks.reset()
+	gd.objectModes.reset()
 }
 
 func (gd *GameReader) GetData() data.Data {
+	gd.mu.Lock()
+	defer gd.mu.Unlock()
+
+	if gd.cachesStale.Swap(false) {
+		gd.resetCaches()
+	}
+
+	start := time.Now()
+	defer func() {
+		gd.metricsOrNop().ObserveGetData(time.Since(start))
+	}()
+
 	if gd.offset.UnitTable == 0 {
 		gd.offset = calculateOffsets(gd.Process)
 	}
@@ -69,55 +162,67 @@ func (gd *GameReader) GetData() data.Data {
 	hover := gd.HoveredData()
 
 	now := time.Now()
+	pu.Velocity = gd.velocity.update(now, pu.Position, pu.Area)
 
 	// Conditionally update monsters
 	monsters := gd.cachedMonsters
-	if now.Sub(gd.monstersLastUpdate) > 200*time.Millisecond {
+	refreshMonsters := now.Sub(gd.monstersLastUpdate) > gd.cacheInterval(200*time.Millisecond)
+	if refreshMonsters {
 		monsters = gd.Monsters(pu.Position, hover)
 		gd.cachedMonsters = monsters
 		gd.monstersLastUpdate = now
+		gd.monsterTracks.update(now, monsters)
 	}
+	gd.metricsOrNop().CacheAccess(CacheMonsters, !refreshMonsters)
 
 	// Conditionally update inventory 500ms
 	// Except when hovering over an item
 	inventory := gd.cachedInventory
-	if now.Sub(gd.inventoryLastUpdate) > 500*time.Millisecond ||
-		(hover.IsHovered && hover.UnitType == 4) { // 4 = Item type
+	refreshInventory := now.Sub(gd.inventoryLastUpdate) > gd.cacheInterval(500*time.Millisecond) ||
+		(hover.IsHovered && hover.UnitType == 4) // 4 = Item type
+	if refreshInventory {
 		inventory = gd.Inventory(rawPlayerUnits, hover)
 		gd.cachedInventory = inventory
 		gd.inventoryLastUpdate = now
 	}
+	gd.metricsOrNop().CacheAccess(CacheInventory, !refreshInventory)
 
 	// Conditionally update objects
 	objects := gd.cachedObjects
-	if now.Sub(gd.objectsLastUpdate) > 200*time.Millisecond {
+	refreshObjects := now.Sub(gd.objectsLastUpdate) > gd.cacheInterval(200*time.Millisecond)
+	if refreshObjects {
 		objects = gd.Objects(pu.Position, hover)
+		gd.objectModes.update(now, pu.ID, objects)
 		gd.cachedObjects = objects
 		gd.objectsLastUpdate = now
 	}
+	gd.metricsOrNop().CacheAccess(CacheObjects, !refreshObjects)
 
 	// Always update other critical data
-	corpseUnit := rawPlayerUnits.GetCorpse()
-	roster := gd.getRoster(rawPlayerUnits)
+	playerCorpses := gd.playerCorpses(rawPlayerUnits, mainPlayerUnit)
+	corpse, found := playerCorpses.WithItems()
+	if !found && len(playerCorpses) > 0 {
+		corpse = playerCorpses[0]
+	}
+	roster := gd.getRoster(rawPlayerUnits, now)
 	openMenus := gd.OpenMenus()
 
 	// Quests
 	questDataPtr := uintptr(gd.Process.ReadUInt(gd.moduleBaseAddressPtr+gd.offset.QuestInfo, Uint64))
 	flagsBufferPtr := uintptr(gd.Process.ReadUInt(questDataPtr, Uint64))
-	gameQuestsBytes := gd.Process.ReadBytesFromMemory(flagsBufferPtr, 82)
+	gameQuestsBytes := gd.Process.ReadBytesFromMemory(flagsBufferPtr, quest.BufferSize)
+
+	fps, ping := gd.FPS(), gd.Ping()
+	gd.network.add(NetworkSample{Time: now, FPS: fps, Ping: ping})
 
 	d := data.Data{
-		Corpse: data.Corpse{
-			Found:     corpseUnit.Address != 0,
-			IsHovered: corpseUnit.IsHovered,
-			Position:  corpseUnit.Position,
-			States:    corpseUnit.States,
-		},
+		Corpse:        corpse,
+		PlayerCorpses: playerCorpses,
 		Game: data.OnlineGame{
 			LastGameName:     gd.LastGameName(),
 			LastGamePassword: gd.LastGamePass(),
-			FPS:              gd.FPS(),
-			Ping:             gd.Ping(),
+			FPS:              fps,
+			Ping:             ping,
 		},
 		Monsters:       monsters,
 		Corpses:        gd.Corpses(pu.Position, hover),
@@ -129,10 +234,12 @@ func (gd *GameReader) GetData() data.Data {
 		Roster:         roster,
 		HoverData:      hover,
 		TerrorZones:    gd.TerrorZones(),
-		Quests:         gd.getQuests(gameQuestsBytes),
+		Quests:         quest.Decode(gameQuestsBytes),
+		QuestsRaw:      quest.Raw{Buffer: gameQuestsBytes, DecoderVersion: quest.DecoderVersion},
 		KeyBindings:    gd.GetKeyBindings(),
 		LegacyGraphics: gd.LegacyGraphics(),
 		IsIngame:       gd.IsIngame(),
+		AreaLoaded:     gd.areaLoad.update(mainPlayerUnit.Area, isAreaLoaded(mainPlayerUnit, openMenus.LoadingScreen)),
 
 		// These use the Panel Manager which is heavy to read. Use the functions below instead.
 		//IsOnline:       		   gd.IsOnline(),
@@ -146,6 +253,73 @@ func (gd *GameReader) GetData() data.Data {
 	return d
 }
 
+// ReadFailedError is returned by the error-returning readers when memory reads failed, with the amount of failed reads
+// of each source, so callers can decide which ones matter. It matches ErrReadFailed with errors.Is
+type ReadFailedError struct {
+	Reading  string // What was being read, e.g. "game data"
+	Failures map[ReadSource]uint64
+}
+
+func (e *ReadFailedError) Error() string {
+	parts := make([]string, 0, len(e.Failures))
+	for source := ReadSource(0); source < readSourceCount; source++ {
+		if n := e.Failures[source]; n > 0 {
+			parts = append(parts, fmt.Sprintf("%s: %d", source, n))
+		}
+	}
+
+	return fmt.Sprintf("%s reading %s (%s)", ErrReadFailed, e.Reading, strings.Join(parts, ", "))
+}
+
+func (e *ReadFailedError) Unwrap() error {
+	return ErrReadFailed
+}
+
+// readFailuresSince returns a ReadFailedError with the reads that failed, per source, since the given counters were taken
+func (gd *GameReader) readFailuresSince(reading string, before [readSourceCount]uint64) error {
+	var failures map[ReadSource]uint64
+	for source, after := range gd.readFailureCounters() {
+		if failed := after - before[source]; failed > 0 {
+			if failures == nil {
+				failures = make(map[ReadSource]uint64)
+			}
+			failures[ReadSource(source)] = failed
+		}
+	}
+	if failures == nil {
+		return nil
+	}
+
+	return &ReadFailedError{Reading: reading, Failures: failures}
+}
+
+func (gd *GameReader) readFailureCounters() [readSourceCount]uint64 {
+	var counters [readSourceCount]uint64
+	for source := range counters {
+		counters[source] = gd.ReadFailuresBySource(ReadSource(source))
+	}
+
+	return counters
+}
+
+// GetDataE works like GetData but returns a ReadFailedError if any memory read failed while reading, so an empty value
+// can be told apart from a failed read (e.g. in the middle of a game transition). Data is returned anyway, it may be
+// partial. Read failures are counted per Process, so the result is not reliable if the same Process is read concurrently
+func (gd *GameReader) GetDataE() (data.Data, error) {
+	before := gd.readFailureCounters()
+	d := gd.GetData()
+
+	return d, gd.readFailuresSince("game data", before)
+}
+
+// GetInventoryE works like GetInventory but returns a ReadFailedError if any memory read failed, see GetDataE
+func (gd *GameReader) GetInventoryE() (data.Inventory, error) {
+	before := gd.readFailureCounters()
+	inventory := gd.GetInventory()
+
+	return inventory, gd.readFailuresSince("inventory", before)
+}
+
 func (gd *GameReader) GetInventory() data.Inventory {
 	rawPlayerUnits := gd.GetRawPlayerUnits()
 	hover := gd.HoveredData()
@@ -211,16 +385,21 @@ func (gd *GameReader) HoveredData() data.HoverData {
 func (gd *GameReader) getStatsList(statListPtr uintptr) stat.Stats {
 	statsListBuffer := gd.ReadBytesFromMemory(statListPtr, 0x10)
 	statList := ReadUIntFromBuffer(statsListBuffer, 0, Uint64)
-	statCount := ReadUIntFromBuffer(statsListBuffer, 0x08, Uint64)
-	if statCount == 0 {
+	count := statCount(ReadUIntFromBuffer(statsListBuffer, 0x08, Uint64))
+	if count == 0 {
 		return []stat.Data{}
 	}
 
+	statBuffer := gd.Process.ReadBytesFromMemory(uintptr(statList), count*10)
+
+	return statsFromBuffer(statBuffer[:min(count*8, uint(len(statBuffer)))])
+}
+
+// statsFromBuffer decodes the stats of a stats list, buffer should be read from the stats pointer (8 bytes per stat)
+func statsFromBuffer(statBuffer []byte) stat.Stats {
 	var stats = make([]stat.Data, 0)
 
-	statBuffer := gd.Process.ReadBytesFromMemory(uintptr(statList), statCount*10)
-	for i := 0; i < int(statCount); i++ {
-		offset := uint(i * 8)
+	for offset := uint(0); offset+8 <= uint(len(statBuffer)); offset += 8 {
 
 		statLayer := ReadUIntFromBuffer(statBuffer, offset, Uint16)
 		statEnum := ReadUIntFromBuffer(statBuffer, offset+0x2, Uint16)
@@ -334,6 +513,13 @@ func (gd *GameReader) IsIngame() bool {
 	return gd.ReadUInt(gd.Process.moduleBaseAddressPtr+gd.offset.UI-0xA, 1) == 1
 }
 
+// IsChatInputFocused returns true while the chat input box is open, it has the keyboard focus so keys are typed into
+// the chat instead of triggering hotkeys. Same as OpenMenus().ChatOpen but it's a single byte read, cheap enough to be
+// checked before every key press
+func (gd *GameReader) IsChatInputFocused() bool {
+	return gd.ReadUInt(gd.Process.moduleBaseAddressPtr+gd.offset.UI-0xA+0x05, Uint8) != 0
+}
+
 func (gd *GameReader) IsInLobby() bool {
 	panel := gd.GetPanel("LobbyBackgroundPanel")
 	return panel.PanelName != "" && panel.PanelEnabled && panel.PanelVisible
@@ -398,7 +584,7 @@ func (gd *GameReader) GetMercList() []MercOption {
 		}
 
 		if sk.Name == "" {
-			log.Printf("Unknown merc skill: %s", skillName)
+			gd.log().Warn("Unknown merc skill", slog.String("skill", skillName))
 			continue
 		}
 
@@ -459,45 +645,8 @@ func (gd *GameReader) HasMerc() bool {
 	return gd.ReadUInt(gd.Process.moduleBaseAddressPtr+gd.offset.UI+0x8, Uint8) != 0
 }
 
-// GetWidgetState reference : https://github.com/ResurrectedTrader/ResurrectedTrade/blob/f121ec02dd3fbe1c574f713e5a0c2db92ccca821/ResurrectedTrade.AgentBase/Capture.cs#L618
-func (gd *GameReader) GetWidgetState(stateFlag uint64) (int, error) {
-	// Get widget states pointer
-	stateFlags := uint64(gd.Process.ReadUInt(gd.moduleBaseAddressPtr+gd.offset.WidgetStatesOffset, Uint64))
-	if stateFlags == 0 {
-		return 0, nil
-	}
-
-	v2 := uint64(gd.Process.ReadUInt(uintptr(stateFlags)+8, Uint64))
-	if v2 == 0 {
-		return 0, nil
-	}
-
-	flag := stateFlag
-	v4 := uint64(0xC4CEB9FE1A85EC53) * ((uint64(0xFF51AFD7ED558CCD) * (flag ^ (flag >> 33))) ^ ((uint64(0xFF51AFD7ED558CCD) * (flag ^ (flag >> 33))) >> 33))
-	v5 := (uint64(gd.Process.ReadUInt(uintptr(stateFlags), Uint64)) - 1) & (v4 ^ (v4 >> 33))
-	v6 := uint64(gd.Process.ReadUInt(uintptr(v2)+uintptr(8*v5), Uint64))
-
-	i := uintptr(v2) + uintptr(8*v5)
-
-	for ; v6 != 0; v6 = uint64(gd.Process.ReadUInt(uintptr(v6), Uint64)) {
-		if flag == uint64(gd.Process.ReadUInt(uintptr(v6)+8, Uint64)) {
-			break
-		}
-		i = uintptr(v6)
-	}
-
-	ir := uint64(gd.Process.ReadUInt(i, Uint64))
-	if ir != 0 {
-		ptr1 := uint64(gd.Process.ReadUInt(uintptr(ir)+16, Uint64))
-		ptr2 := uint64(gd.Process.ReadUInt(uintptr(ptr1)+16, Uint64))
-		return int(gd.Process.ReadUInt(uintptr(ptr2), Uint8)), nil
-	}
-
-	return 0, nil
-}
-
 func (gd *GameReader) GetActiveWeaponSlot() int {
-	state, err := gd.GetWidgetState(WidgetStateFlags["WeaponSwap"])
+	state, err := gd.GetNamedWidgetState(WidgetStateWeaponSwap)
 	if err != nil {
 		return 0 // Default to primary weapons on error
 	}
@@ -0,0 +1,71 @@
+package memory
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/hectorgimenez/d2go/pkg/data"
+)
+
+// Widgets holding the ground item labels, labelWidget is a format with the label index. They have not been verified
+// against the client UI, check them dumping the panels with ReadAllPanels while the item labels are shown
+const (
+	groundLabelsPanel = "ItemLabelsPanel"
+	groundLabelWidget = "Label%d"
+)
+
+type GroundItemLabel struct {
+	Text string // Label text as rendered, it may span several lines (e.g. identified uniques, runewords)
+	Rect ScreenRect
+}
+
+// GroundItemLabels are the labels drawn over the ground items. They don't keep the unit ID of their item and the text
+// is the same for identical items, so labels are matched to items by screen position
+type GroundItemLabels []GroundItemLabel
+
+// Closest returns the label nearest to the given screen position, usually the screen position of the ground item the
+// label is drawn over, computed by the caller. False if there are no labels
+func (ls GroundItemLabels) Closest(screenPosition data.Position) (GroundItemLabel, bool) {
+	closest, closestDistance := GroundItemLabel{}, math.MaxFloat64
+	for _, l := range ls {
+		center := l.Rect.Center()
+		distance := math.Hypot(float64(center.X-screenPosition.X), float64(center.Y-screenPosition.Y))
+		if distance < closestDistance {
+			closest, closestDistance = l, distance
+		}
+	}
+
+	return closest, closestDistance != math.MaxFloat64
+}
+
+// GetGroundItemLabels returns the labels drawn over the ground items, nil if they are not shown. Clicking the label
+// picks the item up even if the ground cell is covered by corpses or other units. Only the labels panel is read, the
+// Show Items state itself is not mapped (see WidgetStateFlags), the labels panel visibility is used instead. The labels
+// panel name has not been verified yet, while it's wrong no labels are returned
+func (gd *GameReader) GetGroundItemLabels() GroundItemLabels {
+	panels := gd.readRootPanels(groundLabelsPanel)
+	container, found := panels[groundLabelsPanel]
+	if !found || !container.PanelVisible {
+		return nil
+	}
+
+	labels := make(GroundItemLabels, 0, container.NumChildren)
+	for i := 0; i < container.NumChildren; i++ {
+		name := fmt.Sprintf(groundLabelWidget, i)
+		label, found := container.PanelChildren[name]
+		if !found || !label.PanelVisible {
+			continue
+		}
+		text := strings.TrimSpace(GetText(label))
+		if text == "" {
+			continue
+		}
+		labels = append(labels, GroundItemLabel{
+			Text: text,
+			Rect: gd.panelScreenRect(panels, groundLabelsPanel, name),
+		})
+	}
+
+	return labels
+}
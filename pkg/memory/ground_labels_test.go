@@ -0,0 +1,26 @@
+package memory
+
+import (
+	"testing"
+
+	"github.com/hectorgimenez/d2go/pkg/data"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGroundItemLabelsClosest(t *testing.T) {
+	label := func(x, y int) GroundItemLabel {
+		return GroundItemLabel{Text: "Jah Rune", Rect: ScreenRect{Position: data.Position{X: x, Y: y}, Width: 60, Height: 20}}
+	}
+
+	_, found := GroundItemLabels{}.Closest(data.Position{X: 100, Y: 100})
+	require.False(t, found)
+
+	// Two identical items, only the position tells them apart
+	labels := GroundItemLabels{label(100, 100), label(400, 300)}
+	closest, found := labels.Closest(data.Position{X: 420, Y: 330})
+	require.True(t, found)
+	require.Equal(t, labels[1], closest)
+
+	closest, _ = labels.Closest(data.Position{X: 120, Y: 125})
+	require.Equal(t, labels[0], closest)
+}
@@ -0,0 +1,88 @@
+package memory
+
+import (
+	"sort"
+
+	"github.com/hectorgimenez/d2go/pkg/data"
+	"github.com/hectorgimenez/d2go/pkg/data/item"
+	"github.com/hectorgimenez/d2go/pkg/data/stat"
+)
+
+// PlayerEquipment returns the items equipped by another player unit, sorted by body slot, the game keeps them loaded
+// while the player is close enough to be drawn. Level requirements are not calculated
+func (gd *GameReader) PlayerEquipment(unitID data.UnitID) []data.Item {
+	units := gd.walkUnitTable(unitTableItems)
+	requests := make([]ReadRequest, 0, len(units)*2)
+	for _, u := range units {
+		requests = append(requests,
+			ReadRequest{Address: uintptr(ReadUIntFromBuffer(u.Buffer, 0x10, Uint64)), Size: itemUnitDataSize},
+			ReadRequest{Address: uintptr(ReadUIntFromBuffer(u.Buffer, 0x38, Uint64)), Size: itemPathSize},
+		)
+	}
+	buffers := gd.Process.ReadBatch(requests)
+
+	type equippedItem struct {
+		item *data.Item
+		slot item.BodyLocation
+	}
+	items := make([]equippedItem, 0, 12)
+	sockets := make(map[data.UnitID][]data.Item)
+	parentBuffer := make([]byte, 0x10)
+	for idx, u := range units {
+		itemDataBuffer := u.Buffer
+		unitDataBuffer, pathBuffer := buffers[idx*2], buffers[idx*2+1]
+		if ReadUIntFromBuffer(itemDataBuffer, 0x00, Uint32) != 4 || ReadUIntFromBuffer(unitDataBuffer, 0x00, Uint32) == 0 {
+			continue
+		}
+
+		// 1 equipped, 6 socketed
+		itemLoc := ReadUIntFromBuffer(itemDataBuffer, 0x0C, Uint32)
+		if itemLoc != 1 && itemLoc != 6 {
+			continue
+		}
+
+		itm := newItem(itemDataBuffer, unitDataBuffer, pathBuffer, data.HoverData{})
+		itm.BaseStats, itm.Stats = gd.getItemStats(uintptr(ReadUIntFromBuffer(itemDataBuffer, 0x88, Uint64)))
+
+		if itemLoc == 6 {
+			itm.Location = item.Location{LocationType: item.LocationSocket}
+			itemExtraData := uintptr(ReadUIntFromBuffer(unitDataBuffer, 0xA0, Uint64))
+			if itemExtraData == 0 {
+				continue
+			}
+			parentInfoPtr := uintptr(gd.Process.ReadUInt(itemExtraData+0x08, Uint64))
+			if parentInfoPtr != 0 && gd.Process.ReadIntoBuffer(parentInfoPtr, parentBuffer) == nil {
+				parentUnitID := data.UnitID(ReadUIntFromBuffer(parentBuffer, 0x08, Uint32))
+				sockets[parentUnitID] = append(sockets[parentUnitID], *itm)
+			}
+			continue
+		}
+
+		// Merc items are equipped too, they are owned by the merc unit so they are filtered by owner as well
+		itemOwner := data.UnitID(ReadUIntFromBuffer(unitDataBuffer, 0x0C, Uint32))
+		equipSlotFlags := uint16(ReadUIntFromBuffer(unitDataBuffer, 0x54, Uint16))
+		if itemOwner != unitID || equipSlotFlags&0xFF00 != 0xFF00 {
+			continue
+		}
+		slot := item.BodyLocation(equipSlotFlags & 0xFF)
+		itm.Location = item.Location{
+			LocationType: item.LocationEquipped,
+			BodyLocation: slot.LocationType(),
+		}
+		items = append(items, equippedItem{item: itm, slot: slot})
+	}
+
+	sort.Slice(items, func(i, j int) bool { return items[i].slot < items[j].slot })
+	equipment := make([]data.Item, 0, len(items))
+	for _, ei := range items {
+		itm := ei.item
+		numSockets, _ := itm.Stats.FindStat(stat.NumSockets, 0)
+		if socketed := sockets[itm.UnitID]; numSockets.Value > 0 && len(socketed) == numSockets.Value {
+			sort.Slice(socketed, func(i, j int) bool { return socketed[i].Position.X < socketed[j].Position.X })
+			itm.Sockets = socketed
+		}
+		equipment = append(equipment, *itm)
+	}
+
+	return equipment
+}
@@ -7,16 +7,21 @@ import (
 
 	"github.com/hectorgimenez/d2go/pkg/data"
 	"github.com/hectorgimenez/d2go/pkg/data/item"
+	"github.com/hectorgimenez/d2go/pkg/data/mode"
 	"github.com/hectorgimenez/d2go/pkg/data/skill"
 	"github.com/hectorgimenez/d2go/pkg/data/stat"
 	"github.com/hectorgimenez/d2go/pkg/data/state"
 	"github.com/hectorgimenez/d2go/pkg/utils"
 )
 
+const (
+	// Enough to cover the item data fields we use, up to the extra data pointer at 0xA0
+	itemUnitDataSize = 0xA8
+	itemPathSize     = 0x18
+)
+
 func (gd *GameReader) Inventory(rawPlayerUnits RawPlayerUnits, hover data.HoverData) data.Inventory {
 	mainPlayer := rawPlayerUnits.GetMainPlayer()
-	baseAddr := gd.Process.moduleBaseAddressPtr + gd.offset.UnitTable + (4 * 1024)
-	unitTableBuffer := gd.Process.ReadBytesFromMemory(baseAddr, 128*8)
 
 	// Process shared stash data
 	stashPlayerUnits := make(map[uint]RawPlayerUnit)
@@ -30,6 +35,10 @@ func (gd *GameReader) Inventory(rawPlayerUnits RawPlayerUnits, hover data.HoverD
 	}
 	slices.Sort(stashPlayerUnitOrder)
 
+	// Page 2 is the item slot of the anvil screen, it's only reported as such while the anvil is open so stash items
+	// are never reported as LocationTrade
+	anvilOpen := gd.OpenMenus().Anvil
+
 	// Gold
 	inventoryGold, _ := mainPlayer.BaseStats.FindStat(stat.Gold, 0)
 	mainPlayerStashedGold, _ := mainPlayer.BaseStats.FindStat(stat.StashGold, 0)
@@ -64,344 +73,169 @@ func (gd *GameReader) Inventory(rawPlayerUnits RawPlayerUnits, hover data.HoverD
 	baseItemsMap := make(map[data.UnitID]*data.Item, 120)       // Same number of potential base items
 	allItems := make([]*data.Item, 0, 480)                      // max capacity: 400 (stashes) + 40 (inv) + 12 (cube) + 12 (equipped) + 16 (belt)
 
-	// Pre-allocate buffers for repeated use
-	var itemDataBuffer = make([]byte, 144)
-	var unitDataBuffer = make([]byte, 144)
-	var pathBuffer = make([]byte, 144)
+	// Unit data and path of all the items are read in a single batch, they're allocated close to each other
+	units := gd.walkUnitTable(unitTableItems)
+	requests := make([]ReadRequest, 0, len(units)*2)
+	for _, u := range units {
+		requests = append(requests,
+			ReadRequest{Address: uintptr(ReadUIntFromBuffer(u.Buffer, 0x10, Uint64)), Size: itemUnitDataSize},
+			ReadRequest{Address: uintptr(ReadUIntFromBuffer(u.Buffer, 0x38, Uint64)), Size: itemPathSize},
+		)
+	}
+	buffers := gd.Process.ReadBatch(requests)
+	parentBuffer := make([]byte, 0x10)
 
 	// Process all items in a single pass
-	for i := 0; i < 128; i++ {
-		itemOffset := 8 * i
-		itemUnitPtr := uintptr(ReadUIntFromBuffer(unitTableBuffer, uint(itemOffset), Uint64))
-
-		for itemUnitPtr > 0 {
-			nextItemPtr := uintptr(gd.Process.ReadUInt(itemUnitPtr+0x158, Uint64))
-
-			// Read basic item data into pre-allocated buffer
-			if err := gd.Process.ReadIntoBuffer(itemUnitPtr, itemDataBuffer); err != nil {
-				itemUnitPtr = nextItemPtr
-				continue
-			}
-
-			itemType := ReadUIntFromBuffer(itemDataBuffer, 0x00, Uint32)
-
-			// Skip non-item entries early
-			if itemType != 4 {
-				itemUnitPtr = nextItemPtr
-				continue
-			}
-
-			txtFileNo := ReadUIntFromBuffer(itemDataBuffer, 0x04, Uint32)
-			unitID := ReadUIntFromBuffer(itemDataBuffer, 0x08, Uint32)
-
-			// itemLoc = 0 in inventory, 1 equipped, 2 in belt, 3 on ground, 4 cursor, 5 dropping, 6 socketed
-			itemLoc := ReadUIntFromBuffer(itemDataBuffer, 0x0C, Uint32)
-
-			unitDataPtr := uintptr(ReadUIntFromBuffer(itemDataBuffer, 0x10, Uint64))
-			if err := gd.Process.ReadIntoBuffer(unitDataPtr, unitDataBuffer); err != nil {
-				itemUnitPtr = nextItemPtr
-				continue
-			}
+	for idx, u := range units {
+		itemDataBuffer := u.Buffer
+		unitDataBuffer, pathBuffer := buffers[idx*2], buffers[idx*2+1]
 
-			flags := ReadUIntFromBuffer(unitDataBuffer, 0x18, Uint32)
-			invPage := ReadUIntFromBuffer(unitDataBuffer, 0x55, Uint8)
-			itemQuality := ReadUIntFromBuffer(unitDataBuffer, 0x00, Uint32)
-			itemOwnerNPC := ReadUIntFromBuffer(unitDataBuffer, 0x0C, Uint32)
+		itemType := ReadUIntFromBuffer(itemDataBuffer, 0x00, Uint32)
 
-			// Link to uniqueitems.txt, setitems.txt
-			txtUniqueSet := int32(gd.Process.ReadUInt(unitDataPtr+0x34, Uint32))
-
-			pathPtr := uintptr(ReadUIntFromBuffer(itemDataBuffer, 0x38, Uint64))
-			if err := gd.Process.ReadIntoBuffer(pathPtr, pathBuffer); err != nil {
-				itemUnitPtr = nextItemPtr
-				continue
-			}
-			// Item coordinates (X, Y)
-			itemX := ReadUIntFromBuffer(pathBuffer, 0x10, Uint16)
-			itemY := ReadUIntFromBuffer(pathBuffer, 0x14, Uint16)
-
-			// Create item structure
-			itm := &data.Item{
-				ID:      int(txtFileNo),
-				UnitID:  data.UnitID(unitID),
-				Name:    item.GetNameByEnum(txtFileNo),
-				Quality: item.Quality(itemQuality),
-				Position: data.Position{
-					X: int(itemX),
-					Y: int(itemY),
-				},
-				IsHovered:   hover.IsHovered && hover.UnitType == 4 && hover.UnitID == data.UnitID(unitID),
-				Sockets:     make([]data.Item, 0),
-				UniqueSetID: txtUniqueSet,
-			}
-
-			// Set item properties
-			setProperties(itm, uint32(flags))
+		// Skip non-item entries early
+		if itemType != 4 {
+			continue
+		}
 
-			// Read rare affixes
-			rarePrefix := int16(gd.Process.ReadUInt(unitDataPtr+0x42, Uint16))
-			rareSuffix := int16(gd.Process.ReadUInt(unitDataPtr+0x44, Uint16))
-			//autoAffix := int16(gd.Process.ReadUInt(unitDataPtr+0x46, Uint16))
+		// itemLoc = 0 in inventory, 1 equipped, 2 in belt, 3 on ground, 4 cursor, 5 dropping, 6 socketed
+		itemLoc := ReadUIntFromBuffer(itemDataBuffer, 0x0C, Uint32)
 
-			// Read magic affixes
-			var prefixes [3]int16
-			var suffixes [3]int16
-			for i := 0; i < 3; i++ {
-				prefixes[i] = int16(gd.Process.ReadUInt(unitDataPtr+0x48+uintptr(i*2), Uint16))
-				suffixes[i] = int16(gd.Process.ReadUInt(unitDataPtr+0x4E+uintptr(i*2), Uint16))
-			}
+		// A failed read returns a zeroed buffer, there is no item quality 0
+		if ReadUIntFromBuffer(unitDataBuffer, 0x00, Uint32) == 0 {
+			continue
+		}
 
-			itm.Affixes = data.ItemAffixes{
-				Rare: struct {
-					Prefix int16
-					Suffix int16
-				}{
-					Prefix: rarePrefix,
-					Suffix: rareSuffix,
-				},
-				Magic: struct {
-					Prefixes [3]int16
-					Suffixes [3]int16
-				}{
-					Prefixes: prefixes,
-					Suffixes: suffixes,
-				},
-			}
+		flags := ReadUIntFromBuffer(unitDataBuffer, 0x18, Uint32)
+		invPage := ReadUIntFromBuffer(unitDataBuffer, 0x55, Uint8)
+		itemOwnerNPC := ReadUIntFromBuffer(unitDataBuffer, 0x0C, Uint32)
 
-			maxAffixReq := 0
-			if itm.Identified {
-				switch itm.Quality {
-				case item.QualityUnique:
-					// find matching item (uniqueitems.txt)
-					for _, uniqueInfo := range item.UniqueItems {
-						if uniqueInfo.ID == int(txtUniqueSet) {
-							itm.IdentifiedName = uniqueInfo.Name
-							itm.LevelReq = uniqueInfo.LevelReq
-							break
-						}
-					}
-				case item.QualitySet:
-					// find matching item (setitems.txt)
-					for setItemName, setItemInfo := range item.SetItems {
-						if setItemInfo.ID == int(txtUniqueSet) {
-							itm.IdentifiedName = string(setItemName)
-							itm.LevelReq = setItemInfo.LevelReq
-							break
-						}
-					}
-				case item.QualityRare, item.QualityCrafted:
-					// Set item name from rare affixes
-					if prefix, exists := item.RarePrefixDesc[int(rarePrefix)]; exists {
-						if suffix, exists := item.RareSuffixDesc[int(rareSuffix)]; exists {
-							itm.IdentifiedName = prefix.Name + " " + suffix.Name
-						}
-					}
-					// Get level requirements from magic affixes
-					for _, prefixID := range prefixes {
-						if prefix, exists := item.MagicPrefixDesc[int(prefixID)]; exists && prefixID != 0 {
-							if prefix.LevelReq > maxAffixReq {
-								maxAffixReq = prefix.LevelReq
-							}
-						}
-					}
-					for _, suffixID := range suffixes {
-						if suffix, exists := item.MagicSuffixDesc[int(suffixID)]; exists && suffixID != 0 {
-							if suffix.LevelReq > maxAffixReq {
-								maxAffixReq = suffix.LevelReq
-							}
-						}
-					}
-				case item.QualityMagic:
-					var prefixParts []string
-					var suffixParts []string
-
-					// Get all prefixes
-					for _, prefixID := range prefixes {
-						if prefix, exists := item.MagicPrefixDesc[int(prefixID)]; exists && prefixID != 0 {
-							prefixParts = append(prefixParts, prefix.Name)
-							if prefix.LevelReq > maxAffixReq {
-								maxAffixReq = prefix.LevelReq
-							}
-						}
-					}
-
-					// Get all suffixes
-					for _, suffixID := range suffixes {
-						if suffix, exists := item.MagicSuffixDesc[int(suffixID)]; exists && suffixID != 0 {
-							suffixParts = append(suffixParts, suffix.Name)
-							if suffix.LevelReq > maxAffixReq {
-								maxAffixReq = suffix.LevelReq
-							}
-						}
-					}
+		if ReadUIntFromBuffer(itemDataBuffer, 0x38, Uint64) == 0 {
+			continue
+		}
 
-					// Construct name: prefixes + base name + suffixes
-					var nameParts []string
-					if len(prefixParts) > 0 {
-						nameParts = append(nameParts, prefixParts...)
-					}
-					nameParts = append(nameParts, itm.Desc().Name)
-					if len(suffixParts) > 0 {
-						nameParts = append(nameParts, suffixParts...)
-					}
-					itm.IdentifiedName = strings.Join(nameParts, " ")
+		itm := newItem(itemDataBuffer, unitDataBuffer, pathBuffer, hover)
+
+		// Determine item location
+		location := item.LocationUnknown
+		switch itemLoc {
+		case 0:
+			if itemOwnerNPC == 2 || itemOwnerNPC == uint(stashPlayerUnits[stashPlayerUnitOrder[0]].UnitID) {
+				location = item.LocationSharedStash
+				invPage = 1
+			} else if itemOwnerNPC == 3 || itemOwnerNPC == uint(stashPlayerUnits[stashPlayerUnitOrder[1]].UnitID) {
+				location = item.LocationSharedStash
+				invPage = 2
+			} else if itemOwnerNPC == 4 || itemOwnerNPC == uint(stashPlayerUnits[stashPlayerUnitOrder[2]].UnitID) {
+				location = item.LocationSharedStash
+				invPage = 3
+			} else if 0x00002000&flags != 0 && itemOwnerNPC == 4294967295 {
+				location = item.LocationVendor
+			} else if data.UnitID(itemOwnerNPC) == mainPlayer.UnitID || itemOwnerNPC == 1 {
+				if invPage == 0 {
+					location = item.LocationInventory
+				} else if invPage == 3 {
+					location = item.LocationCube
+					invPage = 0
+				} else if invPage == 2 && anvilOpen {
+					location = item.LocationTrade
+					invPage = 0
+				} else {
+					location = item.LocationStash
+					invPage = 0
 				}
 			}
-
-			// Set runeword name if applicable
-			if itm.IsRuneword {
-				if runeword, exists := item.RunewordIDMap[prefixes[0]]; exists {
-					itm.RunewordName = runeword
+		case 1:
+			isMercItem := (flags & 0x800000) != 0
+			if data.UnitID(itemOwnerNPC) == mainPlayer.UnitID || itemOwnerNPC == 1 {
+				location = item.LocationEquipped
+				if itm.Type().Code == item.TypeBelt {
+					belt.Name = itm.Name
 				}
+			} else if isMercItem {
+				location = item.LocationMercenary
 			}
-			// Determine item location
-			location := item.LocationUnknown
-			switch itemLoc {
-			case 0:
-				if itemOwnerNPC == 2 || itemOwnerNPC == uint(stashPlayerUnits[stashPlayerUnitOrder[0]].UnitID) {
-					location = item.LocationSharedStash
-					invPage = 1
-				} else if itemOwnerNPC == 3 || itemOwnerNPC == uint(stashPlayerUnits[stashPlayerUnitOrder[1]].UnitID) {
-					location = item.LocationSharedStash
-					invPage = 2
-				} else if itemOwnerNPC == 4 || itemOwnerNPC == uint(stashPlayerUnits[stashPlayerUnitOrder[2]].UnitID) {
-					location = item.LocationSharedStash
-					invPage = 3
-				} else if 0x00002000&flags != 0 && itemOwnerNPC == 4294967295 {
-					location = item.LocationVendor
-				} else if data.UnitID(itemOwnerNPC) == mainPlayer.UnitID || itemOwnerNPC == 1 {
-					if invPage == 0 {
-						location = item.LocationInventory
-					} else if invPage == 3 {
-						location = item.LocationCube
-						invPage = 0
-					} else {
-						location = item.LocationStash
-						invPage = 0
-					}
-				}
-			case 1:
-				isMercItem := (flags & 0x800000) != 0
-				if data.UnitID(itemOwnerNPC) == mainPlayer.UnitID || itemOwnerNPC == 1 {
-					location = item.LocationEquipped
-					if itm.Type().Code == item.TypeBelt {
-						belt.Name = itm.Name
-					}
-				} else if isMercItem {
-					location = item.LocationMercenary
-				}
-			case 2:
-				if data.UnitID(itemOwnerNPC) == mainPlayer.UnitID || itemOwnerNPC == 1 {
-					location = item.LocationBelt
-				}
-			case 3, 5:
-				location = item.LocationGround
-			case 6:
-				location = item.LocationSocket
-			case 4:
-				location = item.LocationCursor
+		case 2:
+			if data.UnitID(itemOwnerNPC) == mainPlayer.UnitID || itemOwnerNPC == 1 {
+				location = item.LocationBelt
 			}
+		case 3, 5:
+			location = item.LocationGround
+		case 6:
+			location = item.LocationSocket
+		case 4:
+			location = item.LocationCursor
+		}
 
-			// Set body location if equipped
-			bodyLoc := item.LocNone
-			equipSlotFlags := uint16(gd.Process.ReadUInt(unitDataPtr+uintptr(0x54), Uint16))
-			if equipSlotFlags&0xFF00 == 0xFF00 {
-				equipSlot := uint8(equipSlotFlags & 0xFF)
-				switch equipSlot {
-				case 0x01:
-					bodyLoc = item.LocHead
-				case 0x02:
-					bodyLoc = item.LocNeck
-				case 0x03:
-					bodyLoc = item.LocTorso
-				case 0x04:
-					bodyLoc = item.LocLeftArm
-				case 0x05:
-					bodyLoc = item.LocRightArm
-				case 0x06:
-					bodyLoc = item.LocLeftRing
-				case 0x07:
-					bodyLoc = item.LocRightRing
-				case 0x08:
-					bodyLoc = item.LocBelt
-				case 0x09:
-					bodyLoc = item.LocFeet
-				case 0x0A:
-					bodyLoc = item.LocGloves
-				case 0x0B:
-					bodyLoc = item.LocLeftArmSecondary
-				case 0x0C:
-					bodyLoc = item.LocRightArmSecondary
-				}
-			}
+		// Set body location if equipped
+		bodyLoc := item.LocNone
+		equipSlotFlags := uint16(ReadUIntFromBuffer(unitDataBuffer, 0x54, Uint16))
+		if equipSlotFlags&0xFF00 == 0xFF00 {
+			bodyLoc = item.BodyLocation(equipSlotFlags & 0xFF).LocationType()
+		}
 
-			itm.Location = item.Location{
-				LocationType: location,
-				BodyLocation: bodyLoc,
-				Page:         int(invPage),
-			}
+		itm.Location = item.Location{
+			LocationType: location,
+			BodyLocation: bodyLoc,
+			Page:         int(invPage),
+		}
 
-			// We don't care about the inventory we don't know where they are, probably previous games or random crap
-			if location == item.LocationUnknown {
-				itemUnitPtr = nextItemPtr
-				continue
-			}
+		// We don't care about the inventory we don't know where they are, probably previous games or random crap
+		if location == item.LocationUnknown {
+			continue
+		}
 
-			// Read item stats
-			statsListExPtr := uintptr(ReadUIntFromBuffer(itemDataBuffer, 0x88, Uint64))
-			itm.BaseStats, itm.Stats = gd.getItemStats(statsListExPtr)
+		// Read item stats
+		statsListExPtr := uintptr(ReadUIntFromBuffer(itemDataBuffer, 0x88, Uint64))
+		itm.BaseStats, itm.Stats = gd.getItemStats(statsListExPtr)
 
-			// Process socket information
-			if location == item.LocationSocket {
-				if itm.Desc().Code == "jew" {
-					// Base requirement for jewels
-					itm.LevelReq = item.Desc[itm.ID].RequiredLevel
+		// Process socket information
+		if location == item.LocationSocket {
+			if itm.Desc().Code == "jew" {
+				// Base requirement for jewels
+				itm.LevelReq = item.Desc[itm.ID].RequiredLevel
 
-					// For magic/rare jewels, check affixes
-					if itm.Quality == item.QualityMagic || itm.Quality == item.QualityRare {
-						itm.LevelReq = updateMaxReqFromAffixes(itm.LevelReq, itm.Affixes)
-					}
-					// Rainbow facets
-				} else if itm.Quality == item.QualityUnique {
-					for _, uniqueInfo := range item.UniqueItems {
-						if uniqueInfo.Code == itm.Desc().Code {
-							itm.LevelReq = uniqueInfo.LevelReq
-							break
-						}
-					}
-				} else {
-					// Normal socketed items (runes,gems) just use base requirement
-					itm.LevelReq = item.Desc[itm.ID].RequiredLevel
+				// For magic/rare jewels, check affixes
+				if itm.Quality == item.QualityMagic || itm.Quality == item.QualityRare {
+					itm.LevelReq = updateMaxReqFromAffixes(itm.LevelReq, itm.Affixes)
 				}
-				itemExtraData := uintptr(gd.Process.ReadUInt(unitDataPtr+0xA0, Uint64))
-				if itemExtraData != 0 {
-					parentInfoPtr := uintptr(gd.Process.ReadUInt(itemExtraData+0x08, Uint64))
-					if parentInfoPtr != 0 {
-						// Read parent unit ID directly from base item memory structure
-						if err := gd.Process.ReadIntoBuffer(parentInfoPtr, itemDataBuffer); err == nil {
-							parentUnitID := data.UnitID(ReadUIntFromBuffer(itemDataBuffer, 0x08, Uint32))
-							socketedItemsMap[parentUnitID] = append(socketedItemsMap[parentUnitID], socketInfo{
-								item:     itm,
-								position: itm.Position.X,
-							})
-						}
+				// Rainbow facets
+			} else if itm.Quality == item.QualityUnique {
+				for _, uniqueInfo := range item.UniqueItems {
+					if uniqueInfo.Code == itm.Desc().Code {
+						itm.LevelReq = uniqueInfo.LevelReq
+						break
 					}
 				}
 			} else {
-				// Check if item has sockets
-				if numSockets, _ := itm.Stats.FindStat(stat.NumSockets, 0); numSockets.Value > 0 {
-					baseItemsMap[itm.UnitID] = itm
+				// Normal socketed items (runes,gems) just use base requirement
+				itm.LevelReq = item.Desc[itm.ID].RequiredLevel
+			}
+			itemExtraData := uintptr(ReadUIntFromBuffer(unitDataBuffer, 0xA0, Uint64))
+			if itemExtraData != 0 {
+				parentInfoPtr := uintptr(gd.Process.ReadUInt(itemExtraData+0x08, Uint64))
+				if parentInfoPtr != 0 {
+					// Read parent unit ID directly from base item memory structure
+					if err := gd.Process.ReadIntoBuffer(parentInfoPtr, parentBuffer); err == nil {
+						parentUnitID := data.UnitID(ReadUIntFromBuffer(parentBuffer, 0x08, Uint32))
+						socketedItemsMap[parentUnitID] = append(socketedItemsMap[parentUnitID], socketInfo{
+							item:     itm,
+							position: itm.Position.X,
+						})
+					}
 				}
 			}
-
-			// Add to appropriate collections
-			if location == item.LocationBelt {
-				belt.Items = append(belt.Items, *itm)
-			} else if location != item.LocationSocket {
-				allItems = append(allItems, itm)
+		} else {
+			// Check if item has sockets
+			if numSockets, _ := itm.Stats.FindStat(stat.NumSockets, 0); numSockets.Value > 0 {
+				baseItemsMap[itm.UnitID] = itm
 			}
+		}
 
-			itemUnitPtr = nextItemPtr
+		// Add to appropriate collections
+		if location == item.LocationBelt {
+			belt.Items = append(belt.Items, *itm)
+		} else if location != item.LocationSocket {
+			allItems = append(allItems, itm)
 		}
 	}
 
@@ -490,6 +324,166 @@ func (gd *GameReader) Inventory(rawPlayerUnits RawPlayerUnits, hover data.HoverD
 	return inventory
 }
 
+// newItem builds an item from its unit, unit data and path buffers, location is not set because it depends on the
+// owner unit
+func newItem(itemDataBuffer, unitDataBuffer, pathBuffer []byte, hover data.HoverData) *data.Item {
+	txtFileNo := ReadUIntFromBuffer(itemDataBuffer, 0x04, Uint32)
+	unitID := ReadUIntFromBuffer(itemDataBuffer, 0x08, Uint32)
+
+	itemLevel := ReadUIntFromBuffer(unitDataBuffer, 0x2C, Uint32)
+	itemSeed := ReadUIntFromBuffer(unitDataBuffer, 0x10, Uint32)
+	itemLoc := ReadUIntFromBuffer(itemDataBuffer, 0x0C, Uint32)
+	itemQuality := ReadUIntFromBuffer(unitDataBuffer, 0x00, Uint32)
+	flags := ReadUIntFromBuffer(unitDataBuffer, 0x18, Uint32)
+
+	// Link to uniqueitems.txt, setitems.txt
+	txtUniqueSet := int32(ReadUIntFromBuffer(unitDataBuffer, 0x34, Uint32))
+
+	// Item coordinates (X, Y)
+	itemX := ReadUIntFromBuffer(pathBuffer, 0x10, Uint16)
+	itemY := ReadUIntFromBuffer(pathBuffer, 0x14, Uint16)
+
+	// Create item structure
+	itm := &data.Item{
+		ID:        int(txtFileNo),
+		UnitID:    data.UnitID(unitID),
+		Name:      item.GetNameByEnum(txtFileNo),
+		Quality:   item.Quality(itemQuality),
+		ItemLevel: int(itemLevel),
+		Seed:      uint32(itemSeed),
+		Mode:      mode.ItemMode(itemLoc),
+		Position: data.Position{
+			X: int(itemX),
+			Y: int(itemY),
+		},
+		IsHovered:   hover.IsHovered && hover.UnitType == 4 && hover.UnitID == data.UnitID(unitID),
+		Sockets:     make([]data.Item, 0),
+		UniqueSetID: txtUniqueSet,
+	}
+
+	// Set item properties
+	setProperties(itm, uint32(flags))
+
+	// Read rare affixes
+	rarePrefix := int16(ReadUIntFromBuffer(unitDataBuffer, 0x42, Uint16))
+	rareSuffix := int16(ReadUIntFromBuffer(unitDataBuffer, 0x44, Uint16))
+	//autoAffix := int16(ReadUIntFromBuffer(unitDataBuffer, 0x46, Uint16))
+
+	// Read magic affixes
+	var prefixes [3]int16
+	var suffixes [3]int16
+	for i := 0; i < 3; i++ {
+		prefixes[i] = int16(ReadUIntFromBuffer(unitDataBuffer, uint(0x48+i*2), Uint16))
+		suffixes[i] = int16(ReadUIntFromBuffer(unitDataBuffer, uint(0x4E+i*2), Uint16))
+	}
+
+	itm.Affixes = data.ItemAffixes{
+		Rare: struct {
+			Prefix int16
+			Suffix int16
+		}{
+			Prefix: rarePrefix,
+			Suffix: rareSuffix,
+		},
+		Magic: struct {
+			Prefixes [3]int16
+			Suffixes [3]int16
+		}{
+			Prefixes: prefixes,
+			Suffixes: suffixes,
+		},
+	}
+
+	maxAffixReq := 0
+	if itm.Identified {
+		switch itm.Quality {
+		case item.QualityUnique:
+			// find matching item (uniqueitems.txt)
+			for _, uniqueInfo := range item.UniqueItems {
+				if uniqueInfo.ID == int(txtUniqueSet) {
+					itm.IdentifiedName = uniqueInfo.Name
+					itm.LevelReq = uniqueInfo.LevelReq
+					break
+				}
+			}
+		case item.QualitySet:
+			// find matching item (setitems.txt)
+			for setItemName, setItemInfo := range item.SetItems {
+				if setItemInfo.ID == int(txtUniqueSet) {
+					itm.IdentifiedName = string(setItemName)
+					itm.LevelReq = setItemInfo.LevelReq
+					break
+				}
+			}
+		case item.QualityRare, item.QualityCrafted:
+			// Set item name from rare affixes
+			if prefix, exists := item.RarePrefixDesc[int(rarePrefix)]; exists {
+				if suffix, exists := item.RareSuffixDesc[int(rareSuffix)]; exists {
+					itm.IdentifiedName = prefix.Name + " " + suffix.Name
+				}
+			}
+			// Get level requirements from magic affixes
+			for _, prefixID := range prefixes {
+				if prefix, exists := item.MagicPrefixDesc[int(prefixID)]; exists && prefixID != 0 {
+					if prefix.LevelReq > maxAffixReq {
+						maxAffixReq = prefix.LevelReq
+					}
+				}
+			}
+			for _, suffixID := range suffixes {
+				if suffix, exists := item.MagicSuffixDesc[int(suffixID)]; exists && suffixID != 0 {
+					if suffix.LevelReq > maxAffixReq {
+						maxAffixReq = suffix.LevelReq
+					}
+				}
+			}
+		case item.QualityMagic:
+			var prefixParts []string
+			var suffixParts []string
+
+			// Get all prefixes
+			for _, prefixID := range prefixes {
+				if prefix, exists := item.MagicPrefixDesc[int(prefixID)]; exists && prefixID != 0 {
+					prefixParts = append(prefixParts, prefix.Name)
+					if prefix.LevelReq > maxAffixReq {
+						maxAffixReq = prefix.LevelReq
+					}
+				}
+			}
+
+			// Get all suffixes
+			for _, suffixID := range suffixes {
+				if suffix, exists := item.MagicSuffixDesc[int(suffixID)]; exists && suffixID != 0 {
+					suffixParts = append(suffixParts, suffix.Name)
+					if suffix.LevelReq > maxAffixReq {
+						maxAffixReq = suffix.LevelReq
+					}
+				}
+			}
+
+			// Construct name: prefixes + base name + suffixes
+			var nameParts []string
+			if len(prefixParts) > 0 {
+				nameParts = append(nameParts, prefixParts...)
+			}
+			nameParts = append(nameParts, itm.Desc().Name)
+			if len(suffixParts) > 0 {
+				nameParts = append(nameParts, suffixParts...)
+			}
+			itm.IdentifiedName = strings.Join(nameParts, " ")
+		}
+	}
+
+	// Set runeword name if applicable
+	if itm.IsRuneword {
+		if runeword, exists := item.RunewordIDMap[prefixes[0]]; exists {
+			itm.RunewordName = runeword
+		}
+	}
+
+	return itm
+}
+
 func (gd *GameReader) getItemStats(statsListExPtr uintptr) (stat.Stats, stat.Stats) {
 	// Initial full and base stats extraction
 	fullStats := gd.getStatsList(statsListExPtr + 0xA8)
@@ -518,8 +512,8 @@ func (gd *GameReader) getItemStats(statsListExPtr uintptr) (stat.Stats, stat.Sta
 	statListPtr := lastStatsList
 
 	// Traverse the stat lists to accumulate additional stats
-	for statListPtr != 0 {
-
+	depth := 0
+	for ; statListPtr != 0 && depth < maxStatListDepth; depth++ {
 		statListFlags := gd.Process.ReadUInt(statListPtr+0x1C, Uint64)
 
 		// If we hit a condition where no further traversal is needed, break
@@ -531,8 +525,8 @@ func (gd *GameReader) getItemStats(statsListExPtr uintptr) (stat.Stats, stat.Sta
 		statListPtr = uintptr(gd.Process.ReadUInt(statListPtr+0x48, Uint64))
 	}
 
-	// If we found a valid previous stat list
-	if statListPtr != 0 {
+	// If we found a valid previous stat list, a chain longer than maxStatListDepth is garbage
+	if statListPtr != 0 && depth < maxStatListDepth {
 		additionalBaseStats := gd.getStatsList(statListPtr + 0x30)
 
 		// Add only the additional stats that are not already present in fullStats
@@ -549,7 +543,7 @@ func (gd *GameReader) getItemStats(statsListExPtr uintptr) (stat.Stats, stat.Sta
 	// Handle base stats from potential modifiers and ensure they don't pollute baseStats
 	statListPtr = lastStatsList
 
-	for statListPtr != 0 {
+	for depth := 0; statListPtr != 0 && depth < maxStatListDepth; depth++ {
 		statListFlags := gd.Process.ReadUInt(statListPtr+0x1C, Uint64)
 
 		if statListFlags != 0 {
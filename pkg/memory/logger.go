@@ -0,0 +1,61 @@
+package memory
+
+import (
+	"fmt"
+	"log/slog"
+)
+
+// Logger is the logging interface used by the memory reader, *slog.Logger satisfies it
+type Logger interface {
+	Debug(msg string, args ...any)
+	Info(msg string, args ...any)
+	Warn(msg string, args ...any)
+	Error(msg string, args ...any)
+}
+
+type GameReaderOption func(gd *GameReader)
+
+// WithLogger sets the logger used by the GameReader and its Process, slog.Default() is used if not set
+func WithLogger(logger Logger) GameReaderOption {
+	return func(gd *GameReader) {
+		gd.Process.logger = logger
+	}
+}
+
+// SetLogger sets the logger used by the Process, slog.Default() is used if not set
+func (p *Process) SetLogger(logger Logger) {
+	p.logger = logger
+}
+
+func (p *Process) log() Logger {
+	if p.logger != nil {
+		return p.logger
+	}
+
+	return slog.Default()
+}
+
+// hexValue is an address logged in hex, formatted only if the record is actually handled
+type hexValue uintptr
+
+func (h hexValue) String() string {
+	return fmt.Sprintf("0x%X", uintptr(h))
+}
+
+func (h hexValue) LogValue() slog.Value {
+	return slog.StringValue(h.String())
+}
+
+// lazySprintf formats its arguments only if the record is actually handled, for values that are expensive to print
+type lazySprintf struct {
+	format string
+	args   []any
+}
+
+func (l lazySprintf) String() string {
+	return fmt.Sprintf(l.format, l.args...)
+}
+
+func (l lazySprintf) LogValue() slog.Value {
+	return slog.StringValue(l.String())
+}
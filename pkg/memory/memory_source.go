@@ -0,0 +1,26 @@
+package memory
+
+// MemoryReader contains the low level memory operations used by the readers, it's implemented by Process
+type MemoryReader interface {
+	ReadUInt(address uintptr, size IntType) uint
+	ReadBytesFromMemory(address uintptr, size uint) []byte
+	ReadStringFromMemory(address uintptr, size uint) string
+}
+
+var _ MemoryReader = (*Process)(nil)
+
+// MemorySource is where Process reads the memory from, by default it's the game process. Implementing it allows to
+// build a Process (and a GameReader on top of it) over fake memory, so readers can be tested without the game running
+type MemorySource interface {
+	// ReadMemory fills the whole buffer with the memory at the given address, or returns an error
+	ReadMemory(address uintptr, buffer []byte) error
+}
+
+// NewProcessFromSource creates a Process reading from the given MemorySource instead of a game process
+func NewProcessFromSource(source MemorySource, moduleBaseAddress uintptr, moduleBaseSize uint32) *Process {
+	return &Process{
+		moduleBaseAddressPtr: moduleBaseAddress,
+		moduleBaseSize:       moduleBaseSize,
+		source:               source,
+	}
+}
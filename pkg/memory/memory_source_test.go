@@ -0,0 +1,76 @@
+package memory
+
+import (
+	"errors"
+	"testing"
+)
+
+type fakeMemory struct {
+	base uintptr
+	data []byte
+}
+
+func (f fakeMemory) ReadMemory(address uintptr, buffer []byte) error {
+	if address < f.base || address+uintptr(len(buffer)) > f.base+uintptr(len(f.data)) {
+		return errors.New("out of range")
+	}
+	copy(buffer, f.data[address-f.base:])
+
+	return nil
+}
+
+func TestProcessFromSource(t *testing.T) {
+	mem := fakeMemory{base: 0x140000000, data: make([]byte, 0x100)}
+	mem.data[0x10] = 0x34
+	mem.data[0x11] = 0x12
+	copy(mem.data[0x20:], "Akara\x00")
+
+	var reader MemoryReader = NewProcessFromSource(mem, mem.base, uint32(len(mem.data)))
+
+	if got := reader.ReadUInt(mem.base+0x10, Uint16); got != 0x1234 {
+		t.Errorf("ReadUInt returned 0x%X, expected 0x1234", got)
+	}
+	if got := reader.ReadStringFromMemory(mem.base+0x20, 0); got != "Akara" {
+		t.Errorf("ReadStringFromMemory returned %q, expected %q", got, "Akara")
+	}
+	if got := reader.ReadBytesFromMemory(mem.base+0x200, 4); string(got) != "\x00\x00\x00\x00" {
+		t.Errorf("ReadBytesFromMemory out of range returned %v, expected zeroed buffer", got)
+	}
+}
+
+func TestReadStringFromMemoryRegionEnd(t *testing.T) {
+	mem := fakeMemory{base: 0x140000000, data: make([]byte, 0x40)}
+	// Closer to the end of the region than a whole chunk
+	copy(mem.data[0x38:], "Charsi\x00")
+	// Not terminated before the end of the region
+	mem.data[0x3F] = 'X'
+
+	p := NewProcessFromSource(mem, mem.base, uint32(len(mem.data)))
+
+	if got := p.ReadStringFromMemory(mem.base+0x38, 0); got != "Charsi" {
+		t.Errorf("ReadStringFromMemory returned %q, expected %q", got, "Charsi")
+	}
+	if got := p.ReadStringFromMemory(mem.base+0x3F, 0); got != "X" {
+		t.Errorf("ReadStringFromMemory returned %q, expected %q", got, "X")
+	}
+}
+
+func TestReadFailuresBySource(t *testing.T) {
+	mem := fakeMemory{base: 0x140000000, data: make([]byte, 0x100)}
+	p := NewProcessFromSource(mem, mem.base, uint32(len(mem.data)))
+
+	p.ReadUInt(mem.base+0x200, Uint32)
+	p.ReadUInt(mem.base+0x300, Uint32)
+	p.ReadBatch([]ReadRequest{{Address: mem.base + 0x10, Size: 4}, {Address: mem.base + 0x400, Size: 4}})
+	// Null pointers are not failures
+	p.ReadUInt(0x08, Uint32)
+
+	for source, expected := range map[ReadSource]uint64{ReadSourceScalar: 2, ReadSourceBytes: 0, ReadSourceBatch: 1} {
+		if got := p.ReadFailuresBySource(source); got != expected {
+			t.Errorf("%s read failures %d, expected %d", source, got, expected)
+		}
+	}
+	if got := p.ReadFailures(); got != 3 {
+		t.Errorf("read failures %d, expected 3", got)
+	}
+}
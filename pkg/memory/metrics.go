@@ -0,0 +1,51 @@
+package memory
+
+import (
+	"time"
+)
+
+const (
+	CacheMonsters  = "monsters"
+	CacheInventory = "inventory"
+	CacheObjects   = "objects"
+)
+
+// Metrics receives the reader health metrics, it can be implemented on top of any metrics library (Prometheus
+// counters/histograms, expvar...). Implementations must be safe for concurrent use and fast, they are called on every read
+type Metrics interface {
+	// ObserveGetData is called after every GetData call with its duration
+	ObserveGetData(duration time.Duration)
+	// AddBytesRead is called after every successful memory read
+	AddBytesRead(bytes int)
+	// ReadError is called on every failed memory read, null pointer reads are not included
+	ReadError()
+	// CacheAccess is called every time GetData decides to use (hit) or refresh (miss) a cached value
+	CacheAccess(cache string, hit bool)
+}
+
+type nopMetrics struct{}
+
+func (nopMetrics) ObserveGetData(time.Duration) {}
+func (nopMetrics) AddBytesRead(int)             {}
+func (nopMetrics) ReadError()                   {}
+func (nopMetrics) CacheAccess(string, bool)     {}
+
+// WithMetrics sets the metrics receiver used by the GameReader and its Process, metrics are disabled if not set
+func WithMetrics(metrics Metrics) GameReaderOption {
+	return func(gd *GameReader) {
+		gd.Process.metrics = metrics
+	}
+}
+
+// SetMetrics sets the metrics receiver used by the Process, metrics are disabled if not set
+func (p *Process) SetMetrics(metrics Metrics) {
+	p.metrics = metrics
+}
+
+func (p *Process) metricsOrNop() Metrics {
+	if p.metrics != nil {
+		return p.metrics
+	}
+
+	return nopMetrics{}
+}
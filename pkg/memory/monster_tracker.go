@@ -0,0 +1,141 @@
+package memory
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/hectorgimenez/d2go/pkg/data"
+	"github.com/hectorgimenez/d2go/pkg/data/npc"
+	"github.com/hectorgimenez/d2go/pkg/data/stat"
+)
+
+const (
+	monsterPositionHistorySize = 32
+	// Monsters out of range are unloaded and loaded again with the same UnitID, tracks are kept for a while so they
+	// continue where they were left
+	monsterTrackExpiration = 30 * time.Second
+)
+
+type TrackedPosition struct {
+	Time     time.Time
+	Position data.Position
+}
+
+// MonsterTrack is the history of a monster across monster refreshes, identified by its UnitID
+type MonsterTrack struct {
+	UnitID    data.UnitID
+	Name      npc.ID
+	FirstSeen time.Time
+	LastSeen  time.Time
+	Life      int // Last life read
+	// Estimated damage dealt to the monster since it was first seen: the sum of all the life drops between refreshes.
+	// Life regenerated between refreshes hides part of the damage, so it's a lower bound
+	DamageTaken int
+	Positions   []TrackedPosition // Last positions where the monster was seen moving, oldest first
+}
+
+// Lifetime returns for how long the monster has been tracked
+func (t MonsterTrack) Lifetime() time.Duration {
+	return t.LastSeen.Sub(t.FirstSeen)
+}
+
+type monsterTracker struct {
+	mu      sync.Mutex
+	enabled bool
+	tracks  map[data.UnitID]*MonsterTrack
+}
+
+// WithMonsterTracking keeps the history of every monster across refreshes (see GameReader.MonsterTrack), it's disabled
+// by default because it keeps a few positions per monster in memory
+func WithMonsterTracking() GameReaderOption {
+	return func(gd *GameReader) {
+		gd.monsterTracks.mu.Lock()
+		defer gd.monsterTracks.mu.Unlock()
+		gd.monsterTracks.enabled = true
+	}
+}
+
+func (t *monsterTracker) update(now time.Time, monsters data.Monsters) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if !t.enabled {
+		return
+	}
+	if t.tracks == nil {
+		t.tracks = make(map[data.UnitID]*MonsterTrack)
+	}
+
+	for _, m := range monsters {
+		life := m.Stats[stat.Life]
+		track, found := t.tracks[m.UnitID]
+		// UnitIDs are reused, a different monster with the same ID starts a new track
+		if !found || track.Name != m.Name {
+			track = &MonsterTrack{UnitID: m.UnitID, Name: m.Name, FirstSeen: now, Life: life}
+			t.tracks[m.UnitID] = track
+		}
+
+		if life < track.Life {
+			track.DamageTaken += track.Life - life
+		}
+		track.Life = life
+		track.LastSeen = now
+
+		if n := len(track.Positions); n == 0 || track.Positions[n-1].Position != m.Position {
+			if n == monsterPositionHistorySize {
+				copy(track.Positions, track.Positions[1:])
+				track.Positions = track.Positions[:n-1]
+			}
+			track.Positions = append(track.Positions, TrackedPosition{Time: now, Position: m.Position})
+		}
+	}
+
+	for id, track := range t.tracks {
+		if now.Sub(track.LastSeen) > monsterTrackExpiration {
+			delete(t.tracks, id)
+		}
+	}
+}
+
+func (t *monsterTracker) reset() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.tracks = nil
+}
+
+// MonsterTrack returns the history of the given monster, false if monster tracking is not enabled (see
+// WithMonsterTracking) or the monster has not been seen recently
+func (gd *GameReader) MonsterTrack(id data.UnitID) (MonsterTrack, bool) {
+	gd.monsterTracks.mu.Lock()
+	defer gd.monsterTracks.mu.Unlock()
+
+	track, found := gd.monsterTracks.tracks[id]
+	if !found {
+		return MonsterTrack{}, false
+	}
+
+	return copyTrack(track), true
+}
+
+// MonsterTracks returns the history of all the recently seen monsters, sorted by first seen time
+func (gd *GameReader) MonsterTracks() []MonsterTrack {
+	gd.monsterTracks.mu.Lock()
+	defer gd.monsterTracks.mu.Unlock()
+
+	tracks := make([]MonsterTrack, 0, len(gd.monsterTracks.tracks))
+	for _, track := range gd.monsterTracks.tracks {
+		tracks = append(tracks, copyTrack(track))
+	}
+	sort.Slice(tracks, func(i, j int) bool { return tracks[i].FirstSeen.Before(tracks[j].FirstSeen) })
+
+	return tracks
+}
+
+func copyTrack(track *MonsterTrack) MonsterTrack {
+	t := *track
+	t.Positions = append([]TrackedPosition(nil), track.Positions...)
+
+	return t
+}
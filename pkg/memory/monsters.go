@@ -12,61 +12,79 @@ import (
 )
 
 func (gd *GameReader) Monsters(playerPosition data.Position, hover data.HoverData) data.Monsters {
-	baseAddr := gd.Process.moduleBaseAddressPtr + gd.offset.UnitTable + 1024
-	unitTableBuffer := gd.Process.ReadBytesFromMemory(baseAddr, 128*8)
-
-	monsters := data.Monsters{}
-	for i := 0; i < 128; i++ {
-		monsterOffset := 8 * i
-		monsterUnitPtr := uintptr(ReadUIntFromBuffer(unitTableBuffer, uint(monsterOffset), Uint64))
-		for monsterUnitPtr > 0 {
-			// Quick corpse check first
-			isCorpse := gd.Process.ReadUInt(monsterUnitPtr+0x1AE, Uint8)
-			if isCorpse != 0 {
-				monsterUnitPtr = uintptr(gd.Process.ReadUInt(monsterUnitPtr+0x158, Uint64))
-				continue
-			}
-
-			monsterDataBuffer := gd.Process.ReadBytesFromMemory(monsterUnitPtr, 144)
-			txtFileNo := ReadUIntFromBuffer(monsterDataBuffer, 0x04, Uint32)
-			unitID := ReadUIntFromBuffer(monsterDataBuffer, 0x08, Uint32)
+	return gd.readMonsterUnits(playerPosition, hover, false)
+}
 
-			// Get stats early for filtering
-			statsListExPtr := uintptr(ReadUIntFromBuffer(monsterDataBuffer, 0x88, Uint64))
-			statPtr := uintptr(gd.Process.ReadUInt(statsListExPtr+0x30, Uint64))
-			statCount := gd.Process.ReadUInt(statsListExPtr+0x38, Uint64)
-			stats := gd.getMonsterStats(statCount, statPtr)
+func (gd *GameReader) Corpses(playerPosition data.Position, hover data.HoverData) data.Monsters {
+	return gd.readMonsterUnits(playerPosition, hover, true)
+}
 
-			if !gd.shouldBeIgnored(txtFileNo) || stats[stat.Experience] > 0 {
-				monsterMode := mode.NpcMode(gd.Process.ReadUInt(monsterUnitPtr+0x0c, Uint32))
-				unitDataPtr := uintptr(ReadUIntFromBuffer(monsterDataBuffer, 0x10, Uint64))
-				flag := gd.Process.ReadBytesFromMemory(unitDataPtr+0x1A, Uint8)[0]
-				//unitDataBuffer := gd.Process.ReadBytesFromMemory(unitDataPtr, 144)
+// readMonsterUnits returns alive monsters or corpses, sorted by distance to the player. Reads are batched in 2 steps,
+// first all the data pointed by the unit struct and then the stats pointed by the stats list. No GameReader state is
+// modified, so it's safe to call while another goroutine is reading
+func (gd *GameReader) readMonsterUnits(playerPosition data.Position, hover data.HoverData, corpses bool) data.Monsters {
+	units := make([]rawUnit, 0)
+	for _, u := range gd.walkUnitTable(unitTableMonsters) {
+		isCorpse := ReadUIntFromBuffer(u.Buffer, 0x1AE, Uint8) != 0
+		if isCorpse == corpses {
+			units = append(units, u)
+		}
+	}
 
-				// Coordinates (X, Y)
-				pathPtr := uintptr(gd.Process.ReadUInt(monsterUnitPtr+0x38, Uint64))
-				posX := gd.Process.ReadUInt(pathPtr+0x02, Uint16)
-				posY := gd.Process.ReadUInt(pathPtr+0x06, Uint16)
+	const requestsPerUnit = 4
+	requests := make([]ReadRequest, 0, len(units)*requestsPerUnit)
+	for _, u := range units {
+		statsListExPtr := uintptr(ReadUIntFromBuffer(u.Buffer, 0x88, Uint64))
+		unitDataPtr := uintptr(ReadUIntFromBuffer(u.Buffer, 0x10, Uint64))
+		pathPtr := uintptr(ReadUIntFromBuffer(u.Buffer, 0x38, Uint64))
+		requests = append(requests,
+			ReadRequest{Address: statsListExPtr + 0x30, Size: 0x10},
+			ReadRequest{Address: statsListExPtr + statesOffset, Size: statesBufferSize},
+			ReadRequest{Address: unitDataPtr + 0x1A, Size: Uint8},
+			ReadRequest{Address: pathPtr, Size: 0x14},
+		)
+	}
+	unitData := gd.Process.ReadBatch(requests)
+
+	statRequests := make([]ReadRequest, len(units))
+	for i := range units {
+		statListBuffer := unitData[i*requestsPerUnit]
+		statPtr := uintptr(ReadUIntFromBuffer(statListBuffer, 0, Uint64))
+		count := statCount(ReadUIntFromBuffer(statListBuffer, 0x08, Uint64))
+		statRequests[i] = ReadRequest{Address: statPtr + 0x2, Size: count * 8}
+	}
+	statBuffers := gd.Process.ReadBatch(statRequests)
 
-				states := gd.GetStates(statsListExPtr)
+	monsters := data.Monsters{}
+	for i, u := range units {
+		txtFileNo := ReadUIntFromBuffer(u.Buffer, 0x04, Uint32)
+		stats := monsterStatsFromBuffer(statBuffers[i])
+		if gd.shouldBeIgnored(txtFileNo) && stats[stat.Experience] <= 0 {
+			continue
+		}
 
-				monsters = append(monsters, data.Monster{
-					UnitID:    data.UnitID(unitID),
-					Name:      npc.ID(int(txtFileNo)),
-					IsHovered: hover.IsHovered && hover.UnitType == 1 && hover.UnitID == data.UnitID(unitID),
-					Position: data.Position{
-						X: int(posX),
-						Y: int(posY),
-					},
-					Stats:  stats,
-					Type:   getMonsterType(flag),
-					States: states,
-					Mode:   monsterMode,
-				})
-			}
+		unitID := ReadUIntFromBuffer(u.Buffer, 0x08, Uint32)
+		pathBuffer := unitData[i*requestsPerUnit+3]
+		position := data.Position{
+			X: int(ReadUIntFromBuffer(pathBuffer, 0x02, Uint16)),
+			Y: int(ReadUIntFromBuffer(pathBuffer, 0x06, Uint16)),
+		}
 
-			monsterUnitPtr = uintptr(gd.Process.ReadUInt(monsterUnitPtr+0x158, Uint64))
+		monster := data.Monster{
+			UnitID:     data.UnitID(unitID),
+			Name:       npc.ID(int(txtFileNo)),
+			IsHovered:  hover.IsHovered && hover.UnitType == 1 && hover.UnitID == data.UnitID(unitID),
+			Position:   position,
+			PathTarget: pathTarget(position, int(ReadUIntFromBuffer(pathBuffer, 0x10, Uint16)), int(ReadUIntFromBuffer(pathBuffer, 0x12, Uint16))),
+			Stats:      stats,
+			Type:       getMonsterType(unitData[i*requestsPerUnit+2][0]),
+			States:     statesFromBuffer(unitData[i*requestsPerUnit+1]),
+		}
+		if !corpses {
+			monster.Mode = mode.NpcMode(ReadUIntFromBuffer(u.Buffer, 0x0C, Uint32))
 		}
+
+		monsters = append(monsters, monster)
 	}
 
 	if len(monsters) > 0 {
@@ -80,70 +98,6 @@ func (gd *GameReader) Monsters(playerPosition data.Position, hover data.HoverDat
 	return monsters
 }
 
-func (gd *GameReader) Corpses(playerPosition data.Position, hover data.HoverData) data.Monsters {
-	baseAddr := gd.Process.moduleBaseAddressPtr + gd.offset.UnitTable + 1024
-	unitTableBuffer := gd.Process.ReadBytesFromMemory(baseAddr, 128*8)
-
-	corpses := data.Monsters{}
-
-	for i := 0; i < 128; i++ {
-		monsterOffset := 8 * i
-		monsterUnitPtr := uintptr(ReadUIntFromBuffer(unitTableBuffer, uint(monsterOffset), Uint64))
-		for monsterUnitPtr > 0 {
-			isCorpse := gd.Process.ReadUInt(monsterUnitPtr+0x1AE, Uint8)
-			if isCorpse == 0 {
-				monsterUnitPtr = uintptr(gd.Process.ReadUInt(monsterUnitPtr+0x158, Uint64))
-				continue
-			}
-
-			monsterDataBuffer := gd.Process.ReadBytesFromMemory(monsterUnitPtr, 144)
-			txtFileNo := ReadUIntFromBuffer(monsterDataBuffer, 0x04, Uint32)
-
-			statsListExPtr := uintptr(ReadUIntFromBuffer(monsterDataBuffer, 0x88, Uint64))
-			statPtr := uintptr(gd.Process.ReadUInt(statsListExPtr+0x30, Uint64))
-			statCount := gd.Process.ReadUInt(statsListExPtr+0x38, Uint64)
-			stats := gd.getMonsterStats(statCount, statPtr)
-
-			if !gd.shouldBeIgnored(txtFileNo) || stats[stat.Experience] > 0 {
-				unitID := ReadUIntFromBuffer(monsterDataBuffer, 0x08, Uint32)
-				unitDataPtr := uintptr(ReadUIntFromBuffer(monsterDataBuffer, 0x10, Uint64))
-				flag := gd.Process.ReadBytesFromMemory(unitDataPtr+0x1A, Uint8)[0]
-
-				pathPtr := uintptr(gd.Process.ReadUInt(monsterUnitPtr+0x38, Uint64))
-				posX := gd.Process.ReadUInt(pathPtr+0x02, Uint16)
-				posY := gd.Process.ReadUInt(pathPtr+0x06, Uint16)
-
-				states := gd.GetStates(statsListExPtr)
-
-				corpses = append(corpses, data.Monster{
-					UnitID:    data.UnitID(unitID),
-					Name:      npc.ID(int(txtFileNo)),
-					IsHovered: hover.IsHovered && hover.UnitType == 1 && hover.UnitID == data.UnitID(unitID),
-					Position: data.Position{
-						X: int(posX),
-						Y: int(posY),
-					},
-					Stats:  stats,
-					Type:   getMonsterType(flag),
-					States: states,
-				})
-			}
-
-			monsterUnitPtr = uintptr(gd.Process.ReadUInt(monsterUnitPtr+0x158, Uint64))
-		}
-	}
-
-	if len(corpses) > 0 {
-		sort.SliceStable(corpses, func(i, j int) bool {
-			distanceI := utils.DistanceFromPoint(playerPosition, corpses[i].Position)
-			distanceJ := utils.DistanceFromPoint(playerPosition, corpses[j].Position)
-			return distanceI < distanceJ
-		})
-	}
-
-	return corpses
-}
-
 func getMonsterType(typeFlag byte) data.MonsterType {
 	switch typeFlag {
 	case 10:
@@ -158,17 +112,14 @@ func getMonsterType(typeFlag byte) data.MonsterType {
 	return data.MonsterTypeNone
 }
 
-func (gd *GameReader) getMonsterStats(statCount uint, statPtr uintptr) map[stat.ID]int {
+// monsterStatsFromBuffer decodes the monster stats, buffer should be read from statPtr+0x2 (8 bytes per stat)
+func monsterStatsFromBuffer(statBuffer []byte) map[stat.ID]int {
 	stats := map[stat.ID]int{}
 
-	if statCount > 0 {
-		statBuffer := gd.Process.ReadBytesFromMemory(statPtr+0x2, statCount*8)
-		for i := 0; i < int(statCount); i++ {
-			offset := uint(i * 8)
-			statEnum := ReadUIntFromBuffer(statBuffer, offset, Uint16)
-			statValue := ReadUIntFromBuffer(statBuffer, offset+0x2, Uint32)
-			stats[stat.ID(statEnum)] = int(statValue)
-		}
+	for offset := uint(0); offset+8 <= uint(len(statBuffer)); offset += 8 {
+		statEnum := ReadUIntFromBuffer(statBuffer, offset, Uint16)
+		statValue := ReadUIntFromBuffer(statBuffer, offset+0x2, Uint32)
+		stats[stat.ID(statEnum)] = int(statValue)
 	}
 
 	return stats
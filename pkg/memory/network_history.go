@@ -0,0 +1,161 @@
+package memory
+
+import (
+	"math"
+	"sync"
+	"time"
+)
+
+const (
+	// Enough for a few seconds of samples when GetData is polled at 30+ Hz
+	networkHistorySize          = 512
+	defaultNetworkHistoryWindow = 5 * time.Second
+
+	// FPS dropping under the average divided by this is reported by FPSDropped
+	fpsDropFactor = 2
+)
+
+type NetworkSample struct {
+	Time time.Time
+	FPS  int
+	Ping int
+}
+
+// NetworkStats are the FPS and ping stats over the last samples. A sustained lag spike can be detected checking
+// MinPing, e.g. MinPing > 300 means the ping was over 300ms for the whole window
+type NetworkStats struct {
+	Samples int
+	Window  time.Duration
+	MinFPS  int
+	AvgFPS  int
+	MaxFPS  int
+	MinPing int
+	AvgPing int
+	MaxPing int
+	// Standard deviation of the FPS samples, unknown FPS (0, e.g. loading screens) samples are skipped. The game FPS
+	// counter is already an average sampled at the GetData rate, single long frames are not seen, only FPS drops
+	FPSStdDev float64
+}
+
+// FPSDropped returns true if the FPS went under half of the average within the window, input sent meanwhile may be
+// handled late, so cast sequences should wait a bit longer between inputs. Windows including unknown FPS samples
+// (MinFPS 0) are not reported
+func (s NetworkStats) FPSDropped() bool {
+	return s.MinFPS > 0 && s.MinFPS*fpsDropFactor < s.AvgFPS
+}
+
+// networkHistory is a ring buffer of the FPS and ping read on every GetData call
+type networkHistory struct {
+	mu      sync.Mutex
+	window  time.Duration
+	samples [networkHistorySize]NetworkSample
+	next    int
+	count   int
+}
+
+// WithNetworkHistoryWindow sets the time window used by NetworkStats, 5 seconds by default. Samples are kept in a
+// fixed size buffer, so very long windows only cover the last samples
+func WithNetworkHistoryWindow(window time.Duration) GameReaderOption {
+	return func(gd *GameReader) {
+		gd.network.mu.Lock()
+		defer gd.network.mu.Unlock()
+		gd.network.window = window
+	}
+}
+
+func (h *networkHistory) add(sample NetworkSample) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.samples[h.next] = sample
+	h.next = (h.next + 1) % networkHistorySize
+	h.count = min(h.count+1, networkHistorySize)
+}
+
+func (h *networkHistory) stats(now time.Time) NetworkStats {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	window := h.windowOrDefault()
+
+	st := NetworkStats{Window: window}
+	fpsSum, pingSum := 0, 0
+	knownFPS := make([]float64, 0, h.count)
+	for i := 1; i <= h.count; i++ {
+		s := h.samples[(h.next-i+networkHistorySize)%networkHistorySize]
+		if now.Sub(s.Time) > window {
+			break
+		}
+
+		if st.Samples == 0 {
+			st.MinFPS, st.MaxFPS, st.MinPing, st.MaxPing = s.FPS, s.FPS, s.Ping, s.Ping
+		}
+		st.MinFPS, st.MaxFPS = min(st.MinFPS, s.FPS), max(st.MaxFPS, s.FPS)
+		st.MinPing, st.MaxPing = min(st.MinPing, s.Ping), max(st.MaxPing, s.Ping)
+		fpsSum += s.FPS
+		pingSum += s.Ping
+		st.Samples++
+		if s.FPS > 0 {
+			knownFPS = append(knownFPS, float64(s.FPS))
+		}
+	}
+	if st.Samples > 0 {
+		st.AvgFPS = fpsSum / st.Samples
+		st.AvgPing = pingSum / st.Samples
+	}
+	if len(knownFPS) > 0 {
+		sum := 0.0
+		for _, fps := range knownFPS {
+			sum += fps
+		}
+		avg := sum / float64(len(knownFPS))
+		variance := 0.0
+		for _, fps := range knownFPS {
+			variance += (fps - avg) * (fps - avg)
+		}
+		st.FPSStdDev = math.Sqrt(variance / float64(len(knownFPS)))
+	}
+
+	return st
+}
+
+func (h *networkHistory) windowOrDefault() time.Duration {
+	if h.window <= 0 {
+		return defaultNetworkHistoryWindow
+	}
+
+	return h.window
+}
+
+func (h *networkHistory) reset() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.next, h.count = 0, 0
+}
+
+// NetworkStats returns the FPS and ping min/avg/max over the configured window (see WithNetworkHistoryWindow), samples
+// are taken on every GetData call
+func (gd *GameReader) NetworkStats() NetworkStats {
+	return gd.network.stats(time.Now())
+}
+
+// NetworkHistory returns the samples within the configured window, oldest first
+func (gd *GameReader) NetworkHistory() []NetworkSample {
+	h := &gd.network
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	window := h.windowOrDefault()
+
+	now := time.Now()
+	samples := make([]NetworkSample, 0, h.count)
+	for i := h.count; i >= 1; i-- {
+		s := h.samples[(h.next-i+networkHistorySize)%networkHistorySize]
+		if now.Sub(s.Time) <= window {
+			samples = append(samples, s)
+		}
+	}
+
+	return samples
+}
@@ -1,7 +1,10 @@
 package memory
 
 import (
+	"bytes"
 	"sort"
+	"sync"
+	"time"
 
 	"github.com/hectorgimenez/d2go/pkg/data/entrance"
 	"github.com/hectorgimenez/d2go/pkg/data/mode"
@@ -17,75 +20,128 @@ func isPortal(txtFileNo int) bool {
 	return ok && desc.Name == "Portal"
 }
 
+// objectLoadModes keeps the mode each object had when it was first seen, so objects that were already open when loaded
+// can be told apart from the ones opened by the player, and when healing objects were depleted. Objects are kept after
+// they unload until the main player unit changes (new game or out of game), so a chest opened by the player is still
+// known when coming back to it. Object unit IDs are assumed to be unique within a game
+type objectLoadModes struct {
+	mu       sync.Mutex
+	player   data.UnitID
+	modes    map[data.UnitID]mode.ObjectMode
+	depleted map[data.UnitID]time.Time
+}
+
+// update sets PreOpened and DepletedSince on the given objects, an object is pre-opened if it was not closed when it
+// was first seen during the game of the given main player
+func (l *objectLoadModes) update(now time.Time, player data.UnitID, objects []data.Object) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.modes == nil || player != l.player {
+		l.player = player
+		l.modes, l.depleted = make(map[data.UnitID]mode.ObjectMode), make(map[data.UnitID]time.Time)
+	}
+
+	for i, o := range objects {
+		loadMode, found := l.modes[o.ID]
+		// Seen closed, e.g. a healing object that recharged, whatever happened before it's not open anymore
+		if !found || o.Mode == mode.ObjectModeIdle {
+			loadMode = o.Mode
+			l.modes[o.ID] = loadMode
+		}
+		objects[i].PreOpened = loadMode != mode.ObjectModeIdle && loadMode != mode.ObjectModeOperating
+
+		if !o.Depleted() {
+			delete(l.depleted, o.ID)
+			continue
+		}
+		// Only depletions seen happening are timed, the time is unknown (zero) for the ones depleted when first seen
+		since, seen := l.depleted[o.ID]
+		if !seen && found {
+			since = now
+		}
+		l.depleted[o.ID] = since
+		objects[i].DepletedSince = since
+	}
+}
+
+func (l *objectLoadModes) reset() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.player, l.modes, l.depleted = 0, nil, nil
+}
+
 func (gd *GameReader) Objects(playerPosition data.Position, hover data.HoverData) []data.Object {
-	baseAddr := gd.Process.moduleBaseAddressPtr + gd.offset.UnitTable + (2 * 1024)
-	unitTableBuffer := gd.Process.ReadBytesFromMemory(baseAddr, 128*8)
+	units := make([]rawUnit, 0)
+	for _, u := range gd.walkUnitTable(unitTableObjects) {
+		if objectType := ReadUIntFromBuffer(u.Buffer, 0x00, Uint32); objectType == 2 {
+			units = append(units, u)
+		}
+	}
 
-	var objects []data.Object
+	requests := make([]ReadRequest, 0, len(units)*2)
+	for _, u := range units {
+		unitDataPtr := uintptr(ReadUIntFromBuffer(u.Buffer, 0x10, Uint64))
+		pathPtr := uintptr(ReadUIntFromBuffer(u.Buffer, 0x38, Uint64))
+		requests = append(requests,
+			ReadRequest{Address: unitDataPtr, Size: 0x54},
+			ReadRequest{Address: pathPtr + 0x10, Size: 0x06},
+		)
+	}
+	buffers := gd.Process.ReadBatch(requests)
 
-	for i := 0; i < 128; i++ {
-		objectOffset := 8 * i
-		objectUnitPtr := uintptr(ReadUIntFromBuffer(unitTableBuffer, uint(objectOffset), Uint64))
-
-		for objectUnitPtr > 0 {
-			// Read minimal data first to check object type
-			objectType := gd.Process.ReadUInt(objectUnitPtr+0x00, Uint32)
-
-			if objectType == 2 {
-				rawTxtFileNo := gd.Process.ReadUInt(objectUnitPtr+0x04, Uint32) // Extract actual txtFileNo
-				txtFileNo := rawTxtFileNo & 0xFFFF
-				unitID := gd.Process.ReadUInt(objectUnitPtr+0x08, Uint32)
-				objectMode := mode.ObjectMode(gd.Process.ReadUInt(objectUnitPtr+0x0c, Uint32))
-				unitDataPtr := uintptr(gd.Process.ReadUInt(objectUnitPtr+0x10, Uint64))
-
-				//This offset gives timer for each mode to keep progress in real time. exemple: Mode.Operating fresh timer then Mode.Opened new timer (for objects)
-				// timerValue := uint32(gd.Process.ReadUInt(objectUnitPtr+0x5C, Uint32))
-
-				// Path and position data
-				pathPtr := uintptr(gd.Process.ReadUInt(objectUnitPtr+0x38, Uint64))
-				// Coordinates (X, Y)
-				posX := gd.Process.ReadUInt(pathPtr+0x10, Uint16)
-				posY := gd.Process.ReadUInt(pathPtr+0x14, Uint16)
-
-				var shrineData object.ShrineData
-				var portalData object.PortalData
-				interactType := gd.Process.ReadUInt(unitDataPtr+0x08, Uint8)
-				owner := gd.Process.ReadStringFromMemory(unitDataPtr+0x34, 32)
-
-				// Handle portals
-				if isPortal(int(txtFileNo)) {
-					destArea := area.ID(gd.Process.ReadUInt(unitDataPtr+0x08, Uint8))
-					portalData.DestArea = destArea
-					// Handle Shrines
-				} else {
-					shrineTextPtr := uintptr(gd.Process.ReadUInt(objectUnitPtr+0x0A, Uint64))
-					if shrineTextPtr > 0 {
-						shrineType := gd.Process.ReadUInt(unitDataPtr+0x08, Uint8)
-						shrineData = object.ShrineData{
-							ShrineName: object.ShrineTypeNames[object.ShrineType(shrineType)],
-							ShrineType: object.ShrineType(shrineType),
-						}
-					}
+	var objects []data.Object
+	for i, u := range units {
+		rawTxtFileNo := ReadUIntFromBuffer(u.Buffer, 0x04, Uint32) // Extract actual txtFileNo
+		txtFileNo := rawTxtFileNo & 0xFFFF
+		unitID := ReadUIntFromBuffer(u.Buffer, 0x08, Uint32)
+		objectMode := mode.ObjectMode(ReadUIntFromBuffer(u.Buffer, 0x0C, Uint32))
+
+		//This offset gives timer for each mode to keep progress in real time. exemple: Mode.Operating fresh timer then Mode.Opened new timer (for objects)
+		// timerValue := uint32(ReadUIntFromBuffer(u.Buffer, 0x5C, Uint32))
+
+		unitDataBuffer := buffers[i*2]
+		pathBuffer := buffers[i*2+1]
+
+		// Coordinates (X, Y)
+		posX := ReadUIntFromBuffer(pathBuffer, 0x00, Uint16)
+		posY := ReadUIntFromBuffer(pathBuffer, 0x04, Uint16)
+
+		var shrineData object.ShrineData
+		var portalData object.PortalData
+		interactType := ReadUIntFromBuffer(unitDataBuffer, 0x08, Uint8)
+		owner := string(bytes.Trim(unitDataBuffer[0x34:0x54], "\x00"))
+
+		// Handle portals
+		if isPortal(int(txtFileNo)) {
+			portalData.DestArea = area.ID(interactType)
+			// Handle Shrines
+		} else {
+			shrineTextPtr := uintptr(ReadUIntFromBuffer(u.Buffer, 0x0A, Uint64))
+			if shrineTextPtr > 0 {
+				shrineData = object.ShrineData{
+					ShrineName: object.ShrineTypeNames[object.ShrineType(interactType)],
+					ShrineType: object.ShrineType(interactType),
 				}
-				// Handle objects
-				objects = append(objects, data.Object{
-					ID:           data.UnitID(unitID),
-					Name:         object.Name(int(txtFileNo)),
-					IsHovered:    data.UnitID(unitID) == hover.UnitID && hover.UnitType == 2 && hover.IsHovered,
-					InteractType: object.InteractType(interactType),
-					Shrine:       shrineData,
-					Selectable:   objectMode == mode.ObjectModeIdle,
-					Position: data.Position{
-						X: int(posX),
-						Y: int(posY),
-					},
-					Owner:      owner,
-					Mode:       objectMode,
-					PortalData: portalData,
-				})
 			}
-			objectUnitPtr = uintptr(gd.Process.ReadUInt(objectUnitPtr+0x158, Uint64))
 		}
+		// Handle objects
+		objects = append(objects, data.Object{
+			ID:           data.UnitID(unitID),
+			Name:         object.Name(int(txtFileNo)),
+			IsHovered:    data.UnitID(unitID) == hover.UnitID && hover.UnitType == 2 && hover.IsHovered,
+			InteractType: object.InteractType(interactType),
+			Shrine:       shrineData,
+			Selectable:   objectMode == mode.ObjectModeIdle,
+			Position: data.Position{
+				X: int(posX),
+				Y: int(posY),
+			},
+			Owner:      owner,
+			Mode:       objectMode,
+			PortalData: portalData,
+		})
 	}
 
 	if len(objects) > 0 {
@@ -98,38 +154,36 @@ func (gd *GameReader) Objects(playerPosition data.Position, hover data.HoverData
 
 	return objects
 }
+
 func (gd *GameReader) Entrances(playerPosition data.Position, hover data.HoverData) []data.Entrance {
-	baseAddr := gd.Process.moduleBaseAddressPtr + gd.offset.UnitTable + (5 * 1024)
-	unitTableBuffer := gd.Process.ReadBytesFromMemory(baseAddr, 128*8)
+	units := make([]rawUnit, 0)
+	for _, u := range gd.walkUnitTable(unitTableEntrances) {
+		if entranceType := ReadUIntFromBuffer(u.Buffer, 0x00, Uint32); entranceType == 5 {
+			units = append(units, u)
+		}
+	}
 
-	var entrances []data.Entrance
+	requests := make([]ReadRequest, len(units))
+	for i, u := range units {
+		pathPtr := uintptr(ReadUIntFromBuffer(u.Buffer, 0x38, Uint64))
+		requests[i] = ReadRequest{Address: pathPtr + 0x10, Size: 0x06}
+	}
+	pathBuffers := gd.Process.ReadBatch(requests)
 
-	for i := 0; i < 128; i++ {
-		entranceOffset := 8 * i
-		entranceUnitPtr := uintptr(ReadUIntFromBuffer(unitTableBuffer, uint(entranceOffset), Uint64))
-
-		for entranceUnitPtr > 0 {
-
-			if entranceType := gd.Process.ReadUInt(entranceUnitPtr+0x00, Uint32); entranceType == 5 {
-				txtFileNo := gd.Process.ReadUInt(entranceUnitPtr+0x04, Uint32)
-				unitID := gd.Process.ReadUInt(entranceUnitPtr+0x08, Uint32)
-
-				pathPtr := uintptr(gd.Process.ReadUInt(entranceUnitPtr+0x38, Uint64))
-				posX := gd.Process.ReadUInt(pathPtr+0x10, Uint16)
-				posY := gd.Process.ReadUInt(pathPtr+0x14, Uint16)
-
-				entrances = append(entrances, data.Entrance{
-					ID:        data.UnitID(unitID),
-					Name:      entrance.Name(txtFileNo),
-					IsHovered: data.UnitID(unitID) == hover.UnitID && hover.UnitType == 5 && hover.IsHovered,
-					Position: data.Position{
-						X: int(posX),
-						Y: int(posY),
-					},
-				})
-			}
-			entranceUnitPtr = uintptr(gd.Process.ReadUInt(entranceUnitPtr+0x158, Uint64))
-		}
+	var entrances []data.Entrance
+	for i, u := range units {
+		txtFileNo := ReadUIntFromBuffer(u.Buffer, 0x04, Uint32)
+		unitID := ReadUIntFromBuffer(u.Buffer, 0x08, Uint32)
+
+		entrances = append(entrances, data.Entrance{
+			ID:        data.UnitID(unitID),
+			Name:      entrance.Name(txtFileNo),
+			IsHovered: data.UnitID(unitID) == hover.UnitID && hover.UnitType == 5 && hover.IsHovered,
+			Position: data.Position{
+				X: int(ReadUIntFromBuffer(pathBuffers[i], 0x00, Uint16)),
+				Y: int(ReadUIntFromBuffer(pathBuffers[i], 0x04, Uint16)),
+			},
+		})
 	}
 
 	return entrances
@@ -0,0 +1,97 @@
+package memory
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hectorgimenez/d2go/pkg/data"
+	"github.com/hectorgimenez/d2go/pkg/data/mode"
+	"github.com/hectorgimenez/d2go/pkg/data/object"
+	"github.com/stretchr/testify/require"
+)
+
+func TestObjectLoadModesPreOpened(t *testing.T) {
+	var l objectLoadModes
+	now := time.Now()
+	chest := func(id data.UnitID, m mode.ObjectMode) data.Object {
+		return data.Object{ID: id, Name: object.LargeChestRight, Mode: m}
+	}
+
+	objects := []data.Object{
+		chest(1, mode.ObjectModeIdle),
+		chest(2, mode.ObjectModeOpened),
+		chest(3, mode.ObjectModeOperating),
+	}
+	l.update(now, 100, objects)
+	require.False(t, objects[0].PreOpened)
+	require.True(t, objects[1].PreOpened)
+	// Seen while the player was opening it
+	require.False(t, objects[2].PreOpened)
+
+	// Opened by the player, then unloaded while walking away
+	objects = []data.Object{chest(1, mode.ObjectModeOperating)}
+	l.update(now, 100, objects)
+	require.False(t, objects[0].PreOpened)
+	l.update(now, 100, []data.Object{})
+
+	// Back to the chest, it's still known as opened by the player
+	objects = []data.Object{chest(1, mode.ObjectModeOpened), chest(2, mode.ObjectModeOpened)}
+	l.update(now, 100, objects)
+	require.False(t, objects[0].PreOpened)
+	require.True(t, objects[1].PreOpened)
+
+	// New game, the player unit changed and the IDs are not related anymore
+	objects = []data.Object{chest(1, mode.ObjectModeOpened)}
+	l.update(now, 200, objects)
+	require.True(t, objects[0].PreOpened)
+
+	l.reset()
+	objects = []data.Object{chest(2, mode.ObjectModeIdle)}
+	l.update(now, 200, objects)
+	require.False(t, objects[0].PreOpened)
+}
+
+func TestObjectLoadModesDepleted(t *testing.T) {
+	var l objectLoadModes
+	start := time.Now()
+	shrine := func(id data.UnitID, m mode.ObjectMode) data.Object {
+		return data.Object{ID: id, Mode: m, Shrine: object.ShrineData{ShrineType: object.HealthShrine}}
+	}
+
+	objects := []data.Object{shrine(1, mode.ObjectModeIdle), shrine(2, mode.ObjectModeOpened)}
+	l.update(start, 100, objects)
+	require.True(t, objects[0].DepletedSince.IsZero())
+	// Already depleted when first seen, the depletion time is unknown
+	require.True(t, objects[1].Depleted())
+	require.True(t, objects[1].DepletedSince.IsZero())
+
+	// Used by the player, the depletion time is kept on later updates
+	used := start.Add(time.Second)
+	objects = []data.Object{shrine(1, mode.ObjectModeOperating)}
+	l.update(used, 100, objects)
+	require.Equal(t, used, objects[0].DepletedSince)
+	objects = []data.Object{shrine(1, mode.ObjectModeOpened)}
+	l.update(used.Add(time.Second), 100, objects)
+	require.Equal(t, used, objects[0].DepletedSince)
+
+	// Unloaded while walking away, the depletion time survives
+	l.update(used.Add(2*time.Second), 100, []data.Object{})
+	objects = []data.Object{shrine(1, mode.ObjectModeOpened)}
+	l.update(used.Add(3*time.Second), 100, objects)
+	require.Equal(t, used, objects[0].DepletedSince)
+
+	// Recharged, it's neither depleted nor pre-opened anymore
+	objects = []data.Object{shrine(1, mode.ObjectModeIdle), shrine(2, mode.ObjectModeIdle)}
+	l.update(used.Add(4*time.Second), 100, objects)
+	for _, o := range objects {
+		require.True(t, o.DepletedSince.IsZero())
+		require.False(t, o.PreOpened)
+	}
+
+	// Used again after recharging, timed from the new use
+	usedAgain := used.Add(5 * time.Second)
+	objects = []data.Object{shrine(2, mode.ObjectModeOperating)}
+	l.update(usedAgain, 100, objects)
+	require.Equal(t, usedAgain, objects[0].DepletedSince)
+	require.False(t, objects[0].PreOpened)
+}
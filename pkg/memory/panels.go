@@ -1,9 +1,29 @@
 package memory
 
 import (
+	"math"
+
 	"github.com/hectorgimenez/d2go/pkg/data"
 )
 
+type ScreenRect struct {
+	data.Position
+	Width  int
+	Height int
+}
+
+func (r ScreenRect) Center() data.Position {
+	return data.Position{
+		X: r.X + r.Width/2,
+		Y: r.Y + r.Height/2,
+	}
+}
+
+// IsZero returns true when the rect could not be resolved from the UI layout
+func (r ScreenRect) IsZero() bool {
+	return r.Width == 0 && r.Height == 0
+}
+
 func NewPanel(panelPtr uintptr, panelParent string, depth int, gd *GameReader) *data.Panel { //itemUnitPtr = uintptr(gd.Process.ReadUInt(itemUnitPtr+0x158, Uint64))
 	panel := &data.Panel{
 		PanelPtr:      panelPtr,
@@ -69,6 +89,28 @@ func (gd *GameReader) ReadAllPanels() map[string]data.Panel {
 	return panels
 }
 
+// readRootPanels reads only the given top level panels and their children, instead of the whole UI layout
+func (gd *GameReader) readRootPanels(names ...string) map[string]data.Panel {
+	base := gd.Process.moduleBaseAddressPtr + gd.offset.PanelManagerContainerOffset
+	panelStructPtr := uintptr(gd.Process.ReadUInt(base, Uint64))
+	panelPtr := uintptr(gd.Process.ReadUInt(panelStructPtr+0x58, Uint64))
+	numChildren := int(gd.Process.ReadUInt(panelStructPtr+0x60, Uint8))
+
+	panels := make(map[string]data.Panel, len(names))
+	for i := 0; i < numChildren && len(panels) < len(names); i++ {
+		childPtr := uintptr(gd.Process.ReadUInt(panelPtr+uintptr(i*8), Uint64))
+		name := gd.Process.ReadStringFromMemory(uintptr(gd.Process.ReadUInt(childPtr+0x08, Uint64)), 0)
+		for _, n := range names {
+			if n == name {
+				panels[name] = *NewPanel(childPtr, "Root", 0, gd)
+				break
+			}
+		}
+	}
+
+	return panels
+}
+
 func readPanel(panelPtr uintptr, numChildren int, panels *map[string]data.Panel, panelParent string, depth int, gd *GameReader) {
 	for i := 0; i < numChildren; i++ {
 		panelStructPtr := uintptr(gd.Process.ReadUInt(uintptr(uint64(panelPtr)+uint64(i*8)), Uint64))
@@ -76,3 +118,34 @@ func readPanel(panelPtr uintptr, numChildren int, panels *map[string]data.Panel,
 		(*panels)[thisPanel.PanelName] = *thisPanel
 	}
 }
+
+// panelScreenRect resolves the panel from the given path, panel positions are relative to their parent so we need to
+// add up all of them to get the screen position. The geometry is not read by NewPanel, only the panels in the path are
+// read here. Position and size are stored as floats at panel+0x30 (x, y, width, height), this has not been verified
+// against the client yet
+func (gd *GameReader) panelScreenRect(panels map[string]data.Panel, panelPath ...string) ScreenRect {
+	if len(panelPath) == 0 {
+		return ScreenRect{}
+	}
+
+	currentPanel, exists := panels[panelPath[0]]
+	if !exists {
+		return ScreenRect{}
+	}
+
+	rect := ScreenRect{}
+	for i := 0; ; i++ {
+		geometry := gd.Process.ReadBytesFromMemory(currentPanel.PanelPtr+0x30, 0x10)
+		rect.X += int(math.Float32frombits(uint32(ReadUIntFromBuffer(geometry, 0x00, Uint32))))
+		rect.Y += int(math.Float32frombits(uint32(ReadUIntFromBuffer(geometry, 0x04, Uint32))))
+		rect.Width = int(math.Float32frombits(uint32(ReadUIntFromBuffer(geometry, 0x08, Uint32))))
+		rect.Height = int(math.Float32frombits(uint32(ReadUIntFromBuffer(geometry, 0x0C, Uint32))))
+
+		if i+1 == len(panelPath) {
+			return rect
+		}
+		if currentPanel, exists = currentPanel.PanelChildren[panelPath[i+1]]; !exists {
+			return ScreenRect{}
+		}
+	}
+}
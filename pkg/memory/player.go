@@ -1,7 +1,11 @@
 package memory
 
 import (
+	"bytes"
 	"encoding/binary"
+	"math"
+	"sort"
+	"time"
 
 	"github.com/hectorgimenez/d2go/pkg/data/mode"
 
@@ -9,66 +13,106 @@ import (
 	"github.com/hectorgimenez/d2go/pkg/data/area"
 	"github.com/hectorgimenez/d2go/pkg/data/skill"
 	"github.com/hectorgimenez/d2go/pkg/data/state"
+	"github.com/hectorgimenez/d2go/pkg/utils"
 )
 
+const (
+	statesOffset     = 0xAF0
+	statesBufferSize = 6 * 4
+)
+
+// GetRawPlayerUnits returns all the player units and corpses. Like the monsters, reads are batched by dependency depth:
+// the unit structs, then the data they point to, then the stats and the room and level pointers of each unit
 func (gd *GameReader) GetRawPlayerUnits() RawPlayerUnits {
-	rawPlayerUnits := make(RawPlayerUnits, 0)
 	hover := gd.HoveredData()
-	for i := 0; i < 128; i++ {
-		unitOffset := gd.offset.UnitTable + uintptr(i*8)
-		playerUnitAddr := gd.Process.moduleBaseAddressPtr + unitOffset
-		playerUnit := uintptr(gd.Process.ReadUInt(playerUnitAddr, Uint64))
-		for playerUnit > 0 {
-			unitID := gd.Process.ReadUInt(playerUnit+0x08, Uint32)
-			pInventory := playerUnit + 0x90
-			inventoryAddr := uintptr(gd.Process.ReadUInt(pInventory, Uint64))
-
-			pPath := playerUnit + 0x38
-			pathAddress := uintptr(gd.Process.ReadUInt(pPath, Uint64))
-			room1Ptr := uintptr(gd.Process.ReadUInt(pathAddress+0x20, Uint64))
-			room2Ptr := uintptr(gd.Process.ReadUInt(room1Ptr+0x18, Uint64))
-			levelPtr := uintptr(gd.Process.ReadUInt(room2Ptr+0x90, Uint64))
-			levelNo := gd.Process.ReadUInt(levelPtr+0x1F8, Uint32)
-
-			xPos := gd.Process.ReadUInt(pathAddress+0x02, Uint16)
-			yPos := gd.Process.ReadUInt(pathAddress+0x06, Uint16)
-			pUnitData := playerUnit + 0x10
-			playerNameAddr := uintptr(gd.Process.ReadUInt(pUnitData, Uint64))
-			name := gd.Process.ReadStringFromMemory(playerNameAddr, 0)
-
-			expCharPtr := uintptr(gd.Process.ReadUInt(gd.moduleBaseAddressPtr+gd.offset.Expansion, Uint64))
-			expChar := gd.Process.ReadUInt(expCharPtr+0x5C, Uint16)
-			isMainPlayer := gd.Process.ReadUInt(inventoryAddr+0x30, Uint16)
-			if expChar > 0 {
-				isMainPlayer = gd.Process.ReadUInt(inventoryAddr+0x70, Uint16)
-			}
-			isCorpse := gd.Process.ReadUInt(playerUnit+0x1AE, Uint8)
-
-			statsListExPtr := uintptr(gd.Process.ReadUInt(playerUnit+0x88, Uint64))
-			baseStats := gd.getStatsList(statsListExPtr + 0x30)
-			stats := gd.getStatsList(statsListExPtr + 0xA8)
-			states := gd.GetStates(statsListExPtr)
-			playerMode := mode.PlayerMode(gd.Process.ReadUInt(playerUnit+0x0c, Uint32))
-
-			rawPlayerUnits = append(rawPlayerUnits, RawPlayerUnit{
-				UnitID:       data.UnitID(unitID),
-				Address:      playerUnit,
-				Name:         name,
-				IsMainPlayer: isMainPlayer > 0,
-				IsCorpse:     isCorpse == 1 && inventoryAddr > 0 && xPos > 0 && yPos > 0,
-				Area:         area.ID(levelNo),
-				Position: data.Position{
-					X: int(xPos),
-					Y: int(yPos),
-				},
-				IsHovered: hover.IsHovered && hover.UnitID == data.UnitID(unitID) && hover.UnitType == 0,
-				States:    states,
-				Stats:     stats,
-				BaseStats: baseStats,
-				Mode:      playerMode,
-			})
-			playerUnit = uintptr(gd.Process.ReadUInt(playerUnit+0x158, Uint64))
+	units := gd.walkUnitTable(unitTablePlayers)
+
+	// The main player flag is stored at a different offset for expansion characters
+	flagOffset := uint(0x30)
+	expCharPtr := uintptr(gd.Process.ReadUInt(gd.moduleBaseAddressPtr+gd.offset.Expansion, Uint64))
+	if gd.Process.ReadUInt(expCharPtr+0x5C, Uint16) > 0 {
+		flagOffset = 0x70
+	}
+
+	const requestsPerUnit = 6
+	requests := make([]ReadRequest, 0, len(units)*requestsPerUnit)
+	for _, u := range units {
+		statsListExPtr := uintptr(ReadUIntFromBuffer(u.Buffer, 0x88, Uint64))
+		requests = append(requests,
+			ReadRequest{Address: uintptr(ReadUIntFromBuffer(u.Buffer, 0x38, Uint64)), Size: 0x28},
+			ReadRequest{Address: uintptr(ReadUIntFromBuffer(u.Buffer, 0x90, Uint64)), Size: 0x78},
+			// Player names are up to 15 characters, null terminated
+			ReadRequest{Address: uintptr(ReadUIntFromBuffer(u.Buffer, 0x10, Uint64)), Size: 0x10},
+			ReadRequest{Address: statsListExPtr + 0x30, Size: 0x10},
+			ReadRequest{Address: statsListExPtr + 0xA8, Size: 0x10},
+			ReadRequest{Address: statsListExPtr + statesOffset, Size: statesBufferSize},
+		)
+	}
+	unitData := gd.Process.ReadBatch(requests)
+
+	// Stats and the path room, each unit needs 3 requests
+	requests = requests[:0]
+	for i := range units {
+		for _, header := range unitData[i*requestsPerUnit+3 : i*requestsPerUnit+5] {
+			count := statCount(ReadUIntFromBuffer(header, 0x08, Uint64))
+			requests = append(requests, ReadRequest{Address: uintptr(ReadUIntFromBuffer(header, 0, Uint64)), Size: count * 8})
 		}
+		room1Ptr := uintptr(ReadUIntFromBuffer(unitData[i*requestsPerUnit], 0x20, Uint64))
+		requests = append(requests, ReadRequest{Address: room1Ptr + 0x18, Size: Uint64})
+	}
+	statsData := gd.Process.ReadBatch(requests)
+
+	// Room2 -> level -> level number
+	levelRequests := make([]ReadRequest, len(units))
+	for i := range units {
+		room2Ptr := uintptr(ReadUIntFromBuffer(statsData[i*3+2], 0, Uint64))
+		levelRequests[i] = ReadRequest{Address: room2Ptr + 0x90, Size: Uint64}
+	}
+	for i, levelPtr := range gd.Process.ReadBatch(levelRequests) {
+		levelRequests[i] = ReadRequest{Address: uintptr(ReadUIntFromBuffer(levelPtr, 0, Uint64)) + 0x1F8, Size: Uint32}
+	}
+	levelNos := gd.Process.ReadBatch(levelRequests)
+
+	rawPlayerUnits := make(RawPlayerUnits, 0, len(units))
+	for i, u := range units {
+		pathBuffer := unitData[i*requestsPerUnit]
+		inventoryBuffer := unitData[i*requestsPerUnit+1]
+		nameBuffer := unitData[i*requestsPerUnit+2]
+		if idx := bytes.IndexByte(nameBuffer, 0); idx >= 0 {
+			nameBuffer = nameBuffer[:idx]
+		}
+
+		unitID := ReadUIntFromBuffer(u.Buffer, 0x08, Uint32)
+		inventoryAddr := ReadUIntFromBuffer(u.Buffer, 0x90, Uint64)
+		xPos := ReadUIntFromBuffer(pathBuffer, 0x02, Uint16)
+		yPos := ReadUIntFromBuffer(pathBuffer, 0x06, Uint16)
+		targetX := ReadUIntFromBuffer(pathBuffer, 0x10, Uint16)
+		targetY := ReadUIntFromBuffer(pathBuffer, 0x12, Uint16)
+		isCorpse := ReadUIntFromBuffer(u.Buffer, 0x1AE, Uint8)
+		hasItems := false
+		if isCorpse == 1 && inventoryAddr > 0 {
+			hasItems = ReadUIntFromBuffer(inventoryBuffer, 0x18, Uint64) != 0
+		}
+
+		rawPlayerUnits = append(rawPlayerUnits, RawPlayerUnit{
+			UnitID:       data.UnitID(unitID),
+			Address:      u.Address,
+			Name:         string(nameBuffer),
+			IsMainPlayer: ReadUIntFromBuffer(inventoryBuffer, flagOffset, Uint16) > 0,
+			IsCorpse:     isCorpse == 1 && inventoryAddr > 0 && xPos > 0 && yPos > 0,
+			HasItems:     hasItems,
+			Area:         area.ID(ReadUIntFromBuffer(levelNos[i], 0, Uint32)),
+			Position: data.Position{
+				X: int(xPos),
+				Y: int(yPos),
+			},
+			PathTarget: pathTarget(data.Position{X: int(xPos), Y: int(yPos)}, int(targetX), int(targetY)),
+			IsHovered:  hover.IsHovered && hover.UnitID == data.UnitID(unitID) && hover.UnitType == 0,
+			States:     statesFromBuffer(unitData[i*requestsPerUnit+5]),
+			Stats:      statsFromBuffer(statsData[i*3+1]),
+			BaseStats:  statsFromBuffer(statsData[i*3]),
+			Mode:       mode.PlayerMode(ReadUIntFromBuffer(u.Buffer, 0x0C, Uint32)),
+		})
 	}
 
 	return rawPlayerUnits
@@ -91,6 +135,8 @@ func (gd *GameReader) GetPlayerUnit(mainPlayerUnit RawPlayerUnit) data.PlayerUni
 	class := data.Class(gd.Process.ReadUInt(mainPlayerUnit.Address+0x17C, Uint32))
 
 	availableWPs := gd.decodeWaypointMasks()
+	statsListExPtr := uintptr(gd.Process.ReadUInt(mainPlayerUnit.Address+0x88, Uint64))
+	stateEntries := gd.getStateEntries(statsListExPtr, mainPlayerUnit.States)
 
 	d := data.PlayerUnit{
 		Address:            mainPlayerUnit.Address,
@@ -102,16 +148,54 @@ func (gd *GameReader) GetPlayerUnit(mainPlayerUnit RawPlayerUnit) data.PlayerUni
 		BaseStats:          mainPlayerUnit.BaseStats,
 		Skills:             skills,
 		States:             mainPlayerUnit.States,
+		StateEntries:       stateEntries,
 		Class:              class,
 		LeftSkill:          skill.ID(leftSkillId),
 		RightSkill:         skill.ID(rightSkillId),
 		AvailableWaypoints: availableWPs,
 		Mode:               mainPlayerUnit.Mode,
+		PathTarget:         mainPlayerUnit.PathTarget,
 	}
 
 	return d
 }
 
+// velocityTracker keeps the player position of the previous GetData call to compute the player velocity
+type velocityTracker struct {
+	position data.Position
+	area     area.ID
+	read     time.Time
+}
+
+// update returns the player speed in sub-tiles per second since the previous update, 0 on the first one and on area
+// change
+func (t *velocityTracker) update(now time.Time, position data.Position, a area.ID) float64 {
+	last, lastArea, lastRead := t.position, t.area, t.read
+	t.position, t.area, t.read = position, a, now
+
+	elapsed := now.Sub(lastRead).Seconds()
+	if lastRead.IsZero() || lastArea != a || elapsed <= 0 {
+		return 0
+	}
+
+	dx, dy := float64(position.X-last.X), float64(position.Y-last.Y)
+
+	return math.Sqrt(dx*dx+dy*dy) / elapsed
+}
+
+func (t *velocityTracker) reset() {
+	*t = velocityTracker{}
+}
+
+// pathTarget returns the path destination, units not moving have no target set
+func pathTarget(position data.Position, targetX, targetY int) data.Position {
+	if targetX == 0 || targetY == 0 {
+		return position
+	}
+
+	return data.Position{X: targetX, Y: targetY}
+}
+
 // WaypointTableData returns the waypoint table struct and the data buffer it points to
 func (gd *GameReader) WaypointTableData() (structAddr uintptr, structBuf []byte, dataAddr uintptr, dataBuf []byte) {
 	var structSize = uint(0x100)
@@ -210,12 +294,54 @@ func (gd *GameReader) getSkills(skillListPtr uintptr) map[skill.ID]skill.Points
 }
 
 func (gd *GameReader) GetStates(statsListExPtr uintptr) state.States {
+	return statesFromBuffer(gd.Process.ReadBytesFromMemory(statsListExPtr+statesOffset, statesBufferSize))
+}
+
+// getStateEntries walks the stat lists linked to the unit, lists created by a state (buffs, curses, auras...) contain
+// the skill and level that applied it. States without a stat list are returned without extra information
+func (gd *GameReader) getStateEntries(statsListExPtr uintptr, states state.States) state.Entries {
+	entries := make(state.Entries, 0, len(states))
+	indexByState := make(map[state.State]int, len(states))
+	for _, st := range states {
+		indexByState[st] = len(entries)
+		entries = append(entries, state.Entry{State: st})
+	}
+
+	// Same chain walked by getItemStats, statsListEx+0x90 is the last stat list and +0x48 links to the previous one.
+	// D2StatList 64 bits header: owner type 0x10, state 0x1C, skill 0x24, skill level 0x28, stats 0x30
+	statListPtr := uintptr(gd.Process.ReadUInt(statsListExPtr+0x90, Uint64))
+	for depth := 0; statListPtr != 0 && statListPtr != statsListExPtr && depth < maxStatListDepth; depth++ {
+		header := gd.Process.ReadBytesFromMemory(statListPtr, 0x30)
+		ownerType := ReadUIntFromBuffer(header, 0x10, Uint32)
+		stateNo := state.State(ReadUIntFromBuffer(header, 0x1C, Uint32))
+		skillNo := ReadUIntFromBuffer(header, 0x24, Uint32)
+		skillLevel := ReadUIntFromBuffer(header, 0x28, Uint32)
+
+		if idx, found := indexByState[stateNo]; found && stateNo != state.None {
+			entry := &entries[idx]
+			entry.Stacks++
+			// Keep the first list found, it's the most recent one
+			if entry.Stacks == 1 {
+				entry.Skill = skill.ID(skillNo)
+				entry.SkillLevel = int(skillLevel)
+				entry.FromItem = ownerType == 4 // Owner unit is an item
+				entry.Stats = gd.getStatsList(statListPtr + 0x30)
+			}
+		}
+
+		statListPtr = uintptr(gd.Process.ReadUInt(statListPtr+0x48, Uint64))
+	}
+
+	return entries
+}
+
+// statesFromBuffer decodes the state flags, buffer should be read from statsListExPtr+statesOffset
+func statesFromBuffer(buffer []byte) state.States {
 	var states state.States
-	for i := 0; i < 6; i++ {
-		offset := i * 4
-		stateByte := gd.Process.ReadUInt(statsListExPtr+0xAF0+uintptr(offset), Uint32)
+	for i := 0; i < statesBufferSize/4; i++ {
+		stateByte := ReadUIntFromBuffer(buffer, uint(i*4), Uint32)
 
-		offset = (32 * i) - 1
+		offset := (32 * i) - 1
 		states = append(states, calculateStates(stateByte, uint(offset))...)
 	}
 
@@ -323,3 +449,23 @@ func calculateStates(stateFlag uint, offset uint) []state.State {
 
 	return states
 }
+
+// playerCorpses returns the main player corpses sorted by distance, closest first
+func (gd *GameReader) playerCorpses(rawPlayerUnits RawPlayerUnits, mainPlayer RawPlayerUnit) data.Corpses {
+	corpses := make(data.Corpses, 0)
+	for _, c := range rawPlayerUnits.GetCorpses(mainPlayer.Name) {
+		corpses = append(corpses, data.Corpse{
+			ID:        c.UnitID,
+			Found:     true,
+			IsHovered: c.IsHovered,
+			HasItems:  c.HasItems,
+			Position:  c.Position,
+			States:    c.States,
+		})
+	}
+	sort.SliceStable(corpses, func(i, j int) bool {
+		return utils.DistanceFromPoint(mainPlayer.Position, corpses[i].Position) < utils.DistanceFromPoint(mainPlayer.Position, corpses[j].Position)
+	})
+
+	return corpses
+}
@@ -0,0 +1,25 @@
+package memory
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hectorgimenez/d2go/pkg/data"
+	"github.com/hectorgimenez/d2go/pkg/data/area"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVelocityTracker(t *testing.T) {
+	var v velocityTracker
+	now := time.Now()
+
+	require.Zero(t, v.update(now, data.Position{X: 100, Y: 100}, area.ColdPlains))
+	require.InDelta(t, 10, v.update(now.Add(500*time.Millisecond), data.Position{X: 103, Y: 104}, area.ColdPlains), 0.001)
+
+	// Same time twice and area changes don't give a speed
+	require.Zero(t, v.update(now.Add(500*time.Millisecond), data.Position{X: 110, Y: 104}, area.ColdPlains))
+	require.Zero(t, v.update(now.Add(time.Second), data.Position{X: 10, Y: 10}, area.StonyField))
+
+	v.reset()
+	require.Zero(t, v.update(now.Add(2*time.Second), data.Position{X: 20, Y: 10}, area.StonyField))
+}
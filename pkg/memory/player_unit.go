@@ -14,8 +14,10 @@ type RawPlayerUnit struct {
 	Name         string
 	IsMainPlayer bool
 	IsCorpse     bool
+	HasItems     bool // Only set for corpses
 	Area         area.ID
 	Position     data.Position
+	PathTarget   data.Position
 	IsHovered    bool
 	States       state.States
 	Stats        stat.Stats
@@ -34,6 +36,19 @@ func (pu RawPlayerUnits) GetMainPlayer() RawPlayerUnit {
 	return RawPlayerUnit{}
 }
 
+// GetCorpses returns the corpses of the player with the given name, there are many if the player died several times
+// without recovering them
+func (pu RawPlayerUnits) GetCorpses(name string) RawPlayerUnits {
+	corpses := make(RawPlayerUnits, 0)
+	for _, p := range pu {
+		if p.IsCorpse && p.Name == name {
+			corpses = append(corpses, p)
+		}
+	}
+
+	return corpses
+}
+
 func (pu RawPlayerUnits) GetCorpse() RawPlayerUnit {
 	for _, p := range pu {
 		if p.IsCorpse {
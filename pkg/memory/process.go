@@ -4,23 +4,39 @@ import (
 	"bytes"
 	"encoding/binary"
 	"errors"
+	"fmt"
+	"log/slog"
 	"strings"
 	"sync"
-	"syscall"
+	"sync/atomic"
 	"unsafe"
-
-	"golang.org/x/sys/windows"
 )
 
-const moduleName = "d2r.exe"
+const (
+	moduleName = "d2r.exe"
+
+	// Addresses below this one are never mapped on Windows, reading them means a null pointer was followed
+	minValidAddress = 0x10000
+)
 
 type Process struct {
-	handler              windows.Handle
+	// Guards the fields below it, they are only replaced by Reconnect
+	attachMu             sync.RWMutex
+	handler              processHandle
 	pid                  uint32
 	moduleBaseAddressPtr uintptr
 	moduleBaseSize       uint32
+	modulePath           string
 	sendPacket           *sendPacketState
 	sendPacketMu         sync.Mutex
+	readFailures         [readSourceCount]atomic.Uint64
+	logger               Logger
+	metrics              Metrics
+	recorder             atomic.Pointer[snapshotRecorder]
+	source               MemorySource
+	readCtx              atomic.Pointer[readContext]
+	readCtxMu            sync.Mutex
+	limiter              atomic.Pointer[readLimiter]
 }
 
 const (
@@ -36,17 +52,7 @@ func NewProcess() (*Process, error) {
 		return nil, err
 	}
 
-	h, err := windows.OpenProcess(0x0010, false, module.ProcessID)
-	if err != nil {
-		return nil, err
-	}
-
-	return &Process{
-		handler:              h,
-		pid:                  module.ProcessID,
-		moduleBaseAddressPtr: module.ModuleBaseAddress,
-		moduleBaseSize:       module.ModuleBaseSize,
-	}, nil
+	return newProcessFromModule(module)
 }
 
 func NewProcessForPID(pid uint32) (*Process, error) {
@@ -55,27 +61,89 @@ func NewProcessForPID(pid uint32) (*Process, error) {
 		return nil, errors.New("no module found for the specified PID")
 	}
 
-	h, err := windows.OpenProcess(0x0010, false, module.ProcessID)
+	return newProcessFromModule(module)
+}
+
+// FindAllProcesses attaches to every running game process, useful when multiple clients are running at the same time.
+// Processes that can't be opened (e.g. running as another user) are skipped, an error is only returned if there are
+// game processes and none of them could be opened. Each returned Process should be closed by the caller
+func FindAllProcesses() ([]*Process, error) {
+	pids, err := enumProcesses()
 	if err != nil {
 		return nil, err
 	}
 
-	return &Process{
-		handler:              h,
-		pid:                  module.ProcessID,
-		moduleBaseAddressPtr: module.ModuleBaseAddress,
-		moduleBaseSize:       module.ModuleBaseSize,
-	}, nil
+	processes := make([]*Process, 0)
+	var attachErrs []error
+	for _, pid := range pids {
+		module, found := getMainModule(pid)
+		if !found {
+			continue
+		}
+
+		process, err := newProcessFromModule(module)
+		if err != nil {
+			attachErrs = append(attachErrs, fmt.Errorf("error attaching to process %d: %w", pid, err))
+			continue
+		}
+		processes = append(processes, process)
+	}
+
+	if len(processes) == 0 && len(attachErrs) > 0 {
+		return nil, errors.Join(attachErrs...)
+	}
+
+	return processes, nil
 }
 
-func (p *Process) Close() error {
-	return windows.CloseHandle(p.handler)
+// Reconnect attaches again to the game process. If the previous process is still alive the same PID is used,
+// otherwise it attaches to the first game process found, so it should be used carefully when multiple clients are running.
+// It waits for the running context reads (GetDataContext, the refresher) and memory reads, the process is swapped while
+// no memory is being read
+func (p *Process) Reconnect() error {
+	p.readCtxMu.Lock()
+	defer p.readCtxMu.Unlock()
+
+	return p.reconnect()
+}
+
+// reconnect works like Reconnect, readCtxMu must be held by the caller
+func (p *Process) reconnect() error {
+	pid := p.GetPID()
+
+	var module ModuleInfo
+	if p.IsProcessAlive() {
+		var found bool
+		if module, found = getMainModule(pid); !found {
+			return fmt.Errorf("game module not found in process %d", pid)
+		}
+	} else {
+		var err error
+		if module, err = getGameModule(); err != nil {
+			return err
+		}
+	}
+
+	newProcess, err := newProcessFromModule(module)
+	if err != nil {
+		return err
+	}
+
+	p.attachMu.Lock()
+	defer p.attachMu.Unlock()
+
+	p.Close()
+	p.handler = newProcess.handler
+	p.pid = newProcess.pid
+	p.moduleBaseAddressPtr = newProcess.moduleBaseAddressPtr
+	p.moduleBaseSize = newProcess.moduleBaseSize
+	p.modulePath = newProcess.modulePath
+
+	return nil
 }
 
 func getGameModule() (ModuleInfo, error) {
-	processes := make([]uint32, 2048)
-	length := uint32(0)
-	err := windows.EnumProcesses(processes, &length)
+	processes, err := enumProcesses()
 	if err != nil {
 		return ModuleInfo{}, err
 	}
@@ -87,7 +155,7 @@ func getGameModule() (ModuleInfo, error) {
 		}
 	}
 
-	return ModuleInfo{}, err
+	return ModuleInfo{}, errors.New("game process not found")
 }
 
 func getMainModule(pid uint32) (ModuleInfo, bool) {
@@ -106,7 +174,7 @@ func getMainModule(pid uint32) (ModuleInfo, bool) {
 
 func (p *Process) getProcessMemory() ([]byte, error) {
 	var data = make([]byte, p.moduleBaseSize)
-	err := windows.ReadProcessMemory(p.handler, p.moduleBaseAddressPtr, &data[0], uintptr(p.moduleBaseSize), nil)
+	err := p.readMemory(p.moduleBaseAddressPtr, data)
 	if err != nil {
 		return nil, err
 	}
@@ -115,12 +183,77 @@ func (p *Process) getProcessMemory() ([]byte, error) {
 }
 
 func (p *Process) ReadBytesFromMemory(address uintptr, size uint) []byte {
-	var data = make([]byte, size)
-	windows.ReadProcessMemory(p.handler, address, &data[0], uintptr(size), nil)
+	data, _ := p.ReadBytesFromMemoryE(address, size)
 
 	return data
 }
 
+// ReadBytesFromMemoryE works like ReadBytesFromMemory but returns the read error, the returned buffer is zeroed on error
+func (p *Process) ReadBytesFromMemoryE(address uintptr, size uint) ([]byte, error) {
+	var data = make([]byte, size)
+	if err := p.readMemory(address, data); err != nil {
+		p.countReadFailure(ReadSourceBytes, address)
+		clear(data)
+		return data, fmt.Errorf("error reading %d bytes at 0x%X: %w", size, address, err)
+	}
+	p.metricsOrNop().AddBytesRead(int(size))
+
+	return data, nil
+}
+
+// ReadSource is the kind of read a failure comes from, failures are counted separately for each one so a failing kind of
+// read (e.g. pointer chasing over a half loaded UI) doesn't hide whether the others are working
+type ReadSource int
+
+const (
+	ReadSourceScalar ReadSource = iota // ReadUInt, ReadPointer and the other single value reads
+	ReadSourceBytes                    // ReadBytesFromMemory, ReadStringFromMemory and ReadIntoBuffer
+	ReadSourceBatch                    // ReadBatch, used by the unit table walkers
+	readSourceCount
+)
+
+func (s ReadSource) String() string {
+	switch s {
+	case ReadSourceScalar:
+		return "scalar"
+	case ReadSourceBytes:
+		return "bytes"
+	case ReadSourceBatch:
+		return "batch"
+	}
+
+	return "unknown"
+}
+
+// ReadFailures returns the amount of memory reads that failed since the process was attached, from all the sources.
+// Reads from the null page are not counted, they are just null pointers being followed (no unit hovered, no merc...)
+// and are expected
+func (p *Process) ReadFailures() uint64 {
+	var total uint64
+	for i := range p.readFailures {
+		total += p.readFailures[i].Load()
+	}
+
+	return total
+}
+
+// ReadFailuresBySource returns the amount of failed memory reads of the given kind, see ReadFailures
+func (p *Process) ReadFailuresBySource(source ReadSource) uint64 {
+	if source < 0 || source >= readSourceCount {
+		return 0
+	}
+
+	return p.readFailures[source].Load()
+}
+
+func (p *Process) countReadFailure(source ReadSource, address uintptr) {
+	if address >= minValidAddress && p.readCancelled() == nil {
+		p.readFailures[source].Add(1)
+		p.metricsOrNop().ReadError()
+		p.log().Debug("memory read failed", slog.String("source", source.String()), slog.Any("address", hexValue(address)))
+	}
+}
+
 type IntType uint
 
 const (
@@ -131,9 +264,21 @@ const (
 )
 
 func (p *Process) ReadUInt(address uintptr, size IntType) uint {
-	bytes := p.ReadBytesFromMemory(address, uint(size))
+	bp := p.readPooled(ReadSourceScalar, address, uint(size))
+	defer putReadBuffer(bp)
 
-	return bytesToUint(bytes, size)
+	return bytesToUint(*bp, size)
+}
+
+// ReadUIntE works like ReadUInt but returns the read error instead of a silent zero value
+func (p *Process) ReadUIntE(address uintptr, size IntType) (uint, error) {
+	bp, err := p.readPooledE(ReadSourceScalar, address, uint(size))
+	defer putReadBuffer(bp)
+	if err != nil {
+		return 0, err
+	}
+
+	return bytesToUint(*bp, size), nil
 }
 
 func ReadUIntFromBuffer(bytes []byte, offset uint, size IntType) uint {
@@ -173,20 +318,50 @@ func bytesToInt(bytes []byte, size IntType) int {
 
 func (p *Process) ReadStringFromMemory(address uintptr, size uint) string {
 	if size == 0 {
-		for i := 1; true; i++ {
-			data := p.ReadBytesFromMemory(address, uint(i))
-			if data[i-1] == 0 {
-				return string(bytes.Trim(data, "\x00"))
+		// Unknown length, read in chunks until the null terminator is found
+		const chunkSize = 32
+		var sb strings.Builder
+		for offset := uintptr(0); ; offset += chunkSize {
+			bp := getReadBuffer(chunkSize)
+			chunk := *bp
+			if err := p.readMemory(address+offset, chunk); err != nil {
+				putReadBuffer(bp)
+				// The chunk may cross the end of the mapped region while the string doesn't, read byte by byte
+				return p.readStringBytes(address+offset, &sb)
 			}
+			p.metricsOrNop().AddBytesRead(chunkSize)
+			if idx := bytes.IndexByte(chunk, 0); idx >= 0 {
+				sb.Write(chunk[:idx])
+				putReadBuffer(bp)
+				return sb.String()
+			}
+			sb.Write(chunk)
+			putReadBuffer(bp)
 		}
 	}
 
-	return string(bytes.Trim(p.ReadBytesFromMemory(address, size), "\x00"))
+	bp := p.readPooled(ReadSourceBytes, address, size)
+	defer putReadBuffer(bp)
+
+	return string(bytes.Trim(*bp, "\x00"))
 }
 
+// readStringBytes appends to sb the bytes at the given address until the null terminator or a failed read
+func (p *Process) readStringBytes(address uintptr, sb *strings.Builder) string {
+	for ; ; address++ {
+		b, err := p.ReadBytesFromMemoryE(address, 1)
+		if err != nil || b[0] == 0 {
+			return sb.String()
+		}
+		sb.WriteByte(b[0])
+	}
+}
+
+// findPattern returns the offset of the pattern in the module memory, 0 if it's not found. The memory read may have
+// failed or be shorter than the module, only the available bytes are searched
 func (p *Process) findPattern(memory []byte, pattern, mask string) int {
 	patternLength := len(pattern)
-	for i := 0; i < int(p.moduleBaseSize)-patternLength; i++ {
+	for i := 0; i < min(int(p.moduleBaseSize), len(memory))-patternLength; i++ {
 		found := true
 		for j := 0; j < patternLength; j++ {
 			if string(mask[j]) != "?" && string(pattern[j]) != string(memory[i+j]) {
@@ -212,7 +387,7 @@ func (p *Process) FindPattern(memory []byte, pattern, mask string) uintptr {
 }
 
 func (p *Process) FindPatternByOperand(memory []byte, pattern, mask string) uintptr {
-	if offset := p.findPattern(memory, pattern, mask); offset != 0 {
+	if offset := p.findPattern(memory, pattern, mask); offset != 0 && offset+7 <= len(memory) {
 		// Adjust the address based on the operand value
 		operandAddress := p.moduleBaseAddressPtr + uintptr(offset)
 		operandValue := binary.LittleEndian.Uint32(memory[offset+3 : offset+7])
@@ -224,67 +399,33 @@ func (p *Process) FindPatternByOperand(memory []byte, pattern, mask string) uint
 }
 
 func (p *Process) GetPID() uint32 {
-	return p.pid
-}
-
-type ModuleInfo struct {
-	ProcessID         uint32
-	ModuleBaseAddress uintptr
-	ModuleBaseSize    uint32
-	ModuleHandle      syscall.Handle
-	ModuleName        string
-}
-
-func GetProcessModules(processID uint32) ([]ModuleInfo, error) {
-	hProcess, err := windows.OpenProcess(windows.PROCESS_QUERY_INFORMATION|windows.PROCESS_VM_READ, false, processID)
-	if err != nil {
-		return nil, err
-	}
-	defer windows.CloseHandle(hProcess)
-
-	var modules [1024]windows.Handle
-	var needed uint32
-	if err := windows.EnumProcessModules(hProcess, &modules[0], uint32(unsafe.Sizeof(modules[0]))*1024, &needed); err != nil {
-		return nil, err
-	}
-	count := needed / uint32(unsafe.Sizeof(modules[0]))
+	p.attachMu.RLock()
+	defer p.attachMu.RUnlock()
 
-	var moduleInfos []ModuleInfo
-	for i := uint32(0); i < count; i++ {
-		var mi windows.ModuleInfo
-		if err := windows.GetModuleInformation(hProcess, modules[i], &mi, uint32(unsafe.Sizeof(mi))); err != nil {
-			return nil, err
-		}
-
-		var moduleName [windows.MAX_PATH]uint16
-		if err := windows.GetModuleFileNameEx(hProcess, modules[i], &moduleName[0], windows.MAX_PATH); err != nil {
-			return nil, err
-		}
-
-		moduleInfos = append(moduleInfos, ModuleInfo{
-			ProcessID:         processID,
-			ModuleBaseAddress: mi.BaseOfDll,
-			ModuleBaseSize:    mi.SizeOfImage,
-			ModuleHandle:      syscall.Handle(modules[i]),
-			ModuleName:        syscall.UTF16ToString(moduleName[:]),
-		})
-	}
-
-	return moduleInfos, nil
+	return p.pid
 }
 
 // ReadPointer reads a pointer from the specified memory address.
 func (p *Process) ReadPointer(address uintptr, size int) (uintptr, error) {
-	buffer := p.ReadBytesFromMemory(address, uint(size))
-	if len(buffer) == 0 {
+	if size <= 0 {
 		return 0, errors.New("failed to read memory")
 	}
 
-	return uintptr(*(*uint64)(unsafe.Pointer(&buffer[0]))), nil
+	bp := p.readPooled(ReadSourceScalar, address, uint(max(size, Uint64)))
+	defer putReadBuffer(bp)
+
+	return uintptr(*(*uint64)(unsafe.Pointer(&(*bp)[0]))), nil
 }
 
 func (p *Process) ReadIntoBuffer(address uintptr, buffer []byte) error {
-	return windows.ReadProcessMemory(p.handler, address, &buffer[0], uintptr(len(buffer)), nil)
+	err := p.readMemory(address, buffer)
+	if err != nil {
+		p.countReadFailure(ReadSourceBytes, address)
+	} else {
+		p.metricsOrNop().AddBytesRead(len(buffer))
+	}
+
+	return err
 }
 
 // ReadWidgetContainer reads the WidgetContainer structure.
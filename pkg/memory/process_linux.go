@@ -0,0 +1,226 @@
+package memory
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// On Linux the game runs under Wine/Proton, it's a regular Linux process mapping the PE image, so memory is read from
+// /proc/<pid>/mem. Reading another process memory requires ptrace permissions, the reader must run as the same user with
+// kernel.yama.ptrace_scope set to 0, or have CAP_SYS_PTRACE
+
+type processHandle = *os.File
+
+type ModuleInfo struct {
+	ProcessID         uint32
+	ModuleBaseAddress uintptr
+	ModuleBaseSize    uint32
+	ModuleHandle      uintptr // There are no module handles on Linux, it's the base address like HMODULE on Windows
+	ModuleName        string
+}
+
+func newProcessFromModule(module ModuleInfo) (*Process, error) {
+	f, err := os.Open(fmt.Sprintf("/proc/%d/mem", module.ProcessID))
+	if err != nil {
+		return nil, err
+	}
+
+	return &Process{
+		handler:              f,
+		pid:                  module.ProcessID,
+		moduleBaseAddressPtr: module.ModuleBaseAddress,
+		moduleBaseSize:       module.ModuleBaseSize,
+		modulePath:           module.ModuleName,
+	}, nil
+}
+
+func (p *Process) Close() error {
+	if p.source != nil || p.handler == nil {
+		return nil
+	}
+
+	return p.handler.Close()
+}
+
+// IsProcessAlive returns false when the attached game process has exited (crash, client closed...)
+func (p *Process) IsProcessAlive() bool {
+	pid := p.GetPID()
+	stat, err := os.ReadFile(fmt.Sprintf("/proc/%d/stat", pid))
+	if err != nil {
+		return false
+	}
+
+	// Format is "pid (comm) state ...", comm can contain spaces and parentheses
+	if idx := bytes.LastIndexByte(stat, ')'); idx == -1 || idx+2 >= len(stat) || stat[idx+2] == 'Z' {
+		return false
+	}
+
+	// PIDs can be reused by the OS, make sure it's still the game
+	_, found := getMainModule(pid)
+
+	return found
+}
+
+func enumProcesses() ([]uint32, error) {
+	entries, err := os.ReadDir("/proc")
+	if err != nil {
+		return nil, err
+	}
+
+	processes := make([]uint32, 0, len(entries))
+	for _, e := range entries {
+		pid, err := strconv.ParseUint(e.Name(), 10, 32)
+		if err != nil || !e.IsDir() {
+			continue
+		}
+		processes = append(processes, uint32(pid))
+	}
+
+	return processes, nil
+}
+
+// GetProcessModules returns the files mapped by the process, Wine maps each PE module from its file so the game
+// executable and its DLLs are listed here
+func GetProcessModules(processID uint32) ([]ModuleInfo, error) {
+	f, err := os.Open(fmt.Sprintf("/proc/%d/maps", processID))
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	mem, err := os.Open(fmt.Sprintf("/proc/%d/mem", processID))
+	if err == nil {
+		defer mem.Close()
+	}
+	imageSize := func(base uintptr) (uint32, bool) {
+		if mem == nil {
+			return 0, false
+		}
+		return peImageSize(mem, base)
+	}
+
+	return parseModules(f, processID, imageSize)
+}
+
+type memoryMapping struct {
+	start, end uintptr
+	offset     uint64
+	path       string // Empty for anonymous mappings
+}
+
+// parseModules returns the modules found in a /proc/<pid>/maps listing. The module base is its mapping at file offset 0,
+// where the PE headers are, and the size is the SizeOfImage from those headers when they can be read. Otherwise, e.g.
+// for native libraries, the module spans the contiguous mappings following the base that belong to the same file or are
+// anonymous (.bss), mappings of the same file somewhere else are not part of the module
+func parseModules(maps io.Reader, processID uint32, imageSize func(base uintptr) (uint32, bool)) ([]ModuleInfo, error) {
+	mappings := make([]memoryMapping, 0)
+	scanner := bufio.NewScanner(maps)
+	for scanner.Scan() {
+		// Format is "start-end perms offset dev inode path"
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 5 {
+			continue
+		}
+
+		startStr, endStr, found := strings.Cut(fields[0], "-")
+		if !found {
+			continue
+		}
+		start, err := strconv.ParseUint(startStr, 16, 64)
+		if err != nil {
+			continue
+		}
+		end, err := strconv.ParseUint(endStr, 16, 64)
+		if err != nil {
+			continue
+		}
+		offset, err := strconv.ParseUint(fields[2], 16, 64)
+		if err != nil {
+			continue
+		}
+
+		m := memoryMapping{start: uintptr(start), end: uintptr(end), offset: offset}
+		if len(fields) >= 6 && strings.HasPrefix(fields[5], "/") {
+			m.path = strings.Join(fields[5:], " ")
+		}
+		mappings = append(mappings, m)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	moduleInfos := make([]ModuleInfo, 0)
+	seen := make(map[string]bool)
+	for i, m := range mappings {
+		if m.path == "" || m.offset != 0 || seen[m.path] {
+			continue
+		}
+		seen[m.path] = true
+
+		size, found := imageSize(m.start)
+		if !found {
+			end := m.end
+			for _, next := range mappings[i+1:] {
+				if next.start != end || (next.path != "" && next.path != m.path) {
+					break
+				}
+				end = next.end
+			}
+			size = uint32(end - m.start)
+		}
+
+		moduleInfos = append(moduleInfos, ModuleInfo{
+			ProcessID:         processID,
+			ModuleBaseAddress: m.start,
+			ModuleBaseSize:    size,
+			ModuleHandle:      m.start,
+			ModuleName:        m.path,
+		})
+	}
+	if len(moduleInfos) == 0 {
+		return nil, errors.New("no modules found")
+	}
+
+	return moduleInfos, nil
+}
+
+// peImageSize reads the SizeOfImage field of the PE headers loaded at the given address
+func peImageSize(mem io.ReaderAt, base uintptr) (uint32, bool) {
+	var dosHeader [0x40]byte
+	if _, err := mem.ReadAt(dosHeader[:], int64(base)); err != nil || dosHeader[0] != 'M' || dosHeader[1] != 'Z' {
+		return 0, false
+	}
+
+	// e_lfanew points to the "PE\0\0" signature, followed by the file header (0x14 bytes) and the optional header, where
+	// SizeOfImage is at 0x38 for both PE32 and PE32+
+	ntHeader := int64(base) + int64(binary.LittleEndian.Uint32(dosHeader[0x3C:]))
+	var header [0x18 + 0x3C]byte
+	if _, err := mem.ReadAt(header[:], ntHeader); err != nil || !bytes.Equal(header[:4], []byte("PE\x00\x00")) {
+		return 0, false
+	}
+
+	return binary.LittleEndian.Uint32(header[0x18+0x38:]), true
+}
+
+func (p *Process) readProcessMemory(address uintptr, buffer []byte) error {
+	if p.handler == nil {
+		return os.ErrInvalid
+	}
+
+	n, err := p.handler.ReadAt(buffer, int64(address))
+	if err != nil {
+		return err
+	}
+	if n != len(buffer) {
+		return fmt.Errorf("partial read, %d of %d bytes", n, len(buffer))
+	}
+
+	return nil
+}
@@ -0,0 +1,66 @@
+package memory
+
+import (
+	"bytes"
+	"encoding/binary"
+	"os"
+	"strings"
+	"testing"
+)
+
+// Benchmarks use the current process memory, so they can run without the game running
+func newCurrentProcess() *Process {
+	f, err := os.Open("/proc/self/mem")
+	if err != nil {
+		panic(err)
+	}
+
+	return &Process{handler: f}
+}
+
+func TestParseModules(t *testing.T) {
+	maps := `140000000-140001000 r--p 00000000 08:01 1234 /games/Diablo II Resurrected/D2R.exe
+140001000-141000000 r-xp 00001000 08:01 1234 /games/Diablo II Resurrected/D2R.exe
+141000000-141200000 rw-p 00000000 00:00 0
+7f0000000000-7f0000001000 r--p 00000000 08:01 99 /usr/lib/libc.so.6
+7f0000001000-7f0000010000 r-xp 00001000 08:01 99 /usr/lib/libc.so.6
+7f0000010000-7f0000011000 r--p 00000000 08:01 1234 /games/Diablo II Resurrected/D2R.exe
+7ffd00000000-7ffd00021000 rw-p 00000000 00:00 0 [stack]
+`
+	imageSizes := map[uintptr]uint32{0x140000000: 0x1300000}
+	modules, err := parseModules(strings.NewReader(maps), 42, func(base uintptr) (uint32, bool) {
+		size, found := imageSizes[base]
+		return size, found
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []ModuleInfo{
+		{ProcessID: 42, ModuleBaseAddress: 0x140000000, ModuleBaseSize: 0x1300000, ModuleHandle: 0x140000000, ModuleName: "/games/Diablo II Resurrected/D2R.exe"},
+		{ProcessID: 42, ModuleBaseAddress: 0x7f0000000000, ModuleBaseSize: 0x10000, ModuleHandle: 0x7f0000000000, ModuleName: "/usr/lib/libc.so.6"},
+	}
+	if len(modules) != len(expected) {
+		t.Fatalf("%d modules found, expected %d: %+v", len(modules), len(expected), modules)
+	}
+	for i := range expected {
+		if modules[i] != expected[i] {
+			t.Errorf("module %d is %+v, expected %+v", i, modules[i], expected[i])
+		}
+	}
+}
+
+func TestPEImageSize(t *testing.T) {
+	image := make([]byte, 0x200)
+	copy(image, "MZ")
+	binary.LittleEndian.PutUint32(image[0x3C:], 0x80)
+	copy(image[0x80:], "PE\x00\x00")
+	binary.LittleEndian.PutUint32(image[0x80+0x18+0x38:], 0x2A00000)
+
+	if size, found := peImageSize(bytes.NewReader(image), 0); !found || size != 0x2A00000 {
+		t.Errorf("image size 0x%X (found %t), expected 0x2A00000", size, found)
+	}
+	if _, found := peImageSize(bytes.NewReader(make([]byte, 0x200)), 0); found {
+		t.Error("image size found without PE headers")
+	}
+}
@@ -0,0 +1,53 @@
+package memory
+
+import (
+	"testing"
+	"unsafe"
+)
+
+var benchmarkValue = [64]byte{'d', '2', 'g', 'o'}
+
+func BenchmarkReadUInt(b *testing.B) {
+	p := newCurrentProcess()
+	address := uintptr(unsafe.Pointer(&benchmarkValue[0]))
+
+	b.ReportAllocs()
+	for n := 0; n < b.N; n++ {
+		p.ReadUInt(address, Uint64)
+	}
+}
+
+func BenchmarkReadBytesFromMemory(b *testing.B) {
+	p := newCurrentProcess()
+	address := uintptr(unsafe.Pointer(&benchmarkValue[0]))
+
+	b.ReportAllocs()
+	for n := 0; n < b.N; n++ {
+		p.ReadBytesFromMemory(address, Uint64)
+	}
+}
+
+func BenchmarkReadStringFromMemory(b *testing.B) {
+	p := newCurrentProcess()
+	address := uintptr(unsafe.Pointer(&benchmarkValue[0]))
+
+	b.ReportAllocs()
+	for n := 0; n < b.N; n++ {
+		p.ReadStringFromMemory(address, 0)
+	}
+}
+
+func TestFindPatternShortMemory(t *testing.T) {
+	p := &Process{moduleBaseAddressPtr: 0x140000000, moduleBaseSize: 0x1000}
+
+	// A failed module read returns nil memory
+	if got := p.FindPattern(nil, "\x48\x8B", "xx"); got != 0 {
+		t.Errorf("FindPattern on nil memory returned 0x%X", got)
+	}
+	if got := p.FindPatternByOperand([]byte{0x00, 0x48, 0x8B, 0x05}, "\x48\x8B\x05", "xxx"); got != 0 {
+		t.Errorf("FindPatternByOperand without operand bytes returned 0x%X", got)
+	}
+	if got := p.FindPattern([]byte{0x00, 0x48, 0x8B, 0x05}, "\x48\x8B", "xx"); got != 0x140000001 {
+		t.Errorf("FindPattern returned 0x%X, expected 0x140000001", got)
+	}
+}
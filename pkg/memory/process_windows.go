@@ -0,0 +1,133 @@
+package memory
+
+import (
+	"errors"
+	"syscall"
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+const processStillActive = 259
+
+type processHandle = windows.Handle
+
+// NewProcessForWindow attaches to the game process owning the given window handle
+func NewProcessForWindow(hwnd windows.HWND) (*Process, error) {
+	var pid uint32
+	if _, err := windows.GetWindowThreadProcessId(hwnd, &pid); err != nil {
+		return nil, err
+	}
+	if pid == 0 {
+		return nil, errors.New("no process found for the specified window")
+	}
+
+	return NewProcessForPID(pid)
+}
+
+func newProcessFromModule(module ModuleInfo) (*Process, error) {
+	h, err := windows.OpenProcess(0x0010, false, module.ProcessID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Process{
+		handler:              h,
+		pid:                  module.ProcessID,
+		moduleBaseAddressPtr: module.ModuleBaseAddress,
+		moduleBaseSize:       module.ModuleBaseSize,
+		modulePath:           module.ModuleName,
+	}, nil
+}
+
+func (p *Process) Close() error {
+	if p.source != nil {
+		return nil
+	}
+
+	return windows.CloseHandle(p.handler)
+}
+
+// IsProcessAlive returns false when the attached game process has exited (crash, client closed...)
+func (p *Process) IsProcessAlive() bool {
+	pid := p.GetPID()
+	h, err := windows.OpenProcess(windows.PROCESS_QUERY_LIMITED_INFORMATION, false, pid)
+	if err != nil {
+		return false
+	}
+	defer windows.CloseHandle(h)
+
+	var exitCode uint32
+	if err = windows.GetExitCodeProcess(h, &exitCode); err != nil {
+		return false
+	}
+
+	if exitCode != processStillActive {
+		return false
+	}
+
+	// PIDs can be reused by the OS, make sure it's still the game
+	_, found := getMainModule(pid)
+
+	return found
+}
+
+func enumProcesses() ([]uint32, error) {
+	processes := make([]uint32, 2048)
+	length := uint32(0)
+	if err := windows.EnumProcesses(processes, &length); err != nil {
+		return nil, err
+	}
+
+	return processes[:length/uint32(unsafe.Sizeof(processes[0]))], nil
+}
+
+type ModuleInfo struct {
+	ProcessID         uint32
+	ModuleBaseAddress uintptr
+	ModuleBaseSize    uint32
+	ModuleHandle      syscall.Handle
+	ModuleName        string
+}
+
+func GetProcessModules(processID uint32) ([]ModuleInfo, error) {
+	hProcess, err := windows.OpenProcess(windows.PROCESS_QUERY_INFORMATION|windows.PROCESS_VM_READ, false, processID)
+	if err != nil {
+		return nil, err
+	}
+	defer windows.CloseHandle(hProcess)
+
+	var modules [1024]windows.Handle
+	var needed uint32
+	if err := windows.EnumProcessModules(hProcess, &modules[0], uint32(unsafe.Sizeof(modules[0]))*1024, &needed); err != nil {
+		return nil, err
+	}
+	count := needed / uint32(unsafe.Sizeof(modules[0]))
+
+	var moduleInfos []ModuleInfo
+	for i := uint32(0); i < count; i++ {
+		var mi windows.ModuleInfo
+		if err := windows.GetModuleInformation(hProcess, modules[i], &mi, uint32(unsafe.Sizeof(mi))); err != nil {
+			return nil, err
+		}
+
+		var moduleName [windows.MAX_PATH]uint16
+		if err := windows.GetModuleFileNameEx(hProcess, modules[i], &moduleName[0], windows.MAX_PATH); err != nil {
+			return nil, err
+		}
+
+		moduleInfos = append(moduleInfos, ModuleInfo{
+			ProcessID:         processID,
+			ModuleBaseAddress: mi.BaseOfDll,
+			ModuleBaseSize:    mi.SizeOfImage,
+			ModuleHandle:      syscall.Handle(modules[i]),
+			ModuleName:        syscall.UTF16ToString(moduleName[:]),
+		})
+	}
+
+	return moduleInfos, nil
+}
+
+func (p *Process) readProcessMemory(address uintptr, buffer []byte) error {
+	return windows.ReadProcessMemory(p.handler, address, &buffer[0], uintptr(len(buffer)), nil)
+}
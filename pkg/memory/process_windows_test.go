@@ -0,0 +1,10 @@
+package memory
+
+import (
+	"golang.org/x/sys/windows"
+)
+
+// Benchmarks use the current process memory, so they can run without the game running
+func newCurrentProcess() *Process {
+	return &Process{handler: windows.CurrentProcess()}
+}
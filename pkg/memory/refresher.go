@@ -0,0 +1,64 @@
+package memory
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/hectorgimenez/d2go/pkg/data"
+)
+
+var ErrRefresherRunning = errors.New("refresher is already running")
+
+type latestData struct {
+	data      data.Data
+	updatedAt time.Time
+}
+
+// StartRefresher reads the game data in background every interval until the context is done, the last snapshot can be
+// read at any time with Latest. GetData calls from other goroutines wait for the refresher read in progress, the other
+// GameReader readers don't modify any shared state and can be called while the refresher is running
+func (gd *GameReader) StartRefresher(ctx context.Context, interval time.Duration) error {
+	if !gd.refresherRunning.CompareAndSwap(false, true) {
+		return ErrRefresherRunning
+	}
+
+	go func() {
+		defer gd.refresherRunning.Store(false)
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			d, err := gd.GetDataContext(ctx)
+			if ctx.Err() != nil {
+				return
+			}
+			if err != nil {
+				// Partial reads are expected from time to time (loading screens, units despawning...)
+				gd.log().Debug("background refresh read failed", slog.Any("error", err))
+			}
+			gd.latest.Store(&latestData{data: d, updatedAt: time.Now()})
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+
+	return nil
+}
+
+// Latest returns the last snapshot read by the refresher and the time it was read, zero time if nothing has been read
+// yet. The snapshot is shared between callers, it must not be modified
+func (gd *GameReader) Latest() (data.Data, time.Time) {
+	l := gd.latest.Load()
+	if l == nil {
+		return data.Data{}, time.Time{}
+	}
+
+	return l.data, l.updatedAt
+}
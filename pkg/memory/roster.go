@@ -1,11 +1,45 @@
 package memory
 
 import (
+	"sync"
+	"time"
+
 	"github.com/hectorgimenez/d2go/pkg/data"
 	"github.com/hectorgimenez/d2go/pkg/data/area"
 )
 
-func (gd *GameReader) getRoster(rawPlayerUnits RawPlayerUnits) (roster []data.RosterMember) {
+// rosterTracker keeps the last time each party member player unit was loaded, the roster struct doesn't know it
+type rosterTracker struct {
+	mu       sync.Mutex
+	lastSeen map[string]time.Time
+}
+
+// seen records the members loaded on this read and fills LastSeen for all of them, members no longer in the roster
+// are forgotten
+func (t *rosterTracker) seen(now time.Time, roster []data.RosterMember) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	lastSeen := make(map[string]time.Time, len(roster))
+	for i, rm := range roster {
+		if rm.PositionExact {
+			lastSeen[rm.Name] = now
+		} else if ts, found := t.lastSeen[rm.Name]; found {
+			lastSeen[rm.Name] = ts
+		}
+		roster[i].LastSeen = lastSeen[rm.Name]
+	}
+	t.lastSeen = lastSeen
+}
+
+func (t *rosterTracker) reset() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.lastSeen = nil
+}
+
+func (gd *GameReader) getRoster(rawPlayerUnits RawPlayerUnits, now time.Time) (roster []data.RosterMember) {
 	partyStruct := uintptr(gd.Process.ReadUInt(gd.Process.moduleBaseAddressPtr+gd.offset.RosterOffset, Uint64))
 
 	// We skip the first position because it's the main player, and we already have the information (+0x148 is the next party member)
@@ -17,29 +51,41 @@ func (gd *GameReader) getRoster(rawPlayerUnits RawPlayerUnits) (roster []data.Ro
 		xPos := int(gd.Process.ReadUInt(partyStruct+0x60, Uint32))
 		yPos := int(gd.Process.ReadUInt(partyStruct+0x64, Uint32))
 
+		pathTarget := data.Position{X: xPos, Y: yPos}
+		exact := false
+
 		// When the player is in town, roster data is not updated, so we need to get the area from the player unit that match the same name
 		for _, pu := range rawPlayerUnits {
-			if pu.Name == name {
+			if pu.Name == name && !pu.IsCorpse {
 				xPos = pu.Position.X
 				yPos = pu.Position.Y
 				a = pu.Area
+				pathTarget = pu.PathTarget
+				exact = true
 				break
 			}
 		}
 
 		roster = append(roster, data.RosterMember{
-			Name:     name,
-			Area:     a,
-			Position: data.Position{X: xPos, Y: yPos},
+			Name:          name,
+			Area:          a,
+			Position:      data.Position{X: xPos, Y: yPos},
+			PathTarget:    pathTarget,
+			PositionExact: exact,
 		})
 		partyStruct = uintptr(gd.Process.ReadUInt(partyStruct+0x148, Uint64))
 	}
 
 	mainPlayerUnit := rawPlayerUnits.GetMainPlayer()
 
-	return append([]data.RosterMember{{
-		Name:     mainPlayerUnit.Name,
-		Area:     mainPlayerUnit.Area,
-		Position: mainPlayerUnit.Position,
+	roster = append([]data.RosterMember{{
+		Name:          mainPlayerUnit.Name,
+		Area:          mainPlayerUnit.Area,
+		Position:      mainPlayerUnit.Position,
+		PathTarget:    mainPlayerUnit.PathTarget,
+		PositionExact: true,
 	}}, roster...)
+	gd.roster.seen(now, roster)
+
+	return roster
 }
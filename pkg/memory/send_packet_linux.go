@@ -0,0 +1,17 @@
+package memory
+
+import (
+	"errors"
+)
+
+var errSendPacketNotSupported = errors.New("sending packets is only supported on Windows")
+
+type sendPacketState struct{}
+
+func (p *Process) GetD2GSSendPacketFn() (uintptr, error) {
+	return 0, errSendPacketNotSupported
+}
+
+func (p *Process) SendPacket(packet []byte) error {
+	return errSendPacketNotSupported
+}
@@ -4,7 +4,7 @@ import (
 	"encoding/binary"
 	"errors"
 	"fmt"
-	"log"
+	"log/slog"
 	"strings"
 	"sync"
 	"time"
@@ -88,6 +88,7 @@ type sendPacketState struct {
 	processPID          uint32
 	threadLastValidated time.Time
 	leakedBuffers       []uintptr
+	logger              Logger
 }
 
 type cfgCallTargetInfo struct {
@@ -221,7 +222,7 @@ func (s *sendPacketState) ensurePacketBuffer(handle windows.Handle, size uintptr
 
 	if s.packet != 0 {
 		if err := virtualFreeEx(handle, s.packet); err != nil {
-			log.Printf("Warning: failed to free old packet buffer at 0x%X: %v", s.packet, err)
+			s.logger.Warn("failed to free old packet buffer", slog.Any("address", hexValue(s.packet)), slog.Any("error", err))
 			s.leakedBuffers = append(s.leakedBuffers, s.packet)
 		}
 	}
@@ -485,7 +486,7 @@ func (p *Process) GetD2GSSendPacketFn() (uintptr, error) {
 
 		memory := make([]byte, int(module.ModuleBaseSize))
 		if err := windows.ReadProcessMemory(p.handler, module.ModuleBaseAddress, &memory[0], uintptr(module.ModuleBaseSize), nil); err != nil {
-			log.Printf("Warning: failed to read module %s: %v", module.ModuleName, err)
+			p.log().Warn("failed to read module", slog.String("module", module.ModuleName), slog.Any("error", err))
 			continue
 		}
 
@@ -495,7 +496,7 @@ func (p *Process) GetD2GSSendPacketFn() (uintptr, error) {
 		}
 
 		if !validatePatternMatch(memory, offset) {
-			log.Printf("Warning: false positive pattern match at offset 0x%X in %s", offset, module.ModuleName)
+			p.log().Warn("false positive pattern match", slog.Any("offset", hexValue(offset)), slog.String("module", module.ModuleName))
 			continue
 		}
 
@@ -504,11 +505,11 @@ func (p *Process) GetD2GSSendPacketFn() (uintptr, error) {
 		absolute := uintptr(int64(patternAddr+5) + int64(relOffset))
 
 		if absolute == 0 || absolute < module.ModuleBaseAddress {
-			log.Printf("Warning: invalid computed address 0x%X in %s", absolute, module.ModuleName)
+			p.log().Warn("invalid computed address", slog.Any("address", hexValue(absolute)), slog.String("module", module.ModuleName))
 			continue
 		}
 
-		log.Printf("D2GS_SendPacket resolved at 0x%X in module %s", absolute, module.ModuleName)
+		p.log().Info("D2GS_SendPacket resolved", slog.Any("address", hexValue(absolute)), slog.String("module", module.ModuleName))
 
 		d2gsCacheMu.Lock()
 		d2gsCachedFn = absolute
@@ -528,7 +529,7 @@ func (p *Process) SendPacket(packet []byte) (err error) {
 
 	defer func() {
 		if err != nil {
-			log.Printf("SendPacket(%d bytes) error: %v", len(packet), err)
+			p.log().Error("SendPacket error", slog.Int("bytes", len(packet)), slog.Any("error", err))
 		}
 	}()
 
@@ -542,7 +543,7 @@ func (p *Process) SendPacket(packet []byte) (err error) {
 
 	p.sendPacketMu.Lock()
 	if p.sendPacket == nil {
-		p.sendPacket = &sendPacketState{}
+		p.sendPacket = &sendPacketState{logger: p.log()}
 	}
 	state := p.sendPacket
 	state.mu.Lock()
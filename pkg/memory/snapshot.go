@@ -0,0 +1,228 @@
+package memory
+
+import (
+	"compress/gzip"
+	"encoding/gob"
+	"errors"
+	"io"
+	"sort"
+	"sync"
+	"time"
+)
+
+const snapshotPageSize = 0x1000
+
+var errNotRecorded = errors.New("memory region not present in the snapshot")
+
+// Snapshot contains all the memory regions read by the GameReader while recording, it can be saved to a file and
+// replayed later with NewReplayGameReader, useful to reproduce bugs and to test readers without a running game
+type Snapshot struct {
+	RecordedAt        time.Time
+	ModuleBaseAddress uintptr
+	ModuleBaseSize    uint32
+	ModulePath        string
+	Offset            Offset
+	Regions           []SnapshotRegion
+}
+
+type SnapshotRegion struct {
+	Address uintptr
+	Data    []byte
+}
+
+// Save writes the snapshot gzip compressed
+func (s *Snapshot) Save(w io.Writer) error {
+	zw := gzip.NewWriter(w)
+	if err := gob.NewEncoder(zw).Encode(s); err != nil {
+		zw.Close()
+		return err
+	}
+
+	return zw.Close()
+}
+
+// LoadSnapshot reads a snapshot previously written by Snapshot.Save
+func LoadSnapshot(r io.Reader) (*Snapshot, error) {
+	zr, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, err
+	}
+	defer zr.Close()
+
+	s := &Snapshot{}
+	if err = gob.NewDecoder(zr).Decode(s); err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+// StartRecording starts capturing every memory read, any previous recording is discarded
+func (gd *GameReader) StartRecording() {
+	gd.Process.recorder.Store(&snapshotRecorder{pages: memoryPages{}})
+}
+
+// StopRecording stops capturing memory reads and returns the snapshot, nil if it was not recording. If the same address
+// has been read multiple times while recording, only the last value is kept
+func (gd *GameReader) StopRecording() *Snapshot {
+	rec := gd.Process.recorder.Swap(nil)
+	if rec == nil {
+		return nil
+	}
+
+	rec.mu.Lock()
+	defer rec.mu.Unlock()
+
+	return &Snapshot{
+		RecordedAt:        time.Now(),
+		ModuleBaseAddress: gd.Process.moduleBaseAddressPtr,
+		ModuleBaseSize:    gd.Process.moduleBaseSize,
+		ModulePath:        gd.Process.modulePath,
+		Offset:            gd.offset,
+		Regions:           rec.pages.regions(),
+	}
+}
+
+// NewReplayGameReader returns a GameReader serving all the reads from the given snapshot instead of a game process.
+// Reads not captured in the snapshot fail as they would do with an unmapped address
+func NewReplayGameReader(snapshot *Snapshot, opts ...GameReaderOption) *GameReader {
+	pages := memoryPages{}
+	for _, region := range snapshot.Regions {
+		pages.write(region.Address, region.Data)
+	}
+
+	process := NewProcessFromSource(pages, snapshot.ModuleBaseAddress, snapshot.ModuleBaseSize)
+	process.modulePath = snapshot.ModulePath
+
+	return NewGameReaderWithOffset(process, snapshot.Offset, opts...)
+}
+
+// IsReplay returns true if reads are served from a snapshot
+func (p *Process) IsReplay() bool {
+	_, isReplay := p.source.(memoryPages)
+
+	return isReplay
+}
+
+// readMemory is the single place where the game memory is read, so snapshots can be recorded and replayed
+func (p *Process) readMemory(address uintptr, buffer []byte) error {
+	if len(buffer) == 0 {
+		return nil
+	}
+	if err := p.readCancelled(); err != nil {
+		return err
+	}
+	if l := p.limiter.Load(); l != nil {
+		if err := l.wait(p.readContext()); err != nil {
+			return err
+		}
+	}
+
+	if p.source != nil {
+		if err := p.source.ReadMemory(address, buffer); err != nil {
+			return err
+		}
+	} else {
+		p.attachMu.RLock()
+		err := p.readProcessMemory(address, buffer)
+		p.attachMu.RUnlock()
+		if err != nil {
+			return err
+		}
+	}
+
+	if rec := p.recorder.Load(); rec != nil {
+		rec.mu.Lock()
+		rec.pages.write(address, buffer)
+		rec.mu.Unlock()
+	}
+
+	return nil
+}
+
+type snapshotRecorder struct {
+	mu    sync.Mutex
+	pages memoryPages
+}
+
+type memoryPage struct {
+	data  [snapshotPageSize]byte
+	valid [snapshotPageSize]bool
+}
+
+// memoryPages stores sparse memory by page, keyed by the page base address
+type memoryPages map[uintptr]*memoryPage
+
+func (m memoryPages) write(address uintptr, data []byte) {
+	for len(data) > 0 {
+		base := address &^ (snapshotPageSize - 1)
+		offset := address - base
+		page, found := m[base]
+		if !found {
+			page = &memoryPage{}
+			m[base] = page
+		}
+
+		n := copy(page.data[offset:], data)
+		for i := offset; i < offset+uintptr(n); i++ {
+			page.valid[i] = true
+		}
+
+		data = data[n:]
+		address += uintptr(n)
+	}
+}
+
+// ReadMemory fills the buffer, it fails if any byte of the requested region is missing
+func (m memoryPages) ReadMemory(address uintptr, buffer []byte) error {
+	for len(buffer) > 0 {
+		base := address &^ (snapshotPageSize - 1)
+		offset := address - base
+		page, found := m[base]
+		if !found {
+			return errNotRecorded
+		}
+
+		n := copy(buffer, page.data[offset:])
+		for i := offset; i < offset+uintptr(n); i++ {
+			if !page.valid[i] {
+				return errNotRecorded
+			}
+		}
+
+		buffer = buffer[n:]
+		address += uintptr(n)
+	}
+
+	return nil
+}
+
+// regions returns the stored memory as contiguous regions sorted by address
+func (m memoryPages) regions() []SnapshotRegion {
+	bases := make([]uintptr, 0, len(m))
+	for base := range m {
+		bases = append(bases, base)
+	}
+	sort.Slice(bases, func(i, j int) bool { return bases[i] < bases[j] })
+
+	regions := make([]SnapshotRegion, 0)
+	var current *SnapshotRegion
+	for _, base := range bases {
+		page := m[base]
+		for i := uintptr(0); i < snapshotPageSize; i++ {
+			if !page.valid[i] {
+				current = nil
+				continue
+			}
+
+			address := base + i
+			if current == nil || current.Address+uintptr(len(current.Data)) != address {
+				regions = append(regions, SnapshotRegion{Address: address})
+				current = &regions[len(regions)-1]
+			}
+			current.Data = append(current.Data, page.data[i])
+		}
+	}
+
+	return regions
+}
@@ -0,0 +1,38 @@
+package memory
+
+import (
+	"bytes"
+	"testing"
+	"unsafe"
+)
+
+func TestSnapshotRecordAndReplay(t *testing.T) {
+	gd := &GameReader{Process: newCurrentProcess()}
+	address := uintptr(unsafe.Pointer(&benchmarkValue[0]))
+
+	gd.StartRecording()
+	expected := gd.ReadBytesFromMemory(address, 16)
+	snapshot := gd.StopRecording()
+
+	buf := &bytes.Buffer{}
+	if err := snapshot.Save(buf); err != nil {
+		t.Fatalf("error saving snapshot: %v", err)
+	}
+	loaded, err := LoadSnapshot(buf)
+	if err != nil {
+		t.Fatalf("error loading snapshot: %v", err)
+	}
+
+	replay := NewReplayGameReader(loaded)
+	got, err := replay.ReadBytesFromMemoryE(address, 16)
+	if err != nil {
+		t.Fatalf("error reading from replay: %v", err)
+	}
+	if !bytes.Equal(got, expected) {
+		t.Errorf("replayed memory %v, expected %v", got, expected)
+	}
+
+	if _, err = replay.ReadBytesFromMemoryE(address, 32); err == nil {
+		t.Error("expected error reading memory not present in the snapshot")
+	}
+}
@@ -0,0 +1,77 @@
+package memory
+
+import (
+	"context"
+	"math/rand"
+	"sync"
+	"time"
+)
+
+// WithReadRateLimit limits the amount of memory reads (syscalls) per second, see Process.SetReadRateLimit
+func WithReadRateLimit(readsPerSecond int) GameReaderOption {
+	return func(gd *GameReader) {
+		gd.Process.SetReadRateLimit(readsPerSecond)
+	}
+}
+
+// WithCacheJitter randomizes the refresh interval of the cached data (monsters, inventory, objects) by the given
+// fraction, 0.2 means each refresh happens between 80% and 120% of the default interval
+func WithCacheJitter(jitter float64) GameReaderOption {
+	return func(gd *GameReader) {
+		gd.cacheJitter = min(max(jitter, 0), 1)
+	}
+}
+
+// SetReadRateLimit limits the amount of memory reads (syscalls) per second, reads over the limit wait for their turn.
+// Every syscall counts: ReadBatch waits once per merged memory span, and once more per request of a span that failed to
+// be read and fell back to individual reads. 0 or less removes the limit
+func (p *Process) SetReadRateLimit(readsPerSecond int) {
+	if readsPerSecond <= 0 {
+		p.limiter.Store(nil)
+		return
+	}
+
+	p.limiter.Store(&readLimiter{interval: time.Second / time.Duration(readsPerSecond)})
+}
+
+type readLimiter struct {
+	mu       sync.Mutex
+	interval time.Duration
+	next     time.Time
+}
+
+// wait blocks until the next read is allowed, it returns the context error if the context is done before
+func (l *readLimiter) wait(ctx context.Context) error {
+	l.mu.Lock()
+	now := time.Now()
+	if l.next.Before(now) {
+		l.next = now
+	}
+	delay := l.next.Sub(now)
+	l.next = l.next.Add(l.interval)
+	l.mu.Unlock()
+
+	if delay <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// cacheInterval returns the given interval randomized by the configured jitter
+func (gd *GameReader) cacheInterval(interval time.Duration) time.Duration {
+	if gd.cacheJitter == 0 {
+		return interval
+	}
+
+	factor := 1 + gd.cacheJitter*(rand.Float64()*2-1)
+
+	return time.Duration(float64(interval) * factor)
+}
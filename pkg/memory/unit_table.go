@@ -0,0 +1,81 @@
+package memory
+
+import (
+	"log/slog"
+)
+
+const (
+	unitTablePlayers   = 0
+	unitTableMonsters  = 1
+	unitTableObjects   = 2
+	unitTableItems     = 4
+	unitTableEntrances = 5
+
+	// Enough to cover all the unit struct fields we use, up to the corpse flag at 0x1AE
+	unitStructSize = 0x1B0
+
+	// Sanity limits against garbage read from memory being freed while reading (area changes, game exit): huge stat
+	// counts and circular unit or stat lists. Modded games are only covered as long as they keep the same structures,
+	// different struct layouts are not supported
+	maxStatCount     = 0x200
+	maxUnitsPerTable = 0x4000
+	maxStatListDepth = 0x40
+)
+
+// statCount returns the given stat count, or 0 if it's garbage. Garbage lists are skipped instead of truncated, since
+// the stats pointer of a garbage list is not valid either
+func statCount(count uint) uint {
+	if count > maxStatCount {
+		return 0
+	}
+
+	return count
+}
+
+type rawUnit struct {
+	Address uintptr
+	Buffer  []byte
+}
+
+// walkUnitTable returns all the units linked in the given unit table, reading each unit struct only once.
+// All the bucket lists are walked at the same time, one batch read per list depth, units are usually allocated close
+// to each other so each batch ends up in a few large reads instead of one syscall per unit
+func (gd *GameReader) walkUnitTable(table int) []rawUnit {
+	baseAddr := gd.Process.moduleBaseAddressPtr + gd.offset.UnitTable + uintptr(table*1024)
+	unitTableBuffer := gd.Process.ReadBytesFromMemory(baseAddr, 128*8)
+
+	units := make([]rawUnit, 0, 128)
+	visited := make(map[uintptr]struct{}, 128)
+	pending := make([]uintptr, 0, 128)
+	for i := 0; i < 128; i++ {
+		if unitPtr := uintptr(ReadUIntFromBuffer(unitTableBuffer, uint(8*i), Uint64)); unitPtr > 0 {
+			pending = append(pending, unitPtr)
+		}
+	}
+
+	requests := make([]ReadRequest, 0, 128)
+	for len(pending) > 0 && len(units) < maxUnitsPerTable {
+		requests = requests[:0]
+		for _, unitPtr := range pending {
+			if _, found := visited[unitPtr]; found {
+				gd.log().Debug("circular unit list found", slog.Int("table", table))
+				continue
+			}
+			if len(units)+len(requests) >= maxUnitsPerTable {
+				break
+			}
+			visited[unitPtr] = struct{}{}
+			requests = append(requests, ReadRequest{Address: unitPtr, Size: unitStructSize})
+		}
+
+		pending = pending[:0]
+		for i, unitBuffer := range gd.Process.ReadBatch(requests) {
+			units = append(units, rawUnit{Address: requests[i].Address, Buffer: unitBuffer})
+			if next := uintptr(ReadUIntFromBuffer(unitBuffer, 0x158, Uint64)); next > 0 {
+				pending = append(pending, next)
+			}
+		}
+	}
+
+	return units
+}
@@ -0,0 +1,104 @@
+package memory
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf16"
+)
+
+type GameVersion struct {
+	Major uint32
+	Minor uint32
+	Patch uint32
+	Build uint32
+}
+
+func (v GameVersion) String() string {
+	return fmt.Sprintf("%d.%d.%d.%d", v.Major, v.Minor, v.Patch, v.Build)
+}
+
+// vsFixedFileInfoSignature is the first field of VS_FIXEDFILEINFO
+var vsFixedFileInfoSignature = []byte{0xBD, 0x04, 0xEF, 0xFE}
+
+// parseVersionInfo returns the version stored in a VS_VERSIONINFO resource. The FileVersion string is preferred, the
+// game build number doesn't fit in the 16 bits fields of VS_FIXEDFILEINFO, which is only used as fallback
+func parseVersionInfo(resource []byte) (GameVersion, error) {
+	if version, found := versionInfoString(resource, "FileVersion"); found {
+		if v, err := parseGameVersion(version); err == nil {
+			return v, nil
+		}
+	}
+
+	// Signature, StrucVersion, FileVersionMS, FileVersionLS
+	idx := bytes.Index(resource, vsFixedFileInfoSignature)
+	if idx == -1 || idx+16 > len(resource) {
+		return GameVersion{}, errors.New("game executable has no version info")
+	}
+	fileVersionMS := binary.LittleEndian.Uint32(resource[idx+8:])
+	fileVersionLS := binary.LittleEndian.Uint32(resource[idx+12:])
+
+	return GameVersion{
+		Major: fileVersionMS >> 16,
+		Minor: fileVersionMS & 0xFFFF,
+		Patch: fileVersionLS >> 16,
+		Build: fileVersionLS & 0xFFFF,
+	}, nil
+}
+
+// versionInfoString returns the value of the given StringFileInfo key, strings are stored as UTF-16 key and value
+// separated by the key terminator and zero padding
+func versionInfoString(resource []byte, key string) (string, bool) {
+	idx := bytes.Index(resource, utf16Bytes(key+"\x00"))
+	if idx == -1 {
+		return "", false
+	}
+
+	i := idx + len(key)*2 + 2
+	for i+1 < len(resource) && resource[i] == 0 && resource[i+1] == 0 {
+		i += 2
+	}
+
+	value := make([]uint16, 0)
+	for ; i+1 < len(resource); i += 2 {
+		c := binary.LittleEndian.Uint16(resource[i:])
+		if c == 0 {
+			break
+		}
+		value = append(value, c)
+	}
+
+	return string(utf16.Decode(value)), len(value) > 0
+}
+
+// parseGameVersion parses versions like "1.6.80273.0" or "1, 6, 80273, 0", missing components are zero
+func parseGameVersion(s string) (GameVersion, error) {
+	fields := strings.FieldsFunc(s, func(r rune) bool { return r == '.' || r == ',' || r == ' ' })
+	if len(fields) == 0 || len(fields) > 4 {
+		return GameVersion{}, fmt.Errorf("invalid version %q", s)
+	}
+
+	var parts [4]uint32
+	for i, f := range fields {
+		n, err := strconv.ParseUint(f, 10, 32)
+		if err != nil {
+			return GameVersion{}, fmt.Errorf("invalid version %q: %w", s, err)
+		}
+		parts[i] = uint32(n)
+	}
+
+	return GameVersion{Major: parts[0], Minor: parts[1], Patch: parts[2], Build: parts[3]}, nil
+}
+
+func utf16Bytes(s string) []byte {
+	encoded := utf16.Encode([]rune(s))
+	b := make([]byte, len(encoded)*2)
+	for i, c := range encoded {
+		binary.LittleEndian.PutUint16(b[i*2:], c)
+	}
+
+	return b
+}
@@ -0,0 +1,31 @@
+package memory
+
+import (
+	"debug/pe"
+	"errors"
+)
+
+// GameVersion reads the file version of the game executable, the Windows version APIs are not available so the version
+// resource is read from the PE file directly
+func (p *Process) GameVersion() (GameVersion, error) {
+	if p.modulePath == "" {
+		return GameVersion{}, errors.New("game executable path is unknown")
+	}
+
+	f, err := pe.Open(p.modulePath)
+	if err != nil {
+		return GameVersion{}, err
+	}
+	defer f.Close()
+
+	section := f.Section(".rsrc")
+	if section == nil {
+		return GameVersion{}, errors.New("game executable has no resources")
+	}
+	resources, err := section.Data()
+	if err != nil {
+		return GameVersion{}, err
+	}
+
+	return parseVersionInfo(resources)
+}
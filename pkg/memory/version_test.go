@@ -0,0 +1,60 @@
+package memory
+
+import (
+	"encoding/binary"
+	"testing"
+)
+
+func TestParseVersionInfo(t *testing.T) {
+	// StringFileInfo entry: key, terminator, padding and value
+	resource := append([]byte{0x00, 0x00}, utf16Bytes("FileVersion\x00")...)
+	resource = append(resource, 0x00, 0x00)
+	resource = append(resource, utf16Bytes("1.6.80273.0\x00")...)
+
+	v, err := parseVersionInfo(resource)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := (GameVersion{Major: 1, Minor: 6, Patch: 80273}); v != expected {
+		t.Errorf("version %s, expected %s", v, expected)
+	}
+
+	// Without version string the fixed file info is used
+	fixed := make([]byte, 16)
+	copy(fixed, vsFixedFileInfoSignature)
+	binary.LittleEndian.PutUint32(fixed[8:], 1<<16|6)
+	binary.LittleEndian.PutUint32(fixed[12:], 3<<16|7)
+	v, err = parseVersionInfo(fixed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := (GameVersion{Major: 1, Minor: 6, Patch: 3, Build: 7}); v != expected {
+		t.Errorf("version %s, expected %s", v, expected)
+	}
+
+	if _, err = parseVersionInfo([]byte{1, 2, 3}); err == nil {
+		t.Error("expected error without version info")
+	}
+}
+
+func TestParseGameVersion(t *testing.T) {
+	for s, expected := range map[string]GameVersion{
+		"1.6.80273.0":    {Major: 1, Minor: 6, Patch: 80273},
+		"1, 6, 80273, 0": {Major: 1, Minor: 6, Patch: 80273},
+		"1.6":            {Major: 1, Minor: 6},
+	} {
+		v, err := parseGameVersion(s)
+		if err != nil {
+			t.Fatalf("%s: %v", s, err)
+		}
+		if v != expected {
+			t.Errorf("%s: version %s, expected %s", s, v, expected)
+		}
+	}
+
+	for _, s := range []string{"", "a.b", "1.2.3.4.5"} {
+		if _, err := parseGameVersion(s); err == nil {
+			t.Errorf("%q: expected error", s)
+		}
+	}
+}
@@ -0,0 +1,27 @@
+package memory
+
+import (
+	"errors"
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+// GameVersion reads the file version of the game executable
+func (p *Process) GameVersion() (GameVersion, error) {
+	if p.modulePath == "" {
+		return GameVersion{}, errors.New("game executable path is unknown")
+	}
+
+	size, err := windows.GetFileVersionInfoSize(p.modulePath, nil)
+	if err != nil {
+		return GameVersion{}, err
+	}
+
+	buffer := make([]byte, size)
+	if err = windows.GetFileVersionInfo(p.modulePath, 0, size, unsafe.Pointer(&buffer[0])); err != nil {
+		return GameVersion{}, err
+	}
+
+	return parseVersionInfo(buffer)
+}
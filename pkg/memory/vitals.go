@@ -0,0 +1,107 @@
+package memory
+
+import (
+	"time"
+
+	"github.com/hectorgimenez/d2go/pkg/data"
+	"github.com/hectorgimenez/d2go/pkg/data/npc"
+	"github.com/hectorgimenez/d2go/pkg/data/stat"
+)
+
+// Vitals returns the player life and mana, the other fields are left empty (see GetVitals). The player unit is located
+// again on every call, nothing is kept between calls, so a unit freed on an area change is never read. Only the player
+// units table (a few units) and the player stats list are read, all in batches, and like GetVitals it doesn't modify
+// any GameReader state. The mercenary life is not included: units don't tell which player hired them, so the player
+// mercenary can't be told apart without walking the whole monster table, use GetVitals for it
+func (gd *GameReader) Vitals() Vitals {
+	v := Vitals{Time: time.Now()}
+
+	player, found := gd.mainPlayerUnit()
+	if !found {
+		return v
+	}
+
+	// Player life and mana are shifted, monster ones are not
+	stats := gd.readStatsLists([]uintptr{uintptr(ReadUIntFromBuffer(player.Buffer, 0x88, Uint64)) + 0xA8})[0]
+	v.Life = stats[stat.Life] >> 8
+	v.MaxLife = stats[stat.MaxLife] >> 8
+	v.Mana = stats[stat.Mana] >> 8
+	v.MaxMana = stats[stat.MaxMana] >> 8
+
+	return v
+}
+
+// setMerc sets the mercenary life from the first alive one, in multiplayer games it can be the mercenary of another
+// player, units don't tell who hired them
+func (v *Vitals) setMerc(mercStats []map[stat.ID]int) {
+	for _, stats := range mercStats {
+		if stats[stat.Life] > 0 {
+			v.HasMerc = true
+			v.MercLife = stats[stat.Life]
+			v.MercMaxLife = stats[stat.MaxLife]
+			return
+		}
+	}
+}
+
+// mainPlayerUnit returns the unit of the player controlled by this client, found by the same inventory flag as
+// GetRawPlayerUnits
+func (gd *GameReader) mainPlayerUnit() (rawUnit, bool) {
+	players := gd.walkUnitTable(unitTablePlayers)
+
+	// The flag is stored at a different offset for expansion characters
+	flagOffset := uintptr(0x30)
+	expCharPtr := uintptr(gd.Process.ReadUInt(gd.moduleBaseAddressPtr+gd.offset.Expansion, Uint64))
+	if gd.Process.ReadUInt(expCharPtr+0x5C, Uint16) > 0 {
+		flagOffset = 0x70
+	}
+
+	requests := make([]ReadRequest, len(players))
+	for i, u := range players {
+		inventoryAddr := uintptr(ReadUIntFromBuffer(u.Buffer, 0x90, Uint64))
+		requests[i] = ReadRequest{Address: inventoryAddr + flagOffset, Size: Uint16}
+	}
+	for i, flag := range gd.Process.ReadBatch(requests) {
+		if ReadUIntFromBuffer(flag, 0, Uint16) > 0 {
+			return players[i], true
+		}
+	}
+
+	return rawUnit{}, false
+}
+
+// mercStatsLists returns the stats list addresses of the mercenaries found in the monster table, corpses excluded
+func (gd *GameReader) mercStatsLists() []uintptr {
+	lists := make([]uintptr, 0)
+	for _, u := range gd.walkUnitTable(unitTableMonsters) {
+		m := data.Monster{Name: npc.ID(ReadUIntFromBuffer(u.Buffer, 0x04, Uint32))}
+		if m.IsMerc() && ReadUIntFromBuffer(u.Buffer, 0x1AE, Uint8) == 0 {
+			lists = append(lists, uintptr(ReadUIntFromBuffer(u.Buffer, 0x88, Uint64))+0x30)
+		}
+	}
+
+	return lists
+}
+
+// readStatsLists decodes the stats of each list in 2 batched reads, the list headers and the stats, addresses must
+// point to the stats list header (statsListEx+0x30 for monster stats, +0xA8 for player full stats)
+func (gd *GameReader) readStatsLists(addresses []uintptr) []map[stat.ID]int {
+	requests := make([]ReadRequest, len(addresses))
+	for i, address := range addresses {
+		requests[i] = ReadRequest{Address: address, Size: 0x10}
+	}
+
+	statRequests := make([]ReadRequest, len(addresses))
+	for i, h := range gd.Process.ReadBatch(requests) {
+		statPtr := uintptr(ReadUIntFromBuffer(h, 0, Uint64))
+		count := statCount(ReadUIntFromBuffer(h, 0x08, Uint64))
+		statRequests[i] = ReadRequest{Address: statPtr + 0x2, Size: count * 8}
+	}
+
+	stats := make([]map[stat.ID]int, len(addresses))
+	for i, buffer := range gd.Process.ReadBatch(statRequests) {
+		stats[i] = monsterStatsFromBuffer(buffer)
+	}
+
+	return stats
+}
@@ -0,0 +1,65 @@
+package memory
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/hectorgimenez/d2go/pkg/data/stat"
+	"github.com/stretchr/testify/require"
+)
+
+const vitalsTestBase = 0x140000000
+
+type vitalsTestMemory struct {
+	fakeMemory
+}
+
+func (m vitalsTestMemory) putPtr(offset, value uintptr) {
+	binary.LittleEndian.PutUint64(m.data[offset:], uint64(value))
+}
+
+// putStats writes a stats list header at offset pointing to the given stats, stored from statsOffset
+func (m vitalsTestMemory) putStats(offset, statsOffset uintptr, stats map[stat.ID]int) {
+	m.putPtr(offset, vitalsTestBase+statsOffset)
+	binary.LittleEndian.PutUint64(m.data[offset+0x08:], uint64(len(stats)))
+	i := statsOffset + 0x2
+	for id, value := range stats {
+		binary.LittleEndian.PutUint16(m.data[i:], uint16(id))
+		binary.LittleEndian.PutUint32(m.data[i+0x2:], uint32(value))
+		i += 8
+	}
+}
+
+func TestVitals(t *testing.T) {
+	mem := vitalsTestMemory{fakeMemory{base: vitalsTestBase, data: make([]byte, 0x8000)}}
+	gd := NewGameReaderWithOffset(NewProcessFromSource(mem, mem.base, uint32(len(mem.data))),
+		Offset{UnitTable: 0x1000, Expansion: 0x2000})
+
+	// Expansion character, the main player flag is at inventory+0x70
+	mem.putPtr(0x2000, vitalsTestBase+0x2100)
+	mem.data[0x2100+0x5C] = 1
+
+	// Player unit, its inventory and full stats list
+	mem.putPtr(0x1000, vitalsTestBase+0x3000)
+	mem.putPtr(0x3000+0x90, vitalsTestBase+0x3400)
+	mem.data[0x3400+0x70] = 1
+	mem.putPtr(0x3000+0x88, vitalsTestBase+0x3600)
+	mem.putStats(0x3600+0xA8, 0x3800, map[stat.ID]int{
+		stat.Life: 300 << 8, stat.MaxLife: 400 << 8, stat.Mana: 50 << 8, stat.MaxMana: 100 << 8,
+	})
+
+	v := gd.Vitals()
+	require.Equal(t, 300, v.Life)
+	require.Equal(t, 400, v.MaxLife)
+	require.Equal(t, 50, v.Mana)
+	require.Equal(t, 100, v.MaxMana)
+	require.Equal(t, 75, v.LifePercent())
+
+	// Units are located again on each call: the stats list moved
+	mem.putPtr(0x3000+0x88, vitalsTestBase+0x5000)
+	mem.putStats(0x5000+0xA8, 0x5200, map[stat.ID]int{stat.Life: 100 << 8, stat.MaxLife: 400 << 8})
+
+	v = gd.Vitals()
+	require.Equal(t, 100, v.Life)
+	require.Equal(t, 0, v.Mana)
+}
@@ -0,0 +1,115 @@
+package memory
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Known widget state names, see WidgetStateFlags
+const (
+	WidgetStateWeaponSwap = "WeaponSwap" // 0 primary weapons, 1 secondary weapons
+)
+
+var ErrUnknownWidgetState = errors.New("unknown widget state")
+
+// WidgetStateFlags maps widget state names to the 64 bit key the game uses for them in the widget states hash map, only
+// keys verified in game or taken from a referenced source are registered. Keys are not derived from the names, new ones
+// are found dumping the hash map with GetWidgetStates before and after toggling the state in game and comparing which
+// entry value changes. Add them here together with a named constant above and the source or how they were found, so
+// they can be read with GetNamedWidgetState. No verified key is known yet for the skill bar, Show Items or chat focus
+// states, use IsChatInputFocused and OpenMenus for the chat and panels state
+var WidgetStateFlags = map[string]uint64{
+	// From ResurrectedTrade, see GetWidgetState
+	WidgetStateWeaponSwap: 0xF2D7CF8E9CC08212,
+}
+
+// Sanity limits for the widget states hash map walk, against garbage read while the game is loading or exiting
+const (
+	maxWidgetStateBuckets = 1 << 16
+	maxWidgetStateChain   = 256
+)
+
+// GetNamedWidgetState returns the value of a widget state registered in WidgetStateFlags, ErrUnknownWidgetState if
+// the name is not registered
+func (gd *GameReader) GetNamedWidgetState(name string) (int, error) {
+	flag, found := WidgetStateFlags[name]
+	if !found {
+		return 0, fmt.Errorf("%w: %s", ErrUnknownWidgetState, name)
+	}
+
+	return gd.GetWidgetState(flag)
+}
+
+// GetWidgetState reference : https://github.com/ResurrectedTrader/ResurrectedTrade/blob/f121ec02dd3fbe1c574f713e5a0c2db92ccca821/ResurrectedTrade.AgentBase/Capture.cs#L618
+func (gd *GameReader) GetWidgetState(stateFlag uint64) (int, error) {
+	// Get widget states pointer
+	stateFlags := uint64(gd.Process.ReadUInt(gd.moduleBaseAddressPtr+gd.offset.WidgetStatesOffset, Uint64))
+	if stateFlags == 0 {
+		return 0, nil
+	}
+
+	v2 := uint64(gd.Process.ReadUInt(uintptr(stateFlags)+8, Uint64))
+	if v2 == 0 {
+		return 0, nil
+	}
+
+	flag := stateFlag
+	v5 := (uint64(gd.Process.ReadUInt(uintptr(stateFlags), Uint64)) - 1) & widgetStateHash(flag)
+	v6 := uint64(gd.Process.ReadUInt(uintptr(v2)+uintptr(8*v5), Uint64))
+
+	i := uintptr(v2) + uintptr(8*v5)
+
+	for ; v6 != 0; v6 = uint64(gd.Process.ReadUInt(uintptr(v6), Uint64)) {
+		if flag == uint64(gd.Process.ReadUInt(uintptr(v6)+8, Uint64)) {
+			break
+		}
+		i = uintptr(v6)
+	}
+
+	ir := uint64(gd.Process.ReadUInt(i, Uint64))
+	if ir != 0 {
+		return gd.widgetStateValue(uintptr(ir)), nil
+	}
+
+	return 0, nil
+}
+
+// widgetStateHash is the hash of a widget state key, the bucket is the hash masked by the bucket count - 1
+func widgetStateHash(flag uint64) uint64 {
+	v4 := uint64(0xC4CEB9FE1A85EC53) * ((uint64(0xFF51AFD7ED558CCD) * (flag ^ (flag >> 33))) ^ ((uint64(0xFF51AFD7ED558CCD) * (flag ^ (flag >> 33))) >> 33))
+	return v4 ^ (v4 >> 33)
+}
+
+// widgetStateValue reads the value of a widget states hash map node: next node, key and a pointer to the value
+func (gd *GameReader) widgetStateValue(node uintptr) int {
+	ptr1 := uint64(gd.Process.ReadUInt(node+16, Uint64))
+	ptr2 := uint64(gd.Process.ReadUInt(uintptr(ptr1)+16, Uint64))
+	return int(gd.Process.ReadUInt(uintptr(ptr2), Uint8))
+}
+
+// GetWidgetStates returns all the entries of the widget states hash map by key, it's meant to find the keys of new
+// widget states (see WidgetStateFlags), not to be read on every GetData
+func (gd *GameReader) GetWidgetStates() map[uint64]int {
+	states := make(map[uint64]int)
+	stateFlags := uintptr(gd.Process.ReadUInt(gd.moduleBaseAddressPtr+gd.offset.WidgetStatesOffset, Uint64))
+	if stateFlags == 0 {
+		return states
+	}
+
+	buckets := uintptr(gd.Process.ReadUInt(stateFlags+8, Uint64))
+	count := uintptr(gd.Process.ReadUInt(stateFlags, Uint64))
+	if buckets == 0 || count > maxWidgetStateBuckets {
+		return states
+	}
+
+	for b := uintptr(0); b < count; b++ {
+		node := uintptr(gd.Process.ReadUInt(buckets+8*b, Uint64))
+		for depth := 0; node != 0 && depth < maxWidgetStateChain; depth++ {
+			key := uint64(gd.Process.ReadUInt(node+8, Uint64))
+			states[key] = gd.widgetStateValue(node)
+			node = uintptr(gd.Process.ReadUInt(node, Uint64))
+		}
+	}
+
+	return states
+}
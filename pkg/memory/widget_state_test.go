@@ -0,0 +1,49 @@
+package memory
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWidgetStates(t *testing.T) {
+	const base = 0x140000000
+	mem := fakeMemory{base: base, data: make([]byte, 0x2000)}
+	put := func(offset, value uint64) { binary.LittleEndian.PutUint64(mem.data[offset:], value) }
+	gd := NewGameReaderWithOffset(NewProcessFromSource(mem, mem.base, uint32(len(mem.data))), Offset{WidgetStatesOffset: 0x10})
+
+	// Hash map with 2 buckets at 0x200, so some keys share a chain, nodes from 0x400 (next, key, pointer to the value holder)
+	put(0x10, base+0x100)
+	put(0x100, 2)
+	put(0x108, base+0x200)
+
+	values := map[uint64]byte{WidgetStateFlags[WidgetStateWeaponSwap]: 1, 0x1234: 7, 0x5678: 0, 0x9ABC: 3}
+	node := uint64(0x400)
+	for key, value := range values {
+		bucket := 0x200 + 8*(widgetStateHash(key)&1)
+		// Push the node at the head of the bucket chain
+		put(node, binary.LittleEndian.Uint64(mem.data[bucket:]))
+		put(bucket, base+node)
+		put(node+8, key)
+		put(node+16, base+node+0x20)
+		put(node+0x20+16, base+node+0x40)
+		mem.data[node+0x40] = value
+		node += 0x80
+	}
+
+	states := gd.GetWidgetStates()
+	require.Len(t, states, len(values))
+	for key, value := range values {
+		require.Equal(t, int(value), states[key], "key 0x%X", key)
+		got, err := gd.GetWidgetState(key)
+		require.NoError(t, err)
+		require.Equal(t, int(value), got, "key 0x%X", key)
+	}
+
+	swap, err := gd.GetNamedWidgetState(WidgetStateWeaponSwap)
+	require.NoError(t, err)
+	require.Equal(t, 1, swap)
+	_, err = gd.GetNamedWidgetState("ShowItems")
+	require.ErrorIs(t, err, ErrUnknownWidgetState)
+}
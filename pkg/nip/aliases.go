@@ -121,6 +121,16 @@ var classAliases = map[string]int{
 	"elite":       2,
 }
 
+// Item flags as stored in memory, kolbot style
+var flagAliases = map[string]int{
+	"identified": 0x10,
+	"broken":     0x100,
+	"socketed":   0x800,
+	"eth":        0x400000,
+	"ethereal":   0x400000,
+	"runeword":   0x4000000,
+}
+
 var StatAliases = statAliases
 var statAliases = map[string][]int{
 	"strength":         {0},
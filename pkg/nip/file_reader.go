@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"path/filepath"
 	"strings"
 
 	"github.com/hectorgimenez/d2go/pkg/data"
@@ -23,7 +24,7 @@ func ReadDir(path string) (Rules, error) {
 			continue
 		}
 
-		newRules, err := ParseNIPFile(path + file.Name())
+		newRules, err := ParseNIPFile(filepath.Join(path, file.Name()))
 		if err != nil {
 			return nil, err
 		}
@@ -1,6 +1,7 @@
 package nip
 
 import (
+	"errors"
 	"fmt"
 	"regexp"
 	"strconv"
@@ -43,17 +44,29 @@ type Rule struct {
 type RuleResult int
 type Rules []Rule
 
+// EvaluateAll returns the first rule fully matching the item, or the last partial match if there is no full match.
+// Rules failing to evaluate are skipped, use EvaluateAllE to get their errors
 func (r Rules) EvaluateAll(it data.Item) (Rule, RuleResult) {
+	rule, result, _ := r.EvaluateAllE(it)
+
+	return rule, result
+}
+
+// EvaluateAllE works like EvaluateAll but also returns the errors of the rules that failed to evaluate, joined and
+// prefixed by the rule location. The result is still valid when there are errors
+func (r Rules) EvaluateAllE(it data.Item) (Rule, RuleResult, error) {
 	bestMatch := RuleResultNoMatch
 	bestMatchingRule := Rule{}
+	var errs []error
 	for _, rule := range r {
 		if rule.Enabled {
 			result, err := rule.Evaluate(it)
 			if err != nil {
+				errs = append(errs, fmt.Errorf("%s: %w", rule.Location(), err))
 				continue
 			}
 			if result == RuleResultFullMatch {
-				return rule, result
+				return rule, result, errors.Join(errs...)
 			}
 			if result == RuleResultPartial {
 				bestMatch = result
@@ -62,7 +75,7 @@ func (r Rules) EvaluateAll(it data.Item) (Rule, RuleResult) {
 		}
 	}
 
-	return bestMatchingRule, bestMatch
+	return bestMatchingRule, bestMatch, errors.Join(errs...)
 }
 
 func (r Rules) EvaluateAllIgnoreTiers(it data.Item) (Rule, RuleResult) {
@@ -116,7 +129,18 @@ func (r Rules) EvaluateTiers(it data.Item, tierRulesIndexes []int) (Rule, Rule)
 	return highestTierRule, highestMercTierRule
 }
 
-var fixedPropsList = map[string]int{"type": 0, "quality": 0, "class": 0, "name": 0, "flag": 0, "color": 0, "prefix": 0, "suffix": 0}
+var fixedPropsList = map[string]int{"type": 0, "quality": 0, "class": 0, "name": 0, "flag": 0, "color": 0, "prefix": 0, "suffix": 0, "level": 0}
+
+func init() {
+	// Each flag is evaluated on its own, so [flag] == ethereal matches ethereal runewords too
+	for flag := range flagAliases {
+		fixedPropsList[flagProperty(flag)] = 0
+	}
+}
+
+func flagProperty(flag string) string {
+	return "flag" + flag
+}
 
 func NewRule(rawRule string, filename string, lineNumber int) (Rule, error) {
 	rule := sanitizeLine(rawRule)
@@ -251,6 +275,8 @@ func (r Rule) Evaluate(it data.Item) (RuleResult, error) {
 			stage1Props["class"] = int(it.Desc().Tier())
 		case "name":
 			stage1Props["name"] = it.ID
+		case "level":
+			stage1Props["level"] = it.ItemLevel
 		case "flag":
 			// 0x400000 (eth) | 0x4000000 (runeword) kolbot style
 			currentFlag := 0
@@ -263,6 +289,22 @@ func (r Rule) Evaluate(it data.Item) (RuleResult, error) {
 			}
 
 			stage1Props["flag"] = currentFlag
+
+			if it.Identified {
+				currentFlag |= 0x10
+			}
+			if it.IsBroken {
+				currentFlag |= 0x100
+			}
+			if it.HasSockets {
+				currentFlag |= 0x800
+			}
+			for flag, value := range flagAliases {
+				stage1Props[flagProperty(flag)] = 0
+				if currentFlag&value != 0 {
+					stage1Props[flagProperty(flag)] = 1
+				}
+			}
 		case "prefix":
 			if it.Affixes.Rare.Prefix != 0 {
 				stage1Props["prefix"] = int(it.Affixes.Rare.Prefix)
@@ -472,6 +514,12 @@ func replaceStringPropertiesInStage1(stage1 string) (string, error) {
 		case "name":
 			replaceWith = strings.ReplaceAll(prop[0], prop[4], fmt.Sprintf("%d", item.GetIDByName(prop[4])))
 		case "flag":
+			// Known flags compared by equality are checked individually, the item can have more than one flag set
+			if _, found := flagAliases[prop[4]]; found && (prop[3] == "==" || prop[3] == "!=") {
+				replaceWith = fmt.Sprintf("[%s] %s 1", flagProperty(prop[4]), prop[3])
+				break
+			}
+
 			val := 0
 			switch strings.ToLower(prop[4]) {
 			case "runeword":
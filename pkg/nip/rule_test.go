@@ -70,6 +70,43 @@ func TestRule_Evaluate(t *testing.T) {
 			},
 			want: RuleResultFullMatch,
 		},
+		{
+			name: "Ethereal runeword matches both flags",
+			fields: fields{
+				RawLine:  "[type] == polearm && [flag] == ethereal && [flag] == runeword && [flag] != broken",
+				Filename: "test.nip",
+				Enabled:  true,
+			},
+			args: args{
+				item: data.Item{
+					ID:         258,
+					Name:       "GiantThresher",
+					Quality:    item.QualitySuperior,
+					Ethereal:   true,
+					IsRuneword: true,
+					Identified: true,
+					HasSockets: true,
+				},
+			},
+			want: RuleResultFullMatch,
+		},
+		{
+			name: "Item level is evaluated on the first stage",
+			fields: fields{
+				RawLine:  "[name] == grandcharm && [quality] == magic && [level] >= 91",
+				Filename: "test.nip",
+				Enabled:  true,
+			},
+			args: args{
+				item: data.Item{
+					ID:        605,
+					Name:      "GrandCharm",
+					Quality:   item.QualityMagic,
+					ItemLevel: 87,
+				},
+			},
+			want: RuleResultNoMatch,
+		},
 		{
 			name: "Armor with +3 Sorc skills",
 			fields: fields{
@@ -228,6 +265,22 @@ func TestNew(t *testing.T) {
 	}
 }
 
+func TestRules_EvaluateAllE(t *testing.T) {
+	amulet, err := NewRule("[type] == amulet && [quality] == unique", "test.nip", 2)
+	require.NoError(t, err)
+
+	// A rule without compiled program fails to evaluate, the error is returned but the other rules still match
+	rules := Rules{{Filename: "test.nip", LineNumber: 1, Enabled: true}, amulet}
+	rule, result, err := rules.EvaluateAllE(data.Item{ID: 520, Name: "Amulet", Quality: item.QualityUnique})
+	require.ErrorContains(t, err, "test.nip:1")
+	require.Equal(t, RuleResultFullMatch, result)
+	require.Equal(t, "test.nip:2", rule.Location())
+
+	rule, result = rules.EvaluateAll(data.Item{ID: 520, Name: "Amulet", Quality: item.QualityUnique})
+	require.Equal(t, RuleResultFullMatch, result)
+	require.Equal(t, "test.nip:2", rule.Location())
+}
+
 func BenchmarkEvaluate(b *testing.B) {
 	it := data.Item{
 		ID:      0,
@@ -0,0 +1,69 @@
+package nip
+
+import (
+	"fmt"
+
+	"github.com/hectorgimenez/d2go/pkg/data"
+)
+
+// RuleTrace is the result of evaluating a single rule against an item
+type RuleTrace struct {
+	Rule   Rule
+	Result RuleResult
+	Err    error
+}
+
+func (t RuleTrace) String() string {
+	if t.Err != nil {
+		return fmt.Sprintf("%s: error: %v", t.Rule.Location(), t.Err)
+	}
+
+	return fmt.Sprintf("%s: %s: %s", t.Rule.Location(), t.Result, t.Rule.RawLine)
+}
+
+func (r RuleResult) String() string {
+	switch r {
+	case RuleResultFullMatch:
+		return "full match"
+	case RuleResultPartial:
+		return "partial match"
+	case RuleResultNoMatch:
+		return "no match"
+	}
+
+	return fmt.Sprintf("unknown result %d", int(r))
+}
+
+// Location returns the file and line where the rule is defined, in file:line format
+func (r Rule) Location() string {
+	return fmt.Sprintf("%s:%d", r.Filename, r.LineNumber)
+}
+
+// Trace evaluates every enabled rule against the item and returns the result of each one in the same order, it's
+// slower than EvaluateAll but useful to find out why an item was kept or not
+func (r Rules) Trace(it data.Item) []RuleTrace {
+	traces := make([]RuleTrace, 0, len(r))
+	for _, rule := range r {
+		if !rule.Enabled {
+			continue
+		}
+
+		result, err := rule.Evaluate(it)
+		traces = append(traces, RuleTrace{Rule: rule, Result: result, Err: err})
+	}
+
+	return traces
+}
+
+// Matching returns the traces that fully or partially match the item, the one picked by EvaluateAll is the first
+// full match or the last partial match if there is no full match
+func Matching(traces []RuleTrace) []RuleTrace {
+	matching := make([]RuleTrace, 0)
+	for _, t := range traces {
+		if t.Err == nil && t.Result != RuleResultNoMatch {
+			matching = append(matching, t)
+		}
+	}
+
+	return matching
+}
@@ -0,0 +1,153 @@
+package nip
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Watcher keeps the rules of a directory up to date, reloading them when any .nip file is added, modified or removed.
+// Files are polled, so it works the same on every platform without extra dependencies
+type Watcher struct {
+	path     string
+	interval time.Duration
+	mu       sync.RWMutex
+	rules    Rules
+	files    map[string]fileState
+	onReload func(Rules, error)
+}
+
+type fileState struct {
+	modTime time.Time
+	size    int64
+}
+
+// NewWatcher reads the rules from the directory, it fails if the initial rules can not be parsed. Changes are not
+// detected until Start is called
+func NewWatcher(path string, interval time.Duration) (*Watcher, error) {
+	w := &Watcher{path: path, interval: interval}
+
+	files, err := w.scan()
+	if err != nil {
+		return nil, err
+	}
+	rules, err := ReadDir(path)
+	if err != nil {
+		return nil, err
+	}
+
+	w.files = files
+	w.rules = rules
+
+	return w, nil
+}
+
+// Rules returns the latest valid rules, if a reload fails the previous rules are kept
+func (w *Watcher) Rules() Rules {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+
+	return w.rules
+}
+
+// OnReload sets a callback executed after every reload attempt, err is not nil if the new rules have errors
+func (w *Watcher) OnReload(fn func(rules Rules, err error)) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.onReload = fn
+}
+
+// Start polls the directory for changes until the context is cancelled
+func (w *Watcher) Start(ctx context.Context) {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			w.Reload(false)
+		}
+	}
+}
+
+// Reload reads the rules again if any file changed since the last reload, or always if force is true. It returns
+// true if the rules have been replaced
+func (w *Watcher) Reload(force bool) bool {
+	files, err := w.scan()
+	if err != nil {
+		w.notify(nil, err)
+		return false
+	}
+
+	w.mu.RLock()
+	changed := force || !sameFiles(w.files, files)
+	w.mu.RUnlock()
+	if !changed {
+		return false
+	}
+
+	rules, err := ReadDir(w.path)
+
+	w.mu.Lock()
+	// Files are stored even on error, so a broken file is not parsed again until it changes
+	w.files = files
+	if err == nil {
+		w.rules = rules
+	}
+	w.mu.Unlock()
+
+	w.notify(rules, err)
+
+	return err == nil
+}
+
+func (w *Watcher) notify(rules Rules, err error) {
+	w.mu.RLock()
+	fn := w.onReload
+	w.mu.RUnlock()
+
+	if fn != nil {
+		fn(rules, err)
+	}
+}
+
+func (w *Watcher) scan() (map[string]fileState, error) {
+	entries, err := os.ReadDir(w.path)
+	if err != nil {
+		return nil, err
+	}
+
+	files := make(map[string]fileState)
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(strings.ToLower(entry.Name()), ".nip") {
+			continue
+		}
+
+		info, err := os.Stat(filepath.Join(w.path, entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+		files[entry.Name()] = fileState{modTime: info.ModTime(), size: info.Size()}
+	}
+
+	return files, nil
+}
+
+func sameFiles(a, b map[string]fileState) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for name, state := range a {
+		if other, found := b[name]; !found || !other.modTime.Equal(state.modTime) || other.size != state.size {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,40 @@
+package nip
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/hectorgimenez/d2go/pkg/data"
+	"github.com/hectorgimenez/d2go/pkg/data/item"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWatcher_Reload(t *testing.T) {
+	dir := t.TempDir()
+	file := filepath.Join(dir, "test.nip")
+	require.NoError(t, os.WriteFile(file, []byte("[type] == ring && [quality] == unique\n"), 0644))
+
+	w, err := NewWatcher(dir, time.Second)
+	require.NoError(t, err)
+	require.Len(t, w.Rules(), 1)
+	require.False(t, w.Reload(false))
+
+	// Broken rules are reported and the previous rules are kept
+	var reloadErr error
+	w.OnReload(func(_ Rules, err error) { reloadErr = err })
+	require.NoError(t, os.WriteFile(file, []byte("[type] == ring && [quality] == \n"), 0644))
+	require.False(t, w.Reload(false))
+	require.Error(t, reloadErr)
+	require.Len(t, w.Rules(), 1)
+
+	require.NoError(t, os.WriteFile(file, []byte("[type] == ring && [quality] == unique\n[type] == amulet && [quality] == unique\n"), 0644))
+	require.True(t, w.Reload(false))
+	require.NoError(t, reloadErr)
+	require.Len(t, w.Rules(), 2)
+
+	traces := Matching(w.Rules().Trace(data.Item{ID: 520, Name: "Amulet", Quality: item.QualityUnique}))
+	require.Len(t, traces, 1)
+	require.Equal(t, file+":2", traces[0].Rule.Location())
+}
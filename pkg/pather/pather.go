@@ -0,0 +1,212 @@
+// Package pather finds paths over a data.CollisionGrid, for walking and teleporting characters. Paths are returned in
+// world coordinates, the same ones used by the memory reader. The memory reader doesn't read the collision maps, the
+// grid has to be built by the caller from its own map data.
+package pather
+
+import (
+	"container/heap"
+	"math"
+
+	"github.com/hectorgimenez/d2go/pkg/data"
+)
+
+// Path are the positions to go through, it doesn't include the starting position
+type Path []data.Position
+
+// Distance returns the length of the path starting from the given position, in sub-tiles
+func (p Path) Distance(from data.Position) float64 {
+	distance := 0.0
+	for _, pos := range p {
+		distance += euclidean(from, pos)
+		from = pos
+	}
+
+	return distance
+}
+
+// Waypoints returns the path removing the intermediate positions of straight segments, so only the positions where
+// the direction changes are kept
+func (p Path) Waypoints(from data.Position) Path {
+	if len(p) < 2 {
+		return p
+	}
+
+	waypoints := make(Path, 0)
+	prev := from
+	for i := 0; i < len(p)-1; i++ {
+		if direction(prev, p[i]) != direction(p[i], p[i+1]) {
+			waypoints = append(waypoints, p[i])
+		}
+		prev = p[i]
+	}
+
+	return append(waypoints, p[len(p)-1])
+}
+
+var walkDirections = []data.Position{
+	{X: 1, Y: 0}, {X: -1, Y: 0}, {X: 0, Y: 1}, {X: 0, Y: -1},
+	{X: 1, Y: 1}, {X: 1, Y: -1}, {X: -1, Y: 1}, {X: -1, Y: -1},
+}
+
+// FindWalkPath returns the shortest walking path between both positions, moving in 8 directions. Diagonal moves
+// between two blocked sub-tiles are not allowed, the character can't squeeze through wall corners. The destination
+// doesn't need to be walkable, so entrances and stairs placed on walls can be reached
+func FindWalkPath(g data.CollisionGrid, from, to data.Position) (Path, bool) {
+	return find(from, to, euclidean, func(p data.Position, visit func(data.Position, float64)) {
+		for _, d := range walkDirections {
+			next := data.Position{X: p.X + d.X, Y: p.Y + d.Y}
+			if next != to && !g.IsWalkable(next) {
+				continue
+			}
+			if d.X != 0 && d.Y != 0 &&
+				(!g.IsWalkable(data.Position{X: p.X + d.X, Y: p.Y}) || !g.IsWalkable(data.Position{X: p.X, Y: p.Y + d.Y})) {
+				continue
+			}
+			visit(next, euclidean(p, next))
+		}
+	})
+}
+
+// FindWalkPathThroughBarriers returns the shortest walking path considering closed doors and barricades as open, and
+// the barriers that have to be opened or broken to follow it, in the order they are reached. Sealed gates are still
+// considered walls
+func FindWalkPathThroughBarriers(g data.CollisionGrid, from, to data.Position, barriers []data.Barrier) (Path, []data.Barrier, bool) {
+	path, found := FindWalkPath(g.WithoutBarriers(barriers), from, to)
+	if !found {
+		return nil, nil, false
+	}
+
+	crossed := make([]data.Barrier, 0)
+	opened := make(map[data.UnitID]bool)
+	for _, p := range path {
+		for _, b := range barriers {
+			if b.CanBeOpened() && !opened[b.Object.ID] && b.Covers(p) {
+				opened[b.Object.ID] = true
+				crossed = append(crossed, b)
+			}
+		}
+	}
+
+	return path, crossed, true
+}
+
+// Amount of directions tried on every teleport, more directions find better paths but are slower
+const teleportDirections = 32
+
+// FindTeleportPath returns the path with less teleports between both positions. Teleport ignores the collisions in
+// between, only the landing position needs to be walkable, except for the destination
+func FindTeleportPath(g data.CollisionGrid, from, to data.Position, maxDistance int) (Path, bool) {
+	if maxDistance <= 0 {
+		return nil, false
+	}
+
+	hops := func(a, b data.Position) float64 {
+		return math.Ceil(euclidean(a, b) / float64(maxDistance))
+	}
+
+	return find(from, to, hops, func(p data.Position, visit func(data.Position, float64)) {
+		if euclidean(p, to) <= float64(maxDistance) {
+			visit(to, 1)
+			return
+		}
+
+		for i := range teleportDirections {
+			angle := 2 * math.Pi * float64(i) / teleportDirections
+			// When the position is blocked, try closer landing positions on the same direction
+			for distance := maxDistance; distance > maxDistance/2; distance-- {
+				next := data.Position{
+					X: p.X + int(math.Round(math.Cos(angle)*float64(distance))),
+					Y: p.Y + int(math.Round(math.Sin(angle)*float64(distance))),
+				}
+				if g.IsWalkable(next) {
+					visit(next, 1)
+					break
+				}
+			}
+		}
+	})
+}
+
+func find(from, to data.Position, heuristic func(a, b data.Position) float64, neighbors func(data.Position, func(data.Position, float64))) (Path, bool) {
+	if from == to {
+		return Path{}, true
+	}
+
+	cost := map[data.Position]float64{from: 0}
+	cameFrom := make(map[data.Position]data.Position)
+	open := &nodeQueue{{position: from, priority: heuristic(from, to)}}
+	closed := make(map[data.Position]bool)
+
+	for open.Len() > 0 {
+		current := heap.Pop(open).(node).position
+		if current == to {
+			return buildPath(cameFrom, from, to), true
+		}
+		if closed[current] {
+			continue
+		}
+		closed[current] = true
+
+		neighbors(current, func(next data.Position, stepCost float64) {
+			newCost := cost[current] + stepCost
+			if c, found := cost[next]; found && c <= newCost {
+				return
+			}
+			cost[next] = newCost
+			cameFrom[next] = current
+			heap.Push(open, node{position: next, priority: newCost + heuristic(next, to)})
+		})
+	}
+
+	return nil, false
+}
+
+func buildPath(cameFrom map[data.Position]data.Position, from, to data.Position) Path {
+	path := Path{to}
+	for p := cameFrom[to]; p != from; p = cameFrom[p] {
+		path = append(path, p)
+	}
+	for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
+		path[i], path[j] = path[j], path[i]
+	}
+
+	return path
+}
+
+func euclidean(a, b data.Position) float64 {
+	return math.Hypot(float64(a.X-b.X), float64(a.Y-b.Y))
+}
+
+func direction(a, b data.Position) data.Position {
+	return data.Position{X: sign(b.X - a.X), Y: sign(b.Y - a.Y)}
+}
+
+func sign(v int) int {
+	switch {
+	case v > 0:
+		return 1
+	case v < 0:
+		return -1
+	}
+
+	return 0
+}
+
+type node struct {
+	position data.Position
+	priority float64
+}
+
+type nodeQueue []node
+
+func (q nodeQueue) Len() int           { return len(q) }
+func (q nodeQueue) Less(i, j int) bool { return q[i].priority < q[j].priority }
+func (q nodeQueue) Swap(i, j int)      { q[i], q[j] = q[j], q[i] }
+func (q *nodeQueue) Push(x any)        { *q = append(*q, x.(node)) }
+func (q *nodeQueue) Pop() any {
+	old := *q
+	n := old[len(old)-1]
+	*q = old[:len(old)-1]
+
+	return n
+}
@@ -0,0 +1,72 @@
+package pather
+
+import (
+	"testing"
+
+	"github.com/hectorgimenez/d2go/pkg/data"
+	"github.com/hectorgimenez/d2go/pkg/data/object"
+	"github.com/stretchr/testify/require"
+)
+
+// grid returns a 20x20 grid with a vertical wall on x=10, leaving a gap on y=19
+func grid() data.CollisionGrid {
+	g := data.CollisionGrid{Origin: data.Position{X: 1000, Y: 1000}, Width: 20, Height: 20, Flags: make([]data.Collision, 400)}
+	for y := 0; y < 19; y++ {
+		g.Flags[y*20+10] = data.CollisionWall
+	}
+
+	return g
+}
+
+func TestFindWalkPath(t *testing.T) {
+	g := grid()
+	from, to := data.Position{X: 1005, Y: 1005}, data.Position{X: 1015, Y: 1005}
+
+	path, found := FindWalkPath(g, from, to)
+	require.True(t, found)
+	require.Equal(t, to, path[len(path)-1])
+	for _, p := range path {
+		require.True(t, g.IsWalkable(p), "%v is not walkable", p)
+	}
+	require.Contains(t, path, data.Position{X: 1010, Y: 1019})
+
+	// Closing the gap, the wall corner can't be cut diagonally
+	g.Flags[19*20+10] = data.CollisionWall
+	_, found = FindWalkPath(g, from, to)
+	require.False(t, found)
+}
+
+func TestFindTeleportPath(t *testing.T) {
+	g := grid()
+	g.Flags[19*20+10] = data.CollisionWall
+	from, to := data.Position{X: 1005, Y: 1005}, data.Position{X: 1015, Y: 1005}
+
+	path, found := FindTeleportPath(g, from, to, 25)
+	require.True(t, found)
+	require.Equal(t, Path{to}, path)
+
+	path, found = FindTeleportPath(g, from, data.Position{X: 1019, Y: 1019}, 8)
+	require.True(t, found)
+	require.Len(t, path, 3)
+}
+
+func TestFindWalkPathThroughBarriers(t *testing.T) {
+	g := grid()
+	g.Flags[19*20+10] = data.CollisionWall
+	from, to := data.Position{X: 1005, Y: 1005}, data.Position{X: 1015, Y: 1005}
+
+	door, _ := data.Object{ID: 1, Name: object.DoorWoodenLeft, Position: data.Position{X: 1010, Y: 1005}}.Barrier()
+	for _, p := range door.Footprint() {
+		g.Flags[(p.Y-g.Origin.Y)*20+p.X-g.Origin.X] = data.CollisionDoor
+	}
+
+	path, crossed, found := FindWalkPathThroughBarriers(g, from, to, []data.Barrier{door})
+	require.True(t, found)
+	require.Equal(t, to, path[len(path)-1])
+	require.Equal(t, []data.Barrier{door}, crossed)
+
+	// Sealed gates are not opened by the player
+	door.Kind = data.BarrierSealed
+	_, _, found = FindWalkPathThroughBarriers(g, from, to, []data.Barrier{door})
+	require.False(t, found)
+}
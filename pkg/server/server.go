@@ -0,0 +1,260 @@
+// Package server exposes the game data over HTTP, so the package can run as a sidecar daemon for consumers not written
+// in Go (overlays, analytics...). Data is exported using the pkg/data/export schema.
+//
+// Endpoints:
+//   - GET /snapshot returns the last game state as JSON
+//   - GET /ws upgrades to a WebSocket, the game state is pushed as a JSON text message every time it's refreshed
+//
+// Browsers let any web page open a WebSocket to localhost, so cross-origin WebSocket handshakes are rejected unless the
+// origin is allowed with WithAllowedOrigins. Clients not running in a browser don't send an Origin header and are allowed.
+// The game password is never exported.
+package server
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hectorgimenez/d2go/pkg/data"
+	"github.com/hectorgimenez/d2go/pkg/data/export"
+)
+
+const defaultInterval = 100 * time.Millisecond
+
+// DataSource is implemented by memory.GameReader
+type DataSource interface {
+	GetData() data.Data
+}
+
+type Option func(s *Server)
+
+// WithInterval sets how often the game data is refreshed and pushed to the clients
+func WithInterval(interval time.Duration) Option {
+	return func(s *Server) {
+		s.interval = interval
+	}
+}
+
+// WithAllowedOrigins allows WebSocket connections from pages served from the given origins (e.g. "http://localhost:3000"
+// or "null" for Electron file:// pages), besides the same origin ones
+func WithAllowedOrigins(origins ...string) Option {
+	return func(s *Server) {
+		for _, origin := range origins {
+			s.allowedOrigins[strings.ToLower(origin)] = struct{}{}
+		}
+	}
+}
+
+func WithLogger(logger *slog.Logger) Option {
+	return func(s *Server) {
+		s.logger = logger
+	}
+}
+
+type Server struct {
+	source         DataSource
+	interval       time.Duration
+	logger         *slog.Logger
+	allowedOrigins map[string]struct{}
+
+	mu       sync.Mutex
+	last     []byte
+	clients  map[*client]struct{}
+	handlers *http.ServeMux
+}
+
+type client struct {
+	conn     *wsConn
+	messages chan []byte
+}
+
+func New(source DataSource, opts ...Option) *Server {
+	s := &Server{
+		source:         source,
+		interval:       defaultInterval,
+		logger:         slog.Default(),
+		allowedOrigins: make(map[string]struct{}),
+		clients:        make(map[*client]struct{}),
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	s.handlers = http.NewServeMux()
+	s.handlers.HandleFunc("/snapshot", s.handleSnapshot)
+	s.handlers.HandleFunc("/ws", s.handleWebsocket)
+
+	return s
+}
+
+func (s *Server) Handler() http.Handler {
+	return s.handlers
+}
+
+// ListenAndServe starts the HTTP server and the refresh loop, it blocks until the context is cancelled
+func (s *Server) ListenAndServe(ctx context.Context, addr string) error {
+	httpServer := &http.Server{Addr: addr, Handler: s.Handler()}
+
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- httpServer.ListenAndServe()
+	}()
+	go s.Run(ctx)
+
+	select {
+	case err := <-errCh:
+		return err
+	case <-ctx.Done():
+	}
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	s.closeClients()
+	if err := httpServer.Shutdown(shutdownCtx); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+
+	return nil
+}
+
+// Run refreshes the game data at the configured interval and pushes it to the connected clients until the context is
+// cancelled. The data source is only used from this goroutine, GameReader is not safe for concurrent use
+func (s *Server) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		s.refresh()
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *Server) refresh() {
+	payload, err := export.Marshal(s.source.GetData())
+	if err != nil {
+		s.logger.Error("error marshaling game data", slog.Any("error", err))
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.last = payload
+	for c := range s.clients {
+		// Slow clients only get the latest state, pending messages are dropped
+		select {
+		case <-c.messages:
+		default:
+		}
+		c.messages <- payload
+	}
+}
+
+func (s *Server) handleSnapshot(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	payload := s.last
+	s.mu.Unlock()
+
+	if payload == nil {
+		http.Error(w, "game data not available yet", http.StatusServiceUnavailable)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(payload)
+}
+
+// originAllowed returns true for requests without Origin (not sent by a browser), same origin requests and the origins
+// allowed with WithAllowedOrigins
+func (s *Server) originAllowed(r *http.Request) bool {
+	origin := r.Header.Get("Origin")
+	if origin == "" {
+		return true
+	}
+	if _, found := s.allowedOrigins[strings.ToLower(origin)]; found {
+		return true
+	}
+
+	u, err := url.Parse(origin)
+	if err != nil {
+		return false
+	}
+
+	return strings.EqualFold(u.Host, r.Host)
+}
+
+func (s *Server) handleWebsocket(w http.ResponseWriter, r *http.Request) {
+	if !s.originAllowed(r) {
+		s.logger.Warn("websocket connection from a not allowed origin rejected", slog.String("origin", r.Header.Get("Origin")))
+		http.Error(w, "origin not allowed", http.StatusForbidden)
+		return
+	}
+
+	conn, err := upgradeWebsocket(w, r)
+	if err != nil {
+		if errors.Is(err, errNotWebsocket) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		} else {
+			s.logger.Warn("websocket upgrade failed", slog.Any("error", err))
+		}
+		return
+	}
+
+	c := &client{conn: conn, messages: make(chan []byte, 1)}
+	s.mu.Lock()
+	s.clients[c] = struct{}{}
+	if s.last != nil {
+		c.messages <- s.last
+	}
+	s.mu.Unlock()
+	s.logger.Debug("websocket client connected", slog.String("remote", r.RemoteAddr))
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		conn.ReadLoop()
+	}()
+
+	defer func() {
+		s.mu.Lock()
+		delete(s.clients, c)
+		s.mu.Unlock()
+		conn.Close()
+		s.logger.Debug("websocket client disconnected", slog.String("remote", r.RemoteAddr))
+	}()
+
+	for {
+		select {
+		case <-done:
+			return
+		case payload, ok := <-c.messages:
+			if !ok {
+				conn.writeFrame(opClose, nil)
+				return
+			}
+			if err = conn.WriteText(payload); err != nil {
+				return
+			}
+		}
+	}
+}
+
+func (s *Server) closeClients() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for c := range s.clients {
+		close(c.messages)
+		delete(s.clients, c)
+	}
+}
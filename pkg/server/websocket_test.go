@@ -0,0 +1,160 @@
+package server
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// clientFrame builds a frame as sent by a client, client frames are always masked
+func clientFrame(opcode byte, payload []byte) []byte {
+	mask := [4]byte{0x12, 0x34, 0x56, 0x78}
+	frame := []byte{0x80 | opcode}
+	switch {
+	case len(payload) < 126:
+		frame = append(frame, 0x80|byte(len(payload)))
+	case len(payload) <= 0xFFFF:
+		frame = append(frame, 0x80|126)
+		frame = binary.BigEndian.AppendUint16(frame, uint16(len(payload)))
+	default:
+		frame = append(frame, 0x80|127)
+		frame = binary.BigEndian.AppendUint64(frame, uint64(len(payload)))
+	}
+	frame = append(frame, mask[:]...)
+	for i, b := range payload {
+		frame = append(frame, b^mask[i%4])
+	}
+
+	return frame
+}
+
+// readServerFrame reads an unmasked frame as sent by the server
+func readServerFrame(t *testing.T, r io.Reader) (byte, []byte) {
+	t.Helper()
+
+	var header [2]byte
+	if _, err := io.ReadFull(r, header[:]); err != nil {
+		t.Fatalf("error reading frame header: %v", err)
+	}
+	if header[0]&0x80 == 0 {
+		t.Error("server frame without FIN")
+	}
+	if header[1]&0x80 != 0 {
+		t.Error("server frames must not be masked")
+	}
+
+	length := uint64(header[1] & 0x7F)
+	switch length {
+	case 126:
+		var ext [2]byte
+		io.ReadFull(r, ext[:])
+		length = uint64(binary.BigEndian.Uint16(ext[:]))
+	case 127:
+		var ext [8]byte
+		io.ReadFull(r, ext[:])
+		length = binary.BigEndian.Uint64(ext[:])
+	}
+
+	payload := make([]byte, length)
+	if _, err := io.ReadFull(r, payload); err != nil {
+		t.Fatalf("error reading frame payload: %v", err)
+	}
+
+	return header[0] & 0x0F, payload
+}
+
+func pipe() (*wsConn, net.Conn) {
+	server, client := net.Pipe()
+
+	return &wsConn{conn: server, reader: bufio.NewReader(server)}, client
+}
+
+func TestWriteFrameLengths(t *testing.T) {
+	for _, length := range []int{0, 125, 126, 0xFFFF, 0x10000, 70000} {
+		conn, client := pipe()
+		payload := bytes.Repeat([]byte{'a'}, length)
+
+		go conn.WriteText(payload)
+		opcode, got := readServerFrame(t, client)
+		if opcode != opText {
+			t.Errorf("length %d: opcode %d, expected text", length, opcode)
+		}
+		if !bytes.Equal(got, payload) {
+			t.Errorf("length %d: payload of %d bytes received", length, len(got))
+		}
+
+		conn.Close()
+		client.Close()
+	}
+}
+
+func TestReadLoopPingAndClose(t *testing.T) {
+	conn, client := pipe()
+	defer client.Close()
+
+	done := make(chan error, 1)
+	go func() { done <- conn.ReadLoop() }()
+
+	// Masked payloads are unmasked before answering
+	client.Write(clientFrame(opPing, []byte("hello")))
+	if opcode, payload := readServerFrame(t, client); opcode != opPong || string(payload) != "hello" {
+		t.Errorf("expected pong with the ping payload, got opcode %d %q", opcode, payload)
+	}
+
+	// Data frames with 16 and 64 bits lengths are discarded, the stream stays in sync
+	client.Write(clientFrame(opText, bytes.Repeat([]byte{'b'}, 300)))
+	client.Write(clientFrame(opText, bytes.Repeat([]byte{'c'}, 0x10000)))
+	client.Write(clientFrame(opPing, []byte("again")))
+	if opcode, payload := readServerFrame(t, client); opcode != opPong || string(payload) != "again" {
+		t.Errorf("expected pong after discarded frames, got opcode %d %q", opcode, payload)
+	}
+
+	client.Write(clientFrame(opClose, nil))
+	if opcode, _ := readServerFrame(t, client); opcode != opClose {
+		t.Errorf("expected close frame answer, got opcode %d", opcode)
+	}
+	if err := <-done; !errors.Is(err, io.EOF) {
+		t.Errorf("ReadLoop returned %v, expected EOF", err)
+	}
+}
+
+func TestWebsocketOrigin(t *testing.T) {
+	srv := httptest.NewServer(New(nil, WithAllowedOrigins("http://localhost:3000")).Handler())
+	defer srv.Close()
+
+	for origin, expected := range map[string]int{
+		"":                      http.StatusSwitchingProtocols,
+		srv.URL:                 http.StatusSwitchingProtocols,
+		"http://localhost:3000": http.StatusSwitchingProtocols,
+		"https://example.com":   http.StatusForbidden,
+	} {
+		req, _ := http.NewRequest(http.MethodGet, srv.URL+"/ws", nil)
+		req.Header.Set("Upgrade", "websocket")
+		req.Header.Set("Connection", "Upgrade")
+		req.Header.Set("Sec-WebSocket-Key", "dGhlIHNhbXBsZSBub25jZQ==")
+		req.Header.Set("Sec-WebSocket-Version", "13")
+		if origin != "" {
+			req.Header.Set("Origin", origin)
+		}
+
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatalf("origin %q: %v", origin, err)
+		}
+		resp.Body.Close()
+
+		if resp.StatusCode != expected {
+			t.Errorf("origin %q: status %d, expected %d", origin, resp.StatusCode, expected)
+		}
+		// Accept key from the RFC 6455 example
+		if expected == http.StatusSwitchingProtocols && resp.Header.Get("Sec-WebSocket-Accept") != "s3pPLMBiTxaQ9kYGzzhZRbK+xOo=" {
+			t.Errorf("origin %q: wrong accept key %q", origin, resp.Header.Get("Sec-WebSocket-Accept"))
+		}
+	}
+}
@@ -0,0 +1,188 @@
+// Package stats collects per-run statistics (duration, experience, found items, deaths and chickens) from the
+// game data snapshots returned by the memory reader.
+package stats
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/hectorgimenez/d2go/pkg/data"
+	"github.com/hectorgimenez/d2go/pkg/data/stat"
+)
+
+// ItemFilter decides which picked up items are kept in the run stats, e.g. a NIP rule evaluation
+type ItemFilter func(data.Item) bool
+
+type Run struct {
+	Name             string        `json:"name"`
+	Start            time.Time     `json:"start"`
+	End              time.Time     `json:"end"` // Zero while the run is in progress
+	Duration         time.Duration `json:"duration"`
+	ExperienceGained int           `json:"experienceGained"`
+	Items            []data.Item   `json:"-"`
+	ItemNames        []string      `json:"items"`
+	Deaths           int           `json:"deaths"`
+	Chickens         int           `json:"chickens"`
+}
+
+type Totals struct {
+	Runs             int           `json:"runs"`
+	Duration         time.Duration `json:"duration"`
+	AverageDuration  time.Duration `json:"averageDuration"`
+	ExperienceGained int           `json:"experienceGained"`
+	Items            int           `json:"items"`
+	Deaths           int           `json:"deaths"`
+	Chickens         int           `json:"chickens"`
+}
+
+// Collector accumulates the run stats, it must be fed with every GetData snapshot while a run is in progress. It's not
+// safe for concurrent use
+type Collector struct {
+	filter  ItemFilter
+	runs    []Run
+	current *Run
+	startXP int
+	last    data.Data
+	hasLast bool
+	now     func() time.Time
+}
+
+// NewCollector returns a collector keeping the picked up items matching the filter, all of them if filter is nil
+func NewCollector(filter ItemFilter) *Collector {
+	if filter == nil {
+		filter = func(data.Item) bool { return true }
+	}
+
+	return &Collector{filter: filter, now: time.Now}
+}
+
+// StartRun starts a new run, ending the current one if any
+func (c *Collector) StartRun(name string) {
+	if c.current != nil {
+		c.EndRun()
+	}
+
+	c.current = &Run{Name: name, Start: c.now()}
+	c.hasLast = false
+}
+
+// EndRun ends the current run and stores it, it does nothing if there is no run in progress
+func (c *Collector) EndRun() {
+	if c.current == nil {
+		return
+	}
+
+	c.current.End = c.now()
+	c.current.Duration = c.current.End.Sub(c.current.Start)
+	c.runs = append(c.runs, *c.current)
+	c.current = nil
+}
+
+// Update feeds a new game data snapshot to the current run, it does nothing if there is no run in progress
+func (c *Collector) Update(d data.Data) {
+	if c.current == nil {
+		return
+	}
+
+	xp, _ := d.PlayerUnit.FindStat(stat.Experience, 0)
+	if !c.hasLast {
+		c.startXP = xp.Value
+		c.last = d
+		c.hasLast = true
+		return
+	}
+
+	// Experience is lost on death, we only count what was gained
+	if xp.Value-c.startXP > c.current.ExperienceGained {
+		c.current.ExperienceGained = xp.Value - c.startXP
+	}
+	if d.PlayerUnit.IsDead() && !c.last.PlayerUnit.IsDead() {
+		c.current.Deaths++
+	}
+	for _, i := range data.Diff(c.last, d).ItemsPickedUp {
+		if c.filter(i) {
+			c.current.Items = append(c.current.Items, i)
+			c.current.ItemNames = append(c.current.ItemNames, string(i.Name))
+		}
+	}
+
+	c.last = d
+}
+
+// Chicken records a chicken (leaving the game to avoid a death) on the current run, chickens are a bot decision so the
+// collector can't detect them
+func (c *Collector) Chicken() {
+	if c.current != nil {
+		c.current.Chickens++
+	}
+}
+
+// Current returns the run in progress, with the duration up to now
+func (c *Collector) Current() (Run, bool) {
+	if c.current == nil {
+		return Run{}, false
+	}
+
+	r := *c.current
+	r.Duration = c.now().Sub(r.Start)
+
+	return r, true
+}
+
+// Runs returns all the finished runs, oldest first
+func (c *Collector) Runs() []Run {
+	return append([]Run(nil), c.runs...)
+}
+
+// Totals returns the stats of all the finished runs added up
+func (c *Collector) Totals() Totals {
+	t := Totals{Runs: len(c.runs)}
+	for _, r := range c.runs {
+		t.Duration += r.Duration
+		t.ExperienceGained += r.ExperienceGained
+		t.Items += len(r.Items)
+		t.Deaths += r.Deaths
+		t.Chickens += r.Chickens
+	}
+	if t.Runs > 0 {
+		t.AverageDuration = t.Duration / time.Duration(t.Runs)
+	}
+
+	return t
+}
+
+// MarshalJSON returns the finished runs and their totals as JSON
+func (c *Collector) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Runs   []Run  `json:"runs"`
+		Totals Totals `json:"totals"`
+	}{Runs: c.Runs(), Totals: c.Totals()})
+}
+
+// WriteCSV writes the finished runs as CSV, one row per run. Durations are in seconds
+func (c *Collector) WriteCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write([]string{"name", "start", "duration", "experience", "items", "deaths", "chickens"}); err != nil {
+		return err
+	}
+	for _, r := range c.runs {
+		row := []string{
+			r.Name,
+			r.Start.Format(time.RFC3339),
+			strconv.FormatFloat(r.Duration.Seconds(), 'f', 1, 64),
+			strconv.Itoa(r.ExperienceGained),
+			strconv.Itoa(len(r.Items)),
+			strconv.Itoa(r.Deaths),
+			strconv.Itoa(r.Chickens),
+		}
+		if err := cw.Write(row); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+
+	return cw.Error()
+}
@@ -0,0 +1,52 @@
+package stats
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/hectorgimenez/d2go/pkg/data"
+	"github.com/hectorgimenez/d2go/pkg/data/item"
+	"github.com/hectorgimenez/d2go/pkg/data/mode"
+	"github.com/hectorgimenez/d2go/pkg/data/stat"
+	"github.com/stretchr/testify/require"
+)
+
+func snapshot(xp, life int, items ...data.Item) data.Data {
+	return data.Data{
+		PlayerUnit: data.PlayerUnit{
+			Stats: stat.Stats{{ID: stat.Experience, Value: xp}, {ID: stat.Life, Value: life}, {ID: stat.MaxLife, Value: 100}},
+			Mode:  mode.StandingOutsideTown,
+		},
+		Inventory: data.Inventory{AllItems: items},
+	}
+}
+
+func TestCollector(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	c := NewCollector(func(i data.Item) bool { return i.Name == "Shako" })
+	c.now = func() time.Time { return now }
+
+	ground := item.Location{LocationType: item.LocationGround}
+	inventory := item.Location{LocationType: item.LocationInventory}
+
+	c.StartRun("mephisto")
+	c.Update(snapshot(1000, 100, data.Item{UnitID: 1, Name: "Shako", Location: ground}, data.Item{UnitID: 2, Name: "Jewel", Location: ground}))
+	c.Update(snapshot(1500, 100, data.Item{UnitID: 1, Name: "Shako", Location: inventory}, data.Item{UnitID: 2, Name: "Jewel", Location: inventory}))
+	c.Update(snapshot(1400, 0))
+	c.Chicken()
+	now = now.Add(90 * time.Second)
+	c.EndRun()
+
+	runs := c.Runs()
+	require.Len(t, runs, 1)
+	require.Equal(t, 500, runs[0].ExperienceGained)
+	require.Equal(t, []string{"Shako"}, runs[0].ItemNames)
+	require.Equal(t, 1, runs[0].Deaths)
+	require.Equal(t, 1, runs[0].Chickens)
+	require.Equal(t, 90*time.Second, c.Totals().AverageDuration)
+
+	var buf bytes.Buffer
+	require.NoError(t, c.WriteCSV(&buf))
+	require.Equal(t, "name,start,duration,experience,items,deaths,chickens\nmephisto,2024-01-01T00:00:00Z,90.0,500,1,1,1\n", buf.String())
+}